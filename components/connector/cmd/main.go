@@ -50,22 +50,27 @@ func main() {
 	exitOnError(appErr, "Failed to initialize Kubernetes client.")
 
 	directorGCLI := newInternalGraphQLClient(cfg.OneTimeTokenURL, cfg.HTTPClientTimeout, cfg.HttpClientSkipSslValidation)
-	internalComponents, certsLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	internalComponents, certsLoader, err := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	exitOnError(err, "Failed to initialize internal components")
 
 	go certsLoader.Run(ctx)
+	go internalComponents.IssuedCertsRepository.Run(ctx)
+	go internalComponents.CRLPublisher.Run(ctx)
 
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.KeyAlgorithms,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.IssuedCertsRepository)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, internalComponents.CRLPublisher, internalComponents.OCSPResponder, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
 	exitOnError(err, "Failed configuring external graphQL handler")
 
 	wg := &sync.WaitGroup{}
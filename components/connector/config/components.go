@@ -1,7 +1,11 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kyma-incubator/compass/components/connector/internal/namespacedname"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/pkg/errors"
 
 	"k8s.io/apimachinery/pkg/types"
 
@@ -19,25 +23,41 @@ type Components struct {
 
 	CertificateService     certificates.Service
 	RevokedCertsRepository revocation.RevokedCertificatesRepository
+	IssuedCertsRepository  revocation.IssuedCertificatesRepository
+
+	CRLPublisher  revocation.CRLPublisher
+	OCSPResponder revocation.OCSPResponder
 
 	CSRSubjectConsts certificates.CSRSubjectConsts
+	KeyAlgorithms    []certificates.KeyAlgorithm
 }
 
-func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, directorGCLI tokens.GraphQLClient) (Components, certificates.Loader) {
+func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, directorGCLI tokens.GraphQLClient) (Components, certificates.Loader, error) {
+	keyAlgorithms, err := certificates.ParseKeyAlgorithms(cfg.AllowedKeyAlgorithms)
+	if err != nil {
+		return Components{}, nil, errors.Wrap(err, "while parsing allowed key algorithms")
+	}
+
 	caSecret := namespacedname.Parse(cfg.CASecret.Name)
 
 	rootCASecret := namespacedname.Parse(cfg.RootCASecret.Name)
 
+	issuedCertsRepository := revocation.NewIssuedCertificatesRepository(k8sClientSet.CoreV1().ConfigMaps(cfg.IssuedCertificatesNamespace), cfg.IssuedCertificatesCleanupInterval)
+
 	certsCache := certificates.NewCertificateCache()
+	certUtil := certificates.NewCertificateUtility(cfg.CertificateValidityTime, newCertificateValidityByConsumerType(cfg))
 	certsService := certificates.NewCertificateService(
 		certsCache,
-		certificates.NewCertificateUtility(cfg.CertificateValidityTime),
+		certUtil,
 		caSecret.Name,
 		rootCASecret.Name,
 		cfg.CASecret.CertificateKey,
 		cfg.CASecret.KeyKey,
 		cfg.RootCASecret.CertificateKey,
+		keyAlgorithms,
+		issuedCertsRepository,
 	)
+	caProvider := certificates.NewCAProvider(certsCache, certUtil, caSecret.Name, cfg.CASecret.CertificateKey, cfg.CASecret.KeyKey)
 	certsLoader := certificates.NewCertificateLoader(certsCache, newSecretsRepository(k8sClientSet), caSecret, rootCASecret)
 
 	revokedCertsConfigMap := namespacedname.Parse(cfg.RevocationConfigMapName)
@@ -49,8 +69,12 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, direc
 		TokenService:           tokens.NewTokenService(directorGCLI),
 		CertificateService:     certsService,
 		RevokedCertsRepository: revokedCertsRepository,
+		IssuedCertsRepository:  issuedCertsRepository,
+		CRLPublisher:           revocation.NewCRLPublisher(issuedCertsRepository, caProvider, cfg.CRL.RefreshInterval),
+		OCSPResponder:          revocation.NewOCSPResponder(issuedCertsRepository, caProvider, cfg.OCSP.ResponseValidity),
 		CSRSubjectConsts:       newCSRSubjectConsts(cfg),
-	}, certsLoader
+		KeyAlgorithms:          keyAlgorithms,
+	}, certsLoader, nil
 }
 
 func newRevokedCertsRepository(k8sClientSet kubernetes.Interface, revokedCertsConfigMap types.NamespacedName) revocation.RevokedCertificatesRepository {
@@ -76,3 +100,10 @@ func newCSRSubjectConsts(config Config) certificates.CSRSubjectConsts {
 		Province:           config.CSRSubject.Province,
 	}
 }
+
+func newCertificateValidityByConsumerType(config Config) map[string]time.Duration {
+	return map[string]time.Duration{
+		string(consumer.Application): config.ApplicationCertificateValidityTime,
+		string(consumer.Runtime):     config.RuntimeCertificateValidityTime,
+	}
+}
@@ -23,8 +23,11 @@ type Config struct {
 		Locality           string `envconfig:"default=Locality"`
 		Province           string `envconfig:"default=State"`
 	}
-	CertificateValidityTime time.Duration `envconfig:"default=2160h"`
-	CASecret                struct {
+	CertificateValidityTime            time.Duration `envconfig:"default=2160h"`
+	ApplicationCertificateValidityTime time.Duration `envconfig:"optional"`
+	RuntimeCertificateValidityTime     time.Duration `envconfig:"optional"`
+	AllowedKeyAlgorithms               []string      `envconfig:"default=rsa2048;rsa3072;rsa4096"`
+	CASecret                           struct {
 		Name           string `envconfig:"default=kyma-integration/connector-service-app-ca"`
 		CertificateKey string `envconfig:"default=ca.crt"`
 		KeyKey         string `envconfig:"default=ca.key"`
@@ -34,7 +37,17 @@ type Config struct {
 		CertificateKey string `envconfig:"optional"`
 	}
 
-	RevocationConfigMapName string `envconfig:"default=compass-system/revocations-Config"`
+	RevocationConfigMapName           string        `envconfig:"default=compass-system/revocations-Config"`
+	IssuedCertificatesNamespace       string        `envconfig:"default=compass-system"`
+	IssuedCertificatesCleanupInterval time.Duration `envconfig:"default=1h"`
+	CRL                               struct {
+		Endpoint        string        `envconfig:"default=/crl"`
+		RefreshInterval time.Duration `envconfig:"default=5m"`
+	}
+	OCSP struct {
+		Endpoint         string        `envconfig:"default=/ocsp"`
+		ResponseValidity time.Duration `envconfig:"default=10m"`
+	}
 
 	DirectorURL                    string `envconfig:"default=127.0.0.1:3003"`
 	CertificateSecuredConnectorURL string `envconfig:"default=https://compass-gateway-mtls.kyma.local"`
@@ -53,20 +66,24 @@ func (c *Config) String() string {
 	return fmt.Sprintf("ExternalAddress: %s, APIEndpoint: %s, "+
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
-		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
+		"CertificateValidityTime: %s, ApplicationCertificateValidityTime: %s, RuntimeCertificateValidityTime: %s, "+
+		"AllowedKeyAlgorithms: %v, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
 		"RootCASecretName: %s, RootCASecretCertificateKey: %s, "+
 		"CertificateSecuredConnectorURL: %s, "+
-		"RevocationConfigMapName: %s, "+
+		"RevocationConfigMapName: %s, IssuedCertificatesNamespace: %s, IssuedCertificatesCleanupInterval: %s, "+
+		"CRLEndpoint: %s, CRLRefreshInterval: %s, OCSPEndpoint: %s, OCSPResponseValidity: %s, "+
 		"DirectorURL: %s "+
 		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s"+
 		"OneTimeTokenURL: %s, HTTPClienttimeout: %s",
 		c.ExternalAddress, c.APIEndpoint,
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
-		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
+		c.CertificateValidityTime, c.ApplicationCertificateValidityTime, c.RuntimeCertificateValidityTime,
+		c.AllowedKeyAlgorithms, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
 		c.RootCASecret.Name, c.RootCASecret.CertificateKey,
 		c.CertificateSecuredConnectorURL,
-		c.RevocationConfigMapName,
+		c.RevocationConfigMapName, c.IssuedCertificatesNamespace, c.IssuedCertificatesCleanupInterval,
+		c.CRL.Endpoint, c.CRL.RefreshInterval, c.OCSP.Endpoint, c.OCSP.ResponseValidity,
 		c.DirectorURL,
 		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout,
 		c.OneTimeTokenURL, c.HTTPClientTimeout)
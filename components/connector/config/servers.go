@@ -10,11 +10,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/healthz"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 )
 
-func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
+func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, crlPublisher revocation.CRLPublisher, ocspResponder revocation.OCSPResponder, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
 	gqlInternalCfg := externalschema.Config{
 		Resolvers: &api.ExternalResolver{CertificateResolver: certResolver},
 	}
@@ -27,6 +28,8 @@ func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolv
 	externalRouter.HandleFunc("/", handler2.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
 	externalRouter.HandleFunc(cfg.APIEndpoint, gqlServer.ServeHTTP)
 	externalRouter.HandleFunc("/healthz", healthz.NewHTTPHandler())
+	externalRouter.Handle(cfg.CRL.Endpoint, crlPublisher).Methods(http.MethodGet)
+	externalRouter.PathPrefix(cfg.OCSP.Endpoint).Handler(http.StripPrefix(cfg.OCSP.Endpoint, ocspResponder)).Methods(http.MethodGet, http.MethodPost)
 
 	externalRouter.Use(middlewares...)
 
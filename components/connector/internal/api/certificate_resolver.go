@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
@@ -16,7 +17,7 @@ import (
 
 type CertificateResolver interface {
 	SignCertificateSigningRequest(ctx context.Context, csr string) (*externalschema.CertificationResult, error)
-	RevokeCertificate(ctx context.Context) (bool, error)
+	RevokeCertificate(ctx context.Context, reason *externalschema.RevocationReason) (bool, error)
 	Configuration(ctx context.Context) (*externalschema.Configuration, error)
 }
 
@@ -25,9 +26,11 @@ type certificateResolver struct {
 	tokenService                   tokens.Service
 	certificatesService            certificates.Service
 	csrSubjectConsts               certificates.CSRSubjectConsts
+	keyAlgorithms                  []certificates.KeyAlgorithm
 	directorURL                    string
 	certificateSecuredConnectorURL string
 	revokedCertsRepository         revocation.RevokedCertificatesRepository
+	issuedCertsRepository          revocation.IssuedCertificatesRepository
 }
 
 func NewCertificateResolver(
@@ -35,17 +38,21 @@ func NewCertificateResolver(
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
 	csrSubjectConsts certificates.CSRSubjectConsts,
+	keyAlgorithms []certificates.KeyAlgorithm,
 	directorURL string,
 	certificateSecuredConnectorURL string,
-	revokedCertsRepository revocation.RevokedCertificatesRepository) CertificateResolver {
+	revokedCertsRepository revocation.RevokedCertificatesRepository,
+	issuedCertsRepository revocation.IssuedCertificatesRepository) CertificateResolver {
 	return &certificateResolver{
 		authenticator:                  authenticator,
 		tokenService:                   tokenService,
 		certificatesService:            certificatesService,
 		csrSubjectConsts:               csrSubjectConsts,
+		keyAlgorithms:                  keyAlgorithms,
 		directorURL:                    directorURL,
 		certificateSecuredConnectorURL: certificateSecuredConnectorURL,
 		revokedCertsRepository:         revokedCertsRepository,
+		issuedCertsRepository:          issuedCertsRepository,
 	}
 }
 
@@ -71,9 +78,20 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 		return nil, errors.Wrap(err, "Failed to get one-time token during fetching configuration process")
 	}
 
+	keyAlgorithms := make([]string, 0, len(r.keyAlgorithms))
+	for _, algorithm := range r.keyAlgorithms {
+		keyAlgorithms = append(keyAlgorithms, string(algorithm))
+	}
+
+	preferredKeyAlgorithm := ""
+	if len(keyAlgorithms) > 0 {
+		preferredKeyAlgorithm = keyAlgorithms[0]
+	}
+
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(clientId),
-		KeyAlgorithm: "rsa2048",
+		Subject:       r.csrSubjectConsts.ToString(clientId),
+		KeyAlgorithm:  preferredKeyAlgorithm,
+		KeyAlgorithms: keyAlgorithms,
 	}
 
 	log.C(ctx).Infof("Configuration for client with id %s successfully fetched.", clientId)
@@ -105,8 +123,15 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		return nil, errors.Wrap(err, "Error while decoding Certificate Signing Request")
 	}
 
+	// The consumer type is known only for some of the callers, the default certificate validity time is used for the others
+	consumerType, err := authentication.GetStringFromContext(ctx, authentication.ConsumerType)
+	if err != nil {
+		log.C(ctx).Debugf("Consumer type of client with id %s not found, using default certificate validity time", clientId)
+	}
+
 	subject := certificates.CSRSubject{
 		CommonName:       clientId,
+		ConsumerType:     consumerType,
 		CSRSubjectConsts: r.csrSubjectConsts,
 	}
 
@@ -122,7 +147,7 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 	return &certificationResult, nil
 }
 
-func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, error) {
+func (r *certificateResolver) RevokeCertificate(ctx context.Context, reason *externalschema.RevocationReason) (bool, error) {
 	log.C(ctx).Debug("Authenticating the call for certificate revocation.")
 
 	clientId, certificateHash, err := r.authenticator.AuthenticateCertificate(ctx)
@@ -140,10 +165,40 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 		return false, errors.Wrap(err, "Failed to add hash to revocation list")
 	}
 
+	log.C(ctx).Debugf("Marking issued certificate of client with id %s as revoked", clientId)
+	err = r.issuedCertsRepository.Revoke(ctx, certificateHash, toRevocationReason(reason), time.Now())
+	if err != nil {
+		// Certificates issued before the issued certificates were stored are only in the revocation list
+		if !apperrors.IsNotFound(err) {
+			log.C(ctx).WithError(err).Errorf("Failed to mark issued certificate of client with id %s as revoked: %v", clientId, err)
+			return false, errors.Wrap(err, "Failed to mark issued certificate as revoked")
+		}
+		log.C(ctx).Warnf("Issued certificate of client with id %s not found, it will not be published in the CRL", clientId)
+	}
+
 	log.C(ctx).Infof("Certificate of client with id %s successfully revoked.", clientId)
 	return true, nil
 }
 
+func toRevocationReason(reason *externalschema.RevocationReason) revocation.Reason {
+	if reason == nil {
+		return revocation.ReasonUnspecified
+	}
+
+	switch *reason {
+	case externalschema.RevocationReasonKeyCompromise:
+		return revocation.ReasonKeyCompromise
+	case externalschema.RevocationReasonAffiliationChanged:
+		return revocation.ReasonAffiliationChanged
+	case externalschema.RevocationReasonSuperseded:
+		return revocation.ReasonSuperseded
+	case externalschema.RevocationReasonCessationOfOperation:
+		return revocation.ReasonCessationOfOperation
+	default:
+		return revocation.ReasonUnspecified
+	}
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
@@ -10,8 +10,10 @@ import (
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/automock"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,6 +39,7 @@ var (
 			Province:           "province",
 		},
 	}
+	keyAlgorithms           = []certificates.KeyAlgorithm{certificates.RSA2048, certificates.ECDSAP256}
 	directorURL             = "https://compass-gateway.kyma.local/director/graphql"
 	certSecuredConnectorURL = "https://compass-gateway-mtls.kyma.local/connector/graphql"
 )
@@ -62,7 +65,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -75,6 +78,37 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
+	t.Run("should sign client certificate with consumer type", func(t *testing.T) {
+		// given
+		ctx := context.WithValue(context.TODO(), authentication.ConsumerType, "Runtime")
+		encodedChain := certificates.EncodedCertificateChain{
+			CertificateChain:  "certChainBase64",
+			CaCertificate:     "caCertificate",
+			ClientCertificate: "clientCertificate",
+		}
+
+		runtimeSubject := subject
+		runtimeSubject.ConsumerType = "Runtime"
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, runtimeSubject).Return(encodedChain, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "certChainBase64", certificationResult.CertificateChain)
+		mock.AssertExpectationsForObjects(t, authenticator, certService)
+	})
+
 	t.Run("should return error when unauthenticated call", func(t *testing.T) {
 		// given
 		certChainBase64 := "certChainBase64"
@@ -95,7 +129,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -125,7 +159,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -145,7 +179,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -166,15 +200,63 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(nil)
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Revoke", ctx, certificateHash, revocation.ReasonUnspecified, mock.AnythingOfType("time.Time")).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
 
 		// when
-		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), nil)
 
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
+	})
+
+	t.Run("should revoke certificate with reason", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		reason := externalschema.RevocationReasonKeyCompromise
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(nil)
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Revoke", ctx, certificateHash, revocation.ReasonKeyCompromise, mock.AnythingOfType("time.Time")).Return(nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), &reason)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
+	})
+
+	t.Run("should revoke certificate which was issued before issued certificates were stored", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(nil)
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Revoke", ctx, certificateHash, revocation.ReasonUnspecified, mock.AnythingOfType("time.Time")).Return(apperrors.NotFound("not found"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
 	})
 
 	t.Run("should return error if failed to verify certificate", func(t *testing.T) {
@@ -182,16 +264,17 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return("", "", errors.Errorf("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
-		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
 
 		// when
-		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), nil)
 
 		// then
 		require.Error(t, err)
 		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
 	})
 
 	t.Run("should return error if failed to save cert to repository", func(t *testing.T) {
@@ -202,15 +285,39 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(errors.Errorf("error"))
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
+	})
+
+	t.Run("should return error if failed to mark issued certificate as revoked", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(nil)
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Revoke", ctx, certificateHash, revocation.ReasonUnspecified, mock.AnythingOfType("time.Time")).Return(errors.Errorf("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuedCertsRepository)
 
 		// when
-		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background(), nil)
 
 		// then
 		require.Error(t, err)
 		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuedCertsRepository)
 	})
 }
 
@@ -227,7 +334,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService.On("GetToken", mock.Anything, subject.CommonName, "Application").Return(token, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -239,6 +346,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
 		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"rsa2048", "ecdsap256"}, configurationResult.CertificateSigningRequestInfo.KeyAlgorithms)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
@@ -253,7 +361,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService.On("GetToken", mock.Anything, subject.CommonName, "Application").Return("", apperrors.Internal("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -274,7 +382,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithms, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -67,3 +67,9 @@ func (ae appError) Code() int {
 func (ae appError) Error() string {
 	return ae.message
 }
+
+// IsNotFound checks whether the error is an AppError with the not found code
+func IsNotFound(err error) bool {
+	appErr, ok := err.(AppError)
+	return ok && appErr.Code() == CodeNotFound
+}
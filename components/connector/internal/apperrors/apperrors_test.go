@@ -1,6 +1,7 @@
 package apperrors
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -97,3 +98,10 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, "Some additional message: error, Some BadRequest apperror, Some bndl err", appendedBadRequestErr.Error())
 	})
 }
+
+func TestIsNotFound(t *testing.T) {
+	assert.True(t, IsNotFound(NotFound("Some NotFound apperror")))
+	assert.False(t, IsNotFound(Internal("Some Internal apperror")))
+	assert.False(t, IsNotFound(errors.New("some error")))
+	assert.False(t, IsNotFound(nil))
+}
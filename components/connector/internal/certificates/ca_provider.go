@@ -0,0 +1,48 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+)
+
+type caProvider struct {
+	certsCache       Cache
+	certUtil         CertificateUtility
+	caCertSecretName string
+	caCertSecretKey  string
+	caKeySecretKey   string
+}
+
+// NewCAProvider creates a provider of the CA which signs the client certificates. The CA is read from the certificates
+// cache on every call, so that a rotated CA secret is picked up without a restart.
+func NewCAProvider(certsCache Cache, certUtil CertificateUtility, caCertSecretName, caCertSecretKey, caKeySecretKey string) revocation.CAProvider {
+	return &caProvider{
+		certsCache:       certsCache,
+		certUtil:         certUtil,
+		caCertSecretName: caCertSecretName,
+		caCertSecretKey:  caCertSecretKey,
+		caKeySecretKey:   caKeySecretKey,
+	}
+}
+
+func (p *caProvider) GetCA() (*x509.Certificate, crypto.Signer, apperrors.AppError) {
+	secretData, err := p.certsCache.Get(p.caCertSecretName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caCrt, err := p.certUtil.LoadCert(secretData[p.caCertSecretKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caKey, err := p.certUtil.LoadKey(secretData[p.caKeySecretKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return caCrt, caKey, nil
+}
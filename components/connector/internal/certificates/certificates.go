@@ -1,8 +1,8 @@
 package certificates
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"math/big"
@@ -11,23 +11,30 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 )
 
+// maxSerialNumber limits the random serial numbers to 128 bits, which keeps them unique and within the 20 octets allowed by RFC 5280
+var maxSerialNumber = new(big.Int).Lsh(big.NewInt(1), 128)
+
 //go:generate mockery --name=CertificateUtility --disable-version-string
 type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
-	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
+	LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, consumerType string) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
 type certificateUtility struct {
-	certificateValidityTime time.Duration
+	certificateValidityTime           time.Duration
+	certificateValidityByConsumerType map[string]time.Duration
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
+// NewCertificateUtility creates a CertificateUtility which signs certificates valid for certificateValidityTime,
+// unless a different validity time is configured for the consumer type of the certificate owner
+func NewCertificateUtility(certificateValidityTime time.Duration, certificateValidityByConsumerType map[string]time.Duration) CertificateUtility {
 	return &certificateUtility{
-		certificateValidityTime: certificateValidityTime,
+		certificateValidityTime:           certificateValidityTime,
+		certificateValidityByConsumerType: certificateValidityByConsumerType,
 	}
 }
 
@@ -45,7 +52,7 @@ func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 	return caCRT, nil
 }
 
-func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (cu *certificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	pemBlock, _ := pem.Decode(encodedData)
 	if pemBlock == nil {
 		return nil, apperrors.Internal("Error while decoding pem block.")
@@ -55,12 +62,21 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return caPrivateKey, nil
 	}
 
+	if caPrivateKey, err := x509.ParseECPrivateKey(pemBlock.Bytes); err == nil {
+		return caPrivateKey, nil
+	}
+
 	caPrivateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 	if err != nil {
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, apperrors.Internal("Unsupported private key type %T", caPrivateKey)
+	}
+
+	return signer, nil
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -119,8 +135,15 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, consumerType string) ([]byte, apperrors.AppError) {
+	serialNumber, err := rand.Int(rand.Reader, maxSerialNumber)
+	if err != nil {
+		return nil, apperrors.Internal("Error while generating serial number: %s", err)
+	}
+	// RFC 5280 requires a positive serial number
+	serialNumber.Add(serialNumber, big.NewInt(1))
+
+	clientCRTTemplate := cu.prepareCRTTemplate(csr, serialNumber, cu.validityTime(consumerType))
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,19 +153,27 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
+// prepareCRTTemplate leaves the signature algorithm empty, so that it is chosen based on the CA key.
+// The key of the client may be of a different type than the key of the CA.
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, serialNumber *big.Int, validityTime time.Duration) x509.Certificate {
 	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
-
-		SerialNumber: big.NewInt(2),
+		SerialNumber: serialNumber,
 		Subject:      csr.Subject,
 		NotBefore:    time.Now(),
-		NotAfter:     time.Now().Add(cu.certificateValidityTime),
+		NotAfter:     time.Now().Add(validityTime),
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 	}
 }
 
+func (cu *certificateUtility) validityTime(consumerType string) time.Duration {
+	if validityTime, ok := cu.certificateValidityByConsumerType[consumerType]; ok && validityTime > 0 {
+		return validityTime
+	}
+
+	return cu.certificateValidityTime
+}
+
 func (cu *certificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byte {
 	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: crtRaw})
 }
@@ -1,10 +1,15 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -229,7 +234,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +253,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +266,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +282,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +294,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -301,7 +306,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +319,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +335,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +347,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +360,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -393,7 +398,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -414,7 +419,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -438,7 +443,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -462,7 +467,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -487,7 +492,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -511,7 +516,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -535,7 +540,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -559,7 +564,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -575,11 +580,11 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
-		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, "")
 
 		//then
 		require.NoError(t, apperr)
@@ -598,10 +603,10 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 
 		// when
-		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key, "")
 
 		// then
 		require.Error(t, err)
@@ -611,11 +616,95 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 }
 
+func TestCertificateUtility_SignCSR_KeyAlgorithms(t *testing.T) {
+	t.Run("should sign ECDSA CSR with RSA CA key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(validityTime, nil)
+		caCrt, _, key := prepareCrtAndKey(certificateUtility)
+		csr := fixECDSACSR(t, elliptic.P256())
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, "")
+
+		// then
+		require.NoError(t, apperr)
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.ECDSA, decodedCrt.PublicKeyAlgorithm)
+		assert.Equal(t, x509.SHA256WithRSA, decodedCrt.SignatureAlgorithm)
+		require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
+	t.Run("should sign CSR with ECDSA CA key", func(t *testing.T) {
+		// given
+		caKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		caTemplate := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "ca"},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		rawCA, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+		require.NoError(t, err)
+		caCrt, err := x509.ParseCertificate(rawCA)
+		require.NoError(t, err)
+
+		rawKey, err := x509.MarshalECPrivateKey(caKey)
+		require.NoError(t, err)
+
+		certificateUtility := NewCertificateUtility(validityTime, nil)
+		loadedKey, apperr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey}))
+		require.NoError(t, apperr)
+		csr, apperr := certificateUtility.LoadCSR([]byte(CSR))
+		require.NoError(t, apperr)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, loadedKey, "")
+
+		// then
+		require.NoError(t, apperr)
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.ECDSAWithSHA384, decodedCrt.SignatureAlgorithm)
+		require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
+	t.Run("should use validity time of the consumer type", func(t *testing.T) {
+		// given
+		runtimeValidityTime := 5 * time.Minute
+		certificateUtility := NewCertificateUtility(validityTime, map[string]time.Duration{
+			"Runtime":     runtimeValidityTime,
+			"Application": 0,
+		})
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		// when
+		rawRuntimeCRT, runtimeErr := certificateUtility.SignCSR(caCrt, csr, key, "Runtime")
+		rawApplicationCRT, applicationErr := certificateUtility.SignCSR(caCrt, csr, key, "Application")
+
+		// then
+		require.NoError(t, runtimeErr)
+		require.NoError(t, applicationErr)
+
+		runtimeCrt, err := x509.ParseCertificate(rawRuntimeCRT)
+		require.NoError(t, err)
+		assert.Equal(t, runtimeValidityTime, calculateValidityTime(runtimeCrt))
+
+		applicationCrt, err := x509.ParseCertificate(rawApplicationCRT)
+		require.NoError(t, err)
+		assert.Equal(t, validityTime, calculateValidityTime(applicationCrt))
+	})
+}
+
 func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, nil)
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
@@ -645,7 +734,7 @@ func calculateValidityTime(certificate *x509.Certificate) time.Duration {
 	return difference
 }
 
-func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) {
+func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, crypto.Signer) {
 	caCrt, err := certificateUtility.LoadCert(encodedCert)
 	if err != nil {
 	}
@@ -657,3 +746,15 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func fixECDSACSR(t *testing.T, curve elliptic.Curve) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+
+	rawCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: commonName}}, key)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(rawCSR)
+	require.NoError(t, err)
+	return csr
+}
@@ -0,0 +1,75 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// KeyAlgorithm identifies the type and size of the public key in a Certificate Signing Request
+type KeyAlgorithm string
+
+const (
+	RSA2048   KeyAlgorithm = "rsa2048"
+	RSA3072   KeyAlgorithm = "rsa3072"
+	RSA4096   KeyAlgorithm = "rsa4096"
+	ECDSAP256 KeyAlgorithm = "ecdsap256"
+	ECDSAP384 KeyAlgorithm = "ecdsap384"
+)
+
+var supportedKeyAlgorithms = map[KeyAlgorithm]bool{
+	RSA2048:   true,
+	RSA3072:   true,
+	RSA4096:   true,
+	ECDSAP256: true,
+	ECDSAP384: true,
+}
+
+// ParseKeyAlgorithms validates the configured key algorithms. The order is preserved, the first algorithm is the preferred one.
+func ParseKeyAlgorithms(names []string) ([]KeyAlgorithm, error) {
+	algorithms := make([]KeyAlgorithm, 0, len(names))
+	seen := make(map[KeyAlgorithm]bool, len(names))
+	for _, name := range names {
+		algorithm := KeyAlgorithm(name)
+		if !supportedKeyAlgorithms[algorithm] {
+			return nil, errors.Errorf("unsupported key algorithm %q", name)
+		}
+
+		if seen[algorithm] {
+			continue
+		}
+		seen[algorithm] = true
+		algorithms = append(algorithms, algorithm)
+	}
+
+	if len(algorithms) == 0 {
+		return nil, errors.New("at least one key algorithm must be allowed")
+	}
+
+	return algorithms, nil
+}
+
+// KeyAlgorithmOf returns the key algorithm of a public key parsed from a Certificate Signing Request
+func KeyAlgorithmOf(publicKey interface{}) (KeyAlgorithm, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		algorithm := KeyAlgorithm(fmt.Sprintf("rsa%d", key.N.BitLen()))
+		if !supportedKeyAlgorithms[algorithm] {
+			return "", errors.Errorf("unsupported RSA key size %d", key.N.BitLen())
+		}
+		return algorithm, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return ECDSAP256, nil
+		case elliptic.P384():
+			return ECDSAP384, nil
+		}
+		return "", errors.Errorf("unsupported ECDSA curve %s", key.Curve.Params().Name)
+	default:
+		return "", errors.Errorf("unsupported public key type %T", publicKey)
+	}
+}
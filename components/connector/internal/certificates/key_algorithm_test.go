@@ -0,0 +1,96 @@
+package certificates_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyAlgorithms(t *testing.T) {
+	t.Run("should parse key algorithms preserving order", func(t *testing.T) {
+		// when
+		algorithms, err := certificates.ParseKeyAlgorithms([]string{"ecdsap256", "rsa3072", "ecdsap256", "rsa4096"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []certificates.KeyAlgorithm{certificates.ECDSAP256, certificates.RSA3072, certificates.RSA4096}, algorithms)
+	})
+
+	t.Run("should return error for unsupported key algorithm", func(t *testing.T) {
+		// when
+		_, err := certificates.ParseKeyAlgorithms([]string{"rsa1024"})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported key algorithm "rsa1024"`)
+	})
+
+	t.Run("should return error when no key algorithm is allowed", func(t *testing.T) {
+		// when
+		_, err := certificates.ParseKeyAlgorithms(nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one key algorithm must be allowed")
+	})
+}
+
+func TestKeyAlgorithmOf(t *testing.T) {
+	t.Run("should return RSA key algorithm", func(t *testing.T) {
+		// when
+		algorithm, err := certificates.KeyAlgorithmOf(&fixRSAKey(2048).PublicKey)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.RSA2048, algorithm)
+	})
+
+	t.Run("should return ECDSA key algorithms", func(t *testing.T) {
+		// when
+		p256, err := certificates.KeyAlgorithmOf(&fixECDSAKey(elliptic.P256()).PublicKey)
+		require.NoError(t, err)
+		p384, err := certificates.KeyAlgorithmOf(&fixECDSAKey(elliptic.P384()).PublicKey)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, certificates.ECDSAP256, p256)
+		assert.Equal(t, certificates.ECDSAP384, p384)
+	})
+
+	t.Run("should return error for unsupported RSA key size", func(t *testing.T) {
+		// when
+		_, err := certificates.KeyAlgorithmOf(&fixRSAKey(1024).PublicKey)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported RSA key size 1024")
+	})
+
+	t.Run("should return error for unsupported ECDSA curve", func(t *testing.T) {
+		// given
+		key, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+		require.NoError(t, err)
+
+		// when
+		_, err = certificates.KeyAlgorithmOf(&key.PublicKey)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported ECDSA curve P-521")
+	})
+
+	t.Run("should return error for unsupported key type", func(t *testing.T) {
+		// when
+		_, err := certificates.KeyAlgorithmOf(ed25519.PublicKey{})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unsupported public key type ed25519.PublicKey")
+	})
+}
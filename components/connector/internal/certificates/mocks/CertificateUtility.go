@@ -3,14 +3,13 @@
 package mocks
 
 import (
+	crypto "crypto"
+	x509 "crypto/x509"
+	testing "testing"
+
 	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
-
 	mock "github.com/stretchr/testify/mock"
-
-	rsa "crypto/rsa"
-
-	x509 "crypto/x509"
 )
 
 // CertificateUtility is an autogenerated mock type for the CertificateUtility type
@@ -101,15 +100,15 @@ func (_m *CertificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 }
 
 // LoadKey provides a mock function with given fields: encodedData
-func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (_m *CertificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	ret := _m.Called(encodedData)
 
-	var r0 *rsa.PrivateKey
-	if rf, ok := ret.Get(0).(func([]byte) *rsa.PrivateKey); ok {
+	var r0 crypto.Signer
+	if rf, ok := ret.Get(0).(func([]byte) crypto.Signer); ok {
 		r0 = rf(encodedData)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rsa.PrivateKey)
+			r0 = ret.Get(0).(crypto.Signer)
 		}
 	}
 
@@ -125,13 +124,13 @@ func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 	return r0, r1
 }
 
-// SignCSR provides a mock function with given fields: caCrt, csr, caKey
-func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	ret := _m.Called(caCrt, csr, caKey)
+// SignCSR provides a mock function with given fields: caCrt, csr, caKey, consumerType
+func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, consumerType string) ([]byte, apperrors.AppError) {
+	ret := _m.Called(caCrt, csr, caKey, consumerType)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) []byte); ok {
-		r0 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer, string) []byte); ok {
+		r0 = rf(caCrt, csr, caKey, consumerType)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -139,8 +138,8 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) apperrors.AppError); ok {
-		r1 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer, string) apperrors.AppError); ok {
+		r1 = rf(caCrt, csr, caKey, consumerType)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -149,3 +148,13 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 
 	return r0, r1
 }
+
+// NewCertificateUtility creates a new instance of CertificateUtility. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCertificateUtility(t testing.TB) *CertificateUtility {
+	mock := &CertificateUtility{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,8 @@ import (
 
 type CSRSubject struct {
 	CommonName string
+	// ConsumerType is the type of the certificate owner, e.g. Application or Runtime. It may be empty if it is unknown.
+	ConsumerType string
 	CSRSubjectConsts
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 )
 
 //go:generate mockery --name=Service --disable-version-string
@@ -18,29 +19,31 @@ type Service interface {
 }
 
 type certificateService struct {
-	certsCache           Cache
-	certUtil             CertificateUtility
-	caCertSecretName     string
-	caCertSecretKey      string
-	caKeySecretKey       string
-	rootCACertSecretName string
-	rootCACertSecretKey  string
+	certsCache            Cache
+	certUtil              CertificateUtility
+	caProvider            revocation.CAProvider
+	rootCACertSecretName  string
+	rootCACertSecretKey   string
+	allowedKeyAlgorithms  []KeyAlgorithm
+	issuedCertsRepository revocation.IssuedCertificatesRepository
 }
 
 func NewCertificateService(
 	certsCache Cache,
 	certUtil CertificateUtility,
 	caCertSecretName, rootCACertSecretName string,
-	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string) Service {
+	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string,
+	allowedKeyAlgorithms []KeyAlgorithm,
+	issuedCertsRepository revocation.IssuedCertificatesRepository) Service {
 
 	return &certificateService{
-		certsCache:           certsCache,
-		certUtil:             certUtil,
-		caCertSecretName:     caCertSecretName,
-		caCertSecretKey:      caCertSecretKey,
-		caKeySecretKey:       caKeySecretKey,
-		rootCACertSecretName: rootCACertSecretName,
-		rootCACertSecretKey:  rootCACertSecretKey,
+		certsCache:            certsCache,
+		certUtil:              certUtil,
+		caProvider:            NewCAProvider(certsCache, certUtil, caCertSecretName, caCertSecretKey, caKeySecretKey),
+		rootCACertSecretName:  rootCACertSecretName,
+		rootCACertSecretKey:   rootCACertSecretKey,
+		allowedKeyAlgorithms:  allowedKeyAlgorithms,
+		issuedCertsRepository: issuedCertsRepository,
 	}
 }
 
@@ -59,7 +62,7 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	}
 	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", subject.CommonName)
 
-	encodedCertChain, err := svc.signCSR(csr)
+	encodedCertChain, err := svc.signCSR(ctx, csr, subject.ConsumerType)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
@@ -68,25 +71,20 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	return encodedCertChain, nil
 }
 
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
-	secretData, err := svc.certsCache.Get(svc.caCertSecretName)
+func (svc *certificateService) signCSR(ctx context.Context, csr *x509.CertificateRequest, consumerType string) (EncodedCertificateChain, apperrors.AppError) {
+	caCrt, caKey, err := svc.caProvider.GetCA()
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
-	caCrt, err := svc.certUtil.LoadCert(secretData[svc.caCertSecretKey])
+	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey, consumerType)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
-	caKey, err := svc.certUtil.LoadKey(secretData[svc.caKeySecretKey])
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
-
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
-	if err != nil {
-		return EncodedCertificateChain{}, err
+	// A certificate which is not stored could not be revoked later, so it is not returned to the client
+	if err := svc.issuedCertsRepository.Insert(ctx, signedCrt, consumerType); err != nil {
+		return EncodedCertificateChain{}, apperrors.Internal("Error while storing issued certificate: %s", err)
 	}
 
 	return svc.encodeCertificates(caCrt.Raw, signedCrt)
@@ -125,9 +123,28 @@ func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
 }
 
 func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
+	if err := svc.checkKeyAlgorithm(csr); err != nil {
+		return err
+	}
+
 	return svc.certUtil.CheckCSRValues(csr, expectedSubject)
 }
 
+func (svc *certificateService) checkKeyAlgorithm(csr *x509.CertificateRequest) apperrors.AppError {
+	algorithm, err := KeyAlgorithmOf(csr.PublicKey)
+	if err != nil {
+		return apperrors.WrongInput("CSR: Invalid key provided: %s.", err)
+	}
+
+	for _, allowed := range svc.allowedKeyAlgorithms {
+		if allowed == algorithm {
+			return nil
+		}
+	}
+
+	return apperrors.WrongInput("CSR: Key algorithm %s is not allowed, allowed key algorithms: %v.", algorithm, svc.allowedKeyAlgorithms)
+}
+
 func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertificateChain {
 	return EncodedCertificateChain{
 		CertificateChain:  encodeStringBase64(certChain),
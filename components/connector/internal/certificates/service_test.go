@@ -2,6 +2,10 @@ package certificates_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
@@ -11,7 +15,10 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -51,7 +58,9 @@ var (
 	rootCACrt = &x509.Certificate{}
 	caCrt     = &x509.Certificate{}
 	caKey     = &rsa.PrivateKey{}
-	csr       = &x509.CertificateRequest{}
+	csr       = &x509.CertificateRequest{PublicKey: &fixRSAKey(2048).PublicKey}
+
+	keyAlgorithms = []certificates.KeyAlgorithm{certificates.RSA2048, certificates.ECDSAP256}
 
 	rootCACrtBytes = []byte("rootCACertificate")
 	clientCRT      = []byte("clientCertificate")
@@ -83,10 +92,13 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, "").Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Insert", mock.Anything, clientCRT, "").Return(nil)
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -94,7 +106,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -112,6 +126,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Equal(t, certChain, decodedChain)
 
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should create certificate with additional root certificate", func(t *testing.T) {
@@ -128,11 +143,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, "").Return(clientCRT, nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once().
 			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Insert", mock.Anything, clientCRT, "").Return(nil)
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -140,7 +158,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCASecretName,
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -158,6 +178,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Equal(t, certChain, decodedChain)
 
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
@@ -167,6 +188,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -174,7 +197,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -184,6 +209,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Equal(t, apperrors.CodeNotFound, err.Code())
 		assert.Empty(t, encodedChain)
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load csr", func(t *testing.T) {
@@ -193,6 +219,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(nil, apperrors.Internal("error"))
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -200,7 +228,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -210,6 +240,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when subject check failed", func(t *testing.T) {
@@ -220,6 +251,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -227,7 +260,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -237,6 +272,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeForbidden, err.Code())
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load cert", func(t *testing.T) {
@@ -249,6 +285,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -256,7 +294,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -266,6 +306,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load key", func(t *testing.T) {
@@ -279,6 +320,8 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
 
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
@@ -286,7 +329,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -296,6 +341,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
@@ -308,7 +354,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
+		certUtils.On("SignCSR", caCrt, csr, caKey, "").Return(nil, apperrors.Internal("error"))
+
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
@@ -317,7 +365,9 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			"",
 			caCertificateSecretKey,
 			caKeySecretKey,
-			rootCACertificateSecretKey)
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
 
 		// when
 		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
@@ -327,9 +377,171 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to store issued certificate", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, "").Return(clientCRT, nil)
+
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Insert", mock.Anything, clientCRT, "").Return(errors.New("some error"))
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Contains(t, err.Error(), "some error")
+		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
+	})
+}
+
+func TestCertificateService_SignCSR_KeyAlgorithm(t *testing.T) {
+	t.Run("should sign ECDSA CSR with validity time of the consumer type", func(t *testing.T) {
+		// given
+		ecdsaCSR := &x509.CertificateRequest{PublicKey: &fixECDSAKey(elliptic.P256()).PublicKey}
+		runtimeSubject := subjectValues
+		runtimeSubject.ConsumerType = "Runtime"
+
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(ecdsaCSR, nil)
+		certUtils.On("CheckCSRValues", ecdsaCSR, runtimeSubject).Return(nil)
+		certUtils.On("SignCSR", caCrt, ecdsaCSR, caKey, "Runtime").Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+		issuedCertsRepository.On("Insert", mock.Anything, clientCRT, "Runtime").Return(nil)
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, runtimeSubject)
+
+		// then
+		require.NoError(t, apperr)
+		assert.NotEmpty(t, encodedCertChain)
+		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when key algorithm is not allowed", func(t *testing.T) {
+		// given
+		ecdsaCSR := &x509.CertificateRequest{PublicKey: &fixECDSAKey(elliptic.P384()).PublicKey}
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCSR", rawCSR).Return(ecdsaCSR, nil)
+
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
+		certificatesService := certificates.NewCertificateService(
+			certificates.NewCertificateCache(),
+			certUtils,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		assert.Contains(t, err.Error(), "Key algorithm ecdsap384 is not allowed")
+		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when key type is not supported", func(t *testing.T) {
+		// given
+		ed25519CSR := &x509.CertificateRequest{PublicKey: ed25519.PublicKey{}}
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCSR", rawCSR).Return(ed25519CSR, nil)
+
+		issuedCertsRepository := &revocationMocks.IssuedCertificatesRepository{}
+
+		certificatesService := certificates.NewCertificateService(
+			certificates.NewCertificateCache(),
+			certUtils,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey,
+			keyAlgorithms,
+			issuedCertsRepository)
+
+		// when
+		_, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		assert.Contains(t, err.Error(), "unsupported public key type")
+		certUtils.AssertExpectations(t)
+		issuedCertsRepository.AssertExpectations(t)
 	})
 }
 
 func decodeBase64(base64CrtChain string) ([]byte, error) {
 	return base64.StdEncoding.DecodeString(base64CrtChain)
 }
+
+func fixRSAKey(bits int) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func fixECDSAKey(curve elliptic.Curve) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
@@ -0,0 +1,199 @@
+package revocation
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	crlPublisherCorrelationID = "crl-publisher"
+	crlContentType            = "application/pkix-crl"
+)
+
+// oidExtensionReasonCode is the object identifier of the CRL entry extension holding the revocation reason, defined in RFC 5280
+var oidExtensionReasonCode = asn1.ObjectIdentifier{2, 5, 29, 21}
+
+//go:generate mockery --name=CAProvider --disable-version-string
+type CAProvider interface {
+	// GetCA returns the certificate and key of the CA which signs the client certificates
+	GetCA() (*x509.Certificate, crypto.Signer, apperrors.AppError)
+}
+
+type CRLPublisher interface {
+	Run(ctx context.Context)
+	Refresh(ctx context.Context) error
+	ServeHTTP(writer http.ResponseWriter, request *http.Request)
+}
+
+type crlPublisher struct {
+	repository      IssuedCertificatesRepository
+	caProvider      CAProvider
+	refreshInterval time.Duration
+
+	mu  sync.RWMutex
+	crl []byte
+}
+
+// NewCRLPublisher creates a publisher of the Certificate Revocation List signed by the CA. The list is rebuilt
+// every refreshInterval and announces the next update after two intervals, so that clients fetching it just before
+// the refresh do not consider it stale.
+func NewCRLPublisher(repository IssuedCertificatesRepository, caProvider CAProvider, refreshInterval time.Duration) CRLPublisher {
+	return &crlPublisher{
+		repository:      repository,
+		caProvider:      caProvider,
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (p *crlPublisher) Run(ctx context.Context) {
+	ctx = p.configureLogger(ctx)
+
+	ticker := time.NewTicker(p.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Refresh(ctx); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to refresh Certificate Revocation List")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping CRL publisher...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh signs a new CRL with the revoked certificates which are still valid
+func (p *crlPublisher) Refresh(ctx context.Context) error {
+	now := time.Now()
+
+	revoked, err := p.repository.ListRevoked(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while listing revoked certificates")
+	}
+
+	caCrt, caKey, appErr := p.caProvider.GetCA()
+	if appErr != nil {
+		return errors.Wrap(appErr, "while loading CA")
+	}
+
+	entries := make([]pkix.RevokedCertificate, 0, len(revoked))
+	for _, crt := range revoked {
+		if crt.NotAfter.Before(now) {
+			continue
+		}
+
+		entry, err := revokedCertificateEntry(crt)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+
+	issuer, err := crlIssuer(caCrt)
+	if err != nil {
+		return err
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(now.Unix()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(2 * p.refreshInterval),
+		RevokedCertificates: entries,
+	}, issuer, caKey)
+	if err != nil {
+		return errors.Wrap(err, "while signing Certificate Revocation List")
+	}
+
+	p.mu.Lock()
+	p.crl = crl
+	p.mu.Unlock()
+
+	log.C(ctx).Debugf("Certificate Revocation List refreshed with %d revoked certificates", len(entries))
+	return nil
+}
+
+// ServeHTTP responds with the latest DER encoded CRL
+func (p *crlPublisher) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	p.mu.RLock()
+	crl := p.crl
+	p.mu.RUnlock()
+
+	if crl == nil {
+		http.Error(writer, "Certificate Revocation List is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	writer.Header().Set("Content-Type", crlContentType)
+	if _, err := writer.Write(crl); err != nil {
+		log.C(request.Context()).WithError(err).Error("Failed to write Certificate Revocation List")
+	}
+}
+
+func (p *crlPublisher) configureLogger(ctx context.Context) context.Context {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, crlPublisherCorrelationID)
+	return log.ContextWithLogger(ctx, entry)
+}
+
+// crlIssuer prepares the CA certificate for signing the CRL. A CA without the key usage extension, e.g. an X.509 v1
+// certificate, may sign CRLs according to RFC 5280, so the cRLSign usage and the subject key identifier, which are
+// required when creating the CRL, are filled in on a copy of the certificate.
+func crlIssuer(caCrt *x509.Certificate) (*x509.Certificate, error) {
+	if caCrt.KeyUsage != 0 && len(caCrt.SubjectKeyId) > 0 {
+		return caCrt, nil
+	}
+
+	issuer := *caCrt
+	if issuer.KeyUsage == 0 {
+		issuer.KeyUsage = x509.KeyUsageCRLSign
+	}
+
+	if len(issuer.SubjectKeyId) == 0 {
+		var publicKeyInfo struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}
+		if _, err := asn1.Unmarshal(caCrt.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+			return nil, errors.Wrap(err, "while parsing CA public key")
+		}
+
+		keyID := sha1.Sum(publicKeyInfo.PublicKey.RightAlign())
+		issuer.SubjectKeyId = keyID[:]
+	}
+
+	return &issuer, nil
+}
+
+func revokedCertificateEntry(crt IssuedCertificate) (pkix.RevokedCertificate, error) {
+	entry := pkix.RevokedCertificate{
+		SerialNumber:   crt.SerialNumber,
+		RevocationTime: *crt.RevokedAt,
+	}
+
+	// The reason code extension should be omitted rather than set to unspecified
+	if crt.Reason != ReasonUnspecified {
+		reasonCode, err := asn1.Marshal(asn1.Enumerated(crt.Reason))
+		if err != nil {
+			return pkix.RevokedCertificate{}, errors.Wrapf(err, "while encoding revocation reason of certificate with serial number %s", serialNumberText(crt.SerialNumber))
+		}
+		entry.Extensions = []pkix.Extension{{Id: oidExtensionReasonCode, Value: reasonCode}}
+	}
+
+	return entry, nil
+}
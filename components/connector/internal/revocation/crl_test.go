@@ -0,0 +1,123 @@
+package revocation_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRLPublisher(t *testing.T) {
+	caCrt, caKey := fixCA(t)
+	revokedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	refreshInterval := 5 * time.Minute
+
+	t.Run("should publish revoked certificates which are not expired", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListRevoked", ctx).Return([]revocation.IssuedCertificate{
+			{SerialNumber: big.NewInt(42), NotAfter: time.Now().Add(time.Hour), RevokedAt: &revokedAt, Reason: revocation.ReasonKeyCompromise},
+			{SerialNumber: big.NewInt(43), NotAfter: time.Now().Add(time.Hour), RevokedAt: &revokedAt, Reason: revocation.ReasonUnspecified},
+			{SerialNumber: big.NewInt(44), NotAfter: time.Now().Add(-time.Minute), RevokedAt: &revokedAt, Reason: revocation.ReasonSuperseded},
+		}, nil)
+		caProvider := &mocks.CAProvider{}
+		caProvider.On("GetCA").Return(caCrt, caKey, nil)
+
+		publisher := revocation.NewCRLPublisher(repository, caProvider, refreshInterval)
+
+		// when
+		err := publisher.Refresh(ctx)
+		require.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		publisher.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/crl", nil))
+
+		// then
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/pkix-crl", recorder.Header().Get("Content-Type"))
+
+		crl, err := x509.ParseDERCRL(recorder.Body.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, caCrt.CheckCRLSignature(crl))
+		assert.WithinDuration(t, crl.TBSCertList.ThisUpdate.Add(2*refreshInterval), crl.TBSCertList.NextUpdate, time.Second)
+
+		entries := crl.TBSCertList.RevokedCertificates
+		require.Len(t, entries, 2)
+		assert.Equal(t, big.NewInt(42), entries[0].SerialNumber)
+		assert.True(t, revokedAt.Equal(entries[0].RevocationTime))
+		require.Len(t, entries[0].Extensions, 1)
+		var reason asn1.Enumerated
+		_, err = asn1.Unmarshal(entries[0].Extensions[0].Value, &reason)
+		require.NoError(t, err)
+		assert.Equal(t, asn1.Enumerated(revocation.ReasonKeyCompromise), reason)
+
+		assert.Equal(t, big.NewInt(43), entries[1].SerialNumber)
+		assert.Empty(t, entries[1].Extensions)
+
+		mock.AssertExpectationsForObjects(t, repository, caProvider)
+	})
+
+	t.Run("should respond with service unavailable before the first refresh", func(t *testing.T) {
+		// given
+		publisher := revocation.NewCRLPublisher(&mocks.IssuedCertificatesRepository{}, &mocks.CAProvider{}, refreshInterval)
+		recorder := httptest.NewRecorder()
+
+		// when
+		publisher.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/crl", nil))
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	})
+
+	t.Run("should return error when failed to list revoked certificates", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListRevoked", ctx).Return(nil, errors.New("some error"))
+
+		publisher := revocation.NewCRLPublisher(repository, &mocks.CAProvider{}, refreshInterval)
+
+		// when
+		err := publisher.Refresh(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "some error")
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when CA is not loaded", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListRevoked", ctx).Return([]revocation.IssuedCertificate{}, nil)
+		caProvider := &mocks.CAProvider{}
+		caProvider.On("GetCA").Return(nil, nil, apperrors.NotFound("Certificate data not found in the cache."))
+
+		publisher := revocation.NewCRLPublisher(repository, caProvider, refreshInterval)
+
+		// when
+		err := publisher.Refresh(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading CA")
+		mock.AssertExpectationsForObjects(t, repository, caProvider)
+	})
+}
@@ -0,0 +1,55 @@
+package revocation_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fixCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// The CA intentionally has no key usage extension, the same as the CA generated during the installation
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "connector-ca"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	return crt, key
+}
+
+func fixClientCertificate(t *testing.T, caCrt *x509.Certificate, caKey *ecdsa.PrivateKey, serialNumber int64, notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, caCrt, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	return crt
+}
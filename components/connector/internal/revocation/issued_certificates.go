@@ -0,0 +1,366 @@
+package revocation
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	issuedCertificateNamePrefix = "issued-certificate-"
+
+	issuedCertificateLabel = "connector.compass.kyma-project.io/issued-certificate"
+	serialNumberLabel      = "connector.compass.kyma-project.io/serial-number"
+	revokedLabel           = "connector.compass.kyma-project.io/revoked"
+
+	serialNumberKey = "serialNumber"
+	hashKey         = "hash"
+	commonNameKey   = "commonName"
+	consumerTypeKey = "consumerType"
+	notAfterKey     = "notAfter"
+	revokedAtKey    = "revokedAt"
+	reasonKey       = "reason"
+
+	serialNumberIndex = "serialNumber"
+	revokedIndex      = "revoked"
+)
+
+// Reason is a certificate revocation reason code as defined in RFC 5280
+type Reason int
+
+const (
+	ReasonUnspecified          Reason = 0
+	ReasonKeyCompromise        Reason = 1
+	ReasonAffiliationChanged   Reason = 3
+	ReasonSuperseded           Reason = 4
+	ReasonCessationOfOperation Reason = 5
+)
+
+// IssuedCertificate describes a client certificate signed by the Connector
+type IssuedCertificate struct {
+	SerialNumber *big.Int
+	// Hash is the hex encoded SHA-256 digest of the DER encoded certificate, the same which is used by Istio in the client certificate header
+	Hash         string
+	CommonName   string
+	ConsumerType string
+	NotAfter     time.Time
+	// RevokedAt is nil for certificates which are not revoked
+	RevokedAt *time.Time
+	Reason    Reason
+}
+
+func (c IssuedCertificate) Revoked() bool {
+	return c.RevokedAt != nil
+}
+
+//go:generate mockery --name=IssuedCertificatesManager --disable-version-string
+type IssuedCertificatesManager interface {
+	Create(ctx context.Context, configMap *v1.ConfigMap, opts metav1.CreateOptions) (*v1.ConfigMap, error)
+	Get(ctx context.Context, name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Update(ctx context.Context, configMap *v1.ConfigMap, opts metav1.UpdateOptions) (*v1.ConfigMap, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.ConfigMapList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+//go:generate mockery --name=IssuedCertificatesRepository --disable-version-string
+type IssuedCertificatesRepository interface {
+	// Run keeps the cache of issued certificates in sync and deletes the expired ones every cleanup interval, until the context is cancelled
+	Run(ctx context.Context)
+	// Insert stores the DER encoded certificate signed for a consumer of the given type
+	Insert(ctx context.Context, rawCertificate []byte, consumerType string) error
+	// Revoke marks the certificate with the given hash as revoked. A certificate which is already revoked keeps its original revocation time and reason.
+	Revoke(ctx context.Context, hash string, reason Reason, revokedAt time.Time) error
+	GetBySerialNumber(ctx context.Context, serialNumber *big.Int) (IssuedCertificate, error)
+	ListRevoked(ctx context.Context) ([]IssuedCertificate, error)
+	// DeleteExpired removes the certificates which expired before the given time, as they no longer have to be listed in the CRL
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// issuedCertificatesRepository stores every issued certificate in a separate ConfigMap, so that the number of
+// certificates is not limited by the size of a single ConfigMap. The ConfigMap is named after the certificate hash,
+// which is known when the certificate owner requests revocation, and labeled with the serial number, which is used in CRLs and OCSP requests.
+// The lookups are served from an informer cache indexed by the serial number and the revocation state, so that OCSP and CRL requests do not reach the API server.
+type issuedCertificatesRepository struct {
+	configMapManager IssuedCertificatesManager
+	informer         cache.SharedIndexInformer
+	cleanupInterval  time.Duration
+}
+
+func NewIssuedCertificatesRepository(configMapManager IssuedCertificatesManager, cleanupInterval time.Duration) IssuedCertificatesRepository {
+	labelSelector := fmt.Sprintf("%s=true", issuedCertificateLabel)
+	listWatch := &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			opts.LabelSelector = labelSelector
+			return configMapManager.List(context.Background(), opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+			opts.LabelSelector = labelSelector
+			return configMapManager.Watch(context.Background(), opts)
+		},
+	}
+
+	informer := cache.NewSharedIndexInformer(listWatch, &v1.ConfigMap{}, 0, cache.Indexers{
+		serialNumberIndex: labelIndexFunc(serialNumberLabel),
+		revokedIndex:      labelIndexFunc(revokedLabel),
+	})
+
+	return &issuedCertificatesRepository{
+		configMapManager: configMapManager,
+		informer:         informer,
+		cleanupInterval:  cleanupInterval,
+	}
+}
+
+func (r *issuedCertificatesRepository) Run(ctx context.Context) {
+	go r.informer.Run(ctx.Done())
+
+	ticker := time.NewTicker(r.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := r.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			log.C(ctx).WithError(err).Error("Failed to delete expired certificates")
+		} else if deleted > 0 {
+			log.C(ctx).Infof("Deleted %d expired certificates", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping issued certificates cleanup...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *issuedCertificatesRepository) Insert(ctx context.Context, rawCertificate []byte, consumerType string) error {
+	crt, err := x509.ParseCertificate(rawCertificate)
+	if err != nil {
+		return errors.Wrap(err, "while parsing issued certificate")
+	}
+
+	digest := sha256.Sum256(rawCertificate)
+	issued := IssuedCertificate{
+		SerialNumber: crt.SerialNumber,
+		Hash:         hex.EncodeToString(digest[:]),
+		CommonName:   crt.Subject.CommonName,
+		ConsumerType: consumerType,
+		NotAfter:     crt.NotAfter,
+	}
+
+	if _, err := r.configMapManager.Create(ctx, toConfigMap(issued), metav1.CreateOptions{}); err != nil {
+		return errors.Wrapf(err, "while storing issued certificate with serial number %s", serialNumberText(issued.SerialNumber))
+	}
+
+	return nil
+}
+
+func (r *issuedCertificatesRepository) Revoke(ctx context.Context, hash string, reason Reason, revokedAt time.Time) error {
+	name := configMapName(hash)
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return apperrors.NotFound("Issued certificate with hash %s not found", hash)
+			}
+			return err
+		}
+
+		issued, err := fromConfigMap(configMap)
+		if err != nil {
+			return err
+		}
+
+		if issued.Revoked() {
+			return nil
+		}
+
+		issued.RevokedAt = &revokedAt
+		issued.Reason = reason
+
+		updated := toConfigMap(issued)
+		updated.ResourceVersion = configMap.ResourceVersion
+
+		_, err = r.configMapManager.Update(ctx, updated, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *issuedCertificatesRepository) GetBySerialNumber(ctx context.Context, serialNumber *big.Int) (IssuedCertificate, error) {
+	issued, err := r.list(ctx, serialNumberIndex, serialNumberText(serialNumber))
+	if err != nil {
+		return IssuedCertificate{}, err
+	}
+
+	if len(issued) == 0 {
+		return IssuedCertificate{}, apperrors.NotFound("Issued certificate with serial number %s not found", serialNumberText(serialNumber))
+	}
+
+	return issued[0], nil
+}
+
+func (r *issuedCertificatesRepository) ListRevoked(ctx context.Context) ([]IssuedCertificate, error) {
+	return r.list(ctx, revokedIndex, "true")
+}
+
+func (r *issuedCertificatesRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	if err := r.waitForCacheSync(ctx); err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, obj := range r.informer.GetStore().List() {
+		crt, err := fromConfigMap(obj.(*v1.ConfigMap))
+		if err != nil {
+			return deleted, err
+		}
+
+		if !crt.NotAfter.Before(before) {
+			continue
+		}
+
+		err = r.configMapManager.Delete(ctx, configMapName(crt.Hash), metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return deleted, errors.Wrapf(err, "while deleting expired certificate with serial number %s", serialNumberText(crt.SerialNumber))
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+func (r *issuedCertificatesRepository) list(ctx context.Context, indexName, value string) ([]IssuedCertificate, error) {
+	if err := r.waitForCacheSync(ctx); err != nil {
+		return nil, err
+	}
+
+	configMaps, err := r.informer.GetIndexer().ByIndex(indexName, value)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing issued certificates")
+	}
+
+	issued := make([]IssuedCertificate, 0, len(configMaps))
+	for _, obj := range configMaps {
+		crt, err := fromConfigMap(obj.(*v1.ConfigMap))
+		if err != nil {
+			return nil, err
+		}
+		issued = append(issued, crt)
+	}
+
+	return issued, nil
+}
+
+// waitForCacheSync blocks until the issued certificates are listed for the first time, or the context is cancelled
+func (r *issuedCertificatesRepository) waitForCacheSync(ctx context.Context) error {
+	if !cache.WaitForCacheSync(ctx.Done(), r.informer.HasSynced) {
+		return errors.New("issued certificates cache has not been synced")
+	}
+	return nil
+}
+
+func labelIndexFunc(label string) cache.IndexFunc {
+	return func(obj interface{}) ([]string, error) {
+		configMap, ok := obj.(*v1.ConfigMap)
+		if !ok {
+			return nil, errors.Errorf("unexpected object of type %T in issued certificates cache", obj)
+		}
+
+		value, ok := configMap.Labels[label]
+		if !ok {
+			return nil, nil
+		}
+		return []string{value}, nil
+	}
+}
+
+func toConfigMap(issued IssuedCertificate) *v1.ConfigMap {
+	data := map[string]string{
+		serialNumberKey: serialNumberText(issued.SerialNumber),
+		hashKey:         issued.Hash,
+		commonNameKey:   issued.CommonName,
+		consumerTypeKey: issued.ConsumerType,
+		notAfterKey:     issued.NotAfter.UTC().Format(time.RFC3339),
+	}
+	if issued.Revoked() {
+		data[revokedAtKey] = issued.RevokedAt.UTC().Format(time.RFC3339)
+		data[reasonKey] = strconv.Itoa(int(issued.Reason))
+	}
+
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: configMapName(issued.Hash),
+			Labels: map[string]string{
+				issuedCertificateLabel: "true",
+				serialNumberLabel:      serialNumberText(issued.SerialNumber),
+				revokedLabel:           strconv.FormatBool(issued.Revoked()),
+			},
+		},
+		Data: data,
+	}
+}
+
+func fromConfigMap(configMap *v1.ConfigMap) (IssuedCertificate, error) {
+	serialNumber, ok := new(big.Int).SetString(configMap.Data[serialNumberKey], 16)
+	if !ok {
+		return IssuedCertificate{}, errors.Errorf("invalid serial number in issued certificate %s", configMap.Name)
+	}
+
+	notAfter, err := time.Parse(time.RFC3339, configMap.Data[notAfterKey])
+	if err != nil {
+		return IssuedCertificate{}, errors.Wrapf(err, "invalid expiration time in issued certificate %s", configMap.Name)
+	}
+
+	issued := IssuedCertificate{
+		SerialNumber: serialNumber,
+		Hash:         configMap.Data[hashKey],
+		CommonName:   configMap.Data[commonNameKey],
+		ConsumerType: configMap.Data[consumerTypeKey],
+		NotAfter:     notAfter,
+	}
+
+	if rawRevokedAt, ok := configMap.Data[revokedAtKey]; ok {
+		revokedAt, err := time.Parse(time.RFC3339, rawRevokedAt)
+		if err != nil {
+			return IssuedCertificate{}, errors.Wrapf(err, "invalid revocation time in issued certificate %s", configMap.Name)
+		}
+
+		reason, err := strconv.Atoi(configMap.Data[reasonKey])
+		if err != nil {
+			return IssuedCertificate{}, errors.Wrapf(err, "invalid revocation reason in issued certificate %s", configMap.Name)
+		}
+
+		issued.RevokedAt = &revokedAt
+		issued.Reason = Reason(reason)
+	}
+
+	return issued, nil
+}
+
+func configMapName(hash string) string {
+	return issuedCertificateNamePrefix + strings.ToLower(hash)
+}
+
+func serialNumberText(serialNumber *big.Int) string {
+	return serialNumber.Text(16)
+}
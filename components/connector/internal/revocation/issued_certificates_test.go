@@ -0,0 +1,243 @@
+package revocation_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const namespace = "compass-system"
+
+func TestIssuedCertificatesRepository(t *testing.T) {
+	caCrt, caKey := fixCA(t)
+	notAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	crt := fixClientCertificate(t, caCrt, caKey, 42, notAfter)
+	digest := sha256.Sum256(crt.Raw)
+	hash := hex.EncodeToString(digest[:])
+
+	t.Run("should insert issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		configMaps := fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace)
+		repository := startIssuedCertificatesRepository(t, configMaps, time.Hour)
+
+		// when
+		err := repository.Insert(ctx, crt.Raw, "Application")
+		require.NoError(t, err)
+
+		// then
+		issued := eventuallyGetBySerialNumber(t, repository, big.NewInt(42))
+		assert.Equal(t, revocation.IssuedCertificate{
+			SerialNumber: big.NewInt(42),
+			Hash:         hash,
+			CommonName:   "client",
+			ConsumerType: "Application",
+			NotAfter:     notAfter,
+		}, issued)
+		assert.False(t, issued.Revoked())
+
+		configMap, err := configMaps.Get(ctx, "issued-certificate-"+hash, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "2a", configMap.Labels["connector.compass.kyma-project.io/serial-number"])
+	})
+
+	t.Run("should return error when certificate is invalid", func(t *testing.T) {
+		// given
+		repository := revocation.NewIssuedCertificatesRepository(fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+
+		// when
+		err := repository.Insert(context.Background(), []byte("invalid"), "Application")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing issued certificate")
+	})
+
+	t.Run("should revoke issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		repository := startIssuedCertificatesRepository(t, fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+		require.NoError(t, repository.Insert(ctx, crt.Raw, "Runtime"))
+		revokedAt := time.Now().UTC().Truncate(time.Second)
+
+		// when
+		err := repository.Revoke(ctx, hash, revocation.ReasonKeyCompromise, revokedAt)
+		require.NoError(t, err)
+
+		// then
+		var revoked []revocation.IssuedCertificate
+		require.Eventually(t, func() bool {
+			revoked, err = repository.ListRevoked(ctx)
+			return err == nil && len(revoked) == 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, big.NewInt(42), revoked[0].SerialNumber)
+		assert.Equal(t, revocation.ReasonKeyCompromise, revoked[0].Reason)
+		require.True(t, revoked[0].Revoked())
+		assert.Equal(t, revokedAt, *revoked[0].RevokedAt)
+	})
+
+	t.Run("should keep the original revocation when certificate is revoked again", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		repository := startIssuedCertificatesRepository(t, fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+		require.NoError(t, repository.Insert(ctx, crt.Raw, "Runtime"))
+		revokedAt := time.Now().UTC().Truncate(time.Second)
+		require.NoError(t, repository.Revoke(ctx, hash, revocation.ReasonSuperseded, revokedAt))
+
+		// when
+		err := repository.Revoke(ctx, hash, revocation.ReasonKeyCompromise, revokedAt.Add(time.Minute))
+		require.NoError(t, err)
+
+		// then
+		var issued revocation.IssuedCertificate
+		require.Eventually(t, func() bool {
+			issued, err = repository.GetBySerialNumber(ctx, big.NewInt(42))
+			return err == nil && issued.Revoked()
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, revocation.ReasonSuperseded, issued.Reason)
+		assert.Equal(t, revokedAt, *issued.RevokedAt)
+	})
+
+	t.Run("should return not found error when revoked certificate is not stored", func(t *testing.T) {
+		// given
+		repository := revocation.NewIssuedCertificatesRepository(fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+
+		// when
+		err := repository.Revoke(context.Background(), hash, revocation.ReasonUnspecified, time.Now())
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFound(err))
+	})
+
+	t.Run("should return not found error when serial number is unknown", func(t *testing.T) {
+		// given
+		repository := startIssuedCertificatesRepository(t, fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+
+		// when
+		_, err := repository.GetBySerialNumber(context.Background(), big.NewInt(42))
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFound(err))
+	})
+
+	t.Run("should delete expired certificates", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		repository := startIssuedCertificatesRepository(t, fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace), time.Hour)
+		expired := fixClientCertificate(t, caCrt, caKey, 43, time.Now().Add(-time.Minute))
+		require.NoError(t, repository.Insert(ctx, crt.Raw, "Runtime"))
+		require.NoError(t, repository.Insert(ctx, expired.Raw, "Runtime"))
+		eventuallyGetBySerialNumber(t, repository, big.NewInt(42))
+		eventuallyGetBySerialNumber(t, repository, big.NewInt(43))
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, time.Now())
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, 1, deleted)
+		require.Eventually(t, func() bool {
+			_, err := repository.GetBySerialNumber(ctx, big.NewInt(43))
+			return apperrors.IsNotFound(err)
+		}, time.Second, 10*time.Millisecond)
+		_, err = repository.GetBySerialNumber(ctx, big.NewInt(42))
+		require.NoError(t, err)
+	})
+
+	t.Run("should delete expired certificates every cleanup interval", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		configMaps := fake.NewSimpleClientset().CoreV1().ConfigMaps(namespace)
+		expired := fixClientCertificate(t, caCrt, caKey, 43, time.Now().Add(-time.Minute))
+		require.NoError(t, revocation.NewIssuedCertificatesRepository(configMaps, time.Hour).Insert(ctx, expired.Raw, "Runtime"))
+		digest := sha256.Sum256(expired.Raw)
+
+		// when
+		startIssuedCertificatesRepository(t, configMaps, 10*time.Millisecond)
+
+		// then
+		require.Eventually(t, func() bool {
+			_, err := configMaps.Get(ctx, "issued-certificate-"+hex.EncodeToString(digest[:]), metav1.GetOptions{})
+			return k8serrors.IsNotFound(err)
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("should return error when failed to store issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		configMapManager := &mocks.IssuedCertificatesManager{}
+		configMapManager.On("Create", ctx, mock.AnythingOfType("*v1.ConfigMap"), metav1.CreateOptions{}).Return(nil, errors.New("some error"))
+		repository := revocation.NewIssuedCertificatesRepository(configMapManager, time.Hour)
+
+		// when
+		err := repository.Insert(ctx, crt.Raw, "Runtime")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "some error")
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when issued certificates could not be listed before the context is cancelled", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		listed := make(chan struct{})
+		var once sync.Once
+		configMapManager := &mocks.IssuedCertificatesManager{}
+		configMapManager.On("List", mock.Anything, mock.MatchedBy(func(opts metav1.ListOptions) bool {
+			return opts.LabelSelector == "connector.compass.kyma-project.io/issued-certificate=true"
+		})).Return((*v1.ConfigMapList)(nil), errors.New("some error")).Run(func(mock.Arguments) { once.Do(func() { close(listed) }) })
+		repository := startIssuedCertificatesRepository(t, configMapManager, time.Hour)
+
+		// when
+		_, err := repository.ListRevoked(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "issued certificates cache has not been synced")
+		<-listed
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+// startIssuedCertificatesRepository runs the repository until the test finishes
+func startIssuedCertificatesRepository(t *testing.T, configMapManager revocation.IssuedCertificatesManager, cleanupInterval time.Duration) revocation.IssuedCertificatesRepository {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	repository := revocation.NewIssuedCertificatesRepository(configMapManager, cleanupInterval)
+	go repository.Run(ctx)
+
+	return repository
+}
+
+func eventuallyGetBySerialNumber(t *testing.T, repository revocation.IssuedCertificatesRepository, serialNumber *big.Int) revocation.IssuedCertificate {
+	var issued revocation.IssuedCertificate
+	require.Eventually(t, func() bool {
+		var err error
+		issued, err = repository.GetBySerialNumber(context.Background(), serialNumber)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	return issued
+}
@@ -0,0 +1,170 @@
+package revocation
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	ocspRequestContentType  = "application/ocsp-request"
+	ocspResponseContentType = "application/ocsp-response"
+
+	maxOCSPRequestSize = 10 * 1024
+)
+
+type OCSPResponder interface {
+	ServeHTTP(writer http.ResponseWriter, request *http.Request)
+}
+
+type ocspResponder struct {
+	repository IssuedCertificatesRepository
+	caProvider CAProvider
+	validity   time.Duration
+}
+
+// NewOCSPResponder creates an OCSP responder, as described in RFC 6960, for the certificates issued by the Connector.
+// The responses are signed directly by the CA and may be cached by the clients for the given validity time.
+func NewOCSPResponder(repository IssuedCertificatesRepository, caProvider CAProvider, validity time.Duration) OCSPResponder {
+	return &ocspResponder{
+		repository: repository,
+		caProvider: caProvider,
+		validity:   validity,
+	}
+}
+
+// ServeHTTP handles OCSP requests sent in the body of a POST request or appended base64 encoded to the path of a GET request
+func (r *ocspResponder) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	rawRequest, err := readOCSPRequest(request)
+	if err != nil {
+		log.C(ctx).WithError(err).Warn("Failed to read OCSP request")
+		writeOCSPResponse(ctx, writer, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	ocspRequest, err := ocsp.ParseRequest(rawRequest)
+	if err != nil {
+		log.C(ctx).WithError(err).Warn("Failed to parse OCSP request")
+		writeOCSPResponse(ctx, writer, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	caCrt, caKey, appErr := r.caProvider.GetCA()
+	if appErr != nil {
+		log.C(ctx).WithError(appErr).Error("Failed to load CA for OCSP response")
+		writeOCSPResponse(ctx, writer, ocsp.TryLaterErrorResponse)
+		return
+	}
+
+	if matches, err := isIssuedBy(ocspRequest, caCrt); err != nil || !matches {
+		log.C(ctx).Infof("OCSP request for certificate with serial number %s issued by unknown CA", serialNumberText(ocspRequest.SerialNumber))
+		writeOCSPResponse(ctx, writer, ocsp.UnauthorizedErrorResponse)
+		return
+	}
+
+	template, err := r.responseTemplate(ctx, ocspRequest)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to get status of certificate with serial number %s", serialNumberText(ocspRequest.SerialNumber))
+		writeOCSPResponse(ctx, writer, ocsp.InternalErrorErrorResponse)
+		return
+	}
+
+	response, err := ocsp.CreateResponse(caCrt, caCrt, template, caKey)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Failed to sign OCSP response")
+		writeOCSPResponse(ctx, writer, ocsp.InternalErrorErrorResponse)
+		return
+	}
+
+	writeOCSPResponse(ctx, writer, response)
+}
+
+func (r *ocspResponder) responseTemplate(ctx context.Context, ocspRequest *ocsp.Request) (ocsp.Response, error) {
+	now := time.Now()
+	template := ocsp.Response{
+		Status:       ocsp.Good,
+		SerialNumber: ocspRequest.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(r.validity),
+		IssuerHash:   ocspRequest.HashAlgorithm,
+	}
+
+	issued, err := r.repository.GetBySerialNumber(ctx, ocspRequest.SerialNumber)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			template.Status = ocsp.Unknown
+			return template, nil
+		}
+		return ocsp.Response{}, err
+	}
+
+	if issued.Revoked() {
+		template.Status = ocsp.Revoked
+		template.RevokedAt = *issued.RevokedAt
+		template.RevocationReason = int(issued.Reason)
+	}
+
+	return template, nil
+}
+
+func readOCSPRequest(request *http.Request) ([]byte, error) {
+	switch request.Method {
+	case http.MethodGet:
+		// The handler is mounted with the endpoint prefix stripped, so the path holds only the URL decoded request, as described in RFC 6960, Appendix A.1
+		return base64.StdEncoding.DecodeString(strings.TrimPrefix(request.URL.Path, "/"))
+	case http.MethodPost:
+		if contentType := request.Header.Get("Content-Type"); contentType != ocspRequestContentType {
+			return nil, errors.Errorf("unsupported content type %q", contentType)
+		}
+		return ioutil.ReadAll(io.LimitReader(request.Body, maxOCSPRequestSize))
+	default:
+		return nil, errors.Errorf("unsupported method %s", request.Method)
+	}
+}
+
+// isIssuedBy checks whether the certificate in the OCSP request is issued by the CA, by comparing the hashes of its name and public key
+func isIssuedBy(ocspRequest *ocsp.Request, caCrt *x509.Certificate) (bool, error) {
+	if !ocspRequest.HashAlgorithm.Available() {
+		return false, errors.Errorf("unsupported hash algorithm %v", ocspRequest.HashAlgorithm)
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCrt.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false, errors.Wrap(err, "while parsing CA public key")
+	}
+
+	hash := ocspRequest.HashAlgorithm.New()
+	hash.Write(caCrt.RawSubject)
+	nameHash := hash.Sum(nil)
+
+	hash.Reset()
+	hash.Write(publicKeyInfo.PublicKey.RightAlign())
+	keyHash := hash.Sum(nil)
+
+	return bytes.Equal(nameHash, ocspRequest.IssuerNameHash) && bytes.Equal(keyHash, ocspRequest.IssuerKeyHash), nil
+}
+
+func writeOCSPResponse(ctx context.Context, writer http.ResponseWriter, response []byte) {
+	writer.Header().Set("Content-Type", ocspResponseContentType)
+	if _, err := writer.Write(response); err != nil {
+		log.C(ctx).WithError(err).Error("Failed to write OCSP response")
+	}
+}
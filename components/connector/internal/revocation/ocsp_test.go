@@ -0,0 +1,164 @@
+package revocation_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestOCSPResponder(t *testing.T) {
+	caCrt, caKey := fixCA(t)
+	crt := fixClientCertificate(t, caCrt, caKey, 42, time.Now().Add(time.Hour))
+	revokedAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+	validity := 10 * time.Minute
+
+	rawRequest, err := ocsp.CreateRequest(crt, caCrt, nil)
+	require.NoError(t, err)
+
+	postRequest := func() *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(rawRequest))
+		request.Header.Set("Content-Type", "application/ocsp-request")
+		return request
+	}
+
+	testCases := []struct {
+		Name               string
+		Request            func() *http.Request
+		IssuedCert         revocation.IssuedCertificate
+		RepositoryErr      error
+		ExpectedStatus     int
+		ExpectedReason     int
+		ExpectedRevocation bool
+	}{
+		{
+			Name:           "should respond with good status",
+			Request:        postRequest,
+			IssuedCert:     revocation.IssuedCertificate{SerialNumber: big.NewInt(42)},
+			ExpectedStatus: ocsp.Good,
+		},
+		{
+			Name:               "should respond with revoked status",
+			Request:            postRequest,
+			IssuedCert:         revocation.IssuedCertificate{SerialNumber: big.NewInt(42), RevokedAt: &revokedAt, Reason: revocation.ReasonCessationOfOperation},
+			ExpectedStatus:     ocsp.Revoked,
+			ExpectedReason:     ocsp.CessationOfOperation,
+			ExpectedRevocation: true,
+		},
+		{
+			Name:           "should respond with unknown status when certificate is not stored",
+			Request:        postRequest,
+			RepositoryErr:  apperrors.NotFound("not found"),
+			ExpectedStatus: ocsp.Unknown,
+		},
+		{
+			Name: "should handle GET request",
+			Request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/"+url.PathEscape(base64.StdEncoding.EncodeToString(rawRequest)), nil)
+			},
+			IssuedCert:     revocation.IssuedCertificate{SerialNumber: big.NewInt(42)},
+			ExpectedStatus: ocsp.Good,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			repository := &mocks.IssuedCertificatesRepository{}
+			repository.On("GetBySerialNumber", mock.Anything, big.NewInt(42)).Return(testCase.IssuedCert, testCase.RepositoryErr)
+			caProvider := &mocks.CAProvider{}
+			caProvider.On("GetCA").Return(caCrt, caKey, nil)
+
+			responder := revocation.NewOCSPResponder(repository, caProvider, validity)
+			recorder := httptest.NewRecorder()
+
+			// when
+			responder.ServeHTTP(recorder, testCase.Request())
+
+			// then
+			require.Equal(t, http.StatusOK, recorder.Code)
+			assert.Equal(t, "application/ocsp-response", recorder.Header().Get("Content-Type"))
+
+			response, err := ocsp.ParseResponseForCert(recorder.Body.Bytes(), crt, caCrt)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedStatus, response.Status)
+			assert.Equal(t, big.NewInt(42), response.SerialNumber)
+			assert.WithinDuration(t, response.ThisUpdate.Add(validity), response.NextUpdate, time.Second)
+			if testCase.ExpectedRevocation {
+				assert.True(t, revokedAt.Equal(response.RevokedAt))
+				assert.Equal(t, testCase.ExpectedReason, response.RevocationReason)
+			}
+
+			mock.AssertExpectationsForObjects(t, repository, caProvider)
+		})
+	}
+
+	t.Run("should respond with unauthorized error when certificate is issued by another CA", func(t *testing.T) {
+		// given
+		otherCACrt, otherCAKey := fixCA(t)
+		otherCrt := fixClientCertificate(t, otherCACrt, otherCAKey, 42, time.Now().Add(time.Hour))
+		otherRequest, err := ocsp.CreateRequest(otherCrt, otherCACrt, nil)
+		require.NoError(t, err)
+
+		caProvider := &mocks.CAProvider{}
+		caProvider.On("GetCA").Return(caCrt, caKey, nil)
+
+		responder := revocation.NewOCSPResponder(&mocks.IssuedCertificatesRepository{}, caProvider, validity)
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(otherRequest))
+		request.Header.Set("Content-Type", "application/ocsp-request")
+		recorder := httptest.NewRecorder()
+
+		// when
+		responder.ServeHTTP(recorder, request)
+
+		// then
+		assert.Equal(t, ocsp.UnauthorizedErrorResponse, recorder.Body.Bytes())
+		caProvider.AssertExpectations(t)
+	})
+
+	t.Run("should respond with malformed request error when request is invalid", func(t *testing.T) {
+		// given
+		responder := revocation.NewOCSPResponder(&mocks.IssuedCertificatesRepository{}, &mocks.CAProvider{}, validity)
+		request := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte("invalid")))
+		request.Header.Set("Content-Type", "application/ocsp-request")
+		recorder := httptest.NewRecorder()
+
+		// when
+		responder.ServeHTTP(recorder, request)
+
+		// then
+		assert.Equal(t, ocsp.MalformedRequestErrorResponse, recorder.Body.Bytes())
+	})
+
+	t.Run("should respond with internal error when failed to get certificate status", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("GetBySerialNumber", mock.Anything, big.NewInt(42)).Return(revocation.IssuedCertificate{}, errors.New("some error"))
+		caProvider := &mocks.CAProvider{}
+		caProvider.On("GetCA").Return(caCrt, caKey, nil)
+
+		responder := revocation.NewOCSPResponder(repository, caProvider, validity)
+		recorder := httptest.NewRecorder()
+
+		// when
+		responder.ServeHTTP(recorder, postRequest().WithContext(context.Background()))
+
+		// then
+		assert.Equal(t, ocsp.InternalErrorErrorResponse, recorder.Body.Bytes())
+		mock.AssertExpectationsForObjects(t, repository, caProvider)
+	})
+}
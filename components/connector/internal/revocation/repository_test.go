@@ -1,4 +1,4 @@
-package revocation
+package revocation_test
 
 import (
 	"context"
@@ -7,6 +7,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -37,7 +38,7 @@ func TestRevokedCertificatesRepository(t *testing.T) {
 				someHash: someHash,
 			}}, nil)
 
-		repository := NewRepository(configListManagerMock, configMapName)
+		repository := revocation.NewRepository(configListManagerMock, configMapName)
 
 		// when
 		err := repository.Insert(ctx, someHash)
@@ -64,7 +65,7 @@ func TestRevokedCertificatesRepository(t *testing.T) {
 				someHash: someHash,
 			}}, metav1.UpdateOptions{}).Return(nil, errors.New("some error"))
 
-		repository := NewRepository(configListManagerMock, configMapName)
+		repository := revocation.NewRepository(configListManagerMock, configMapName)
 
 		// when
 		err := repository.Insert(ctx, someHash)
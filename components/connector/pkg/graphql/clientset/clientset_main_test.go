@@ -2,7 +2,9 @@ package clientset
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -14,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/config"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	gcliMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/automock"
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
@@ -34,12 +37,18 @@ const (
 	testSecretName    = "test-secret"
 	testConfigMapName = "test-secret"
 	oneTimeTokenURL   = "http://director.com"
-	clientID          = "abcd-efgh"
+
+	issuedCertificatesNamespace = "default"
+	clientID                    = "abcd-efgh"
 )
 
 var (
-	externalAPIUrl string
-	k8sClientSet   kubernetes.Interface
+	externalAPIUrl        string
+	crlURL                string
+	ocspURL               string
+	k8sClientSet          kubernetes.Interface
+	issuedCertsRepository revocation.IssuedCertificatesRepository
+	crlPublisher          revocation.CRLPublisher
 )
 
 func TestMain(m *testing.M) {
@@ -49,6 +58,8 @@ func TestMain(m *testing.M) {
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
 	err = os.Setenv("APP_ONE_TIME_TOKEN_URL", oneTimeTokenURL)
 	exitOnError(err, "Error setting APP_ONE_TIME_TOKEN_URL env")
+	err = os.Setenv("APP_ISSUED_CERTIFICATES_NAMESPACE", issuedCertificatesNamespace)
+	exitOnError(err, "Error setting APP_ISSUED_CERTIFICATES_NAMESPACE env")
 
 	cfg := config.Config{}
 	err = envconfig.InitWithPrefix(&cfg, "APP")
@@ -77,20 +88,28 @@ func TestMain(m *testing.M) {
 	directorGCLI := &gcliMocks.GraphQLClient{}
 	directorGCLI.On("Run", mock.Anything, mock.Anything, mock.Anything).
 		Run(GenerateTestToken(tokens.NewTokenResponse("abcd"))).Return(nil).Twice()
-	internalComponents, certsLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	internalComponents, certsLoader, err := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	exitOnError(err, "Error initializing internal components")
 
 	go certsLoader.Run(context.TODO())
+	go internalComponents.IssuedCertsRepository.Run(context.TODO())
 
 	externalAPIUrl = fmt.Sprintf("https://%s%s", cfg.ExternalAddress, cfg.APIEndpoint)
+	crlURL = fmt.Sprintf("https://%s%s", cfg.ExternalAddress, cfg.CRL.Endpoint)
+	ocspURL = fmt.Sprintf("https://%s%s", cfg.ExternalAddress, cfg.OCSP.Endpoint)
+	issuedCertsRepository = internalComponents.IssuedCertsRepository
+	crlPublisher = internalComponents.CRLPublisher
 
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.KeyAlgorithms,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.IssuedCertsRepository)
 
 	authContextTestMiddleware := func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -113,13 +132,14 @@ func TestMain(m *testing.M) {
 
 			clientId := r.TLS.PeerCertificates[0].Subject.CommonName
 			r = r.WithContext(authentication.PutIntoContext(r.Context(), authentication.ClientIdFromCertificateKey, clientId))
-			r = r.WithContext(authentication.PutIntoContext(r.Context(), authentication.ClientCertificateHashKey, "hash"))
+			certificateHash := sha256.Sum256(r.TLS.PeerCertificates[0].Raw)
+			r = r.WithContext(authentication.PutIntoContext(r.Context(), authentication.ClientCertificateHashKey, hex.EncodeToString(certificateHash[:])))
 
 			handler.ServeHTTP(w, r)
 		})
 	}
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, authContextTestMiddleware)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, internalComponents.CRLPublisher, internalComponents.OCSPResponder, authContextTestMiddleware)
 	exitOnError(err, "Error configuring external graphQL handler")
 
 	externalGqlServer.TLSConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
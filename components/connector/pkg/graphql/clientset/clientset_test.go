@@ -1,8 +1,16 @@
 package clientset
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -61,4 +69,54 @@ func Test_Clientset(t *testing.T) {
 	revocationCM, err := k8sClientSet.CoreV1().ConfigMaps("default").Get(ctx, testConfigMapName, v1.GetOptions{})
 	require.NoError(t, err)
 	assert.Len(t, revocationCM.Data, 1)
+
+	revokedCMs, err := k8sClientSet.CoreV1().ConfigMaps(issuedCertificatesNamespace).List(ctx, v1.ListOptions{LabelSelector: "connector.compass.kyma-project.io/revoked=true"})
+	require.NoError(t, err)
+	assert.Len(t, revokedCMs.Items, 1)
+
+	// given
+	clientCrt, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.NoError(t, err)
+	caCrt, err := x509.ParseCertificate(certificate.Certificate[1])
+	require.NoError(t, err)
+
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true, Certificates: []tls.Certificate{certificate}}}}
+
+	require.Eventually(t, func() bool {
+		revoked, err := issuedCertsRepository.ListRevoked(ctx)
+		return err == nil && len(revoked) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// when
+	require.NoError(t, crlPublisher.Refresh(ctx))
+	crlResponse, err := httpClient.Get(crlURL)
+	require.NoError(t, err)
+	defer crlResponse.Body.Close()
+
+	// then
+	require.Equal(t, http.StatusOK, crlResponse.StatusCode)
+	rawCRL, err := ioutil.ReadAll(crlResponse.Body)
+	require.NoError(t, err)
+	crl, err := x509.ParseDERCRL(rawCRL)
+	require.NoError(t, err)
+	require.NoError(t, caCrt.CheckCRLSignature(crl))
+	require.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+	assert.Equal(t, clientCrt.SerialNumber, crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+
+	// given
+	ocspRequest, err := ocsp.CreateRequest(clientCrt, caCrt, nil)
+	require.NoError(t, err)
+
+	// when
+	ocspHTTPResponse, err := httpClient.Post(ocspURL, "application/ocsp-request", bytes.NewReader(ocspRequest))
+	require.NoError(t, err)
+	defer ocspHTTPResponse.Body.Close()
+
+	// then
+	require.Equal(t, http.StatusOK, ocspHTTPResponse.StatusCode)
+	rawOCSPResponse, err := ioutil.ReadAll(ocspHTTPResponse.Body)
+	require.NoError(t, err)
+	ocspResponse, err := ocsp.ParseResponseForCert(rawOCSPResponse, clientCrt, caCrt)
+	require.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, ocspResponse.Status)
 }
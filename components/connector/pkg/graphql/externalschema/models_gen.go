@@ -2,9 +2,16 @@
 
 package externalschema
 
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
 type CertificateSigningRequestInfo struct {
-	Subject      string `json:"subject"`
-	KeyAlgorithm string `json:"keyAlgorithm"`
+	Subject       string   `json:"subject"`
+	KeyAlgorithm  string   `json:"keyAlgorithm"`
+	KeyAlgorithms []string `json:"keyAlgorithms"`
 }
 
 type CertificationResult struct {
@@ -27,3 +34,50 @@ type ManagementPlaneInfo struct {
 type Token struct {
 	Token string `json:"token"`
 }
+
+type RevocationReason string
+
+const (
+	RevocationReasonUnspecified          RevocationReason = "UNSPECIFIED"
+	RevocationReasonKeyCompromise        RevocationReason = "KEY_COMPROMISE"
+	RevocationReasonAffiliationChanged   RevocationReason = "AFFILIATION_CHANGED"
+	RevocationReasonSuperseded           RevocationReason = "SUPERSEDED"
+	RevocationReasonCessationOfOperation RevocationReason = "CESSATION_OF_OPERATION"
+)
+
+var AllRevocationReason = []RevocationReason{
+	RevocationReasonUnspecified,
+	RevocationReasonKeyCompromise,
+	RevocationReasonAffiliationChanged,
+	RevocationReasonSuperseded,
+	RevocationReasonCessationOfOperation,
+}
+
+func (e RevocationReason) IsValid() bool {
+	switch e {
+	case RevocationReasonUnspecified, RevocationReasonKeyCompromise, RevocationReasonAffiliationChanged, RevocationReasonSuperseded, RevocationReasonCessationOfOperation:
+		return true
+	}
+	return false
+}
+
+func (e RevocationReason) String() string {
+	return string(e)
+}
+
+func (e *RevocationReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RevocationReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RevocationReason", str)
+	}
+	return nil
+}
+
+func (e RevocationReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -43,8 +43,9 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	CertificateSigningRequestInfo struct {
-		KeyAlgorithm func(childComplexity int) int
-		Subject      func(childComplexity int) int
+		KeyAlgorithm  func(childComplexity int) int
+		KeyAlgorithms func(childComplexity int) int
+		Subject       func(childComplexity int) int
 	}
 
 	CertificationResult struct {
@@ -65,7 +66,7 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
-		RevokeCertificate             func(childComplexity int) int
+		RevokeCertificate             func(childComplexity int, reason *RevocationReason) int
 		SignCertificateSigningRequest func(childComplexity int, csr string) int
 	}
 
@@ -80,7 +81,7 @@ type ComplexityRoot struct {
 
 type MutationResolver interface {
 	SignCertificateSigningRequest(ctx context.Context, csr string) (*CertificationResult, error)
-	RevokeCertificate(ctx context.Context) (bool, error)
+	RevokeCertificate(ctx context.Context, reason *RevocationReason) (bool, error)
 }
 type QueryResolver interface {
 	Configuration(ctx context.Context) (*Configuration, error)
@@ -108,6 +109,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertificateSigningRequestInfo.KeyAlgorithm(childComplexity), true
 
+	case "CertificateSigningRequestInfo.keyAlgorithms":
+		if e.complexity.CertificateSigningRequestInfo.KeyAlgorithms == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.KeyAlgorithms(childComplexity), true
+
 	case "CertificateSigningRequestInfo.subject":
 		if e.complexity.CertificateSigningRequestInfo.Subject == nil {
 			break
@@ -176,7 +184,12 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Mutation.RevokeCertificate(childComplexity), true
+		args, err := ec.field_Mutation_revokeCertificate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeCertificate(childComplexity, args["reason"].(*RevocationReason)), true
 
 	case "Mutation.signCertificateSigningRequest":
 		if e.complexity.Mutation.SignCertificateSigningRequest == nil {
@@ -296,7 +309,17 @@ type Configuration {
 # CSRInfo
 type CertificateSigningRequestInfo {
     subject: String! # eg.: "OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US,CN={ID}"
-    keyAlgorithm: String! # eg.: rsa2048
+    keyAlgorithm: String! # eg.: rsa2048, the preferred algorithm
+    keyAlgorithms: [String!]! # eg.: ["rsa2048", "ecdsap256"], all algorithms accepted in the signing request
+}
+
+# RevocationReason is a subset of the revocation reasons defined in RFC 5280, which can be stated by the certificate owner
+enum RevocationReason {
+    UNSPECIFIED
+    KEY_COMPROMISE
+    AFFILIATION_CHANGED
+    SUPERSEDED
+    CESSATION_OF_OPERATION
 }
 
 type Query {
@@ -310,8 +333,8 @@ type Mutation {
     # Client-Certificates
     signCertificateSigningRequest(csr: String!): CertificationResult!
 
-    """revokes certificate with which the request was issued"""
-    revokeCertificate: Boolean!
+    """revokes certificate with which the request was issued, the reason is published in the CRL and OCSP responses"""
+    revokeCertificate(reason: RevocationReason = UNSPECIFIED): Boolean!
 }
 `, BuiltIn: false},
 }
@@ -321,6 +344,20 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
+func (ec *executionContext) field_Mutation_revokeCertificate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *RevocationReason
+	if tmp, ok := rawArgs["reason"]; ok {
+		arg0, err = ec.unmarshalORevocationReason2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["reason"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Mutation_signCertificateSigningRequest_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -453,6 +490,40 @@ func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithms(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.KeyAlgorithms, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -766,9 +837,16 @@ func (ec *executionContext) _Mutation_revokeCertificate(ctx context.Context, fie
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeCertificate_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RevokeCertificate(rctx)
+		return ec.resolvers.Mutation().RevokeCertificate(rctx, args["reason"].(*RevocationReason))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2006,6 +2084,11 @@ func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "keyAlgorithms":
+			out.Values[i] = ec._CertificateSigningRequestInfo_keyAlgorithms(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2516,6 +2599,35 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -2787,6 +2899,30 @@ func (ec *executionContext) marshalOManagementPlaneInfo2ᚖgithubᚗcomᚋkyma
 	return ec._ManagementPlaneInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORevocationReason2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx context.Context, v interface{}) (RevocationReason, error) {
+	var res RevocationReason
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalORevocationReason2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx context.Context, sel ast.SelectionSet, v RevocationReason) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalORevocationReason2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx context.Context, v interface{}) (*RevocationReason, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalORevocationReason2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalORevocationReason2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐRevocationReason(ctx context.Context, sel ast.SelectionSet, v *RevocationReason) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
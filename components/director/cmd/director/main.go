@@ -17,7 +17,10 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/appmetadatavalidation"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/subscription"
 
 	kube "github.com/kyma-incubator/compass/components/director/pkg/kubernetes"
@@ -53,6 +56,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/schema"
@@ -70,6 +74,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/statusupdate"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	pkgadapters "github.com/kyma-incubator/compass/components/director/pkg/adapters"
+	pkgauth "github.com/kyma-incubator/compass/components/director/pkg/auth"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
@@ -82,12 +87,14 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
 	panicrecovery "github.com/kyma-incubator/compass/components/director/pkg/panic_recovery"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/scenario"
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/client"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -98,6 +105,13 @@ import (
 
 const envPrefix = "APP"
 
+const (
+	// kubernetesOperationsScheduler schedules the async operations as Operation CRs processed by the operations-controller
+	kubernetesOperationsScheduler = "kubernetes"
+	// databaseOperationsScheduler schedules the async operations in the database and processes them in the director
+	databaseOperationsScheduler = "database"
+)
+
 type config struct {
 	Address string `envconfig:"default=127.0.0.1:3000"`
 
@@ -140,6 +154,11 @@ type config struct {
 
 	DisableAsyncMode bool `envconfig:"default=false"`
 
+	OperationsScheduler string `envconfig:"default=kubernetes"`
+	Operations          postgres.Config
+
+	WebhookClientCertificates webhookclient.CertificatesConfig
+
 	HealthConfig healthz.Config `envconfig:"APP_HEALTH_CONFIG_INDICATORS"`
 
 	ReadyConfig healthz.ReadyConfig
@@ -155,6 +174,12 @@ type config struct {
 
 	TenantOnDemandConfig tenant.FetchOnDemandAPIConfig
 
+	ORDResyncConfig ordresync.Config
+
+	BundleInstanceAuth bundleinstanceauth.Config
+
+	ChangeEvents changeevent.Config
+
 	RetryConfig retry.Config
 
 	SkipSSLValidation bool `envconfig:"default=false,APP_HTTP_CLIENT_SKIP_SSL_VALIDATION"`
@@ -226,6 +251,11 @@ func main() {
 
 	appRepo := applicationRepo()
 
+	historyRecorder := operationHistoryRecorder(appRepo)
+	scheduler, err := buildScheduler(ctx, cfg, historyRecorder)
+	exitOnError(err, "Error while creating operations scheduler")
+	formationAssignmentScheduler := buildFormationAssignmentScheduler(cfg, historyRecorder)
+
 	adminURL, err := url.Parse(cfg.OAuth20.URL)
 	exitOnError(err, "Error while parsing Hydra URL")
 
@@ -235,6 +265,14 @@ func main() {
 	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache)
 	retryHTTPExecutor := retry.NewHTTPExecutor(&cfg.RetryConfig)
 
+	changeEventBroker := changeevent.NewBroker(cfg.ChangeEvents.SubscriberBufferSize)
+	changeEventListener := changeevent.NewListener(cfg.ChangeEvents, cfg.Database.GetConnString(), changeEventBroker)
+	go func() {
+		if err := changeEventListener.Start(ctx); err != nil {
+			logger.WithError(err).Errorf("An error has occurred while listening for change events: %v", err)
+		}
+	}()
+
 	rootResolver, err := domain.NewRootResolver(
 		&normalizer.DefaultNormalizator{},
 		transact,
@@ -254,13 +292,17 @@ func main() {
 		accessStrategyExecutorProvider,
 		cfg.SubscriptionConfig,
 		cfg.TenantOnDemandConfig,
+		cfg.ORDResyncConfig,
+		cfg.BundleInstanceAuth,
+		changeEventBroker,
+		formationAssignmentScheduler,
 	)
 	exitOnError(err, "Failed to initialize root resolver")
 
 	gqlCfg := graphql.Config{
 		Resolvers: rootResolver,
 		Directives: graphql.DirectiveRoot{
-			Async:                 getAsyncDirective(ctx, cfg, transact, appRepo),
+			Async:                 getAsyncDirective(transact, appRepo, scheduler),
 			HasScenario:           scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), bundleRepo(), bundleInstanceAuthRepo()).HasScenario,
 			HasScopes:             scope.NewDirective(cfgProvider, &scope.HasScopesErrorProvider{}).VerifyScopes,
 			Sanitize:              scope.NewDirective(cfgProvider, &scope.SanitizeErrorProvider{}).VerifyScopes,
@@ -270,7 +312,7 @@ func main() {
 	}
 
 	executableSchema := graphql.NewExecutableSchema(gqlCfg)
-	claimsValidator := claims.NewValidator(transact, runtimeSvc(cfg), runtimeCtxSvc(cfg), intSystemSvc(), cfg.Features.SubscriptionProviderLabelKey, cfg.Features.ConsumerSubaccountLabelKey, cfg.Features.TokenPrefix)
+	claimsValidator := claims.NewValidator(transact, runtimeSvc(cfg, formationAssignmentScheduler), runtimeCtxSvc(cfg, formationAssignmentScheduler), intSystemSvc(), cfg.Features.SubscriptionProviderLabelKey, cfg.Features.ConsumerSubaccountLabelKey, cfg.Features.TokenPrefix)
 
 	logger.Infof("Registering GraphQL endpoint on %s...", cfg.APIEndpoint)
 	authMiddleware := mp_authenticator.New(httpClient, cfg.JWKSEndpoint, cfg.AllowJWTSigningNone, cfg.ClientIDHTTPHeaderKey, claimsValidator)
@@ -311,6 +353,15 @@ func main() {
 	gqlAPIRouter.Use(dataloader.HandlerFetchRequestEventDef(rootResolver.FetchRequestEventDefDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 	gqlAPIRouter.Use(dataloader.HandlerFetchRequestDocument(rootResolver.FetchRequestDocumentDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 	gqlAPIRouter.Use(dataloader.HandlerRuntimeContext(rootResolver.RuntimeContextsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerFormationApplication(rootResolver.FormationApplicationsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerFormationRuntime(rootResolver.FormationRuntimesDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerFormationRuntimeContext(rootResolver.FormationRuntimeContextsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerFormationTenantAssignment(rootResolver.FormationTenantAssignmentsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerFormationStatus(rootResolver.FormationStatusDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerPackage(rootResolver.PackagesDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerProduct(rootResolver.ProductsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerVendor(rootResolver.VendorsDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerTombstone(rootResolver.TombstonesDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 
 	operationMiddleware := operation.NewMiddleware(cfg.AppURL + cfg.LastOperationPath)
 
@@ -332,18 +383,33 @@ func main() {
 	operationsAPIRouter.Use(authMiddleware.Handler())
 	operationsAPIRouter.HandleFunc("/{resource_type}/{resource_id}", operationHandler.ServeHTTP)
 
+	formationAssignmentSvc := formationAssignmentService(formationAssignmentScheduler)
 	operationUpdaterHandler := operation.NewUpdateOperationHandler(transact, map[resource.Type]operation.ResourceUpdaterFunc{
-		resource.Application: appUpdaterFunc(appRepo),
+		resource.Application:         appUpdaterFunc(appRepo),
+		resource.FormationAssignment: formationAssignmentUpdaterFunc(formationAssignmentSvc),
 	}, map[resource.Type]operation.ResourceDeleterFunc{
 		resource.Application: func(ctx context.Context, id string) error {
 			return appRepo.DeleteGlobal(ctx, id)
 		},
-	})
+		resource.FormationAssignment: formationAssignmentSvc.DeleteGlobal,
+	}, historyRecorder)
+	operationProgressHandler := operation.NewUpdateOperationProgressHandler(transact, historyRecorder)
 
 	internalRouter := mux.NewRouter()
 	internalRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(), header.AttachHeadersToContext())
 	internalOperationsAPIRouter := internalRouter.PathPrefix(cfg.OperationPath).Subrouter()
 	internalOperationsAPIRouter.HandleFunc("", operationUpdaterHandler.ServeHTTP)
+	internalOperationsAPIRouter.HandleFunc("/progress", operationProgressHandler.ServeHTTP)
+
+	// The formation assignment notifications are always scheduled in the database, so the worker runs regardless of the operations scheduler
+	if !cfg.DisableAsyncMode {
+		go operationsWorker(ctx, cfg, transact, appRepo, certCache, k8sClient, operationUpdaterHandler, operationProgressHandler).Start(ctx)
+	}
+
+	if cfg.BundleInstanceAuth.ExpiryCheckPeriod != 0 {
+		logger.Infof("BundleInstanceAuth credentials expiry enabled. Check period: %v", cfg.BundleInstanceAuth.ExpiryCheckPeriod)
+		go runBundleInstanceAuthExpiry(ctx, cfg, transact)
+	}
 
 	logger.Infof("Registering readiness endpoint...")
 	schemaRepo := schema.NewRepository()
@@ -483,7 +549,7 @@ func createServer(ctx context.Context, address string, handler http.Handler, nam
 
 	srv := &http.Server{
 		Addr:              address,
-		Handler:           handlerWithTimeout,
+		Handler:           timeouthandler.WithWebsocketUpgradeBypass(handlerWithTimeout, handler),
 		ReadHeaderTimeout: timeout,
 	}
 
@@ -510,6 +576,28 @@ func bundleInstanceAuthRepo() bundleinstanceauth.Repository {
 	return bundleinstanceauth.NewRepository(bundleinstanceauth.NewConverter(authConverter))
 }
 
+func runBundleInstanceAuthExpiry(ctx context.Context, cfg config, transact persistence.Transactioner) {
+	svc := bundleinstanceauth.NewService(bundleInstanceAuthRepo(), uid.NewService(), cfg.BundleInstanceAuth.RotationGracePeriod)
+
+	executor.NewPeriodic(cfg.BundleInstanceAuth.ExpiryCheckPeriod, func(ctx context.Context) {
+		tx, err := transact.Begin()
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while opening transaction for BundleInstanceAuth credentials expiry: %v", err)
+			return
+		}
+		defer transact.RollbackUnlessCommitted(ctx, tx)
+
+		if err = svc.ExpireCredentials(persistence.SaveToContext(ctx, tx)); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while expiring BundleInstanceAuth credentials: %v", err)
+			return
+		}
+
+		if err = tx.Commit(); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while committing BundleInstanceAuth credentials expiry: %v", err)
+		}
+	}).Run(ctx)
+}
+
 func bundleRepo() bundle.BundleRepository {
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
@@ -551,23 +639,29 @@ func webhookService() webhook.WebhookService {
 	return webhook.NewService(webhookRepo, applicationRepo(), uidSvc)
 }
 
-func getAsyncDirective(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
+func getAsyncDirective(transact persistence.Transactioner, appRepo application.ApplicationRepository, scheduler operation.Scheduler) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
 	resourceFetcherFunc := func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
 		return appRepo.GetByID(ctx, tenantID, resourceID)
 	}
 
-	scheduler, err := buildScheduler(ctx, cfg)
-	exitOnError(err, "Error while creating operations scheduler")
-
 	return operation.NewDirective(transact, webhookService().ListAllApplicationWebhooks, resourceFetcherFunc, appUpdaterFunc(appRepo), tenant.LoadFromContext, scheduler).HandleOperation
 }
 
-func buildScheduler(ctx context.Context, config config) (operation.Scheduler, error) {
+func buildScheduler(ctx context.Context, config config, historyRecorder operation.HistoryRecorder) (operation.Scheduler, error) {
 	if config.DisableAsyncMode {
 		log.C(ctx).Info("Async operations are disabled")
 		return &operation.DisabledScheduler{}, nil
 	}
 
+	switch config.OperationsScheduler {
+	case databaseOperationsScheduler:
+		log.C(ctx).Info("Async operations are scheduled in the database")
+		return operation.NewHistoryScheduler(postgres.NewScheduler(postgres.NewRepository(), uid.NewService()), historyRecorder), nil
+	case kubernetesOperationsScheduler:
+	default:
+		return nil, errors.Errorf("unknown operations scheduler %q", config.OperationsScheduler)
+	}
+
 	cfg, err := cr.GetConfig()
 	exitOnError(err, "Failed to get cluster config for operations k8s client")
 
@@ -578,7 +672,69 @@ func buildScheduler(ctx context.Context, config config) (operation.Scheduler, er
 	}
 	operationsK8sClient := k8sClient.Operations(config.OperationsNamespace)
 
-	return k8s.NewScheduler(operationsK8sClient), nil
+	return operation.NewHistoryScheduler(k8s.NewScheduler(operationsK8sClient), historyRecorder), nil
+}
+
+// buildFormationAssignmentScheduler returns the scheduler of the formation assignment notifications. They are always scheduled in the database and processed by the operations worker,
+// because the operations-controller reconciles only application operations.
+func buildFormationAssignmentScheduler(config config, historyRecorder operation.HistoryRecorder) operation.Scheduler {
+	if config.DisableAsyncMode {
+		return &operation.DisabledScheduler{}
+	}
+
+	return operation.NewHistoryScheduler(postgres.NewScheduler(postgres.NewRepository(), uid.NewService()), historyRecorder)
+}
+
+func operationsWorker(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository, certCache certloader.Cache, k8sClient *kubernetes.Clientset, operationUpdater postgres.OperationUpdater, progressUpdater postgres.OperationProgressUpdater) *postgres.Worker {
+	webhookConverter := webhook.NewConverter(auth.NewConverter())
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	webhookFetcherFunc := func(ctx context.Context, id string) (*graphql.Webhook, error) {
+		wh, err := webhookRepo.GetByIDGlobal(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return webhookConverter.ToGraphQL(wh)
+	}
+
+	resourceFetcherFuncs := map[resource.Type]postgres.ResourceFetcherFunc{
+		resource.Application: func(ctx context.Context, id string) (model.Entity, error) {
+			return appRepo.GetGlobalByID(ctx, id)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: cfg.SkipSSLValidation}
+	httpClient := &http.Client{
+		Timeout:   cfg.ClientTimeout,
+		Transport: httputil.NewCorrelationIDTransport(transport),
+	}
+	securedHTTPClient := &http.Client{
+		Timeout:   cfg.ClientTimeout,
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewSecuredTransport(transport, pkgauth.NewBasicAuthorizationProvider(), pkgauth.NewTokenAuthorizationProvider(httpClient))),
+	}
+
+	mtlsTransport := transport.Clone()
+	mtlsTransport.TLSClientConfig.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return certCache.Get(), nil
+	}
+	mtlsHTTPClient := &http.Client{
+		Timeout:   cfg.ClientTimeout,
+		Transport: httputil.NewCorrelationIDTransport(mtlsTransport),
+	}
+
+	// Webhooks can reference their own client certificates only when the namespace of the certificate secrets is configured
+	var certClients webhookclient.ClientProvider
+	if cfg.WebhookClientCertificates.Namespace != "" {
+		certClientProvider := webhookclient.NewClientProvider(k8sClient.CoreV1().Secrets(cfg.WebhookClientCertificates.Namespace), cfg.WebhookClientCertificates, transport, cfg.ClientTimeout)
+		go certClientProvider.Run(ctx)
+		certClients = certClientProvider
+	}
+
+	operationsRepo := postgres.NewRepository()
+	webhookClient := webhookclient.NewClient(httpClient, securedHTTPClient, mtlsHTTPClient, certClients)
+	reconciler := postgres.NewReconciler(cfg.Operations, transact, operationsRepo, webhookClient, webhookFetcherFunc, resourceFetcherFuncs, operationUpdater, progressUpdater)
+
+	return postgres.NewWorker(cfg.Operations, transact, operationsRepo, reconciler)
 }
 
 func appUpdaterFunc(appRepo application.ApplicationRepository) operation.ResourceUpdaterFunc {
@@ -597,7 +753,32 @@ func appUpdaterFunc(appRepo application.ApplicationRepository) operation.Resourc
 	}
 }
 
-func runtimeSvc(cfg config) claims.RuntimeService {
+func formationAssignmentService(scheduler operation.Scheduler) formationassignment.Service {
+	authConverter := auth.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	runtimeConverter := runtime.NewConverter(webhookConverter)
+
+	formationAssignmentRepo := formationassignment.NewRepository(formationassignment.NewConverter())
+	runtimeRepo := runtime.NewRepository(runtimeConverter)
+	runtimeContextRepo := runtimectx.NewRepository(runtimectx.NewConverter())
+	labelRepo := label.NewRepository(label.NewConverter())
+	webhookRepo := webhook.NewRepository(webhookConverter)
+
+	return formationassignment.NewService(formationAssignmentRepo, applicationRepo(), runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, uid.NewService(), scheduler)
+}
+
+func operationHistoryRecorder(appRepo application.ApplicationRepository) operation.HistoryRecorder {
+	formationAssignmentRepo := formationassignment.NewRepository(formationassignment.NewConverter())
+	return operationhistory.NewService(operationhistory.NewRepository(), appRepo, formationAssignmentRepo, uid.NewService())
+}
+
+func formationAssignmentUpdaterFunc(formationAssignmentSvc formationassignment.Service) operation.ResourceUpdaterFunc {
+	return func(ctx context.Context, id string, _ bool, errorMsg *string, _ model.ApplicationStatusCondition) error {
+		return formationAssignmentSvc.SetState(ctx, id, errorMsg)
+	}
+}
+
+func runtimeSvc(cfg config, formationAssignmentScheduler operation.Scheduler) claims.RuntimeService {
 	asaConverter := scenarioassignment.NewConverter()
 	authConverter := auth.NewConverter()
 	webhookConverter := webhook.NewConverter(authConverter)
@@ -623,13 +804,15 @@ func runtimeSvc(cfg config) claims.RuntimeService {
 	labelDefinitionSvc := labeldef.NewService(labelDefinitionRepo, labelRepo, asaRepo, tenantRepo, uidSvc, cfg.Features.DefaultScenarioEnabled)
 	asaSvc := scenarioassignment.NewService(asaRepo, labelDefinitionSvc)
 	tenantSvc := tenant.NewServiceWithLabels(tenantRepo, uidSvc, labelRepo, labelSvc)
-	formationSvc := formation.NewService(labelDefinitionRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefinitionSvc, asaRepo, asaSvc, tenantSvc, runtimeRepo, runtimeContextRepo)
+	changeEventSvc := changeevent.NewService(changeevent.NewRepository(), labelRepo, uidSvc)
+	formationAssignmentSvc := formationAssignmentService(formationAssignmentScheduler)
+	formationSvc := formation.NewService(labelDefinitionRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefinitionSvc, asaRepo, asaSvc, tenantSvc, runtimeRepo, runtimeContextRepo, applicationRepo(), changeEventSvc, formationAssignmentSvc)
 	runtimeContextSvc := runtimectx.NewService(runtimeContextRepo, labelRepo, labelSvc, formationSvc, tenantSvc, uidSvc)
 
-	return runtime.NewService(runtimeRepo, labelRepo, labelDefinitionSvc, labelSvc, uidSvc, formationSvc, tenantSvc, webhookService(), runtimeContextSvc, cfg.Features.ProtectedLabelPattern, cfg.Features.ImmutableLabelPattern, cfg.Features.RuntimeTypeLabelKey, cfg.Features.KymaRuntimeTypeLabelValue)
+	return runtime.NewService(runtimeRepo, labelRepo, labelDefinitionSvc, labelSvc, uidSvc, formationSvc, tenantSvc, webhookService(), runtimeContextSvc, changeEventSvc, cfg.Features.ProtectedLabelPattern, cfg.Features.ImmutableLabelPattern, cfg.Features.RuntimeTypeLabelKey, cfg.Features.KymaRuntimeTypeLabelValue)
 }
 
-func runtimeCtxSvc(cfg config) claims.RuntimeCtxService {
+func runtimeCtxSvc(cfg config, formationAssignmentScheduler operation.Scheduler) claims.RuntimeCtxService {
 	runtimeContextConverter := runtimectx.NewConverter()
 	labelConverter := label.NewConverter()
 	labelDefinitionConverter := labeldef.NewConverter()
@@ -655,7 +838,9 @@ func runtimeCtxSvc(cfg config) claims.RuntimeCtxService {
 	labelDefinitionSvc := labeldef.NewService(labelDefinitionRepo, labelRepo, asaRepo, tenantRepo, uidSvc, cfg.Features.DefaultScenarioEnabled)
 	asaSvc := scenarioassignment.NewService(asaRepo, labelDefinitionSvc)
 	tenantSvc := tenant.NewServiceWithLabels(tenantRepo, uidSvc, labelRepo, labelSvc)
-	formationSvc := formation.NewService(labelDefinitionRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefinitionSvc, asaRepo, asaSvc, tenantSvc, runtimeRepo, runtimeContextRepo)
+	changeEventSvc := changeevent.NewService(changeevent.NewRepository(), labelRepo, uidSvc)
+	formationAssignmentSvc := formationAssignmentService(formationAssignmentScheduler)
+	formationSvc := formation.NewService(labelDefinitionRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefinitionSvc, asaRepo, asaSvc, tenantSvc, runtimeRepo, runtimeContextRepo, applicationRepo(), changeEventSvc, formationAssignmentSvc)
 
 	return runtimectx.NewService(runtimeContextRepo, labelRepo, labelSvc, formationSvc, tenantSvc, uidSvc)
 }
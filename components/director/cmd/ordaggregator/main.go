@@ -4,13 +4,24 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"os"
 	"time"
 
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/authenticator"
+	"github.com/kyma-incubator/compass/components/director/internal/authenticator/claims"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/retry"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplate"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
 	runtimectx "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/pkg/certloader"
 
@@ -32,6 +43,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
@@ -41,12 +53,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
+	"github.com/kyma-incubator/compass/components/director/internal/metrics"
 	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
@@ -73,15 +88,46 @@ type config struct {
 
 	MaxParallelApplicationProcessors int `envconfig:"APP_MAX_PARALLEL_APPLICATION_PROCESSORS,default=1"`
 
+	ScheduleConfig ord.ScheduleConfig
+
+	MetricsPushEndpoint string `envconfig:"optional,APP_METRICS_PUSH_ENDPOINT"`
+
 	SelfRegisterDistinguishLabelKey string `envconfig:"APP_SELF_REGISTER_DISTINGUISH_LABEL_KEY"`
+
+	OnDemandAggregationEnabled bool `envconfig:"default=false"`
+	OnDemandServer             onDemandServerConfig
+}
+
+type onDemandServerConfig struct {
+	Address string `envconfig:"default=127.0.0.1:8080,APP_ADDRESS"`
+
+	ServerTimeout   time.Duration `envconfig:"default=110s,APP_SERVER_TIMEOUT"`
+	ShutdownTimeout time.Duration `envconfig:"default=10s,APP_SHUTDOWN_TIMEOUT"`
+
+	RootAPI string `envconfig:"default=/ord-aggregator,APP_ROOT_API"`
+
+	Handler  ord.HandlerConfig
+	JobQueue ord.JobQueueConfig
+
+	SecurityConfig securityConfig
+}
+
+type securityConfig struct {
+	JWKSSyncPeriod      time.Duration `envconfig:"default=5m,APP_JWKS_SYNC_PERIOD"`
+	AllowJWTSigningNone bool          `envconfig:"default=false,APP_ALLOW_JWT_SIGNING_NONE"`
+	JwksEndpoint        string        `envconfig:"default=file://hack/default-jwks.json,APP_JWKS_ENDPOINT"`
+	AggregateScope      string        `envconfig:"default=aggregate_ord,APP_AGGREGATE_ON_DEMAND_SCOPE"`
 }
 
 func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	cfg := config{}
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app config")
 
-	ctx, err := log.Configure(context.Background(), &cfg.Log)
+	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Error while configuring logger")
 
 	cfgProvider := createAndRunConfigProvider(ctx, cfg)
@@ -109,14 +155,34 @@ func main() {
 	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache)
 	retryHTTPExecutor := retry.NewHTTPExecutor(&cfg.RetryConfig)
 
-	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg, transact, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor)
+	var metricsPusher *metrics.ORDAggregationPusher
+	var metricsRecorder ord.MetricsRecorder
+	// the metrics are pushed once the aggregation run finishes, so they are not recorded by the long-running on-demand aggregation
+	if cfg.MetricsPushEndpoint != "" && !cfg.OnDemandAggregationEnabled {
+		metricsPusher = metrics.NewORDAggregationPusher(cfg.MetricsPushEndpoint, cfg.ClientTimeout)
+		metricsRecorder = metricsPusher
+	}
+
+	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg, transact, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor, metricsRecorder)
+
+	if cfg.OnDemandAggregationEnabled {
+		term := make(chan os.Signal)
+		signal.HandleInterrupts(ctx, cancel, term)
+
+		runOnDemandAggregation(ctx, cfg.OnDemandServer, ordAggregator)
+		return
+	}
+
 	err = ordAggregator.SyncORDDocuments(ctx)
+	if metricsPusher != nil {
+		metricsPusher.Push()
+	}
 	exitOnError(err, "Error while synchronizing Open Resource Discovery Documents")
 
 	log.C(ctx).Info("Successfully synchronized Open Resource Discovery Documents")
 }
 
-func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutorProvider *accessstrategy.Provider, retryHTTPExecutor *retry.HTTPExecutor) *ord.Service {
+func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutorProvider *accessstrategy.Provider, retryHTTPExecutor *retry.HTTPExecutor, metricsRecorder ord.MetricsRecorder) *ord.Service {
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
 	versionConverter := version.NewConverter()
@@ -167,7 +233,7 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
 	tenantRepo := tenant.NewRepository(tenant.NewConverter())
 	scenariosSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, tenantRepo, uidSvc, config.Features.DefaultScenarioEnabled)
-	fetchRequestSvc := fetchrequest.NewServiceWithRetry(fetchRequestRepo, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor)
+	fetchRequestSvc := fetchrequest.NewServiceWithRetry(fetchRequestRepo, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor, specvalidation.NewValidator(config.Features.SpecNormalizationEnabled))
 	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
 	bundleReferenceSvc := bundlereferences.NewService(bundleReferenceRepo, uidSvc)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc, bundleReferenceSvc)
@@ -177,8 +243,11 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 	bundleSvc := bundleutil.NewService(bundleRepo, apiSvc, eventAPISvc, docSvc, uidSvc)
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, scenariosSvc)
 	tntSvc := tenant.NewServiceWithLabels(tenantRepo, uidSvc, labelRepo, labelSvc)
-	formationSvc := formation.NewService(labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo)
-	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, scenariosSvc, bundleSvc, uidSvc, formationSvc, config.SelfRegisterDistinguishLabelKey)
+	changeEventSvc := changeevent.NewService(changeevent.NewRepository(), labelRepo, uidSvc)
+	formationAssignmentRepo := formationassignment.NewRepository(formationassignment.NewConverter())
+	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, applicationRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, uidSvc, &operation.DisabledScheduler{})
+	formationSvc := formation.NewService(labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, scenariosSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tntSvc, runtimeRepo, runtimeContextRepo, applicationRepo, changeEventSvc, formationAssignmentSvc)
+	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, scenariosSvc, bundleSvc, uidSvc, formationSvc, changeEventSvc, config.SelfRegisterDistinguishLabelKey)
 	packageSvc := ordpackage.NewService(pkgRepo, uidSvc)
 	productSvc := product.NewService(productRepo, uidSvc)
 	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
@@ -189,8 +258,105 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, config config,
 
 	globalRegistrySvc := ord.NewGlobalRegistryService(transact, config.GlobalRegistryConfig, vendorSvc, productSvc, ordClient)
 
-	ordConfig := ord.NewServiceConfig(config.MaxParallelApplicationProcessors)
-	return ord.NewAggregatorService(ordConfig, transact, labelRepo, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, ordClient)
+	ordConfig := ord.NewServiceConfig(config.MaxParallelApplicationProcessors, config.ScheduleConfig)
+	return ord.NewAggregatorService(ordConfig, transact, labelRepo, ord.NewScheduleRepository(), ordreport.NewService(ordreport.NewRepository()), appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, ordClient, metricsRecorder)
+}
+
+func runOnDemandAggregation(ctx context.Context, cfg onDemandServerConfig, ordAggregator *ord.Service) {
+	jobQueue := ord.NewJobQueue(cfg.JobQueue, ordAggregator)
+	go jobQueue.Run(ctx)
+
+	httpClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	handler := initAPIHandler(ctx, httpClient, cfg, jobQueue)
+	runMainSrv, shutdownMainSrv := createServer(ctx, cfg, handler, "main")
+
+	go func() {
+		<-ctx.Done()
+		// Interrupt signal received - shut down the servers
+		shutdownMainSrv()
+	}()
+
+	runMainSrv()
+}
+
+func initAPIHandler(ctx context.Context, httpClient *http.Client, cfg onDemandServerConfig, jobQueue *ord.JobQueue) http.Handler {
+	logger := log.C(ctx)
+	mainRouter := mux.NewRouter()
+	mainRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger())
+
+	aggregateAPIRouter := mainRouter.PathPrefix(cfg.RootAPI).Subrouter()
+	configureAuthMiddleware(ctx, httpClient, aggregateAPIRouter, cfg.SecurityConfig, cfg.SecurityConfig.AggregateScope)
+
+	aggregationHandler := ord.NewAggregationHTTPHandler(jobQueue, cfg.Handler)
+	logger.Infof("Registering on-demand aggregation endpoint on %s...", cfg.Handler.AggregateEndpoint)
+	aggregateAPIRouter.HandleFunc(cfg.Handler.AggregateEndpoint, aggregationHandler.ScheduleAggregation).Methods(http.MethodPost)
+	aggregateAPIRouter.HandleFunc(cfg.Handler.AggregateEndpoint, aggregationHandler.GetAggregation).Methods(http.MethodGet)
+
+	healthCheckRouter := mainRouter.PathPrefix(cfg.RootAPI).Subrouter()
+	logger.Infof("Registering readiness endpoint...")
+	healthCheckRouter.HandleFunc("/readyz", newReadinessHandler())
+	logger.Infof("Registering liveness endpoint...")
+	healthCheckRouter.HandleFunc("/healthz", newReadinessHandler())
+
+	return mainRouter
+}
+
+func createServer(ctx context.Context, cfg onDemandServerConfig, handler http.Handler, name string) (func(), func()) {
+	logger := log.C(ctx)
+
+	handlerWithTimeout, err := timeouthandler.WithTimeout(handler, cfg.ServerTimeout)
+	exitOnError(err, "Error while configuring on-demand aggregation handler")
+
+	srv := &http.Server{
+		Addr:              cfg.Address,
+		Handler:           handlerWithTimeout,
+		ReadHeaderTimeout: cfg.ServerTimeout,
+	}
+
+	runFn := func() {
+		logger.Infof("Running %s server on %s...", name, cfg.Address)
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			logger.Errorf("%s HTTP server ListenAndServe: %v", name, err)
+		}
+	}
+
+	shutdownFn := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+
+		logger.Infof("Shutting down %s server...", name)
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Errorf("%s HTTP server Shutdown: %v", name, err)
+		}
+	}
+
+	return runFn, shutdownFn
+}
+
+func configureAuthMiddleware(ctx context.Context, httpClient *http.Client, router *mux.Router, cfg securityConfig, requiredScopes ...string) {
+	scopeValidator := claims.NewScopesValidator(requiredScopes)
+	middleware := authenticator.New(httpClient, cfg.JwksEndpoint, cfg.AllowJWTSigningNone, "", scopeValidator)
+	router.Use(middleware.Handler())
+
+	log.C(ctx).Infof("JWKS synchronization enabled. Sync period: %v", cfg.JWKSSyncPeriod)
+	periodicExecutor := executor.NewPeriodic(cfg.JWKSSyncPeriod, func(ctx context.Context) {
+		if err := middleware.SynchronizeJWKS(ctx); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while synchronizing JWKS: %v", err)
+		}
+	})
+	go periodicExecutor.Run(ctx)
+}
+
+func newReadinessHandler() func(writer http.ResponseWriter, request *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	}
 }
 
 func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider.Provider {
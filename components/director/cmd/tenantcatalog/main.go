@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	envPrefix = "APP"
+
+	exportOperation = "export"
+	importOperation = "import"
+
+	exportQuery = `query ($format: TenantCatalogFormat) {
+		result: exportTenantCatalog(format: $format)
+	}`
+	importMutation = `mutation ($in: CLOB!, $dryRun: Boolean) {
+		result: importTenantCatalog(in: $in, dryRun: $dryRun) {
+			dryRun
+			changes {
+				action
+				resourceType
+				path
+			}
+		}
+	}`
+)
+
+type config struct {
+	DirectorGraphqlURL string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
+	ClientTimeout      time.Duration `envconfig:"default=60s"`
+	// Token is the value of the Authorization header sent to the Director, for example "Bearer <JWT>"
+	Token string
+	// Tenant is the external ID of the tenant whose catalog is exported or imported
+	Tenant string
+	// Operation is either export or import
+	Operation string `envconfig:"default=export"`
+	// File is the file the catalog is written to on export and read from on import. The standard output is used on export when it is not set.
+	File   string                      `envconfig:"optional"`
+	Format graphql.TenantCatalogFormat `envconfig:"default=YAML"`
+	DryRun bool                        `envconfig:"default=false"`
+}
+
+type exportResponse struct {
+	Result graphql.CLOB `json:"result"`
+}
+
+type importResponse struct {
+	Result graphql.TenantCatalogImportResult `json:"result"`
+}
+
+func main() {
+	ctx := context.Background()
+	ctx = withCorrelationID(ctx, uid.NewService().Generate())
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, envPrefix)
+	exitOnError(ctx, err, "Error while loading app config")
+
+	httpClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		Timeout:   cfg.ClientTimeout,
+	}
+	client := gcli.NewClient(cfg.DirectorGraphqlURL, gcli.WithHTTPClient(httpClient))
+
+	switch cfg.Operation {
+	case exportOperation:
+		err = exportCatalog(ctx, client, cfg)
+	case importOperation:
+		err = importCatalog(ctx, client, cfg)
+	default:
+		err = errors.Errorf("unknown operation %q, expected %q or %q", cfg.Operation, exportOperation, importOperation)
+	}
+	exitOnError(ctx, err, "Error while processing tenant catalog")
+}
+
+func exportCatalog(ctx context.Context, client *gcli.Client, cfg config) error {
+	if !cfg.Format.IsValid() {
+		return errors.Errorf("unsupported tenant catalog format %q", cfg.Format)
+	}
+
+	req := newRequest(exportQuery, cfg)
+	req.Var("format", cfg.Format)
+
+	var resp exportResponse
+	if err := client.Run(ctx, req, &resp); err != nil {
+		return errors.Wrap(err, "while exporting tenant catalog")
+	}
+
+	if cfg.File == "" {
+		_, err := fmt.Fprint(os.Stdout, string(resp.Result))
+		return err
+	}
+
+	if err := os.WriteFile(cfg.File, []byte(resp.Result), 0600); err != nil {
+		return errors.Wrapf(err, "while writing tenant catalog to %s", cfg.File)
+	}
+
+	log.C(ctx).Infof("Tenant catalog of tenant %s exported to %s", cfg.Tenant, cfg.File)
+	return nil
+}
+
+func importCatalog(ctx context.Context, client *gcli.Client, cfg config) error {
+	if cfg.File == "" {
+		return errors.New("the file of the tenant catalog to import is required")
+	}
+
+	content, err := os.ReadFile(cfg.File)
+	if err != nil {
+		return errors.Wrapf(err, "while reading tenant catalog from %s", cfg.File)
+	}
+
+	// Validate locally first, so that malformed documents are reported without a roundtrip to the Director
+	if _, err := tenantcatalog.Unmarshal(content); err != nil {
+		return err
+	}
+
+	req := newRequest(importMutation, cfg)
+	req.Var("in", string(content))
+	req.Var("dryRun", cfg.DryRun)
+
+	var resp importResponse
+	if err := client.Run(ctx, req, &resp); err != nil {
+		return errors.Wrap(err, "while importing tenant catalog")
+	}
+
+	for _, change := range resp.Result.Changes {
+		fmt.Fprintf(os.Stdout, "%s %s %s\n", change.Action, change.ResourceType, change.Path)
+	}
+
+	log.C(ctx).Infof("Tenant catalog import into tenant %s with dry run %t resulted in %d changes", cfg.Tenant, resp.Result.DryRun, len(resp.Result.Changes))
+	return nil
+}
+
+func newRequest(query string, cfg config) *gcli.Request {
+	req := gcli.NewRequest(query)
+	req.Header.Set("Authorization", cfg.Token)
+	req.Header.Set("Tenant", cfg.Tenant)
+
+	return req
+}
+
+func exitOnError(ctx context.Context, err error, context string) {
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("%s: %v", context, err)
+		os.Exit(1)
+	}
+}
+
+func withCorrelationID(ctx context.Context, id string) context.Context {
+	correlationIDKey := correlation.RequestIDHeaderKey
+	return correlation.SaveCorrelationIDHeaderToContext(ctx, &correlationIDKey, &id)
+}
@@ -12,6 +12,9 @@ const QueryTypeName = "Query"
 // MutationTypeName missing godoc
 const MutationTypeName = "Mutation"
 
+// SubscriptionTypeName missing godoc
+const SubscriptionTypeName = "Subscription"
+
 // OrderedDefinitionList missing godoc
 type OrderedDefinitionList []ast.Definition
 
@@ -38,7 +41,13 @@ func (d OrderedDefinitionList) Less(i, j int) bool {
 	}
 
 	if first.Kind == ast.Object {
-		// query and mutations should be at the end of the file
+		// query, mutations and subscriptions should be at the end of the file
+		if first.Name == SubscriptionTypeName {
+			return false
+		}
+		if second.Name == SubscriptionTypeName {
+			return true
+		}
 		if first.Name == MutationTypeName {
 			return false
 		}
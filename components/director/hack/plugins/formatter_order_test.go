@@ -13,11 +13,11 @@ import (
 
 func TestOrderedDefinitionList(t *testing.T) {
 	// GIVEN
-	definitions := plugins.OrderedDefinitionList{defMutation(), defQuery(), defObjectZ(), defObjectA(), defScalarB(), defScalarA(), defEnumB(), defEnumA()}
+	definitions := plugins.OrderedDefinitionList{defSubscription(), defMutation(), defQuery(), defObjectZ(), defObjectA(), defScalarB(), defScalarA(), defEnumB(), defEnumA()}
 	// WHEN
 	sort.Sort(definitions)
 	// THEN
-	require.Len(t, definitions, 8)
+	require.Len(t, definitions, 9)
 	assert.Equal(t, definitions[0], defScalarA())
 	assert.Equal(t, definitions[1], defScalarB())
 	assert.Equal(t, definitions[2], defEnumA())
@@ -26,6 +26,7 @@ func TestOrderedDefinitionList(t *testing.T) {
 	assert.Equal(t, definitions[5], defObjectZ())
 	assert.Equal(t, definitions[6], defQuery())
 	assert.Equal(t, definitions[7], defMutation())
+	assert.Equal(t, definitions[8], defSubscription())
 }
 
 func defScalarA() ast.Definition {
@@ -70,6 +71,13 @@ func defMutation() ast.Definition {
 	}
 }
 
+func defSubscription() ast.Definition {
+	return ast.Definition{
+		Kind: ast.Object,
+		Name: "Subscription",
+	}
+}
+
 func defQuery() ast.Definition {
 	return ast.Definition{
 		Kind: ast.Object,
@@ -21,6 +21,8 @@ const (
 	Query GraphqlOperationType = "query"
 	// Mutation missing godoc
 	Mutation GraphqlOperationType = "mutation"
+	// Subscription missing godoc
+	Subscription GraphqlOperationType = "subscription"
 )
 
 const (
@@ -63,6 +65,11 @@ func (p *scopesDecoratorPlugin) MutateConfig(cfg *config.Config) error {
 			p.ensureDirective(f, Mutation)
 		}
 	}
+	if schema.Subscription != nil {
+		for _, f := range schema.Subscription.Fields {
+			p.ensureDirective(f, Subscription)
+		}
+	}
 	if err := cfg.LoadSchema(); err != nil {
 		return err
 	}
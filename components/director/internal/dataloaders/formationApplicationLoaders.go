@@ -0,0 +1,49 @@
+//go:generate go run github.com/vektah/dataloaden FormationApplicationLoader ParamFormationApplication *github.com/kyma-incubator/compass/components/director/pkg/graphql.ApplicationPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyFormationApplication contextKey = "dataloadersFormationApplication"
+
+// FormationApplicationLoaders missing godoc
+type FormationApplicationLoaders struct {
+	FormationApplicationByID FormationApplicationLoader
+}
+
+// ParamFormationApplication missing godoc
+type ParamFormationApplication struct {
+	ID    string
+	Name  string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerFormationApplication missing godoc
+func HandlerFormationApplication(fetchFunc func(keys []ParamFormationApplication) ([]*graphql.ApplicationPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyFormationApplication, &FormationApplicationLoaders{
+				FormationApplicationByID: FormationApplicationLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// FormationApplicationFor missing godoc
+func FormationApplicationFor(ctx context.Context) *FormationApplicationLoaders {
+	return ctx.Value(loadersKeyFormationApplication).(*FormationApplicationLoaders)
+}
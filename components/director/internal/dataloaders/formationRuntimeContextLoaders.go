@@ -0,0 +1,49 @@
+//go:generate go run github.com/vektah/dataloaden FormationRuntimeContextLoader ParamFormationRuntimeContext *github.com/kyma-incubator/compass/components/director/pkg/graphql.RuntimeContextPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyFormationRuntimeContext contextKey = "dataloadersFormationRuntimeContext"
+
+// FormationRuntimeContextLoaders missing godoc
+type FormationRuntimeContextLoaders struct {
+	FormationRuntimeContextByID FormationRuntimeContextLoader
+}
+
+// ParamFormationRuntimeContext missing godoc
+type ParamFormationRuntimeContext struct {
+	ID    string
+	Name  string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerFormationRuntimeContext missing godoc
+func HandlerFormationRuntimeContext(fetchFunc func(keys []ParamFormationRuntimeContext) ([]*graphql.RuntimeContextPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyFormationRuntimeContext, &FormationRuntimeContextLoaders{
+				FormationRuntimeContextByID: FormationRuntimeContextLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// FormationRuntimeContextFor missing godoc
+func FormationRuntimeContextFor(ctx context.Context) *FormationRuntimeContextLoaders {
+	return ctx.Value(loadersKeyFormationRuntimeContext).(*FormationRuntimeContextLoaders)
+}
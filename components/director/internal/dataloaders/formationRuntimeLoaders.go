@@ -0,0 +1,49 @@
+//go:generate go run github.com/vektah/dataloaden FormationRuntimeLoader ParamFormationRuntime *github.com/kyma-incubator/compass/components/director/pkg/graphql.RuntimePage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyFormationRuntime contextKey = "dataloadersFormationRuntime"
+
+// FormationRuntimeLoaders missing godoc
+type FormationRuntimeLoaders struct {
+	FormationRuntimeByID FormationRuntimeLoader
+}
+
+// ParamFormationRuntime missing godoc
+type ParamFormationRuntime struct {
+	ID    string
+	Name  string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerFormationRuntime missing godoc
+func HandlerFormationRuntime(fetchFunc func(keys []ParamFormationRuntime) ([]*graphql.RuntimePage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyFormationRuntime, &FormationRuntimeLoaders{
+				FormationRuntimeByID: FormationRuntimeLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// FormationRuntimeFor missing godoc
+func FormationRuntimeFor(ctx context.Context) *FormationRuntimeLoaders {
+	return ctx.Value(loadersKeyFormationRuntime).(*FormationRuntimeLoaders)
+}
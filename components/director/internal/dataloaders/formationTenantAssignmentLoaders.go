@@ -0,0 +1,49 @@
+//go:generate go run github.com/vektah/dataloaden FormationTenantAssignmentLoader ParamFormationTenantAssignment *github.com/kyma-incubator/compass/components/director/pkg/graphql.AutomaticScenarioAssignmentPage
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyFormationTenantAssignment contextKey = "dataloadersFormationTenantAssignment"
+
+// FormationTenantAssignmentLoaders missing godoc
+type FormationTenantAssignmentLoaders struct {
+	FormationTenantAssignmentByID FormationTenantAssignmentLoader
+}
+
+// ParamFormationTenantAssignment missing godoc
+type ParamFormationTenantAssignment struct {
+	ID    string
+	Name  string
+	First *int
+	After *graphql.PageCursor
+	Ctx   context.Context
+}
+
+// HandlerFormationTenantAssignment missing godoc
+func HandlerFormationTenantAssignment(fetchFunc func(keys []ParamFormationTenantAssignment) ([]*graphql.AutomaticScenarioAssignmentPage, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyFormationTenantAssignment, &FormationTenantAssignmentLoaders{
+				FormationTenantAssignmentByID: FormationTenantAssignmentLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// FormationTenantAssignmentFor missing godoc
+func FormationTenantAssignmentFor(ctx context.Context) *FormationTenantAssignmentLoaders {
+	return ctx.Value(loadersKeyFormationTenantAssignment).(*FormationTenantAssignmentLoaders)
+}
@@ -0,0 +1,46 @@
+//go:generate go run github.com/vektah/dataloaden PackageLoader ParamPackage []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Package
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyPackage contextKey = "dataloadersPackage"
+
+// PackageLoaders missing godoc
+type PackageLoaders struct {
+	PackageByID PackageLoader
+}
+
+// ParamPackage missing godoc
+type ParamPackage struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerPackage missing godoc
+func HandlerPackage(fetchFunc func(keys []ParamPackage) ([][]*graphql.Package, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyPackage, &PackageLoaders{
+				PackageByID: PackageLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PackageFor missing godoc
+func PackageFor(ctx context.Context) *PackageLoaders {
+	return ctx.Value(loadersKeyPackage).(*PackageLoaders)
+}
@@ -0,0 +1,46 @@
+//go:generate go run github.com/vektah/dataloaden ProductLoader ParamProduct []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Product
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyProduct contextKey = "dataloadersProduct"
+
+// ProductLoaders missing godoc
+type ProductLoaders struct {
+	ProductByID ProductLoader
+}
+
+// ParamProduct missing godoc
+type ParamProduct struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerProduct missing godoc
+func HandlerProduct(fetchFunc func(keys []ParamProduct) ([][]*graphql.Product, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyProduct, &ProductLoaders{
+				ProductByID: ProductLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ProductFor missing godoc
+func ProductFor(ctx context.Context) *ProductLoaders {
+	return ctx.Value(loadersKeyProduct).(*ProductLoaders)
+}
@@ -0,0 +1,46 @@
+//go:generate go run github.com/vektah/dataloaden TombstoneLoader ParamTombstone []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Tombstone
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyTombstone contextKey = "dataloadersTombstone"
+
+// TombstoneLoaders missing godoc
+type TombstoneLoaders struct {
+	TombstoneByID TombstoneLoader
+}
+
+// ParamTombstone missing godoc
+type ParamTombstone struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerTombstone missing godoc
+func HandlerTombstone(fetchFunc func(keys []ParamTombstone) ([][]*graphql.Tombstone, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyTombstone, &TombstoneLoaders{
+				TombstoneByID: TombstoneLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TombstoneFor missing godoc
+func TombstoneFor(ctx context.Context) *TombstoneLoaders {
+	return ctx.Value(loadersKeyTombstone).(*TombstoneLoaders)
+}
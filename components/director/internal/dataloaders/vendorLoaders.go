@@ -0,0 +1,46 @@
+//go:generate go run github.com/vektah/dataloaden VendorLoader ParamVendor []*github.com/kyma-incubator/compass/components/director/pkg/graphql.Vendor
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeyVendor contextKey = "dataloadersVendor"
+
+// VendorLoaders missing godoc
+type VendorLoaders struct {
+	VendorByID VendorLoader
+}
+
+// ParamVendor missing godoc
+type ParamVendor struct {
+	ID  string
+	Ctx context.Context
+}
+
+// HandlerVendor missing godoc
+func HandlerVendor(fetchFunc func(keys []ParamVendor) ([][]*graphql.Vendor, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeyVendor, &VendorLoaders{
+				VendorByID: VendorLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// VendorFor missing godoc
+func VendorFor(ctx context.Context) *VendorLoaders {
+	return ctx.Value(loadersKeyVendor).(*VendorLoaders)
+}
@@ -8,6 +8,8 @@ import (
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 	testing "testing"
 )
 
@@ -162,13 +164,13 @@ func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenantID strin
 	return r0, r1
 }
 
-// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs, bundleRefs, counts, pageSize, cursor
-func (_m *APIRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, counts map[string]int, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
-	ret := _m.Called(ctx, tenantID, bundleIDs, bundleRefs, counts, pageSize, cursor)
+// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs, bundleRefs, counts, pages
+func (_m *APIRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, counts map[string]int, pages map[string]*pagination.Page) ([]*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs, bundleRefs, counts, pages)
 
 	var r0 []*model.APIDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, int, string) []*model.APIDefinitionPage); ok {
-		r0 = rf(ctx, tenantID, bundleIDs, bundleRefs, counts, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, map[string]*pagination.Page) []*model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, bundleIDs, bundleRefs, counts, pages)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.APIDefinitionPage)
@@ -176,8 +178,8 @@ func (_m *APIRepository) ListByBundleIDs(ctx context.Context, tenantID string, b
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, int, string) error); ok {
-		r1 = rf(ctx, tenantID, bundleIDs, bundleRefs, counts, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, map[string]*pagination.Page) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs, bundleRefs, counts, pages)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,7 +72,7 @@ func (r APIDefCollection) Len() int {
 }
 
 // ListByBundleIDs retrieves all APIDefinitions for a Bundle in pages. Each Bundle is extracted from the input array of bundleIDs. The input bundleReferences array is used for getting the appropriate APIDefinition IDs.
-func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pages map[string]*pagination.Page) ([]*model.APIDefinitionPage, error) {
 	apiDefIDs := make([]string, 0, len(bundleRefs))
 	for _, ref := range bundleRefs {
 		apiDefIDs = append(apiDefIDs, *ref.ObjectID)
@@ -93,30 +93,11 @@ func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bun
 
 	refsByBundleID, apiDefsByAPIDefID := r.groupEntitiesByID(bundleRefs, apiDefCollection)
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
-	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
-	}
-
 	apiDefPages := make([]*model.APIDefinitionPage, 0, len(bundleIDs))
 	for _, bundleID := range bundleIDs {
 		ids := getAPIDefIDsForBundle(refsByBundleID[bundleID])
 		apiDefs := getAPIDefsForBundle(ids, apiDefsByAPIDefID)
-
-		hasNextPage := false
-		endCursor := ""
-		if totalCounts[bundleID] > offset+len(apiDefs) {
-			hasNextPage = true
-			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
-		}
-
-		page := &pagination.Page{
-			StartCursor: cursor,
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		}
-
-		apiDefPages = append(apiDefPages, &model.APIDefinitionPage{Data: apiDefs, TotalCount: totalCounts[bundleID], PageInfo: page})
+		apiDefPages = append(apiDefPages, &model.APIDefinitionPage{Data: apiDefs, TotalCount: totalCounts[bundleID], PageInfo: pages[bundleID]})
 	}
 
 	return apiDefPages, nil
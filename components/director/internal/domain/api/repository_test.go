@@ -86,8 +86,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 }
 
 func TestPgRepository_ListAllForBundle(t *testing.T) {
-	pageSize := 1
-	cursor := ""
+	multiplePagesEndCursor := "multiplePagesEndCursor"
 
 	emptyPageBundleID := "emptyPageBundleID"
 
@@ -110,6 +109,12 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		multiplePagesBundleID: 2,
 	}
 
+	pages := map[string]*pagination.Page{
+		emptyPageBundleID:     {},
+		onePageBundleID:       {},
+		multiplePagesBundleID: {EndCursor: multiplePagesEndCursor, HasNextPage: true},
+	}
+
 	suite := testdb.RepoListPageableTestSuite{
 		Name: "List APIs for multiple bundles with paging",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
@@ -156,7 +161,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 					Data: []*model.APIDefinition{&secondAPIDef},
 					PageInfo: &pagination.Page{
 						StartCursor: "",
-						EndCursor:   pagination.EncodeNextOffsetCursor(0, pageSize),
+						EndCursor:   multiplePagesEndCursor,
 						HasNextPage: true,
 					},
 					TotalCount: 2,
@@ -169,7 +174,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		RepoConstructorFunc: api.NewRepository,
 		MethodName:          "ListByBundleIDs",
 		MethodArgs: []interface{}{tenantID, []string{emptyPageBundleID, onePageBundleID, multiplePagesBundleID},
-			[]*model.BundleReference{firstBundleRef, secondBundleRef}, totalCounts, pageSize, cursor},
+			[]*model.BundleReference{firstBundleRef, secondBundleRef}, totalCounts, pages},
 		DisableConverterErrorTest: true,
 	}
 
@@ -221,6 +221,10 @@ func TestService_ListByBundleIDs(t *testing.T) {
 	apiDefPages := []*model.APIDefinitionPage{apiDefPageFirstBundle, apiDefPageSecondBundle}
 
 	after := "test"
+	pages := map[string]*pagination.Page{
+		firstBundleID:  {StartCursor: after},
+		secondBundleID: {StartCursor: after},
+	}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
@@ -237,12 +241,12 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Success",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, nil).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, pages, nil).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, 2, after).Return(apiDefPages, nil).Once()
+				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages).Return(apiDefPages, nil).Once()
 				return repo
 			},
 			PageSize:           2,
@@ -281,7 +285,7 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Returns error when APIDefinition BundleReferences listing failed",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(nil, nil, testErr).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(nil, nil, nil, testErr).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.APIRepository {
@@ -296,12 +300,12 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Returns error when APIDefinition listing failed",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, nil).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, pages, nil).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.APIRepository {
 				repo := &automock.APIRepository{}
-				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, 2, after).Return(nil, testErr).Once()
+				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages).Return(nil, testErr).Once()
 				return repo
 			},
 			PageSize:           2,
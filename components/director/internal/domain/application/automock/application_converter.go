@@ -4,13 +4,11 @@ package automock
 
 import (
 	context "context"
+	testing "testing"
 
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
 )
 
 // ApplicationConverter is an autogenerated mock type for the ApplicationConverter type
@@ -55,6 +53,43 @@ func (_m *ApplicationConverter) GraphQLToModel(obj *graphql.Application, tenantI
 	return r0
 }
 
+// MergeConflictPolicyFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) MergeConflictPolicyFromGraphQL(in *graphql.ApplicationMergeConflictPolicy) model.ApplicationMergeConflictPolicy {
+	ret := _m.Called(in)
+
+	var r0 model.ApplicationMergeConflictPolicy
+	if rf, ok := ret.Get(0).(func(*graphql.ApplicationMergeConflictPolicy) model.ApplicationMergeConflictPolicy); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ApplicationMergeConflictPolicy)
+	}
+
+	return r0
+}
+
+// MergePlanToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) MergePlanToGraphQL(in *model.ApplicationMergePlan) (*graphql.ApplicationMergePlan, error) {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ApplicationMergePlan
+	if rf, ok := ret.Get(0).(func(*model.ApplicationMergePlan) *graphql.ApplicationMergePlan); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationMergePlan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.ApplicationMergePlan) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MultipleToGraphQL provides a mock function with given fields: in
 func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*graphql.Application {
 	ret := _m.Called(in)
@@ -4,15 +4,13 @@ package automock
 
 import (
 	context "context"
-
-	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
-	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
 	testing "testing"
 
 	uuid "github.com/google/uuid"
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	pageorder "github.com/kyma-incubator/compass/components/director/internal/pageorder"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ApplicationRepository is an autogenerated mock type for the ApplicationRepository type
@@ -198,13 +196,13 @@ func (_m *ApplicationRepository) GetGlobalByID(ctx context.Context, id string) (
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, order, pageSize, cursor
+func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, tenant, filter, order, pageSize, cursor)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, *pageorder.Order, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, tenant, filter, order, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -212,8 +210,8 @@ func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, *pageorder.Order, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, order, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -267,6 +265,29 @@ func (_m *ApplicationRepository) ListAllByFilter(ctx context.Context, tenant str
 	return r0, r1
 }
 
+// ListAllByIDs provides a mock function with given fields: ctx, tenantID, ids
+func (_m *ApplicationRepository) ListAllByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Application, error) {
+	ret := _m.Called(ctx, tenantID, ids)
+
+	var r0 []*model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Application); ok {
+		r0 = rf(ctx, tenantID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByScenarios provides a mock function with given fields: ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors
 func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors)
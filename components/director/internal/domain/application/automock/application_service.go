@@ -4,15 +4,13 @@ package automock
 
 import (
 	context "context"
-
-	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
-	mock "github.com/stretchr/testify/mock"
-
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
 	testing "testing"
 
 	uuid "github.com/google/uuid"
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	pageorder "github.com/kyma-incubator/compass/components/director/internal/pageorder"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // ApplicationService is an autogenerated mock type for the ApplicationService type
@@ -115,13 +113,13 @@ func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, filter, order, pageSize, cursor
+func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, filter, order, pageSize, cursor)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, *pageorder.Order, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, filter, order, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -129,8 +127,8 @@ func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.La
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, *pageorder.Order, int, string) error); ok {
+		r1 = rf(ctx, filter, order, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -184,13 +182,13 @@ func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID stri
 	return r0, r1
 }
 
-// Merge provides a mock function with given fields: ctx, destID, sourceID
-func (_m *ApplicationService) Merge(ctx context.Context, destID string, sourceID string) (*model.Application, error) {
-	ret := _m.Called(ctx, destID, sourceID)
+// Merge provides a mock function with given fields: ctx, destID, sourceID, conflictPolicy
+func (_m *ApplicationService) Merge(ctx context.Context, destID string, sourceID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.Application, error) {
+	ret := _m.Called(ctx, destID, sourceID, conflictPolicy)
 
 	var r0 *model.Application
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Application); ok {
-		r0 = rf(ctx, destID, sourceID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationMergeConflictPolicy) *model.Application); ok {
+		r0 = rf(ctx, destID, sourceID, conflictPolicy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Application)
@@ -198,8 +196,31 @@ func (_m *ApplicationService) Merge(ctx context.Context, destID string, sourceID
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, destID, sourceID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.ApplicationMergeConflictPolicy) error); ok {
+		r1 = rf(ctx, destID, sourceID, conflictPolicy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergePlan provides a mock function with given fields: ctx, destID, sourceID, conflictPolicy
+func (_m *ApplicationService) MergePlan(ctx context.Context, destID string, sourceID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.ApplicationMergePlan, error) {
+	ret := _m.Called(ctx, destID, sourceID, conflictPolicy)
+
+	var r0 *model.ApplicationMergePlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationMergeConflictPolicy) *model.ApplicationMergePlan); ok {
+		r0 = rf(ctx, destID, sourceID, conflictPolicy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationMergePlan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.ApplicationMergeConflictPolicy) error); ok {
+		r1 = rf(ctx, destID, sourceID, conflictPolicy)
 	} else {
 		r1 = ret.Error(1)
 	}
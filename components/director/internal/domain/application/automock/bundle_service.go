@@ -4,11 +4,10 @@ package automock
 
 import (
 	context "context"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
-
-	testing "testing"
 )
 
 // BundleService is an autogenerated mock type for the BundleService type
@@ -53,6 +52,29 @@ func (_m *BundleService) GetForApplication(ctx context.Context, id string, appli
 	return r0, r1
 }
 
+// ListByApplicationIDNoPaging provides a mock function with given fields: ctx, appID
+func (_m *BundleService) ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 []*model.Bundle
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Bundle); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Bundle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationIDs provides a mock function with given fields: ctx, applicationIDs, pageSize, cursor
 func (_m *BundleService) ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
 	ret := _m.Called(ctx, applicationIDs, pageSize, cursor)
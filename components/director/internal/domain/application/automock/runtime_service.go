@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	pageorder "github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
@@ -41,13 +42,13 @@ func (_m *RuntimeService) GetLabel(ctx context.Context, runtimeID string, key st
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, filter, order, pageSize, cursor
+func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, filter, order, pageSize, cursor)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, *pageorder.Order, int, string) *model.RuntimePage); ok {
+		r0 = rf(ctx, filter, order, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -55,8 +56,8 @@ func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelF
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, *pageorder.Order, int, string) error); ok {
+		r1 = rf(ctx, filter, order, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
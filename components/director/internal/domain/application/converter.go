@@ -249,6 +249,59 @@ func (c *converter) GraphQLToModel(obj *graphql.Application, tenantID string) *m
 	}
 }
 
+// MergePlanToGraphQL converts a model.ApplicationMergePlan to graphql.ApplicationMergePlan
+func (c *converter) MergePlanToGraphQL(in *model.ApplicationMergePlan) (*graphql.ApplicationMergePlan, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	bundles, err := c.bndl.MultipleToGraphQL(in.RemovedBundles)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting removed bundles")
+	}
+
+	labels := make([]*graphql.ApplicationMergeLabel, 0, len(in.Labels))
+	for _, l := range in.Labels {
+		labels = append(labels, &graphql.ApplicationMergeLabel{
+			Key:              l.Key,
+			Action:           graphql.ApplicationMergeLabelAction(l.Action),
+			Conflict:         l.Conflict,
+			SourceValue:      l.SourceValue,
+			DestinationValue: l.DestinationValue,
+			Value:            l.Value,
+		})
+	}
+
+	addedScenarios := in.AddedScenarios
+	if addedScenarios == nil {
+		addedScenarios = []string{}
+	}
+
+	if bundles == nil {
+		bundles = []*graphql.Bundle{}
+	}
+
+	return &graphql.ApplicationMergePlan{
+		DestinationID:  in.DestinationID,
+		SourceID:       in.SourceID,
+		ConflictPolicy: graphql.ApplicationMergeConflictPolicy(in.ConflictPolicy),
+		Labels:         labels,
+		AddedScenarios: addedScenarios,
+		Conflicts:      in.Conflicts(),
+		RemovedBundles: bundles,
+	}, nil
+}
+
+// MergeConflictPolicyFromGraphQL converts a graphql.ApplicationMergeConflictPolicy to model.ApplicationMergeConflictPolicy,
+// defaulting to keeping the values of the destination application
+func (c *converter) MergeConflictPolicyFromGraphQL(in *graphql.ApplicationMergeConflictPolicy) model.ApplicationMergeConflictPolicy {
+	if in == nil {
+		return model.ApplicationMergeConflictPolicyPreferDestination
+	}
+
+	return model.ApplicationMergeConflictPolicy(*in)
+}
+
 func (c *converter) statusModelToGraphQL(in *model.ApplicationStatus) *graphql.ApplicationStatus {
 	if in == nil {
 		return &graphql.ApplicationStatus{Condition: graphql.ApplicationStatusConditionInitial}
@@ -432,6 +432,78 @@ func TestConverter_ConvertToModel(t *testing.T) {
 	})
 }
 
+func TestConverter_MergePlanToGraphQL(t *testing.T) {
+	plan := &model.ApplicationMergePlan{
+		DestinationID:  "dest",
+		SourceID:       "src",
+		ConflictPolicy: model.ApplicationMergeConflictPolicyPreferSource,
+		Labels: []*model.ApplicationMergeLabel{
+			{Key: "foo", Action: model.ApplicationMergeLabelActionOverwritten, Conflict: true, SourceValue: "src", DestinationValue: "dest", Value: "src"},
+			{Key: "bar", Action: model.ApplicationMergeLabelActionAdded, SourceValue: "src", Value: "src"},
+		},
+		AddedScenarios: []string{"Egg"},
+		RemovedBundles: []*model.Bundle{{Name: "bundle", BaseEntity: &model.BaseEntity{ID: "bundle-id"}}},
+	}
+	gqlBundles := []*graphql.Bundle{{Name: "bundle", BaseEntity: &graphql.BaseEntity{ID: "bundle-id"}}}
+
+	t.Run("Success", func(t *testing.T) {
+		bndlConv := &automock.BundleConverter{}
+		bndlConv.On("MultipleToGraphQL", plan.RemovedBundles).Return(gqlBundles, nil).Once()
+		conv := application.NewConverter(nil, bndlConv)
+
+		// WHEN
+		res, err := conv.MergePlanToGraphQL(plan)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.ApplicationMergePlan{
+			DestinationID:  "dest",
+			SourceID:       "src",
+			ConflictPolicy: graphql.ApplicationMergeConflictPolicyPreferSource,
+			Labels: []*graphql.ApplicationMergeLabel{
+				{Key: "foo", Action: graphql.ApplicationMergeLabelActionOverwritten, Conflict: true, SourceValue: "src", DestinationValue: "dest", Value: "src"},
+				{Key: "bar", Action: graphql.ApplicationMergeLabelActionAdded, SourceValue: "src", Value: "src"},
+			},
+			AddedScenarios: []string{"Egg"},
+			Conflicts:      []string{"foo"},
+			RemovedBundles: gqlBundles,
+		}, res)
+		bndlConv.AssertExpectations(t)
+	})
+
+	t.Run("Error when converting bundles fails", func(t *testing.T) {
+		testErr := errors.New("test error")
+		bndlConv := &automock.BundleConverter{}
+		bndlConv.On("MultipleToGraphQL", plan.RemovedBundles).Return(nil, testErr).Once()
+		conv := application.NewConverter(nil, bndlConv)
+
+		// WHEN
+		_, err := conv.MergePlanToGraphQL(plan)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		bndlConv.AssertExpectations(t)
+	})
+
+	t.Run("Nil plan", func(t *testing.T) {
+		// WHEN
+		res, err := application.NewConverter(nil, nil).MergePlanToGraphQL(nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestConverter_MergeConflictPolicyFromGraphQL(t *testing.T) {
+	conv := application.NewConverter(nil, nil)
+	policy := graphql.ApplicationMergeConflictPolicyFail
+
+	assert.Equal(t, model.ApplicationMergeConflictPolicyFail, conv.MergeConflictPolicyFromGraphQL(&policy))
+	assert.Equal(t, model.ApplicationMergeConflictPolicyPreferDestination, conv.MergeConflictPolicyFromGraphQL(nil))
+}
+
 func assertApplicationDefinition(t *testing.T, appModel *model.Application, entity *application.Entity) {
 	assert.Equal(t, appModel.ID, entity.ID)
 	assert.Equal(t, appModel.Name, entity.Name)
@@ -14,9 +14,11 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -420,3 +422,9 @@ func fixApplicationLabels(appID, labelKey1, labelKey2 string, labelValue1 []inte
 		},
 	}
 }
+
+func changeEventServiceThatPublishes() *automock.ChangeEventService {
+	svc := &automock.ChangeEventService{}
+	svc.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return svc
+}
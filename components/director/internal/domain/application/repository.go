@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
@@ -27,6 +28,7 @@ var (
 	updatableColumns      = []string{"name", "description", "status_condition", "status_timestamp", "system_status", "healthcheck_url", "integration_system_id", "provider_name", "base_url", "labels", "ready", "created_at", "updated_at", "deleted_at", "error", "correlation_ids", "documentation_labels", "system_number", "local_tenant_id"}
 	upsertableColumns     = []string{"name", "description", "status_condition", "system_status", "provider_name", "base_url", "labels"}
 	matchingSystemColumns = []string{"system_number"}
+	orderColumns          = map[pageorder.Field]string{pageorder.FieldID: "id", pageorder.FieldName: "name", pageorder.FieldCreatedAt: "created_at"}
 )
 
 // EntityConverter missing godoc
@@ -209,6 +211,20 @@ func (r *pgRepository) ListAll(ctx context.Context, tenantID string) ([]*model.A
 	return r.multipleFromEntities(entities)
 }
 
+// ListAllByIDs retrieves all applications with the given IDs
+func (r *pgRepository) ListAllByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Application, error) {
+	if len(ids) == 0 {
+		return []*model.Application{}, nil
+	}
+
+	var entities EntityCollection
+	if err := r.lister.List(ctx, resource.Application, tenantID, &entities, repo.NewInConditionForStringValues("id", ids)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 // ListAllByFilter retrieves all applications matching on the given label filters
 func (r *pgRepository) ListAllByFilter(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Application, error) {
 	var entities EntityCollection
@@ -236,7 +252,7 @@ func (r *pgRepository) ListAllByFilter(ctx context.Context, tenant string, filte
 }
 
 // List missing godoc
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -252,7 +268,12 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Application, tenant, pageSize, cursor, "id", &appsCollection, conditions...)
+	orderBy, err := repo.NewOrderByForPage(order, orderColumns, repo.NewAscOrderBy("id"))
+	if err != nil {
+		return nil, err
+	}
+
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Application, tenant, pageSize, cursor, orderBy, &appsCollection, conditions...)
 
 	if err != nil {
 		return nil, err
@@ -274,7 +295,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 func (r *pgRepository) ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
 
-	page, totalCount, err := r.globalPageableQuerier.ListGlobal(ctx, pageSize, cursor, "id", &appsCollection)
+	page, totalCount, err := r.globalPageableQuerier.ListGlobal(ctx, pageSize, cursor, repo.NewAscOrderBy("id"), &appsCollection)
 
 	if err != nil {
 		return nil, err
@@ -330,7 +351,7 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", combinedQuery, combinedArgs))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Application, tenant.String(), pageSize, cursor, "id", &appsCollection, conditions...)
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Application, tenant.String(), pageSize, cursor, repo.NewAscOrderBy("id"), &appsCollection, conditions...)
 
 	if err != nil {
 		return nil, err
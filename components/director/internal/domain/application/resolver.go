@@ -23,6 +23,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/pkg/errors"
 )
@@ -34,14 +35,15 @@ type ApplicationService interface {
 	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
 	Get(ctx context.Context, id string) (*model.Application, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, applicationID string, key string) error
 	Unpair(ctx context.Context, id string) error
-	Merge(ctx context.Context, destID, sourceID string) (*model.Application, error)
+	Merge(ctx context.Context, destID, sourceID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.Application, error)
+	MergePlan(ctx context.Context, destID, sourceID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.ApplicationMergePlan, error)
 }
 
 // ApplicationConverter missing godoc
@@ -52,6 +54,8 @@ type ApplicationConverter interface {
 	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
 	UpdateInputFromGraphQL(in graphql.ApplicationUpdateInput) model.ApplicationUpdateInput
 	GraphQLToModel(obj *graphql.Application, tenantID string) *model.Application
+	MergePlanToGraphQL(in *model.ApplicationMergePlan) (*graphql.ApplicationMergePlan, error)
+	MergeConflictPolicyFromGraphQL(in *graphql.ApplicationMergeConflictPolicy) model.ApplicationMergeConflictPolicy
 }
 
 // EventingService missing godoc
@@ -98,7 +102,7 @@ type OAuth20Service interface {
 // RuntimeService missing godoc
 //go:generate mockery --name=RuntimeService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type RuntimeService interface {
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error)
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 }
 
@@ -107,6 +111,7 @@ type RuntimeService interface {
 type BundleService interface {
 	GetForApplication(ctx context.Context, id string, applicationID string) (*model.Bundle, error)
 	ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error)
+	ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error)
 	CreateMultiple(ctx context.Context, applicationID string, in []*model.BundleCreateInput) error
 }
 
@@ -170,8 +175,11 @@ func NewResolver(transact persistence.Transactioner,
 }
 
 // Applications missing godoc
-func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
-	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	labelFilter, err := labelfilter.MultipleFromGraphQLWithExpression(filter, labelExpression)
+	if err != nil {
+		return nil, err
+	}
 
 	var cursor string
 	if after != nil {
@@ -189,7 +197,7 @@ func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilt
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	appPage, err := r.appSvc.List(ctx, labelFilter, *first, cursor)
+	appPage, err := r.appSvc.List(ctx, labelFilter, pageorder.FromGraphQL(orderBy), *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -445,8 +453,9 @@ func (r *Resolver) SetApplicationLabel(ctx context.Context, applicationID string
 }
 
 // MergeApplications merges properties from Source Application into Destination Application, provided that the Destination's
-// Application does not have a value set for a given property. Then the Source Application is being deleted.
-func (r *Resolver) MergeApplications(ctx context.Context, destID string, sourceID string) (*graphql.Application, error) {
+// Application does not have a value set for a given property. Label conflicts are resolved according to the conflict policy.
+// Then the Source Application is being deleted.
+func (r *Resolver) MergeApplications(ctx context.Context, destID string, sourceID string, conflictPolicy *graphql.ApplicationMergeConflictPolicy) (*graphql.Application, error) {
 	log.C(ctx).Infof("Merging source app with id %s into destination app with id %s", sourceID, destID)
 
 	tx, err := r.transact.Begin()
@@ -457,7 +466,7 @@ func (r *Resolver) MergeApplications(ctx context.Context, destID string, sourceI
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	mergedApp, err := r.appSvc.Merge(ctx, destID, sourceID)
+	mergedApp, err := r.appSvc.Merge(ctx, destID, sourceID, r.appConverter.MergeConflictPolicyFromGraphQL(conflictPolicy))
 	if err != nil {
 		return nil, err
 	}
@@ -472,6 +481,29 @@ func (r *Resolver) MergeApplications(ctx context.Context, destID string, sourceI
 	return gqlApp, nil
 }
 
+// ApplicationsMergePlan returns the changes which merging the Source Application into the Destination Application
+// would make, without applying them
+func (r *Resolver) ApplicationsMergePlan(ctx context.Context, destID string, sourceID string, conflictPolicy *graphql.ApplicationMergeConflictPolicy) (*graphql.ApplicationMergePlan, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	plan, err := r.appSvc.MergePlan(ctx, destID, sourceID, r.appConverter.MergeConflictPolicyFromGraphQL(conflictPolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.appConverter.MergePlanToGraphQL(plan)
+}
+
 // DeleteApplicationLabel missing godoc
 func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID string, key string) (*graphql.Label, error) {
 	tx, err := r.transact.Begin()
@@ -24,6 +24,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/pkg/errors"
@@ -721,6 +722,8 @@ func TestResolver_MergeApplications(t *testing.T) {
 	gqlApplication := fixGQLApplication(destAppID, "Foo", "Lorem ipsum")
 
 	testErr := errors.New("Test error")
+	gqlPolicy := graphql.ApplicationMergeConflictPolicyPreferSource
+	policy := model.ApplicationMergeConflictPolicyPreferSource
 
 	testCases := []struct {
 		Name                   string
@@ -737,13 +740,14 @@ func TestResolver_MergeApplications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ApplicationConverterFn: func() *automock.ApplicationConverter {
 				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
 				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
 
 				return conv
 			},
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelApplication, nil).Once()
+				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(modelApplication, nil).Once()
 
 				return svc
 			},
@@ -783,13 +787,14 @@ func TestResolver_MergeApplications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ApplicationConverterFn: func() *automock.ApplicationConverter {
 				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
 				conv.AssertNotCalled(t, "ToGraphQL")
 
 				return conv
 			},
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(modelApplication, nil).Once()
+				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(modelApplication, nil).Once()
 
 				return svc
 			},
@@ -800,13 +805,14 @@ func TestResolver_MergeApplications(t *testing.T) {
 			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
 			ApplicationConverterFn: func() *automock.ApplicationConverter {
 				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
 				conv.AssertNotCalled(t, "ToGraphQL")
 
 				return conv
 			},
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID).Return(nil, testErr).Once()
+				svc.On("Merge", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(nil, testErr).Once()
 
 				return svc
 			},
@@ -827,19 +833,155 @@ func TestResolver_MergeApplications(t *testing.T) {
 			resolver := application.NewResolver(mockTransactioner, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil)
 
 			// WHEN
-			result, err := resolver.MergeApplications(context.TODO(), destAppID, srcAppID)
+			result, err := resolver.MergeApplications(context.TODO(), destAppID, srcAppID, &gqlPolicy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
 			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
 			mockPersistence.AssertExpectations(t)
 			mockTransactioner.AssertExpectations(t)
 		})
 	}
 }
 
+func TestResolver_ApplicationsMergePlan(t *testing.T) {
+	// GIVEN
+	srcAppID := "srcID"
+	destAppID := "destID"
+	testErr := errors.New("Test error")
+	gqlPolicy := graphql.ApplicationMergeConflictPolicyFail
+	policy := model.ApplicationMergeConflictPolicyFail
+
+	modelPlan := &model.ApplicationMergePlan{
+		DestinationID:  destAppID,
+		SourceID:       srcAppID,
+		ConflictPolicy: policy,
+		Labels: []*model.ApplicationMergeLabel{
+			{Key: "foo", Action: model.ApplicationMergeLabelActionKept, Conflict: true, SourceValue: "bar", DestinationValue: "baz", Value: "baz"},
+		},
+	}
+	gqlPlan := &graphql.ApplicationMergePlan{
+		DestinationID:  destAppID,
+		SourceID:       srcAppID,
+		ConflictPolicy: gqlPolicy,
+		Labels: []*graphql.ApplicationMergeLabel{
+			{Key: "foo", Action: graphql.ApplicationMergeLabelActionKept, Conflict: true, SourceValue: "bar", DestinationValue: "baz", Value: "baz"},
+		},
+		AddedScenarios: []string{},
+		Conflicts:      []string{"foo"},
+		RemovedBundles: []*graphql.Bundle{},
+	}
+
+	testCases := []struct {
+		Name                   string
+		PersistenceFn          func() *persistenceautomock.PersistenceTx
+		TransactionerFn        func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
+		ServiceFn              func() *automock.ApplicationService
+		ApplicationConverterFn func() *automock.ApplicationConverter
+		ExpectedResult         *graphql.ApplicationMergePlan
+		ExpectedErr            error
+	}{
+		{
+			Name:            "Success",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ApplicationConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
+				conv.On("MergePlanToGraphQL", modelPlan).Return(gqlPlan, nil).Once()
+				return conv
+			},
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("MergePlan", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(modelPlan, nil).Once()
+				return svc
+			},
+			ExpectedResult: gqlPlan,
+		},
+		{
+			Name:          "Returns error when transaction begin fails",
+			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(nil, testErr).Once()
+				return transact
+			},
+			ApplicationConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when MergePlan fails",
+			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ApplicationConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
+				return conv
+			},
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("MergePlan", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error on committing transaction",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				persistTx.On("Commit").Return(testErr).Once()
+				return persistTx
+			},
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ApplicationConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MergeConflictPolicyFromGraphQL", &gqlPolicy).Return(policy).Once()
+				return conv
+			},
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("MergePlan", txtest.CtxWithDBMatcher(), destAppID, srcAppID, policy).Return(modelPlan, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			converter := testCase.ApplicationConverterFn()
+
+			mockPersistence := testCase.PersistenceFn()
+			mockTransactioner := testCase.TransactionerFn(mockPersistence)
+
+			resolver := application.NewResolver(mockTransactioner, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.ApplicationsMergePlan(context.TODO(), destAppID, srcAppID, &gqlPolicy)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, svc, converter, mockPersistence, mockTransactioner)
+		})
+	}
+}
+
 func TestResolver_Application(t *testing.T) {
 	// GIVEN
 	modelApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
@@ -958,6 +1100,18 @@ func TestResolver_Applications(t *testing.T) {
 	gqlFilter := []*graphql.LabelFilter{
 		{Key: "", Query: &query},
 	}
+	expression := `runtimeType IN ("kyma", "cf") AND NOT region = "eu10"`
+	parsedExpression, err := labelfilter.ParseExpression(expression)
+	require.NoError(t, err)
+	expressionFilter := []*labelfilter.LabelFilter{
+		{Key: "", Query: &query},
+		labelfilter.NewForExpression(parsedExpression),
+	}
+	invalidExpression := `runtimeType =`
+	_, invalidExpressionErr := labelfilter.ParseExpression(invalidExpression)
+	require.Error(t, invalidExpressionErr)
+	descending := graphql.PageOrderDirectionDesc
+	gqlOrder := &graphql.PageOrder{Field: graphql.PageOrderFieldCreatedAt, Direction: &descending}
 	testErr := errors.New("Test error")
 
 	testCases := []struct {
@@ -967,6 +1121,8 @@ func TestResolver_Applications(t *testing.T) {
 		ServiceFn         func() *automock.ApplicationService
 		ConverterFn       func() *automock.ApplicationConverter
 		InputLabelFilters []*graphql.LabelFilter
+		InputExpression   *string
+		InputOrderBy      *graphql.PageOrder
 		ExpectedResult    *graphql.ApplicationPage
 		ExpectedErr       error
 	}{
@@ -976,7 +1132,7 @@ func TestResolver_Applications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				svc.On("List", contextParam, filter, (*pageorder.Order)(nil), first, after).Return(fixApplicationPage(modelApplications), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -994,7 +1150,7 @@ func TestResolver_Applications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, (*pageorder.Order)(nil), first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -1005,6 +1161,59 @@ func TestResolver_Applications(t *testing.T) {
 			ExpectedResult:    nil,
 			ExpectedErr:       testErr,
 		},
+		{
+			Name:            "Success with label filter expression",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("List", contextParam, expressionFilter, (*pageorder.Order)(nil), first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MultipleToGraphQL", modelApplications).Return(gqlApplications).Once()
+				return conv
+			},
+			InputLabelFilters: gqlFilter,
+			InputExpression:   &expression,
+			ExpectedResult:    fixGQLApplicationPage(gqlApplications),
+			ExpectedErr:       nil,
+		},
+		{
+			Name:            "Success with page order",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("List", contextParam, filter, pageorder.NewDesc(pageorder.FieldCreatedAt), first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MultipleToGraphQL", modelApplications).Return(gqlApplications).Once()
+				return conv
+			},
+			InputLabelFilters: gqlFilter,
+			InputOrderBy:      gqlOrder,
+			ExpectedResult:    fixGQLApplicationPage(gqlApplications),
+			ExpectedErr:       nil,
+		},
+		{
+			Name:            "Returns error when label filter expression is invalid",
+			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: txtest.NoopTransactioner,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			InputLabelFilters: gqlFilter,
+			InputExpression:   &invalidExpression,
+			ExpectedResult:    nil,
+			ExpectedErr:       invalidExpressionErr,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1018,7 +1227,7 @@ func TestResolver_Applications(t *testing.T) {
 			resolver.SetConverter(converter)
 
 			// WHEN
-			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, &first, &gqlAfter)
+			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, testCase.InputExpression, testCase.InputOrderBy, &first, &gqlAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
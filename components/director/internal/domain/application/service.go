@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/imdario/mergo"
@@ -28,6 +30,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
@@ -55,8 +58,9 @@ type ApplicationRepository interface {
 	GetGlobalByID(ctx context.Context, id string) (*model.Application, error)
 	GetByNameAndSystemNumber(ctx context.Context, tenant, name, systemNumber string) (*model.Application, error)
 	GetByFilter(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) (*model.Application, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListAll(ctx context.Context, tenant string) ([]*model.Application, error)
+	ListAllByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Application, error)
 	ListAllByFilter(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Application, error)
 	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error)
@@ -93,6 +97,12 @@ type FormationService interface {
 	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error)
 }
 
+// ChangeEventService is responsible for publishing the change events of the applications
+//go:generate mockery --name=ChangeEventService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ChangeEventService interface {
+	Publish(ctx context.Context, tenantID string, in model.ChangeEventInput) error
+}
+
 // RuntimeRepository missing godoc
 //go:generate mockery --name=RuntimeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type RuntimeRepository interface {
@@ -148,12 +158,13 @@ type service struct {
 	bndlService        BundleService
 	timestampGen       timestamp.Generator
 	formationService   FormationService
+	changeEventService ChangeEventService
 
 	selfRegisterDistinguishLabelKey string
 }
 
 // NewService missing godoc
-func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, bndlService BundleService, uidService UIDService, formationService FormationService, selfRegisterDistinguishLabelKey string) *service {
+func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, bndlService BundleService, uidService UIDService, formationService FormationService, changeEventService ChangeEventService, selfRegisterDistinguishLabelKey string) *service {
 	return &service{
 		appNameNormalizer:               appNameNormalizer,
 		appHideCfgProvider:              appHideCfgProvider,
@@ -168,12 +179,13 @@ func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider Ap
 		uidService:                      uidService,
 		timestampGen:                    timestamp.DefaultGenerator,
 		formationService:                formationService,
+		changeEventService:              changeEventService,
 		selfRegisterDistinguishLabelKey: selfRegisterDistinguishLabelKey,
 	}
 }
 
 // List missing godoc
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
@@ -183,7 +195,7 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
 	}
 
-	return s.appRepo.List(ctx, appTenant, filter, pageSize, cursor)
+	return s.appRepo.List(ctx, appTenant, filter, order, pageSize, cursor)
 }
 
 // ListAll lists tenant scoped applications
@@ -495,7 +507,7 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationUpd
 
 	if in.IntegrationSystemID != nil {
 		intSysLabel := createLabel(intSysKey, *in.IntegrationSystemID, id)
-		err = s.SetLabel(ctx, intSysLabel)
+		err = s.setLabel(ctx, appTenant, intSysLabel)
 		if err != nil {
 			return errors.Wrapf(err, "while setting the integration system label for %s with id %s", intSysLabel.ObjectType, intSysLabel.ObjectID)
 		}
@@ -503,12 +515,13 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationUpd
 	}
 
 	label := createLabel(nameKey, s.appNameNormalizer.Normalize(app.Name), app.ID)
-	err = s.SetLabel(ctx, label)
+	err = s.setLabel(ctx, appTenant, label)
 	if err != nil {
 		return errors.Wrap(err, "while setting application name label")
 	}
 	log.C(ctx).Debugf("Successfully set Label for Application with id %s", app.ID)
-	return nil
+
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeUpdated, app.ID, "")
 }
 
 // Upsert persists application or update it if it already exists
@@ -555,7 +568,11 @@ func (s *service) UpdateBaseURL(ctx context.Context, appID, targetURL string) er
 
 	app.BaseURL = str.Ptr(fmt.Sprintf("%s://%s", parsedTargetURL.Scheme, parsedTargetURL.Host))
 
-	return s.appRepo.Update(ctx, appTenant, app)
+	if err = s.appRepo.Update(ctx, appTenant, app); err != nil {
+		return err
+	}
+
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeUpdated, app.ID, "")
 }
 
 // TrustedUpsert persists application or update it if it already exists ignoring tenant isolation
@@ -606,6 +623,11 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	// The event is published before the deletion, so that the tenants with access and the scenarios of the application are still known
+	if err = s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeDeleted, id, ""); err != nil {
+		return err
+	}
+
 	err = s.appRepo.Delete(ctx, appTenant, id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Application with id %s", id)
@@ -642,7 +664,7 @@ func (s *service) Unpair(ctx context.Context, id string) error {
 		return err
 	}
 
-	return nil
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeUpdated, id, "")
 }
 
 // SetLabel updates application label with given input label
@@ -654,24 +676,11 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	appExists, err := s.appRepo.Exists(ctx, appTenant, labelInput.ObjectID)
-	if err != nil {
-		return errors.Wrap(err, "while checking Application existence")
-	}
-	if !appExists {
-		return apperrors.NewNotFoundError(resource.Application, labelInput.ObjectID)
-	}
-
-	if labelInput.Key == model.ScenariosKey {
-		return s.setScenarioLabel(ctx, appTenant, labelInput)
-	}
-
-	err = s.labelUpsertService.UpsertLabel(ctx, appTenant, labelInput)
-	if err != nil {
-		return errors.Wrapf(err, "while creating label for Application")
+	if err = s.setLabel(ctx, appTenant, labelInput); err != nil {
+		return err
 	}
 
-	return nil
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeLabelChanged, labelInput.ObjectID, labelInput.Key)
 }
 
 // GetLabel missing godoc
@@ -748,7 +757,7 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 		if err = s.unassignFormations(ctx, appTenant, applicationID, scenarios, allowAllCriteria); err != nil {
 			return errors.Wrapf(err, "while unassigning formations")
 		}
-		return nil
+		return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeLabelChanged, applicationID, key)
 	}
 
 	err = s.labelRepo.Delete(ctx, appTenant, model.ApplicationLabelableObject, applicationID, key)
@@ -756,35 +765,95 @@ func (s *service) DeleteLabel(ctx context.Context, applicationID string, key str
 		return errors.Wrapf(err, "while deleting Application label")
 	}
 
-	return nil
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeLabelChanged, applicationID, key)
 }
 
 // Merge merges properties from Source Application into Destination Application, provided that the Destination's
-// Application does not have a value set for a given property. Then the Source Application is being deleted.
-func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Application, error) {
+// Application does not have a value set for a given property. Labels which both applications have with different values
+// are resolved according to the conflict policy. Then the Source Application is being deleted.
+func (s *service) Merge(ctx context.Context, destID, srcID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.Application, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	destApp, plan, err := s.prepareMerge(ctx, appTenant, destID, srcID, conflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	if conflicts := plan.Conflicts(); plan.ConflictPolicy == model.ApplicationMergeConflictPolicyFail && len(conflicts) > 0 {
+		return nil, apperrors.NewInvalidDataError("applications %s and %s have conflicting labels: %s", destID, srcID, strings.Join(conflicts, ", "))
+	}
+
+	log.C(ctx).Infof("Deleting source application with id %s", srcID)
+	if err := s.Delete(ctx, srcID); err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Updating destination app with id %s", srcID)
+	if err := s.appRepo.Update(ctx, appTenant, destApp); err != nil {
+		return nil, err
+	}
+
+	if err := s.labelUpsertService.UpsertMultipleLabels(ctx, appTenant, model.ApplicationLabelableObject, destID, plan.LabelValues()); err != nil {
+		return nil, err
+	}
+
+	if err := s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeUpdated, destID, ""); err != nil {
+		return nil, err
+	}
+
+	return s.appRepo.GetByID(ctx, appTenant, destID)
+}
+
+// MergePlan returns the changes which merging the Source Application into the Destination Application would make,
+// without applying them. Label conflicts are reported in the plan even if the conflict policy rejects them.
+func (s *service) MergePlan(ctx context.Context, destID, srcID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.ApplicationMergePlan, error) {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
+	_, plan, err := s.prepareMerge(ctx, appTenant, destID, srcID, conflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles, err := s.bndlService.ListByApplicationIDNoPaging(ctx, srcID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing bundles for Application with id %s", srcID)
+	}
+	plan.RemovedBundles = bundles
+
+	return plan, nil
+}
+
+// prepareMerge validates that the Source Application can be merged into the Destination Application and returns
+// the merged Destination Application together with the plan for its labels
+func (s *service) prepareMerge(ctx context.Context, appTenant, destID, srcID string, conflictPolicy model.ApplicationMergeConflictPolicy) (*model.Application, *model.ApplicationMergePlan, error) {
+	if conflictPolicy == "" {
+		conflictPolicy = model.ApplicationMergeConflictPolicyPreferDestination
+	}
+
 	destApp, err := s.Get(ctx, destID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting destination application")
+		return nil, nil, errors.Wrapf(err, "while getting destination application")
 	}
 
 	srcApp, err := s.Get(ctx, srcID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting source application")
+		return nil, nil, errors.Wrapf(err, "while getting source application")
 	}
 
 	destAppLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.ApplicationLabelableObject, destID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting labels for Application with id %s", destID)
+		return nil, nil, errors.Wrapf(err, "while getting labels for Application with id %s", destID)
 	}
 
 	srcAppLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.ApplicationLabelableObject, srcID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting labels for Application with id %s", srcID)
+		return nil, nil, errors.Wrapf(err, "while getting labels for Application with id %s", srcID)
 	}
 
 	if destAppLabels == nil {
@@ -798,130 +867,190 @@ func (s *service) Merge(ctx context.Context, destID, srcID string) (*model.Appli
 	srcBaseURL := strings.TrimSuffix(str.PtrStrToStr(srcApp.BaseURL), urlSuffixToBeTrimmed)
 	destBaseURL := strings.TrimSuffix(str.PtrStrToStr(destApp.BaseURL), urlSuffixToBeTrimmed)
 	if len(srcBaseURL) == 0 || len(destBaseURL) == 0 || srcBaseURL != destBaseURL {
-		return nil, errors.Errorf("BaseURL for applications %s and %s are not the same. Destination app BaseURL: %s. Source app BaseURL: %s", destID, srcID, destBaseURL, srcBaseURL)
+		return nil, nil, errors.Errorf("BaseURL for applications %s and %s are not the same. Destination app BaseURL: %s. Source app BaseURL: %s", destID, srcID, destBaseURL, srcBaseURL)
 	}
 
 	srcTemplateID := str.PtrStrToStr(srcApp.ApplicationTemplateID)
 	destTemplateID := str.PtrStrToStr(destApp.ApplicationTemplateID)
 	if len(srcTemplateID) == 0 || len(destTemplateID) == 0 || srcTemplateID != destTemplateID {
-		return nil, errors.Errorf("Application templates are not the same. Destination app template: %s. Source app template: %s", destTemplateID, srcTemplateID)
+		return nil, nil, errors.Errorf("Application templates are not the same. Destination app template: %s. Source app template: %s", destTemplateID, srcTemplateID)
 	}
 
 	appTemplateLabels, err := s.labelRepo.ListForObject(ctx, appTenant, model.AppTemplateLabelableObject, srcTemplateID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while getting labels for app template with id %s", srcTemplateID)
+		return nil, nil, errors.Wrapf(err, "while getting labels for app template with id %s", srcTemplateID)
 	}
 
 	if _, exists := appTemplateLabels[s.selfRegisterDistinguishLabelKey]; exists {
 		log.C(ctx).Infof("applications should not be merged, because an application template with id %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey)
-		return nil, errors.Errorf("app template: %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey)
+		return nil, nil, errors.Errorf("app template: %s has label %s", srcTemplateID, s.selfRegisterDistinguishLabelKey)
 	}
 	if srcApp.Status == nil {
-		return nil, errors.Errorf("Could not determine status of source application with id %s", srcID)
+		return nil, nil, errors.Errorf("Could not determine status of source application with id %s", srcID)
 	}
 
 	if srcApp.Status.Condition != model.ApplicationStatusConditionInitial {
-		return nil, errors.Errorf("Cannot merge application with id %s, because it is in a %s status", srcID, model.ApplicationStatusConditionConnected)
+		return nil, nil, errors.Errorf("Cannot merge application with id %s, because it is in a %s status", srcID, model.ApplicationStatusConditionConnected)
 	}
 
 	log.C(ctx).Infof("Merging applications with ids %s and %s", destID, srcID)
 	if err := mergo.Merge(destApp, *srcApp); err != nil {
-		return nil, errors.Wrapf(err, "while trying to merge applications with ids %s and %s", destID, srcID)
+		return nil, nil, errors.Wrapf(err, "while trying to merge applications with ids %s and %s", destID, srcID)
 	}
 
 	log.C(ctx).Infof("Merging labels for applications with ids %s and %s", destID, srcID)
-	destAppLabelsMerged, err := s.handleMergeLabels(ctx, srcAppLabels, destAppLabels)
+	labels, addedScenarios, err := s.handleMergeLabels(ctx, srcAppLabels, destAppLabels, conflictPolicy)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while trying to merge labels for applications with ids %s and %s", destID, srcID)
+		return nil, nil, errors.Wrapf(err, "while trying to merge labels for applications with ids %s and %s", destID, srcID)
 	}
 
-	log.C(ctx).Infof("Deleting source application with id %s", srcID)
-	if err := s.Delete(ctx, srcID); err != nil {
-		return nil, err
+	return destApp, &model.ApplicationMergePlan{
+		DestinationID:  destID,
+		SourceID:       srcID,
+		ConflictPolicy: conflictPolicy,
+		Labels:         labels,
+		AddedScenarios: addedScenarios,
+	}, nil
+}
+
+// handleMergeLabels merges source labels into destination labels without modifying them. Labels which only one of the
+// applications has are taken as they are, while labels which both applications have with different values are resolved
+// according to the conflict policy. model.ScenariosKey is always combined as a union of both values. The managedKey label
+// is "true" if the destination or source label have a value "true"
+func (s *service) handleMergeLabels(ctx context.Context, srcAppLabels, destAppLabels map[string]*model.Label, conflictPolicy model.ApplicationMergeConflictPolicy) ([]*model.ApplicationMergeLabel, []string, error) {
+	keys := make([]string, 0, len(srcAppLabels)+len(destAppLabels))
+	for key := range destAppLabels {
+		keys = append(keys, key)
+	}
+	for key := range srcAppLabels {
+		if _, ok := destAppLabels[key]; !ok {
+			keys = append(keys, key)
+		}
 	}
+	sort.Strings(keys)
 
-	log.C(ctx).Infof("Updating destination app with id %s", srcID)
-	if err := s.appRepo.Update(ctx, appTenant, destApp); err != nil {
-		return nil, err
+	addedScenarios := make([]string, 0)
+	labels := make([]*model.ApplicationMergeLabel, 0, len(keys))
+	for _, key := range keys {
+		srcLabel, srcOk := srcAppLabels[key]
+		destLabel, destOk := destAppLabels[key]
+
+		mergeLabel := &model.ApplicationMergeLabel{Key: key}
+		if srcOk {
+			mergeLabel.SourceValue = srcLabel.Value
+		}
+		if destOk {
+			mergeLabel.DestinationValue = destLabel.Value
+		}
+
+		switch {
+		case !destOk:
+			mergeLabel.Action = model.ApplicationMergeLabelActionAdded
+			mergeLabel.Value = srcLabel.Value
+		case !srcOk:
+			mergeLabel.Action = model.ApplicationMergeLabelActionKept
+			mergeLabel.Value = destLabel.Value
+		case key == model.ScenariosKey || key == managedKey:
+			mergeLabel.Action = model.ApplicationMergeLabelActionCombined
+		case reflect.DeepEqual(srcLabel.Value, destLabel.Value):
+			mergeLabel.Action = model.ApplicationMergeLabelActionKept
+			mergeLabel.Value = destLabel.Value
+		case conflictPolicy == model.ApplicationMergeConflictPolicyPreferSource:
+			mergeLabel.Conflict = true
+			mergeLabel.Action = model.ApplicationMergeLabelActionOverwritten
+			mergeLabel.Value = srcLabel.Value
+		default:
+			mergeLabel.Conflict = true
+			mergeLabel.Action = model.ApplicationMergeLabelActionKept
+			mergeLabel.Value = destLabel.Value
+		}
+
+		labels = append(labels, mergeLabel)
 	}
 
-	if err := s.labelUpsertService.UpsertMultipleLabels(ctx, appTenant, model.ApplicationLabelableObject, destID, destAppLabelsMerged); err != nil {
-		return nil, err
+	for _, mergeLabel := range labels {
+		var err error
+		switch mergeLabel.Key {
+		case model.ScenariosKey:
+			addedScenarios, err = s.mergeScenarios(ctx, mergeLabel)
+		case managedKey:
+			err = s.mergeManaged(ctx, mergeLabel, srcAppLabels, destAppLabels)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	return s.appRepo.GetByID(ctx, appTenant, destID)
+	return labels, addedScenarios, nil
 }
 
-// handleMergeLabels merges source labels into destination labels. model.ScenariosKey is merged manually as well due to limitation
-// of the lib that is used. The last manually merged label is managedKey which is updated only if the destination or
-// source label have a value "true"
-func (s *service) handleMergeLabels(ctx context.Context, srcAppLabels, destAppLabels map[string]*model.Label) (map[string]interface{}, error) {
-	srcScenarios, ok := srcAppLabels[model.ScenariosKey]
-	if !ok {
+func (s *service) mergeScenarios(ctx context.Context, mergeLabel *model.ApplicationMergeLabel) ([]string, error) {
+	srcScenarios := mergeLabel.SourceValue
+	if srcScenarios == nil {
 		log.C(ctx).Infof("No %q label found in source object.", model.ScenariosKey)
-		srcScenarios = &model.Label{Value: make([]interface{}, 0)}
+		srcScenarios = make([]interface{}, 0)
 	}
 
-	destScenarios, ok := destAppLabels[model.ScenariosKey]
-	if !ok {
+	destScenarios := mergeLabel.DestinationValue
+	if destScenarios == nil {
 		log.C(ctx).Infof("No %q label found in destination object.", model.ScenariosKey)
-		destScenarios = &model.Label{Value: make([]interface{}, 0)}
+		destScenarios = make([]interface{}, 0)
 	}
 
-	srcScenariosStrSlice, err := label.ValueToStringsSlice(srcScenarios.Value)
+	srcScenariosStrSlice, err := label.ValueToStringsSlice(srcScenarios)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting source application labels to string slice")
 	}
 
-	destScenariosStrSlice, err := label.ValueToStringsSlice(destScenarios.Value)
+	destScenariosStrSlice, err := label.ValueToStringsSlice(destScenarios)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while converting destination application labels to string slice")
 	}
 
+	addedScenarios := make([]string, 0)
 	for _, srcScenario := range srcScenariosStrSlice {
 		if !str.ContainsInSlice(destScenariosStrSlice, srcScenario) {
 			destScenariosStrSlice = append(destScenariosStrSlice, srcScenario)
+			addedScenarios = append(addedScenarios, srcScenario)
 		}
 	}
 
-	if err := mergo.Merge(&destAppLabels, srcAppLabels); err != nil {
-		return nil, errors.Wrapf(err, "while trying to merge labels")
-	}
+	mergeLabel.Value = destScenariosStrSlice
 
-	destAppLabels[model.ScenariosKey].Value = destScenariosStrSlice
+	return addedScenarios, nil
+}
 
-	srcLabelManaged, ok := srcAppLabels[managedKey]
-	if !ok {
-		log.C(ctx).Infof("No %q label found in source object.", managedKey)
-		srcLabelManaged = &model.Label{Value: "false"}
-	}
+func (s *service) mergeManaged(ctx context.Context, mergeLabel *model.ApplicationMergeLabel, srcAppLabels, destAppLabels map[string]*model.Label) error {
+	srcLabelManagedValue, destLabelManagedValue := false, false
 
-	srcLabelManagedValue, err := str.CastToBool(srcLabelManaged.Value)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while converting %s value for source label with ID: %s", managedKey, srcAppLabels[managedKey].ID)
+	if mergeLabel.SourceValue == nil {
+		log.C(ctx).Infof("No %q label found in source object.", managedKey)
+	} else {
+		value, err := str.CastToBool(mergeLabel.SourceValue)
+		if err != nil {
+			return errors.Wrapf(err, "while converting %s value for source label with ID: %s", managedKey, srcAppLabels[managedKey].ID)
+		}
+		srcLabelManagedValue = value
 	}
 
-	destLabelManaged, ok := destAppLabels[managedKey]
-	if !ok {
+	if mergeLabel.DestinationValue == nil {
 		log.C(ctx).Infof("No %q label found in destination object.", managedKey)
-		destLabelManaged = &model.Label{Value: "false"}
+	} else {
+		value, err := str.CastToBool(mergeLabel.DestinationValue)
+		if err != nil {
+			return errors.Wrapf(err, "while converting %s value for destination label with ID: %s", managedKey, destAppLabels[managedKey].ID)
+		}
+		destLabelManagedValue = value
 	}
 
-	destLabelManagedValue, err := str.CastToBool(destLabelManaged.Value)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while converting %s value for destination label with ID: %s", managedKey, destAppLabels[managedKey].ID)
+	if mergeLabel.DestinationValue != nil {
+		mergeLabel.Value = mergeLabel.DestinationValue
 	}
-
 	if destLabelManagedValue || srcLabelManagedValue {
-		destAppLabels[managedKey].Value = "true"
-	}
-
-	conv := make(map[string]interface{}, len(destAppLabels))
-	for key, val := range destAppLabels {
-		conv[key] = val.Value
+		mergeLabel.Value = "true"
 	}
 
-	return conv, nil
+	return nil
 }
 
 // ensureApplicationNotPartOfScenarioWithRuntime Checks if an application has scenarios associated with it. if a runtime is part of any scenario, then the application is considered being used by that runtime.
@@ -1044,6 +1173,10 @@ func (s *service) genericCreate(ctx context.Context, in model.ApplicationRegiste
 		}
 	}
 
+	if err = s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeCreated, id, ""); err != nil {
+		return "", err
+	}
+
 	return id, nil
 }
 
@@ -1237,6 +1370,42 @@ func (s *service) genericUpsert(ctx context.Context, appTenant string, in model.
 		return errors.Wrapf(err, "while creating multiple labels for Application with id %s", id)
 	}
 
+	return s.publishChangeEvent(ctx, appTenant, model.ChangeEventTypeUpdated, id, "")
+}
+
+// setLabel sets the label without publishing a change event, so that it can be reused by operations which publish their own events
+func (s *service) setLabel(ctx context.Context, appTenant string, labelInput *model.LabelInput) error {
+	appExists, err := s.appRepo.Exists(ctx, appTenant, labelInput.ObjectID)
+	if err != nil {
+		return errors.Wrap(err, "while checking Application existence")
+	}
+	if !appExists {
+		return apperrors.NewNotFoundError(resource.Application, labelInput.ObjectID)
+	}
+
+	if labelInput.Key == model.ScenariosKey {
+		return s.setScenarioLabel(ctx, appTenant, labelInput)
+	}
+
+	err = s.labelUpsertService.UpsertLabel(ctx, appTenant, labelInput)
+	if err != nil {
+		return errors.Wrapf(err, "while creating label for Application")
+	}
+
+	return nil
+}
+
+func (s *service) publishChangeEvent(ctx context.Context, appTenant string, eventType model.ChangeEventType, appID, labelKey string) error {
+	err := s.changeEventService.Publish(ctx, appTenant, model.ChangeEventInput{
+		Type:         eventType,
+		ResourceType: resource.Application,
+		ResourceID:   appID,
+		LabelKey:     labelKey,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while publishing change event for Application with id %s", appID)
+	}
+
 	return nil
 }
 
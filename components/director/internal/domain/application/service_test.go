@@ -25,6 +25,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -728,7 +729,7 @@ func TestService_Create(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
 			bndlSvc := testCase.BundleServiceFn()
 			formationSvc := testCase.FormationServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, bndlSvc, uidSvc, formationSvc, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, bndlSvc, uidSvc, formationSvc, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// WHEN
@@ -754,7 +755,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
 		// WHEN
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1417,7 +1418,7 @@ func TestService_CreateFromTemplate(t *testing.T) {
 			intSysRepo := testCase.IntSysRepoFn()
 			bndlSvc := testCase.BundleServiceFn()
 			formationSvc := testCase.FormationServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, bndlSvc, uidSvc, formationSvc, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, bndlSvc, uidSvc, formationSvc, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// WHEN
@@ -1443,7 +1444,7 @@ func TestService_CreateFromTemplate(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
 		// WHEN
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1768,7 +1769,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 			labelSvc := testCase.LabelServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -1795,7 +1796,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 			labelSvc := testCase.LabelServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -1817,7 +1818,7 @@ func TestService_Upsert_TrustedUpsert(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -2143,7 +2144,7 @@ func TestService_TrustedUpsertFromTemplate(t *testing.T) {
 			labelSvc := testCase.LabelServiceFn()
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, labelSvc, scenariosSvc, nil, uidSvc, nil, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -2165,7 +2166,7 @@ func TestService_TrustedUpsertFromTemplate(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -2404,7 +2405,7 @@ func TestService_Update(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			lblUpsrtSvc := testCase.LabelUpsertSvcFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil, nil, "")
+			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(timestampGenFunc)
 
 			// WHEN
@@ -2535,7 +2536,7 @@ func TestService_UpdateBaseURL(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			resetModels()
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			err := svc.UpdateBaseURL(testCase.Context, testCase.InputID, testCase.TargetURL)
@@ -2593,6 +2594,7 @@ func TestService_Delete(t *testing.T) {
 		AppRepoFn          func() *automock.ApplicationRepository
 		LabelRepoFn        func() *automock.LabelRepository
 		RuntimeRepoFn      func() *automock.RuntimeRepository
+		ChangeEventSvcFn   func() *automock.ChangeEventService
 		Input              model.ApplicationRegisterInput
 		InputID            string
 		ExpectedErrMessage string
@@ -2683,6 +2685,31 @@ func TestService_Delete(t *testing.T) {
 			InputID:            id,
 			ExpectedErrMessage: formationAndRuntimeError.Error(),
 		},
+		{
+			Name: "Returns error when publishing change event failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, applicationModel.ID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, applicationModel.ID, model.ScenariosKey).Return(emptyScenarioLabel, nil)
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListAll", ctx, tnt, mock.Anything).Return([]*model.Runtime{}, nil)
+				return repo
+			},
+			ChangeEventSvcFn: func() *automock.ChangeEventService {
+				svc := &automock.ChangeEventService{}
+				svc.On("Publish", ctx, tnt, model.ChangeEventInput{Type: model.ChangeEventTypeDeleted, ResourceType: resource.Application, ResourceID: id}).Return(testErr).Once()
+				return svc
+			},
+			InputID:            id,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2690,7 +2717,11 @@ func TestService_Delete(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
 			labelRepo := testCase.LabelRepoFn()
 			runtimeRepo := testCase.RuntimeRepoFn()
-			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, nil, nil, nil, nil, nil, "")
+			changeEventSvc := changeEventServiceThatPublishes()
+			if testCase.ChangeEventSvcFn != nil {
+				changeEventSvc = testCase.ChangeEventSvcFn()
+			}
+			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, nil, nil, nil, nil, nil, changeEventSvc, "")
 
 			// WHEN
 			err := svc.Delete(ctx, testCase.InputID)
@@ -2703,7 +2734,7 @@ func TestService_Delete(t *testing.T) {
 				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
 			}
 
-			appRepo.AssertExpectations(t)
+			mock.AssertExpectationsForObjects(t, appRepo, changeEventSvc)
 		})
 	}
 }
@@ -2964,7 +2995,7 @@ func TestService_Unpair(t *testing.T) {
 			runtimeRepo := testCase.RuntimeRepoFn()
 			ctx := testCase.ContextFn()
 			ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
-			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 			// WHEN
 			err := svc.Unpair(ctx, testCase.InputID)
@@ -3010,11 +3041,18 @@ func TestService_Merge(t *testing.T) {
 	labelKey2 := "managed"
 	labelValue1 := []interface{}{"Easter", "Egg"}
 	labelValue2 := []interface{}{"Easter", "Bunny"}
+	conflictingKey := "conflicting"
 
 	upsertLabelValues := make(map[string]interface{})
 	upsertLabelValues[labelKey1] = []string{"Easter", "Bunny", "Egg"}
 	upsertLabelValues[labelKey2] = "true"
 
+	upsertLabelValuesWithSourceValue := map[string]interface{}{
+		labelKey1:      []string{"Easter", "Bunny", "Egg"},
+		labelKey2:      "true",
+		conflictingKey: "src",
+	}
+
 	upsertLabelValuesWithManagedFalse := make(map[string]interface{})
 	upsertLabelValuesWithManagedFalse[labelKey1] = []string{"Easter", "Bunny", "Egg"}
 	upsertLabelValuesWithManagedFalse[labelKey2] = "false"
@@ -3072,6 +3110,7 @@ func TestService_Merge(t *testing.T) {
 		Ctx                            context.Context
 		SourceID                       string
 		DestinationID                  string
+		ConflictPolicy                 model.ApplicationMergeConflictPolicy
 		ExpectedErrMessage             string
 	}{
 		{
@@ -3146,6 +3185,78 @@ func TestService_Merge(t *testing.T) {
 			ExpectedDestinationApplication: mergedDestModel,
 			ExpectedErrMessage:             "",
 		},
+		{
+			Name: "Success with source value when conflict policy prefers source",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, destModel.ID).Return(destModel, nil).Twice()
+				repo.On("GetByID", ctx, tnt, srcModel.ID).Return(srcModel, nil).Once()
+				repo.On("Exists", ctx, tnt, srcModel.ID).Return(true, nil).Once()
+				repo.On("Update", ctx, tnt, destModel).Return(nil).Once()
+				repo.On("Delete", ctx, tnt, srcModel.ID).Return(nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListAll", ctx, tnt, mock.Anything).Return([]*model.Runtime{}, nil)
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				srcLabels := fixApplicationLabels(srcID, labelKey1, labelKey2, labelValue1, "true")
+				srcLabels[conflictingKey] = &model.Label{Key: conflictingKey, Value: "src"}
+				destLabels := fixApplicationLabels(destID, labelKey1, labelKey2, labelValue2, "false")
+				destLabels[conflictingKey] = &model.Label{Key: conflictingKey, Value: "dest"}
+
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, srcModel.ID, model.ScenariosKey).Return(scenarioLabel, nil)
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, srcModel.ID).Return(srcLabels, nil)
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destModel.ID).Return(destLabels, nil)
+				repo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, *srcModel.ApplicationTemplateID).Return(map[string]*model.Label{}, nil)
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertMultipleLabels", ctx, tnt, model.ApplicationLabelableObject, destModel.ID, upsertLabelValuesWithSourceValue).Return(nil)
+				return svc
+			},
+			Ctx:                            ctx,
+			DestinationID:                  destID,
+			SourceID:                       srcID,
+			ConflictPolicy:                 model.ApplicationMergeConflictPolicyPreferSource,
+			ExpectedDestinationApplication: mergedDestModel,
+		},
+		{
+			Name: "Error when labels conflict and conflict policy is fail",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, tnt, destModel.ID).Return(destModel, nil).Once()
+				repo.On("GetByID", ctx, tnt, srcModel.ID).Return(srcModel, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				srcLabels := fixApplicationLabels(srcID, labelKey1, labelKey2, labelValue1, "true")
+				srcLabels[conflictingKey] = &model.Label{Key: conflictingKey, Value: "src"}
+				destLabels := fixApplicationLabels(destID, labelKey1, labelKey2, labelValue2, "false")
+				destLabels[conflictingKey] = &model.Label{Key: conflictingKey, Value: "dest"}
+
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, srcModel.ID).Return(srcLabels, nil)
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destModel.ID).Return(destLabels, nil)
+				repo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, *srcModel.ApplicationTemplateID).Return(map[string]*model.Label{}, nil)
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			Ctx:                ctx,
+			DestinationID:      destID,
+			SourceID:           srcID,
+			ConflictPolicy:     model.ApplicationMergeConflictPolicyFail,
+			ExpectedErrMessage: "applications foo and bar have conflicting labels: conflicting",
+		},
 		{
 			Name: "Error when tenant is not in context",
 			AppRepoFn: func() *automock.ApplicationRepository {
@@ -3488,10 +3599,10 @@ func TestService_Merge(t *testing.T) {
 			runtimeRepo := testCase.RuntimeRepoFn()
 			labelRepo := testCase.LabelRepoFn()
 			labelUpserSvc := testCase.LabelUpsertSvcFn()
-			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, labelUpserSvc, nil, nil, nil, nil, selfRegDistLabelKey)
+			svc := application.NewService(nil, nil, appRepo, nil, runtimeRepo, labelRepo, nil, labelUpserSvc, nil, nil, nil, nil, changeEventServiceThatPublishes(), selfRegDistLabelKey)
 
 			// WHEN
-			destApp, err := svc.Merge(testCase.Ctx, testCase.DestinationID, testCase.SourceID)
+			destApp, err := svc.Merge(testCase.Ctx, testCase.DestinationID, testCase.SourceID, testCase.ConflictPolicy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -3513,6 +3624,165 @@ func TestService_Merge(t *testing.T) {
 	}
 }
 
+func TestService_MergePlan(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	destID := "foo"
+	srcID := "bar"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	templateID := "12346789"
+	managedKey := "managed"
+
+	srcModel := fixDetailedModelApplication(t, srcID, tnt, "src app", "src description")
+	srcModel.ApplicationTemplateID = &templateID
+
+	destModel := fixModelApplication(destID, tnt, "dest app", "")
+	destModel.ApplicationTemplateID = &templateID
+	destModel.BaseURL = srcModel.BaseURL
+
+	srcLabels := map[string]*model.Label{
+		model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"Easter", "Egg"}},
+		managedKey:         {Key: managedKey, Value: "true"},
+		"foo":              {Key: "foo", Value: "src"},
+		"same":             {Key: "same", Value: "value"},
+		"source-only":      {Key: "source-only", Value: "src"},
+	}
+	destLabels := map[string]*model.Label{
+		model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"Easter", "Bunny"}},
+		managedKey:         {Key: managedKey, Value: "false"},
+		"foo":              {Key: "foo", Value: "dest"},
+		"same":             {Key: "same", Value: "value"},
+		"destination-only": {Key: "destination-only", Value: "dest"},
+	}
+
+	bundles := []*model.Bundle{{Name: "bundle", BaseEntity: &model.BaseEntity{ID: "bundle-id"}}}
+
+	ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+
+	expectedLabels := func(fooAction model.ApplicationMergeLabelAction, fooValue interface{}) []*model.ApplicationMergeLabel {
+		return []*model.ApplicationMergeLabel{
+			{Key: "destination-only", Action: model.ApplicationMergeLabelActionKept, DestinationValue: "dest", Value: "dest"},
+			{Key: "foo", Action: fooAction, Conflict: true, SourceValue: "src", DestinationValue: "dest", Value: fooValue},
+			{Key: managedKey, Action: model.ApplicationMergeLabelActionCombined, SourceValue: "true", DestinationValue: "false", Value: "true"},
+			{Key: "same", Action: model.ApplicationMergeLabelActionKept, SourceValue: "value", DestinationValue: "value", Value: "value"},
+			{Key: model.ScenariosKey, Action: model.ApplicationMergeLabelActionCombined, SourceValue: []interface{}{"Easter", "Egg"}, DestinationValue: []interface{}{"Easter", "Bunny"}, Value: []string{"Easter", "Bunny", "Egg"}},
+			{Key: "source-only", Action: model.ApplicationMergeLabelActionAdded, SourceValue: "src", Value: "src"},
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		ConflictPolicy     model.ApplicationMergeConflictPolicy
+		BundleSvcFn        func() *automock.BundleService
+		ExpectedPlan       *model.ApplicationMergePlan
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success with default conflict policy",
+			BundleSvcFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", ctx, srcID).Return(bundles, nil).Once()
+				return svc
+			},
+			ExpectedPlan: &model.ApplicationMergePlan{
+				DestinationID:  destID,
+				SourceID:       srcID,
+				ConflictPolicy: model.ApplicationMergeConflictPolicyPreferDestination,
+				Labels:         expectedLabels(model.ApplicationMergeLabelActionKept, "dest"),
+				AddedScenarios: []string{"Egg"},
+				RemovedBundles: bundles,
+			},
+		},
+		{
+			Name:           "Success when conflict policy prefers source",
+			ConflictPolicy: model.ApplicationMergeConflictPolicyPreferSource,
+			BundleSvcFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", ctx, srcID).Return(bundles, nil).Once()
+				return svc
+			},
+			ExpectedPlan: &model.ApplicationMergePlan{
+				DestinationID:  destID,
+				SourceID:       srcID,
+				ConflictPolicy: model.ApplicationMergeConflictPolicyPreferSource,
+				Labels:         expectedLabels(model.ApplicationMergeLabelActionOverwritten, "src"),
+				AddedScenarios: []string{"Egg"},
+				RemovedBundles: bundles,
+			},
+		},
+		{
+			Name:           "Success with conflicts reported when conflict policy is fail",
+			ConflictPolicy: model.ApplicationMergeConflictPolicyFail,
+			BundleSvcFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", ctx, srcID).Return(bundles, nil).Once()
+				return svc
+			},
+			ExpectedPlan: &model.ApplicationMergePlan{
+				DestinationID:  destID,
+				SourceID:       srcID,
+				ConflictPolicy: model.ApplicationMergeConflictPolicyFail,
+				Labels:         expectedLabels(model.ApplicationMergeLabelActionKept, "dest"),
+				AddedScenarios: []string{"Egg"},
+				RemovedBundles: bundles,
+			},
+		},
+		{
+			Name: "Error when listing bundles fails",
+			BundleSvcFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("ListByApplicationIDNoPaging", ctx, srcID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while listing bundles for Application with id bar",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dest := *destModel
+			appRepo := &automock.ApplicationRepository{}
+			appRepo.On("GetByID", ctx, tnt, destID).Return(&dest, nil).Once()
+			appRepo.On("GetByID", ctx, tnt, srcID).Return(srcModel, nil).Once()
+
+			labelRepo := &automock.LabelRepository{}
+			labelRepo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, srcID).Return(srcLabels, nil).Once()
+			labelRepo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, destID).Return(destLabels, nil).Once()
+			labelRepo.On("ListForObject", ctx, tnt, model.AppTemplateLabelableObject, templateID).Return(map[string]*model.Label{}, nil).Once()
+
+			bundleSvc := testCase.BundleSvcFn()
+
+			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, bundleSvc, nil, nil, nil, "subscriptionProviderId")
+
+			// WHEN
+			plan, err := svc.MergePlan(ctx, destID, srcID, testCase.ConflictPolicy)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPlan, plan)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, labelRepo, bundleSvc)
+		})
+	}
+
+	t.Run("Error when tenant is not in context", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+
+		// WHEN
+		_, err := svc.MergePlan(context.TODO(), destID, srcID, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
 func TestService_Get(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -3568,7 +3838,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.Get(ctx, testCase.InputID)
@@ -3648,7 +3918,7 @@ func TestService_GetSystem(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.GetSccSystem(testCase.Ctx, "id", locationID, virtualHost)
@@ -3688,6 +3958,7 @@ func TestService_List(t *testing.T) {
 	first := 2
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
+	order := pageorder.NewDesc(pageorder.FieldCreatedAt)
 
 	tnt := "tenant"
 	externalTnt := "external-tnt"
@@ -3707,7 +3978,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(applicationPage, nil).Once()
+				repo.On("List", ctx, tnt, filter, order, first, after).Return(applicationPage, nil).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -3719,7 +3990,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when application listing failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, order, first, after).Return(nil, testErr).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -3751,10 +4022,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
-			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
+			app, err := svc.List(ctx, testCase.InputLabelFilters, order, testCase.InputPageSize, after)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -3834,7 +4105,7 @@ func TestService_ListAll(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			defer mock.AssertExpectationsForObjects(t, repo)
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.ListAll(testCase.Context)
@@ -3935,7 +4206,7 @@ func TestService_ListGlobal(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.ListGlobal(ctx, testCase.InputPageSize, after)
@@ -4198,7 +4469,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			labelRepository := testCase.LabelRepositoryFn()
 			appRepository := testCase.AppRepositoryFn()
 			cfgProvider := testCase.ConfigProviderFn()
-			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			results, err := svc.ListByRuntimeID(ctx, testCase.Input, first, cursor)
@@ -4346,7 +4617,7 @@ func TestService_ListBySCC(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appRepo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, appRepo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.ListBySCC(testCase.Ctx, filter)
@@ -4442,7 +4713,7 @@ func TestService_ListSCCs(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, nil, nil, nil, repo, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, nil, nil, nil, repo, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			app, err := svc.ListSCCs(testCase.Ctx)
@@ -4517,7 +4788,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputApplicationID)
@@ -4780,7 +5051,7 @@ func TestService_SetLabel(t *testing.T) {
 			labelSvc := testCase.LabelServiceFn()
 			formationSvc := testCase.FormationServiceFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, labelSvc, nil, nil, nil, formationSvc, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, labelSvc, nil, nil, nil, formationSvc, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -4893,7 +5164,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -5006,7 +5277,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -5157,7 +5428,7 @@ func TestService_DeleteLabel(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			formationSvc := testCase.FormationServiceFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, formationSvc, "")
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, formationSvc, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			err := svc.DeleteLabel(ctx, testCase.InputApplicationID, testCase.InputKey)
@@ -5225,7 +5496,7 @@ func TestService_GetByNameAndSystemNumber(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, "")
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "")
 
 			// WHEN
 			value, err := svc.GetByNameAndSystemNumber(ctx, testCase.InputApplicationName, testCase.InputSystemNumber)
@@ -245,8 +245,15 @@ func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionI
 	placeholders := make([]model.ApplicationTemplatePlaceholder, 0, len(in))
 	for _, p := range in {
 		np := model.ApplicationTemplatePlaceholder{
-			Name:        p.Name,
-			Description: p.Description,
+			Name:          p.Name,
+			Description:   p.Description,
+			DefaultValue:  p.DefaultValue,
+			Optional:      p.Optional != nil && *p.Optional,
+			Pattern:       p.Pattern,
+			AllowedValues: p.AllowedValues,
+		}
+		if p.Type != nil {
+			np.Type = model.PlaceholderType(*p.Type)
 		}
 		placeholders = append(placeholders, np)
 	}
@@ -257,8 +264,13 @@ func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlacehol
 	placeholders := make([]*graphql.PlaceholderDefinition, 0, len(in))
 	for _, p := range in {
 		np := graphql.PlaceholderDefinition{
-			Name:        p.Name,
-			Description: p.Description,
+			Name:          p.Name,
+			Description:   p.Description,
+			Type:          graphql.PlaceholderType(p.ValueType()),
+			DefaultValue:  p.DefaultValue,
+			Optional:      p.Optional,
+			Pattern:       p.Pattern,
+			AllowedValues: p.AllowedValues,
 		}
 		placeholders = append(placeholders, &np)
 	}
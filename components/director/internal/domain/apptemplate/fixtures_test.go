@@ -333,6 +333,7 @@ func fixGQLPlaceholders() []*graphql.PlaceholderDefinition {
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
+			Type:        graphql.PlaceholderTypeString,
 		},
 	}
 }
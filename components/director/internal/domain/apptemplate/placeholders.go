@@ -0,0 +1,159 @@
+package apptemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+// renderedPlaceholder holds the representations of a placeholder value in the application input JSON
+type renderedPlaceholder struct {
+	// literal replaces a whole JSON string which consists only of the placeholder. It is empty for strings.
+	literal string
+	// escaped is embedded in a JSON string which contains the placeholder
+	escaped string
+}
+
+// validatePlaceholders checks the definitions of the placeholders of an Application Template and reports all invalid ones in a single error
+func validatePlaceholders(placeholders []model.ApplicationTemplatePlaceholder) error {
+	errs := validation.Errors{}
+	for _, placeholder := range placeholders {
+		if err := placeholder.Validate(); err != nil {
+			errs[placeholder.Name] = err
+		}
+	}
+
+	if err := errs.Filter(); err != nil {
+		return apperrors.NewInvalidDataError("invalid placeholder definitions: %s", err.Error())
+	}
+
+	return nil
+}
+
+// renderApplicationInputJSON substitutes the placeholders in the application input JSON with JSON-escaped values.
+// A string consisting only of a placeholder which is not a string is replaced with the typed value, so that "{{count}}" becomes 3.
+// All missing and invalid values are reported in a single error.
+func renderApplicationInputJSON(appInputJSON string, placeholders []model.ApplicationTemplatePlaceholder, values model.ApplicationFromTemplateInputValues) (string, error) {
+	rendered := make(map[string]renderedPlaceholder, len(placeholders))
+	errs := validation.Errors{}
+	for _, placeholder := range placeholders {
+		value, err := renderPlaceholder(placeholder, values)
+		if err != nil {
+			errs[placeholder.Name] = err
+			continue
+		}
+		rendered[placeholder.Name] = value
+	}
+
+	if err := errs.Filter(); err != nil {
+		return "", apperrors.NewInvalidDataError("invalid placeholder values: %s", err.Error())
+	}
+
+	return substitutePlaceholders(appInputJSON, rendered), nil
+}
+
+func renderPlaceholder(placeholder model.ApplicationTemplatePlaceholder, values model.ApplicationFromTemplateInputValues) (renderedPlaceholder, error) {
+	value, err := values.FindPlaceholderValue(placeholder.Name)
+	provided := err == nil && (value != "" || !placeholder.Optional)
+	if !provided {
+		switch {
+		case placeholder.DefaultValue != nil:
+			value = *placeholder.DefaultValue
+		case placeholder.Optional:
+			return renderMissingPlaceholder(placeholder.ValueType()), nil
+		default:
+			return renderedPlaceholder{}, errors.New("required placeholder not provided")
+		}
+	}
+
+	if err := placeholder.ValidateValue(value); err != nil {
+		return renderedPlaceholder{}, err
+	}
+
+	switch placeholder.ValueType() {
+	case model.PlaceholderTypeNumber, model.PlaceholderTypeBoolean:
+		return renderedPlaceholder{literal: value, escaped: value}, nil
+	case model.PlaceholderTypeJSON:
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, []byte(value)); err != nil {
+			return renderedPlaceholder{}, errors.Wrap(err, "while compacting JSON value")
+		}
+		return renderedPlaceholder{literal: compacted.String(), escaped: escapeJSONString(compacted.String())}, nil
+	default:
+		return renderedPlaceholder{escaped: escapeJSONString(value)}, nil
+	}
+}
+
+func renderMissingPlaceholder(placeholderType model.PlaceholderType) renderedPlaceholder {
+	if placeholderType == model.PlaceholderTypeString {
+		return renderedPlaceholder{}
+	}
+	return renderedPlaceholder{literal: "null"}
+}
+
+// substitutePlaceholders replaces the known placeholders in the JSON document. Placeholders are expected to be inside JSON strings.
+func substitutePlaceholders(in string, rendered map[string]renderedPlaceholder) string {
+	out := strings.Builder{}
+	i := 0
+	for i < len(in) {
+		start := strings.Index(in[i:], "{{")
+		if start < 0 {
+			break
+		}
+		start += i
+
+		end := strings.Index(in[start:], "}}")
+		if end < 0 {
+			break
+		}
+		end += start + len("}}")
+
+		value, ok := rendered[in[start+len("{{"):end-len("}}")]]
+		if !ok {
+			out.WriteString(in[i : start+len("{{")])
+			i = start + len("{{")
+			continue
+		}
+
+		isWholeString := start > i && in[start-1] == '"' && !isEscaped(in, start-1) && end < len(in) && in[end] == '"'
+		if isWholeString && value.literal != "" {
+			out.WriteString(in[i : start-1])
+			out.WriteString(value.literal)
+			i = end + 1
+			continue
+		}
+
+		out.WriteString(in[i:start])
+		out.WriteString(value.escaped)
+		i = end
+	}
+	out.WriteString(in[i:])
+
+	return out.String()
+}
+
+// isEscaped checks if the character at the index is preceded by an odd number of backslashes
+func isEscaped(in string, index int) bool {
+	backslashes := 0
+	for j := index - 1; j >= 0 && in[j] == '\\'; j-- {
+		backslashes++
+	}
+	return backslashes%2 == 1
+}
+
+// escapeJSONString returns the value escaped as the content of a JSON string, without the surrounding quotes
+func escapeJSONString(value string) string {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	// Encoding a string cannot fail
+	_ = encoder.Encode(value)
+
+	escaped := strings.TrimSuffix(buf.String(), "\n")
+	return escaped[1 : len(escaped)-1]
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -21,6 +22,7 @@ var (
 	updatableTableColumns = []string{"name", "description", "application_namespace", "application_input", "placeholders", "access_level"}
 	idTableColumns        = []string{"id"}
 	tableColumns          = append(idTableColumns, updatableTableColumns...)
+	orderColumns          = map[pageorder.Field]string{pageorder.FieldID: "id", pageorder.FieldName: "name"}
 )
 
 // EntityConverter missing godoc
@@ -113,7 +115,7 @@ func (r *repository) Exists(ctx context.Context, id string) (bool, error) {
 }
 
 // List missing godoc
-func (r *repository) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
+func (r *repository) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
 	var entityCollection EntityCollection
 
 	filterSubquery, args, err := label.FilterQueryGlobal(model.AppTemplateLabelableObject, label.IntersectSet, filter)
@@ -128,7 +130,12 @@ func (r *repository) List(ctx context.Context, filter []*labelfilter.LabelFilter
 
 	conditionsTree := repo.And(repo.ConditionTreesFromConditions(conditions)...)
 
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobalWithAdditionalConditions(ctx, pageSize, cursor, "id", &entityCollection, conditionsTree)
+	orderBy, err := repo.NewOrderByForPage(order, orderColumns, repo.NewAscOrderBy("id"))
+	if err != nil {
+		return model.ApplicationTemplatePage{}, err
+	}
+
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobalWithAdditionalConditions(ctx, pageSize, cursor, orderBy, &entityCollection, conditionsTree)
 	if err != nil {
 		return model.ApplicationTemplatePage{}, err
 	}
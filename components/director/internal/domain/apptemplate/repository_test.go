@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 
@@ -485,7 +486,7 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates WHERE id IN (SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL AND "key" = $1 AND "value" @> $2) ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates WHERE id IN (SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL AND "key" = $1 AND "value" @> $2) ORDER BY id ASC LIMIT 4`)).
 			WillReturnRows(rowsToReturn)
 		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
@@ -494,7 +495,7 @@ func TestRepository_List(t *testing.T) {
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.List(ctx, labelFilters, testPageSize, testCursor)
+		result, err := appTemplateRepo.List(ctx, labelFilters, nil, testPageSize, testCursor)
 
 		// THEN
 		require.NoError(t, err)
@@ -518,7 +519,7 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates WHERE id IN (SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL AND "key" = $1 AND "value" @> $2) ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates WHERE id IN (SELECT "app_template_id" FROM public.labels WHERE "app_template_id" IS NOT NULL AND "key" = $1 AND "value" @> $2) ORDER BY id ASC LIMIT 4`)).
 			WillReturnRows(rowsToReturn)
 		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
@@ -527,7 +528,7 @@ func TestRepository_List(t *testing.T) {
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, labelFilters, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, labelFilters, nil, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -540,18 +541,35 @@ func TestRepository_List(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_namespace, application_input, placeholders, access_level FROM public.app_templates ORDER BY id ASC LIMIT 4`)).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, nil, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, nil, nil, testPageSize, testCursor)
 
 		// THEN
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
+
+	t.Run("Error when ordering by unsupported field", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := appTemplateRepo.List(ctx, nil, pageorder.NewAsc(pageorder.FieldCreatedAt), testPageSize, testCursor)
+
+		// THEN
+		require.EqualError(t, err, "Invalid data [reason=ordering by CREATED_AT is not supported]")
+	})
 }
 
 func TestRepository_Update(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/internal/selfregmanager"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -37,7 +38,7 @@ type ApplicationTemplateService interface {
 	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
 	GetByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.ApplicationTemplate, error)
 	GetByNameAndRegion(ctx context.Context, name string, region interface{}) (*model.ApplicationTemplate, error)
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
 	ListByName(ctx context.Context, name string) ([]*model.ApplicationTemplate, error)
 	ListByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) ([]*model.ApplicationTemplate, error)
 	Update(ctx context.Context, id string, in model.ApplicationTemplateUpdateInput) error
@@ -156,8 +157,12 @@ func (r *Resolver) ApplicationTemplate(ctx context.Context, id string) (*graphql
 }
 
 // ApplicationTemplates missing godoc
-func (r *Resolver) ApplicationTemplates(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationTemplatePage, error) {
-	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+func (r *Resolver) ApplicationTemplates(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.ApplicationTemplatePage, error) {
+	labelFilter, err := labelfilter.MultipleFromGraphQLWithExpression(filter, labelExpression)
+	if err != nil {
+		return nil, err
+	}
+
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
@@ -174,7 +179,7 @@ func (r *Resolver) ApplicationTemplates(ctx context.Context, filter []*graphql.L
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	appTemplatePage, err := r.appTemplateSvc.List(ctx, labelFilter, *first, cursor)
+	appTemplatePage, err := r.appTemplateSvc.List(ctx, labelFilter, pageorder.FromGraphQL(orderBy), *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/apptmpltest"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
@@ -212,7 +213,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			TxFn:        txGen.ThatSucceeds,
 			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
-				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFilters, first, after).Return(modelPage, nil).Once()
+				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFilters, (*pageorder.Order)(nil), first, after).Return(modelPage, nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -229,7 +230,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			TxFn: txGen.ThatDoesntExpectCommit,
 			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
-				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, first, after).Return(model.ApplicationTemplatePage{}, testError).Once()
+				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, (*pageorder.Order)(nil), first, after).Return(model.ApplicationTemplatePage{}, testError).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: UnusedAppTemplateConv,
@@ -251,7 +252,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			TxFn: txGen.ThatFailsOnCommit,
 			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
-				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, first, after).Return(modelPage, nil).Once()
+				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, (*pageorder.Order)(nil), first, after).Return(modelPage, nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: UnusedAppTemplateConv,
@@ -264,7 +265,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			TxFn: txGen.ThatSucceeds,
 			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
 				appTemplateSvc := &automock.ApplicationTemplateService{}
-				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, first, after).Return(modelPage, nil).Once()
+				appTemplateSvc.On("List", txtest.CtxWithDBMatcher(), labelFiltersEmpty, (*pageorder.Order)(nil), first, after).Return(modelPage, nil).Once()
 				return appTemplateSvc
 			},
 			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
@@ -289,7 +290,7 @@ func TestResolver_ApplicationTemplates(t *testing.T) {
 			resolver := apptemplate.NewResolver(transact, nil, nil, appTemplateSvc, appTemplateConv, webhookSvc, webhookConverter, nil, nil)
 
 			// WHEN
-			result, err := resolver.ApplicationTemplates(ctx, testCase.LabelFilter, &first, &gqlAfter)
+			result, err := resolver.ApplicationTemplates(ctx, testCase.LabelFilter, nil, nil, &first, &gqlAfter)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -32,7 +32,7 @@ type ApplicationTemplateRepository interface {
 	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
 	GetByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.ApplicationTemplate, error)
 	Exists(ctx context.Context, id string) (bool, error)
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
 	ListByName(ctx context.Context, id string) ([]*model.ApplicationTemplate, error)
 	ListByFilters(ctx context.Context, filter []*labelfilter.LabelFilter) ([]*model.ApplicationTemplate, error)
 	Update(ctx context.Context, model model.ApplicationTemplate) error
@@ -96,6 +96,10 @@ func (s *service) Create(ctx context.Context, in model.ApplicationTemplateInput)
 
 	log.C(ctx).Debugf("ID %s generated for Application Template with name %s", appTemplateID, in.Name)
 
+	if err := validatePlaceholders(in.Placeholders); err != nil {
+		return "", err
+	}
+
 	applicationType, err := s.constructApplicationTypeLabelValue(in.Name, in.Labels)
 	if err != nil {
 		return "", err
@@ -258,16 +262,20 @@ func (s *service) Exists(ctx context.Context, id string) (bool, error) {
 }
 
 // List missing godoc
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
 	if pageSize < 1 || pageSize > 200 {
 		return model.ApplicationTemplatePage{}, apperrors.NewInvalidDataError("page size must be between 1 and 200")
 	}
 
-	return s.appTemplateRepo.List(ctx, filter, pageSize, cursor)
+	return s.appTemplateRepo.List(ctx, filter, order, pageSize, cursor)
 }
 
 // Update missing godoc
 func (s *service) Update(ctx context.Context, id string, in model.ApplicationTemplateUpdateInput) error {
+	if err := validatePlaceholders(in.Placeholders); err != nil {
+		return err
+	}
+
 	oldAppTemplate, err := s.Get(ctx, id)
 	if err != nil {
 		return err
@@ -319,17 +327,10 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// PrepareApplicationCreateInputJSON missing godoc
+// PrepareApplicationCreateInputJSON renders the application input JSON of the Application Template with the given placeholder values.
+// The values are validated against the placeholder definitions and JSON-escaped, and all invalid values are reported in a single error.
 func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error) {
-	appCreateInputJSON := appTemplate.ApplicationInputJSON
-	for _, placeholder := range appTemplate.Placeholders {
-		newValue, err := values.FindPlaceholderValue(placeholder.Name)
-		if err != nil {
-			return "", errors.Wrap(err, "required placeholder not provided")
-		}
-		appCreateInputJSON = strings.ReplaceAll(appCreateInputJSON, fmt.Sprintf("{{%s}}", placeholder.Name), newValue)
-	}
-	return appCreateInputJSON, nil
+	return renderApplicationInputJSON(appTemplate.ApplicationInputJSON, appTemplate.Placeholders, values)
 }
 
 func (s *service) retrieveLabel(ctx context.Context, id string, labelKey string) (interface{}, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
@@ -84,6 +85,22 @@ func TestService_Create(t *testing.T) {
 			LabelRepoFn:    UnusedLabelRepo,
 			ExpectedOutput: testID,
 		},
+		{
+			Name: "Error when placeholder definitions are invalid",
+			Input: func() *model.ApplicationTemplateInput {
+				in := fixModelAppTemplateInput(testName, appInputJSON)
+				in.Placeholders = []model.ApplicationTemplatePlaceholder{
+					{Name: "count", Type: model.PlaceholderTypeNumber, DefaultValue: str.Ptr("three")},
+					{Name: "region", Pattern: str.Ptr("[a-z")},
+				}
+				return in
+			},
+			AppTemplateRepoFn: UnusedAppTemplateRepo,
+			WebhookRepoFn:     UnusedWebhookRepo,
+			LabelUpsertSvcFn:  UnusedLabelUpsertSvc,
+			LabelRepoFn:       UnusedLabelRepo,
+			ExpectedError:     errors.New(`invalid placeholder definitions: count: invalid default value: value "three" is not a number; region: invalid pattern`),
+		},
 		{
 			Name: "Success without app input labels",
 			Input: func() *model.ApplicationTemplateInput {
@@ -1026,6 +1043,7 @@ func TestService_List(t *testing.T) {
 		fixModelApplicationTemplate("foo2", "bar2", fixModelApplicationTemplateWebhooks("webhook-id-2", "foo2")),
 	})
 	labelFilters := []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(RegionKey, "eu-1")}
+	order := pageorder.NewAsc(pageorder.FieldName)
 
 	testCases := []struct {
 		Name              string
@@ -1039,7 +1057,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, labelFilters, 50, testCursor).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("List", ctx, labelFilters, order, 50, testCursor).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			WebhookRepoFn:  UnusedWebhookRepo,
@@ -1050,7 +1068,7 @@ func TestService_List(t *testing.T) {
 			Name: "Error when listing application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, labelFilters, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
+				appTemplateRepo.On("List", ctx, labelFilters, order, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
 				return appTemplateRepo
 			},
 			WebhookRepoFn:  UnusedWebhookRepo,
@@ -1089,7 +1107,7 @@ func TestService_List(t *testing.T) {
 			svc := apptemplate.NewService(appTemplateRepo, webhookRepo, nil, nil, nil)
 
 			// WHEN
-			result, err := svc.List(ctx, labelFilters, testCase.InputPageSize, testCursor)
+			result, err := svc.List(ctx, labelFilters, order, testCase.InputPageSize, testCursor)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -1137,6 +1155,20 @@ func TestService_Update(t *testing.T) {
 				return labelRepo
 			},
 		},
+		{
+			Name: "Error when placeholder definitions are invalid",
+			Input: func() *model.ApplicationTemplateUpdateInput {
+				in := fixModelAppTemplateUpdateInput(testName, appInputJSON)
+				in.Placeholders = []model.ApplicationTemplatePlaceholder{
+					{Name: "env", AllowedValues: []string{"dev"}, DefaultValue: str.Ptr("prod")},
+				}
+				return in
+			},
+			AppTemplateRepoFn: UnusedAppTemplateRepo,
+			WebhookRepoFn:     UnusedWebhookRepo,
+			LabelRepoFn:       UnusedLabelRepo,
+			ExpectedError:     errors.New(`invalid placeholder definitions: env: invalid default value: value "prod" is not one of the allowed values ["dev"]`),
+		},
 		{
 			Name: "Success - app input json without labels",
 			Input: func() *model.ApplicationTemplateUpdateInput {
@@ -1446,7 +1478,76 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 			},
 			InputValues:    []*model.ApplicationTemplateValueInput{},
 			ExpectedOutput: "",
-			ExpectedError:  errors.New("required placeholder not provided: value for placeholder name 'name' not found"),
+			ExpectedError:  errors.New("invalid placeholder values: name: required placeholder not provided"),
+		},
+		{
+			Name: "Success escapes string values",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Description": "Application {{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: `my-app", "Labels": {"injected": "\true"}`},
+			},
+			ExpectedOutput: `{"Name": "my-app\", \"Labels\": {\"injected\": \"\\true\"}", "Description": "Application my-app\", \"Labels\": {\"injected\": \"\\true\"}"}`,
+		},
+		{
+			Name: "Success renders typed values",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Labels": {"count": "{{count}}", "enabled": "{{enabled}}", "config": "{{config}}", "summary": "{{count}} items"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+					{Name: "count", Type: model.PlaceholderTypeNumber},
+					{Name: "enabled", Type: model.PlaceholderTypeBoolean},
+					{Name: "config", Type: model.PlaceholderTypeJSON},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "my-app"},
+				{Placeholder: "count", Value: "3"},
+				{Placeholder: "enabled", Value: "true"},
+				{Placeholder: "config", Value: `{ "key": ["value"] }`},
+			},
+			ExpectedOutput: `{"Name": "my-app", "Labels": {"count": 3, "enabled": true, "config": {"key":["value"]}, "summary": "3 items"}}`,
+		},
+		{
+			Name: "Success uses default values and renders missing optional values",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Description": "{{description}}", "Labels": {"count": "{{count}}", "region": "{{region}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+					{Name: "description", Optional: true},
+					{Name: "count", Type: model.PlaceholderTypeNumber, Optional: true},
+					{Name: "region", DefaultValue: str.Ptr("eu-1")},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "my-app"},
+				{Placeholder: "description", Value: ""},
+			},
+			ExpectedOutput: `{"Name": "my-app", "Description": "", "Labels": {"count": null, "region": "eu-1"}}`,
+		},
+		{
+			Name: "Returns error with all invalid placeholder values",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Labels": {"count": "{{count}}", "enabled": "{{enabled}}", "region": "{{region}}", "env": "{{env}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+					{Name: "count", Type: model.PlaceholderTypeNumber},
+					{Name: "enabled", Type: model.PlaceholderTypeBoolean},
+					{Name: "region", Pattern: str.Ptr("[a-z]{2}-[0-9]")},
+					{Name: "env", AllowedValues: []string{"dev", "prod"}},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "count", Value: "three"},
+				{Placeholder: "enabled", Value: "yes"},
+				{Placeholder: "region", Value: "eu-10"},
+				{Placeholder: "env", Value: "test"},
+			},
+			ExpectedError: errors.New(`invalid placeholder values: count: value "three" is not a number; enabled: value "yes" is not a boolean; env: value "test" is not one of the allowed values ["dev" "prod"]; name: required placeholder not provided; region: value "eu-10" does not match pattern "[a-z]{2}-[0-9]".`),
 		},
 	}
 
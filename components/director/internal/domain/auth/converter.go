@@ -76,6 +76,7 @@ func (c *converter) ToGraphQL(in *model.Auth) (*graphql.Auth, error) {
 		AdditionalQueryParamsSerialized: paramsSerialized,
 		RequestAuth:                     c.requestAuthToGraphQL(in.RequestAuth),
 		CertCommonName:                  &in.CertCommonName,
+		ClientCertificateRef:            in.ClientCertificateRef,
 	}, nil
 }
 
@@ -128,6 +129,7 @@ func (c *converter) InputFromGraphQL(in *graphql.AuthInput) (*model.AuthInput, e
 		AdditionalHeaders:     additionalHeaders,
 		AdditionalQueryParams: additionalQueryParams,
 		RequestAuth:           reqAuth,
+		ClientCertificateRef:  in.ClientCertificateRef,
 	}, nil
 }
 
@@ -185,6 +187,7 @@ func (c *converter) ModelFromGraphQLInput(in graphql.AuthInput) (*model.Auth, er
 		AdditionalQueryParams: additionalQueryParams,
 		RequestAuth:           reqAuth,
 		CertCommonName:        str.PtrStrToStr(in.CertCommonName),
+		ClientCertificateRef:  in.ClientCertificateRef,
 	}, nil
 }
 
@@ -27,6 +27,7 @@ var (
 	authParamsSerialized         = graphql.QueryParamsSerialized(authMapSerialized)
 	invalidAuthParamsSerialized  = graphql.QueryParamsSerialized("invalid")
 	accessStrategy               = "testAccessStrategy"
+	clientCertificateRef         = "webhook-client-cert"
 )
 
 func fixDetailedAuth() *model.Auth {
@@ -55,6 +56,7 @@ func fixDetailedAuth() *model.Auth {
 				AdditionalQueryParams: authMap,
 			},
 		},
+		ClientCertificateRef: &clientCertificateRef,
 	}
 }
 
@@ -81,7 +83,8 @@ func fixDetailedGQLAuth() *graphql.Auth {
 				AdditionalQueryParams: authParams,
 			},
 		},
-		CertCommonName: &emptyCertCommonName,
+		CertCommonName:       &emptyCertCommonName,
+		ClientCertificateRef: &clientCertificateRef,
 	}
 }
 
@@ -111,6 +114,7 @@ func fixDetailedAuthInput() *model.AuthInput {
 				AdditionalQueryParams: authMap,
 			},
 		},
+		ClientCertificateRef: &clientCertificateRef,
 	}
 }
 
@@ -140,6 +144,7 @@ func fixDetailedGQLAuthInput() *graphql.AuthInput {
 				AdditionalQueryParamsSerialized: &authParamsSerialized,
 			},
 		},
+		ClientCertificateRef: &clientCertificateRef,
 	}
 }
 
@@ -169,6 +174,7 @@ func fixDetailedGQLAuthInputDeprecated() *graphql.AuthInput {
 				AdditionalQueryParams: authParams,
 			},
 		},
+		ClientCertificateRef: &clientCertificateRef,
 	}
 }
 
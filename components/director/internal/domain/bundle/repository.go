@@ -3,7 +3,6 @@ package bundle
 import (
 	"context"
 
-	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -146,7 +145,7 @@ func (r *pgRepository) GetForApplication(ctx context.Context, tenant string, id
 // ListByApplicationIDs missing godoc
 func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
 	var bundleCollection BundleCollection
-	counts, err := r.unionLister.List(ctx, resource.Bundle, tenantID, applicationIDs, "app_id", pageSize, cursor, orderByColumns, &bundleCollection)
+	counts, pages, err := r.unionLister.List(ctx, resource.Bundle, tenantID, applicationIDs, "app_id", pageSize, cursor, orderByColumns, &bundleCollection)
 	if err != nil {
 		return nil, err
 	}
@@ -160,28 +159,9 @@ func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string
 		bundleByID[bundleEnt.ApplicationID] = append(bundleByID[bundleEnt.ApplicationID], m)
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
-	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
-	}
-
 	bundlePages := make([]*model.BundlePage, 0, len(applicationIDs))
 	for _, appID := range applicationIDs {
-		totalCount := counts[appID]
-		hasNextPage := false
-		endCursor := ""
-		if totalCount > offset+len(bundleByID[appID]) {
-			hasNextPage = true
-			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
-		}
-
-		page := &pagination.Page{
-			StartCursor: cursor,
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		}
-
-		bundlePages = append(bundlePages, &model.BundlePage{Data: bundleByID[appID], TotalCount: totalCount, PageInfo: page})
+		bundlePages = append(bundlePages, &model.BundlePage{Data: bundleByID[appID], TotalCount: counts[appID], PageInfo: pages[appID]})
 	}
 
 	return bundlePages, nil
@@ -233,6 +233,10 @@ func TestPgRepository_ListByApplicationIDs(t *testing.T) {
 	secondBndlModel := fixBundleModelWithID(secondBundleID, "foo", desc)
 	secondBndlModel.ApplicationID = multiplePagesAppID
 
+	thirdBundleID := "333333333-3333-3333-3333-333333333333"
+	multiplePagesEndCursor, err := pagination.EncodeKeysetCursor(secondBundleID)
+	require.NoError(t, err)
+
 	suite := testdb.RepoListPageableTestSuite{
 		Name: "List Bundles for multiple Applications with paging",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
@@ -241,12 +245,13 @@ func TestPgRepository_ListByApplicationIDs(t *testing.T) {
 												UNION
 												(SELECT id, app_id, name, description, instance_auth_request_json_schema, default_instance_auth, ord_id, short_description, links, labels, credential_exchange_strategies, ready, created_at, updated_at, deleted_at, error, correlation_ids, documentation_labels FROM public.bundles WHERE (id IN (SELECT id FROM bundles_tenants WHERE tenant_id = $5)) AND app_id = $6 ORDER BY app_id ASC, id ASC LIMIT $7 OFFSET $8)
 												UNION
-												(SELECT id, app_id, name, description, instance_auth_request_json_schema, default_instance_auth, ord_id, short_description, links, labels, credential_exchange_strategies, ready, created_at, updated_at, deleted_at, error, correlation_ids, documentation_labels FROM public.bundles WHERE (id IN (SELECT id FROM bundles_tenants WHERE tenant_id = $9)) AND app_id = $10 ORDER BY app_id ASC, id ASC LIMIT $11 OFFSET $12)`),
+												(SELECT id, app_id, name, description, instance_auth_request_json_schema, default_instance_auth, ord_id, short_description, links, labels, credential_exchange_strategies, ready, created_at, updated_at, deleted_at, error, correlation_ids, documentation_labels FROM public.bundles WHERE (id IN (SELECT id FROM bundles_tenants WHERE tenant_id = $9)) AND app_id = $10 ORDER BY app_id ASC, id ASC LIMIT $11 OFFSET $12)
+												ORDER BY app_id ASC, id ASC`),
 
-				Args:     []driver.Value{tenantID, emptyPageAppID, pageSize, 0, tenantID, onePageAppID, pageSize, 0, tenantID, multiplePagesAppID, pageSize, 0},
+				Args:     []driver.Value{tenantID, emptyPageAppID, pageSize + 1, 0, tenantID, onePageAppID, pageSize + 1, 0, tenantID, multiplePagesAppID, pageSize + 1, 0},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixBundleColumns()).AddRow(fixBundleRowWithAppID(firstBundleID, onePageAppID)...).AddRow(fixBundleRowWithAppID(secondBundleID, multiplePagesAppID)...)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixBundleColumns()).AddRow(fixBundleRowWithAppID(firstBundleID, onePageAppID)...).AddRow(fixBundleRowWithAppID(secondBundleID, multiplePagesAppID)...).AddRow(fixBundleRowWithAppID(thirdBundleID, multiplePagesAppID)...)}
 				},
 			},
 			{
@@ -292,7 +297,7 @@ func TestPgRepository_ListByApplicationIDs(t *testing.T) {
 					Data: []*model.Bundle{secondBndlModel},
 					PageInfo: &pagination.Page{
 						StartCursor: "",
-						EndCursor:   pagination.EncodeNextOffsetCursor(0, pageSize),
+						EndCursor:   multiplePagesEndCursor,
 						HasNextPage: true,
 					},
 					TotalCount: 2,
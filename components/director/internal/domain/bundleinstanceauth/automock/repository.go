@@ -4,11 +4,11 @@ package automock
 
 import (
 	context "context"
+	testing "testing"
+	time "time"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
-
-	testing "testing"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -44,6 +44,48 @@ func (_m *Repository) Delete(ctx context.Context, tenantID string, id string) er
 	return r0
 }
 
+// DeleteExpiredPreviousAuthsGlobal provides a mock function with given fields: ctx, now
+func (_m *Repository) DeleteExpiredPreviousAuthsGlobal(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExpireGlobal provides a mock function with given fields: ctx, now
+func (_m *Repository) ExpireGlobal(ctx context.Context, now time.Time) (int64, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, tenantID, id
 func (_m *Repository) GetByID(ctx context.Context, tenantID string, id string) (*model.BundleInstanceAuth, error) {
 	ret := _m.Called(ctx, tenantID, id)
@@ -4,11 +4,10 @@ package automock
 
 import (
 	context "context"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
-
-	testing "testing"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -95,6 +94,20 @@ func (_m *Service) RequestDeletion(ctx context.Context, instanceAuth *model.Bund
 	return r0, r1
 }
 
+// RequestRotation provides a mock function with given fields: ctx, instanceAuth, defaultBundleInstanceAuth
+func (_m *Service) RequestRotation(ctx context.Context, instanceAuth *model.BundleInstanceAuth, defaultBundleInstanceAuth *model.Auth) error {
+	ret := _m.Called(ctx, instanceAuth, defaultBundleInstanceAuth)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BundleInstanceAuth, *model.Auth) error); ok {
+		r0 = rf(ctx, instanceAuth, defaultBundleInstanceAuth)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetAuth provides a mock function with given fields: ctx, id, in
 func (_m *Service) SetAuth(ctx context.Context, id string, in model.BundleInstanceAuthSetInput) error {
 	ret := _m.Called(ctx, id, in)
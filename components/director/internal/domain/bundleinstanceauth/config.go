@@ -0,0 +1,9 @@
+package bundleinstanceauth
+
+import "time"
+
+// Config contains the configuration of the BundleInstanceAuth credentials lifecycle
+type Config struct {
+	RotationGracePeriod time.Duration `envconfig:"default=24h,APP_BUNDLE_INSTANCE_AUTH_ROTATION_GRACE_PERIOD"`
+	ExpiryCheckPeriod   time.Duration `envconfig:"default=5m,APP_BUNDLE_INSTANCE_AUTH_EXPIRY_CHECK_PERIOD"`
+}
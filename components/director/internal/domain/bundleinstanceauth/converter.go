@@ -3,6 +3,7 @@ package bundleinstanceauth
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
@@ -12,6 +13,7 @@ import (
 )
 
 // AuthConverter missing godoc
+//
 //go:generate mockery --name=AuthConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
 type AuthConverter interface {
 	ToGraphQL(in *model.Auth) (*graphql.Auth, error)
@@ -40,14 +42,24 @@ func (c *converter) ToGraphQL(in *model.BundleInstanceAuth) (*graphql.BundleInst
 		return nil, errors.Wrap(err, "while converting Auth to GraphQL")
 	}
 
+	var previousAuth *graphql.Auth
+	if in.PreviousAuth != nil {
+		if previousAuth, err = c.authConverter.ToGraphQL(in.PreviousAuth); err != nil {
+			return nil, errors.Wrap(err, "while converting previous Auth to GraphQL")
+		}
+	}
+
 	return &graphql.BundleInstanceAuth{
-		ID:               in.ID,
-		Context:          c.strPtrToJSONPtr(in.Context),
-		InputParams:      c.strPtrToJSONPtr(in.InputParams),
-		Auth:             auth,
-		Status:           c.statusToGraphQL(in.Status),
-		RuntimeID:        in.RuntimeID,
-		RuntimeContextID: in.RuntimeContextID,
+		ID:                    in.ID,
+		Context:               c.strPtrToJSONPtr(in.Context),
+		InputParams:           c.strPtrToJSONPtr(in.InputParams),
+		Auth:                  auth,
+		Status:                c.statusToGraphQL(in.Status),
+		RuntimeID:             in.RuntimeID,
+		RuntimeContextID:      in.RuntimeContextID,
+		ExpiresAt:             c.timePtrToTimestampPtr(in.ExpiresAt),
+		PreviousAuth:          previousAuth,
+		PreviousAuthExpiresAt: c.timePtrToTimestampPtr(in.PreviousAuthExpiresAt),
 	}, nil
 }
 
@@ -88,6 +100,11 @@ func (c *converter) SetInputFromGraphQL(in graphql.BundleInstanceAuthSetInput) (
 		Auth: auth,
 	}
 
+	if in.ExpiresAt != nil {
+		expiresAt := time.Time(*in.ExpiresAt)
+		out.ExpiresAt = &expiresAt
+	}
+
 	if in.Status != nil {
 		out.Status = &model.BundleInstanceAuthStatusInput{
 			Condition: model.BundleInstanceAuthSetStatusConditionInput(in.Status.Condition),
@@ -116,6 +133,14 @@ func (c *converter) ToEntity(in *model.BundleInstanceAuth) (*Entity, error) {
 	}
 	out.AuthValue = authValue
 
+	previousAuthValue, err := c.nullStringFromAuthPtr(in.PreviousAuth)
+	if err != nil {
+		return nil, err
+	}
+	out.PreviousAuthValue = previousAuthValue
+	out.ExpiresAt = c.nullTimeFromTimePtr(in.ExpiresAt)
+	out.PreviousAuthExpiresAt = c.nullTimeFromTimePtr(in.PreviousAuthExpiresAt)
+
 	if in.Status != nil {
 		out.StatusCondition = string(in.Status.Condition)
 		out.StatusTimestamp = in.Status.Timestamp
@@ -133,6 +158,11 @@ func (c *converter) FromEntity(in *Entity) (*model.BundleInstanceAuth, error) {
 		return nil, err
 	}
 
+	previousAuth, err := c.authPtrFromNullString(in.PreviousAuthValue)
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.BundleInstanceAuth{
 		ID:               in.ID,
 		BundleID:         in.BundleID,
@@ -148,6 +178,9 @@ func (c *converter) FromEntity(in *Entity) (*model.BundleInstanceAuth, error) {
 			Message:   in.StatusMessage,
 			Reason:    in.StatusReason,
 		},
+		ExpiresAt:             c.timePtrFromNullTime(in.ExpiresAt),
+		PreviousAuth:          previousAuth,
+		PreviousAuthExpiresAt: c.timePtrFromNullTime(in.PreviousAuthExpiresAt),
 	}, nil
 }
 
@@ -180,6 +213,32 @@ func (c *converter) jsonPtrToStrPtr(in *graphql.JSON) *string {
 	return &out
 }
 
+func (c *converter) timePtrToTimestampPtr(in *time.Time) *graphql.Timestamp {
+	if in == nil {
+		return nil
+	}
+	out := graphql.Timestamp(*in)
+	return &out
+}
+
+func (c *converter) nullTimeFromTimePtr(in *time.Time) sql.NullTime {
+	if in == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{
+		Time:  *in,
+		Valid: true,
+	}
+}
+
+func (c *converter) timePtrFromNullTime(in sql.NullTime) *time.Time {
+	if !in.Valid {
+		return nil
+	}
+	out := in.Time
+	return &out
+}
+
 func (c *converter) nullStringFromAuthPtr(in *model.Auth) (sql.NullString, error) {
 	if in == nil {
 		return sql.NullString{}, nil
@@ -21,6 +21,19 @@ func TestConverter_ToGraphQL(t *testing.T) {
 	piaModel := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, authModel, fixModelStatusSucceeded(), &testRuntimeID)
 	piaGQL := fixGQLBundleInstanceAuth(testID, authGQL, fixGQLStatusSucceeded(), &testRuntimeID)
 
+	rotatedModel := fixModelRotatedBundleInstanceAuth()
+	expiresAt := graphql.Timestamp(*rotatedModel.ExpiresAt)
+	previousAuthExpiresAt := graphql.Timestamp(*rotatedModel.PreviousAuthExpiresAt)
+	rotatedGQL := fixGQLBundleInstanceAuth(testID, &graphql.Auth{Credential: &graphql.BasicCredentialData{Username: "foo", Password: "rotated"}}, &graphql.BundleInstanceAuthStatus{
+		Condition: graphql.BundleInstanceAuthStatusConditionSucceeded,
+		Timestamp: graphql.Timestamp(testTime),
+		Message:   rotatedModel.Status.Message,
+		Reason:    rotatedModel.Status.Reason,
+	}, &testRuntimeID)
+	rotatedGQL.ExpiresAt = &expiresAt
+	rotatedGQL.PreviousAuth = authGQL
+	rotatedGQL.PreviousAuthExpiresAt = &previousAuthExpiresAt
+
 	testCases := []struct {
 		Name            string
 		AuthConverterFn func() *automock.AuthConverter
@@ -66,6 +79,17 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixModelBundleInstanceAuthWithoutContextAndInputParams(testID, testBundleID, testTenant, nil, nil, nil),
 			Expected: fixGQLBundleInstanceAuthWithoutContextAndInputParams(testID, nil, nil, nil),
 		},
+		{
+			Name: "Success when credentials were rotated",
+			AuthConverterFn: func() *automock.AuthConverter {
+				conv := &automock.AuthConverter{}
+				conv.On("ToGraphQL", rotatedModel.Auth).Return(rotatedGQL.Auth, nil).Once()
+				conv.On("ToGraphQL", rotatedModel.PreviousAuth).Return(rotatedGQL.PreviousAuth, nil).Once()
+				return conv
+			},
+			Input:    rotatedModel,
+			Expected: rotatedGQL,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -194,6 +218,8 @@ func TestConverter_SetInputFromGraphQL(t *testing.T) {
 	// GIVEN
 	authInputModel := fixModelAuthInput()
 	authInputGQL := fixGQLAuthInput()
+	expiresAt := testTime.Add(testGracePeriod)
+	expiresAtGQL := graphql.Timestamp(expiresAt)
 
 	testCases := []struct {
 		Name            string
@@ -249,6 +275,22 @@ func TestConverter_SetInputFromGraphQL(t *testing.T) {
 				Status: fixModelStatusInput(model.BundleInstanceAuthSetStatusConditionInputFailed, "foo", "bar"),
 			},
 		},
+		{
+			Name: "Success when expiration is provided",
+			AuthConverterFn: func() *automock.AuthConverter {
+				conv := &automock.AuthConverter{}
+				conv.On("InputFromGraphQL", authInputGQL).Return(authInputModel, nil).Once()
+				return conv
+			},
+			Input: graphql.BundleInstanceAuthSetInput{
+				Auth:      authInputGQL,
+				ExpiresAt: &expiresAtGQL,
+			},
+			Expected: model.BundleInstanceAuthSetInput{
+				Auth:      authInputModel,
+				ExpiresAt: &expiresAt,
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -298,6 +340,18 @@ func TestConverter_ToEntity(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, piaEntity, entity)
 	})
+
+	t.Run("Success when credentials were rotated", func(t *testing.T) {
+		// GIVEN
+		conv := bundleinstanceauth.NewConverter(nil)
+
+		// WHEN
+		entity, err := conv.ToEntity(fixModelRotatedBundleInstanceAuth())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityRotatedBundleInstanceAuth(t), entity)
+	})
 }
 
 func TestConverter_FromEntity(t *testing.T) {
@@ -330,4 +384,16 @@ func TestConverter_FromEntity(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, piaModel, result)
 	})
+
+	t.Run("Success when credentials were rotated", func(t *testing.T) {
+		// GIVEN
+		conv := bundleinstanceauth.NewConverter(nil)
+
+		// WHEN
+		result, err := conv.FromEntity(fixEntityRotatedBundleInstanceAuth(t))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelRotatedBundleInstanceAuth(), result)
+	})
 }
@@ -28,7 +28,8 @@ var (
 	testInputParams    = `{"bar": "baz"}`
 	testError          = errors.New("test")
 	testTime           = time.Now()
-	testTableColumns   = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id"}
+	testGracePeriod    = time.Hour
+	testTableColumns   = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id", "expires_at", "previous_auth_value", "previous_auth_expires_at"}
 )
 
 func fixModelBundleInstanceAuth(id, bundleID, tenant string, auth *model.Auth, status *model.BundleInstanceAuthStatus, runtimeID *string) *model.BundleInstanceAuth {
@@ -88,6 +89,24 @@ func fixModelStatusPending() *model.BundleInstanceAuthStatus {
 	}
 }
 
+func fixModelStatusRotationRequested() *model.BundleInstanceAuthStatus {
+	return &model.BundleInstanceAuthStatus{
+		Condition: model.BundleInstanceAuthStatusConditionPending,
+		Timestamp: testTime,
+		Message:   "Credentials rotation was requested. The current credentials are valid until new ones are provided.",
+		Reason:    model.BundleInstanceAuthStatusReasonRotationRequested,
+	}
+}
+
+func fixModelStatusCredentialsRotated() *model.BundleInstanceAuthStatus {
+	return &model.BundleInstanceAuthStatus{
+		Condition: model.BundleInstanceAuthStatusConditionSucceeded,
+		Timestamp: testTime,
+		Message:   "Credentials were rotated. The previous credentials are valid until the end of the grace period.",
+		Reason:    model.BundleInstanceAuthStatusReasonCredentialsRotated,
+	}
+}
+
 func fixGQLStatusSucceeded() *graphql.BundleInstanceAuthStatus {
 	return &graphql.BundleInstanceAuthStatus{
 		Condition: graphql.BundleInstanceAuthStatusConditionSucceeded,
@@ -193,6 +212,28 @@ func fixEntityBundleInstanceAuthWithoutContextAndInputParams(t *testing.T, id, b
 	return &out
 }
 
+func fixModelRotatedBundleInstanceAuth() *model.BundleInstanceAuth {
+	expiresAt := testTime.Add(2 * testGracePeriod)
+	previousAuthExpiresAt := testTime.Add(testGracePeriod)
+
+	out := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelRotatedAuth(), fixModelStatusCredentialsRotated(), &testRuntimeID)
+	out.ExpiresAt = &expiresAt
+	out.PreviousAuth = fixModelAuth()
+	out.PreviousAuthExpiresAt = &previousAuthExpiresAt
+	return out
+}
+
+func fixEntityRotatedBundleInstanceAuth(t *testing.T) *bundleinstanceauth.Entity {
+	out := fixEntityBundleInstanceAuth(t, testID, testBundleID, testTenant, fixModelRotatedAuth(), fixModelStatusCredentialsRotated(), &testRuntimeID)
+
+	marshalled, err := json.Marshal(fixModelAuth())
+	require.NoError(t, err)
+	out.PreviousAuthValue = sql.NullString{String: string(marshalled), Valid: true}
+	out.ExpiresAt = sql.NullTime{Time: testTime.Add(2 * testGracePeriod), Valid: true}
+	out.PreviousAuthExpiresAt = sql.NullTime{Time: testTime.Add(testGracePeriod), Valid: true}
+	return out
+}
+
 func fixModelAuth() *model.Auth {
 	return &model.Auth{
 		Credential: model.CredentialData{
@@ -213,6 +254,17 @@ func fixGQLAuth() *graphql.Auth {
 	}
 }
 
+func fixModelRotatedAuth() *model.Auth {
+	return &model.Auth{
+		Credential: model.CredentialData{
+			Basic: &model.BasicCredentialData{
+				Username: "foo",
+				Password: "rotated",
+			},
+		},
+	}
+}
+
 func fixModelAuthInput() *model.AuthInput {
 	return &model.AuthInput{
 		Credential: &model.CredentialDataInput{
@@ -236,47 +288,53 @@ func fixGQLAuthInput() *graphql.AuthInput {
 }
 
 type sqlRow struct {
-	id               string
-	ownerID          string
-	bundleID         string
-	runtimeID        sql.NullString
-	runtimeContextID sql.NullString
-	context          sql.NullString
-	inputParams      sql.NullString
-	authValue        sql.NullString
-	statusCondition  string
-	statusTimestamp  time.Time
-	statusMessage    string
-	statusReason     string
+	id                    string
+	ownerID               string
+	bundleID              string
+	runtimeID             sql.NullString
+	runtimeContextID      sql.NullString
+	context               sql.NullString
+	inputParams           sql.NullString
+	authValue             sql.NullString
+	statusCondition       string
+	statusTimestamp       time.Time
+	statusMessage         string
+	statusReason          string
+	expiresAt             sql.NullTime
+	previousAuthValue     sql.NullString
+	previousAuthExpiresAt sql.NullTime
 }
 
 func fixSQLRows(rows []sqlRow) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, row := range rows {
-		out.AddRow(row.id, row.ownerID, row.bundleID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason, row.runtimeID, row.runtimeContextID)
+		out.AddRow(row.id, row.ownerID, row.bundleID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason, row.runtimeID, row.runtimeContextID, row.expiresAt, row.previousAuthValue, row.previousAuthExpiresAt)
 	}
 	return out
 }
 
 func fixSQLRowFromEntity(entity bundleinstanceauth.Entity) sqlRow {
 	return sqlRow{
-		id:               entity.ID,
-		ownerID:          entity.OwnerID,
-		bundleID:         entity.BundleID,
-		runtimeID:        entity.RuntimeID,
-		runtimeContextID: entity.RuntimeContextID,
-		context:          entity.Context,
-		inputParams:      entity.InputParams,
-		authValue:        entity.AuthValue,
-		statusCondition:  entity.StatusCondition,
-		statusTimestamp:  entity.StatusTimestamp,
-		statusMessage:    entity.StatusMessage,
-		statusReason:     entity.StatusReason,
+		id:                    entity.ID,
+		ownerID:               entity.OwnerID,
+		bundleID:              entity.BundleID,
+		runtimeID:             entity.RuntimeID,
+		runtimeContextID:      entity.RuntimeContextID,
+		context:               entity.Context,
+		inputParams:           entity.InputParams,
+		authValue:             entity.AuthValue,
+		statusCondition:       entity.StatusCondition,
+		statusTimestamp:       entity.StatusTimestamp,
+		statusMessage:         entity.StatusMessage,
+		statusReason:          entity.StatusReason,
+		expiresAt:             entity.ExpiresAt,
+		previousAuthValue:     entity.PreviousAuthValue,
+		previousAuthExpiresAt: entity.PreviousAuthExpiresAt,
 	}
 }
 
 func fixCreateArgs(ent bundleinstanceauth.Entity) []driver.Value {
-	return []driver.Value{ent.ID, ent.OwnerID, ent.BundleID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason, ent.RuntimeID, ent.RuntimeContextID}
+	return []driver.Value{ent.ID, ent.OwnerID, ent.BundleID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason, ent.RuntimeID, ent.RuntimeContextID, ent.ExpiresAt, ent.PreviousAuthValue, ent.PreviousAuthExpiresAt}
 }
 
 func fixSimpleModelBundleInstanceAuth(id string) *model.BundleInstanceAuth {
@@ -2,6 +2,9 @@ package bundleinstanceauth
 
 import (
 	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -16,10 +19,21 @@ import (
 
 const tableName string = `public.bundle_instance_auths`
 
+const (
+	// expireQuery removes the expired credentials, including the ones of BundleInstanceAuths waiting for their rotation
+	expireQuery = `UPDATE public.bundle_instance_auths SET auth_value = NULL, status_condition = $1, status_timestamp = $2, status_message = $3, status_reason = $4
+		WHERE expires_at <= $2 AND status_condition IN ($5, $6)`
+
+	deleteExpiredPreviousAuthsQuery = `UPDATE public.bundle_instance_auths SET previous_auth_value = NULL, previous_auth_expires_at = NULL
+		WHERE previous_auth_expires_at <= $1`
+
+	expiredMessage = "Credentials expired."
+)
+
 var (
 	idColumns        = []string{"id"}
-	updatableColumns = []string{"auth_value", "status_condition", "status_timestamp", "status_message", "status_reason"}
-	tableColumns     = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id"}
+	updatableColumns = []string{"auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "expires_at", "previous_auth_value", "previous_auth_expires_at"}
+	tableColumns     = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id", "expires_at", "previous_auth_value", "previous_auth_expires_at"}
 )
 
 // EntityConverter missing godoc
@@ -165,6 +179,39 @@ func (r *repository) Delete(ctx context.Context, tenantID string, id string) err
 	return r.deleter.DeleteOne(ctx, resource.BundleInstanceAuth, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+// ExpireGlobal removes the credentials which expired before the given time and sets the status of their BundleInstanceAuths to FAILED
+func (r *repository) ExpireGlobal(ctx context.Context, now time.Time) (int64, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", expireQuery)
+	res, err := persist.ExecContext(ctx, expireQuery, string(model.BundleInstanceAuthStatusConditionFailed), now, expiredMessage, model.BundleInstanceAuthStatusReasonCredentialsExpired,
+		string(model.BundleInstanceAuthStatusConditionSucceeded), string(model.BundleInstanceAuthStatusConditionPending))
+	if err != nil {
+		return 0, persistence.MapSQLError(ctx, err, resource.BundleInstanceAuth, resource.Update, "while expiring BundleInstanceAuths credentials")
+	}
+
+	return res.RowsAffected()
+}
+
+// DeleteExpiredPreviousAuthsGlobal removes the previous credentials whose grace period ended before the given time
+func (r *repository) DeleteExpiredPreviousAuthsGlobal(ctx context.Context, now time.Time) (int64, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", deleteExpiredPreviousAuthsQuery)
+	res, err := persist.ExecContext(ctx, deleteExpiredPreviousAuthsQuery, now)
+	if err != nil {
+		return 0, persistence.MapSQLError(ctx, err, resource.BundleInstanceAuth, resource.Update, "while deleting expired previous credentials of BundleInstanceAuths")
+	}
+
+	return res.RowsAffected()
+}
+
 func (r *repository) multipleFromEntities(entities Collection) ([]*model.BundleInstanceAuth, error) {
 	items := make([]*model.BundleInstanceAuth, 0, len(entities))
 	for _, ent := range entities {
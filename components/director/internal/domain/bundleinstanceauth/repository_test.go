@@ -31,7 +31,7 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.bundle_instance_auths ( id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.bundle_instance_auths ( id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, expires_at, previous_auth_value, previous_auth_expires_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixCreateArgs(*biaEntity)...).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
@@ -107,7 +107,7 @@ func TestRepository_GetByID(t *testing.T) {
 		Name: "Get BIA",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, expires_at, previous_auth_value, previous_auth_expires_at FROM public.bundle_instance_auths WHERE id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -142,7 +142,7 @@ func TestRepository_GetForBundle(t *testing.T) {
 		Name: "Get BIA For Bundle",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE id = $1 AND bundle_id = $2 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $3) OR owner_id = $4)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, expires_at, previous_auth_value, previous_auth_expires_at FROM public.bundle_instance_auths WHERE id = $1 AND bundle_id = $2 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $3) OR owner_id = $4)`),
 				Args:     []driver.Value{testID, testBundleID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -175,7 +175,7 @@ func TestRepository_ListByBundleID(t *testing.T) {
 		Name: "List BIA by BundleID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE bundle_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, expires_at, previous_auth_value, previous_auth_expires_at FROM public.bundle_instance_auths WHERE bundle_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testBundleID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -207,7 +207,7 @@ func TestRepository_ListByRuntimeID(t *testing.T) {
 		Name: "List BIA by RuntimeID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE runtime_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, expires_at, previous_auth_value, previous_auth_expires_at FROM public.bundle_instance_auths WHERE runtime_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testRuntimeID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -235,7 +235,7 @@ func TestRepository_ListByRuntimeID(t *testing.T) {
 }
 
 func TestRepository_Update(t *testing.T) {
-	updateStmt := regexp.QuoteMeta(`UPDATE public.bundle_instance_auths SET auth_value = ?, status_condition = ?, status_timestamp = ?, status_message = ?, status_reason = ? WHERE id = ? AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = ? AND owner = true) OR owner_id = ?)`)
+	updateStmt := regexp.QuoteMeta(`UPDATE public.bundle_instance_auths SET auth_value = ?, status_condition = ?, status_timestamp = ?, status_message = ?, status_reason = ?, expires_at = ?, previous_auth_value = ?, previous_auth_expires_at = ? WHERE id = ? AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = ? AND owner = true) OR owner_id = ?)`)
 
 	var nilBiaModel *model.BundleInstanceAuth
 	biaModel := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), nil)
@@ -246,7 +246,7 @@ func TestRepository_Update(t *testing.T) {
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
 				Query:         updateStmt,
-				Args:          []driver.Value{biaEntity.AuthValue, biaEntity.StatusCondition, biaEntity.StatusTimestamp, biaEntity.StatusMessage, biaEntity.StatusReason, testID, testTenant, testTenant},
+				Args:          []driver.Value{biaEntity.AuthValue, biaEntity.StatusCondition, biaEntity.StatusTimestamp, biaEntity.StatusMessage, biaEntity.StatusReason, biaEntity.ExpiresAt, biaEntity.PreviousAuthValue, biaEntity.PreviousAuthExpiresAt, testID, testTenant, testTenant},
 				ValidResult:   sqlmock.NewResult(-1, 1),
 				InvalidResult: sqlmock.NewResult(-1, 0),
 			},
@@ -284,3 +284,100 @@ func TestRepository_Delete(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestRepository_ExpireGlobal(t *testing.T) {
+	expireStmt := regexp.QuoteMeta(`UPDATE public.bundle_instance_auths SET auth_value = NULL, status_condition = $1, status_timestamp = $2, status_message = $3, status_reason = $4
+		WHERE expires_at <= $2 AND status_condition IN ($5, $6)`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(expireStmt).
+			WithArgs("FAILED", testTime, "Credentials expired.", "CredentialsExpired", "SUCCEEDED", "PENDING").
+			WillReturnResult(sqlmock.NewResult(-1, 2))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := bundleinstanceauth.NewRepository(nil)
+
+		// WHEN
+		expired, err := repo.ExpireGlobal(ctx, testTime)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), expired)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(expireStmt).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := bundleinstanceauth.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ExpireGlobal(ctx, testTime)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_DeleteExpiredPreviousAuthsGlobal(t *testing.T) {
+	deleteStmt := regexp.QuoteMeta(`UPDATE public.bundle_instance_auths SET previous_auth_value = NULL, previous_auth_expires_at = NULL
+		WHERE previous_auth_expires_at <= $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteStmt).
+			WithArgs(testTime).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := bundleinstanceauth.NewRepository(nil)
+
+		// WHEN
+		deleted, err := repo.DeleteExpiredPreviousAuthsGlobal(ctx, testTime)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteStmt).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := bundleinstanceauth.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.DeleteExpiredPreviousAuthsGlobal(ctx, testTime)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when persistence is missing in context", func(t *testing.T) {
+		repo := bundleinstanceauth.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.DeleteExpiredPreviousAuthsGlobal(context.TODO(), testTime)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
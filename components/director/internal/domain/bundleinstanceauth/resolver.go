@@ -17,6 +17,7 @@ import (
 //go:generate mockery --name=Service --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Service interface {
 	RequestDeletion(ctx context.Context, instanceAuth *model.BundleInstanceAuth, defaultBundleInstanceAuth *model.Auth) (bool, error)
+	RequestRotation(ctx context.Context, instanceAuth *model.BundleInstanceAuth, defaultBundleInstanceAuth *model.Auth) error
 	Create(ctx context.Context, bundleID string, in model.BundleInstanceAuthRequestInput, defaultAuth *model.Auth, requestInputSchema *string) (string, error)
 	Get(ctx context.Context, id string) (*model.BundleInstanceAuth, error)
 	SetAuth(ctx context.Context, id string, in model.BundleInstanceAuthSetInput) error
@@ -268,3 +269,44 @@ func (r *Resolver) RequestBundleInstanceAuthDeletion(ctx context.Context, authID
 
 	return r.conv.ToGraphQL(instanceAuth)
 }
+
+// RequestBundleInstanceAuthRotation requests new credentials for the BundleInstanceAuth with the given ID
+func (r *Resolver) RequestBundleInstanceAuthRotation(ctx context.Context, authID string) (*graphql.BundleInstanceAuth, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Requesting credentials rotation for BundleInstanceAuth with id %s", authID)
+
+	instanceAuth, err := r.svc.Get(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
+	bndl, err := r.bndlSvc.Get(ctx, instanceAuth.BundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.svc.RequestRotation(ctx, instanceAuth, bndl.DefaultInstanceAuth); err != nil {
+		return nil, err
+	}
+
+	instanceAuth, err = r.svc.Get(ctx, authID) // get InstanceAuth once again for new status
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Credentials rotation for BundleInstanceAuth with id %s successfully requested", authID)
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(instanceAuth)
+}
@@ -718,3 +718,187 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_RequestBundleInstanceAuthRotation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	id := "bar"
+	modelInstanceAuth := fixSimpleModelBundleInstanceAuth(id)
+	gqlInstanceAuth := fixSimpleGQLBundleInstanceAuth(id)
+
+	modelBndl := &model.Bundle{
+		DefaultInstanceAuth: fixModelAuth(),
+		BaseEntity: &model.BaseEntity{
+			ID: testBundleID,
+		},
+	}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.Service
+		BundleServiceFn func() *automock.BundleService
+		ConverterFn     func() *automock.Converter
+		ExpectedResult  *graphql.BundleInstanceAuth
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Twice()
+				svc.On("RequestRotation", txtest.CtxWithDBMatcher(), modelInstanceAuth, modelBndl.DefaultInstanceAuth).Return(nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBndl, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", modelInstanceAuth).Return(gqlInstanceAuth, nil).Once()
+				return conv
+			},
+			ExpectedResult: gqlInstanceAuth,
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Error - Get Instance Auth",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				return &automock.BundleService{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - Get Bundle",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - Request Rotation",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
+				svc.On("RequestRotation", txtest.CtxWithDBMatcher(), modelInstanceAuth, modelBndl.DefaultInstanceAuth).Return(testErr).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBndl, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - Get After Rotation",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+				svc.On("RequestRotation", txtest.CtxWithDBMatcher(), modelInstanceAuth, modelBndl.DefaultInstanceAuth).Return(nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBndl, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - Transaction Begin",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.Service {
+				return &automock.Service{}
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				return &automock.BundleService{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+		{
+			Name:            "Error - Transaction Commit",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Twice()
+				svc.On("RequestRotation", txtest.CtxWithDBMatcher(), modelInstanceAuth, modelBndl.DefaultInstanceAuth).Return(nil).Once()
+				return svc
+			},
+			BundleServiceFn: func() *automock.BundleService {
+				svc := &automock.BundleService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBndl, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			bundleSvc := testCase.BundleServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := bundleinstanceauth.NewResolver(transact, svc, bundleSvc, converter, nil)
+
+			// WHEN
+			result, err := resolver.RequestBundleInstanceAuthRotation(context.TODO(), id)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			mock.AssertExpectationsForObjects(t, svc, converter, transact, persist, bundleSvc)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package bundleinstanceauth
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -25,6 +26,8 @@ type Repository interface {
 	ListByRuntimeID(ctx context.Context, tenantID string, runtimeID string) ([]*model.BundleInstanceAuth, error)
 	Update(ctx context.Context, tenant string, item *model.BundleInstanceAuth) error
 	Delete(ctx context.Context, tenantID string, id string) error
+	ExpireGlobal(ctx context.Context, now time.Time) (int64, error)
+	DeleteExpiredPreviousAuthsGlobal(ctx context.Context, now time.Time) (int64, error)
 }
 
 // UIDService missing godoc
@@ -34,17 +37,19 @@ type UIDService interface {
 }
 
 type service struct {
-	repo         Repository
-	uidService   UIDService
-	timestampGen timestamp.Generator
+	repo                Repository
+	uidService          UIDService
+	timestampGen        timestamp.Generator
+	rotationGracePeriod time.Duration
 }
 
 // NewService missing godoc
-func NewService(repo Repository, uidService UIDService) *service {
+func NewService(repo Repository, uidService UIDService, rotationGracePeriod time.Duration) *service {
 	return &service{
-		repo:         repo,
-		uidService:   uidService,
-		timestampGen: timestamp.DefaultGenerator,
+		repo:                repo,
+		uidService:          uidService,
+		timestampGen:        timestamp.DefaultGenerator,
+		rotationGracePeriod: rotationGracePeriod,
 	}
 }
 
@@ -181,7 +186,11 @@ func (s *service) SetAuth(ctx context.Context, id string, in model.BundleInstanc
 		return apperrors.NewInvalidOperationError("auth can be set only on BundleInstanceAuths in PENDING state")
 	}
 
-	err = s.setUpdateAuthAndStatus(ctx, instanceAuth, in)
+	if instanceAuth.IsRotationRequested() {
+		err = s.setRotatedAuthAndStatus(ctx, instanceAuth, in)
+	} else {
+		err = s.setUpdateAuthAndStatus(ctx, instanceAuth, in)
+	}
 	if err != nil {
 		return err
 	}
@@ -226,6 +235,64 @@ func (s *service) RequestDeletion(ctx context.Context, instanceAuth *model.Bundl
 	return true, nil
 }
 
+// RequestRotation requests new credentials for a BundleInstanceAuth in SUCCEEDED state.
+// If the Bundle has default credentials, they replace the current ones right away. Otherwise, the BundleInstanceAuth is set to PENDING state,
+// so that the Application or Integration System provides the new credentials the same way as for a newly requested BundleInstanceAuth.
+// In both cases the current credentials stay valid until the end of the rotation grace period after the new ones are set.
+func (s *service) RequestRotation(ctx context.Context, instanceAuth *model.BundleInstanceAuth, defaultBundleInstanceAuth *model.Auth) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if instanceAuth == nil {
+		return apperrors.NewInternalError("BundleInstanceAuth is required to request its rotation")
+	}
+
+	if instanceAuth.Status == nil || instanceAuth.Status.Condition != model.BundleInstanceAuthStatusConditionSucceeded {
+		return apperrors.NewInvalidOperationError("rotation can be requested only for BundleInstanceAuths in SUCCEEDED state")
+	}
+
+	ts := s.timestampGen()
+	if defaultBundleInstanceAuth != nil {
+		log.C(ctx).Debugf("Default credentials for BundleInstanceAuth with id %s are provided. Rotating the credentials.", instanceAuth.ID)
+		s.rotate(instanceAuth, defaultBundleInstanceAuth, nil, ts)
+	} else {
+		log.C(ctx).Debugf("Default credentials for BundleInstanceAuth with id %s are not provided.", instanceAuth.ID)
+	}
+	instanceAuth.SetRotationStatus(defaultBundleInstanceAuth != nil, ts)
+	log.C(ctx).Infof("Status for BundleInstanceAuth with id %s set to '%s' with reason '%s'", instanceAuth.ID, instanceAuth.Status.Condition, instanceAuth.Status.Reason)
+
+	if err = s.repo.Update(ctx, tnt, instanceAuth); err != nil {
+		return errors.Wrapf(err, "while updating BundleInstanceAuth with id %s", instanceAuth.ID)
+	}
+
+	return nil
+}
+
+// ExpireCredentials removes the credentials of all BundleInstanceAuths which expired and sets their status to FAILED.
+// It also removes the previous credentials of the rotated BundleInstanceAuths whose grace period has ended.
+func (s *service) ExpireCredentials(ctx context.Context) error {
+	now := s.timestampGen()
+
+	expired, err := s.repo.ExpireGlobal(ctx, now)
+	if err != nil {
+		return errors.Wrap(err, "while expiring BundleInstanceAuths credentials")
+	}
+	if expired > 0 {
+		log.C(ctx).Infof("Credentials of %d BundleInstanceAuths expired", expired)
+	}
+
+	deleted, err := s.repo.DeleteExpiredPreviousAuthsGlobal(ctx, now)
+	if err != nil {
+		return errors.Wrap(err, "while deleting expired previous credentials of BundleInstanceAuths")
+	}
+	if deleted > 0 {
+		log.C(ctx).Infof("Grace period of the previous credentials of %d BundleInstanceAuths ended", deleted)
+	}
+
+	return nil
+}
+
 // Delete missing godoc
 func (s *service) Delete(ctx context.Context, id string) error {
 	tnt, err := tenant.LoadFromContext(ctx)
@@ -245,8 +312,12 @@ func (s *service) setUpdateAuthAndStatus(ctx context.Context, instanceAuth *mode
 	}
 
 	ts := s.timestampGen()
+	if err := validateExpiresAt(in.ExpiresAt, ts); err != nil {
+		return err
+	}
 
 	instanceAuth.Auth = in.Auth.ToAuth()
+	instanceAuth.ExpiresAt = in.ExpiresAt
 	instanceAuth.Status = in.Status.ToBundleInstanceAuthStatus(ts)
 
 	// Input validation ensures that status can be nil only when auth was provided, so we can assume SUCCEEDED status
@@ -261,6 +332,50 @@ func (s *service) setUpdateAuthAndStatus(ctx context.Context, instanceAuth *mode
 	return nil
 }
 
+func (s *service) setRotatedAuthAndStatus(ctx context.Context, instanceAuth *model.BundleInstanceAuth, in model.BundleInstanceAuthSetInput) error {
+	ts := s.timestampGen()
+
+	// The current credentials stay in place if the Application or Integration System failed to provide new ones
+	if in.Status != nil && in.Status.Condition == model.BundleInstanceAuthSetStatusConditionInputFailed {
+		log.C(ctx).Infof("Credentials rotation for BundleInstanceAuth with id %s failed. Keeping the current credentials", instanceAuth.ID)
+		instanceAuth.Status = in.Status.ToBundleInstanceAuthStatus(ts)
+		return nil
+	}
+
+	if err := validateExpiresAt(in.ExpiresAt, ts); err != nil {
+		return err
+	}
+
+	s.rotate(instanceAuth, in.Auth.ToAuth(), in.ExpiresAt, ts)
+	instanceAuth.SetRotationStatus(true, ts)
+	if in.Status != nil {
+		instanceAuth.Status = in.Status.ToBundleInstanceAuthStatus(ts)
+	}
+	log.C(ctx).Infof("Credentials for BundleInstanceAuth with id %s rotated. Previous credentials are valid until %s", instanceAuth.ID, instanceAuth.PreviousAuthExpiresAt)
+
+	return nil
+}
+
+// rotate replaces the credentials of the BundleInstanceAuth and keeps the current ones until the end of the grace period or their expiration, whichever comes first
+func (s *service) rotate(instanceAuth *model.BundleInstanceAuth, auth *model.Auth, expiresAt *time.Time, ts time.Time) {
+	previousAuthExpiresAt := ts.Add(s.rotationGracePeriod)
+	if instanceAuth.ExpiresAt != nil && instanceAuth.ExpiresAt.Before(previousAuthExpiresAt) {
+		previousAuthExpiresAt = *instanceAuth.ExpiresAt
+	}
+
+	instanceAuth.PreviousAuth = instanceAuth.Auth
+	instanceAuth.PreviousAuthExpiresAt = &previousAuthExpiresAt
+	instanceAuth.Auth = auth
+	instanceAuth.ExpiresAt = expiresAt
+}
+
+func validateExpiresAt(expiresAt *time.Time, now time.Time) error {
+	if expiresAt != nil && !expiresAt.After(now) {
+		return apperrors.NewInvalidDataError("expiresAt must be in the future")
+	}
+	return nil
+}
+
 func (s *service) setCreationStatusFromAuth(ctx context.Context, instanceAuth *model.BundleInstanceAuth, defaultAuth *model.Auth) error {
 	if instanceAuth == nil {
 		return nil
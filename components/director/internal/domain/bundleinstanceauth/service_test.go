@@ -64,7 +64,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, 0)
 
 			// WHEN
 			result, err := svc.Get(ctx, id)
@@ -83,7 +83,7 @@ func TestService_Get(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		_, err := svc.Get(context.TODO(), id)
@@ -141,7 +141,7 @@ func TestService_GetForBundle(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, 0)
 
 			// WHEN
 			result, err := svc.GetForBundle(ctx, id, bundleID)
@@ -160,7 +160,7 @@ func TestService_GetForBundle(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		_, err := svc.GetForBundle(context.TODO(), id, bundleID)
@@ -212,7 +212,7 @@ func TestService_Delete(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.instanceAuthRepoFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, 0)
 
 			// WHEN
 			err := svc.Delete(ctx, id)
@@ -230,7 +230,7 @@ func TestService_Delete(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		err := svc.Delete(context.TODO(), id)
@@ -268,6 +268,30 @@ func TestService_SetAuth(t *testing.T) {
 	err := modelUpdatedInstanceAuthWithDefaultStatus.SetDefaultStatus(model.BundleInstanceAuthStatusConditionSucceeded, testTime)
 	require.NoError(t, err)
 
+	expiresAt := testTime.Add(2 * testGracePeriod)
+	previousAuthExpiresAt := testTime.Add(testGracePeriod)
+	modelSetInputWithExpiry := model.BundleInstanceAuthSetInput{
+		Auth:      fixModelAuthInput(),
+		ExpiresAt: &expiresAt,
+	}
+	modelUpdatedInstanceAuthWithExpiry := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, modelSetInputWithExpiry.Auth.ToAuth(), fixModelStatusSucceeded(), nil)
+	modelUpdatedInstanceAuthWithExpiry.ExpiresAt = &expiresAt
+
+	rotationRequestedInstanceAuthFn := func() *model.BundleInstanceAuth {
+		return fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelRotatedAuth(), fixModelStatusRotationRequested(), nil)
+	}
+	modelRotatedInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, modelSetInputWithExpiry.Auth.ToAuth(), fixModelStatusCredentialsRotated(), nil)
+	modelRotatedInstanceAuth.ExpiresAt = &expiresAt
+	modelRotatedInstanceAuth.PreviousAuth = fixModelRotatedAuth()
+	modelRotatedInstanceAuth.PreviousAuthExpiresAt = &previousAuthExpiresAt
+
+	modelFailedStatusInput := model.BundleInstanceAuthSetInput{
+		Status: fixModelStatusInput(model.BundleInstanceAuthSetStatusConditionInputFailed, "foo", "bar"),
+	}
+	modelFailedRotationInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelRotatedAuth(), modelFailedStatusInput.Status.ToBundleInstanceAuthStatus(testTime), nil)
+
+	pastExpiresAt := testTime.Add(-testGracePeriod)
+
 	testCases := []struct {
 		Name               string
 		InstanceAuthRepoFn func() *automock.Repository
@@ -296,6 +320,52 @@ func TestService_SetAuth(t *testing.T) {
 			Input:         modelSetInputWithoutStatus,
 			ExpectedError: nil,
 		},
+		{
+			Name: "Success when expiration is provided",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, testID).Return(modelInstanceAuthFn(), nil).Once()
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, modelUpdatedInstanceAuthWithExpiry).Return(nil).Once()
+				return instanceAuthRepo
+			},
+			Input:         modelSetInputWithExpiry,
+			ExpectedError: nil,
+		},
+		{
+			Name: "Success when rotation was requested keeps the current credentials for the grace period",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, testID).Return(rotationRequestedInstanceAuthFn(), nil).Once()
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, modelRotatedInstanceAuth).Return(nil).Once()
+				return instanceAuthRepo
+			},
+			Input:         modelSetInputWithExpiry,
+			ExpectedError: nil,
+		},
+		{
+			Name: "Success when rotation failed keeps the current credentials",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, testID).Return(rotationRequestedInstanceAuthFn(), nil).Once()
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, modelFailedRotationInstanceAuth).Return(nil).Once()
+				return instanceAuthRepo
+			},
+			Input:         modelFailedStatusInput,
+			ExpectedError: nil,
+		},
+		{
+			Name: "Error when expiration is not in the future",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("GetByID", contextThatHasTenant(testTenant), testTenant, testID).Return(modelInstanceAuthFn(), nil).Once()
+				return instanceAuthRepo
+			},
+			Input: model.BundleInstanceAuthSetInput{
+				Auth:      fixModelAuthInput(),
+				ExpiresAt: &pastExpiresAt,
+			},
+			ExpectedError: errors.New("expiresAt must be in the future"),
+		},
 		{
 			Name: "Error when Bundle Instance Auth retrieval failed",
 			InstanceAuthRepoFn: func() *automock.Repository {
@@ -362,7 +432,7 @@ func TestService_SetAuth(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, testGracePeriod)
 			svc.SetTimestampGen(func() time.Time { return testTime })
 
 			// WHEN
@@ -381,7 +451,7 @@ func TestService_SetAuth(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		err := svc.SetAuth(context.TODO(), testID, model.BundleInstanceAuthSetInput{})
@@ -563,7 +633,7 @@ func TestService_Create(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
 			uidSvc := testCase.UIDSvcFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, uidSvc)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, uidSvc, 0)
 			svc.SetTimestampGen(func() time.Time { return testTime })
 
 			// WHEN
@@ -583,7 +653,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		_, err := svc.Create(context.TODO(), testBundleID, model.BundleInstanceAuthRequestInput{}, nil, nil)
@@ -595,7 +665,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("Error when consumer is not in the context", func(t *testing.T) {
 		// GIVEN
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 		ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
 
 		// WHEN
@@ -655,7 +725,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := bundleinstanceauth.NewService(repo, nil)
+			svc := bundleinstanceauth.NewService(repo, nil, 0)
 
 			// WHEN
 			pia, err := svc.List(ctx, testBundleID)
@@ -674,7 +744,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 		// WHEN
 		_, err := svc.List(context.TODO(), "")
 		// THEN
@@ -731,7 +801,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := bundleinstanceauth.NewService(repo, nil)
+			svc := bundleinstanceauth.NewService(repo, nil, 0)
 
 			// WHEN
 			bundleInstanceAuth, err := svc.ListByRuntimeID(ctx, testRuntimeID)
@@ -750,7 +820,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 
 		// WHEN
 		_, err := svc.ListByRuntimeID(context.TODO(), "")
@@ -802,7 +872,7 @@ func TestService_Update(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := bundleinstanceauth.NewService(repo, nil)
+			svc := bundleinstanceauth.NewService(repo, nil, 0)
 
 			// WHEN
 			err := svc.Update(ctx, bundleInstanceAuth)
@@ -891,7 +961,7 @@ func TestService_RequestDeletion(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			instanceAuthRepo := testCase.InstanceAuthRepoFn()
 
-			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, 0)
 			svc.SetTimestampGen(func() time.Time {
 				return timestampNow
 			})
@@ -917,7 +987,7 @@ func TestService_RequestDeletion(t *testing.T) {
 		expectedError := errors.New("BundleInstanceAuth is required to request its deletion")
 
 		// WHEN
-		svc := bundleinstanceauth.NewService(nil, nil)
+		svc := bundleinstanceauth.NewService(nil, nil, 0)
 		_, err := svc.RequestDeletion(ctx, nil, nil)
 
 		// THEN
@@ -926,6 +996,183 @@ func TestService_RequestDeletion(t *testing.T) {
 	})
 }
 
+func TestService_RequestRotation(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	expiresAt := testTime.Add(testGracePeriod / 2)
+	previousAuthExpiresAt := testTime.Add(testGracePeriod)
+
+	succeededInstanceAuthFn := func() *model.BundleInstanceAuth {
+		return fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), nil)
+	}
+	expiringInstanceAuthFn := func() *model.BundleInstanceAuth {
+		instanceAuth := succeededInstanceAuthFn()
+		instanceAuth.ExpiresAt = &expiresAt
+		return instanceAuth
+	}
+
+	rotationRequestedInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusRotationRequested(), nil)
+
+	rotatedInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelRotatedAuth(), fixModelStatusCredentialsRotated(), nil)
+	rotatedInstanceAuth.PreviousAuth = fixModelAuth()
+	rotatedInstanceAuth.PreviousAuthExpiresAt = &previousAuthExpiresAt
+
+	rotatedExpiringInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelRotatedAuth(), fixModelStatusCredentialsRotated(), nil)
+	rotatedExpiringInstanceAuth.PreviousAuth = fixModelAuth()
+	rotatedExpiringInstanceAuth.PreviousAuthExpiresAt = &expiresAt
+
+	testCases := []struct {
+		Name                      string
+		InstanceAuth              *model.BundleInstanceAuth
+		BundleDefaultInstanceAuth *model.Auth
+		InstanceAuthRepoFn        func() *automock.Repository
+		ExpectedError             error
+	}{
+		{
+			Name:         "Success - No Bundle Default Instance Auth",
+			InstanceAuth: succeededInstanceAuthFn(),
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, rotationRequestedInstanceAuth).Return(nil).Once()
+				return instanceAuthRepo
+			},
+		},
+		{
+			Name:                      "Success - Bundle Default Instance Auth",
+			InstanceAuth:              succeededInstanceAuthFn(),
+			BundleDefaultInstanceAuth: fixModelRotatedAuth(),
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, rotatedInstanceAuth).Return(nil).Once()
+				return instanceAuthRepo
+			},
+		},
+		{
+			Name:                      "Success - previous credentials expire before the end of the grace period",
+			InstanceAuth:              expiringInstanceAuthFn(),
+			BundleDefaultInstanceAuth: fixModelRotatedAuth(),
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, rotatedExpiringInstanceAuth).Return(nil).Once()
+				return instanceAuthRepo
+			},
+		},
+		{
+			Name:         "Error - status different from SUCCEEDED",
+			InstanceAuth: fixModelBundleInstanceAuth(testID, testBundleID, testTenant, nil, fixModelStatusPending(), nil),
+			InstanceAuthRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			ExpectedError: errors.New("rotation can be requested only for BundleInstanceAuths in SUCCEEDED state"),
+		},
+		{
+			Name:         "Error - Update",
+			InstanceAuth: succeededInstanceAuthFn(),
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("Update", contextThatHasTenant(testTenant), testTenant, rotationRequestedInstanceAuth).Return(testError).Once()
+				return instanceAuthRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			instanceAuthRepo := testCase.InstanceAuthRepoFn()
+
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, testGracePeriod)
+			svc.SetTimestampGen(func() time.Time { return testTime })
+
+			// WHEN
+			err := svc.RequestRotation(ctx, testCase.InstanceAuth, testCase.BundleDefaultInstanceAuth)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			instanceAuthRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error - nil", func(t *testing.T) {
+		// WHEN
+		svc := bundleinstanceauth.NewService(nil, nil, testGracePeriod)
+		err := svc.RequestRotation(ctx, nil, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "BundleInstanceAuth is required to request its rotation")
+	})
+}
+
+func TestService_ExpireCredentials(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name               string
+		InstanceAuthRepoFn func() *automock.Repository
+		ExpectedError      error
+	}{
+		{
+			Name: "Success",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("ExpireGlobal", ctx, testTime).Return(int64(2), nil).Once()
+				instanceAuthRepo.On("DeleteExpiredPreviousAuthsGlobal", ctx, testTime).Return(int64(1), nil).Once()
+				return instanceAuthRepo
+			},
+		},
+		{
+			Name: "Error when expiring credentials fails",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("ExpireGlobal", ctx, testTime).Return(int64(0), testError).Once()
+				return instanceAuthRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Error when deleting expired previous credentials fails",
+			InstanceAuthRepoFn: func() *automock.Repository {
+				instanceAuthRepo := &automock.Repository{}
+				instanceAuthRepo.On("ExpireGlobal", ctx, testTime).Return(int64(0), nil).Once()
+				instanceAuthRepo.On("DeleteExpiredPreviousAuthsGlobal", ctx, testTime).Return(int64(0), testError).Once()
+				return instanceAuthRepo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			instanceAuthRepo := testCase.InstanceAuthRepoFn()
+
+			svc := bundleinstanceauth.NewService(instanceAuthRepo, nil, testGracePeriod)
+			svc.SetTimestampGen(func() time.Time { return testTime })
+
+			// WHEN
+			err := svc.ExpireCredentials(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			instanceAuthRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func contextThatHasTenant(expectedTenant string) interface{} {
 	return mock.MatchedBy(func(actual context.Context) bool {
 		actualTenant, err := tenant.LoadFromContext(actual)
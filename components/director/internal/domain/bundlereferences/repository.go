@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
@@ -189,10 +190,10 @@ func (r *repository) DeleteByReferenceObjectID(ctx context.Context, bundleID str
 }
 
 // ListByBundleIDs retrieves all BundleReferences matching an array of bundleIDs from the Compass storage.
-func (r *repository) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, error) {
+func (r *repository) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, map[string]*pagination.Page, error) {
 	objectTable, objectIDCol, columns, err := getDetailsByObjectType(objectType)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	unionLister := r.unionLister.Clone()
@@ -200,7 +201,7 @@ func (r *repository) ListByBundleIDs(ctx context.Context, objectType model.Bundl
 
 	objectFieldName, err := r.referenceObjectFieldName(objectType)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	isInternalVisibilityScopePresent, err := scope.Contains(ctx, internalVisibilityScope)
@@ -221,25 +222,25 @@ func (r *repository) ListByBundleIDs(ctx context.Context, objectType model.Bundl
 
 	orderByColumns, err := getOrderByColumnsByObjectType(objectType)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	var objectBundleIDs BundleReferencesCollection
-	counts, err := unionLister.ListGlobal(ctx, bundleIDs, bundleIDColumn, pageSize, cursor, orderByColumns, &objectBundleIDs, conditions...)
+	counts, pages, err := unionLister.ListGlobal(ctx, bundleIDs, bundleIDColumn, pageSize, cursor, orderByColumns, &objectBundleIDs, conditions...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	bundleReferences := make([]*model.BundleReference, 0, len(objectBundleIDs))
 	for _, d := range objectBundleIDs {
 		entity, err := r.conv.FromEntity(d)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		bundleReferences = append(bundleReferences, &entity)
 	}
 
-	return bundleReferences, counts, nil
+	return bundleReferences, counts, pages, nil
 }
 
 func (r *repository) referenceObjectFieldName(objectType model.BundleReferenceObjectType) (string, error) {
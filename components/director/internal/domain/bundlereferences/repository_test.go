@@ -277,7 +277,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	countQueryWithVisibilityCheckForEvents := `SELECT bundle_id AS id, COUNT\(\*\) AS total_count FROM public.bundle_references WHERE \(SELECT visibility FROM event_api_definitions WHERE event_api_definitions.id = public.bundle_references.event_def_id\) = \$1 AND event_def_id IS NOT NULL GROUP BY bundle_id ORDER BY bundle_id ASC`
 
 	t.Run("success when everything is returned for APIs when there is internal_visibility scope", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -316,7 +316,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 2)
@@ -333,7 +333,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("success when there is no internal_visibility scope and result for APIs is filtered", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -366,7 +366,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 1)
@@ -380,7 +380,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("success when everything is returned for Events when there is internal_visibility scope", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -417,7 +417,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 2)
@@ -432,7 +432,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("success when there is no internal_visibility scope and result for Events is filtered", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -464,7 +464,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 1)
@@ -477,7 +477,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("success when there are more records", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -516,7 +516,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 2)
@@ -533,7 +533,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("returns both public and internal/private Events when check for internal scope fails", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -569,7 +569,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleEventReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 2)
@@ -584,7 +584,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("returns both public and internal/private APIs when check for internal scope fails", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 
@@ -622,7 +622,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		}, nil)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.NoError(t, err)
 		require.Len(t, modelBndlRefs, 2)
@@ -639,7 +639,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	})
 
 	t.Run("returns error when conversion from entity fails", func(t *testing.T) {
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 		inputPageSize := 1
 		totalCountForFirstBundle := 1
@@ -668,7 +668,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		convMock.On("FromEntity", firstAPIBndlRefEntity).Return(model.BundleReference{}, testErr)
 		pgRepository := bundlereferences.NewRepository(convMock)
 		// WHEN
-		_, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		_, _, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 		// THEN
 		require.Error(t, err)
 		require.Contains(t, err.Error(), testErr.Error())
@@ -679,7 +679,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 	t.Run("DB Error", func(t *testing.T) {
 		// GIVEN
 		inputPageSize := 1
-		ExpectedLimit := 1
+		ExpectedLimit := 2
 		ExpectedOffset := 0
 
 		pgRepository := bundlereferences.NewRepository(nil)
@@ -693,7 +693,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		ctx = scope.SaveToContext(ctx, scopesWithInternalVisibility)
 
 		// WHEN
-		modelBndlRefs, totalCounts, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
+		modelBndlRefs, totalCounts, _, err := pgRepository.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, inputPageSize, inputCursor)
 
 		// THEN
 		sqlMock.AssertExpectations(t)
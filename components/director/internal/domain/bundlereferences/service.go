@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 )
 
@@ -16,7 +17,7 @@ type BundleReferenceRepository interface {
 	DeleteByReferenceObjectID(ctx context.Context, bundleID string, objectType model.BundleReferenceObjectType, objectID string) error
 	GetByID(ctx context.Context, objectType model.BundleReferenceObjectType, objectID, bundleID *string) (*model.BundleReference, error)
 	GetBundleIDsForObject(ctx context.Context, objectType model.BundleReferenceObjectType, objectID *string) (ids []string, err error)
-	ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, error)
+	ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, map[string]*pagination.Page, error)
 }
 
 // UIDService is responsible for generating GUIDs, which will be used as internal bundleReference IDs when they are created.
@@ -99,10 +100,10 @@ func (s *service) DeleteByReferenceObjectID(ctx context.Context, objectType mode
 	return s.repo.DeleteByReferenceObjectID(ctx, *bundleID, objectType, *objectID)
 }
 
-// ListByBundleIDs lists all BundleReferences for given array of bundle IDs. In addition, the number of records for each BundleReference is returned.
-func (s *service) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, error) {
+// ListByBundleIDs lists all BundleReferences for given array of bundle IDs. In addition, the number of records and the page info for each bundle are returned.
+func (s *service) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, map[string]*pagination.Page, error) {
 	if pageSize < 1 || pageSize > 200 {
-		return nil, nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+		return nil, nil, nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
 	}
 
 	return s.repo.ListByBundleIDs(ctx, objectType, bundleIDs, pageSize, cursor)
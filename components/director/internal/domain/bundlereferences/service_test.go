@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundlereferences"
@@ -378,6 +379,10 @@ func TestService_ListByBundleIDs(t *testing.T) {
 	totalCounts := map[string]int{firstBundleID: numberOfAPIsInFirstBundle, secondBundleID: numberOfAPIsInSecondBundle}
 
 	after := "test"
+	pages := map[string]*pagination.Page{
+		firstBundleID:  {StartCursor: after},
+		secondBundleID: {StartCursor: after},
+	}
 
 	ctx := context.TODO()
 
@@ -387,18 +392,20 @@ func TestService_ListByBundleIDs(t *testing.T) {
 		RepositoryFn        func() *automock.BundleReferenceRepository
 		ExpectedBundleRefs  []*model.BundleReference
 		ExpectedTotalCounts map[string]int
+		ExpectedPages       map[string]*pagination.Page
 		ExpectedErrMessage  string
 	}{
 		{
 			Name: "Success",
 			RepositoryFn: func() *automock.BundleReferenceRepository {
 				repo := &automock.BundleReferenceRepository{}
-				repo.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, nil).Once()
+				repo.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, pages, nil).Once()
 				return repo
 			},
 			PageSize:            2,
 			ExpectedBundleRefs:  bundleRefs,
 			ExpectedTotalCounts: totalCounts,
+			ExpectedPages:       pages,
 		},
 		{
 			Name: "Return error when page size is less than 1",
@@ -422,7 +429,7 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Error on listing bundle references",
 			RepositoryFn: func() *automock.BundleReferenceRepository {
 				repo := &automock.BundleReferenceRepository{}
-				repo.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(nil, nil, testErr).Once()
+				repo.On("ListByBundleIDs", ctx, model.BundleAPIReference, bundleIDs, 2, after).Return(nil, nil, nil, testErr).Once()
 				return repo
 			},
 			PageSize:            2,
@@ -439,7 +446,7 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			svc := bundlereferences.NewService(repo, nil)
 
 			// WHEN
-			bndlRefs, counts, err := svc.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, testCase.PageSize, after)
+			bndlRefs, counts, pageInfos, err := svc.ListByBundleIDs(ctx, model.BundleAPIReference, bundleIDs, testCase.PageSize, after)
 
 			// THEN
 			if testCase.ExpectedErrMessage == "" {
@@ -447,6 +454,7 @@ func TestService_ListByBundleIDs(t *testing.T) {
 				assert.Equal(t, testCase.ExpectedBundleRefs, bndlRefs)
 				assert.Equal(t, testCase.ExpectedTotalCounts[firstBundleID], counts[firstBundleID])
 				assert.Equal(t, testCase.ExpectedTotalCounts[secondBundleID], counts[secondBundleID])
+				assert.Equal(t, testCase.ExpectedPages, pageInfos)
 			} else {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
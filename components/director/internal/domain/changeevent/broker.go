@@ -0,0 +1,89 @@
+package changeevent
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+type subscriber struct {
+	tenantID     string
+	resourceType resource.Type
+	events       chan *model.ChangeEvent
+}
+
+// Broker fans out the change events received by the Listener to the GraphQL subscribers of the current director replica
+type Broker struct {
+	mutex       sync.RWMutex
+	nextID      uint64
+	subscribers map[uint64]*subscriber
+	bufferSize  int
+}
+
+// NewBroker creates a new Broker. Each subscriber receives a channel with the given buffer size.
+func NewBroker(bufferSize int) *Broker {
+	return &Broker{
+		subscribers: make(map[uint64]*subscriber),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Subscribe registers a subscriber for the change events of the given resource type which are visible in the given tenant.
+// The returned function must be called to unsubscribe; it closes the events channel.
+func (b *Broker) Subscribe(tenantID string, resourceType resource.Type) (<-chan *model.ChangeEvent, func()) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	s := &subscriber{
+		tenantID:     tenantID,
+		resourceType: resourceType,
+		events:       make(chan *model.ChangeEvent, b.bufferSize),
+	}
+	b.subscribers[id] = s
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
+
+			delete(b.subscribers, id)
+			close(s.events)
+		})
+	}
+
+	return s.events, unsubscribe
+}
+
+// Dispatch delivers the event to all matching subscribers. Slow subscribers whose buffers are full do not receive the event.
+// Trimmed events carry no tenants, so they are delivered to all subscribers for the resource type, which check the access to the resource themselves.
+func (b *Broker) Dispatch(ctx context.Context, event *model.ChangeEvent) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, s := range b.subscribers {
+		if s.resourceType != event.ResourceType || (!event.Trimmed && !event.HasTenant(s.tenantID)) {
+			continue
+		}
+
+		select {
+		case s.events <- event:
+		default:
+			log.C(ctx).Warnf("Dropping %s change event with ID %s for subscriber in tenant %s: buffer is full", event.ResourceType, event.ID, s.tenantID)
+		}
+	}
+}
+
+// SubscribersCount returns the number of active subscribers
+func (b *Broker) SubscribersCount() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	return len(b.subscribers)
+}
@@ -0,0 +1,99 @@
+package changeevent_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_Dispatch(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("Delivers the event only to the subscribers for the same tenant and resource type", func(t *testing.T) {
+		// GIVEN
+		broker := changeevent.NewBroker(1)
+		appEvents, unsubscribeApp := broker.Subscribe(tenantID, resource.Application)
+		defer unsubscribeApp()
+		runtimeEvents, unsubscribeRuntime := broker.Subscribe(tenantID, resource.Runtime)
+		defer unsubscribeRuntime()
+		otherTenantEvents, unsubscribeOtherTenant := broker.Subscribe(otherTenantID, resource.Application)
+		defer unsubscribeOtherTenant()
+
+		event := fixApplicationEvent(model.ChangeEventTypeCreated)
+
+		// WHEN
+		broker.Dispatch(ctx, event)
+
+		// THEN
+		require.Len(t, appEvents, 1)
+		assert.Equal(t, event, <-appEvents)
+		assert.Len(t, runtimeEvents, 0)
+		assert.Len(t, otherTenantEvents, 0)
+	})
+
+	t.Run("Delivers the trimmed event to the subscribers for the same resource type in all tenants", func(t *testing.T) {
+		// GIVEN
+		broker := changeevent.NewBroker(1)
+		appEvents, unsubscribeApp := broker.Subscribe(tenantID, resource.Application)
+		defer unsubscribeApp()
+		runtimeEvents, unsubscribeRuntime := broker.Subscribe(tenantID, resource.Runtime)
+		defer unsubscribeRuntime()
+		otherTenantEvents, unsubscribeOtherTenant := broker.Subscribe(otherTenantID, resource.Application)
+		defer unsubscribeOtherTenant()
+
+		event := fixApplicationEvent(model.ChangeEventTypeCreated).Trim()
+
+		// WHEN
+		broker.Dispatch(ctx, event)
+
+		// THEN
+		require.Len(t, appEvents, 1)
+		assert.Equal(t, event, <-appEvents)
+		assert.Len(t, runtimeEvents, 0)
+		require.Len(t, otherTenantEvents, 1)
+		assert.Equal(t, event, <-otherTenantEvents)
+	})
+
+	t.Run("Drops the event when the subscriber buffer is full", func(t *testing.T) {
+		// GIVEN
+		broker := changeevent.NewBroker(1)
+		events, unsubscribe := broker.Subscribe(tenantID, resource.Application)
+		defer unsubscribe()
+
+		first := fixApplicationEvent(model.ChangeEventTypeCreated)
+		second := fixApplicationEvent(model.ChangeEventTypeUpdated)
+
+		// WHEN
+		broker.Dispatch(ctx, first)
+		broker.Dispatch(ctx, second)
+
+		// THEN
+		require.Len(t, events, 1)
+		assert.Equal(t, first, <-events)
+	})
+}
+
+func TestBroker_Subscribe(t *testing.T) {
+	t.Run("Unsubscribe removes the subscriber and closes the channel", func(t *testing.T) {
+		// GIVEN
+		broker := changeevent.NewBroker(1)
+		events, unsubscribe := broker.Subscribe(tenantID, resource.Application)
+		_, unsubscribeOther := broker.Subscribe(tenantID, resource.Runtime)
+		defer unsubscribeOther()
+		require.Equal(t, 2, broker.SubscribersCount())
+
+		// WHEN
+		unsubscribe()
+		unsubscribe()
+
+		// THEN
+		assert.Equal(t, 1, broker.SubscribersCount())
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+}
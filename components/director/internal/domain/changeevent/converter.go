@@ -0,0 +1,73 @@
+package changeevent
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter creates a new change event converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToApplicationEventGraphQL converts model.ChangeEvent to graphql.ApplicationEvent
+func (c *converter) ToApplicationEventGraphQL(in *model.ChangeEvent) *graphql.ApplicationEvent {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ApplicationEvent{
+		ID:            in.ID,
+		Type:          graphql.ChangeEventType(in.Type),
+		ApplicationID: in.ResourceID,
+		LabelKey:      optionalString(in.LabelKey),
+		OccurredAt:    graphql.Timestamp(in.OccurredAt),
+	}
+}
+
+// ToRuntimeEventGraphQL converts model.ChangeEvent to graphql.RuntimeEvent
+func (c *converter) ToRuntimeEventGraphQL(in *model.ChangeEvent) *graphql.RuntimeEvent {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.RuntimeEvent{
+		ID:         in.ID,
+		Type:       graphql.ChangeEventType(in.Type),
+		RuntimeID:  in.ResourceID,
+		LabelKey:   optionalString(in.LabelKey),
+		OccurredAt: graphql.Timestamp(in.OccurredAt),
+	}
+}
+
+// ToFormationEventGraphQL converts model.ChangeEvent to graphql.FormationEvent
+func (c *converter) ToFormationEventGraphQL(in *model.ChangeEvent) *graphql.FormationEvent {
+	if in == nil {
+		return nil
+	}
+
+	var objectType *graphql.FormationObjectType
+	if in.ObjectType != "" {
+		t := graphql.FormationObjectType(in.ObjectType)
+		objectType = &t
+	}
+
+	return &graphql.FormationEvent{
+		ID:            in.ID,
+		Type:          graphql.ChangeEventType(in.Type),
+		FormationID:   optionalString(in.ResourceID),
+		FormationName: in.ResourceName,
+		ObjectID:      optionalString(in.ObjectID),
+		ObjectType:    objectType,
+		OccurredAt:    graphql.Timestamp(in.OccurredAt),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,151 @@
+package changeevent_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToApplicationEventGraphQL(t *testing.T) {
+	key := labelKey
+	event := fixApplicationEvent(model.ChangeEventTypeLabelChanged)
+	event.LabelKey = key
+
+	testCases := []struct {
+		Name     string
+		Input    *model.ChangeEvent
+		Expected *graphql.ApplicationEvent
+	}{
+		{
+			Name:  "All properties given",
+			Input: event,
+			Expected: &graphql.ApplicationEvent{
+				ID:            eventID,
+				Type:          graphql.ChangeEventTypeLabelChanged,
+				ApplicationID: appID,
+				LabelKey:      &key,
+				OccurredAt:    graphql.Timestamp(occurredAt),
+			},
+		},
+		{
+			Name:  "Empty label key",
+			Input: fixApplicationEvent(model.ChangeEventTypeCreated),
+			Expected: &graphql.ApplicationEvent{
+				ID:            eventID,
+				Type:          graphql.ChangeEventTypeCreated,
+				ApplicationID: appID,
+				OccurredAt:    graphql.Timestamp(occurredAt),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			res := changeevent.NewConverter().ToApplicationEventGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_ToRuntimeEventGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.ChangeEvent
+		Expected *graphql.RuntimeEvent
+	}{
+		{
+			Name:  "All properties given",
+			Input: fixRuntimeEvent(model.ChangeEventTypeDeleted, runtimeID),
+			Expected: &graphql.RuntimeEvent{
+				ID:         eventID,
+				Type:       graphql.ChangeEventTypeDeleted,
+				RuntimeID:  runtimeID,
+				OccurredAt: graphql.Timestamp(occurredAt),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			res := changeevent.NewConverter().ToRuntimeEventGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
+func TestConverter_ToFormationEventGraphQL(t *testing.T) {
+	id := formationID
+	objectID := appID
+	objectType := graphql.FormationObjectTypeApplication
+
+	testCases := []struct {
+		Name     string
+		Input    *model.ChangeEvent
+		Expected *graphql.FormationEvent
+	}{
+		{
+			Name:  "All properties given",
+			Input: fixFormationEvent(model.ChangeEventTypeAssigned, formationName, appID),
+			Expected: &graphql.FormationEvent{
+				ID:            eventID,
+				Type:          graphql.ChangeEventTypeAssigned,
+				FormationID:   &id,
+				FormationName: formationName,
+				ObjectID:      &objectID,
+				ObjectType:    &objectType,
+				OccurredAt:    graphql.Timestamp(occurredAt),
+			},
+		},
+		{
+			Name: "Without object",
+			Input: &model.ChangeEvent{
+				ID:           eventID,
+				Type:         model.ChangeEventTypeCreated,
+				ResourceID:   formationID,
+				ResourceName: formationName,
+				OccurredAt:   occurredAt,
+			},
+			Expected: &graphql.FormationEvent{
+				ID:            eventID,
+				Type:          graphql.ChangeEventTypeCreated,
+				FormationID:   &id,
+				FormationName: formationName,
+				OccurredAt:    graphql.Timestamp(occurredAt),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			res := changeevent.NewConverter().ToFormationEventGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
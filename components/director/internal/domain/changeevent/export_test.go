@@ -0,0 +1,7 @@
+package changeevent
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
@@ -0,0 +1,67 @@
+package changeevent_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	eventID        = "0c3b5fb4-9a58-4e6d-97bf-6f3a4b1e2c11"
+	appID          = "1b9f6a6e-2d6f-4e39-9a43-6e7b0fd0c222"
+	runtimeID      = "c4a6a8e5-5a45-4b2e-8d4e-6a6df6e0b333"
+	formationID    = "d2e1f5b4-7e3c-4d87-8f5a-2c9b4d1e7444"
+	tenantID       = "f1f3b4c5-3c55-4ad9-9ad4-6c1c3b0f5555"
+	otherTenantID  = "a7c54a3c-7bc2-4e5e-bd73-1d1e5c6a6666"
+	labelKey       = "foo"
+	formationName  = "test-formation"
+	otherFormation = "other-formation"
+)
+
+var occurredAt = time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func fixApplicationEvent(eventType model.ChangeEventType, scenarios ...string) *model.ChangeEvent {
+	return &model.ChangeEvent{
+		ID:           eventID,
+		Type:         eventType,
+		ResourceType: resource.Application,
+		ResourceID:   appID,
+		Scenarios:    scenarios,
+		TenantIDs:    []string{tenantID},
+		OccurredAt:   occurredAt,
+	}
+}
+
+func fixRuntimeEvent(eventType model.ChangeEventType, id string) *model.ChangeEvent {
+	return &model.ChangeEvent{
+		ID:           eventID,
+		Type:         eventType,
+		ResourceType: resource.Runtime,
+		ResourceID:   id,
+		TenantIDs:    []string{tenantID},
+		OccurredAt:   occurredAt,
+	}
+}
+
+func fixFormationEvent(eventType model.ChangeEventType, name, objectID string) *model.ChangeEvent {
+	return &model.ChangeEvent{
+		ID:           eventID,
+		Type:         eventType,
+		ResourceType: resource.Formations,
+		ResourceID:   formationID,
+		ResourceName: name,
+		ObjectID:     objectID,
+		ObjectType:   string(graphql.FormationObjectTypeApplication),
+		TenantIDs:    []string{tenantID},
+		OccurredAt:   occurredAt,
+	}
+}
+
+func fixScenariosLabel(scenarios ...interface{}) *model.Label {
+	return &model.Label{
+		Key:   model.ScenariosKey,
+		Value: scenarios,
+	}
+}
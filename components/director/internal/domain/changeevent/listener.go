@@ -0,0 +1,86 @@
+package changeevent
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+// Config contains the configuration of the change events delivery
+type Config struct {
+	MinReconnectInterval time.Duration `envconfig:"default=1s,APP_CHANGE_EVENTS_MIN_RECONNECT_INTERVAL"`
+	MaxReconnectInterval time.Duration `envconfig:"default=1m,APP_CHANGE_EVENTS_MAX_RECONNECT_INTERVAL"`
+	PingInterval         time.Duration `envconfig:"default=90s,APP_CHANGE_EVENTS_PING_INTERVAL"`
+	SubscriberBufferSize int           `envconfig:"default=100,APP_CHANGE_EVENTS_SUBSCRIBER_BUFFER_SIZE"`
+}
+
+// Listener receives the change events published by all director replicas through Postgres notifications and dispatches them to the Broker
+type Listener struct {
+	cfg        Config
+	connString string
+	broker     *Broker
+}
+
+// NewListener creates a new Listener
+func NewListener(cfg Config, connString string, broker *Broker) *Listener {
+	return &Listener{
+		cfg:        cfg,
+		connString: connString,
+		broker:     broker,
+	}
+}
+
+// Start listens for change events until the context is cancelled
+func (l *Listener) Start(ctx context.Context) error {
+	listener := pq.NewListener(l.connString, l.cfg.MinReconnectInterval, l.cfg.MaxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Change events listener received event %d", event)
+		}
+	})
+	defer func() {
+		if err := listener.Close(); err != nil {
+			log.C(ctx).WithError(err).Error("An error has occurred while closing change events listener")
+		}
+	}()
+
+	if err := listener.Listen(NotificationChannel); err != nil {
+		return errors.Wrapf(err, "while listening on channel %s", NotificationChannel)
+	}
+
+	log.C(ctx).Infof("Listening for change events on channel %s", NotificationChannel)
+	ticker := time.NewTicker(l.cfg.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Stopping change events listener")
+			return nil
+		case notification := <-listener.Notify:
+			if notification == nil {
+				log.C(ctx).Info("Change events listener reconnected. Events published during the reconnection may have been lost")
+				continue
+			}
+			l.handle(ctx, notification.Extra)
+		case <-ticker.C:
+			if err := listener.Ping(); err != nil {
+				log.C(ctx).WithError(err).Error("An error has occurred while pinging the database from change events listener")
+			}
+		}
+	}
+}
+
+func (l *Listener) handle(ctx context.Context, payload string) {
+	event := &model.ChangeEvent{}
+	if err := json.Unmarshal([]byte(payload), event); err != nil {
+		log.C(ctx).WithError(err).Error("An error has occurred while unmarshalling change event")
+		return
+	}
+
+	l.broker.Dispatch(ctx, event)
+}
@@ -0,0 +1,88 @@
+package changeevent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	// NotificationChannel is the Postgres channel through which the change events are delivered to all director replicas
+	NotificationChannel = "compass_change_events"
+
+	// maxPayloadSize is the maximum size of a Postgres NOTIFY payload
+	maxPayloadSize = 8000
+
+	notifyQuery       = "SELECT pg_notify($1, $2)"
+	tenantAccessQuery = "SELECT DISTINCT %s FROM %s WHERE %s = $1"
+)
+
+type repository struct{}
+
+// NewRepository creates a new change event repository
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// Publish sends the change event as a Postgres notification. The notification is part of the transaction stored in the context,
+// so it is delivered to the listeners only if the transaction is committed.
+// An event exceeding the maximum notification size is published trimmed to its IDs, as the notification must never fail the change itself.
+func (r *repository) Publish(ctx context.Context, event *model.ChangeEvent) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling change event for %s with ID %s", event.ResourceType, event.ResourceID)
+	}
+
+	if len(payload) >= maxPayloadSize {
+		log.C(ctx).Warnf("Change event for %s with ID %s exceeds the maximum notification size of %d bytes. Publishing it trimmed to its IDs", event.ResourceType, event.ResourceID, maxPayloadSize)
+		if payload, err = json.Marshal(event.Trim()); err != nil {
+			return errors.Wrapf(err, "while marshalling trimmed change event for %s with ID %s", event.ResourceType, event.ResourceID)
+		}
+	}
+
+	if len(payload) >= maxPayloadSize {
+		log.C(ctx).Errorf("Trimmed change event for %s with ID %s still exceeds the maximum notification size of %d bytes. Dropping it", event.ResourceType, event.ResourceID, maxPayloadSize)
+		return nil
+	}
+
+	log.C(ctx).Debugf("Publishing %s change event for %s with ID %s", event.Type, event.ResourceType, event.ResourceID)
+	if _, err = persist.ExecContext(ctx, notifyQuery, NotificationChannel, string(payload)); err != nil {
+		return persistence.MapSQLError(ctx, err, event.ResourceType, resource.Create, "while publishing change event")
+	}
+
+	return nil
+}
+
+// ListTenantsWithAccess returns the IDs of all tenants which have access to the resource with the given type and ID
+func (r *repository) ListTenantsWithAccess(ctx context.Context, resourceType resource.Type, id string) ([]string, error) {
+	m2mTable, ok := resourceType.TenantAccessTable()
+	if !ok {
+		return nil, errors.Errorf("entity %s does not have access table", resourceType)
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var tenantIDs []string
+	stmt := fmt.Sprintf(tenantAccessQuery, repo.M2MTenantIDColumn, m2mTable, repo.M2MResourceIDColumn)
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	if err = persist.SelectContext(ctx, &tenantIDs, stmt, id); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resourceType, resource.List, "while listing tenants with access to %s with ID %s", resourceType, id)
+	}
+
+	return tenantIDs, nil
+}
@@ -0,0 +1,148 @@
+package changeevent_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Publish(t *testing.T) {
+	event := fixApplicationEvent("CREATED", formationName)
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+			WithArgs(changeevent.NotificationChannel, string(payload)).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changeevent.NewRepository()
+
+		// WHEN
+		err := repo.Publish(ctx, event)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when notify fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+			WithArgs(changeevent.NotificationChannel, string(payload)).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changeevent.NewRepository()
+
+		// WHEN
+		err := repo.Publish(ctx, event)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Success with the event trimmed to its IDs when payload is too large", func(t *testing.T) {
+		// GIVEN
+		largeEvent := fixApplicationEvent("CREATED")
+		for i := 0; i < 1000; i++ {
+			largeEvent.TenantIDs = append(largeEvent.TenantIDs, otherTenantID)
+		}
+
+		trimmedPayload, err := json.Marshal(largeEvent.Trim())
+		require.NoError(t, err)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+			WithArgs(changeevent.NotificationChannel, string(trimmedPayload)).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changeevent.NewRepository()
+
+		// WHEN
+		err = repo.Publish(ctx, largeEvent)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		err := changeevent.NewRepository().Publish(context.TODO(), event)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_ListTenantsWithAccess(t *testing.T) {
+	query := regexp.QuoteMeta("SELECT DISTINCT tenant_id FROM tenant_applications WHERE id = $1")
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"tenant_id"}).AddRow(tenantID).AddRow(otherTenantID)
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changeevent.NewRepository()
+
+		// WHEN
+		tenantIDs, err := repo.ListTenantsWithAccess(ctx, resource.Application, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{tenantID, otherTenantID}, tenantIDs)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := changeevent.NewRepository()
+
+		// WHEN
+		_, err := repo.ListTenantsWithAccess(ctx, resource.Application, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when resource does not have access table", func(t *testing.T) {
+		// WHEN
+		_, err := changeevent.NewRepository().ListTenantsWithAccess(context.TODO(), resource.Formations, formationID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not have access table")
+	})
+}
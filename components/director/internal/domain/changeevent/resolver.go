@@ -0,0 +1,284 @@
+package changeevent
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// EventBroker is responsible for delivering the change events to the subscribers
+//go:generate mockery --name=EventBroker --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EventBroker interface {
+	Subscribe(tenantID string, resourceType resource.Type) (<-chan *model.ChangeEvent, func())
+}
+
+// Converter is responsible for converting the change events to their GraphQL representations
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Converter interface {
+	ToApplicationEventGraphQL(in *model.ChangeEvent) *graphql.ApplicationEvent
+	ToRuntimeEventGraphQL(in *model.ChangeEvent) *graphql.RuntimeEvent
+	ToFormationEventGraphQL(in *model.ChangeEvent) *graphql.FormationEvent
+}
+
+// Resolver is the change events subscriptions resolver
+type Resolver struct {
+	transact  persistence.Transactioner
+	broker    EventBroker
+	repo      ChangeEventRepository
+	labelRepo LabelRepository
+	conv      Converter
+}
+
+// NewResolver creates a new change events resolver
+func NewResolver(transact persistence.Transactioner, broker EventBroker, repo ChangeEventRepository, labelRepo LabelRepository, conv Converter) *Resolver {
+	return &Resolver{
+		transact:  transact,
+		broker:    broker,
+		repo:      repo,
+		labelRepo: labelRepo,
+		conv:      conv,
+	}
+}
+
+// ApplicationEvents streams the change events of the applications visible to the caller until the subscription is closed
+func (r *Resolver) ApplicationEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.ApplicationEvent, error) {
+	out := make(chan *graphql.ApplicationEvent)
+	send := func(event *model.ChangeEvent) bool {
+		select {
+		case out <- r.conv.ToApplicationEventGraphQL(event):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if err := r.forward(ctx, resource.Application, types, send, func() { close(out) }); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// RuntimeEvents streams the change events of the runtimes visible to the caller until the subscription is closed
+func (r *Resolver) RuntimeEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.RuntimeEvent, error) {
+	out := make(chan *graphql.RuntimeEvent)
+	send := func(event *model.ChangeEvent) bool {
+		select {
+		case out <- r.conv.ToRuntimeEventGraphQL(event):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if err := r.forward(ctx, resource.Runtime, types, send, func() { close(out) }); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// FormationEvents streams the change events of the formations visible to the caller until the subscription is closed
+func (r *Resolver) FormationEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.FormationEvent, error) {
+	out := make(chan *graphql.FormationEvent)
+	send := func(event *model.ChangeEvent) bool {
+		select {
+		case out <- r.conv.ToFormationEventGraphQL(event):
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	if err := r.forward(ctx, resource.Formations, types, send, func() { close(out) }); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *Resolver) forward(ctx context.Context, resourceType resource.Type, types []graphql.ChangeEventType, send func(*model.ChangeEvent) bool, done func()) error {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading consumer from context")
+	}
+
+	events, unsubscribe := r.broker.Subscribe(tenantID, resourceType)
+	log.C(ctx).Infof("Consumer with ID %s subscribed for %s change events in tenant %s", consumerInfo.ConsumerID, resourceType, tenantID)
+
+	go func() {
+		defer done()
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.C(ctx).Infof("Consumer with ID %s unsubscribed from %s change events in tenant %s", consumerInfo.ConsumerID, resourceType, tenantID)
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !hasType(types, event.Type) {
+					continue
+				}
+
+				if event.Trimmed {
+					refetched, err := r.refetchTrimmed(ctx, tenantID, event)
+					if err != nil {
+						log.C(ctx).WithError(err).Errorf("An error occurred while fetching the details of trimmed %s change event with ID %s: %v", event.ResourceType, event.ID, err)
+						continue
+					}
+					if !refetched.HasTenant(tenantID) {
+						continue
+					}
+					event = refetched
+				}
+
+				visible, err := r.isVisible(ctx, tenantID, consumerInfo, event)
+				if err != nil {
+					log.C(ctx).WithError(err).Errorf("An error occurred while checking visibility of %s change event with ID %s: %v", event.ResourceType, event.ID, err)
+					continue
+				}
+				if !visible {
+					continue
+				}
+
+				if !send(event) {
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// isVisible checks whether the event should be delivered to the consumer. Runtimes receive only the events of the resources in their scenarios.
+func (r *Resolver) isVisible(ctx context.Context, tenantID string, consumerInfo consumer.Consumer, event *model.ChangeEvent) (bool, error) {
+	if consumerInfo.ConsumerType != consumer.Runtime {
+		return true, nil
+	}
+
+	runtimeID := consumerInfo.ConsumerID
+	if event.ResourceType == resource.Runtime {
+		return event.ResourceID == runtimeID, nil
+	}
+	if event.ResourceType == resource.Formations && event.ObjectID == runtimeID {
+		return true, nil
+	}
+
+	runtimeScenarios, err := r.getRuntimeScenarios(ctx, tenantID, runtimeID)
+	if err != nil {
+		return false, err
+	}
+
+	switch event.ResourceType {
+	case resource.Application:
+		return containsAny(runtimeScenarios, event.Scenarios), nil
+	case resource.Formations:
+		return containsAny(runtimeScenarios, []string{event.ResourceName}), nil
+	default:
+		return false, nil
+	}
+}
+
+// refetchTrimmed returns a copy of the trimmed event with the tenants with access to its resource, and the scenarios of its resource for applications
+func (r *Resolver) refetchTrimmed(ctx context.Context, tenantID string, event *model.ChangeEvent) (*model.ChangeEvent, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	refetched := *event
+	if refetched.TenantIDs, err = r.repo.ListTenantsWithAccess(ctx, event.ResourceType, event.ResourceID); err != nil {
+		return nil, errors.Wrapf(err, "while listing tenants with access to %s with ID %s", event.ResourceType, event.ResourceID)
+	}
+
+	if event.ResourceType == resource.Application && refetched.HasTenant(tenantID) {
+		scenariosLabel, err := r.labelRepo.GetByKey(ctx, tenantID, model.ApplicationLabelableObject, event.ResourceID, model.ScenariosKey)
+		if err != nil && !apperrors.IsNotFoundError(err) {
+			return nil, errors.Wrapf(err, "while getting scenarios for application with ID %s", event.ResourceID)
+		}
+		if err == nil {
+			if refetched.Scenarios, err = label.ValueToStringsSlice(scenariosLabel.Value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &refetched, nil
+}
+
+func (r *Resolver) getRuntimeScenarios(ctx context.Context, tenantID, runtimeID string) ([]string, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	var scenarios []string
+	scenariosLabel, err := r.labelRepo.GetByKey(ctx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "while getting scenarios for runtime with ID %s", runtimeID)
+	}
+	if err == nil {
+		if scenarios, err = label.ValueToStringsSlice(scenariosLabel.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return scenarios, nil
+}
+
+func hasType(types []graphql.ChangeEventType, eventType model.ChangeEventType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if string(t) == string(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values, candidates []string) bool {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	for _, c := range candidates {
+		if _, ok := set[c]; ok {
+			return true
+		}
+	}
+	return false
+}
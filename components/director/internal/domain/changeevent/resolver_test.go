@@ -0,0 +1,248 @@
+package changeevent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const receiveTimeout = time.Second
+
+func TestResolver_ApplicationEvents(t *testing.T) {
+	t.Run("Delivers the events of the requested types to non-runtime consumers", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.IntegrationSystem, "integration-system"))
+		defer cancel()
+
+		events := make(chan *model.ChangeEvent, 2)
+		broker := fixEventBroker(events, resource.Application)
+		labelRepo := &automock.LabelRepository{}
+		transact := &persistenceautomock.Transactioner{}
+		defer mock.AssertExpectationsForObjects(t, broker, labelRepo, transact)
+
+		resolver := changeevent.NewResolver(transact, broker, nil, labelRepo, changeevent.NewConverter())
+
+		// WHEN
+		out, err := resolver.ApplicationEvents(ctx, []graphql.ChangeEventType{graphql.ChangeEventTypeUpdated})
+		require.NoError(t, err)
+
+		events <- fixApplicationEvent(model.ChangeEventTypeCreated)
+		events <- fixApplicationEvent(model.ChangeEventTypeUpdated)
+
+		// THEN
+		event := receiveApplicationEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeUpdated, event.Type)
+		assert.Equal(t, appID, event.ApplicationID)
+	})
+
+	t.Run("Delivers to runtimes only the events of applications in their scenarios", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.Runtime, runtimeID))
+		defer cancel()
+
+		events := make(chan *model.ChangeEvent, 2)
+		broker := fixEventBroker(events, resource.Application)
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(2)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(fixScenariosLabel(formationName), nil).Twice()
+		defer mock.AssertExpectationsForObjects(t, broker, labelRepo, persist, transact)
+
+		resolver := changeevent.NewResolver(transact, broker, nil, labelRepo, changeevent.NewConverter())
+
+		// WHEN
+		out, err := resolver.ApplicationEvents(ctx, nil)
+		require.NoError(t, err)
+
+		events <- fixApplicationEvent(model.ChangeEventTypeCreated, otherFormation)
+		events <- fixApplicationEvent(model.ChangeEventTypeUpdated, formationName)
+
+		// THEN
+		event := receiveApplicationEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeUpdated, event.Type)
+	})
+
+	t.Run("Delivers trimmed events to runtimes after fetching their tenants and scenarios", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.Runtime, runtimeID))
+		defer cancel()
+
+		events := make(chan *model.ChangeEvent, 2)
+		broker := fixEventBroker(events, resource.Application)
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(3)
+		repo := &automock.ChangeEventRepository{}
+		repo.On("ListTenantsWithAccess", txtest.CtxWithDBMatcher(), resource.Application, appID).Return([]string{otherTenantID}, nil).Once()
+		repo.On("ListTenantsWithAccess", txtest.CtxWithDBMatcher(), resource.Application, appID).Return([]string{otherTenantID, tenantID}, nil).Once()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID, model.ScenariosKey).Return(fixScenariosLabel(formationName), nil).Once()
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(fixScenariosLabel(formationName), nil).Once()
+		defer mock.AssertExpectationsForObjects(t, broker, repo, labelRepo, persist, transact)
+
+		resolver := changeevent.NewResolver(transact, broker, repo, labelRepo, changeevent.NewConverter())
+
+		// WHEN
+		out, err := resolver.ApplicationEvents(ctx, nil)
+		require.NoError(t, err)
+
+		events <- fixApplicationEvent(model.ChangeEventTypeCreated).Trim()
+		events <- fixApplicationEvent(model.ChangeEventTypeUpdated).Trim()
+
+		// THEN
+		event := receiveApplicationEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeUpdated, event.Type)
+		assert.Equal(t, appID, event.ApplicationID)
+	})
+
+	t.Run("Returns error when tenant is missing in the context", func(t *testing.T) {
+		// GIVEN
+		resolver := changeevent.NewResolver(nil, &automock.EventBroker{}, nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.ApplicationEvents(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
+func TestResolver_RuntimeEvents(t *testing.T) {
+	t.Run("Delivers to runtimes only their own events", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.Runtime, runtimeID))
+		defer cancel()
+
+		events := make(chan *model.ChangeEvent, 2)
+		broker := fixEventBroker(events, resource.Runtime)
+		defer mock.AssertExpectationsForObjects(t, broker)
+
+		resolver := changeevent.NewResolver(nil, broker, nil, nil, changeevent.NewConverter())
+
+		// WHEN
+		out, err := resolver.RuntimeEvents(ctx, nil)
+		require.NoError(t, err)
+
+		events <- fixRuntimeEvent(model.ChangeEventTypeUpdated, "other-runtime")
+		events <- fixRuntimeEvent(model.ChangeEventTypeDeleted, runtimeID)
+
+		// THEN
+		event := receiveRuntimeEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeDeleted, event.Type)
+		assert.Equal(t, runtimeID, event.RuntimeID)
+	})
+
+	t.Run("Closes the channel when the subscription is cancelled", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.User, "user"))
+
+		events := make(chan *model.ChangeEvent)
+		unsubscribed := make(chan struct{})
+		broker := &automock.EventBroker{}
+		broker.On("Subscribe", tenantID, resource.Runtime).Return((<-chan *model.ChangeEvent)(events), func() { close(unsubscribed) }).Once()
+		defer mock.AssertExpectationsForObjects(t, broker)
+
+		resolver := changeevent.NewResolver(nil, broker, nil, nil, changeevent.NewConverter())
+
+		out, err := resolver.RuntimeEvents(ctx, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		cancel()
+
+		// THEN
+		select {
+		case _, ok := <-out:
+			assert.False(t, ok)
+		case <-time.After(receiveTimeout):
+			t.Fatal("subscription channel was not closed")
+		}
+		<-unsubscribed
+	})
+}
+
+func TestResolver_FormationEvents(t *testing.T) {
+	t.Run("Delivers to runtimes the events of their formations", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(fixContext(consumer.Runtime, runtimeID))
+		defer cancel()
+
+		events := make(chan *model.ChangeEvent, 3)
+		broker := fixEventBroker(events, resource.Formations)
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(2)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(fixScenariosLabel(formationName), nil).Twice()
+		defer mock.AssertExpectationsForObjects(t, broker, labelRepo, persist, transact)
+
+		resolver := changeevent.NewResolver(transact, broker, nil, labelRepo, changeevent.NewConverter())
+
+		// WHEN
+		out, err := resolver.FormationEvents(ctx, nil)
+		require.NoError(t, err)
+
+		events <- fixFormationEvent(model.ChangeEventTypeAssigned, otherFormation, appID)
+		events <- fixFormationEvent(model.ChangeEventTypeUnassigned, otherFormation, runtimeID)
+		events <- fixFormationEvent(model.ChangeEventTypeAssigned, formationName, appID)
+
+		// THEN
+		event := receiveFormationEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeUnassigned, event.Type)
+		assert.Equal(t, otherFormation, event.FormationName)
+
+		event = receiveFormationEvent(t, out)
+		assert.Equal(t, graphql.ChangeEventTypeAssigned, event.Type)
+		assert.Equal(t, formationName, event.FormationName)
+	})
+}
+
+func fixContext(consumerType consumer.ConsumerType, consumerID string) context.Context {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, tenantID)
+	return consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: consumerID, ConsumerType: consumerType})
+}
+
+func fixEventBroker(events chan *model.ChangeEvent, resourceType resource.Type) *automock.EventBroker {
+	broker := &automock.EventBroker{}
+	broker.On("Subscribe", tenantID, resourceType).Return((<-chan *model.ChangeEvent)(events), func() {}).Once()
+	return broker
+}
+
+func receiveApplicationEvent(t *testing.T, out <-chan *graphql.ApplicationEvent) *graphql.ApplicationEvent {
+	select {
+	case event := <-out:
+		return event
+	case <-time.After(receiveTimeout):
+		t.Fatal("timed out waiting for application event")
+	}
+	return nil
+}
+
+func receiveRuntimeEvent(t *testing.T, out <-chan *graphql.RuntimeEvent) *graphql.RuntimeEvent {
+	select {
+	case event := <-out:
+		return event
+	case <-time.After(receiveTimeout):
+		t.Fatal("timed out waiting for runtime event")
+	}
+	return nil
+}
+
+func receiveFormationEvent(t *testing.T, out <-chan *graphql.FormationEvent) *graphql.FormationEvent {
+	select {
+	case event := <-out:
+		return event
+	case <-time.After(receiveTimeout):
+		t.Fatal("timed out waiting for formation event")
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package changeevent
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// ChangeEventRepository is responsible for the repo-layer change event operations
+//go:generate mockery --name=ChangeEventRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ChangeEventRepository interface {
+	Publish(ctx context.Context, event *model.ChangeEvent) error
+	ListTenantsWithAccess(ctx context.Context, resourceType resource.Type, id string) ([]string, error)
+}
+
+// LabelRepository is responsible for the repo-layer label operations
+//go:generate mockery --name=LabelRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+}
+
+// UIDService is responsible for generating the IDs of the change events
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         ChangeEventRepository
+	labelRepo    LabelRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+// NewService creates a new change event service
+func NewService(repo ChangeEventRepository, labelRepo LabelRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		labelRepo:    labelRepo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator,
+	}
+}
+
+// Publish publishes a change event for the resource described by the input. The event is delivered to all tenants which have access to the resource.
+// For resources without tenant access records, such as formations, the event is delivered only to the provided tenant.
+// Application events carry the scenarios of the application, so that they can be delivered to the runtimes in the same scenarios.
+func (s *service) Publish(ctx context.Context, tenantID string, in model.ChangeEventInput) error {
+	tenantIDs := []string{tenantID}
+	if in.ResourceType.IsTopLevel() {
+		accessTenants, err := s.repo.ListTenantsWithAccess(ctx, in.ResourceType, in.ResourceID)
+		if err != nil {
+			return errors.Wrapf(err, "while listing tenants with access to %s with ID %s", in.ResourceType, in.ResourceID)
+		}
+		if len(accessTenants) > 0 {
+			tenantIDs = accessTenants
+		}
+	}
+
+	var scenarios []string
+	if in.ResourceType == resource.Application {
+		var err error
+		if scenarios, err = s.getScenarios(ctx, tenantID, in.ResourceID); err != nil {
+			return err
+		}
+	}
+
+	event := &model.ChangeEvent{
+		ID:           s.uidService.Generate(),
+		Type:         in.Type,
+		ResourceType: in.ResourceType,
+		ResourceID:   in.ResourceID,
+		ResourceName: in.ResourceName,
+		LabelKey:     in.LabelKey,
+		ObjectID:     in.ObjectID,
+		ObjectType:   in.ObjectType,
+		Scenarios:    scenarios,
+		TenantIDs:    tenantIDs,
+		OccurredAt:   s.timestampGen(),
+	}
+
+	if err := s.repo.Publish(ctx, event); err != nil {
+		return errors.Wrapf(err, "while publishing %s change event for %s with ID %s", in.Type, in.ResourceType, in.ResourceID)
+	}
+
+	return nil
+}
+
+func (s *service) getScenarios(ctx context.Context, tenantID, appID string) ([]string, error) {
+	scenariosLabel, err := s.labelRepo.GetByKey(ctx, tenantID, model.ApplicationLabelableObject, appID, model.ScenariosKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting scenarios for application with ID %s", appID)
+	}
+
+	return label.ValueToStringsSlice(scenariosLabel.Value)
+}
@@ -0,0 +1,188 @@
+package changeevent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Publish(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+
+	appInput := model.ChangeEventInput{
+		Type:         model.ChangeEventTypeLabelChanged,
+		ResourceType: resource.Application,
+		ResourceID:   appID,
+		LabelKey:     labelKey,
+	}
+	formationInput := model.ChangeEventInput{
+		Type:         model.ChangeEventTypeAssigned,
+		ResourceType: resource.Formations,
+		ResourceID:   formationID,
+		ResourceName: formationName,
+		ObjectID:     appID,
+		ObjectType:   string(graphql.FormationObjectTypeApplication),
+	}
+
+	appEvent := &model.ChangeEvent{
+		ID:           eventID,
+		Type:         model.ChangeEventTypeLabelChanged,
+		ResourceType: resource.Application,
+		ResourceID:   appID,
+		LabelKey:     labelKey,
+		Scenarios:    []string{formationName},
+		TenantIDs:    []string{tenantID, otherTenantID},
+		OccurredAt:   occurredAt,
+	}
+	appEventWithoutScenarios := *appEvent
+	appEventWithoutScenarios.Scenarios = nil
+	appEventWithoutScenarios.TenantIDs = []string{tenantID}
+
+	formationEvent := fixFormationEvent(model.ChangeEventTypeAssigned, formationName, appID)
+
+	testCases := []struct {
+		Name          string
+		Input         model.ChangeEventInput
+		RepoFn        func() *automock.ChangeEventRepository
+		LabelRepoFn   func() *automock.LabelRepository
+		UIDServiceFn  func() *automock.UIDService
+		ExpectedError error
+	}{
+		{
+			Name:  "Success for application",
+			Input: appInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("ListTenantsWithAccess", ctx, resource.Application, appID).Return([]string{tenantID, otherTenantID}, nil).Once()
+				repo.On("Publish", ctx, appEvent).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tenantID, model.ApplicationLabelableObject, appID, model.ScenariosKey).Return(fixScenariosLabel(formationName), nil).Once()
+				return repo
+			},
+			UIDServiceFn: fixUIDService,
+		},
+		{
+			Name:  "Success for application without scenarios and tenant access records",
+			Input: appInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("ListTenantsWithAccess", ctx, resource.Application, appID).Return(nil, nil).Once()
+				repo.On("Publish", ctx, &appEventWithoutScenarios).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tenantID, model.ApplicationLabelableObject, appID, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, model.ScenariosKey)).Once()
+				return repo
+			},
+			UIDServiceFn: fixUIDService,
+		},
+		{
+			Name:  "Success for formation",
+			Input: formationInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("Publish", ctx, formationEvent).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn: fixUIDService,
+		},
+		{
+			Name:  "Error when listing tenants with access fails",
+			Input: appInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("ListTenantsWithAccess", ctx, resource.Application, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:  "Error when getting scenarios fails",
+			Input: appInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("ListTenantsWithAccess", ctx, resource.Application, appID).Return([]string{tenantID}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tenantID, model.ApplicationLabelableObject, appID, model.ScenariosKey).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:  "Error when publishing fails",
+			Input: formationInput,
+			RepoFn: func() *automock.ChangeEventRepository {
+				repo := &automock.ChangeEventRepository{}
+				repo.On("Publish", ctx, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			UIDServiceFn:  fixUIDService,
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			uidService := testCase.UIDServiceFn()
+
+			svc := changeevent.NewService(repo, labelRepo, uidService)
+			svc.SetTimestampGen(func() time.Time { return occurredAt })
+
+			// WHEN
+			err := svc.Publish(ctx, tenantID, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, labelRepo, uidService)
+		})
+	}
+}
+
+func fixUIDService() *automock.UIDService {
+	uidService := &automock.UIDService{}
+	uidService.On("Generate").Return(eventID).Once()
+	return uidService
+}
@@ -3,7 +3,6 @@ package document
 import (
 	"context"
 
-	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -142,7 +141,7 @@ func (r *repository) Delete(ctx context.Context, tenant, id string) error {
 // ListByBundleIDs missing godoc
 func (r *repository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error) {
 	var documentCollection DocumentCollection
-	counts, err := r.unionLister.List(ctx, resource.Document, tenantID, bundleIDs, bundleIDColumn, pageSize, cursor, orderByColumns, &documentCollection)
+	counts, pages, err := r.unionLister.List(ctx, resource.Document, tenantID, bundleIDs, bundleIDColumn, pageSize, cursor, orderByColumns, &documentCollection)
 	if err != nil {
 		return nil, err
 	}
@@ -156,28 +155,9 @@ func (r *repository) ListByBundleIDs(ctx context.Context, tenantID string, bundl
 		documentByID[documentEnt.BndlID] = append(documentByID[documentEnt.BndlID], m)
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
-	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
-	}
-
 	documentPages := make([]*model.DocumentPage, 0, len(bundleIDs))
 	for _, bndlID := range bundleIDs {
-		totalCount := counts[bndlID]
-		hasNextPage := false
-		endCursor := ""
-		if totalCount > offset+len(documentByID[bndlID]) {
-			hasNextPage = true
-			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
-		}
-
-		page := &pagination.Page{
-			StartCursor: cursor,
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		}
-
-		documentPages = append(documentPages, &model.DocumentPage{Data: documentByID[bndlID], TotalCount: totalCount, PageInfo: page})
+		documentPages = append(documentPages, &model.DocumentPage{Data: documentByID[bndlID], TotalCount: counts[bndlID], PageInfo: pages[bndlID]})
 	}
 
 	return documentPages, nil
@@ -193,6 +193,11 @@ func TestRepository_ListAllForBundle(t *testing.T) {
 	secondDocEntity := fixEntityDocument(secondDocID, multiplePagesBundleID)
 	secondDocModel := fixModelDocument(secondDocID, multiplePagesBundleID)
 
+	thirdDocID := "333333333-3333-3333-3333-333333333333"
+	thirdDocEntity := fixEntityDocument(thirdDocID, multiplePagesBundleID)
+	multiplePagesEndCursor, err := pagination.EncodeKeysetCursor(secondDocID)
+	require.NoError(t, err)
+
 	suite := testdb.RepoListPageableTestSuite{
 		Name: "List Documents for multiple bundles with paging",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
@@ -201,14 +206,16 @@ func TestRepository_ListAllForBundle(t *testing.T) {
 												UNION
 												(SELECT id, bundle_id, app_id, title, display_name, description, format, kind, data, ready, created_at, updated_at, deleted_at, error FROM public.documents WHERE (id IN (SELECT id FROM documents_tenants WHERE tenant_id = $5)) AND bundle_id = $6 ORDER BY bundle_id ASC, id ASC LIMIT $7 OFFSET $8)
 												UNION
-												(SELECT id, bundle_id, app_id, title, display_name, description, format, kind, data, ready, created_at, updated_at, deleted_at, error FROM public.documents WHERE (id IN (SELECT id FROM documents_tenants WHERE tenant_id = $9)) AND bundle_id = $10 ORDER BY bundle_id ASC, id ASC LIMIT $11 OFFSET $12)`),
+												(SELECT id, bundle_id, app_id, title, display_name, description, format, kind, data, ready, created_at, updated_at, deleted_at, error FROM public.documents WHERE (id IN (SELECT id FROM documents_tenants WHERE tenant_id = $9)) AND bundle_id = $10 ORDER BY bundle_id ASC, id ASC LIMIT $11 OFFSET $12)
+												ORDER BY bundle_id ASC, id ASC`),
 
-				Args:     []driver.Value{givenTenant(), emptyPageBundleID, pageSize, 0, givenTenant(), onePageBundleID, pageSize, 0, givenTenant(), multiplePagesBundleID, pageSize, 0},
+				Args:     []driver.Value{givenTenant(), emptyPageBundleID, pageSize + 1, 0, givenTenant(), onePageBundleID, pageSize + 1, 0, givenTenant(), multiplePagesBundleID, pageSize + 1, 0},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(columns).
 						AddRow(firstDocID, firstDocEntity.BndlID, firstDocEntity.AppID, firstDocEntity.Title, firstDocEntity.DisplayName, firstDocEntity.Description, firstDocEntity.Format, firstDocEntity.Kind, firstDocEntity.Data, firstDocEntity.Ready, firstDocEntity.CreatedAt, firstDocEntity.UpdatedAt, firstDocEntity.DeletedAt, firstDocEntity.Error).
-						AddRow(secondDocID, secondDocEntity.BndlID, secondDocEntity.AppID, secondDocEntity.Title, secondDocEntity.DisplayName, secondDocEntity.Description, secondDocEntity.Format, secondDocEntity.Kind, secondDocEntity.Data, secondDocEntity.Ready, secondDocEntity.CreatedAt, secondDocEntity.UpdatedAt, secondDocEntity.DeletedAt, secondDocEntity.Error),
+						AddRow(secondDocID, secondDocEntity.BndlID, secondDocEntity.AppID, secondDocEntity.Title, secondDocEntity.DisplayName, secondDocEntity.Description, secondDocEntity.Format, secondDocEntity.Kind, secondDocEntity.Data, secondDocEntity.Ready, secondDocEntity.CreatedAt, secondDocEntity.UpdatedAt, secondDocEntity.DeletedAt, secondDocEntity.Error).
+						AddRow(thirdDocID, thirdDocEntity.BndlID, thirdDocEntity.AppID, thirdDocEntity.Title, thirdDocEntity.DisplayName, thirdDocEntity.Description, thirdDocEntity.Format, thirdDocEntity.Kind, thirdDocEntity.Data, thirdDocEntity.Ready, thirdDocEntity.CreatedAt, thirdDocEntity.UpdatedAt, thirdDocEntity.DeletedAt, thirdDocEntity.Error),
 					}
 				},
 			},
@@ -255,7 +262,7 @@ func TestRepository_ListAllForBundle(t *testing.T) {
 					Data: []*model.Document{secondDocModel},
 					PageInfo: &pagination.Page{
 						StartCursor: "",
-						EndCursor:   pagination.EncodeNextOffsetCursor(0, pageSize),
+						EndCursor:   multiplePagesEndCursor,
 						HasNextPage: true,
 					},
 					TotalCount: 2,
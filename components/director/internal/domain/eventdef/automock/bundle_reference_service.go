@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
 	testing "testing"
@@ -69,7 +71,7 @@ func (_m *BundleReferenceService) GetForBundle(ctx context.Context, objectType m
 }
 
 // ListByBundleIDs provides a mock function with given fields: ctx, objectType, bundleIDs, pageSize, cursor
-func (_m *BundleReferenceService) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, error) {
+func (_m *BundleReferenceService) ListByBundleIDs(ctx context.Context, objectType model.BundleReferenceObjectType, bundleIDs []string, pageSize int, cursor string) ([]*model.BundleReference, map[string]int, map[string]*pagination.Page, error) {
 	ret := _m.Called(ctx, objectType, bundleIDs, pageSize, cursor)
 
 	var r0 []*model.BundleReference
@@ -90,14 +92,23 @@ func (_m *BundleReferenceService) ListByBundleIDs(ctx context.Context, objectTyp
 		}
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(context.Context, model.BundleReferenceObjectType, []string, int, string) error); ok {
+	var r2 map[string]*pagination.Page
+	if rf, ok := ret.Get(2).(func(context.Context, model.BundleReferenceObjectType, []string, int, string) map[string]*pagination.Page); ok {
 		r2 = rf(ctx, objectType, bundleIDs, pageSize, cursor)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(map[string]*pagination.Page)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, model.BundleReferenceObjectType, []string, int, string) error); ok {
+		r3 = rf(ctx, objectType, bundleIDs, pageSize, cursor)
+	} else {
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 // UpdateByReferenceObjectID provides a mock function with given fields: ctx, in, objectType, objectID, bundleID
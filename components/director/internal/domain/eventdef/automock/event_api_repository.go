@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
 	testing "testing"
@@ -128,13 +130,13 @@ func (_m *EventAPIRepository) ListByApplicationID(ctx context.Context, tenantID
 	return r0, r1
 }
 
-// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pageSize, cursor
-func (_m *EventAPIRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pageSize int, cursor string) ([]*model.EventDefinitionPage, error) {
-	ret := _m.Called(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pageSize, cursor)
+// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages
+func (_m *EventAPIRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pages map[string]*pagination.Page) ([]*model.EventDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages)
 
 	var r0 []*model.EventDefinitionPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, int, string) []*model.EventDefinitionPage); ok {
-		r0 = rf(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, map[string]*pagination.Page) []*model.EventDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.EventDefinitionPage)
@@ -142,8 +144,8 @@ func (_m *EventAPIRepository) ListByBundleIDs(ctx context.Context, tenantID stri
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, int, string) error); ok {
-		r1 = rf(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, []*model.BundleReference, map[string]int, map[string]*pagination.Page) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -93,7 +93,7 @@ func (r *pgRepository) GetForBundle(ctx context.Context, tenant string, id strin
 }
 
 // ListByBundleIDs retrieves all EventDefinitions for a Bundle in pages. Each Bundle is extracted from the input array of bundleIDs. The input bundleReferences array is used for getting the appropriate EventDefinition IDs.
-func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pageSize int, cursor string) ([]*model.EventDefinitionPage, error) {
+func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string, bundleRefs []*model.BundleReference, totalCounts map[string]int, pages map[string]*pagination.Page) ([]*model.EventDefinitionPage, error) {
 	eventDefIDs := make([]string, 0, len(bundleRefs))
 	for _, ref := range bundleRefs {
 		eventDefIDs = append(eventDefIDs, *ref.ObjectID)
@@ -114,29 +114,11 @@ func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bun
 
 	refsByBundleID, eventDefsByEventDefID := r.groupEntitiesByID(bundleRefs, eventCollection)
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
-	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
-	}
-
 	eventDefPages := make([]*model.EventDefinitionPage, 0, len(bundleIDs))
 	for _, bundleID := range bundleIDs {
 		ids := getEventDefIDsForBundle(refsByBundleID[bundleID])
 		eventDefs := getEventDefsForBundle(ids, eventDefsByEventDefID)
-		hasNextPage := false
-		endCursor := ""
-		if totalCounts[bundleID] > offset+len(eventDefs) {
-			hasNextPage = true
-			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
-		}
-
-		page := &pagination.Page{
-			StartCursor: cursor,
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		}
-
-		eventDefPages = append(eventDefPages, &model.EventDefinitionPage{Data: eventDefs, TotalCount: totalCounts[bundleID], PageInfo: page})
+		eventDefPages = append(eventDefPages, &model.EventDefinitionPage{Data: eventDefs, TotalCount: totalCounts[bundleID], PageInfo: pages[bundleID]})
 	}
 
 	return eventDefPages, nil
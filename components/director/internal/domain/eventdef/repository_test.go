@@ -93,8 +93,7 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 }
 
 func TestPgRepository_ListAllForBundle(t *testing.T) {
-	pageSize := 1
-	cursor := ""
+	multiplePagesEndCursor := "multiplePagesEndCursor"
 
 	emptyPageBundleID := "emptyPageBundleID"
 
@@ -117,6 +116,12 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		multiplePagesBundleID: 2,
 	}
 
+	pages := map[string]*pagination.Page{
+		emptyPageBundleID:     {},
+		onePageBundleID:       {},
+		multiplePagesBundleID: {EndCursor: multiplePagesEndCursor, HasNextPage: true},
+	}
+
 	suite := testdb.RepoListPageableTestSuite{
 		Name: "List Events for multiple bundles with paging",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
@@ -163,7 +168,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 					Data: []*model.EventDefinition{&secondEventDef},
 					PageInfo: &pagination.Page{
 						StartCursor: "",
-						EndCursor:   pagination.EncodeNextOffsetCursor(0, pageSize),
+						EndCursor:   multiplePagesEndCursor,
 						HasNextPage: true,
 					},
 					TotalCount: 2,
@@ -176,7 +181,7 @@ func TestPgRepository_ListAllForBundle(t *testing.T) {
 		RepoConstructorFunc: event.NewRepository,
 		MethodName:          "ListByBundleIDs",
 		MethodArgs: []interface{}{tenantID, []string{emptyPageBundleID, onePageBundleID, multiplePagesBundleID},
-			[]*model.BundleReference{firstBundleRef, secondBundleRef}, totalCounts, pageSize, cursor},
+			[]*model.BundleReference{firstBundleRef, secondBundleRef}, totalCounts, pages},
 		DisableConverterErrorTest: true,
 	}
 
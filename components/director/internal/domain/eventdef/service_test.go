@@ -218,6 +218,10 @@ func TestService_ListByBundleIDs(t *testing.T) {
 	eventPages := []*model.EventDefinitionPage{eventPageFirstBundle, eventPageSecondBundle}
 
 	after := "test"
+	pages := map[string]*pagination.Page{
+		firstBundleID:  {StartCursor: after},
+		secondBundleID: {StartCursor: after},
+	}
 
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
@@ -234,12 +238,12 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Success",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, nil).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, pages, nil).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, 2, after).Return(eventPages, nil).Once()
+				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages).Return(eventPages, nil).Once()
 				return repo
 			},
 			PageSize:           2,
@@ -278,7 +282,7 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Returns error when EventDefinition BundleReferences listing failed",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(nil, nil, testErr).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(nil, nil, nil, testErr).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.EventAPIRepository {
@@ -293,12 +297,12 @@ func TestService_ListByBundleIDs(t *testing.T) {
 			Name: "Returns error when EventDefinition listing failed",
 			BundleRefSvcFn: func() *automock.BundleReferenceService {
 				svc := &automock.BundleReferenceService{}
-				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, nil).Once()
+				svc.On("ListByBundleIDs", ctx, model.BundleEventReference, bundleIDs, 2, after).Return(bundleRefs, totalCounts, pages, nil).Once()
 				return svc
 			},
 			RepositoryFn: func() *automock.EventAPIRepository {
 				repo := &automock.EventAPIRepository{}
-				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, 2, after).Return(nil, testErr).Once()
+				repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs, bundleRefs, totalCounts, pages).Return(nil, testErr).Once()
 				return repo
 			},
 			PageSize:           2,
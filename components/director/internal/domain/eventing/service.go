@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/pkg/errors"
 )
 
@@ -34,7 +35,7 @@ const (
 type RuntimeRepository interface {
 	GetByFiltersAndID(ctx context.Context, tenant, id string, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
 	GetOldestForFilters(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error)
 }
 
 // LabelRepository missing godoc
@@ -303,7 +304,7 @@ func (s *service) getDefaultRuntimeForAppEventing(ctx context.Context, tenantID
 	labelFilterForRuntime := []*labelfilter.LabelFilter{labelfilter.NewForKey(labelKey)}
 
 	var cursor string
-	runtimesPage, err := s.runtimeRepo.List(ctx, tenantID, labelFilterForRuntime, 1, cursor)
+	runtimesPage, err := s.runtimeRepo.List(ctx, tenantID, labelFilterForRuntime, nil, 1, cursor)
 	if err != nil {
 		return nil, false, errors.Wrap(err, fmt.Sprintf("while fetching runtimes with label [key=%s]", labelKey))
 	}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 
@@ -88,7 +89,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -117,7 +118,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -149,7 +150,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -179,7 +180,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -221,7 +222,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -241,7 +242,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -261,7 +262,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -283,7 +284,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(nil)
@@ -307,7 +308,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -333,7 +334,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -359,7 +360,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -386,7 +387,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -416,7 +417,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -449,7 +450,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -468,7 +469,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -494,7 +495,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -520,7 +521,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -559,7 +560,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(nil, errors.New("some-error"))
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -578,7 +579,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -597,7 +598,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -619,7 +620,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(nil, errors.New("some error"))
@@ -643,7 +644,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -673,7 +674,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -701,7 +702,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -729,7 +730,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -757,7 +758,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -785,7 +786,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -809,7 +810,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -836,7 +837,7 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimePage := fixRuntimePageWithOne()
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(runtimePage, nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(runtimePage, nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -858,7 +859,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
@@ -888,7 +889,7 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo := &automock.RuntimeRepository{}
 		newRuntime := fixRuntimes()[0]
 		runtimePage := fixRuntimePageWithOne()
-		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(), 1, mock.Anything).Return(runtimePage, nil)
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(), (*pageorder.Order)(nil), 1, mock.Anything).Return(runtimePage, nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, "")).Once()
@@ -934,7 +935,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -960,7 +961,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, errors.New("some error"))
 		labelRepo := &automock.LabelRepository{}
@@ -983,7 +984,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		scenariosLabel := fixApplicationScenariosLabel()
 		scenariosLabel.Value = "abc"
@@ -1006,7 +1007,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -1027,7 +1028,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some error"))
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(nil, errors.New("some error"))
 		svc := NewService(nil, runtimeRepo, nil)
 
 		// WHEN
@@ -1045,7 +1046,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -1065,7 +1066,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -1087,7 +1088,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -1111,7 +1112,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -1137,7 +1138,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -1163,7 +1164,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			(*pageorder.Order)(nil), 1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -0,0 +1,196 @@
+package fetchrequest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+	tarMagic  = []byte("ustar")
+)
+
+const tarMagicOffset = 257
+
+// extractFromBundle returns the content of the single file in the zip, tar or tar.gz bundle which matches the filter.
+// When no filter is provided, the bundle must contain exactly one file.
+func extractFromBundle(content []byte, filter *string, maxSize int64, maxEntries int) (string, error) {
+	switch {
+	case bytes.HasPrefix(content, zipMagic):
+		return extractFromZip(content, filter, maxSize, maxEntries)
+	case bytes.HasPrefix(content, gzipMagic):
+		gzipReader, err := gzip.NewReader(bytes.NewReader(content))
+		if err != nil {
+			return "", errors.Wrap(err, "while reading gzip archive")
+		}
+		defer func() {
+			_ = gzipReader.Close()
+		}()
+		return extractFromTar(gzipReader, filter, maxSize, maxEntries)
+	case len(content) > tarMagicOffset+len(tarMagic) && bytes.Equal(content[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic):
+		return extractFromTar(bytes.NewReader(content), filter, maxSize, maxEntries)
+	default:
+		return "", errors.New("unsupported bundle format, expected zip, tar or tar.gz archive")
+	}
+}
+
+func extractFromZip(content []byte, filter *string, maxSize int64, maxEntries int) (string, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", errors.Wrap(err, "while reading zip archive")
+	}
+
+	if len(zipReader.File) > maxEntries {
+		return "", errors.Errorf("bundle contains more than %d entries", maxEntries)
+	}
+
+	var totalSize uint64
+	var matched []*zip.File
+	for _, file := range zipReader.File {
+		totalSize += file.UncompressedSize64
+		if totalSize > uint64(maxSize) {
+			return "", errors.Errorf("bundle content exceeds the maximum allowed size of %d bytes", maxSize)
+		}
+
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		ok, err := matchesFilter(filter, file.Name)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			matched = append(matched, file)
+		}
+	}
+
+	names := make([]string, 0, len(matched))
+	for _, file := range matched {
+		names = append(names, file.Name)
+	}
+	if err := ensureSingleMatch(names, filter, "bundle"); err != nil {
+		return "", err
+	}
+
+	file, err := matched[0].Open()
+	if err != nil {
+		return "", errors.Wrapf(err, "while opening %q from bundle", matched[0].Name)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return readLimited(file, maxSize)
+}
+
+func extractFromTar(reader io.Reader, filter *string, maxSize int64, maxEntries int) (string, error) {
+	tarReader := tar.NewReader(reader)
+
+	var entries int
+	var totalSize int64
+	var matched []string
+	var spec string
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.Wrap(err, "while reading tar archive")
+		}
+
+		entries++
+		if entries > maxEntries {
+			return "", errors.Errorf("bundle contains more than %d entries", maxEntries)
+		}
+
+		totalSize += header.Size
+		if totalSize > maxSize {
+			return "", errors.Errorf("bundle content exceeds the maximum allowed size of %d bytes", maxSize)
+		}
+
+		if !header.FileInfo().Mode().IsRegular() {
+			continue
+		}
+
+		ok, err := matchesFilter(filter, header.Name)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+
+		matched = append(matched, header.Name)
+		if len(matched) > 1 {
+			continue
+		}
+
+		if spec, err = readLimited(tarReader, maxSize); err != nil {
+			return "", err
+		}
+	}
+
+	if err := ensureSingleMatch(matched, filter, "bundle"); err != nil {
+		return "", err
+	}
+
+	return spec, nil
+}
+
+// matchesFilter reports whether the glob filter matches either the whole path or the base name of the file.
+func matchesFilter(filter *string, name string) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+
+	name = path.Clean(strings.TrimPrefix(name, "./"))
+	ok, err := path.Match(*filter, name)
+	if err != nil {
+		return false, errors.Wrapf(err, "while matching filter %q", *filter)
+	}
+	if ok {
+		return true, nil
+	}
+
+	return path.Match(*filter, path.Base(name))
+}
+
+func ensureSingleMatch(names []string, filter *string, source string) error {
+	if len(names) == 1 {
+		return nil
+	}
+
+	if filter == nil {
+		return errors.Errorf("%s contains %d files, a filter selecting exactly one of them must be provided", source, len(names))
+	}
+
+	if len(names) == 0 {
+		return errors.Errorf("no file in the %s matches filter %q", source, *filter)
+	}
+
+	return errors.Errorf("filter %q matches %d files in the %s: %s", *filter, len(names), source, strings.Join(names, ", "))
+}
+
+func readLimited(reader io.Reader, maxSize int64) (string, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if int64(len(content)) > maxSize {
+		return "", errors.Errorf("content exceeds the maximum allowed size of %d bytes", maxSize)
+	}
+
+	return string(content), nil
+}
@@ -5,3 +5,8 @@ import "time"
 func (s *service) SetTimestampGen(timestampGen func() time.Time) {
 	s.timestampGen = timestampGen
 }
+
+func (s *service) SetLimits(maxSize int64, maxEntries int) {
+	s.maxSize = maxSize
+	s.maxEntries = maxEntries
+}
@@ -1,18 +1,31 @@
 package fetchrequest_test
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"database/sql"
 	"encoding/json"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest/automock"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+type bundleFile struct {
+	Name    string
+	Content string
+}
+
 const (
 	tenantID = "b91b59f7-2563-40b2-aba9-fef726037aa3"
 	refID    = "refID"
@@ -185,3 +198,70 @@ func fixFetchRequestEntityWithReferences(id string, timestamp time.Time, specID,
 func fixColumns() []string {
 	return []string{"id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id"}
 }
+
+func fixZipBundle(t *testing.T, files []bundleFile) []byte {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for _, file := range files {
+		fileWriter, err := writer.Create(file.Name)
+		require.NoError(t, err)
+		_, err = fileWriter.Write([]byte(file.Content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func fixTarBundle(t *testing.T, files []bundleFile) []byte {
+	buf := &bytes.Buffer{}
+	writeTar(t, buf, files)
+
+	return buf.Bytes()
+}
+
+func fixTarGzBundle(t *testing.T, files []bundleFile) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	writeTar(t, gzipWriter, files)
+	require.NoError(t, gzipWriter.Close())
+
+	return buf.Bytes()
+}
+
+func writeTar(t *testing.T, buf io.Writer, files []bundleFile) {
+	writer := tar.NewWriter(buf)
+	for _, file := range files {
+		header := &tar.Header{Name: file.Name, Mode: 0600, Size: int64(len(file.Content)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(file.Name, "/") {
+			header.Mode, header.Typeflag = 0700, tar.TypeDir
+		}
+		require.NoError(t, writer.WriteHeader(header))
+		_, err := writer.Write([]byte(file.Content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+}
+
+func fixSpec(format model.SpecFormat, apiType *model.APISpecType) *model.Spec {
+	return &model.Spec{
+		ID:         "spec-id",
+		ObjectType: model.APISpecReference,
+		ObjectID:   "api-id",
+		Format:     format,
+		APIType:    apiType,
+	}
+}
+
+func specValidatorThatAccepts() *automock.SpecValidator {
+	validator := &automock.SpecValidator{}
+	validator.On("Validate", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(data string, _ model.SpecFormat, _ *model.APISpecType, _ *model.EventSpecType) string {
+			return data
+		},
+		func(_ string, format model.SpecFormat, _ *model.APISpecType, _ *model.EventSpecType) model.SpecFormat {
+			return format
+		},
+		nil).Maybe()
+	return validator
+}
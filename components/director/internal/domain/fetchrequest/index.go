@@ -0,0 +1,77 @@
+package fetchrequest
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+
+	"github.com/pkg/errors"
+)
+
+// indexDocument lists the specs published by a provider, e.g. {"specs": [{"name": "v1/openapi.json", "url": "v1/openapi.json"}]}
+type indexDocument struct {
+	Specs []indexEntry `json:"specs"`
+}
+
+type indexEntry struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// selectFromIndex returns the absolute URL of the single index entry which matches the filter.
+// Entries are matched by name, or by URL path when no name is provided, and must be hosted next to the index itself.
+func selectFromIndex(indexURL string, content []byte, filter *string, maxEntries int) (string, error) {
+	var index indexDocument
+	if err := json.Unmarshal(content, &index); err != nil {
+		return "", errors.Wrap(err, "while unmarshalling index document")
+	}
+
+	if len(index.Specs) > maxEntries {
+		return "", errors.Errorf("index contains more than %d entries", maxEntries)
+	}
+
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return "", errors.Wrapf(err, "while parsing index URL %q", indexURL)
+	}
+
+	var names []string
+	var selected *url.URL
+	for _, entry := range index.Specs {
+		if entry.URL == "" {
+			return "", errors.New("index entry without URL")
+		}
+
+		entryURL, err := url.Parse(entry.URL)
+		if err != nil {
+			return "", errors.Wrapf(err, "while parsing index entry URL %q", entry.URL)
+		}
+		entryURL = base.ResolveReference(entryURL)
+
+		name := entry.Name
+		if name == "" {
+			name = path.Clean(entryURL.Path)
+		}
+
+		ok, err := matchesFilter(filter, name)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			continue
+		}
+
+		if entryURL.Scheme != base.Scheme || entryURL.Host != base.Host {
+			return "", errors.Errorf("index entry %q must be hosted on %s://%s", name, base.Scheme, base.Host)
+		}
+
+		names = append(names, name)
+		selected = entryURL
+	}
+
+	if err := ensureSingleMatch(names, filter, "index"); err != nil {
+		return "", err
+	}
+
+	return selected.String(), nil
+}
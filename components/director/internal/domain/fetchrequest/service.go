@@ -3,8 +3,10 @@ package fetchrequest
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/retry"
@@ -32,85 +34,164 @@ type service struct {
 	timestampGen                   timestamp.Generator
 	accessStrategyExecutorProvider accessstrategy.ExecutorProvider
 	retryHTTPFuncExecutor          *retry.HTTPExecutor
+	specValidator                  SpecValidator
+	maxSize                        int64
+	maxEntries                     int
 }
 
+const (
+	// defaultMaxSize is the maximum number of bytes downloaded, or unpacked from a bundle, by a single fetch
+	defaultMaxSize int64 = 50 * 1024 * 1024
+	// defaultMaxEntries is the maximum number of entries in a single bundle or index
+	defaultMaxEntries = 1000
+)
+
 // FetchRequestRepository missing godoc
 //go:generate mockery --name=FetchRequestRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type FetchRequestRepository interface {
 	Update(ctx context.Context, tenant string, item *model.FetchRequest) error
 }
 
+// SpecValidator validates fetched specs against their declared type and format
+//go:generate mockery --name=SpecValidator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SpecValidator interface {
+	Validate(data string, format model.SpecFormat, apiType *model.APISpecType, eventType *model.EventSpecType) (string, model.SpecFormat, error)
+}
+
 // NewService missing godoc
-func NewService(repo FetchRequestRepository, client *http.Client, executorProvider accessstrategy.ExecutorProvider) *service {
+func NewService(repo FetchRequestRepository, client *http.Client, executorProvider accessstrategy.ExecutorProvider, specValidator SpecValidator) *service {
 	return &service{
 		repo:                           repo,
 		client:                         client,
 		timestampGen:                   timestamp.DefaultGenerator,
 		accessStrategyExecutorProvider: executorProvider,
+		specValidator:                  specValidator,
+		maxSize:                        defaultMaxSize,
+		maxEntries:                     defaultMaxEntries,
 	}
 }
 
 // NewServiceWithRetry creates a FetchRequest service which is able to retry failed HTTP requests
-func NewServiceWithRetry(repo FetchRequestRepository, client *http.Client, executorProvider accessstrategy.ExecutorProvider, retryHTTPExecutor *retry.HTTPExecutor) *service {
+func NewServiceWithRetry(repo FetchRequestRepository, client *http.Client, executorProvider accessstrategy.ExecutorProvider, retryHTTPExecutor *retry.HTTPExecutor, specValidator SpecValidator) *service {
 	return &service{
 		repo:                           repo,
 		client:                         client,
 		timestampGen:                   timestamp.DefaultGenerator,
 		accessStrategyExecutorProvider: executorProvider,
 		retryHTTPFuncExecutor:          retryHTTPExecutor,
+		specValidator:                  specValidator,
+		maxSize:                        defaultMaxSize,
+		maxEntries:                     defaultMaxEntries,
 	}
 }
 
-// HandleSpec missing godoc
-func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest) *string {
+// HandleSpec fetches the data of the spec and validates it against the declared type and format of the spec.
+// The format of the spec is updated when the fetched document is normalized.
+func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest, spec *model.Spec) {
+	spec.Data = nil
+
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while getting tenant: %v", err)
-		return nil
+		return
 	}
 
 	var data *string
-	data, fr.Status = s.fetchSpec(ctx, fr)
+	var format model.SpecFormat
+	data, format, fr.Status = s.fetchSpec(ctx, fr, spec)
 
 	if err := s.repo.Update(ctx, tnt, fr); err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while updating fetch request status: %v", err)
-		return nil
+		return
 	}
 
-	return data
+	if data != nil {
+		spec.Data = data
+		spec.Format = format
+	}
 }
 
-func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
+func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest, spec *model.Spec) (*string, model.SpecFormat, *model.FetchRequestStatus) {
+	data, status := s.fetchData(ctx, fr)
+	if data == nil {
+		return nil, "", status
+	}
+
+	validated, format, err := s.specValidator.Validate(*data, spec.Format, spec.APIType, spec.EventType)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error has occurred while validating Spec fetched by fetch request with id %q: %v", fr.ID, err)
+		return nil, "", FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While validating Spec: %s", err.Error())), s.timestampGen())
+	}
+
+	return &validated, format, status
+}
+
+func (s *service) fetchData(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
 	err := s.validateFetchRequest(fr)
 	if err != nil {
 		log.C(ctx).WithError(err).Error()
 		return nil, FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr(err.Error()), s.timestampGen())
 	}
 
+	body, status := s.fetch(ctx, fr, fr.URL)
+	if status != nil {
+		return nil, status
+	}
+
+	var spec string
+	switch fr.Mode {
+	case model.FetchModeBundle:
+		spec, err = extractFromBundle(body, fr.Filter, s.maxSize, s.maxEntries)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while processing Spec bundle: %v", err)
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While processing bundle: %s", err.Error())), s.timestampGen())
+		}
+	case model.FetchModeIndex:
+		specURL, err := selectFromIndex(fr.URL, body, fr.Filter, s.maxEntries)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while processing Spec index: %v", err)
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While processing index: %s", err.Error())), s.timestampGen())
+		}
+
+		log.C(ctx).Infof("Fetching Spec %s selected from index %s", specURL, fr.URL)
+		if body, status = s.fetch(ctx, fr, specURL); status != nil {
+			return nil, status
+		}
+		spec = string(body)
+	default:
+		spec = string(body)
+	}
+
+	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+}
+
+// fetch executes a GET request against the provided URL with the authentication of the fetch request.
+// A non-nil status is returned in case the request has failed.
+func (s *service) fetch(ctx context.Context, fr *model.FetchRequest, url string) ([]byte, *model.FetchRequestStatus) {
 	var doRequest retry.ExecutableHTTPFunc
 	if fr.Auth != nil && fr.Auth.AccessStrategy != nil && len(*fr.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("Fetch Request with id %s is configured with %s access strategy.", fr.ID, *fr.Auth.AccessStrategy)
-		var executor accessstrategy.Executor
-		executor, err = s.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*fr.Auth.AccessStrategy))
+		executor, err := s.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*fr.Auth.AccessStrategy))
 		if err != nil {
 			log.C(ctx).WithError(err).Errorf("Cannot find executor for access strategy %q as part of fetch request %s processing: %v", *fr.Auth.AccessStrategy, fr.ID, err)
 			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", err.Error())), s.timestampGen())
 		}
 
 		doRequest = func() (*http.Response, error) {
-			return executor.Execute(s.client, fr.URL)
+			return executor.Execute(ctx, s.client, url, nil, fr.Auth.ToAccessStrategyCredentials())
 		}
 	} else if fr.Auth != nil {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithCredentials(ctx, s.client, fr.URL, fr.Auth)
+			return httputil.GetRequestWithCredentials(ctx, s.client, url, nil, fr.Auth)
 		}
 	} else {
 		doRequest = func() (*http.Response, error) {
-			return httputil.GetRequestWithoutCredentials(s.client, fr.URL)
+			return httputil.GetRequestWithoutCredentials(s.client, url, nil)
 		}
 	}
 
 	var resp *http.Response
+	var err error
 	if s.retryHTTPFuncExecutor != nil {
 		resp, err = s.retryHTTPFuncExecutor.Execute(doRequest)
 	} else {
@@ -131,7 +212,7 @@ func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		}
 	}()
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, s.maxSize+1))
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while reading Spec: %v", err)
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
@@ -142,19 +223,30 @@ func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
 	}
 
-	spec := string(body)
-	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+	if int64(len(body)) > s.maxSize {
+		log.C(ctx).Errorf("Spec fetched from %s exceeds the maximum allowed size of %d bytes", url, s.maxSize)
+		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: content exceeds the maximum allowed size of %d bytes", s.maxSize)), s.timestampGen())
+	}
+
+	return body, nil
 }
 
 func (s *service) validateFetchRequest(fr *model.FetchRequest) error {
-	if fr.Mode != model.FetchModeSingle {
+	switch fr.Mode {
+	case model.FetchModeSingle:
+		if fr.Filter != nil {
+			return apperrors.NewInvalidDataError("Filter for Fetch Request is supported only for %s and %s fetch modes", model.FetchModeBundle, model.FetchModeIndex)
+		}
+	case model.FetchModeBundle, model.FetchModeIndex:
+		if fr.Filter != nil {
+			if _, err := path.Match(*fr.Filter, ""); err != nil {
+				return apperrors.NewInvalidDataError("Invalid filter for Fetch Request: %s", *fr.Filter)
+			}
+		}
+	default:
 		return apperrors.NewInvalidDataError("Unsupported fetch mode: %s", fr.Mode)
 	}
 
-	if fr.Filter != nil {
-		return apperrors.NewInvalidDataError("Filter for Fetch Request was provided, currently it's unsupported")
-	}
-
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -57,6 +58,8 @@ func TestService_HandleSpec(t *testing.T) {
 	var testAccessStrategy = "testAccessStrategy"
 
 	mockSpec := "spec"
+	normalizedSpec := "normalized spec"
+	apiSpecType := model.APISpecTypeOpenAPI
 	timestamp := time.Now()
 
 	modelInput := model.FetchRequest{
@@ -64,11 +67,63 @@ func TestService_HandleSpec(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
+	modelInputUnsupportedMode := model.FetchRequest{
+		ID:   "test",
+		Mode: model.FetchMode("UNKNOWN"),
+	}
+
 	modelInputBundle := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("*.json"),
+	}
+
+	modelInputBundleWithoutFilter := model.FetchRequest{
 		ID:   "test",
 		Mode: model.FetchModeBundle,
 	}
 
+	modelInputBundleWithNonMatchingFilter := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("*.xml"),
+	}
+
+	modelInputMalformedFilter := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("[a-"),
+	}
+
+	const indexURL = "http://test.com/specs/index.json"
+	const indexDocument = `{"specs":[{"name":"v1/openapi.json","url":"v1/openapi.json"},{"url":"/specs/v2/openapi.json"},{"name":"v1/events.yaml","url":"v1/events.yaml"}]}`
+
+	modelInputIndex := model.FetchRequest{
+		ID:     "test",
+		URL:    indexURL,
+		Mode:   model.FetchModeIndex,
+		Filter: str.Ptr("v1/*.json"),
+	}
+
+	modelInputIndexWithAmbiguousFilter := model.FetchRequest{
+		ID:     "test",
+		URL:    indexURL,
+		Mode:   model.FetchModeIndex,
+		Filter: str.Ptr("*.json"),
+	}
+
+	modelInputIndexWithoutFilter := model.FetchRequest{
+		ID:   "test",
+		URL:  indexURL,
+		Mode: model.FetchModeIndex,
+	}
+
+	bundleFiles := []bundleFile{
+		{Name: "docs/", Content: ""},
+		{Name: "docs/README.md", Content: "readme"},
+		{Name: "./api/openapi.json", Content: mockSpec},
+	}
+
 	modelInputFilter := model.FetchRequest{
 		ID:     "test",
 		Mode:   model.FetchModeSingle,
@@ -82,6 +137,22 @@ func TestService_HandleSpec(t *testing.T) {
 		Auth: &model.Auth{AccessStrategy: &testAccessStrategy},
 	}
 
+	modelInputAccessStrategyWithCredentials := model.FetchRequest{
+		ID:   "test",
+		Mode: model.FetchModeSingle,
+		URL:  "http://test.com",
+		Auth: &model.Auth{
+			AccessStrategy: str.Ptr(string(accessstrategy.OAuthAccessStrategy)),
+			Credential: model.CredentialData{
+				Oauth: &model.OAuthCredentialData{
+					ClientID:     clientID,
+					ClientSecret: secret,
+					URL:          url,
+				},
+			},
+		},
+	}
+
 	modelInputBasicCredentials := model.FetchRequest{
 		ID: "test",
 		Auth: &model.Auth{
@@ -127,7 +198,9 @@ func TestService_HandleSpec(t *testing.T) {
 		Client               func(t *testing.T) *http.Client
 		InputFr              model.FetchRequest
 		ExecutorProviderFunc func() accessstrategy.ExecutorProvider
+		SpecValidatorFn      func() *automock.SpecValidator
 		ExpectedResult       *string
+		ExpectedFormat       model.SpecFormat
 		ExpectedStatus       *model.FetchRequestStatus
 	}{
 
@@ -146,19 +219,19 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
 		},
 		{
-			Name: "Nil when fetch request validation fails due to mode Bundle",
+			Name: "Nil when fetch request validation fails due to unsupported mode",
 			Client: func(t *testing.T) *http.Client {
 				return NewTestClient(func(req *http.Request) *http.Response {
 					return &http.Response{}
 				})
 			},
 
-			InputFr:        modelInputBundle,
+			InputFr:        modelInputUnsupportedMode,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: BUNDLE]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: UNKNOWN]"), timestamp),
 		},
 		{
-			Name: "Nil when fetch request validation fails due to provided filter",
+			Name: "Nil when fetch request validation fails due to filter provided in mode Single",
 			Client: func(t *testing.T) *http.Client {
 				return NewTestClient(func(req *http.Request) *http.Response {
 					return &http.Response{}
@@ -167,13 +240,210 @@ func TestService_HandleSpec(t *testing.T) {
 
 			InputFr:        modelInputFilter,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request was provided, currently it's unsupported]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request is supported only for BUNDLE and INDEX fetch modes]"), timestamp),
+		},
+		{
+			Name: "Nil when fetch request validation fails due to malformed filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{}
+				})
+			},
+
+			InputFr:        modelInputMalformedFilter,
+			ExpectedResult: nil,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Invalid filter for Fetch Request: [a-]"), timestamp),
+		},
+		{
+			Name: "Success with zip bundle and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(fixZipBundle(t, bundleFiles))),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success with tar.gz bundle and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(fixTarGzBundle(t, bundleFiles))),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success with tar bundle containing single file and no filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(fixTarBundle(t, []bundleFile{{Name: "openapi.json", Content: mockSpec}}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithoutFilter,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when bundle contains multiple files and no filter is provided",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(fixZipBundle(t, bundleFiles))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithoutFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: bundle contains 2 files, a filter selecting exactly one of them must be provided"), timestamp),
+		},
+		{
+			Name: "Fails when filter matches no file in the bundle",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(fixZipBundle(t, bundleFiles))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithNonMatchingFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: no file in the bundle matches filter \"*.xml\""), timestamp),
+		},
+		{
+			Name: "Fails when bundle is not an archive",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: unsupported bundle format, expected zip, tar or tar.gz archive"), timestamp),
+		},
+		{
+			Name: "Success with index and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					switch req.URL.String() {
+					case indexURL:
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(indexDocument)),
+						}
+					case "http://test.com/specs/v1/openapi.json":
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+						}
+					}
+					return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(bytes.NewBufferString(""))}
+				})
+			},
+			InputFr:        modelInputIndex,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when filter matches multiple index entries",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(indexDocument)),
+					}
+				})
+			},
+			InputFr:        modelInputIndexWithAmbiguousFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing index: filter \"*.json\" matches 2 files in the index: v1/openapi.json, /specs/v2/openapi.json"), timestamp),
+		},
+		{
+			Name: "Fails when index entry is hosted on another host",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`{"specs":[{"url":"http://other.com/openapi.json"}]}`)),
+					}
+				})
+			},
+			InputFr:        modelInputIndexWithoutFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing index: index entry \"/openapi.json\" must be hosted on http://test.com"), timestamp),
+		},
+		{
+			Name: "Fails when fetching the spec selected from the index fails",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					if req.URL.String() == indexURL {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(indexDocument)),
+						}
+					}
+					return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(bytes.NewBufferString(""))}
+				})
+			},
+			InputFr:        modelInputIndex,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 404"), timestamp),
+		},
+		{
+			Name: "Success with normalized spec",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			SpecValidatorFn: func() *automock.SpecValidator {
+				validator := &automock.SpecValidator{}
+				validator.On("Validate", mockSpec, model.SpecFormatYaml, &apiSpecType, (*model.EventSpecType)(nil)).Return(normalizedSpec, model.SpecFormatJSON, nil).Once()
+				return validator
+			},
+			InputFr:        modelInput,
+			ExpectedResult: &normalizedSpec,
+			ExpectedFormat: model.SpecFormatJSON,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when spec validation fails",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			SpecValidatorFn: func() *automock.SpecValidator {
+				validator := &automock.SpecValidator{}
+				validator.On("Validate", mockSpec, model.SpecFormatYaml, &apiSpecType, (*model.EventSpecType)(nil)).Return("", model.SpecFormat(""), testErr).Once()
+				return validator
+			},
+			InputFr:        modelInput,
+			ExpectedFormat: model.SpecFormatYaml,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While validating Spec: test"), timestamp),
 		},
 		{
 			Name: "Success with access strategy",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL).Return(&http.Response{
+				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, http.Header(nil), &accessstrategy.Credentials{}).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
 				}, nil).Once()
@@ -189,6 +459,33 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedResult: &mockSpec,
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
 		},
+		{
+			Name: "Success with access strategy using the fetch request credentials",
+			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
+				credentials := &accessstrategy.Credentials{
+					OAuth: &accessstrategy.OAuthCredentials{
+						ClientID:     clientID,
+						ClientSecret: secret,
+						TokenURL:     url,
+					},
+				}
+				executor := &accessstrategyautomock.Executor{}
+				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategyWithCredentials.URL, http.Header(nil), credentials).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+				}, nil).Once()
+
+				executorProvider := &accessstrategyautomock.ExecutorProvider{}
+				executorProvider.On("Provide", accessstrategy.OAuthAccessStrategy).Return(executor, nil).Once()
+				return executorProvider
+			},
+			Client: func(t *testing.T) *http.Client {
+				return nil
+			},
+			InputFr:        modelInputAccessStrategyWithCredentials,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
 		{
 			Name: "Fails when access strategy is unknown",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
@@ -206,7 +503,7 @@ func TestService_HandleSpec(t *testing.T) {
 			Name: "Fails when access strategy execution fail",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL).Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, mock.Anything, modelInputAccessStrategy.URL, http.Header(nil), &accessstrategy.Credentials{}).Return(nil, testErr).Once()
 
 				executorProvider := &accessstrategyautomock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -350,17 +647,32 @@ func TestService_HandleSpec(t *testing.T) {
 			frRepo := &automock.FetchRequestRepository{}
 			frRepo.On("Update", ctx, tenantID, mock.Anything).Return(nil).Once()
 
-			svc := fetchrequest.NewService(frRepo, testCase.Client(t), executorProviderMock)
+			specValidator := specValidatorThatAccepts()
+			if testCase.SpecValidatorFn != nil {
+				specValidator = testCase.SpecValidatorFn()
+			}
+
+			svc := fetchrequest.NewService(frRepo, testCase.Client(t), executorProviderMock, specValidator)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
-			result := svc.HandleSpec(ctx, &testCase.InputFr)
+			spec := fixSpec(model.SpecFormatYaml, &apiSpecType)
+			svc.HandleSpec(ctx, &testCase.InputFr, spec)
+
+			expectedFormat := testCase.ExpectedFormat
+			if expectedFormat == "" {
+				expectedFormat = model.SpecFormatYaml
+			}
 
 			assert.Equal(t, testCase.ExpectedStatus, testCase.InputFr.Status)
-			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedResult, spec.Data)
+			assert.Equal(t, expectedFormat, spec.Format)
 
 			if testCase.ExecutorProviderFunc != nil {
 				mock.AssertExpectationsForObjects(t, executorProviderMock)
 			}
+			if testCase.SpecValidatorFn != nil {
+				mock.AssertExpectationsForObjects(t, specValidator)
+			}
 		})
 	}
 }
@@ -379,7 +691,7 @@ func TestService_HandleSpec_FailedToUpdateStatusAfterFetching(t *testing.T) {
 			StatusCode: http.StatusOK,
 			Body:       ioutil.NopCloser(bytes.NewBufferString("spec")),
 		}
-	}), accessstrategy.NewDefaultExecutorProvider(certCache))
+	}), accessstrategy.NewDefaultExecutorProvider(certCache), specValidatorThatAccepts())
 	svc.SetTimestampGen(func() time.Time { return timestamp })
 
 	modelInput := &model.FetchRequest{
@@ -387,11 +699,12 @@ func TestService_HandleSpec_FailedToUpdateStatusAfterFetching(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
-	result := svc.HandleSpec(ctx, modelInput)
+	spec := fixSpec(model.SpecFormatJSON, nil)
+	svc.HandleSpec(ctx, modelInput, spec)
 	expectedStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp)
 
 	assert.Equal(t, expectedStatus, modelInput.Status)
-	assert.Nil(t, result)
+	assert.Nil(t, spec.Data)
 }
 
 func TestService_HandleSpec_SucceedsAfterRetryMechanismIsLeveraged(t *testing.T) {
@@ -424,7 +737,7 @@ func TestService_HandleSpec_SucceedsAfterRetryMechanismIsLeveraged(t *testing.T)
 			StatusCode: http.StatusOK,
 			Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
 		}
-	}), accessstrategy.NewDefaultExecutorProvider(certCache), retry.NewHTTPExecutor(retryConfig))
+	}), accessstrategy.NewDefaultExecutorProvider(certCache), retry.NewHTTPExecutor(retryConfig), specValidatorThatAccepts())
 	svc.SetTimestampGen(func() time.Time { return timestamp })
 
 	modelInput := &model.FetchRequest{
@@ -432,11 +745,12 @@ func TestService_HandleSpec_SucceedsAfterRetryMechanismIsLeveraged(t *testing.T)
 		Mode: model.FetchModeSingle,
 	}
 
-	result := svc.HandleSpec(ctx, modelInput)
+	spec := fixSpec(model.SpecFormatJSON, nil)
+	svc.HandleSpec(ctx, modelInput, spec)
 	expectedStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp)
 
 	assert.Equal(t, expectedStatus, modelInput.Status)
-	assert.Equal(t, mockSpec, *result)
+	assert.Equal(t, mockSpec, *spec.Data)
 	assert.Equal(t, int(retryConfig.Attempts), invocations)
 }
 
@@ -461,7 +775,7 @@ func TestService_HandleSpec_FailsAfterRetryMechanismIsExhausted(t *testing.T) {
 		}()
 
 		return &http.Response{StatusCode: http.StatusInternalServerError}
-	}), accessstrategy.NewDefaultExecutorProvider(certCache), retry.NewHTTPExecutor(retryConfig))
+	}), accessstrategy.NewDefaultExecutorProvider(certCache), retry.NewHTTPExecutor(retryConfig), specValidatorThatAccepts())
 	svc.SetTimestampGen(func() time.Time { return timestamp })
 
 	modelInput := &model.FetchRequest{
@@ -469,12 +783,95 @@ func TestService_HandleSpec_FailsAfterRetryMechanismIsExhausted(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
-	result := svc.HandleSpec(ctx, modelInput)
+	spec := fixSpec(model.SpecFormatJSON, nil)
+	svc.HandleSpec(ctx, modelInput, spec)
 	respStatusCodeErr := fmt.Sprintf("unexpected status code: %d", http.StatusInternalServerError)
 	expectedErr := fmt.Sprintf("All attempts fail:\n#1: %s\n#2: %s\n#3: %s", respStatusCodeErr, respStatusCodeErr, respStatusCodeErr)
 	expectedStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", expectedErr)), timestamp)
 
 	assert.Equal(t, expectedStatus, modelInput.Status)
-	assert.Nil(t, result)
+	assert.Nil(t, spec.Data)
 	assert.Equal(t, int(retryConfig.Attempts), invocations)
 }
+
+func TestService_HandleSpec_FailsWhenLimitsAreExceeded(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, tenantID)
+	timestamp := time.Now()
+
+	files := []bundleFile{{Name: "a.json", Content: "a"}, {Name: "b.json", Content: "b"}, {Name: "c.json", Content: "c"}}
+
+	testCases := []struct {
+		Name           string
+		Content        []byte
+		Mode           model.FetchMode
+		MaxSize        int64
+		ExpectedStatus *model.FetchRequestStatus
+	}{
+		{
+			Name:           "Spec exceeds maximum size",
+			Content:        []byte("0123456789"),
+			Mode:           model.FetchModeSingle,
+			MaxSize:        8,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While reading Spec: content exceeds the maximum allowed size of 8 bytes"), timestamp),
+		},
+		{
+			Name:           "Zip bundle exceeds maximum number of entries",
+			Content:        fixZipBundle(t, files),
+			Mode:           model.FetchModeBundle,
+			MaxSize:        4096,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: bundle contains more than 2 entries"), timestamp),
+		},
+		{
+			Name:           "Tar bundle exceeds maximum number of entries",
+			Content:        fixTarBundle(t, files),
+			Mode:           model.FetchModeBundle,
+			MaxSize:        4096,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: bundle contains more than 2 entries"), timestamp),
+		},
+		{
+			Name:           "Unpacked bundle exceeds maximum size",
+			Content:        fixTarGzBundle(t, []bundleFile{{Name: "a.json", Content: strings.Repeat("a", 10000)}}),
+			Mode:           model.FetchModeBundle,
+			MaxSize:        4096,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing bundle: bundle content exceeds the maximum allowed size of 4096 bytes"), timestamp),
+		},
+		{
+			Name:           "Index exceeds maximum number of entries",
+			Content:        []byte(`{"specs":[{"url":"a.json"},{"url":"b.json"},{"url":"c.json"}]}`),
+			Mode:           model.FetchModeIndex,
+			MaxSize:        4096,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While processing index: index contains more than 2 entries"), timestamp),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			frRepo := &automock.FetchRequestRepository{}
+			frRepo.On("Update", ctx, tenantID, mock.Anything).Return(nil).Once()
+
+			certCache := certloader.NewCertificateCache()
+			svc := fetchrequest.NewService(frRepo, NewTestClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewReader(testCase.Content)),
+				}
+			}), accessstrategy.NewDefaultExecutorProvider(certCache), specValidatorThatAccepts())
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+			svc.SetLimits(testCase.MaxSize, 2)
+
+			modelInput := &model.FetchRequest{
+				ID:   "test",
+				URL:  "http://test.com/spec",
+				Mode: testCase.Mode,
+			}
+
+			spec := fixSpec(model.SpecFormatJSON, nil)
+	svc.HandleSpec(ctx, modelInput, spec)
+
+			assert.Equal(t, testCase.ExpectedStatus, modelInput.Status)
+			assert.Nil(t, spec.Data)
+			mock.AssertExpectationsForObjects(t, frRepo)
+		})
+	}
+}
@@ -5,11 +5,10 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // AutomaticFormationAssignmentRepository is an autogenerated mock type for the automaticFormationAssignmentRepository type
@@ -82,6 +81,52 @@ func (_m *AutomaticFormationAssignmentRepository) ListAll(ctx context.Context, t
 	return r0, r1
 }
 
+// ListForScenarioNames provides a mock function with given fields: ctx, tenantID, scenarioNames
+func (_m *AutomaticFormationAssignmentRepository) ListForScenarioNames(ctx context.Context, tenantID string, scenarioNames []string) ([]*model.AutomaticScenarioAssignment, error) {
+	ret := _m.Called(ctx, tenantID, scenarioNames)
+
+	var r0 []*model.AutomaticScenarioAssignment
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.AutomaticScenarioAssignment); ok {
+		r0 = rf(ctx, tenantID, scenarioNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutomaticScenarioAssignment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, scenarioNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForScenarioNamesPages provides a mock function with given fields: ctx, tenantID, scenarioNames, pageSize, cursor
+func (_m *AutomaticFormationAssignmentRepository) ListForScenarioNamesPages(ctx context.Context, tenantID string, scenarioNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error) {
+	ret := _m.Called(ctx, tenantID, scenarioNames, pageSize, cursor)
+
+	var r0 []*model.AutomaticScenarioAssignmentPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.AutomaticScenarioAssignmentPage); ok {
+		r0 = rf(ctx, tenantID, scenarioNames, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutomaticScenarioAssignmentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, scenarioNames, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAutomaticFormationAssignmentRepository creates a new instance of AutomaticFormationAssignmentRepository. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
 func NewAutomaticFormationAssignmentRepository(t testing.TB) *AutomaticFormationAssignmentRepository {
 	mock := &AutomaticFormationAssignmentRepository{}
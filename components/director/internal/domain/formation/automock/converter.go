@@ -3,12 +3,11 @@
 package automock
 
 import (
-	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // Converter is an autogenerated mock type for the Converter type
@@ -46,6 +45,22 @@ func (_m *Converter) MultipleToGraphQL(in []*model.Formation) []*graphql.Formati
 	return r0
 }
 
+// StatusToGraphQL provides a mock function with given fields: in
+func (_m *Converter) StatusToGraphQL(in *model.FormationStatus) *graphql.FormationStatus {
+	ret := _m.Called(in)
+
+	var r0 *graphql.FormationStatus
+	if rf, ok := ret.Get(0).(func(*model.FormationStatus) *graphql.FormationStatus); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.FormationStatus)
+		}
+	}
+
+	return r0
+}
+
 // ToGraphQL provides a mock function with given fields: i
 func (_m *Converter) ToGraphQL(i *model.Formation) *graphql.Formation {
 	ret := _m.Called(i)
@@ -5,11 +5,10 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // LabelRepository is an autogenerated mock type for the labelRepository type
@@ -31,6 +30,52 @@ func (_m *LabelRepository) Delete(_a0 context.Context, _a1 string, _a2 model.Lab
 	return r0
 }
 
+// ListForObjectTypeByScenarios provides a mock function with given fields: ctx, tenant, objectType, scenarios
+func (_m *LabelRepository) ListForObjectTypeByScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string) ([]*model.Label, error) {
+	ret := _m.Called(ctx, tenant, objectType, scenarios)
+
+	var r0 []*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, []string) []*model.Label); ok {
+		r0 = rf(ctx, tenant, objectType, scenarios)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, []string) error); ok {
+		r1 = rf(ctx, tenant, objectType, scenarios)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForObjectTypeByScenariosPages provides a mock function with given fields: ctx, tenant, objectType, scenarios, pageSize, cursor
+func (_m *LabelRepository) ListForObjectTypeByScenariosPages(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string, pageSize int, cursor string) ([]*model.LabelPage, error) {
+	ret := _m.Called(ctx, tenant, objectType, scenarios, pageSize, cursor)
+
+	var r0 []*model.LabelPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, []string, int, string) []*model.LabelPage); ok {
+		r0 = rf(ctx, tenant, objectType, scenarios, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LabelPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, []string, int, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, scenarios, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewLabelRepository creates a new instance of LabelRepository. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
 func NewLabelRepository(t testing.TB) *LabelRepository {
 	mock := &LabelRepository{}
@@ -5,11 +5,10 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // RuntimeContextRepository is an autogenerated mock type for the runtimeContextRepository type
@@ -61,6 +60,29 @@ func (_m *RuntimeContextRepository) ListAll(ctx context.Context, tenant string)
 	return r0, r1
 }
 
+// ListAllByIDs provides a mock function with given fields: ctx, tenant, ids
+func (_m *RuntimeContextRepository) ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.RuntimeContext, error) {
+	ret := _m.Called(ctx, tenant, ids)
+
+	var r0 []*model.RuntimeContext
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.RuntimeContext); ok {
+		r0 = rf(ctx, tenant, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RuntimeContext)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenant, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRuntimeContextRepository creates a new instance of RuntimeContextRepository. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
 func NewRuntimeContextRepository(t testing.TB) *RuntimeContextRepository {
 	mock := &RuntimeContextRepository{}
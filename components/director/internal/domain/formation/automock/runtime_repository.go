@@ -5,13 +5,11 @@ package automock
 import (
 	context "context"
 
-	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
-
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // RuntimeRepository is an autogenerated mock type for the runtimeRepository type
@@ -40,6 +38,29 @@ func (_m *RuntimeRepository) Exists(ctx context.Context, tenant string, id strin
 	return r0, r1
 }
 
+// ListAllByIDs provides a mock function with given fields: ctx, tenant, ids
+func (_m *RuntimeRepository) ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.Runtime, error) {
+	ret := _m.Called(ctx, tenant, ids)
+
+	var r0 []*model.Runtime
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Runtime); ok {
+		r0 = rf(ctx, tenant, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Runtime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenant, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListOwnedRuntimes provides a mock function with given fields: ctx, tenant, filter
 func (_m *RuntimeRepository) ListOwnedRuntimes(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Runtime, error) {
 	ret := _m.Called(ctx, tenant, filter)
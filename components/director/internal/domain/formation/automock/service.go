@@ -5,13 +5,11 @@ package automock
 import (
 	context "context"
 
-	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
-
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -111,6 +109,29 @@ func (_m *Service) Get(ctx context.Context, id string) (*model.Formation, error)
 	return r0, r1
 }
 
+// GetStatusForFormations provides a mock function with given fields: ctx, formationNames
+func (_m *Service) GetStatusForFormations(ctx context.Context, formationNames []string) ([]*model.FormationStatus, error) {
+	ret := _m.Called(ctx, formationNames)
+
+	var r0 []*model.FormationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.FormationStatus); ok {
+		r0 = rf(ctx, formationNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FormationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, formationNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, pageSize, cursor
 func (_m *Service) List(ctx context.Context, pageSize int, cursor string) (*model.FormationPage, error) {
 	ret := _m.Called(ctx, pageSize, cursor)
@@ -134,6 +155,121 @@ func (_m *Service) List(ctx context.Context, pageSize int, cursor string) (*mode
 	return r0, r1
 }
 
+// ListApplicationsForFormations provides a mock function with given fields: ctx, formationNames, pageSize, cursor
+func (_m *Service) ListApplicationsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, formationNames, pageSize, cursor)
+
+	var r0 []*model.ApplicationPage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.ApplicationPage); ok {
+		r0 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimeContextsForFormations provides a mock function with given fields: ctx, formationNames, pageSize, cursor
+func (_m *Service) ListRuntimeContextsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimeContextPage, error) {
+	ret := _m.Called(ctx, formationNames, pageSize, cursor)
+
+	var r0 []*model.RuntimeContextPage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.RuntimeContextPage); ok {
+		r0 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RuntimeContextPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimesForFormations provides a mock function with given fields: ctx, formationNames, pageSize, cursor
+func (_m *Service) ListRuntimesForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimePage, error) {
+	ret := _m.Called(ctx, formationNames, pageSize, cursor)
+
+	var r0 []*model.RuntimePage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.RuntimePage); ok {
+		r0 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RuntimePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTenantAssignmentsForFormations provides a mock function with given fields: ctx, formationNames, pageSize, cursor
+func (_m *Service) ListTenantAssignmentsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error) {
+	ret := _m.Called(ctx, formationNames, pageSize, cursor)
+
+	var r0 []*model.AutomaticScenarioAssignmentPage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.AutomaticScenarioAssignmentPage); ok {
+		r0 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutomaticScenarioAssignmentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, formationNames, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResynchronizeFormationNotifications provides a mock function with given fields: ctx, formationName
+func (_m *Service) ResynchronizeFormationNotifications(ctx context.Context, formationName string) (*model.Formation, error) {
+	ret := _m.Called(ctx, formationName)
+
+	var r0 *model.Formation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Formation); ok {
+		r0 = rf(ctx, formationName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Formation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, formationName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnassignFormation provides a mock function with given fields: ctx, tnt, objectID, objectType, _a4
 func (_m *Service) UnassignFormation(ctx context.Context, tnt string, objectID string, objectType graphql.FormationObjectType, _a4 model.Formation) (*model.Formation, error) {
 	ret := _m.Called(ctx, tnt, objectID, objectType, _a4)
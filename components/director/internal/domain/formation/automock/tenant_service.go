@@ -5,11 +5,10 @@ package automock
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
-
-	testing "testing"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // TenantService is an autogenerated mock type for the tenantService type
@@ -38,6 +37,27 @@ func (_m *TenantService) CreateManyIfNotExists(ctx context.Context, tenantInputs
 	return r0
 }
 
+// GetExternalTenant provides a mock function with given fields: ctx, id
+func (_m *TenantService) GetExternalTenant(ctx context.Context, id string) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInternalTenant provides a mock function with given fields: ctx, externalTenant
 func (_m *TenantService) GetInternalTenant(ctx context.Context, externalTenant string) (string, error) {
 	ret := _m.Called(ctx, externalTenant)
@@ -44,6 +44,21 @@ func (c *converter) MultipleToGraphQL(in []*model.Formation) []*graphql.Formatio
 	return formations
 }
 
+// StatusToGraphQL converts model.FormationStatus to graphql.FormationStatus
+func (c *converter) StatusToGraphQL(in *model.FormationStatus) *graphql.FormationStatus {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.FormationStatus{
+		Condition:              graphql.FormationStatusCondition(in.Condition),
+		ApplicationsCount:      in.ApplicationsCount,
+		RuntimesCount:          in.RuntimesCount,
+		RuntimeContextsCount:   in.RuntimeContextsCount,
+		TenantAssignmentsCount: in.TenantAssignmentsCount,
+	}
+}
+
 func (c *converter) ToEntity(in *model.Formation) *Entity {
 	if in == nil {
 		return nil
@@ -32,6 +32,24 @@ func TestToGraphQL(t *testing.T) {
 	})
 }
 
+func TestConverter_StatusToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		actual := converter.StatusToGraphQL(&model.FormationStatus{Condition: model.FormationStatusConditionReady, ApplicationsCount: 1, RuntimesCount: 2, RuntimeContextsCount: 3, TenantAssignmentsCount: 4})
+
+		// THEN
+		require.Equal(t, &graphql.FormationStatus{Condition: graphql.FormationStatusConditionReady, ApplicationsCount: 1, RuntimesCount: 2, RuntimeContextsCount: 3, TenantAssignmentsCount: 4}, actual)
+	})
+
+	t.Run("Returns nil when status is nil", func(t *testing.T) {
+		// WHEN
+		actual := converter.StatusToGraphQL(nil)
+
+		// THEN
+		require.Nil(t, actual)
+	})
+}
+
 func TestConverter_ToEntity(t *testing.T) {
 	testCases := []struct {
 		Name     string
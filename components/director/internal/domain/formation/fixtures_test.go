@@ -52,6 +52,12 @@ const (
 	secondTestFormationName = "second-formation"
 )
 
+func changeEventServiceThatPublishes() *automock.ChangeEventService {
+	svc := &automock.ChangeEventService{}
+	svc.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return svc
+}
+
 func unusedLabelService() *automock.LabelService {
 	return &automock.LabelService{}
 }
@@ -184,3 +190,27 @@ func fixFormationEntity() *formation.Entity {
 		Name:                testFormationName,
 	}
 }
+
+func unusedApplicationRepo() *automock.ApplicationRepository {
+	return &automock.ApplicationRepository{}
+}
+
+func fixScenariosLabel(objectType model.LabelableObject, objectID string, scenarios ...string) *model.Label {
+	value := make([]interface{}, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		value = append(value, scenario)
+	}
+
+	return &model.Label{
+		Key:        model.ScenariosKey,
+		Value:      value,
+		ObjectID:   objectID,
+		ObjectType: objectType,
+	}
+}
+
+func formationAssignmentServiceThatNotifies() *automock.FormationAssignmentService {
+	svc := &automock.FormationAssignmentService{}
+	svc.On("NotifyParticipants", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return svc
+}
@@ -89,7 +89,7 @@ func (r *repository) GetByName(ctx context.Context, name, tenantID string) (*mod
 // List returns all Formations sorted by id and paginated by the pageSize and cursor parameters
 func (r *repository) List(ctx context.Context, tenant string, pageSize int, cursor string) (*model.FormationPage, error) {
 	var entityCollection EntityCollection
-	page, totalCount, err := r.pageableQuerierGlobal.List(ctx, resource.Formations, tenant, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerierGlobal.List(ctx, resource.Formations, tenant, pageSize, cursor, repo.NewAscOrderBy("id"), &entityCollection)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,7 @@ func TestRepository_List(t *testing.T) {
 		MethodName: "List",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, tenant_id, formation_template_id, name FROM public.formations WHERE tenant_id = $1 ORDER BY id LIMIT 4 OFFSET 0`),
+				Query:    regexp.QuoteMeta(`SELECT id, tenant_id, formation_template_id, name FROM public.formations WHERE tenant_id = $1 ORDER BY id ASC LIMIT 5`),
 				Args:     []driver.Value{Tnt},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -3,6 +3,9 @@ package formation
 import (
 	"context"
 
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -20,6 +23,12 @@ type Service interface {
 	DeleteFormation(ctx context.Context, tnt string, formation model.Formation) (*model.Formation, error)
 	AssignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error)
 	UnassignFormation(ctx context.Context, tnt, objectID string, objectType graphql.FormationObjectType, formation model.Formation) (*model.Formation, error)
+	ListApplicationsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.ApplicationPage, error)
+	ListRuntimesForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimePage, error)
+	ListRuntimeContextsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimeContextPage, error)
+	ListTenantAssignmentsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error)
+	GetStatusForFormations(ctx context.Context, formationNames []string) ([]*model.FormationStatus, error)
+	ResynchronizeFormationNotifications(ctx context.Context, formationName string) (*model.Formation, error)
 }
 
 // Converter missing godoc
@@ -28,6 +37,31 @@ type Converter interface {
 	FromGraphQL(i graphql.FormationInput) model.Formation
 	ToGraphQL(i *model.Formation) *graphql.Formation
 	MultipleToGraphQL(in []*model.Formation) []*graphql.Formation
+	StatusToGraphQL(in *model.FormationStatus) *graphql.FormationStatus
+}
+
+// ApplicationConverter converts Applications to GraphQL
+//go:generate mockery --name=ApplicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationConverter interface {
+	MultipleToGraphQL(in []*model.Application) []*graphql.Application
+}
+
+// RuntimeConverter converts Runtimes to GraphQL
+//go:generate mockery --name=RuntimeConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeConverter interface {
+	MultipleToGraphQL(in []*model.Runtime) []*graphql.Runtime
+}
+
+// RuntimeContextConverter converts Runtime Contexts to GraphQL
+//go:generate mockery --name=RuntimeContextConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type RuntimeContextConverter interface {
+	MultipleToGraphQL(in []*model.RuntimeContext) []*graphql.RuntimeContext
+}
+
+// AutomaticScenarioAssignmentConverter converts Automatic Scenario Assignments to GraphQL
+//go:generate mockery --name=AutomaticScenarioAssignmentConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type AutomaticScenarioAssignmentConverter interface {
+	ToGraphQL(in model.AutomaticScenarioAssignment, targetTenantExternalID string) graphql.AutomaticScenarioAssignment
 }
 
 // TenantFetcher calls an API which fetches details for the given tenant from an external tenancy service, stores the tenant in the Compass DB and returns 200 OK if the tenant was successfully created.
@@ -38,19 +72,29 @@ type TenantFetcher interface {
 
 // Resolver is the formation resolver
 type Resolver struct {
-	transact persistence.Transactioner
-	service  Service
-	conv     Converter
-	fetcher  TenantFetcher
+	transact       persistence.Transactioner
+	service        Service
+	conv           Converter
+	fetcher        TenantFetcher
+	appConv        ApplicationConverter
+	runtimeConv    RuntimeConverter
+	runtimeCtxConv RuntimeContextConverter
+	assignmentConv AutomaticScenarioAssignmentConverter
+	tenantSvc      tenantService
 }
 
 // NewResolver creates formation resolver
-func NewResolver(transact persistence.Transactioner, service Service, conv Converter, fetcher TenantFetcher) *Resolver {
+func NewResolver(transact persistence.Transactioner, service Service, conv Converter, fetcher TenantFetcher, appConv ApplicationConverter, runtimeConv RuntimeConverter, runtimeCtxConv RuntimeContextConverter, assignmentConv AutomaticScenarioAssignmentConverter, tenantSvc tenantService) *Resolver {
 	return &Resolver{
-		transact: transact,
-		service:  service,
-		conv:     conv,
-		fetcher:  fetcher,
+		transact:       transact,
+		service:        service,
+		conv:           conv,
+		fetcher:        fetcher,
+		appConv:        appConv,
+		runtimeConv:    runtimeConv,
+		runtimeCtxConv: runtimeCtxConv,
+		assignmentConv: assignmentConv,
+		tenantSvc:      tenantSvc,
 	}
 }
 
@@ -233,3 +277,273 @@ func (r *Resolver) UnassignFormation(ctx context.Context, objectID string, objec
 
 	return r.conv.ToGraphQL(newFormation), nil
 }
+
+// ResynchronizeFormationNotifications schedules again the failed notifications of the provided formation
+func (r *Resolver) ResynchronizeFormationNotifications(ctx context.Context, formationName string) (*graphql.Formation, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	formation, err := r.service.ResynchronizeFormationNotifications(ctx, formationName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return r.conv.ToGraphQL(formation), nil
+}
+
+// Applications retrieves a page of the Applications assigned to the Formation
+func (r *Resolver) Applications(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation cannot be empty")
+	}
+
+	param := dataloader.ParamFormationApplication{ID: obj.ID, Name: obj.Name, Ctx: ctx, First: first, After: after}
+	return dataloader.FormationApplicationFor(ctx).FormationApplicationByID.Load(param)
+}
+
+// ApplicationsDataLoader retrieves a page of Applications for each Formation in the keys argument
+func (r *Resolver) ApplicationsDataLoader(keys []dataloader.ParamFormationApplication) ([]*graphql.ApplicationPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Formations found")}
+	}
+
+	formationNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formationNames = append(formationNames, key.Name)
+	}
+
+	var appPages []*model.ApplicationPage
+	err := r.loadParticipants(keys[0].Ctx, keys[0].First, keys[0].After, func(ctx context.Context, pageSize int, cursor string) (err error) {
+		appPages, err = r.service.ListApplicationsForFormations(ctx, formationNames, pageSize, cursor)
+		return err
+	})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlAppPages := make([]*graphql.ApplicationPage, 0, len(appPages))
+	for _, page := range appPages {
+		gqlAppPages = append(gqlAppPages, &graphql.ApplicationPage{Data: r.appConv.MultipleToGraphQL(page.Data), TotalCount: page.TotalCount, PageInfo: toGraphQLPageInfo(page.PageInfo)})
+	}
+
+	return gqlAppPages, nil
+}
+
+// Runtimes retrieves a page of the Runtimes assigned to the Formation
+func (r *Resolver) Runtimes(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation cannot be empty")
+	}
+
+	param := dataloader.ParamFormationRuntime{ID: obj.ID, Name: obj.Name, Ctx: ctx, First: first, After: after}
+	return dataloader.FormationRuntimeFor(ctx).FormationRuntimeByID.Load(param)
+}
+
+// RuntimesDataLoader retrieves a page of Runtimes for each Formation in the keys argument
+func (r *Resolver) RuntimesDataLoader(keys []dataloader.ParamFormationRuntime) ([]*graphql.RuntimePage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Formations found")}
+	}
+
+	formationNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formationNames = append(formationNames, key.Name)
+	}
+
+	var runtimePages []*model.RuntimePage
+	err := r.loadParticipants(keys[0].Ctx, keys[0].First, keys[0].After, func(ctx context.Context, pageSize int, cursor string) (err error) {
+		runtimePages, err = r.service.ListRuntimesForFormations(ctx, formationNames, pageSize, cursor)
+		return err
+	})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlRuntimePages := make([]*graphql.RuntimePage, 0, len(runtimePages))
+	for _, page := range runtimePages {
+		gqlRuntimePages = append(gqlRuntimePages, &graphql.RuntimePage{Data: r.runtimeConv.MultipleToGraphQL(page.Data), TotalCount: page.TotalCount, PageInfo: toGraphQLPageInfo(page.PageInfo)})
+	}
+
+	return gqlRuntimePages, nil
+}
+
+// RuntimeContexts retrieves a page of the Runtime Contexts assigned to the Formation
+func (r *Resolver) RuntimeContexts(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.RuntimeContextPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation cannot be empty")
+	}
+
+	param := dataloader.ParamFormationRuntimeContext{ID: obj.ID, Name: obj.Name, Ctx: ctx, First: first, After: after}
+	return dataloader.FormationRuntimeContextFor(ctx).FormationRuntimeContextByID.Load(param)
+}
+
+// RuntimeContextsDataLoader retrieves a page of Runtime Contexts for each Formation in the keys argument
+func (r *Resolver) RuntimeContextsDataLoader(keys []dataloader.ParamFormationRuntimeContext) ([]*graphql.RuntimeContextPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Formations found")}
+	}
+
+	formationNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formationNames = append(formationNames, key.Name)
+	}
+
+	var runtimeContextPages []*model.RuntimeContextPage
+	err := r.loadParticipants(keys[0].Ctx, keys[0].First, keys[0].After, func(ctx context.Context, pageSize int, cursor string) (err error) {
+		runtimeContextPages, err = r.service.ListRuntimeContextsForFormations(ctx, formationNames, pageSize, cursor)
+		return err
+	})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlRuntimeContextPages := make([]*graphql.RuntimeContextPage, 0, len(runtimeContextPages))
+	for _, page := range runtimeContextPages {
+		gqlRuntimeContextPages = append(gqlRuntimeContextPages, &graphql.RuntimeContextPage{Data: r.runtimeCtxConv.MultipleToGraphQL(page.Data), TotalCount: page.TotalCount, PageInfo: toGraphQLPageInfo(page.PageInfo)})
+	}
+
+	return gqlRuntimeContextPages, nil
+}
+
+// TenantAssignments retrieves a page of the Automatic Scenario Assignments of the Formation
+func (r *Resolver) TenantAssignments(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.AutomaticScenarioAssignmentPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation cannot be empty")
+	}
+
+	param := dataloader.ParamFormationTenantAssignment{ID: obj.ID, Name: obj.Name, Ctx: ctx, First: first, After: after}
+	return dataloader.FormationTenantAssignmentFor(ctx).FormationTenantAssignmentByID.Load(param)
+}
+
+// TenantAssignmentsDataLoader retrieves a page of Automatic Scenario Assignments for each Formation in the keys argument
+func (r *Resolver) TenantAssignmentsDataLoader(keys []dataloader.ParamFormationTenantAssignment) ([]*graphql.AutomaticScenarioAssignmentPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Formations found")}
+	}
+
+	formationNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formationNames = append(formationNames, key.Name)
+	}
+
+	var gqlAssignmentPages []*graphql.AutomaticScenarioAssignmentPage
+	err := r.loadParticipants(keys[0].Ctx, keys[0].First, keys[0].After, func(ctx context.Context, pageSize int, cursor string) error {
+		assignmentPages, err := r.service.ListTenantAssignmentsForFormations(ctx, formationNames, pageSize, cursor)
+		if err != nil {
+			return err
+		}
+
+		gqlAssignmentPages = make([]*graphql.AutomaticScenarioAssignmentPage, 0, len(assignmentPages))
+		for _, page := range assignmentPages {
+			gqlAssignments := make([]*graphql.AutomaticScenarioAssignment, 0, len(page.Data))
+			for _, assignment := range page.Data {
+				targetTenant, err := r.tenantSvc.GetExternalTenant(ctx, assignment.TargetTenantID)
+				if err != nil {
+					return errors.Wrap(err, "while converting tenant")
+				}
+
+				gqlAssignment := r.assignmentConv.ToGraphQL(*assignment, targetTenant)
+				gqlAssignments = append(gqlAssignments, &gqlAssignment)
+			}
+
+			gqlAssignmentPages = append(gqlAssignmentPages, &graphql.AutomaticScenarioAssignmentPage{Data: gqlAssignments, TotalCount: page.TotalCount, PageInfo: toGraphQLPageInfo(page.PageInfo)})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	return gqlAssignmentPages, nil
+}
+
+// Status retrieves the status of the Formation computed from its participants
+func (r *Resolver) Status(ctx context.Context, obj *graphql.Formation) (*graphql.FormationStatus, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Formation cannot be empty")
+	}
+
+	param := dataloader.ParamFormationStatus{ID: obj.ID, Name: obj.Name, Ctx: ctx}
+	return dataloader.FormationStatusFor(ctx).FormationStatusByID.Load(param)
+}
+
+// StatusDataLoader retrieves the status of each Formation in the keys argument
+func (r *Resolver) StatusDataLoader(keys []dataloader.ParamFormationStatus) ([]*graphql.FormationStatus, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Formations found")}
+	}
+
+	ctx := keys[0].Ctx
+	formationNames := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formationNames = append(formationNames, key.Name)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	statuses, err := r.service.GetStatusForFormations(ctx, formationNames)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	gqlStatuses := make([]*graphql.FormationStatus, 0, len(statuses))
+	for _, status := range statuses {
+		gqlStatuses = append(gqlStatuses, r.conv.StatusToGraphQL(status))
+	}
+
+	return gqlStatuses, nil
+}
+
+// loadParticipants validates the paging arguments and calls loadFunc in a transaction
+func (r *Resolver) loadParticipants(ctx context.Context, first *int, after *graphql.PageCursor, loadFunc func(ctx context.Context, pageSize int, cursor string) error) error {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	if first == nil {
+		return apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = loadFunc(ctx, *first, cursor); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toGraphQLPageInfo(page *pagination.Page) *graphql.PageInfo {
+	return &graphql.PageInfo{
+		StartCursor: graphql.PageCursor(page.StartCursor),
+		EndCursor:   graphql.PageCursor(page.EndCursor),
+		HasNextPage: page.HasNextPage,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
@@ -48,7 +49,7 @@ func TestCreateFormation(t *testing.T) {
 		mockConverter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.CreateFormation(ctx, formationInputWithTemplateName)
@@ -71,7 +72,7 @@ func TestCreateFormation(t *testing.T) {
 		mockConverter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.CreateFormation(ctx, formationInput)
@@ -86,7 +87,7 @@ func TestCreateFormation(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
 
-		sut := formation.NewResolver(nil, nil, nil, nil)
+		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.CreateFormation(ctx, formationInput)
@@ -101,7 +102,7 @@ func TestCreateFormation(t *testing.T) {
 		persist, transact := txGen.ThatFailsOnBegin()
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, nil, nil, nil)
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.CreateFormation(ctx, formationInput)
@@ -123,7 +124,7 @@ func TestCreateFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.CreateFormation(ctx, formationInput)
@@ -145,7 +146,7 @@ func TestCreateFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.CreateFormation(ctx, formationInput)
@@ -180,7 +181,7 @@ func TestDeleteFormation(t *testing.T) {
 		mockConverter.On("ToGraphQL", &model.Formation{Name: testFormation}).Return(&graphql.Formation{Name: testFormation})
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.DeleteFormation(ctx, formationInput)
@@ -194,7 +195,7 @@ func TestDeleteFormation(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
 
-		sut := formation.NewResolver(nil, nil, nil, nil)
+		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.DeleteFormation(ctx, formationInput)
@@ -207,7 +208,7 @@ func TestDeleteFormation(t *testing.T) {
 		persist, transact := txGen.ThatFailsOnBegin()
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, nil, nil, nil)
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.DeleteFormation(ctx, formationInput)
@@ -228,7 +229,7 @@ func TestDeleteFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(model.Formation{Name: testFormation})
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.DeleteFormation(ctx, formationInput)
@@ -249,7 +250,7 @@ func TestDeleteFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(model.Formation{Name: testFormation})
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.DeleteFormation(ctx, formationInput)
@@ -287,7 +288,7 @@ func TestAssignFormation(t *testing.T) {
 		fetcherSvc.On("FetchOnDemand", "", tnt).Return(nil)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc)
+		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -308,7 +309,7 @@ func TestAssignFormation(t *testing.T) {
 		fetcherSvc.On("FetchOnDemand", "", tnt).Return(testErr)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc)
+		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -322,7 +323,7 @@ func TestAssignFormation(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
 
-		sut := formation.NewResolver(nil, nil, nil, nil)
+		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -339,7 +340,7 @@ func TestAssignFormation(t *testing.T) {
 		fetcherSvc.On("FetchOnDemand", "", tnt).Return(nil)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, nil, nil, fetcherSvc)
+		sut := formation.NewResolver(transact, nil, nil, fetcherSvc, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -363,7 +364,7 @@ func TestAssignFormation(t *testing.T) {
 		fetcherSvc.On("FetchOnDemand", "", tnt).Return(nil)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc)
+		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -387,7 +388,7 @@ func TestAssignFormation(t *testing.T) {
 		fetcherSvc.On("FetchOnDemand", "", tnt).Return(nil)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc)
+		sut := formation.NewResolver(transact, mockService, mockConverter, fetcherSvc, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.AssignFormation(ctx, "", testObjectType, formationInput)
@@ -422,7 +423,7 @@ func TestUnassignFormation(t *testing.T) {
 		mockConverter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.UnassignFormation(ctx, "", testObjectType, formationInput)
@@ -436,7 +437,7 @@ func TestUnassignFormation(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
 
-		sut := formation.NewResolver(nil, nil, nil, nil)
+		sut := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.UnassignFormation(ctx, "", testObjectType, formationInput)
@@ -450,7 +451,7 @@ func TestUnassignFormation(t *testing.T) {
 		persist, transact := txGen.ThatFailsOnBegin()
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, nil, nil, nil)
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.UnassignFormation(ctx, "", testObjectType, formationInput)
@@ -471,7 +472,7 @@ func TestUnassignFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := sut.UnassignFormation(ctx, "", testObjectType, formationInput)
@@ -492,7 +493,7 @@ func TestUnassignFormation(t *testing.T) {
 		mockConverter.On("FromGraphQL", formationInput).Return(modelFormation)
 
 		ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
-		sut := formation.NewResolver(transact, mockService, mockConverter, nil)
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		actual, err := sut.UnassignFormation(ctx, "", testObjectType, formationInput)
@@ -505,6 +506,81 @@ func TestUnassignFormation(t *testing.T) {
 	})
 }
 
+func TestResynchronizeFormationNotifications(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("successfully resynchronizes formation notifications", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+
+		mockService := &automock.Service{}
+		mockService.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), testFormationName).Return(&modelFormation, nil)
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("ToGraphQL", &modelFormation).Return(&graphqlFormation)
+
+		sut := formation.NewResolver(transact, mockService, mockConverter, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := sut.ResynchronizeFormationNotifications(context.TODO(), testFormationName)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &graphqlFormation, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService, mockConverter)
+	})
+	t.Run("returns error when can not start db transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnBegin()
+
+		sut := formation.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := sut.ResynchronizeFormationNotifications(context.TODO(), testFormationName)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact)
+	})
+	t.Run("returns error when resynchronization fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		mockService := &automock.Service{}
+		mockService.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), testFormationName).Return(nil, testErr)
+
+		sut := formation.NewResolver(transact, mockService, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := sut.ResynchronizeFormationNotifications(context.TODO(), testFormationName)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService)
+	})
+	t.Run("returns error when commit fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnCommit()
+
+		mockService := &automock.Service{}
+		mockService.On("ResynchronizeFormationNotifications", txtest.CtxWithDBMatcher(), testFormationName).Return(&modelFormation, nil)
+
+		sut := formation.NewResolver(transact, mockService, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := sut.ResynchronizeFormationNotifications(context.TODO(), testFormationName)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, mockService)
+	})
+}
+
 func TestFormation(t *testing.T) {
 	testErr := errors.New("test error")
 
@@ -582,7 +658,7 @@ func TestFormation(t *testing.T) {
 			service := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := formation.NewResolver(transact, service, converter, nil)
+			resolver := formation.NewResolver(transact, service, converter, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			f, err := resolver.Formation(ctx, testCase.InputID)
@@ -706,7 +782,7 @@ func TestFormations(t *testing.T) {
 			service := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := formation.NewResolver(transact, service, converter, nil)
+			resolver := formation.NewResolver(transact, service, converter, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			f, err := resolver.Formations(ctx, &first, &after)
@@ -725,7 +801,7 @@ func TestFormations(t *testing.T) {
 	}
 
 	t.Run("Returns error when 'first' is nil", func(t *testing.T) {
-		resolver := formation.NewResolver(nil, nil, nil, nil)
+		resolver := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		f, err := resolver.Formations(ctx, nil, &after)
@@ -745,3 +821,361 @@ func contextThatHasTenant(expectedTenant string) interface{} {
 		return actualTenant == expectedTenant
 	})
 }
+
+func TestApplicationsDataLoader(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := context.TODO()
+	first := 2
+	after := graphql.PageCursor("after")
+	formationNames := []string{testFormationName, secondTestFormationName}
+	keys := []dataloader.ParamFormationApplication{
+		{ID: FormationID, Name: testFormationName, First: &first, After: &after, Ctx: ctx},
+		{ID: "second-formation-id", Name: secondTestFormationName, First: &first, After: &after, Ctx: ctx},
+	}
+
+	apps := []*model.Application{{Name: "app", BaseEntity: &model.BaseEntity{ID: "app-id"}}}
+	gqlApps := []*graphql.Application{{Name: "app", BaseEntity: &graphql.BaseEntity{ID: "app-id"}}}
+	appPages := []*model.ApplicationPage{
+		{Data: apps, PageInfo: &pagination.Page{StartCursor: string(after)}, TotalCount: 1},
+		{Data: []*model.Application{}, PageInfo: &pagination.Page{StartCursor: string(after)}, TotalCount: 0},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		service := &automock.Service{}
+		service.On("ListApplicationsForFormations", txtest.CtxWithDBMatcher(), formationNames, first, string(after)).Return(appPages, nil).Once()
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("MultipleToGraphQL", apps).Return(gqlApps).Once()
+		appConv.On("MultipleToGraphQL", []*model.Application{}).Return([]*graphql.Application{}).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, appConv, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader(keys)
+
+		// THEN
+		require.Nil(t, errs)
+		require.Equal(t, []*graphql.ApplicationPage{
+			{Data: gqlApps, PageInfo: &graphql.PageInfo{StartCursor: after}, TotalCount: 1},
+			{Data: []*graphql.Application{}, PageInfo: &graphql.PageInfo{StartCursor: after}, TotalCount: 0},
+		}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, appConv)
+	})
+
+	t.Run("Returns error when listing applications fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("ListApplicationsForFormations", txtest.CtxWithDBMatcher(), formationNames, first, string(after)).Return(nil, testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+
+	t.Run("Returns error when can't start transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnBegin()
+
+		resolver := formation.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact)
+	})
+
+	t.Run("Returns error when can't commit transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnCommit()
+		service := &automock.Service{}
+		service.On("ListApplicationsForFormations", txtest.CtxWithDBMatcher(), formationNames, first, string(after)).Return(appPages, nil).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+
+	t.Run("Returns error when 'first' is nil", func(t *testing.T) {
+		// GIVEN
+		resolver := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader([]dataloader.ParamFormationApplication{{ID: FormationID, Name: testFormationName, Ctx: ctx}})
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0].Error(), "missing required parameter 'first'")
+		require.Nil(t, actual)
+	})
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		// GIVEN
+		resolver := formation.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.ApplicationsDataLoader([]dataloader.ParamFormationApplication{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0].Error(), "No Formations found")
+		require.Nil(t, actual)
+	})
+}
+
+func TestRuntimesDataLoader(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := context.TODO()
+	first := 2
+	keys := []dataloader.ParamFormationRuntime{{ID: FormationID, Name: testFormationName, First: &first, Ctx: ctx}}
+
+	runtimes := []*model.Runtime{{ID: RuntimeID, Name: "runtime"}}
+	gqlRuntimes := []*graphql.Runtime{{ID: RuntimeID, Name: "runtime"}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		service := &automock.Service{}
+		service.On("ListRuntimesForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return([]*model.RuntimePage{{Data: runtimes, PageInfo: &pagination.Page{}, TotalCount: 1}}, nil).Once()
+		runtimeConv := &automock.RuntimeConverter{}
+		runtimeConv.On("MultipleToGraphQL", runtimes).Return(gqlRuntimes).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, runtimeConv, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.RuntimesDataLoader(keys)
+
+		// THEN
+		require.Nil(t, errs)
+		require.Equal(t, []*graphql.RuntimePage{{Data: gqlRuntimes, PageInfo: &graphql.PageInfo{}, TotalCount: 1}}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, runtimeConv)
+	})
+
+	t.Run("Returns error when listing runtimes fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("ListRuntimesForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return(nil, testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.RuntimesDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+}
+
+func TestRuntimeContextsDataLoader(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := context.TODO()
+	first := 2
+	keys := []dataloader.ParamFormationRuntimeContext{{ID: FormationID, Name: testFormationName, First: &first, Ctx: ctx}}
+
+	runtimeContexts := []*model.RuntimeContext{{ID: RuntimeContextID, RuntimeID: RuntimeID, Key: "key", Value: "value"}}
+	gqlRuntimeContexts := []*graphql.RuntimeContext{{ID: RuntimeContextID, Key: "key", Value: "value"}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		service := &automock.Service{}
+		service.On("ListRuntimeContextsForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return([]*model.RuntimeContextPage{{Data: runtimeContexts, PageInfo: &pagination.Page{}, TotalCount: 1}}, nil).Once()
+		runtimeCtxConv := &automock.RuntimeContextConverter{}
+		runtimeCtxConv.On("MultipleToGraphQL", runtimeContexts).Return(gqlRuntimeContexts).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, runtimeCtxConv, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.RuntimeContextsDataLoader(keys)
+
+		// THEN
+		require.Nil(t, errs)
+		require.Equal(t, []*graphql.RuntimeContextPage{{Data: gqlRuntimeContexts, PageInfo: &graphql.PageInfo{}, TotalCount: 1}}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, runtimeCtxConv)
+	})
+
+	t.Run("Returns error when listing runtime contexts fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("ListRuntimeContextsForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return(nil, testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.RuntimeContextsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+}
+
+func TestTenantAssignmentsDataLoader(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := context.TODO()
+	first := 2
+	keys := []dataloader.ParamFormationTenantAssignment{{ID: FormationID, Name: testFormationName, First: &first, Ctx: ctx}}
+
+	assignment := &model.AutomaticScenarioAssignment{ScenarioName: testFormationName, Tenant: Tnt, TargetTenantID: TargetTenantID}
+	gqlAssignment := graphql.AutomaticScenarioAssignment{ScenarioName: testFormationName, Selector: &graphql.Label{Key: "global_subaccount_id", Value: TargetTenant}}
+	assignmentPages := []*model.AutomaticScenarioAssignmentPage{{Data: []*model.AutomaticScenarioAssignment{assignment}, PageInfo: &pagination.Page{}, TotalCount: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		service := &automock.Service{}
+		service.On("ListTenantAssignmentsForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return(assignmentPages, nil).Once()
+		tenantSvc := &automock.TenantService{}
+		tenantSvc.On("GetExternalTenant", txtest.CtxWithDBMatcher(), TargetTenantID).Return(TargetTenant, nil).Once()
+		assignmentConv := &automock.AutomaticScenarioAssignmentConverter{}
+		assignmentConv.On("ToGraphQL", *assignment, TargetTenant).Return(gqlAssignment).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, assignmentConv, tenantSvc)
+
+		// WHEN
+		actual, errs := resolver.TenantAssignmentsDataLoader(keys)
+
+		// THEN
+		require.Nil(t, errs)
+		require.Equal(t, []*graphql.AutomaticScenarioAssignmentPage{{Data: []*graphql.AutomaticScenarioAssignment{&gqlAssignment}, PageInfo: &graphql.PageInfo{}, TotalCount: 1}}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, tenantSvc, assignmentConv)
+	})
+
+	t.Run("Returns error when getting external tenant fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("ListTenantAssignmentsForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return(assignmentPages, nil).Once()
+		tenantSvc := &automock.TenantService{}
+		tenantSvc.On("GetExternalTenant", txtest.CtxWithDBMatcher(), TargetTenantID).Return("", testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, tenantSvc)
+
+		// WHEN
+		actual, errs := resolver.TenantAssignmentsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0].Error(), "while converting tenant")
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, tenantSvc)
+	})
+
+	t.Run("Returns error when listing assignments fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("ListTenantAssignmentsForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}, first, "").Return(nil, testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.TenantAssignmentsDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+}
+
+func TestStatusDataLoader(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	ctx := context.TODO()
+	keys := []dataloader.ParamFormationStatus{{ID: FormationID, Name: testFormationName, Ctx: ctx}}
+
+	status := &model.FormationStatus{Condition: model.FormationStatusConditionIncomplete, ApplicationsCount: 1}
+	gqlStatus := &graphql.FormationStatus{Condition: graphql.FormationStatusConditionIncomplete, ApplicationsCount: 1}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatSucceeds()
+		service := &automock.Service{}
+		service.On("GetStatusForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}).Return([]*model.FormationStatus{status}, nil).Once()
+		conv := &automock.Converter{}
+		conv.On("StatusToGraphQL", status).Return(gqlStatus).Once()
+
+		resolver := formation.NewResolver(transact, service, conv, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.StatusDataLoader(keys)
+
+		// THEN
+		require.Nil(t, errs)
+		require.Equal(t, []*graphql.FormationStatus{gqlStatus}, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service, conv)
+	})
+
+	t.Run("Returns error when getting statuses fails", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		service := &automock.Service{}
+		service.On("GetStatusForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}).Return(nil, testErr).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.StatusDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+
+	t.Run("Returns error when can't commit transaction", func(t *testing.T) {
+		// GIVEN
+		persist, transact := txGen.ThatFailsOnCommit()
+		service := &automock.Service{}
+		service.On("GetStatusForFormations", txtest.CtxWithDBMatcher(), []string{testFormationName}).Return([]*model.FormationStatus{status}, nil).Once()
+
+		resolver := formation.NewResolver(transact, service, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, errs := resolver.StatusDataLoader(keys)
+
+		// THEN
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[0], testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, persist, transact, service)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
@@ -30,17 +32,26 @@ type labelDefRepository interface {
 //go:generate mockery --exported --name=labelRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type labelRepository interface {
 	Delete(context.Context, string, model.LabelableObject, string, string) error
+	ListForObjectTypeByScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string) ([]*model.Label, error)
+	ListForObjectTypeByScenariosPages(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string, pageSize int, cursor string) ([]*model.LabelPage, error)
+}
+
+//go:generate mockery --exported --name=applicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type applicationRepository interface {
+	ListAllByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Application, error)
 }
 
 //go:generate mockery --exported --name=runtimeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type runtimeRepository interface {
 	ListOwnedRuntimes(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Runtime, error)
+	ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.Runtime, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 }
 
 //go:generate mockery --exported --name=runtimeContextRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
 type runtimeContextRepository interface {
 	ListAll(ctx context.Context, tenant string) ([]*model.RuntimeContext, error)
+	ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.RuntimeContext, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 }
 
@@ -92,12 +103,26 @@ type automaticFormationAssignmentRepository interface {
 	DeleteForTargetTenant(ctx context.Context, tenantID string, targetTenantID string) error
 	DeleteForScenarioName(ctx context.Context, tenantID string, scenarioName string) error
 	ListAll(ctx context.Context, tenantID string) ([]*model.AutomaticScenarioAssignment, error)
+	ListForScenarioNames(ctx context.Context, tenantID string, scenarioNames []string) ([]*model.AutomaticScenarioAssignment, error)
+	ListForScenarioNamesPages(ctx context.Context, tenantID string, scenarioNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error)
 }
 
 //go:generate mockery --exported --name=tenantService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type tenantService interface {
 	CreateManyIfNotExists(ctx context.Context, tenantInputs ...model.BusinessTenantMappingInput) error
 	GetInternalTenant(ctx context.Context, externalTenant string) (string, error)
+	GetExternalTenant(ctx context.Context, id string) (string, error)
+}
+
+//go:generate mockery --exported --name=changeEventService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type changeEventService interface {
+	Publish(ctx context.Context, tenantID string, in model.ChangeEventInput) error
+}
+
+//go:generate mockery --exported --name=formationAssignmentService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type formationAssignmentService interface {
+	NotifyParticipants(ctx context.Context, tnt string, formation *model.Formation, objectID string, objectType model.LabelableObject, formationOperation model.FormationOperation) error
+	Resynchronize(ctx context.Context, tnt string, formation *model.Formation) error
 }
 
 type service struct {
@@ -113,10 +138,13 @@ type service struct {
 	repo                        automaticFormationAssignmentRepository
 	runtimeRepo                 runtimeRepository
 	runtimeContextRepo          runtimeContextRepository
+	applicationRepo             applicationRepository
+	changeEventService          changeEventService
+	formationAssignmentService  formationAssignmentService
 }
 
 // NewService creates formation service
-func NewService(labelDefRepository labelDefRepository, labelRepository labelRepository, formationRepository FormationRepository, formationTemplateRepository FormationTemplateRepository, labelService labelService, uuidService uuidService, labelDefService labelDefService, asaRepo automaticFormationAssignmentRepository, asaService automaticFormationAssignmentService, tenantSvc tenantService, runtimeRepo runtimeRepository, runtimeContextRepo runtimeContextRepository) *service {
+func NewService(labelDefRepository labelDefRepository, labelRepository labelRepository, formationRepository FormationRepository, formationTemplateRepository FormationTemplateRepository, labelService labelService, uuidService uuidService, labelDefService labelDefService, asaRepo automaticFormationAssignmentRepository, asaService automaticFormationAssignmentService, tenantSvc tenantService, runtimeRepo runtimeRepository, runtimeContextRepo runtimeContextRepository, applicationRepo applicationRepository, changeEventService changeEventService, formationAssignmentService formationAssignmentService) *service {
 	return &service{
 		labelDefRepository:          labelDefRepository,
 		labelRepository:             labelRepository,
@@ -130,6 +158,9 @@ func NewService(labelDefRepository labelDefRepository, labelRepository labelRepo
 		repo:                        asaRepo,
 		runtimeRepo:                 runtimeRepo,
 		runtimeContextRepo:          runtimeContextRepo,
+		applicationRepo:             applicationRepo,
+		changeEventService:          changeEventService,
+		formationAssignmentService:  formationAssignmentService,
 	}
 }
 
@@ -164,6 +195,173 @@ func (s *service) Get(ctx context.Context, id string) (*model.Formation, error)
 	return formation, nil
 }
 
+// ListApplicationsForFormations returns a page of the Applications assigned to each of the Formations with the given names
+func (s *service) ListApplicationsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.ApplicationPage, error) {
+	tnt, idsPages, err := s.listParticipantIDsPages(ctx, model.ApplicationLabelableObject, formationNames, pageSize, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := s.applicationRepo.ListAllByIDs(ctx, tnt, collectPagesIDs(idsPages))
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation applications")
+	}
+
+	appsByID := make(map[string]*model.Application, len(apps))
+	for _, app := range apps {
+		appsByID[app.ID] = app
+	}
+
+	appPages := make([]*model.ApplicationPage, 0, len(idsPages))
+	for _, idsPage := range idsPages {
+		data := make([]*model.Application, 0, len(idsPage.ids))
+		for _, id := range idsPage.ids {
+			if app, ok := appsByID[id]; ok {
+				data = append(data, app)
+			}
+		}
+		appPages = append(appPages, &model.ApplicationPage{Data: data, PageInfo: idsPage.pageInfo, TotalCount: idsPage.totalCount})
+	}
+
+	return appPages, nil
+}
+
+// ListRuntimesForFormations returns a page of the Runtimes assigned to each of the Formations with the given names
+func (s *service) ListRuntimesForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimePage, error) {
+	tnt, idsPages, err := s.listParticipantIDsPages(ctx, model.RuntimeLabelableObject, formationNames, pageSize, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimes, err := s.runtimeRepo.ListAllByIDs(ctx, tnt, collectPagesIDs(idsPages))
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation runtimes")
+	}
+
+	runtimesByID := make(map[string]*model.Runtime, len(runtimes))
+	for _, rt := range runtimes {
+		runtimesByID[rt.ID] = rt
+	}
+
+	runtimePages := make([]*model.RuntimePage, 0, len(idsPages))
+	for _, idsPage := range idsPages {
+		data := make([]*model.Runtime, 0, len(idsPage.ids))
+		for _, id := range idsPage.ids {
+			if rt, ok := runtimesByID[id]; ok {
+				data = append(data, rt)
+			}
+		}
+		runtimePages = append(runtimePages, &model.RuntimePage{Data: data, PageInfo: idsPage.pageInfo, TotalCount: idsPage.totalCount})
+	}
+
+	return runtimePages, nil
+}
+
+// ListRuntimeContextsForFormations returns a page of the Runtime Contexts assigned to each of the Formations with the given names
+func (s *service) ListRuntimeContextsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.RuntimeContextPage, error) {
+	tnt, idsPages, err := s.listParticipantIDsPages(ctx, model.RuntimeContextLabelableObject, formationNames, pageSize, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeContexts, err := s.runtimeContextRepo.ListAllByIDs(ctx, tnt, collectPagesIDs(idsPages))
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation runtime contexts")
+	}
+
+	runtimeContextsByID := make(map[string]*model.RuntimeContext, len(runtimeContexts))
+	for _, rtmCtx := range runtimeContexts {
+		runtimeContextsByID[rtmCtx.ID] = rtmCtx
+	}
+
+	runtimeContextPages := make([]*model.RuntimeContextPage, 0, len(idsPages))
+	for _, idsPage := range idsPages {
+		data := make([]*model.RuntimeContext, 0, len(idsPage.ids))
+		for _, id := range idsPage.ids {
+			if rtmCtx, ok := runtimeContextsByID[id]; ok {
+				data = append(data, rtmCtx)
+			}
+		}
+		runtimeContextPages = append(runtimeContextPages, &model.RuntimeContextPage{Data: data, PageInfo: idsPage.pageInfo, TotalCount: idsPage.totalCount})
+	}
+
+	return runtimeContextPages, nil
+}
+
+// ListTenantAssignmentsForFormations returns a page of the Automatic Scenario Assignments of each of the Formations with the given names
+func (s *service) ListTenantAssignmentsForFormations(ctx context.Context, formationNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	assignmentPages, err := s.repo.ListForScenarioNamesPages(ctx, tnt, formationNames, pageSize, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation tenant assignments")
+	}
+
+	return assignmentPages, nil
+}
+
+// GetStatusForFormations computes the status of each of the Formations with the given names based on their participants
+func (s *service) GetStatusForFormations(ctx context.Context, formationNames []string) ([]*model.FormationStatus, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	participantCounts := make(map[model.LabelableObject]map[string]int, 3)
+	for _, objectType := range []model.LabelableObject{model.ApplicationLabelableObject, model.RuntimeLabelableObject, model.RuntimeContextLabelableObject} {
+		idsByFormation, err := s.listParticipantIDs(ctx, tnt, objectType, formationNames)
+		if err != nil {
+			return nil, err
+		}
+
+		participantCounts[objectType] = make(map[string]int, len(idsByFormation))
+		for formationName, ids := range idsByFormation {
+			participantCounts[objectType][formationName] = len(ids)
+		}
+	}
+
+	assignments, err := s.repo.ListForScenarioNames(ctx, tnt, formationNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing formation tenant assignments")
+	}
+
+	assignmentCounts := make(map[string]int, len(formationNames))
+	for _, assignment := range assignments {
+		assignmentCounts[assignment.ScenarioName]++
+	}
+
+	statuses := make([]*model.FormationStatus, 0, len(formationNames))
+	for _, formationName := range formationNames {
+		status := &model.FormationStatus{
+			ApplicationsCount:      participantCounts[model.ApplicationLabelableObject][formationName],
+			RuntimesCount:          participantCounts[model.RuntimeLabelableObject][formationName],
+			RuntimeContextsCount:   participantCounts[model.RuntimeContextLabelableObject][formationName],
+			TenantAssignmentsCount: assignmentCounts[formationName],
+		}
+
+		runtimesCount := status.RuntimesCount + status.RuntimeContextsCount
+		switch {
+		case status.ApplicationsCount > 0 && runtimesCount > 0:
+			status.Condition = model.FormationStatusConditionReady
+		case status.ApplicationsCount > 0 || runtimesCount > 0:
+			status.Condition = model.FormationStatusConditionIncomplete
+		default:
+			status.Condition = model.FormationStatusConditionEmpty
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
 // GetFormationsForObject returns slice of formations for entity with ID objID and type objType
 func (s *service) GetFormationsForObject(ctx context.Context, tnt string, objType model.LabelableObject, objID string) ([]string, error) {
 	labelInput := &model.LabelInput{
@@ -194,7 +392,16 @@ func (s *service) CreateFormation(ctx context.Context, tnt string, formation mod
 	}
 
 	// TODO:: Currently we need to support both mechanisms of formation creation/deletion(through label definitions and Formations entity) for backwards compatibility
-	return s.createFormation(ctx, tnt, templateName, formationName)
+	f, err := s.createFormation(ctx, tnt, templateName, formationName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.publishChangeEvent(ctx, tnt, model.ChangeEventTypeCreated, f, "", ""); err != nil {
+		return nil, err
+	}
+
+	return f, nil
 }
 
 // DeleteFormation removes the provided formation from the scenario label definitions of the given tenant.
@@ -216,6 +423,10 @@ func (s *service) DeleteFormation(ctx context.Context, tnt string, formation mod
 		return nil, errors.Wrapf(err, "An error occurred while deleting formation with name: %q", formationName)
 	}
 
+	if err = s.publishChangeEvent(ctx, tnt, model.ChangeEventTypeDeleted, f, "", ""); err != nil {
+		return nil, err
+	}
+
 	return f, nil
 }
 
@@ -235,12 +446,12 @@ func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, obj
 					return nil, err
 				}
 
-				return s.getFormationByName(ctx, formation.Name, tnt)
+				return s.getFormationPublishAndNotify(ctx, tnt, model.ChangeEventTypeAssigned, formation.Name, objectID, objectType)
 			}
 			return nil, err
 		}
 
-		return s.getFormationByName(ctx, formation.Name, tnt)
+		return s.getFormationPublishAndNotify(ctx, tnt, model.ChangeEventTypeAssigned, formation.Name, objectID, objectType)
 	case graphql.FormationObjectTypeTenant:
 		tenantID, err := s.tenantSvc.GetInternalTenant(ctx, objectID)
 		if err != nil {
@@ -250,7 +461,7 @@ func (s *service) AssignFormation(ctx context.Context, tnt, objectID string, obj
 		if _, err = s.CreateAutomaticScenarioAssignment(ctx, newAutomaticScenarioAssignmentModel(formation.Name, tnt, tenantID)); err != nil {
 			return nil, err
 		}
-		return s.getFormationByName(ctx, formation.Name, tnt)
+		return s.getFormationAndPublish(ctx, tnt, model.ChangeEventTypeAssigned, formation.Name, objectID, objectType)
 	default:
 		return nil, fmt.Errorf("unknown formation type %s", objectType)
 	}
@@ -271,7 +482,7 @@ func (s *service) UnassignFormation(ctx context.Context, tnt, objectID string, o
 			return nil, err
 		}
 
-		return s.getFormationByName(ctx, formation.Name, tnt)
+		return s.getFormationPublishAndNotify(ctx, tnt, model.ChangeEventTypeUnassigned, formation.Name, objectID, objectType)
 	case graphql.FormationObjectTypeRuntime, graphql.FormationObjectTypeRuntimeContext:
 		if isFormationComingFromASA, err := s.isFormationComingFromASA(ctx, objectID, formation.Name, objectType); err != nil {
 			return nil, err
@@ -283,7 +494,7 @@ func (s *service) UnassignFormation(ctx context.Context, tnt, objectID string, o
 			return nil, err
 		}
 
-		return s.getFormationByName(ctx, formation.Name, tnt)
+		return s.getFormationPublishAndNotify(ctx, tnt, model.ChangeEventTypeUnassigned, formation.Name, objectID, objectType)
 	case graphql.FormationObjectTypeTenant:
 		asa, err := s.asaService.GetForScenarioName(ctx, formation.Name)
 		if err != nil {
@@ -293,12 +504,31 @@ func (s *service) UnassignFormation(ctx context.Context, tnt, objectID string, o
 			return nil, err
 		}
 
-		return s.getFormationByName(ctx, formation.Name, tnt)
+		return s.getFormationAndPublish(ctx, tnt, model.ChangeEventTypeUnassigned, formation.Name, objectID, objectType)
 	default:
 		return nil, fmt.Errorf("unknown formation type %s", objectType)
 	}
 }
 
+// ResynchronizeFormationNotifications schedules again the failed notifications of the formation with the given name
+func (s *service) ResynchronizeFormationNotifications(ctx context.Context, formationName string) (*model.Formation, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	f, err := s.getFormationByName(ctx, formationName, tnt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.formationAssignmentService.Resynchronize(ctx, tnt, f); err != nil {
+		return nil, errors.Wrapf(err, "while resynchronizing the notifications of formation with name %q", formationName)
+	}
+
+	return f, nil
+}
+
 // CreateAutomaticScenarioAssignment creates a new AutomaticScenarioAssignment for a given ScenarioName, Tenant and TargetTenantID
 // It also ensures that all runtimes with given scenarios are assigned for the TargetTenantID
 func (s *service) CreateAutomaticScenarioAssignment(ctx context.Context, in model.AutomaticScenarioAssignment) (model.AutomaticScenarioAssignment, error) {
@@ -719,3 +949,134 @@ func (s *service) getFormationByName(ctx context.Context, formationName, tnt str
 
 	return f, nil
 }
+
+// getFormationAndPublish returns the formation with the given name and publishes a change event for the (un)assignment of the object
+func (s *service) getFormationAndPublish(ctx context.Context, tnt string, eventType model.ChangeEventType, formationName, objectID string, objectType graphql.FormationObjectType) (*model.Formation, error) {
+	f, err := s.getFormationByName(ctx, formationName, tnt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.publishChangeEvent(ctx, tnt, eventType, f, objectID, objectType); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// getFormationPublishAndNotify does the same as getFormationAndPublish and additionally notifies the rest of the formation participants about the (un)assignment of the object
+func (s *service) getFormationPublishAndNotify(ctx context.Context, tnt string, eventType model.ChangeEventType, formationName, objectID string, objectType graphql.FormationObjectType) (*model.Formation, error) {
+	f, err := s.getFormationAndPublish(ctx, tnt, eventType, formationName, objectID, objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	formationOperation := model.AssignFormation
+	if eventType == model.ChangeEventTypeUnassigned {
+		formationOperation = model.UnassignFormation
+	}
+
+	if err = s.formationAssignmentService.NotifyParticipants(ctx, tnt, f, objectID, objectTypeToLabelableObject(objectType), formationOperation); err != nil {
+		return nil, errors.Wrapf(err, "while notifying the participants of formation with name %q", f.Name)
+	}
+
+	return f, nil
+}
+
+func (s *service) publishChangeEvent(ctx context.Context, tnt string, eventType model.ChangeEventType, formation *model.Formation, objectID string, objectType graphql.FormationObjectType) error {
+	err := s.changeEventService.Publish(ctx, tnt, model.ChangeEventInput{
+		Type:         eventType,
+		ResourceType: resource.Formations,
+		ResourceID:   formation.ID,
+		ResourceName: formation.Name,
+		ObjectID:     objectID,
+		ObjectType:   string(objectType),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while publishing change event for formation with name %q", formation.Name)
+	}
+
+	return nil
+}
+
+type participantIDsPage struct {
+	ids        []string
+	pageInfo   *pagination.Page
+	totalCount int
+}
+
+// listParticipantIDsPages returns a page of the IDs of the objects of the given type which are assigned to each of the given formations
+func (s *service) listParticipantIDsPages(ctx context.Context, objectType model.LabelableObject, formationNames []string, pageSize int, cursor string) (string, []participantIDsPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return "", nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	labelPages, err := s.labelRepository.ListForObjectTypeByScenariosPages(ctx, tnt, objectType, formationNames, pageSize, cursor)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "while listing scenario labels for %s", objectType)
+	}
+
+	idsPages := make([]participantIDsPage, 0, len(labelPages))
+	for _, labelPage := range labelPages {
+		ids := make([]string, 0, len(labelPage.Data))
+		for _, l := range labelPage.Data {
+			ids = append(ids, l.ObjectID)
+		}
+
+		idsPages = append(idsPages, participantIDsPage{
+			ids:        ids,
+			pageInfo:   labelPage.PageInfo,
+			totalCount: labelPage.TotalCount,
+		})
+	}
+
+	return tnt, idsPages, nil
+}
+
+// listParticipantIDs returns the IDs of the objects of the given type grouped by the formations they are assigned to
+func (s *service) listParticipantIDs(ctx context.Context, tnt string, objectType model.LabelableObject, formationNames []string) (map[string][]string, error) {
+	labels, err := s.labelRepository.ListForObjectTypeByScenarios(ctx, tnt, objectType, formationNames)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing scenario labels for %s", objectType)
+	}
+
+	requested := make(map[string]bool, len(formationNames))
+	for _, formationName := range formationNames {
+		requested[formationName] = true
+	}
+
+	idsByFormation := make(map[string][]string, len(formationNames))
+	for _, l := range labels {
+		scenarios, err := label.ValueToStringsSlice(l.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing scenarios of %s with ID %s", objectType, l.ObjectID)
+		}
+
+		for _, scenario := range scenarios {
+			if requested[scenario] {
+				idsByFormation[scenario] = append(idsByFormation[scenario], l.ObjectID)
+			}
+		}
+	}
+
+	return idsByFormation, nil
+}
+
+func collectPagesIDs(idsPages []participantIDsPage) []string {
+	ids := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, idsPage := range idsPages {
+		for _, id := range idsPage.ids {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
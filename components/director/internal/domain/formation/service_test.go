@@ -93,7 +93,7 @@ func TestServiceList(t *testing.T) {
 			// GIVEN
 			formationRepo := testCase.FormationRepoFn()
 
-			svc := formation.NewService(nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := formation.NewService(nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.List(ctx, testCase.InputPageSize, cursor)
@@ -155,7 +155,7 @@ func TestServiceGet(t *testing.T) {
 			// GIVEN
 			formationRepo := testCase.FormationRepoFn()
 
-			svc := formation.NewService(nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := formation.NewService(nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.Get(ctx, testCase.InputID)
@@ -175,6 +175,391 @@ func TestServiceGet(t *testing.T) {
 	}
 }
 
+func TestServiceListApplicationsForFormations(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), Tnt, ExternalTnt)
+
+	testErr := errors.New("Test error")
+
+	formationNames := []string{testFormationName, secondTestFormationName}
+	app1 := &model.Application{Name: "app-1", BaseEntity: &model.BaseEntity{ID: "app-id-1"}}
+	app2 := &model.Application{Name: "app-2", BaseEntity: &model.BaseEntity{ID: "app-id-2"}}
+	app1Label := fixScenariosLabel(model.ApplicationLabelableObject, app1.ID, testFormationName, ScenarioName)
+	app2Label := fixScenariosLabel(model.ApplicationLabelableObject, app2.ID, testFormationName, secondTestFormationName)
+
+	nextPageCursor, err := pagination.EncodeKeysetCursor(app1.ID)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name               string
+		LabelRepoFn        func() *automock.LabelRepository
+		ApplicationRepoFn  func() *automock.ApplicationRepository
+		InputPageSize      int
+		InputCursor        string
+		ExpectedPages      []*model.ApplicationPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.ApplicationLabelableObject, formationNames, 2, "").Return([]*model.LabelPage{
+					{Data: []*model.Label{app1Label, app2Label}, PageInfo: &pagination.Page{}, TotalCount: 2},
+					{Data: []*model.Label{app2Label}, PageInfo: &pagination.Page{}, TotalCount: 1},
+				}, nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, Tnt, []string{app1.ID, app2.ID}).Return([]*model.Application{app2, app1}, nil).Once()
+				return repo
+			},
+			InputPageSize: 2,
+			ExpectedPages: []*model.ApplicationPage{
+				{Data: []*model.Application{app1, app2}, PageInfo: &pagination.Page{}, TotalCount: 2},
+				{Data: []*model.Application{app2}, PageInfo: &pagination.Page{}, TotalCount: 1},
+			},
+		},
+		{
+			Name: "Success with next page",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.ApplicationLabelableObject, formationNames, 1, "").Return([]*model.LabelPage{
+					{Data: []*model.Label{app1Label}, PageInfo: &pagination.Page{EndCursor: nextPageCursor, HasNextPage: true}, TotalCount: 2},
+					{Data: []*model.Label{app2Label}, PageInfo: &pagination.Page{}, TotalCount: 1},
+				}, nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, Tnt, []string{app1.ID, app2.ID}).Return([]*model.Application{app1, app2}, nil).Once()
+				return repo
+			},
+			InputPageSize: 1,
+			ExpectedPages: []*model.ApplicationPage{
+				{Data: []*model.Application{app1}, PageInfo: &pagination.Page{EndCursor: nextPageCursor, HasNextPage: true}, TotalCount: 2},
+				{Data: []*model.Application{app2}, PageInfo: &pagination.Page{}, TotalCount: 1},
+			},
+		},
+		{
+			Name: "Success for second page",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.ApplicationLabelableObject, formationNames, 1, nextPageCursor).Return([]*model.LabelPage{
+					{Data: []*model.Label{app2Label}, PageInfo: &pagination.Page{StartCursor: nextPageCursor}, TotalCount: 2},
+					{PageInfo: &pagination.Page{StartCursor: nextPageCursor}, TotalCount: 1},
+				}, nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, Tnt, []string{app2.ID}).Return([]*model.Application{app2}, nil).Once()
+				return repo
+			},
+			InputPageSize: 1,
+			InputCursor:   nextPageCursor,
+			ExpectedPages: []*model.ApplicationPage{
+				{Data: []*model.Application{app2}, PageInfo: &pagination.Page{StartCursor: nextPageCursor}, TotalCount: 2},
+				{Data: []*model.Application{}, PageInfo: &pagination.Page{StartCursor: nextPageCursor}, TotalCount: 1},
+			},
+		},
+		{
+			Name: "Returns error when listing scenario labels fails",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.ApplicationLabelableObject, formationNames, 2, "").Return(nil, testErr).Once()
+				return repo
+			},
+			ApplicationRepoFn:  unusedApplicationRepo,
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing applications fails",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.ApplicationLabelableObject, formationNames, 2, "").Return([]*model.LabelPage{
+					{Data: []*model.Label{app1Label, app2Label}, PageInfo: &pagination.Page{}, TotalCount: 2},
+					{Data: []*model.Label{app2Label}, PageInfo: &pagination.Page{}, TotalCount: 1},
+				}, nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, Tnt, []string{app1.ID, app2.ID}).Return(nil, testErr).Once()
+				return repo
+			},
+			InputPageSize:      2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Returns error when page size is not between 1 and 200",
+			LabelRepoFn:        unusedLabelRepo,
+			ApplicationRepoFn:  unusedApplicationRepo,
+			InputPageSize:      300,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			labelRepo := testCase.LabelRepoFn()
+			appRepo := testCase.ApplicationRepoFn()
+
+			svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, appRepo, nil, nil)
+
+			// WHEN
+			actual, err := svc.ListApplicationsForFormations(ctx, formationNames, testCase.InputPageSize, testCase.InputCursor)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPages, actual)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, actual)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelRepo, appRepo)
+		})
+	}
+}
+
+func TestServiceListRuntimesForFormations(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), Tnt, ExternalTnt)
+
+	testErr := errors.New("Test error")
+
+	formationNames := []string{testFormationName}
+	rt := &model.Runtime{ID: RuntimeID, Name: "runtime"}
+	labelPages := []*model.LabelPage{{Data: []*model.Label{fixScenariosLabel(model.RuntimeLabelableObject, RuntimeID, testFormationName)}, PageInfo: &pagination.Page{}, TotalCount: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.RuntimeLabelableObject, formationNames, 100, "").Return(labelPages, nil).Once()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("ListAllByIDs", ctx, Tnt, []string{RuntimeID}).Return([]*model.Runtime{rt}, nil).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListRuntimesForFormations(ctx, formationNames, 100, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.RuntimePage{{Data: []*model.Runtime{rt}, PageInfo: &pagination.Page{}, TotalCount: 1}}, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, runtimeRepo)
+	})
+
+	t.Run("Returns error when listing runtimes fails", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.RuntimeLabelableObject, formationNames, 100, "").Return(labelPages, nil).Once()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("ListAllByIDs", ctx, Tnt, []string{RuntimeID}).Return(nil, testErr).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListRuntimesForFormations(ctx, formationNames, 100, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, runtimeRepo)
+	})
+}
+
+func TestServiceListRuntimeContextsForFormations(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), Tnt, ExternalTnt)
+
+	testErr := errors.New("Test error")
+
+	formationNames := []string{testFormationName}
+	rtmCtx := &model.RuntimeContext{ID: RuntimeContextID, RuntimeID: RuntimeID, Key: "key", Value: "value"}
+	labelPages := []*model.LabelPage{{Data: []*model.Label{fixScenariosLabel(model.RuntimeContextLabelableObject, RuntimeContextID, testFormationName)}, PageInfo: &pagination.Page{}, TotalCount: 1}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.RuntimeContextLabelableObject, formationNames, 100, "").Return(labelPages, nil).Once()
+		runtimeContextRepo := &automock.RuntimeContextRepository{}
+		runtimeContextRepo.On("ListAllByIDs", ctx, Tnt, []string{RuntimeContextID}).Return([]*model.RuntimeContext{rtmCtx}, nil).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeContextRepo, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListRuntimeContextsForFormations(ctx, formationNames, 100, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.RuntimeContextPage{{Data: []*model.RuntimeContext{rtmCtx}, PageInfo: &pagination.Page{}, TotalCount: 1}}, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, runtimeContextRepo)
+	})
+
+	t.Run("Returns error when listing runtime contexts fails", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObjectTypeByScenariosPages", ctx, Tnt, model.RuntimeContextLabelableObject, formationNames, 100, "").Return(labelPages, nil).Once()
+		runtimeContextRepo := &automock.RuntimeContextRepository{}
+		runtimeContextRepo.On("ListAllByIDs", ctx, Tnt, []string{RuntimeContextID}).Return(nil, testErr).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeContextRepo, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListRuntimeContextsForFormations(ctx, formationNames, 100, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, runtimeContextRepo)
+	})
+}
+
+func TestServiceListTenantAssignmentsForFormations(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), Tnt, ExternalTnt)
+
+	testErr := errors.New("Test error")
+
+	formationNames := []string{testFormationName, secondTestFormationName}
+	asa := &model.AutomaticScenarioAssignment{ScenarioName: testFormationName, Tenant: Tnt, TargetTenantID: TargetTenantID}
+
+	nextPageCursor, err := pagination.EncodeKeysetCursor(TargetTenantID)
+	require.NoError(t, err)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		assignmentPages := []*model.AutomaticScenarioAssignmentPage{
+			{Data: []*model.AutomaticScenarioAssignment{asa}, PageInfo: &pagination.Page{EndCursor: nextPageCursor, HasNextPage: true}, TotalCount: 2},
+			{PageInfo: &pagination.Page{}, TotalCount: 0},
+		}
+
+		asaRepo := &automock.AutomaticFormationAssignmentRepository{}
+		asaRepo.On("ListForScenarioNamesPages", ctx, Tnt, formationNames, 1, "").Return(assignmentPages, nil).Once()
+
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListTenantAssignmentsForFormations(ctx, formationNames, 1, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, assignmentPages, actual)
+		asaRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when listing assignments fails", func(t *testing.T) {
+		// GIVEN
+		asaRepo := &automock.AutomaticFormationAssignmentRepository{}
+		asaRepo.On("ListForScenarioNamesPages", ctx, Tnt, formationNames, 1, "").Return(nil, testErr).Once()
+
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListTenantAssignmentsForFormations(ctx, formationNames, 1, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		asaRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when page size is not between 1 and 200", func(t *testing.T) {
+		// GIVEN
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.ListTenantAssignmentsForFormations(ctx, formationNames, 0, "")
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "page size must be between 1 and 200")
+		require.Nil(t, actual)
+	})
+}
+
+func TestServiceGetStatusForFormations(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), Tnt, ExternalTnt)
+
+	testErr := errors.New("Test error")
+
+	readyFormationName := "ready-formation"
+	formationNames := []string{readyFormationName, testFormationName, secondTestFormationName}
+
+	labelRepoFn := func() *automock.LabelRepository {
+		repo := &automock.LabelRepository{}
+		repo.On("ListForObjectTypeByScenarios", ctx, Tnt, model.ApplicationLabelableObject, formationNames).Return([]*model.Label{
+			fixScenariosLabel(model.ApplicationLabelableObject, "app-id", readyFormationName, testFormationName),
+		}, nil).Once()
+		repo.On("ListForObjectTypeByScenarios", ctx, Tnt, model.RuntimeLabelableObject, formationNames).Return([]*model.Label{}, nil).Once()
+		repo.On("ListForObjectTypeByScenarios", ctx, Tnt, model.RuntimeContextLabelableObject, formationNames).Return([]*model.Label{
+			fixScenariosLabel(model.RuntimeContextLabelableObject, RuntimeContextID, readyFormationName),
+		}, nil).Once()
+		return repo
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		labelRepo := labelRepoFn()
+		asaRepo := &automock.AutomaticFormationAssignmentRepository{}
+		asaRepo.On("ListForScenarioNames", ctx, Tnt, formationNames).Return([]*model.AutomaticScenarioAssignment{{ScenarioName: readyFormationName, Tenant: Tnt, TargetTenantID: TargetTenantID}}, nil).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.GetStatusForFormations(ctx, formationNames)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.FormationStatus{
+			{Condition: model.FormationStatusConditionReady, ApplicationsCount: 1, RuntimeContextsCount: 1, TenantAssignmentsCount: 1},
+			{Condition: model.FormationStatusConditionIncomplete, ApplicationsCount: 1},
+			{Condition: model.FormationStatusConditionEmpty},
+		}, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, asaRepo)
+	})
+
+	t.Run("Returns error when listing scenario labels fails", func(t *testing.T) {
+		// GIVEN
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObjectTypeByScenarios", ctx, Tnt, model.ApplicationLabelableObject, formationNames).Return(nil, testErr).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.GetStatusForFormations(ctx, formationNames)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		labelRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when listing assignments fails", func(t *testing.T) {
+		// GIVEN
+		labelRepo := labelRepoFn()
+		asaRepo := &automock.AutomaticFormationAssignmentRepository{}
+		asaRepo.On("ListForScenarioNames", ctx, Tnt, formationNames).Return(nil, testErr).Once()
+
+		svc := formation.NewService(nil, labelRepo, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		actual, err := svc.GetStatusForFormations(ctx, formationNames)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		require.Nil(t, actual)
+		mock.AssertExpectationsForObjects(t, labelRepo, asaRepo)
+	})
+}
+
 func TestServiceCreateFormation(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, Tnt, ExternalTnt)
@@ -211,6 +596,7 @@ func TestServiceCreateFormation(t *testing.T) {
 		LabelDefServiceFn       func() *automock.LabelDefService
 		FormationTemplateRepoFn func() *automock.FormationTemplateRepository
 		FormationRepoFn         func() *automock.FormationRepository
+		ChangeEventServiceFn    func() *automock.ChangeEventService
 		TemplateName            string
 		ExpectedFormation       *model.Formation
 		ExpectedErrMessage      string
@@ -432,6 +818,41 @@ func TestServiceCreateFormation(t *testing.T) {
 			TemplateName:       templateName,
 			ExpectedErrMessage: testErr.Error(),
 		},
+		{
+			Name: "error when publishing change event fails",
+			UUIDServiceFn: func() *automock.UuidService {
+				uuidService := &automock.UuidService{}
+				uuidService.On("Generate").Return(fixUUID())
+				return uuidService
+			},
+			LabelDefRepositoryFn: func() *automock.LabelDefRepository {
+				labelDefRepo := &automock.LabelDefRepository{}
+				labelDefRepo.On("GetByKey", ctx, Tnt, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.LabelDefinition, ""))
+				return labelDefRepo
+			},
+			LabelDefServiceFn: func() *automock.LabelDefService {
+				labelDefService := &automock.LabelDefService{}
+				labelDefService.On("CreateWithFormations", ctx, Tnt, []string{testFormationName}).Return(nil)
+				return labelDefService
+			},
+			FormationTemplateRepoFn: func() *automock.FormationTemplateRepository {
+				formationTemplateRepoMock := &automock.FormationTemplateRepository{}
+				formationTemplateRepoMock.On("GetByName", ctx, templateName).Return(fixFormationTemplateModel(), nil).Once()
+				return formationTemplateRepoMock
+			},
+			FormationRepoFn: func() *automock.FormationRepository {
+				formationRepoMock := &automock.FormationRepository{}
+				formationRepoMock.On("Create", ctx, fixFormationModel()).Return(nil).Once()
+				return formationRepoMock
+			},
+			ChangeEventServiceFn: func() *automock.ChangeEventService {
+				changeEventSvc := &automock.ChangeEventService{}
+				changeEventSvc.On("Publish", ctx, Tnt, model.ChangeEventInput{Type: model.ChangeEventTypeCreated, ResourceType: resource.Formations, ResourceID: fixUUID(), ResourceName: testFormationName}).Return(testErr).Once()
+				return changeEventSvc
+			},
+			TemplateName:       templateName,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -452,7 +873,12 @@ func TestServiceCreateFormation(t *testing.T) {
 				formationTemplateRepoMock = testCase.FormationTemplateRepoFn()
 			}
 
-			svc := formation.NewService(lblDefRepo, nil, formationRepoMock, formationTemplateRepoMock, nil, uuidSvcMock, lblDefService, nil, nil, nil, nil, nil)
+			changeEventSvc := changeEventServiceThatPublishes()
+			if testCase.ChangeEventServiceFn != nil {
+				changeEventSvc = testCase.ChangeEventServiceFn()
+			}
+
+			svc := formation.NewService(lblDefRepo, nil, formationRepoMock, formationTemplateRepoMock, nil, uuidSvcMock, lblDefService, nil, nil, nil, nil, nil, nil, changeEventSvc, formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.CreateFormation(ctx, Tnt, in, testCase.TemplateName)
@@ -467,7 +893,7 @@ func TestServiceCreateFormation(t *testing.T) {
 				require.Nil(t, actual)
 			}
 
-			mock.AssertExpectationsForObjects(t, uuidSvcMock, lblDefRepo, lblDefService, formationRepoMock, formationTemplateRepoMock)
+			mock.AssertExpectationsForObjects(t, uuidSvcMock, lblDefRepo, lblDefService, formationRepoMock, formationTemplateRepoMock, changeEventSvc)
 		})
 	}
 }
@@ -689,7 +1115,7 @@ func TestServiceDeleteFormation(t *testing.T) {
 				formationRepoMock = testCase.FormationRepoFn()
 			}
 
-			svc := formation.NewService(lblDefRepo, nil, formationRepoMock, nil, nil, nil, lblDefService, nil, nil, nil, nil, nil)
+			svc := formation.NewService(lblDefRepo, nil, formationRepoMock, nil, nil, nil, lblDefService, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.DeleteFormation(ctx, Tnt, testCase.InputFormation)
@@ -1400,7 +1826,7 @@ func TestServiceAssignFormation(t *testing.T) {
 				tenantSvc = testCase.TenantServiceFn()
 			}
 
-			svc := formation.NewService(nil, nil, formationRepo, nil, labelService, uidService, labelDefService, asaRepo, asaService, tenantSvc, runtimeRepo, runtimeContextRepo)
+			svc := formation.NewService(nil, nil, formationRepo, nil, labelService, uidService, labelDefService, asaRepo, asaService, tenantSvc, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.AssignFormation(ctx, Tnt, objectID, testCase.ObjectType, testCase.InputFormation)
@@ -1420,6 +1846,178 @@ func TestServiceAssignFormation(t *testing.T) {
 	}
 }
 
+func TestServiceAssignFormation_NotifiesParticipants(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, Tnt, ExternalTnt)
+
+	testErr := errors.New("test error")
+	objectID := "123"
+
+	inputFormation := model.Formation{
+		Name: testFormationName,
+	}
+	expectedFormation := &model.Formation{
+		ID:                  fixUUID(),
+		Name:                testFormationName,
+		FormationTemplateID: FormationTemplateID,
+		TenantID:            Tnt,
+	}
+	applicationLblInput := model.LabelInput{
+		Key:        model.ScenariosKey,
+		Value:      []string{testFormationName},
+		ObjectID:   objectID,
+		ObjectType: model.ApplicationLabelableObject,
+		Version:    0,
+	}
+
+	testCases := []struct {
+		Name                         string
+		FormationAssignmentServiceFn func() *automock.FormationAssignmentService
+		ExpectedFormation            *model.Formation
+		ExpectedErrMessage           string
+	}{
+		{
+			Name: "success",
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("NotifyParticipants", ctx, Tnt, expectedFormation, objectID, model.ApplicationLabelableObject, model.AssignFormation).Return(nil).Once()
+				return svc
+			},
+			ExpectedFormation: expectedFormation,
+		},
+		{
+			Name: "error when notifying participants fails",
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("NotifyParticipants", ctx, Tnt, expectedFormation, objectID, model.ApplicationLabelableObject, model.AssignFormation).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			uidService := &automock.UuidService{}
+			uidService.On("Generate").Return(fixUUID())
+			labelService := &automock.LabelService{}
+			labelService.On("GetLabel", ctx, Tnt, &applicationLblInput).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
+			labelService.On("CreateLabel", ctx, Tnt, fixUUID(), &applicationLblInput).Return(nil)
+			formationRepo := &automock.FormationRepository{}
+			formationRepo.On("GetByName", ctx, testFormationName, Tnt).Return(expectedFormation, nil).Once()
+			formationAssignmentSvc := testCase.FormationAssignmentServiceFn()
+
+			svc := formation.NewService(nil, nil, formationRepo, nil, labelService, uidService, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentSvc)
+
+			// WHEN
+			actual, err := svc.AssignFormation(ctx, Tnt, objectID, graphql.FormationObjectTypeApplication, inputFormation)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedFormation, actual)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, actual)
+			}
+
+			mock.AssertExpectationsForObjects(t, uidService, labelService, formationRepo, formationAssignmentSvc)
+		})
+	}
+}
+
+func TestServiceResynchronizeFormationNotifications(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, Tnt, ExternalTnt)
+
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name                         string
+		Context                      context.Context
+		FormationRepositoryFn        func() *automock.FormationRepository
+		FormationAssignmentServiceFn func() *automock.FormationAssignmentService
+		ExpectedFormation            *model.Formation
+		ExpectedErrMessage           string
+	}{
+		{
+			Name:    "success",
+			Context: ctx,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, Tnt).Return(fixFormationModel(), nil).Once()
+				return formationRepo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("Resynchronize", ctx, Tnt, fixFormationModel()).Return(nil).Once()
+				return svc
+			},
+			ExpectedFormation: fixFormationModel(),
+		},
+		{
+			Name:                         "error when tenant is missing in context",
+			Context:                      context.TODO(),
+			FormationRepositoryFn:        unusedFormationRepo,
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService { return &automock.FormationAssignmentService{} },
+			ExpectedErrMessage:           "while loading tenant from context",
+		},
+		{
+			Name:    "error when getting formation fails",
+			Context: ctx,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, Tnt).Return(nil, testErr).Once()
+				return formationRepo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService { return &automock.FormationAssignmentService{} },
+			ExpectedErrMessage:           testErr.Error(),
+		},
+		{
+			Name:    "error when resynchronizing fails",
+			Context: ctx,
+			FormationRepositoryFn: func() *automock.FormationRepository {
+				formationRepo := &automock.FormationRepository{}
+				formationRepo.On("GetByName", ctx, testFormationName, Tnt).Return(fixFormationModel(), nil).Once()
+				return formationRepo
+			},
+			FormationAssignmentServiceFn: func() *automock.FormationAssignmentService {
+				svc := &automock.FormationAssignmentService{}
+				svc.On("Resynchronize", ctx, Tnt, fixFormationModel()).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			formationRepo := testCase.FormationRepositoryFn()
+			formationAssignmentSvc := testCase.FormationAssignmentServiceFn()
+
+			svc := formation.NewService(nil, nil, formationRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, formationAssignmentSvc)
+
+			// WHEN
+			actual, err := svc.ResynchronizeFormationNotifications(testCase.Context, testFormationName)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedFormation, actual)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Nil(t, actual)
+			}
+
+			mock.AssertExpectationsForObjects(t, formationRepo, formationAssignmentSvc)
+		})
+	}
+}
+
 func TestServiceUnassignFormation(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tenant.SaveToContext(ctx, Tnt, ExternalTnt)
@@ -2228,7 +2826,7 @@ func TestServiceUnassignFormation(t *testing.T) {
 			runtimeRepo := testCase.RuntimeRepoFN()
 			runtimeContextRepo := testCase.RuntimeContextRepoFn()
 			formationRepo := testCase.FormationRepositoryFn()
-			svc := formation.NewService(nil, labelRepo, formationRepo, nil, labelService, uidService, nil, asaRepo, asaService, nil, runtimeRepo, runtimeContextRepo)
+			svc := formation.NewService(nil, labelRepo, formationRepo, nil, labelService, uidService, nil, asaRepo, asaService, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.UnassignFormation(ctx, Tnt, objectID, testCase.ObjectType, testCase.InputFormation)
@@ -2386,7 +2984,7 @@ func TestService_CreateAutomaticScenarioAssignment(t *testing.T) {
 			runtimeRepo := testCase.RuntimeRepoFN()
 			runtimeContextRepo := testCase.RuntimeContextRepoFn()
 
-			svc := formation.NewService(nil, nil, nil, nil, nil, nil, labelDefService, asaRepo, nil, tenantSvc, runtimeRepo, runtimeContextRepo)
+			svc := formation.NewService(nil, nil, nil, nil, nil, nil, labelDefService, asaRepo, nil, tenantSvc, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			actual, err := svc.CreateAutomaticScenarioAssignment(ctx, testCase.InputASA)
@@ -2407,7 +3005,7 @@ func TestService_CreateAutomaticScenarioAssignment(t *testing.T) {
 
 	t.Run("returns error on missing tenant in context", func(t *testing.T) {
 		// GIVEN
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := svc.CreateAutomaticScenarioAssignment(context.TODO(), fixModel())
@@ -2445,7 +3043,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(make([]*model.RuntimeContext, 0), nil)
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo, runtimeContextRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, models)
@@ -2463,7 +3061,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 		runtimeRepo.On("ListOwnedRuntimes", ctx, TargetTenantID, []*labelfilter.LabelFilter(nil)).Return(nil, fixError())
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, models)
@@ -2485,7 +3083,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(nil, fixError())
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo, runtimeContextRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, models)
@@ -2497,7 +3095,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 
 	t.Run("return error when input slice is empty", func(t *testing.T) {
 		// GIVEN
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, []*model.AutomaticScenarioAssignment{})
@@ -2520,7 +3118,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 			},
 		}
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, modelsWithDifferentSelectors)
 
@@ -2537,7 +3135,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 
 		defer mock.AssertExpectationsForObjects(t, mockRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 		// WHEN
 		err := svc.DeleteManyASAForSameTargetTenant(ctx, models)
 
@@ -2546,7 +3144,7 @@ func TestService_DeleteManyASAForSameTargetTenant(t *testing.T) {
 	})
 
 	t.Run("returns error when empty tenant", func(t *testing.T) {
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		err := svc.DeleteManyASAForSameTargetTenant(context.TODO(), models)
 		require.EqualError(t, err, "cannot read tenant from context")
 	})
@@ -2566,7 +3164,7 @@ func TestService_DeleteForScenarioName(t *testing.T) {
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(make([]*model.RuntimeContext, 0), nil).Once()
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo, runtimeContextRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteAutomaticScenarioAssignment(fixCtxWithTenant(), fixModel())
@@ -2585,7 +3183,7 @@ func TestService_DeleteForScenarioName(t *testing.T) {
 		runtimeRepo.On("ListOwnedRuntimes", ctx, TargetTenantID, []*labelfilter.LabelFilter(nil)).Return(nil, fixError()).Once()
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteAutomaticScenarioAssignment(ctx, fixModel())
@@ -2608,7 +3206,7 @@ func TestService_DeleteForScenarioName(t *testing.T) {
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(nil, fixError())
 		defer mock.AssertExpectationsForObjects(t, mockRepo, runtimeRepo, runtimeContextRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteAutomaticScenarioAssignment(ctx, fixModel())
@@ -2620,7 +3218,7 @@ func TestService_DeleteForScenarioName(t *testing.T) {
 
 	t.Run("error on missing tenant in context", func(t *testing.T) {
 		// GIVEN
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		err := svc.DeleteAutomaticScenarioAssignment(context.TODO(), fixModel())
@@ -2636,7 +3234,7 @@ func TestService_DeleteForScenarioName(t *testing.T) {
 		mockRepo.On("DeleteForScenarioName", ctx, tenantID.String(), ScenarioName).Return(fixError()).Once()
 		defer mock.AssertExpectationsForObjects(t, mockRepo)
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, mockRepo, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.DeleteAutomaticScenarioAssignment(fixCtxWithTenant(), fixModel())
@@ -2766,7 +3364,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		formationRepo := &automock.FormationRepository{}
 		formationRepo.On("GetByName", ctx, selectorScenario, in.Tenant).Return(expectedFormation, nil).Times(4)
 
-		svc := formation.NewService(nil, nil, formationRepo, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, formationRepo, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2792,7 +3390,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 			ObjectType: model.RuntimeLabelableObject,
 		}).Return(testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2812,7 +3410,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		labelService := &automock.LabelService{}
 		labelService.On("GetLabel", ctx, tenantID.String(), &labelInputWithoutScenario).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2830,7 +3428,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("ListOwnedRuntimes", ctx, TargetTenantID, []*labelfilter.LabelFilter(nil)).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2861,7 +3459,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 			ObjectType: model.RuntimeContextLabelableObject,
 		}).Return(testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2885,7 +3483,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		upsertSvc := &automock.LabelService{}
 		upsertSvc.On("GetLabel", ctx, tenantID.String(), &rtmCtxLabelInputWithoutScenario).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, upsertSvc, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2906,7 +3504,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		runtimeContextRepo := &automock.RuntimeContextRepository{}
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -2927,7 +3525,7 @@ func TestService_EnsureScenarioAssigned(t *testing.T) {
 		runtimeContextRepo := &automock.RuntimeContextRepository{}
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(make([]*model.RuntimeContext, 0), nil).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.EnsureScenarioAssigned(ctx, in)
@@ -3017,7 +3615,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 		formationRepo := &automock.FormationRepository{}
 		formationRepo.On("GetByName", ctx, selectorScenario, in.Tenant).Return(expectedFormation, nil).Times(2)
 
-		svc := formation.NewService(nil, nil, formationRepo, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, formationRepo, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3046,7 +3644,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 			ObjectType: model.RuntimeLabelableObject,
 		}).Return(testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3064,7 +3662,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("ListOwnedRuntimes", ctx, TargetTenantID, []*labelfilter.LabelFilter(nil)).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3088,7 +3686,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 		labelService := &automock.LabelService{}
 		labelService.On("GetLabel", ctx, tenantID.String(), &labelInput).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3120,7 +3718,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 			ObjectType: model.RuntimeContextLabelableObject,
 		}).Return(testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3141,7 +3739,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 		runtimeContextRepo := &automock.RuntimeContextRepository{}
 		runtimeContextRepo.On("ListAll", ctx, TargetTenantID).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3168,7 +3766,7 @@ func TestService_RemoveAssignedScenario(t *testing.T) {
 		labelService := &automock.LabelService{}
 		labelService.On("GetLabel", ctx, tenantID.String(), &rtmCtxLabelInput).Return(nil, testErr).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		err := svc.RemoveAssignedScenario(ctx, in)
@@ -3213,7 +3811,7 @@ func TestService_MergeScenariosFromInputLabelsAndAssignments_Success(t *testing.
 	runtimeRepo.On("Exists", ctx, TargetTenantID, runtimeID).Return(true, nil).Once()
 	runtimeRepo.On("Exists", ctx, differentTargetTenant, runtimeID).Return(false, nil).Once()
 
-	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 	// WHEN
 	actualScenarios, err := svc.MergeScenariosFromInputLabelsAndAssignments(ctx, inputLabels, runtimeID)
@@ -3254,7 +3852,7 @@ func TestService_MergeScenariosFromInputLabelsAndAssignments_SuccessIfScenariosL
 	runtimeRepo := &automock.RuntimeRepository{}
 	runtimeRepo.On("Exists", ctx, TargetTenantID, runtimeID).Return(true, nil).Once()
 
-	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 	// WHEN
 	actualScenarios, err := svc.MergeScenariosFromInputLabelsAndAssignments(ctx, inputLabels, runtimeID)
@@ -3280,7 +3878,7 @@ func TestService_MergeScenariosFromInputLabelsAndAssignments_ReturnsErrorIfListA
 	asaRepo := &automock.AutomaticFormationAssignmentRepository{}
 	asaRepo.On("ListAll", ctx, tenantID.String()).Return(nil, testErr)
 
-	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil)
+	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 	// WHEN
 	_, err := svc.MergeScenariosFromInputLabelsAndAssignments(ctx, inputLabels, "runtimeID")
@@ -3317,7 +3915,7 @@ func TestService_MergeScenariosFromInputLabelsAndAssignments_ReturnsErrorIfExist
 	runtimeRepo := &automock.RuntimeRepository{}
 	runtimeRepo.On("Exists", ctx, TargetTenantID, runtimeID).Return(false, testErr).Once()
 
-	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 	// WHEN
 	_, err := svc.MergeScenariosFromInputLabelsAndAssignments(ctx, inputLabels, runtimeID)
@@ -3355,7 +3953,7 @@ func TestService_MergeScenariosFromInputLabelsAndAssignments_ReturnsErrorIfScena
 	runtimeRepo := &automock.RuntimeRepository{}
 	runtimeRepo.On("Exists", ctx, TargetTenantID, runtimeID).Return(true, nil).Once()
 
-	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil)
+	svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 	// WHEN
 	_, err := svc.MergeScenariosFromInputLabelsAndAssignments(ctx, inputLabels, runtimeID)
@@ -3498,7 +4096,7 @@ func TestService_GetScenariosFromMatchingASAs(t *testing.T) {
 			runtimeRepo := testCase.RuntimeRepoFn()
 			runtimeContextRepo := testCase.RuntimeContextRepoFn()
 
-			svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo)
+			svc := formation.NewService(nil, nil, nil, nil, nil, nil, nil, asaRepo, nil, nil, runtimeRepo, runtimeContextRepo, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 			// WHEN
 			scenarios, err := svc.GetScenariosFromMatchingASAs(ctx, testCase.ObjectID, testCase.ObjectType)
@@ -3544,7 +4142,7 @@ func TestService_GetFormationsForObject(t *testing.T) {
 		labelService := &automock.LabelService{}
 		labelService.On("GetLabel", ctx, tenantID.String(), labelInput).Return(label, nil).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		formations, err := svc.GetFormationsForObject(ctx, tenantID.String(), model.RuntimeLabelableObject, id)
@@ -3562,7 +4160,7 @@ func TestService_GetFormationsForObject(t *testing.T) {
 		labelService := &automock.LabelService{}
 		labelService.On("GetLabel", ctx, tenantID.String(), labelInput).Return(nil, errors.New(testErr)).Once()
 
-		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, nil, nil)
+		svc := formation.NewService(nil, nil, nil, nil, labelService, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), formationAssignmentServiceThatNotifies())
 
 		// WHEN
 		formations, err := svc.GetFormationsForObject(ctx, tenantID.String(), model.RuntimeLabelableObject, id)
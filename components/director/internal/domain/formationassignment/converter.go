@@ -0,0 +1,55 @@
+package formationassignment
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+// NewConverter creates a new formation assignment converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts from internal model to entity
+func (c *converter) ToEntity(in *model.FormationAssignment) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:          in.ID,
+		FormationID: in.FormationID,
+		TenantID:    in.TenantID,
+		Source:      in.Source,
+		SourceType:  string(in.SourceType),
+		Target:      in.Target,
+		TargetType:  string(in.TargetType),
+		WebhookID:   in.WebhookID,
+		Operation:   string(in.Operation),
+		State:       string(in.State),
+		Error:       repo.NewNullableString(in.Error),
+	}
+}
+
+// FromEntity converts from entity to internal model
+func (c *converter) FromEntity(entity *Entity) *model.FormationAssignment {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.FormationAssignment{
+		ID:          entity.ID,
+		FormationID: entity.FormationID,
+		TenantID:    entity.TenantID,
+		Source:      entity.Source,
+		SourceType:  model.LabelableObject(entity.SourceType),
+		Target:      entity.Target,
+		TargetType:  model.LabelableObject(entity.TargetType),
+		WebhookID:   entity.WebhookID,
+		Operation:   model.FormationOperation(entity.Operation),
+		State:       model.FormationAssignmentState(entity.State),
+		Error:       repo.StringPtrFromNullableString(entity.Error),
+	}
+}
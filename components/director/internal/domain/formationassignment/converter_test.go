@@ -0,0 +1,38 @@
+package formationassignment_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := formationassignment.NewConverter()
+
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, fixFormationAssignmentEntity(model.FormationAssignmentStateFailed, sql.NullString{String: TestError, Valid: true}), conv.ToEntity(fixFormationAssignmentModel(model.FormationAssignmentStateFailed, &testErrMsg)))
+	})
+	t.Run("success without error", func(t *testing.T) {
+		assert.Equal(t, fixFormationAssignmentEntity(model.FormationAssignmentStateReady, sql.NullString{}), conv.ToEntity(fixFormationAssignmentModel(model.FormationAssignmentStateReady, nil)))
+	})
+	t.Run("returns nil for nil model", func(t *testing.T) {
+		assert.Nil(t, conv.ToEntity(nil))
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := formationassignment.NewConverter()
+
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, fixFormationAssignmentModel(model.FormationAssignmentStateFailed, &testErrMsg), conv.FromEntity(fixFormationAssignmentEntity(model.FormationAssignmentStateFailed, sql.NullString{String: TestError, Valid: true})))
+	})
+	t.Run("success without error", func(t *testing.T) {
+		assert.Equal(t, fixFormationAssignmentModel(model.FormationAssignmentStateReady, nil), conv.FromEntity(fixFormationAssignmentEntity(model.FormationAssignmentStateReady, sql.NullString{})))
+	})
+	t.Run("returns nil for nil entity", func(t *testing.T) {
+		assert.Nil(t, conv.FromEntity(nil))
+	})
+}
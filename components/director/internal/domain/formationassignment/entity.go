@@ -0,0 +1,26 @@
+package formationassignment
+
+import "database/sql"
+
+// Entity represents the formation assignment entity
+type Entity struct {
+	ID          string         `db:"id"`
+	FormationID string         `db:"formation_id"`
+	TenantID    string         `db:"tenant_id"`
+	Source      string         `db:"source"`
+	SourceType  string         `db:"source_type"`
+	Target      string         `db:"target"`
+	TargetType  string         `db:"target_type"`
+	WebhookID   string         `db:"webhook_id"`
+	Operation   string         `db:"operation"`
+	State       string         `db:"state"`
+	Error       sql.NullString `db:"error"`
+}
+
+// EntityCollection is a collection of formation assignment entities.
+type EntityCollection []*Entity
+
+// Len returns the number of entities in the collection.
+func (s EntityCollection) Len() int {
+	return len(s)
+}
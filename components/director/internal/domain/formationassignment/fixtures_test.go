@@ -0,0 +1,68 @@
+package formationassignment_test
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+const (
+	TestID          = "c861c3db-1265-4143-a05c-1ced1291d816"
+	TestFormationID = "a7c0bd01-2441-4ca1-9b5e-a54e74fd7773"
+	TestTenantID    = "b4d1bd32-dd07-4141-9655-42bc33a4ae37"
+	TestSource      = "05e10560-2259-4adf-bb3e-6aa9d7e4a8d2"
+	TestTarget      = "d2ec3a3c-e3e0-4bc4-9d2c-3fe7cbe8a9e2"
+	TestWebhookID   = "6d12c7bf-0a95-4b7a-8a33-3ea1c6aa1b9b"
+	TestRuntimeID   = "99a2aca6-7c6d-4b0f-a7ac-6a4b2c8e9f14"
+	TestError       = `{"error":"some error"}`
+)
+
+var (
+	nilFormationAssignmentModel *model.FormationAssignment
+	testErrMsg                  = TestError
+)
+
+func fixFormationAssignmentModel(state model.FormationAssignmentState, errorMsg *string) *model.FormationAssignment {
+	return &model.FormationAssignment{
+		ID:          TestID,
+		FormationID: TestFormationID,
+		TenantID:    TestTenantID,
+		Source:      TestSource,
+		SourceType:  model.ApplicationLabelableObject,
+		Target:      TestTarget,
+		TargetType:  model.RuntimeLabelableObject,
+		WebhookID:   TestWebhookID,
+		Operation:   model.AssignFormation,
+		State:       state,
+		Error:       errorMsg,
+	}
+}
+
+func fixFormationAssignmentEntity(state model.FormationAssignmentState, errorMsg sql.NullString) *formationassignment.Entity {
+	return &formationassignment.Entity{
+		ID:          TestID,
+		FormationID: TestFormationID,
+		TenantID:    TestTenantID,
+		Source:      TestSource,
+		SourceType:  string(model.ApplicationLabelableObject),
+		Target:      TestTarget,
+		TargetType:  string(model.RuntimeLabelableObject),
+		WebhookID:   TestWebhookID,
+		Operation:   string(model.AssignFormation),
+		State:       string(state),
+		Error:       errorMsg,
+	}
+}
+
+func fixFormation() *model.Formation {
+	return &model.Formation{
+		ID:       TestFormationID,
+		TenantID: TestTenantID,
+		Name:     "test-formation",
+	}
+}
+
+func fixColumns() []string {
+	return []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "webhook_id", "operation", "state", "error"}
+}
@@ -0,0 +1,116 @@
+package formationassignment
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const tableName string = `public.formation_assignments`
+
+var (
+	updatableTableColumns = []string{"webhook_id", "operation", "state", "error"}
+	idTableColumns        = []string{"id"}
+	tableColumns          = []string{"id", "formation_id", "tenant_id", "source", "source_type", "target", "target_type", "webhook_id", "operation", "state", "error"}
+	tenantColumn          = "tenant_id"
+)
+
+// EntityConverter converts between the internal model and entity
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	ToEntity(in *model.FormationAssignment) *Entity
+	FromEntity(entity *Entity) *model.FormationAssignment
+}
+
+type repository struct {
+	creator      repo.CreatorGlobal
+	getter       repo.SingleGetter
+	globalGetter repo.SingleGetterGlobal
+	lister       repo.Lister
+	updater      repo.UpdaterGlobal
+	deleter      repo.DeleterGlobal
+	conv         EntityConverter
+}
+
+// NewRepository creates a new FormationAssignment repository
+func NewRepository(conv EntityConverter) *repository {
+	return &repository{
+		creator:      repo.NewCreatorGlobal(resource.FormationAssignment, tableName, tableColumns),
+		getter:       repo.NewSingleGetterWithEmbeddedTenant(tableName, tenantColumn, tableColumns),
+		globalGetter: repo.NewSingleGetterGlobal(resource.FormationAssignment, tableName, tableColumns),
+		lister:       repo.NewListerWithEmbeddedTenant(tableName, tenantColumn, tableColumns),
+		updater:      repo.NewUpdaterWithEmbeddedTenant(resource.FormationAssignment, tableName, updatableTableColumns, tenantColumn, idTableColumns),
+		deleter:      repo.NewDeleterGlobal(resource.FormationAssignment, tableName),
+		conv:         conv,
+	}
+}
+
+// Create creates a FormationAssignment with a given input
+func (r *repository) Create(ctx context.Context, item *model.FormationAssignment) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	log.C(ctx).Debugf("Persisting Formation Assignment entity with ID: %q to the DB", item.ID)
+	return r.creator.Create(ctx, r.conv.ToEntity(item))
+}
+
+// GetGlobalByID returns a FormationAssignment by a given id without tenant isolation
+func (r *repository) GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error) {
+	var entity Entity
+	if err := r.globalGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, errors.Wrapf(err, "while getting formation assignment with id: %q", id)
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetBySourceAndTarget returns the FormationAssignment of the formation with the given id for the given source and target participants
+func (r *repository) GetBySourceAndTarget(ctx context.Context, tenantID, formationID, source, target string) (*model.FormationAssignment, error) {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("formation_id", formationID),
+		repo.NewEqualCondition("source", source),
+		repo.NewEqualCondition("target", target),
+	}
+
+	var entity Entity
+	if err := r.getter.Get(ctx, resource.FormationAssignment, tenantID, conditions, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// ListByFormationIDAndState returns all FormationAssignments of the formation with the given id which are in the given state
+func (r *repository) ListByFormationIDAndState(ctx context.Context, tenantID, formationID string, state model.FormationAssignmentState) ([]*model.FormationAssignment, error) {
+	var entities EntityCollection
+	if err := r.lister.List(ctx, resource.FormationAssignment, tenantID, &entities, repo.NewEqualCondition("formation_id", formationID), repo.NewEqualCondition("state", string(state))); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.FormationAssignment, 0, entities.Len())
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(entity))
+	}
+
+	return items, nil
+}
+
+// Update updates a FormationAssignment with the given input
+func (r *repository) Update(ctx context.Context, item *model.FormationAssignment) error {
+	if item == nil {
+		return apperrors.NewInternalError("model can not be empty")
+	}
+
+	return r.updater.UpdateSingleGlobal(ctx, r.conv.ToEntity(item))
+}
+
+// DeleteGlobal deletes a FormationAssignment with the given id without tenant isolation
+func (r *repository) DeleteGlobal(ctx context.Context, id string) error {
+	return r.deleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
@@ -0,0 +1,185 @@
+package formationassignment_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+)
+
+var (
+	faModel  = fixFormationAssignmentModel(model.FormationAssignmentStateInProgress, nil)
+	faEntity = fixFormationAssignmentEntity(model.FormationAssignmentStateInProgress, sql.NullString{})
+)
+
+func fixRow() []driver.Value {
+	return []driver.Value{TestID, TestFormationID, TestTenantID, TestSource, string(model.ApplicationLabelableObject), TestTarget, string(model.RuntimeLabelableObject), TestWebhookID, string(model.AssignFormation), string(model.FormationAssignmentStateInProgress), nil}
+}
+
+func TestRepository_Create(t *testing.T) {
+	suite := testdb.RepoCreateTestSuite{
+		Name:       "Create Formation Assignment",
+		MethodName: "Create",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:       `^INSERT INTO public.formation_assignments \(.+\) VALUES \(.+\)$`,
+				Args:        fixRow(),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formationassignment.NewRepository,
+		ModelEntity:               faModel,
+		DBEntity:                  faEntity,
+		NilModelEntity:            nilFormationAssignmentModel,
+		IsGlobal:                  true,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_GetGlobalByID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Formation Assignment Globally by ID",
+		MethodName: "GetGlobalByID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_id, tenant_id, source, source_type, target, target_type, webhook_id, operation, state, error FROM public.formation_assignments WHERE id = $1`),
+				Args:     []driver.Value{TestID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formationassignment.NewRepository,
+		ExpectedModelEntity:       faModel,
+		ExpectedDBEntity:          faEntity,
+		MethodArgs:                []interface{}{TestID},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_GetBySourceAndTarget(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name:       "Get Formation Assignment by Source and Target",
+		MethodName: "GetBySourceAndTarget",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_id, tenant_id, source, source_type, target, target_type, webhook_id, operation, state, error FROM public.formation_assignments WHERE tenant_id = $1 AND formation_id = $2 AND source = $3 AND target = $4`),
+				Args:     []driver.Value{TestTenantID, TestFormationID, TestSource, TestTarget},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formationassignment.NewRepository,
+		ExpectedModelEntity:       faModel,
+		ExpectedDBEntity:          faEntity,
+		MethodArgs:                []interface{}{TestTenantID, TestFormationID, TestSource, TestTarget},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_ListByFormationIDAndState(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name:       "List Formation Assignments by Formation ID and State",
+		MethodName: "ListByFormationIDAndState",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, formation_id, tenant_id, source, source_type, target, target_type, webhook_id, operation, state, error FROM public.formation_assignments WHERE tenant_id = $1 AND formation_id = $2 AND state = $3`),
+				Args:     []driver.Value{TestTenantID, TestFormationID, string(model.FormationAssignmentStateInProgress)},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns()).AddRow(fixRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formationassignment.NewRepository,
+		ExpectedModelEntities:     []interface{}{faModel},
+		ExpectedDBEntities:        []interface{}{faEntity},
+		MethodArgs:                []interface{}{TestTenantID, TestFormationID, model.FormationAssignmentStateInProgress},
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_Update(t *testing.T) {
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update Formation Assignment by ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.formation_assignments SET webhook_id = ?, operation = ?, state = ?, error = ? WHERE id = ? AND tenant_id = ?`),
+				Args:          []driver.Value{TestWebhookID, string(model.AssignFormation), string(model.FormationAssignmentStateInProgress), nil, TestID, TestTenantID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       formationassignment.NewRepository,
+		ModelEntity:               faModel,
+		DBEntity:                  faEntity,
+		NilModelEntity:            nilFormationAssignmentModel,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestRepository_DeleteGlobal(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Delete Formation Assignment Globally by ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.formation_assignments WHERE id = $1`),
+				Args:          []driver.Value{TestID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		RepoConstructorFunc: formationassignment.NewRepository,
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		IsGlobal:   true,
+		MethodName: "DeleteGlobal",
+		MethodArgs: []interface{}{TestID},
+	}
+
+	suite.Run(t)
+}
@@ -0,0 +1,473 @@
+package formationassignment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/header"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/pkg/errors"
+)
+
+// OperationCategoryFormationAssignmentNotification is the category of the operations which notify formation participants about (un)assignments
+const OperationCategoryFormationAssignmentNotification = "formationAssignmentNotification"
+
+// Service represents the FormationAssignment service layer
+type Service interface {
+	NotifyParticipants(ctx context.Context, tnt string, formation *model.Formation, objectID string, objectType model.LabelableObject, formationOperation model.FormationOperation) error
+	Resynchronize(ctx context.Context, tnt string, formation *model.Formation) error
+	SetState(ctx context.Context, id string, errorMsg *string) error
+	DeleteGlobal(ctx context.Context, id string) error
+}
+
+// FormationAssignmentRepository represents the FormationAssignment repository layer
+//go:generate mockery --name=FormationAssignmentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentRepository interface {
+	Create(ctx context.Context, item *model.FormationAssignment) error
+	GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error)
+	GetBySourceAndTarget(ctx context.Context, tenantID, formationID, source, target string) (*model.FormationAssignment, error)
+	ListByFormationIDAndState(ctx context.Context, tenantID, formationID string, state model.FormationAssignmentState) ([]*model.FormationAssignment, error)
+	Update(ctx context.Context, item *model.FormationAssignment) error
+	DeleteGlobal(ctx context.Context, id string) error
+}
+
+//go:generate mockery --exported --name=applicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type applicationRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Application, error)
+	ListAllByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Application, error)
+}
+
+//go:generate mockery --exported --name=runtimeRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type runtimeRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
+	ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.Runtime, error)
+}
+
+//go:generate mockery --exported --name=runtimeContextRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type runtimeContextRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.RuntimeContext, error)
+	ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.RuntimeContext, error)
+}
+
+//go:generate mockery --exported --name=labelRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type labelRepository interface {
+	ListForObjectTypeByScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string) ([]*model.Label, error)
+}
+
+//go:generate mockery --exported --name=webhookRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type webhookRepository interface {
+	ListByReferenceObjectID(ctx context.Context, tenant, objID string, objType model.WebhookReferenceObjectType) ([]*model.Webhook, error)
+}
+
+//go:generate mockery --exported --name=uidService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type uidService interface {
+	Generate() string
+}
+
+type operationError struct {
+	Error string `json:"error"`
+}
+
+// participant is a formation participant along with the object which owns its webhooks
+type participant struct {
+	webhook.FormationParticipant
+	webhookOwnerID   string
+	webhookOwnerType model.WebhookReferenceObjectType
+}
+
+type service struct {
+	repo               FormationAssignmentRepository
+	applicationRepo    applicationRepository
+	runtimeRepo        runtimeRepository
+	runtimeContextRepo runtimeContextRepository
+	labelRepo          labelRepository
+	webhookRepo        webhookRepository
+	uidService         uidService
+	scheduler          operation.Scheduler
+}
+
+// NewService creates a FormationAssignment service
+func NewService(repo FormationAssignmentRepository, applicationRepo applicationRepository, runtimeRepo runtimeRepository, runtimeContextRepo runtimeContextRepository, labelRepo labelRepository, webhookRepo webhookRepository, uidService uidService, scheduler operation.Scheduler) *service {
+	return &service{
+		repo:               repo,
+		applicationRepo:    applicationRepo,
+		runtimeRepo:        runtimeRepo,
+		runtimeContextRepo: runtimeContextRepo,
+		labelRepo:          labelRepo,
+		webhookRepo:        webhookRepo,
+		uidService:         uidService,
+		scheduler:          scheduler,
+	}
+}
+
+// NotifyParticipants schedules FORMATION_ASSIGNMENT_NOTIFICATION webhooks for the (un)assignment of the object with the given ID to the given formation.
+// Applications are notified about runtimes and runtime contexts and vice versa. The participants of a formation which own a notification webhook
+// are notified about the (un)assigned object and the (un)assigned object is notified about each of them if it owns a notification webhook.
+// Scheduling failures do not fail the (un)assignment - they are persisted in the FormationAssignment and can be retried with Resynchronize.
+func (s *service) NotifyParticipants(ctx context.Context, tnt string, formation *model.Formation, objectID string, objectType model.LabelableObject, formationOperation model.FormationOperation) error {
+	source, err := s.getParticipant(ctx, tnt, objectID, objectType)
+	if err != nil {
+		return err
+	}
+
+	counterparts, err := s.listCounterparts(ctx, tnt, formation.Name, objectType)
+	if err != nil {
+		return err
+	}
+
+	if len(counterparts) == 0 {
+		return nil
+	}
+
+	webhookIDs := make(map[string]string)
+	sourceWebhookID, err := s.getNotificationWebhookID(ctx, tnt, source, webhookIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, counterpart := range counterparts {
+		if sourceWebhookID != "" {
+			if err := s.notify(ctx, tnt, formation, formationOperation, counterpart, source, sourceWebhookID); err != nil {
+				return err
+			}
+		}
+
+		counterpartWebhookID, err := s.getNotificationWebhookID(ctx, tnt, counterpart, webhookIDs)
+		if err != nil {
+			return err
+		}
+
+		if counterpartWebhookID != "" {
+			if err := s.notify(ctx, tnt, formation, formationOperation, source, counterpart, counterpartWebhookID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Resynchronize schedules again the notifications of the given formation which have failed
+func (s *service) Resynchronize(ctx context.Context, tnt string, formation *model.Formation) error {
+	assignments, err := s.repo.ListByFormationIDAndState(ctx, tnt, formation.ID, model.FormationAssignmentStateFailed)
+	if err != nil {
+		return errors.Wrapf(err, "while listing failed formation assignments for formation with name %q", formation.Name)
+	}
+
+	for _, assignment := range assignments {
+		source, err := s.getParticipantForResync(ctx, tnt, assignment.Source, assignment.SourceType)
+		if err != nil {
+			return err
+		}
+
+		target, err := s.getParticipantForResync(ctx, tnt, assignment.Target, assignment.TargetType)
+		if err != nil {
+			return err
+		}
+
+		assignment.State = model.FormationAssignmentStateInProgress
+		assignment.Error = nil
+		if err := s.repo.Update(ctx, assignment); err != nil {
+			return errors.Wrapf(err, "while updating formation assignment with ID %q", assignment.ID)
+		}
+
+		if err := s.schedule(ctx, tnt, formation, assignment, source, target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetState updates the state of the FormationAssignment with the given ID based on the result of its notification
+func (s *service) SetState(ctx context.Context, id string, errorMsg *string) error {
+	assignment, err := s.repo.GetGlobalByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	assignment.State = model.FormationAssignmentStateReady
+	assignment.Error = nil
+	if errorMsg != nil && *errorMsg != "" {
+		assignment.State = model.FormationAssignmentStateFailed
+		assignment.Error = errorMsg
+	}
+
+	return s.repo.Update(ctx, assignment)
+}
+
+// DeleteGlobal deletes the FormationAssignment with the given ID
+func (s *service) DeleteGlobal(ctx context.Context, id string) error {
+	return s.repo.DeleteGlobal(ctx, id)
+}
+
+func (s *service) notify(ctx context.Context, tnt string, formation *model.Formation, formationOperation model.FormationOperation, source, target participant, webhookID string) error {
+	assignment, err := s.repo.GetBySourceAndTarget(ctx, tnt, formation.ID, source.ID, target.ID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting formation assignment for source %q and target %q", source.ID, target.ID)
+	}
+
+	exists := err == nil
+	if !exists {
+		assignment = &model.FormationAssignment{
+			ID:          s.uidService.Generate(),
+			FormationID: formation.ID,
+			TenantID:    tnt,
+			Source:      source.ID,
+			SourceType:  model.LabelableObject(source.Type),
+			Target:      target.ID,
+			TargetType:  model.LabelableObject(target.Type),
+		}
+	}
+	assignment.WebhookID = webhookID
+	assignment.Operation = formationOperation
+	assignment.State = model.FormationAssignmentStateInProgress
+	assignment.Error = nil
+
+	if exists {
+		err = s.repo.Update(ctx, assignment)
+	} else {
+		err = s.repo.Create(ctx, assignment)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while persisting formation assignment for source %q and target %q", source.ID, target.ID)
+	}
+
+	return s.schedule(ctx, tnt, formation, assignment, source, target)
+}
+
+// schedule schedules the notification of the given assignment, which must already be persisted, and marks the assignment as failed if the scheduling fails
+func (s *service) schedule(ctx context.Context, tnt string, formation *model.Formation, assignment *model.FormationAssignment, source, target participant) error {
+	requestObject, err := json.Marshal(&webhook.FormationAssignmentRequestObject{
+		Operation:     string(assignment.Operation),
+		FormationID:   formation.ID,
+		FormationName: formation.Name,
+		Source:        source.FormationParticipant,
+		Target:        target.FormationParticipant,
+		TenantID:      tnt,
+		Headers:       requestHeaders(ctx),
+	})
+	if err != nil {
+		return errors.Wrap(err, "while marshalling formation assignment request object")
+	}
+
+	operationType := operation.OperationTypeCreate
+	if assignment.Operation == model.UnassignFormation {
+		operationType = operation.OperationTypeDelete
+	}
+
+	correlationID, _ := log.C(ctx).Data[log.FieldRequestID].(string)
+	op := &operation.Operation{
+		OperationType:     operationType,
+		OperationCategory: OperationCategoryFormationAssignmentNotification,
+		ResourceID:        assignment.ID,
+		ResourceType:      resource.FormationAssignment,
+		CorrelationID:     correlationID,
+		WebhookIDs:        []string{assignment.WebhookID},
+		RequestObject:     string(requestObject),
+	}
+
+	if _, scheduleErr := s.scheduler.Schedule(ctx, op); scheduleErr != nil {
+		log.C(ctx).WithError(scheduleErr).Errorf("An error occurred while scheduling notification for formation assignment with ID %q: %v", assignment.ID, scheduleErr)
+
+		errorMsg, err := json.Marshal(operationError{Error: scheduleErr.Error()})
+		if err != nil {
+			return errors.Wrap(err, "while marshalling scheduling error")
+		}
+		stringifiedErr := string(errorMsg)
+
+		assignment.State = model.FormationAssignmentStateFailed
+		assignment.Error = &stringifiedErr
+
+		return errors.Wrapf(s.repo.Update(ctx, assignment), "while updating formation assignment with ID %q", assignment.ID)
+	}
+
+	return nil
+}
+
+// listCounterparts returns the participants of the formation which should be notified about the (un)assignment of an object with the given type
+func (s *service) listCounterparts(ctx context.Context, tnt, formationName string, objectType model.LabelableObject) ([]participant, error) {
+	if objectType != model.ApplicationLabelableObject {
+		ids, err := s.listParticipantIDs(ctx, tnt, formationName, model.ApplicationLabelableObject)
+		if err != nil || len(ids) == 0 {
+			return nil, err
+		}
+
+		apps, err := s.applicationRepo.ListAllByIDs(ctx, tnt, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing applications in formation %q", formationName)
+		}
+
+		participants := make([]participant, 0, len(apps))
+		for _, app := range apps {
+			participants = append(participants, applicationParticipant(app))
+		}
+
+		return participants, nil
+	}
+
+	participants := make([]participant, 0)
+
+	runtimeIDs, err := s.listParticipantIDs(ctx, tnt, formationName, model.RuntimeLabelableObject)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runtimeIDs) > 0 {
+		runtimes, err := s.runtimeRepo.ListAllByIDs(ctx, tnt, runtimeIDs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing runtimes in formation %q", formationName)
+		}
+
+		for _, rt := range runtimes {
+			participants = append(participants, runtimeParticipant(rt))
+		}
+	}
+
+	runtimeContextIDs, err := s.listParticipantIDs(ctx, tnt, formationName, model.RuntimeContextLabelableObject)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runtimeContextIDs) > 0 {
+		runtimeContexts, err := s.runtimeContextRepo.ListAllByIDs(ctx, tnt, runtimeContextIDs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing runtime contexts in formation %q", formationName)
+		}
+
+		for _, rtCtx := range runtimeContexts {
+			participants = append(participants, runtimeContextParticipant(rtCtx))
+		}
+	}
+
+	return participants, nil
+}
+
+func (s *service) listParticipantIDs(ctx context.Context, tnt, formationName string, objectType model.LabelableObject) ([]string, error) {
+	labels, err := s.labelRepo.ListForObjectTypeByScenarios(ctx, tnt, objectType, []string{formationName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing scenario labels of type %q for formation %q", objectType, formationName)
+	}
+
+	ids := make([]string, 0, len(labels))
+	for _, l := range labels {
+		ids = append(ids, l.ObjectID)
+	}
+
+	return ids, nil
+}
+
+func (s *service) getParticipant(ctx context.Context, tnt, objectID string, objectType model.LabelableObject) (participant, error) {
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		app, err := s.applicationRepo.GetByID(ctx, tnt, objectID)
+		if err != nil {
+			return participant{}, errors.Wrapf(err, "while getting application with ID %q", objectID)
+		}
+		return applicationParticipant(app), nil
+	case model.RuntimeLabelableObject:
+		rt, err := s.runtimeRepo.GetByID(ctx, tnt, objectID)
+		if err != nil {
+			return participant{}, errors.Wrapf(err, "while getting runtime with ID %q", objectID)
+		}
+		return runtimeParticipant(rt), nil
+	case model.RuntimeContextLabelableObject:
+		rtCtx, err := s.runtimeContextRepo.GetByID(ctx, tnt, objectID)
+		if err != nil {
+			return participant{}, errors.Wrapf(err, "while getting runtime context with ID %q", objectID)
+		}
+		return runtimeContextParticipant(rtCtx), nil
+	default:
+		return participant{}, apperrors.NewInternalError("unknown formation participant type %q", objectType)
+	}
+}
+
+// getParticipantForResync returns the participant with the given ID. Participants which no longer exist are still notified only with their ID and type.
+func (s *service) getParticipantForResync(ctx context.Context, tnt, objectID string, objectType model.LabelableObject) (participant, error) {
+	p, err := s.getParticipant(ctx, tnt, objectID, objectType)
+	if err != nil {
+		if apperrors.IsNotFoundError(errors.Cause(err)) {
+			return participant{FormationParticipant: webhook.FormationParticipant{ID: objectID, Type: string(objectType)}}, nil
+		}
+		return participant{}, err
+	}
+
+	return p, nil
+}
+
+// getNotificationWebhookID returns the ID of the FORMATION_ASSIGNMENT_NOTIFICATION webhook of the given participant or an empty string if it has none.
+// Already fetched webhook IDs are cached by their owner ID as runtime contexts share the webhooks of their runtime.
+func (s *service) getNotificationWebhookID(ctx context.Context, tnt string, p participant, cache map[string]string) (string, error) {
+	if webhookID, ok := cache[p.webhookOwnerID]; ok {
+		return webhookID, nil
+	}
+
+	webhooks, err := s.webhookRepo.ListByReferenceObjectID(ctx, tnt, p.webhookOwnerID, p.webhookOwnerType)
+	if err != nil {
+		return "", errors.Wrapf(err, "while listing webhooks for %q with ID %q", p.webhookOwnerType, p.webhookOwnerID)
+	}
+
+	webhookIDs := make([]string, 0)
+	for _, wh := range webhooks {
+		if wh.Type == model.WebhookTypeFormationAssignmentNotification {
+			webhookIDs = append(webhookIDs, wh.ID)
+		}
+	}
+
+	if len(webhookIDs) > 1 {
+		return "", apperrors.NewInvalidDataError("multiple formation assignment notification webhooks per object are not supported")
+	}
+
+	webhookID := ""
+	if len(webhookIDs) == 1 {
+		webhookID = webhookIDs[0]
+	}
+	cache[p.webhookOwnerID] = webhookID
+
+	return webhookID, nil
+}
+
+func applicationParticipant(app *model.Application) participant {
+	return participant{
+		FormationParticipant: webhook.FormationParticipant{ID: app.ID, Name: app.Name, Type: string(model.ApplicationLabelableObject)},
+		webhookOwnerID:       app.ID,
+		webhookOwnerType:     model.ApplicationWebhookReference,
+	}
+}
+
+func runtimeParticipant(rt *model.Runtime) participant {
+	return participant{
+		FormationParticipant: webhook.FormationParticipant{ID: rt.ID, Name: rt.Name, Type: string(model.RuntimeLabelableObject)},
+		webhookOwnerID:       rt.ID,
+		webhookOwnerType:     model.RuntimeWebhookReference,
+	}
+}
+
+// runtimeContextParticipant uses the value of the runtime context as its name and the webhooks of its runtime
+func runtimeContextParticipant(rtCtx *model.RuntimeContext) participant {
+	return participant{
+		FormationParticipant: webhook.FormationParticipant{ID: rtCtx.ID, Name: rtCtx.Value, Type: string(model.RuntimeContextLabelableObject)},
+		webhookOwnerID:       rtCtx.RuntimeID,
+		webhookOwnerType:     model.RuntimeWebhookReference,
+	}
+}
+
+func requestHeaders(ctx context.Context) map[string]string {
+	headers := make(map[string]string)
+	reqHeaders, ok := ctx.Value(header.ContextKey).(http.Header)
+	if !ok {
+		return headers
+	}
+
+	for key, value := range reqHeaders {
+		headers[key] = value[0]
+	}
+
+	return headers
+}
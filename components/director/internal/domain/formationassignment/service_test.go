@@ -0,0 +1,525 @@
+package formationassignment_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	operationautomock "github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	runtimeContextID = "f3a6c8a8-3f4a-4b59-9b1f-4b0f1f9e2c11"
+	appWebhookID     = "0c8a4c5f-8e9c-4e7b-a9c1-1c2d2f3e4a5b"
+)
+
+func TestService_NotifyParticipants(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	formation := fixFormation()
+
+	app := &model.Application{Name: "app", BaseEntity: &model.BaseEntity{ID: TestSource}}
+	rt := &model.Runtime{ID: TestRuntimeID, Name: "runtime"}
+	rtCtx := &model.RuntimeContext{ID: runtimeContextID, RuntimeID: TestRuntimeID, Key: "key", Value: "value"}
+
+	runtimeLabels := []*model.Label{{ObjectID: TestRuntimeID}}
+	runtimeContextLabels := []*model.Label{{ObjectID: runtimeContextID}}
+	applicationLabels := []*model.Label{{ObjectID: TestSource}}
+
+	notificationWebhook := func(id string) *model.Webhook {
+		return &model.Webhook{ID: id, Type: model.WebhookTypeFormationAssignmentNotification}
+	}
+	otherWebhook := &model.Webhook{ID: "other", Type: model.WebhookTypeConfigurationChanged}
+
+	scheduledOperation := func(operationType operation.OperationType, target string, webhookID string) interface{} {
+		return mock.MatchedBy(func(op *operation.Operation) bool {
+			var requestObject webhook.FormationAssignmentRequestObject
+			if err := json.Unmarshal([]byte(op.RequestObject), &requestObject); err != nil {
+				return false
+			}
+			return op.OperationType == operationType && op.ResourceType == resource.FormationAssignment &&
+				op.OperationCategory == formationassignment.OperationCategoryFormationAssignmentNotification &&
+				len(op.WebhookIDs) == 1 && op.WebhookIDs[0] == webhookID &&
+				requestObject.Target.ID == target && requestObject.FormationName == formation.Name
+		})
+	}
+
+	testCases := []struct {
+		Name                 string
+		ObjectID             string
+		ObjectType           model.LabelableObject
+		Operation            model.FormationOperation
+		RepoFn               func() *automock.FormationAssignmentRepository
+		ApplicationRepoFn    func() *automock.ApplicationRepository
+		RuntimeRepoFn        func() *automock.RuntimeRepository
+		RuntimeContextRepoFn func() *automock.RuntimeContextRepository
+		LabelRepoFn          func() *automock.LabelRepository
+		WebhookRepoFn        func() *automock.WebhookRepository
+		UIDServiceFn         func() *automock.UidService
+		SchedulerFn          func() *operationautomock.Scheduler
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:       "success for application notifies the runtime of the runtimes and runtime contexts in the formation",
+			ObjectID:   TestSource,
+			ObjectType: model.ApplicationLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetBySourceAndTarget", ctx, TestTenantID, TestFormationID, TestSource, TestRuntimeID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, "")).Once()
+				repo.On("GetBySourceAndTarget", ctx, TestTenantID, TestFormationID, TestSource, runtimeContextID).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, "")).Once()
+				repo.On("Create", ctx, mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+					return fa.Source == TestSource && fa.Target == TestRuntimeID && fa.TargetType == model.RuntimeLabelableObject && fa.WebhookID == TestWebhookID &&
+						fa.Operation == model.AssignFormation && fa.State == model.FormationAssignmentStateInProgress && fa.Error == nil
+				})).Return(nil).Once()
+				repo.On("Create", ctx, mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+					return fa.Source == TestSource && fa.Target == runtimeContextID && fa.TargetType == model.RuntimeContextLabelableObject && fa.WebhookID == TestWebhookID &&
+						fa.State == model.FormationAssignmentStateInProgress
+				})).Return(nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestSource).Return(app, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("ListAllByIDs", ctx, TestTenantID, []string{TestRuntimeID}).Return([]*model.Runtime{rt}, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("ListAllByIDs", ctx, TestTenantID, []string{runtimeContextID}).Return([]*model.RuntimeContext{rtCtx}, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.RuntimeLabelableObject, []string{formation.Name}).Return(runtimeLabels, nil).Once()
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.RuntimeContextLabelableObject, []string{formation.Name}).Return(runtimeContextLabels, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestSource, model.ApplicationWebhookReference).Return([]*model.Webhook{otherWebhook}, nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestRuntimeID, model.RuntimeWebhookReference).Return([]*model.Webhook{otherWebhook, notificationWebhook(TestWebhookID)}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UidService {
+				svc := &automock.UidService{}
+				svc.On("Generate").Return(TestID).Twice()
+				return svc
+			},
+			SchedulerFn: func() *operationautomock.Scheduler {
+				scheduler := &operationautomock.Scheduler{}
+				scheduler.On("Schedule", ctx, scheduledOperation(operation.OperationTypeCreate, TestRuntimeID, TestWebhookID)).Return("op-1", nil).Once()
+				scheduler.On("Schedule", ctx, scheduledOperation(operation.OperationTypeCreate, runtimeContextID, TestWebhookID)).Return("op-2", nil).Once()
+				return scheduler
+			},
+		},
+		{
+			Name:       "success for runtime updates existing assignment and persists scheduling failures",
+			ObjectID:   TestRuntimeID,
+			ObjectType: model.RuntimeLabelableObject,
+			Operation:  model.UnassignFormation,
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetBySourceAndTarget", ctx, TestTenantID, TestFormationID, TestRuntimeID, TestSource).Return(&model.FormationAssignment{ID: TestID, Source: TestRuntimeID, Target: TestSource, Operation: model.AssignFormation, State: model.FormationAssignmentStateReady}, nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+					return fa.ID == TestID && fa.WebhookID == appWebhookID && fa.Operation == model.UnassignFormation &&
+						fa.State == model.FormationAssignmentStateInProgress && fa.Error == nil
+				})).Return(nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+					return fa.ID == TestID && fa.WebhookID == appWebhookID && fa.Operation == model.UnassignFormation &&
+						fa.State == model.FormationAssignmentStateFailed && fa.Error != nil && *fa.Error == `{"error":"test error"}`
+				})).Return(nil).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, TestTenantID, []string{TestSource}).Return([]*model.Application{app}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestRuntimeID).Return(rt, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.ApplicationLabelableObject, []string{formation.Name}).Return(applicationLabels, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestRuntimeID, model.RuntimeWebhookReference).Return(nil, nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestSource, model.ApplicationWebhookReference).Return([]*model.Webhook{notificationWebhook(appWebhookID)}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: unusedUIDService,
+			SchedulerFn: func() *operationautomock.Scheduler {
+				scheduler := &operationautomock.Scheduler{}
+				scheduler.On("Schedule", ctx, scheduledOperation(operation.OperationTypeDelete, TestSource, appWebhookID)).Return("", testErr).Once()
+				return scheduler
+			},
+		},
+		{
+			Name:       "success when there are no counterparts in the formation",
+			ObjectID:   TestSource,
+			ObjectType: model.ApplicationLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn:     unusedRepo,
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestSource).Return(app, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn:        unusedRuntimeRepo,
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.RuntimeLabelableObject, []string{formation.Name}).Return(nil, nil).Once()
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.RuntimeContextLabelableObject, []string{formation.Name}).Return(nil, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: unusedWebhookRepo,
+			UIDServiceFn:  unusedUIDService,
+			SchedulerFn:   unusedScheduler,
+		},
+		{
+			Name:       "error when getting the assigned object fails",
+			ObjectID:   TestSource,
+			ObjectType: model.ApplicationLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn:     unusedRepo,
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestSource).Return(nil, testErr).Once()
+				return repo
+			},
+			RuntimeRepoFn:        unusedRuntimeRepo,
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn:          unusedLabelRepo,
+			WebhookRepoFn:        unusedWebhookRepo,
+			UIDServiceFn:         unusedUIDService,
+			SchedulerFn:          unusedScheduler,
+			ExpectedErrMessage:   testErr.Error(),
+		},
+		{
+			Name:       "error when listing the formation participants fails",
+			ObjectID:   TestRuntimeID,
+			ObjectType: model.RuntimeLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn:     unusedRepo,
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestRuntimeID).Return(rt, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.ApplicationLabelableObject, []string{formation.Name}).Return(nil, testErr).Once()
+				return repo
+			},
+			WebhookRepoFn:      unusedWebhookRepo,
+			UIDServiceFn:       unusedUIDService,
+			SchedulerFn:        unusedScheduler,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:       "error when the participant has multiple notification webhooks",
+			ObjectID:   TestRuntimeID,
+			ObjectType: model.RuntimeLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn:     unusedRepo,
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, TestTenantID, []string{TestSource}).Return([]*model.Application{app}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestRuntimeID).Return(rt, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.ApplicationLabelableObject, []string{formation.Name}).Return(applicationLabels, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestRuntimeID, model.RuntimeWebhookReference).Return([]*model.Webhook{notificationWebhook(TestWebhookID), notificationWebhook(appWebhookID)}, nil).Once()
+				return repo
+			},
+			UIDServiceFn:       unusedUIDService,
+			SchedulerFn:        unusedScheduler,
+			ExpectedErrMessage: "multiple formation assignment notification webhooks per object are not supported",
+		},
+		{
+			Name:       "error when persisting the assignment fails",
+			ObjectID:   TestRuntimeID,
+			ObjectType: model.RuntimeLabelableObject,
+			Operation:  model.AssignFormation,
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetBySourceAndTarget", ctx, TestTenantID, TestFormationID, TestRuntimeID, TestSource).Return(nil, apperrors.NewNotFoundError(resource.FormationAssignment, "")).Once()
+				repo.On("Create", ctx, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			ApplicationRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByIDs", ctx, TestTenantID, []string{TestSource}).Return([]*model.Application{app}, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("GetByID", ctx, TestTenantID, TestRuntimeID).Return(rt, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: unusedRuntimeContextRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectTypeByScenarios", ctx, TestTenantID, model.ApplicationLabelableObject, []string{formation.Name}).Return(applicationLabels, nil).Once()
+				return repo
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestRuntimeID, model.RuntimeWebhookReference).Return(nil, nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, TestTenantID, TestSource, model.ApplicationWebhookReference).Return([]*model.Webhook{notificationWebhook(appWebhookID)}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UidService {
+				svc := &automock.UidService{}
+				svc.On("Generate").Return(TestID).Once()
+				return svc
+			},
+			SchedulerFn:        unusedScheduler,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepoFn()
+			appRepo := testCase.ApplicationRepoFn()
+			runtimeRepo := testCase.RuntimeRepoFn()
+			runtimeContextRepo := testCase.RuntimeContextRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+			scheduler := testCase.SchedulerFn()
+
+			svc := formationassignment.NewService(repo, appRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, uidSvc, scheduler)
+
+			// WHEN
+			err := svc.NotifyParticipants(ctx, TestTenantID, formation, testCase.ObjectID, testCase.ObjectType, testCase.Operation)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, appRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, uidSvc, scheduler)
+		})
+	}
+}
+
+func TestService_Resynchronize(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	formation := fixFormation()
+	rt := &model.Runtime{ID: TestTarget, Name: "runtime"}
+
+	t.Run("success reschedules failed assignments", func(t *testing.T) {
+		// GIVEN
+		failedAssignment := fixFormationAssignmentModel(model.FormationAssignmentStateFailed, &testErrMsg)
+
+		repo := &automock.FormationAssignmentRepository{}
+		repo.On("ListByFormationIDAndState", ctx, TestTenantID, TestFormationID, model.FormationAssignmentStateFailed).Return([]*model.FormationAssignment{failedAssignment}, nil).Once()
+		repo.On("Update", ctx, mock.MatchedBy(func(fa *model.FormationAssignment) bool {
+			return fa.ID == TestID && fa.State == model.FormationAssignmentStateInProgress && fa.Error == nil
+		})).Return(nil).Once()
+
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetByID", ctx, TestTenantID, TestSource).Return(nil, apperrors.NewNotFoundError(resource.Application, TestSource)).Once()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("GetByID", ctx, TestTenantID, TestTarget).Return(rt, nil).Once()
+
+		scheduler := &operationautomock.Scheduler{}
+		scheduler.On("Schedule", ctx, mock.MatchedBy(func(op *operation.Operation) bool {
+			var requestObject webhook.FormationAssignmentRequestObject
+			if err := json.Unmarshal([]byte(op.RequestObject), &requestObject); err != nil {
+				return false
+			}
+			return op.ResourceID == TestID && op.WebhookIDs[0] == TestWebhookID && requestObject.Source.ID == TestSource && requestObject.Target.Name == rt.Name
+		})).Return("op", nil).Once()
+
+		svc := formationassignment.NewService(repo, appRepo, runtimeRepo, nil, nil, nil, nil, scheduler)
+
+		// WHEN
+		err := svc.Resynchronize(ctx, TestTenantID, formation)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, repo, appRepo, runtimeRepo, scheduler)
+	})
+	t.Run("error when listing failed assignments fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.FormationAssignmentRepository{}
+		repo.On("ListByFormationIDAndState", ctx, TestTenantID, TestFormationID, model.FormationAssignmentStateFailed).Return(nil, testErr).Once()
+
+		svc := formationassignment.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		err := svc.Resynchronize(ctx, TestTenantID, formation)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+	t.Run("error when getting participant fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.FormationAssignmentRepository{}
+		repo.On("ListByFormationIDAndState", ctx, TestTenantID, TestFormationID, model.FormationAssignmentStateFailed).Return([]*model.FormationAssignment{fixFormationAssignmentModel(model.FormationAssignmentStateFailed, &testErrMsg)}, nil).Once()
+
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetByID", ctx, TestTenantID, TestSource).Return(nil, testErr).Once()
+
+		svc := formationassignment.NewService(repo, appRepo, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		err := svc.Resynchronize(ctx, TestTenantID, formation)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, repo, appRepo)
+	})
+}
+
+func TestService_SetState(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name               string
+		ErrorMsg           *string
+		RepoFn             func() *automock.FormationAssignmentRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "success without error sets READY state",
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", ctx, TestID).Return(fixFormationAssignmentModel(model.FormationAssignmentStateInProgress, nil), nil).Once()
+				repo.On("Update", ctx, fixFormationAssignmentModel(model.FormationAssignmentStateReady, nil)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:     "success with error sets FAILED state",
+			ErrorMsg: &testErrMsg,
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", ctx, TestID).Return(fixFormationAssignmentModel(model.FormationAssignmentStateInProgress, nil), nil).Once()
+				repo.On("Update", ctx, fixFormationAssignmentModel(model.FormationAssignmentStateFailed, &testErrMsg)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "error when getting assignment fails",
+			RepoFn: func() *automock.FormationAssignmentRepository {
+				repo := &automock.FormationAssignmentRepository{}
+				repo.On("GetGlobalByID", ctx, TestID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepoFn()
+			svc := formationassignment.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
+
+			// WHEN
+			err := svc.SetState(ctx, TestID, testCase.ErrorMsg)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_DeleteGlobal(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	repo := &automock.FormationAssignmentRepository{}
+	repo.On("DeleteGlobal", ctx, TestID).Return(testErr).Once()
+	svc := formationassignment.NewService(repo, nil, nil, nil, nil, nil, nil, nil)
+
+	err := svc.DeleteGlobal(ctx, TestID)
+
+	assert.Equal(t, testErr, err)
+	mock.AssertExpectationsForObjects(t, repo)
+}
+
+func unusedRepo() *automock.FormationAssignmentRepository {
+	return &automock.FormationAssignmentRepository{}
+}
+
+func unusedRuntimeRepo() *automock.RuntimeRepository {
+	return &automock.RuntimeRepository{}
+}
+
+func unusedRuntimeContextRepo() *automock.RuntimeContextRepository {
+	return &automock.RuntimeContextRepository{}
+}
+
+func unusedLabelRepo() *automock.LabelRepository {
+	return &automock.LabelRepository{}
+}
+
+func unusedWebhookRepo() *automock.WebhookRepository {
+	return &automock.WebhookRepository{}
+}
+
+func unusedUIDService() *automock.UidService {
+	return &automock.UidService{}
+}
+
+func unusedScheduler() *operationautomock.Scheduler {
+	return &operationautomock.Scheduler{}
+}
@@ -100,7 +100,7 @@ func (r *repository) GetByName(ctx context.Context, templateName string) (*model
 // List queries for all FormationTemplate sorted by ID and paginated by the pageSize and cursor parameters
 func (r *repository) List(ctx context.Context, pageSize int, cursor string) (*model.FormationTemplatePage, error) {
 	var entityCollection EntityCollection
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, repo.NewAscOrderBy("id"), &entityCollection)
 	if err != nil {
 		return nil, err
 	}
@@ -66,7 +66,7 @@ func (r *pgRepository) Exists(ctx context.Context, id string) (bool, error) {
 // List missing godoc
 func (r *pgRepository) List(ctx context.Context, pageSize int, cursor string) (model.IntegrationSystemPage, error) {
 	var entityCollection Collection
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, repo.NewAscOrderBy("id"), &entityCollection)
 	if err != nil {
 		return model.IntegrationSystemPage{}, err
 	}
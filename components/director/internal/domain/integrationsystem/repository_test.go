@@ -189,7 +189,7 @@ func TestPgRepository_List(t *testing.T) {
 			{id: "id2", name: "name2", description: &testDescription},
 			{id: "id3", name: "name3", description: &testDescription},
 		})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description FROM public.integration_systems ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description FROM public.integration_systems ORDER BY id ASC LIMIT 4`)).
 			WillReturnRows(rowsToReturn)
 		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.integration_systems`)).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
@@ -212,7 +212,7 @@ func TestPgRepository_List(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description FROM public.integration_systems ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description FROM public.integration_systems ORDER BY id ASC LIMIT 4`)).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -54,3 +54,18 @@ func (e *Entity) DecorateWithTenantID(tenant string) interface{} {
 		TenantID: tenant,
 	}
 }
+
+// ScenarioEntity is a scenarios label entity together with one of the scenarios in its value.
+// A scenarios label with multiple scenarios results in one ScenarioEntity for each of them.
+type ScenarioEntity struct {
+	Entity
+	Scenario string `db:"scenario"`
+}
+
+// ScenarioCollection is a collection of scenarios label entities.
+type ScenarioCollection []ScenarioEntity
+
+// Len returns the number of entities in the collection.
+func (c ScenarioCollection) Len() int {
+	return len(c)
+}
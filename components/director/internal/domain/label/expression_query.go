@@ -0,0 +1,136 @@
+package label
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	expressionQueryFormat   = `SELECT "id" FROM %s WHERE %s`
+	numberValueExpression   = `(CASE WHEN jsonb_typeof("value") = 'number' THEN ("value" #>> '{}')::numeric END)`
+	stringValueExpression   = `(CASE WHEN jsonb_typeof("value") = 'string' THEN "value" #>> '{}' END)`
+	numberValuePlaceholder  = `?::numeric`
+	defaultValuePlaceholder = `?`
+)
+
+// buildExpressionQuery builds a query selecting the IDs of the objects matching the label filter expression.
+//
+// Every predicate is translated to a subquery over the labels, starting with the given statement prefix, so that the tenant isolation
+// of the labels is preserved. The logical operators are applied to the IDs of the labeled objects, so that NOT also matches objects without labels.
+// All keys and values are passed as query arguments.
+func buildExpressionQuery(queryFor model.LabelableObject, stmtPrefix string, stmtPrefixArgs []interface{}, expr *labelfilter.Expression) (string, []interface{}, error) {
+	objectTable := labelableObjectTable(queryFor)
+	if objectTable == "" {
+		return "", nil, errors.Errorf("label filter expressions are not supported for %s", queryFor)
+	}
+
+	b := &expressionQueryBuilder{stmtPrefix: stmtPrefix, stmtPrefixArgs: stmtPrefixArgs}
+	if err := b.writeExpression(expr); err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf(expressionQueryFormat, objectTable, b.String()), b.args, nil
+}
+
+type expressionQueryBuilder struct {
+	strings.Builder
+	stmtPrefix     string
+	stmtPrefixArgs []interface{}
+	args           []interface{}
+}
+
+func (b *expressionQueryBuilder) writeExpression(expr *labelfilter.Expression) error {
+	switch expr.Operator {
+	case labelfilter.OperatorAnd, labelfilter.OperatorOr:
+		b.WriteString("(")
+		for idx, operand := range expr.Operands {
+			if idx > 0 {
+				b.WriteString(fmt.Sprintf(" %s ", expr.Operator))
+			}
+			if err := b.writeExpression(operand); err != nil {
+				return err
+			}
+		}
+		b.WriteString(")")
+		return nil
+	case labelfilter.OperatorNot:
+		b.WriteString("NOT ")
+		return b.writeExpression(expr.Operands[0])
+	default:
+		return b.writePredicate(expr)
+	}
+}
+
+func (b *expressionQueryBuilder) writePredicate(expr *labelfilter.Expression) error {
+	b.WriteString(`"id" IN (`)
+	b.WriteString(b.stmtPrefix)
+	b.args = append(b.args, b.stmtPrefixArgs...)
+
+	b.WriteString(` AND "key" = ?`)
+	b.args = append(b.args, expr.Key)
+
+	switch expr.Operator {
+	case labelfilter.OperatorExists:
+	case labelfilter.OperatorEqual, labelfilter.OperatorNotEqual, labelfilter.OperatorIn:
+		if expr.Operator == labelfilter.OperatorNotEqual {
+			b.WriteString(` AND NOT`)
+		} else {
+			b.WriteString(` AND`)
+		}
+
+		conditions := make([]string, 0, len(expr.Values))
+		for _, value := range expr.Values {
+			jsonValue, err := json.Marshal(value)
+			if err != nil {
+				return errors.Wrapf(err, "while marshalling value of label %s", expr.Key)
+			}
+			conditions = append(conditions, `"value" @> ?`)
+			b.args = append(b.args, string(jsonValue))
+		}
+		b.WriteString(fmt.Sprintf(` (%s)`, strings.Join(conditions, " OR ")))
+	case labelfilter.OperatorLessThan, labelfilter.OperatorLessThanOrEqual, labelfilter.OperatorGreaterThan, labelfilter.OperatorGreaterThanOrEqual, labelfilter.OperatorMatches:
+		valueExpression, placeholder := stringValueExpression, defaultValuePlaceholder
+		switch value := expr.Values[0].(type) {
+		case json.Number:
+			valueExpression, placeholder = numberValueExpression, numberValuePlaceholder
+			b.args = append(b.args, value.String())
+		case string:
+			b.args = append(b.args, value)
+		default:
+			return errors.Errorf("unsupported value %v for operator %s", value, expr.Operator)
+		}
+
+		sqlOperator := string(expr.Operator)
+		if expr.Operator == labelfilter.OperatorMatches {
+			sqlOperator = "~"
+		}
+		b.WriteString(fmt.Sprintf(` AND %s %s %s`, valueExpression, sqlOperator, placeholder))
+	default:
+		return errors.Errorf("unsupported label filter operator %s", expr.Operator)
+	}
+
+	b.WriteString(")")
+	return nil
+}
+
+func labelableObjectTable(objectType model.LabelableObject) string {
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		return "public.applications"
+	case model.RuntimeLabelableObject:
+		return "public.runtimes"
+	case model.RuntimeContextLabelableObject:
+		return "public.runtime_contexts"
+	case model.TenantLabelableObject:
+		return "public.business_tenant_mappings"
+	case model.AppTemplateLabelableObject:
+		return "public.app_templates"
+	}
+
+	return ""
+}
@@ -57,7 +57,7 @@ func FilterQueryGlobal(queryFor model.LabelableObject, setCombination SetCombina
 
 	stmtPrefix := fmt.Sprintf(stmtPrefixGlobalFormat, objectField, tableName, objectField)
 
-	return buildFilterQuery(stmtPrefix, nil, setCombination, filters, false)
+	return buildFilterQuery(queryFor, stmtPrefix, nil, setCombination, filters, false)
 }
 
 func filterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
@@ -83,10 +83,10 @@ func filterQuery(queryFor model.LabelableObject, setCombination SetCombination,
 	var stmtPrefixArgs []interface{}
 	stmtPrefixArgs = append(stmtPrefixArgs, tenant)
 
-	return buildFilterQuery(stmtPrefix, stmtPrefixArgs, setCombination, filter, isSubQuery)
+	return buildFilterQuery(queryFor, stmtPrefix, stmtPrefixArgs, setCombination, filter, isSubQuery)
 }
 
-func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombination SetCombination, filters []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
+func buildFilterQuery(queryFor model.LabelableObject, stmtPrefix string, stmtPrefixArgs []interface{}, setCombination SetCombination, filters []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
 	var queryBuilder strings.Builder
 
 	args := make([]interface{}, 0, len(filters))
@@ -95,6 +95,16 @@ func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombin
 			queryBuilder.WriteString(fmt.Sprintf(` %s `, setCombination))
 		}
 
+		if lblFilter.Expression != nil {
+			expressionQuery, expressionArgs, err := buildExpressionQuery(queryFor, stmtPrefix, stmtPrefixArgs, lblFilter.Expression)
+			if err != nil {
+				return "", nil, errors.Wrap(err, "while building label filter expression query")
+			}
+			queryBuilder.WriteString(expressionQuery)
+			args = append(args, expressionArgs...)
+			continue
+		}
+
 		queryBuilder.WriteString(stmtPrefix)
 		if len(stmtPrefixArgs) > 0 {
 			args = append(args, stmtPrefixArgs...)
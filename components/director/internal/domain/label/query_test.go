@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_FilterQuery(t *testing.T) {
@@ -611,6 +612,86 @@ func TestFilterSubquery(t *testing.T) {
 	}
 }
 
+func TestFilterQuery_Expression(t *testing.T) {
+	tenantID := uuid.New()
+
+	stmtPrefix := `SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_labels_tenants WHERE tenant_id = ?))`
+	globalStmtPrefix := `SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL`
+	numberValue := `(CASE WHEN jsonb_typeof("value") = 'number' THEN ("value" #>> '{}')::numeric END)`
+	stringValue := `(CASE WHEN jsonb_typeof("value") = 'string' THEN "value" #>> '{}' END)`
+
+	testCases := []struct {
+		Name                string
+		Expression          string
+		Global              bool
+		ExpectedQueryFilter string
+		ExpectedArgs        []interface{}
+	}{
+		{
+			Name:                "Exists",
+			Expression:          `EXISTS(foo)`,
+			ExpectedQueryFilter: `SELECT "id" FROM public.runtimes WHERE "id" IN (` + stmtPrefix + ` AND "key" = ?)`,
+			ExpectedArgs:        []interface{}{tenantID, "foo"},
+		},
+		{
+			Name:       "In and not equal",
+			Expression: `runtimeType IN ("kyma", "cf") AND NOT region = "eu10"`,
+			ExpectedQueryFilter: `SELECT "id" FROM public.runtimes WHERE ("id" IN (` + stmtPrefix + ` AND "key" = ? AND ("value" @> ? OR "value" @> ?))` +
+				` AND NOT "id" IN (` + stmtPrefix + ` AND "key" = ? AND ("value" @> ?)))`,
+			ExpectedArgs: []interface{}{tenantID, "runtimeType", `"kyma"`, `"cf"`, tenantID, "region", `"eu10"`},
+		},
+		{
+			Name:       "Comparisons and regex",
+			Expression: `count > 5 OR name <= "m" OR name =~ "^a" OR flag != true`,
+			ExpectedQueryFilter: `SELECT "id" FROM public.runtimes WHERE (` +
+				`"id" IN (` + stmtPrefix + ` AND "key" = ? AND ` + numberValue + ` > ?::numeric)` +
+				` OR "id" IN (` + stmtPrefix + ` AND "key" = ? AND ` + stringValue + ` <= ?)` +
+				` OR "id" IN (` + stmtPrefix + ` AND "key" = ? AND ` + stringValue + ` ~ ?)` +
+				` OR "id" IN (` + stmtPrefix + ` AND "key" = ? AND NOT ("value" @> ?)))`,
+			ExpectedArgs: []interface{}{tenantID, "count", "5", tenantID, "name", "m", tenantID, "name", "^a", tenantID, "flag", "true"},
+		},
+		{
+			Name:                "Global",
+			Expression:          `foo = 1.5`,
+			Global:              true,
+			ExpectedQueryFilter: `SELECT "id" FROM public.runtimes WHERE "id" IN (` + globalStmtPrefix + ` AND "key" = ? AND ("value" @> ?))`,
+			ExpectedArgs:        []interface{}{"foo", "1.5"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			expr, err := labelfilter.ParseExpression(testCase.Expression)
+			require.NoError(t, err)
+			filters := []*labelfilter.LabelFilter{labelfilter.NewForExpression(expr)}
+
+			var queryFilter string
+			var args []interface{}
+			if testCase.Global {
+				queryFilter, args, err = label.FilterQueryGlobal(model.RuntimeLabelableObject, label.IntersectSet, filters)
+			} else {
+				queryFilter, args, err = label.FilterQuery(model.RuntimeLabelableObject, label.IntersectSet, tenantID, filters)
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedQueryFilter, removeWhitespace(queryFilter))
+			assert.Equal(t, testCase.ExpectedArgs, args)
+		})
+	}
+
+	t.Run("Combined with key filter", func(t *testing.T) {
+		expr, err := labelfilter.ParseExpression(`EXISTS(foo)`)
+		require.NoError(t, err)
+		filters := []*labelfilter.LabelFilter{labelfilter.NewForKey("bar"), labelfilter.NewForExpression(expr)}
+
+		queryFilter, args, err := label.FilterQuery(model.RuntimeLabelableObject, label.IntersectSet, tenantID, filters)
+
+		require.NoError(t, err)
+		assert.Equal(t, stmtPrefix+` AND "key" = ? INTERSECT SELECT "id" FROM public.runtimes WHERE "id" IN (`+stmtPrefix+` AND "key" = ?)`, removeWhitespace(queryFilter))
+		assert.Equal(t, []interface{}{tenantID, "bar", tenantID, "foo"}, args)
+	})
+}
+
 func removeWhitespace(s string) string {
 	return strings.Join(strings.Fields(s), " ")
 }
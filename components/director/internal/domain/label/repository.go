@@ -16,6 +16,10 @@ import (
 const (
 	tableName    string = "public.labels"
 	tenantColumn string = "tenant_id"
+
+	// scenarioLabelsTable expands each scenarios label into one row for each of the scenarios in its value, so that the labels can be listed per scenario
+	scenarioLabelsTable string = `(SELECT labels.*, scenarios.scenario FROM public.labels CROSS JOIN LATERAL jsonb_array_elements_text(labels.value) AS scenarios(scenario) WHERE labels.key = 'scenarios') AS scenario_labels`
+	scenarioColumn      string = "scenario"
 )
 
 var (
@@ -23,6 +27,7 @@ var (
 	updatableColumns   = []string{"value"}
 	idColumns          = []string{"id"}
 	versionedIDColumns = append(idColumns, "version")
+	scenarioColumns    = append(append([]string{}, tableColumns...), scenarioColumn)
 )
 
 // Converter missing godoc
@@ -44,6 +49,8 @@ type repository struct {
 	embeddedTenantDeleter repo.Deleter
 	embeddedTenantGetter  repo.SingleGetter
 
+	scenarioUnionLister repo.UnionLister
+
 	creator                        repo.Creator
 	globalCreator                  repo.CreatorGlobal
 	updater                        repo.Updater
@@ -68,6 +75,8 @@ func NewRepository(conv Converter) *repository {
 		embeddedTenantDeleter: repo.NewDeleterWithEmbeddedTenant(tableName, tenantColumn),
 		embeddedTenantGetter:  repo.NewSingleGetterWithEmbeddedTenant(tableName, tenantColumn, tableColumns),
 
+		scenarioUnionLister: repo.NewUnionLister(scenarioLabelsTable, scenarioColumns),
+
 		creator:                        repo.NewCreator(tableName, tableColumns),
 		globalCreator:                  repo.NewCreatorGlobal(resource.Label, tableName, tableColumns),
 		updater:                        repo.NewUpdater(tableName, updatableColumns, idColumns),
@@ -228,6 +237,63 @@ func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*mode
 	return r.multipleFromEntity(entities)
 }
 
+// ListForObjectTypeByScenarios lists the scenarios labels of objects of the given type which contain any of the given scenarios
+func (r *repository) ListForObjectTypeByScenarios(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string) ([]*model.Label, error) {
+	if len(scenarios) == 0 {
+		return []*model.Label{}, nil
+	}
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("key", model.ScenariosKey),
+		repo.NewNotNullCondition(labelableObjectField(objectType)),
+		repo.NewJSONArrMatchAnyStringCondition("value", scenarios...),
+	}
+
+	var entities Collection
+	if err := r.lister.List(ctx, objectType.GetResourceType(), tenant, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntity(entities)
+}
+
+// ListForObjectTypeByScenariosPages lists a page of the scenarios labels of objects of the given type for each of the given scenarios.
+// The labels of each scenario are ordered by the ID of their object.
+func (r *repository) ListForObjectTypeByScenariosPages(ctx context.Context, tenant string, objectType model.LabelableObject, scenarios []string, pageSize int, cursor string) ([]*model.LabelPage, error) {
+	if len(scenarios) == 0 {
+		return []*model.LabelPage{}, nil
+	}
+
+	objectField := labelableObjectField(objectType)
+	conditions := repo.Conditions{
+		repo.NewNotNullCondition(objectField),
+		repo.NewInConditionForStringValues(scenarioColumn, scenarios),
+	}
+	orderBy := repo.OrderByParams{repo.NewAscOrderBy(scenarioColumn), repo.NewAscOrderBy(objectField)}
+
+	var entities ScenarioCollection
+	counts, pages, err := r.scenarioUnionLister.List(ctx, objectType.GetResourceType(), tenant, scenarios, scenarioColumn, pageSize, cursor, orderBy, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	labelsByScenario := make(map[string][]*model.Label, len(scenarios))
+	for i := range entities {
+		m, err := r.conv.FromEntity(&entities[i].Entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Label entity to model")
+		}
+		labelsByScenario[entities[i].Scenario] = append(labelsByScenario[entities[i].Scenario], m)
+	}
+
+	labelPages := make([]*model.LabelPage, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		labelPages = append(labelPages, &model.LabelPage{Data: labelsByScenario[scenario], TotalCount: counts[scenario], PageInfo: pages[scenario]})
+	}
+
+	return labelPages, nil
+}
+
 // ListGlobalByKey lists all labels which are labeled with the provided key across tenants (global)
 func (r *repository) ListGlobalByKey(ctx context.Context, key string) ([]*model.Label, error) {
 	var entities Collection
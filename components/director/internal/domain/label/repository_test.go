@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/stretchr/testify/require"
 )
@@ -1108,6 +1109,148 @@ func TestRepository_ListByKey(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestRepository_ListForObjectTypeByScenarios(t *testing.T) {
+	label1Model := fixModelLabelWithID("1", model.ScenariosKey, model.ApplicationLabelableObject)
+	label2Model := fixModelLabelWithID("2", model.ScenariosKey, model.ApplicationLabelableObject)
+
+	label1Entity := fixEntityLabelWithID("1", model.ScenariosKey, model.ApplicationLabelableObject)
+	label2Entity := fixEntityLabelWithID("2", model.ScenariosKey, model.ApplicationLabelableObject)
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List scenarios labels of applications by scenarios",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, app_template_id, key, value, version FROM public.labels WHERE key = $1 AND app_id IS NOT NULL AND value ?| array[$2,$3] AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = $4))`),
+				Args:     []driver.Value{model.ScenariosKey, "scenario-1", "scenario-2", tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).
+						AddRow(label1Entity.ID, label1Entity.TenantID, label1Entity.AppID, label1Entity.RuntimeID, label1Entity.RuntimeContextID, label1Entity.AppTemplateID, label1Entity.Key, label1Entity.Value, label1Entity.Version).
+						AddRow(label2Entity.ID, label2Entity.TenantID, label2Entity.AppID, label2Entity.RuntimeID, label2Entity.RuntimeContextID, label2Entity.AppTemplateID, label2Entity.Key, label2Entity.Value, label2Entity.Version),
+					}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.Converter{}
+		},
+		RepoConstructorFunc:   label.NewRepository,
+		ExpectedModelEntities: []interface{}{label1Model, label2Model},
+		ExpectedDBEntities:    []interface{}{label1Entity, label2Entity},
+		MethodArgs:            []interface{}{tenantID, model.ApplicationLabelableObject, []string{"scenario-1", "scenario-2"}},
+		MethodName:            "ListForObjectTypeByScenarios",
+	}
+
+	suite.Run(t)
+
+	t.Run("Success without querying when there are no scenarios", func(t *testing.T) {
+		// GIVEN
+		labelRepo := label.NewRepository(nil)
+
+		// WHEN
+		labels, err := labelRepo.ListForObjectTypeByScenarios(context.TODO(), tenantID, model.ApplicationLabelableObject, nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, labels)
+	})
+}
+
+func TestRepository_ListForObjectTypeByScenariosPages(t *testing.T) {
+	pageSize := 1
+	cursor := ""
+	scenarios := []string{"scenario-1", "scenario-2"}
+
+	label1Model := fixModelLabelWithRefID("1", model.ScenariosKey, model.ApplicationLabelableObject, "app-1")
+	label2Model := fixModelLabelWithRefID("2", model.ScenariosKey, model.ApplicationLabelableObject, "app-2")
+
+	label1Entity := fixEntityLabelWithRefID("1", model.ScenariosKey, model.ApplicationLabelableObject, "app-1")
+	label2Entity := fixEntityLabelWithRefID("2", model.ScenariosKey, model.ApplicationLabelableObject, "app-2")
+
+	multiplePagesEndCursor, err := pagination.EncodeKeysetCursor("app-1")
+	require.NoError(t, err)
+
+	suite := testdb.RepoListPageableTestSuite{
+		Name: "List pages of scenarios labels of applications by scenarios",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query: regexp.QuoteMeta(`(SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, app_template_id, key, value, version, scenario FROM (SELECT labels.*, scenarios.scenario FROM public.labels CROSS JOIN LATERAL jsonb_array_elements_text(labels.value) AS scenarios(scenario) WHERE labels.key = 'scenarios') AS scenario_labels WHERE app_id IS NOT NULL AND scenario IN ($1, $2) AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = $3)) AND scenario = $4 ORDER BY scenario ASC, app_id ASC LIMIT $5 OFFSET $6)
+												UNION
+												(SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, app_template_id, key, value, version, scenario FROM (SELECT labels.*, scenarios.scenario FROM public.labels CROSS JOIN LATERAL jsonb_array_elements_text(labels.value) AS scenarios(scenario) WHERE labels.key = 'scenarios') AS scenario_labels WHERE app_id IS NOT NULL AND scenario IN ($7, $8) AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = $9)) AND scenario = $10 ORDER BY scenario ASC, app_id ASC LIMIT $11 OFFSET $12)
+												ORDER BY scenario ASC, app_id ASC`),
+				Args:     []driver.Value{"scenario-1", "scenario-2", tenantID, "scenario-1", pageSize + 1, 0, "scenario-1", "scenario-2", tenantID, "scenario-2", pageSize + 1, 0},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(append(fixColumns, "scenario")).
+						AddRow(label1Entity.ID, label1Entity.TenantID, label1Entity.AppID, label1Entity.RuntimeID, label1Entity.RuntimeContextID, label1Entity.AppTemplateID, label1Entity.Key, label1Entity.Value, label1Entity.Version, "scenario-1").
+						AddRow(label2Entity.ID, label2Entity.TenantID, label2Entity.AppID, label2Entity.RuntimeID, label2Entity.RuntimeContextID, label2Entity.AppTemplateID, label2Entity.Key, label2Entity.Value, label2Entity.Version, "scenario-1").
+						AddRow(label2Entity.ID, label2Entity.TenantID, label2Entity.AppID, label2Entity.RuntimeID, label2Entity.RuntimeContextID, label2Entity.AppTemplateID, label2Entity.Key, label2Entity.Value, label2Entity.Version, "scenario-2"),
+					}
+				},
+			},
+			{
+				Query:    regexp.QuoteMeta(`SELECT scenario AS id, COUNT(*) AS total_count FROM (SELECT labels.*, scenarios.scenario FROM public.labels CROSS JOIN LATERAL jsonb_array_elements_text(labels.value) AS scenarios(scenario) WHERE labels.key = 'scenarios') AS scenario_labels WHERE app_id IS NOT NULL AND scenario IN ($1, $2) AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = $3)) GROUP BY scenario ORDER BY scenario ASC`),
+				Args:     []driver.Value{"scenario-1", "scenario-2", tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows([]string{"id", "total_count"}).AddRow("scenario-1", 2).AddRow("scenario-2", 1)}
+				},
+			},
+		},
+		Pages: []testdb.PageDetails{
+			{
+				ExpectedModelEntities: []interface{}{label1Model},
+				ExpectedDBEntities:    []interface{}{label1Entity},
+				ExpectedPage: &model.LabelPage{
+					Data: []*model.Label{label1Model},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   multiplePagesEndCursor,
+						HasNextPage: true,
+					},
+					TotalCount: 2,
+				},
+			},
+			{
+				ExpectedModelEntities: []interface{}{label2Model},
+				ExpectedDBEntities:    []interface{}{label2Entity},
+				ExpectedPage: &model.LabelPage{
+					Data: []*model.Label{label2Model},
+					PageInfo: &pagination.Page{
+						StartCursor: "",
+						EndCursor:   "",
+						HasNextPage: false,
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.Converter{}
+		},
+		RepoConstructorFunc: label.NewRepository,
+		MethodArgs:          []interface{}{tenantID, model.ApplicationLabelableObject, scenarios, pageSize, cursor},
+		MethodName:          "ListForObjectTypeByScenariosPages",
+	}
+
+	suite.Run(t)
+
+	t.Run("Success without querying when there are no scenarios", func(t *testing.T) {
+		// GIVEN
+		labelRepo := label.NewRepository(nil)
+
+		// WHEN
+		labelPages, err := labelRepo.ListForObjectTypeByScenariosPages(context.TODO(), tenantID, model.ApplicationLabelableObject, nil, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, labelPages)
+	})
+}
+
 func TestRepository_ListGlobalByKey(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
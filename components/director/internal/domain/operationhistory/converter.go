@@ -0,0 +1,87 @@
+package operationhistory
+
+import (
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+var resourceTypesToGraphQL = map[resource.Type]graphql.OperationResourceType{
+	resource.Application:         graphql.OperationResourceTypeApplication,
+	resource.FormationAssignment: graphql.OperationResourceTypeFormationAssignment,
+}
+
+type converter struct{}
+
+// NewConverter creates a new converter of the operations history entries
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToGraphQL converts the provided service-layer Operation model to a graphql Operation
+func (c *converter) ToGraphQL(in *model.Operation) *graphql.Operation {
+	if in == nil {
+		return nil
+	}
+
+	webhooks := make([]*graphql.OperationWebhook, 0, len(in.Webhooks))
+	for _, webhook := range in.Webhooks {
+		if webhook == nil {
+			continue
+		}
+		webhooks = append(webhooks, &graphql.OperationWebhook{
+			WebhookID:         webhook.WebhookID,
+			State:             graphql.OperationPhase(webhook.State),
+			RetriesCount:      webhook.RetriesCount,
+			PollURL:           stringPtrOrNil(webhook.PollURL),
+			LastPollTimestamp: (*graphql.Timestamp)(webhook.LastPollTimestamp),
+		})
+	}
+
+	return &graphql.Operation{
+		ID:                in.ID,
+		OperationType:     graphql.OperationType(strings.ToUpper(in.OperationType)),
+		OperationCategory: stringPtrOrNil(in.OperationCategory),
+		ResourceType:      resourceTypesToGraphQL[in.ResourceType],
+		ResourceID:        in.ResourceID,
+		Phase:             graphql.OperationPhase(in.Phase),
+		Error:             in.Error,
+		Webhooks:          webhooks,
+		CreatedAt:         graphql.Timestamp(in.CreatedAt),
+		UpdatedAt:         graphql.Timestamp(in.UpdatedAt),
+		FinishedAt:        (*graphql.Timestamp)(in.FinishedAt),
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer Operation models to graphql Operations
+func (c *converter) MultipleToGraphQL(in []*model.Operation) []*graphql.Operation {
+	out := make([]*graphql.Operation, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(item))
+	}
+
+	return out
+}
+
+// ResourceTypeFromGraphQL converts the provided graphql OperationResourceType to the resource type of the operations
+func (c *converter) ResourceTypeFromGraphQL(in graphql.OperationResourceType) resource.Type {
+	for resourceType, gqlResourceType := range resourceTypesToGraphQL {
+		if gqlResourceType == in {
+			return resourceType
+		}
+	}
+
+	return resource.Type(in)
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,82 @@
+package operationhistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result := operationhistory.NewConverter().ToGraphQL(fixOperationModel(model.OperationPhaseInProgress))
+
+		// THEN
+		assert.Equal(t, fixOperationGraphQL(graphql.OperationPhaseInProgress), result)
+	})
+
+	t.Run("Success for finished Formation Assignment operation", func(t *testing.T) {
+		// GIVEN
+		finishedAt := fixedTime.Add(time.Hour)
+		lastPoll := fixedTime.Add(time.Minute)
+		in := &model.Operation{
+			ID:            operationID,
+			ResourceType:  resource.FormationAssignment,
+			ResourceID:    resourceID,
+			OperationType: "Delete",
+			Phase:         model.OperationPhaseFailed,
+			Error:         str.Ptr(testErr),
+			Webhooks:      []*model.OperationWebhook{{WebhookID: webhookID, State: model.OperationPhaseFailed, LastPollTimestamp: &lastPoll}},
+			CreatedAt:     fixedTime,
+			UpdatedAt:     finishedAt,
+			FinishedAt:    &finishedAt,
+		}
+		expectedFinishedAt := graphql.Timestamp(finishedAt)
+		expectedLastPoll := graphql.Timestamp(lastPoll)
+
+		// WHEN
+		result := operationhistory.NewConverter().ToGraphQL(in)
+
+		// THEN
+		assert.Equal(t, &graphql.Operation{
+			ID:            operationID,
+			OperationType: graphql.OperationTypeDelete,
+			ResourceType:  graphql.OperationResourceTypeFormationAssignment,
+			ResourceID:    resourceID,
+			Phase:         graphql.OperationPhaseFailed,
+			Error:         str.Ptr(testErr),
+			Webhooks:      []*graphql.OperationWebhook{{WebhookID: webhookID, State: graphql.OperationPhaseFailed, LastPollTimestamp: &expectedLastPoll}},
+			CreatedAt:     graphql.Timestamp(fixedTime),
+			UpdatedAt:     graphql.Timestamp(finishedAt),
+			FinishedAt:    &expectedFinishedAt,
+		}, result)
+	})
+
+	t.Run("Returns nil for nil input", func(t *testing.T) {
+		assert.Nil(t, operationhistory.NewConverter().ToGraphQL(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	in := []*model.Operation{fixOperationModel(model.OperationPhaseSucceeded), nil}
+
+	// WHEN
+	result := operationhistory.NewConverter().MultipleToGraphQL(in)
+
+	// THEN
+	assert.Equal(t, []*graphql.Operation{fixOperationGraphQL(graphql.OperationPhaseSucceeded)}, result)
+}
+
+func TestConverter_ResourceTypeFromGraphQL(t *testing.T) {
+	conv := operationhistory.NewConverter()
+
+	assert.Equal(t, resource.Application, conv.ResourceTypeFromGraphQL(graphql.OperationResourceTypeApplication))
+	assert.Equal(t, resource.FormationAssignment, conv.ResourceTypeFromGraphQL(graphql.OperationResourceTypeFormationAssignment))
+}
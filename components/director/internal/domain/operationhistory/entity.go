@@ -0,0 +1,23 @@
+package operationhistory
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity represents an entry of the operations history in the database
+type Entity struct {
+	ID                string         `db:"id"`
+	ResourceType      string         `db:"resource_type"`
+	ResourceID        string         `db:"resource_id"`
+	TenantID          sql.NullString `db:"tenant_id"`
+	OperationType     string         `db:"operation_type"`
+	OperationCategory sql.NullString `db:"operation_category"`
+	CorrelationID     sql.NullString `db:"correlation_id"`
+	Phase             string         `db:"phase"`
+	Error             sql.NullString `db:"error"`
+	Webhooks          string         `db:"webhooks"`
+	CreatedAt         time.Time      `db:"created_at"`
+	UpdatedAt         time.Time      `db:"updated_at"`
+	FinishedAt        sql.NullTime   `db:"finished_at"`
+}
@@ -0,0 +1,66 @@
+package operationhistory_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	operationID   = "0b4b5b3c-0e41-4c1d-bb0f-1d07c1d1e8f4"
+	resourceID    = "8fb2bf3e-7a31-4a47-a1bd-5c4bd9d3f7c5"
+	tenantID      = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	webhookID     = "9a4c5d7d-5cf0-4a27-b7fb-2a1d4a7c9f0e"
+	correlationID = "3b2f1c3e-0f1a-4d1b-8c9e-6f4a5d3b2c1a"
+	pollURL       = "https://example.com/poll"
+	testErr       = "test error"
+)
+
+var fixedTime = time.Date(2022, 8, 29, 12, 0, 0, 0, time.UTC)
+
+func fixOperationModel(phase model.OperationPhase) *model.Operation {
+	return &model.Operation{
+		ID:                operationID,
+		ResourceType:      resource.Application,
+		ResourceID:        resourceID,
+		TenantID:          str.Ptr(tenantID),
+		OperationType:     "Create",
+		OperationCategory: "registerApplication",
+		CorrelationID:     correlationID,
+		Phase:             phase,
+		Webhooks: []*model.OperationWebhook{
+			{
+				WebhookID:    webhookID,
+				State:        phase,
+				RetriesCount: 1,
+				PollURL:      pollURL,
+			},
+		},
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+}
+
+func fixOperationGraphQL(phase graphql.OperationPhase) *graphql.Operation {
+	return &graphql.Operation{
+		ID:                operationID,
+		OperationType:     graphql.OperationTypeCreate,
+		OperationCategory: str.Ptr("registerApplication"),
+		ResourceType:      graphql.OperationResourceTypeApplication,
+		ResourceID:        resourceID,
+		Phase:             phase,
+		Webhooks: []*graphql.OperationWebhook{
+			{
+				WebhookID:    webhookID,
+				State:        phase,
+				RetriesCount: 1,
+				PollURL:      str.Ptr(pollURL),
+			},
+		},
+		CreatedAt: graphql.Timestamp(fixedTime),
+		UpdatedAt: graphql.Timestamp(fixedTime),
+	}
+}
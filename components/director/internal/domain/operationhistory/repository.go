@@ -0,0 +1,174 @@
+package operationhistory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	selectedColumns = "id, resource_type, resource_id, tenant_id, operation_type, operation_category, correlation_id, phase, error, webhooks, created_at, updated_at, finished_at"
+
+	createQuery = `INSERT INTO public.operations_history (` + selectedColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+
+	getInProgressByResourceQuery = `SELECT ` + selectedColumns + ` FROM public.operations_history WHERE resource_type = $1 AND resource_id = $2 AND phase = 'IN_PROGRESS' FOR UPDATE`
+
+	updateQuery = `UPDATE public.operations_history SET phase = $1, error = $2, webhooks = $3, updated_at = $4, finished_at = $5 WHERE id = $6`
+
+	listByResourceQuery = `SELECT ` + selectedColumns + ` FROM public.operations_history WHERE resource_type = $1 AND resource_id = $2 ORDER BY created_at DESC`
+)
+
+type repository struct{}
+
+// NewRepository creates a new repository of the operations history
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// Create stores a new entry in the operations history
+func (r *repository) Create(ctx context.Context, item *model.Operation) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	ent, err := toEntity(item)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", createQuery)
+	_, err = persist.ExecContext(ctx, createQuery, ent.ID, ent.ResourceType, ent.ResourceID, ent.TenantID, ent.OperationType, ent.OperationCategory,
+		ent.CorrelationID, ent.Phase, ent.Error, ent.Webhooks, ent.CreatedAt, ent.UpdatedAt, ent.FinishedAt)
+	return persistence.MapSQLError(ctx, err, resource.OperationHistory, resource.Create, "while creating operation for %s with ID %s", item.ResourceType, item.ResourceID)
+}
+
+// GetInProgressByResource returns the operation in progress for the given resource, locked until the end of the transaction
+func (r *repository) GetInProgressByResource(ctx context.Context, resourceType resource.Type, resourceID string) (*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var ent Entity
+	log.C(ctx).Debugf("Executing DB query: %s", getInProgressByResourceQuery)
+	if err = persist.GetContext(ctx, &ent, getInProgressByResourceQuery, resourceType, resourceID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperrors.NewNotFoundErrorWithMessage(resource.OperationHistory, resourceID, "no operation in progress")
+		}
+		return nil, persistence.MapSQLError(ctx, err, resource.OperationHistory, resource.Get, "while getting operation in progress for %s with ID %s", resourceType, resourceID)
+	}
+
+	return fromEntity(ent)
+}
+
+// Update stores the phase, the error and the webhooks progress of the operation
+func (r *repository) Update(ctx context.Context, item *model.Operation) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	ent, err := toEntity(item)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", updateQuery)
+	_, err = persist.ExecContext(ctx, updateQuery, ent.Phase, ent.Error, ent.Webhooks, ent.UpdatedAt, ent.FinishedAt, ent.ID)
+	return persistence.MapSQLError(ctx, err, resource.OperationHistory, resource.Update, "while updating operation with ID %s", item.ID)
+}
+
+// ListByResource returns the operations of the given resource, the most recent first
+func (r *repository) ListByResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var entities []Entity
+	log.C(ctx).Debugf("Executing DB query: %s", listByResourceQuery)
+	if err = persist.SelectContext(ctx, &entities, listByResourceQuery, resourceType, resourceID); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.OperationHistory, resource.List, "while listing operations for %s with ID %s", resourceType, resourceID)
+	}
+
+	operations := make([]*model.Operation, 0, len(entities))
+	for _, ent := range entities {
+		op, err := fromEntity(ent)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, op)
+	}
+
+	return operations, nil
+}
+
+func toEntity(in *model.Operation) (Entity, error) {
+	webhooks := in.Webhooks
+	if webhooks == nil {
+		webhooks = []*model.OperationWebhook{}
+	}
+	webhooksJSON, err := json.Marshal(webhooks)
+	if err != nil {
+		return Entity{}, errors.Wrapf(err, "while marshalling webhooks of operation with ID %s", in.ID)
+	}
+
+	finishedAt := sql.NullTime{}
+	if in.FinishedAt != nil {
+		finishedAt = sql.NullTime{Time: *in.FinishedAt, Valid: true}
+	}
+
+	return Entity{
+		ID:                in.ID,
+		ResourceType:      string(in.ResourceType),
+		ResourceID:        in.ResourceID,
+		TenantID:          repo.NewNullableString(in.TenantID),
+		OperationType:     in.OperationType,
+		OperationCategory: repo.NewValidNullableString(in.OperationCategory),
+		CorrelationID:     repo.NewValidNullableString(in.CorrelationID),
+		Phase:             string(in.Phase),
+		Error:             repo.NewNullableString(in.Error),
+		Webhooks:          string(webhooksJSON),
+		CreatedAt:         in.CreatedAt,
+		UpdatedAt:         in.UpdatedAt,
+		FinishedAt:        finishedAt,
+	}, nil
+}
+
+func fromEntity(ent Entity) (*model.Operation, error) {
+	var webhooks []*model.OperationWebhook
+	if err := json.Unmarshal([]byte(ent.Webhooks), &webhooks); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling webhooks of operation with ID %s", ent.ID)
+	}
+
+	var finishedAt *time.Time
+	if ent.FinishedAt.Valid {
+		finishedAt = &ent.FinishedAt.Time
+	}
+
+	return &model.Operation{
+		ID:                ent.ID,
+		ResourceType:      resource.Type(ent.ResourceType),
+		ResourceID:        ent.ResourceID,
+		TenantID:          repo.StringPtrFromNullableString(ent.TenantID),
+		OperationType:     ent.OperationType,
+		OperationCategory: ent.OperationCategory.String,
+		CorrelationID:     ent.CorrelationID.String,
+		Phase:             model.OperationPhase(ent.Phase),
+		Error:             repo.StringPtrFromNullableString(ent.Error),
+		Webhooks:          webhooks,
+		CreatedAt:         ent.CreatedAt,
+		UpdatedAt:         ent.UpdatedAt,
+		FinishedAt:        finishedAt,
+	}, nil
+}
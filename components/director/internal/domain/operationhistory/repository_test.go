@@ -0,0 +1,265 @@
+package operationhistory_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	columns      = []string{"id", "resource_type", "resource_id", "tenant_id", "operation_type", "operation_category", "correlation_id", "phase", "error", "webhooks", "created_at", "updated_at", "finished_at"}
+	webhooksJSON = `[{"webhook_id":"` + webhookID + `","state":"IN_PROGRESS","retries_count":1,"poll_url":"` + pollURL + `"}]`
+)
+
+func fixOperationRow(rows *sqlmock.Rows) *sqlmock.Rows {
+	return rows.AddRow(operationID, string(resource.Application), resourceID, tenantID, "Create", "registerApplication", correlationID, "IN_PROGRESS", nil, webhooksJSON, fixedTime, fixedTime, nil)
+}
+
+func TestRepository_Create(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO public.operations_history (id, resource_type, resource_id, tenant_id, operation_type, operation_category, correlation_id, phase, error, webhooks, created_at, updated_at, finished_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`)
+	args := []driver.Value{operationID, string(resource.Application), resourceID, sql.NullString{String: tenantID, Valid: true}, "Create",
+		sql.NullString{String: "registerApplication", Valid: true}, sql.NullString{String: correlationID, Valid: true}, "IN_PROGRESS",
+		sql.NullString{}, webhooksJSON, fixedTime, fixedTime, sql.NullTime{}}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := operationhistory.NewRepository().Create(ctx, fixOperationModel(model.OperationPhaseInProgress))
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when insert fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := operationhistory.NewRepository().Create(ctx, fixOperationModel(model.OperationPhaseInProgress))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		err := operationhistory.NewRepository().Create(context.TODO(), fixOperationModel(model.OperationPhaseInProgress))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_GetInProgressByResource(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, resource_type, resource_id, tenant_id, operation_type, operation_category, correlation_id, phase, error, webhooks, created_at, updated_at, finished_at FROM public.operations_history WHERE resource_type = $1 AND resource_id = $2 AND phase = 'IN_PROGRESS' FOR UPDATE`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnRows(fixOperationRow(sqlmock.NewRows(columns)))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		op, err := operationhistory.NewRepository().GetInProgressByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixOperationModel(model.OperationPhaseInProgress), op)
+	})
+
+	t.Run("Not found error when there is no operation in progress", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnRows(sqlmock.NewRows(columns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := operationhistory.NewRepository().GetInProgressByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when select fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := operationhistory.NewRepository().GetInProgressByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		_, err := operationhistory.NewRepository().GetInProgressByResource(context.TODO(), resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE public.operations_history SET phase = $1, error = $2, webhooks = $3, updated_at = $4, finished_at = $5 WHERE id = $6`)
+	op := fixOperationModel(model.OperationPhaseInProgress)
+	op.Finish(nil, fixedTime)
+	args := []driver.Value{"SUCCEEDED", sql.NullString{}, `[{"webhook_id":"` + webhookID + `","state":"SUCCEEDED","retries_count":1,"poll_url":"` + pollURL + `"}]`,
+		fixedTime, sql.NullTime{Time: fixedTime, Valid: true}, operationID}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := operationhistory.NewRepository().Update(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when update fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := operationhistory.NewRepository().Update(ctx, op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		err := operationhistory.NewRepository().Update(context.TODO(), op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_ListByResource(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, resource_type, resource_id, tenant_id, operation_type, operation_category, correlation_id, phase, error, webhooks, created_at, updated_at, finished_at FROM public.operations_history WHERE resource_type = $1 AND resource_id = $2 ORDER BY created_at DESC`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := fixOperationRow(sqlmock.NewRows(columns))
+		rows.AddRow(operationID, string(resource.Application), resourceID, nil, "Delete", nil, nil, "FAILED", testErr, "[]", fixedTime, fixedTime, fixedTime)
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		operations, err := operationhistory.NewRepository().ListByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, operations, 2)
+		assert.Equal(t, fixOperationModel(model.OperationPhaseInProgress), operations[0])
+		assert.Equal(t, model.OperationPhaseFailed, operations[1].Phase)
+		assert.Equal(t, testErr, *operations[1].Error)
+		assert.Nil(t, operations[1].TenantID)
+		assert.Empty(t, operations[1].Webhooks)
+		assert.Equal(t, fixedTime, *operations[1].FinishedAt)
+	})
+
+	t.Run("Error when webhooks are not valid JSON", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(operationID, string(resource.Application), resourceID, nil, "Delete", nil, nil, "FAILED", nil, "{", fixedTime, fixedTime, nil)
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := operationhistory.NewRepository().ListByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling webhooks of operation")
+	})
+
+	t.Run("Error when select fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(resource.Application, resourceID).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := operationhistory.NewRepository().ListByResource(ctx, resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		_, err := operationhistory.NewRepository().ListByResource(context.TODO(), resource.Application, resourceID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
@@ -0,0 +1,82 @@
+package operationhistory
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// OperationHistoryService is responsible for the service-layer operations history operations needed by the resolver
+//go:generate mockery --name=OperationHistoryService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationHistoryService interface {
+	ListByResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error)
+	ListForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error)
+}
+
+// OperationHistoryConverter converts Operations between the model.Operation service-layer representation and the graphql-layer representation
+//go:generate mockery --name=OperationHistoryConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationHistoryConverter interface {
+	MultipleToGraphQL(in []*model.Operation) []*graphql.Operation
+	ResourceTypeFromGraphQL(in graphql.OperationResourceType) resource.Type
+}
+
+// Resolver is an object responsible for resolver-layer operations history operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      OperationHistoryService
+	conv     OperationHistoryConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer operations history operations
+func NewResolver(transact persistence.Transactioner, svc OperationHistoryService, conv OperationHistoryConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Operations returns the operations history of the given resource, the most recent operation first
+func (r *Resolver) Operations(ctx context.Context, resourceID string, resourceType graphql.OperationResourceType) ([]*graphql.Operation, error) {
+	return r.list(ctx, resourceID, func(ctx context.Context) ([]*model.Operation, error) {
+		return r.svc.ListByResource(ctx, r.conv.ResourceTypeFromGraphQL(resourceType), resourceID)
+	})
+}
+
+// ApplicationOperations returns the operations history of the given Application, the most recent operation first
+func (r *Resolver) ApplicationOperations(ctx context.Context, obj *graphql.Application) ([]*graphql.Operation, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	return r.list(ctx, obj.ID, func(ctx context.Context) ([]*model.Operation, error) {
+		return r.svc.ListForResource(ctx, resource.Application, obj.ID)
+	})
+}
+
+func (r *Resolver) list(ctx context.Context, resourceID string, listFunc func(ctx context.Context) ([]*model.Operation, error)) ([]*graphql.Operation, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	operations, err := listFunc(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while listing operations for resource with ID %s: %v", resourceID, err)
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(operations), nil
+}
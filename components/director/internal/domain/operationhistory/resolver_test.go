@@ -0,0 +1,164 @@
+package operationhistory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Operations(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testError := errors.New(testErr)
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	models := []*model.Operation{fixOperationModel(model.OperationPhaseSucceeded)}
+	gqls := []*graphql.Operation{fixOperationGraphQL(graphql.OperationPhaseSucceeded)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.OperationHistoryService
+		ConverterFn    func() *automock.OperationHistoryConverter
+		ExpectedResult []*graphql.Operation
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.OperationHistoryService {
+				svc := &automock.OperationHistoryService{}
+				svc.On("ListByResource", txtest.CtxWithDBMatcher(), resource.FormationAssignment, resourceID).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.OperationHistoryConverter {
+				conv := &automock.OperationHistoryConverter{}
+				conv.On("ResourceTypeFromGraphQL", graphql.OperationResourceTypeFormationAssignment).Return(resource.FormationAssignment).Once()
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: gqls,
+		},
+		{
+			Name: "Returns error when listing the operations fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.OperationHistoryService {
+				svc := &automock.OperationHistoryService{}
+				svc.On("ListByResource", txtest.CtxWithDBMatcher(), resource.FormationAssignment, resourceID).Return(nil, testError).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.OperationHistoryConverter {
+				conv := &automock.OperationHistoryConverter{}
+				conv.On("ResourceTypeFromGraphQL", graphql.OperationResourceTypeFormationAssignment).Return(resource.FormationAssignment).Once()
+				return conv
+			},
+			ExpectedErr: testError,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   func() *automock.OperationHistoryService { return &automock.OperationHistoryService{} },
+			ConverterFn: func() *automock.OperationHistoryConverter { return &automock.OperationHistoryConverter{} },
+			ExpectedErr: testError,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.OperationHistoryService {
+				svc := &automock.OperationHistoryService{}
+				svc.On("ListByResource", txtest.CtxWithDBMatcher(), resource.FormationAssignment, resourceID).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.OperationHistoryConverter {
+				conv := &automock.OperationHistoryConverter{}
+				conv.On("ResourceTypeFromGraphQL", graphql.OperationResourceTypeFormationAssignment).Return(resource.FormationAssignment).Once()
+				return conv
+			},
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := operationhistory.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.Operations(ctx, resourceID, graphql.OperationResourceTypeFormationAssignment)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_ApplicationOperations(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testError := errors.New(testErr)
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	models := []*model.Operation{fixOperationModel(model.OperationPhaseSucceeded)}
+	gqls := []*graphql.Operation{fixOperationGraphQL(graphql.OperationPhaseSucceeded)}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.OperationHistoryService{}
+		svc.On("ListForResource", txtest.CtxWithDBMatcher(), resource.Application, resourceID).Return(models, nil).Once()
+		conv := &automock.OperationHistoryConverter{}
+		conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+		defer mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+
+		// WHEN
+		result, err := operationhistory.NewResolver(transact, svc, conv).ApplicationOperations(ctx, &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: resourceID}})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, gqls, result)
+	})
+
+	t.Run("Returns error when listing the operations fails", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.OperationHistoryService{}
+		svc.On("ListForResource", txtest.CtxWithDBMatcher(), resource.Application, resourceID).Return(nil, testError).Once()
+		defer mock.AssertExpectationsForObjects(t, persist, transact, svc)
+
+		// WHEN
+		_, err := operationhistory.NewResolver(transact, svc, nil).ApplicationOperations(ctx, &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: resourceID}})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr)
+	})
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		// WHEN
+		_, err := operationhistory.NewResolver(nil, nil, nil).ApplicationOperations(ctx, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
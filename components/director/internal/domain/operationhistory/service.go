@@ -0,0 +1,230 @@
+package operationhistory
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const supersededOperationError = "operation was superseded by a newer operation for the same resource"
+
+// OperationHistoryRepository is responsible for the repo-layer operations history operations
+//go:generate mockery --name=OperationHistoryRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationHistoryRepository interface {
+	Create(ctx context.Context, item *model.Operation) error
+	GetInProgressByResource(ctx context.Context, resourceType resource.Type, resourceID string) (*model.Operation, error)
+	Update(ctx context.Context, item *model.Operation) error
+	ListByResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error)
+}
+
+// ApplicationRepository is responsible for checking whether an Application is visible in the tenant of the caller
+//go:generate mockery --name=ApplicationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationRepository interface {
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+}
+
+// FormationAssignmentRepository is responsible for getting the Formation Assignments regardless of the tenant of the caller
+//go:generate mockery --name=FormationAssignmentRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type FormationAssignmentRepository interface {
+	GetGlobalByID(ctx context.Context, id string) (*model.FormationAssignment, error)
+}
+
+// UIDService generates UUIDs for new entities
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo             OperationHistoryRepository
+	appRepo          ApplicationRepository
+	formationAssRepo FormationAssignmentRepository
+	uidService       UIDService
+	timestampGen     timestamp.Generator
+}
+
+// NewService creates a new service which records the asynchronous operations in the operations history
+func NewService(repo OperationHistoryRepository, appRepo ApplicationRepository, formationAssRepo FormationAssignmentRepository, uidService UIDService) *service {
+	return &service{
+		repo:             repo,
+		appRepo:          appRepo,
+		formationAssRepo: formationAssRepo,
+		uidService:       uidService,
+		timestampGen:     timestamp.DefaultGenerator,
+	}
+}
+
+// RecordScheduled creates a new in progress entry in the operations history for the scheduled operation.
+// An entry which is still in progress for the same resource is finished as failed, as its operation was replaced by the new one.
+func (s *service) RecordScheduled(ctx context.Context, op *operation.Operation) error {
+	now := s.timestampGen()
+
+	inProgress, err := s.repo.GetInProgressByResource(ctx, op.ResourceType, op.ResourceID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting operation in progress for %s with ID %s", op.ResourceType, op.ResourceID)
+	}
+	if inProgress != nil {
+		log.C(ctx).Infof("Operation with ID %s for %s with ID %s is superseded by a new %s operation", inProgress.ID, op.ResourceType, op.ResourceID, op.OperationType)
+		errMsg := supersededOperationError
+		inProgress.Finish(&errMsg, now)
+		if err := s.repo.Update(ctx, inProgress); err != nil {
+			return errors.Wrapf(err, "while finishing superseded operation with ID %s", inProgress.ID)
+		}
+	}
+
+	var tenantID *string
+	if tnt, err := tenant.LoadFromContext(ctx); err == nil {
+		tenantID = &tnt
+	}
+
+	webhooks := make([]*model.OperationWebhook, 0, len(op.WebhookIDs))
+	for _, webhookID := range op.WebhookIDs {
+		webhooks = append(webhooks, &model.OperationWebhook{
+			WebhookID: webhookID,
+			State:     model.OperationPhaseInProgress,
+		})
+	}
+
+	item := &model.Operation{
+		ID:                s.uidService.Generate(),
+		ResourceType:      op.ResourceType,
+		ResourceID:        op.ResourceID,
+		TenantID:          tenantID,
+		OperationType:     string(op.OperationType),
+		OperationCategory: op.OperationCategory,
+		CorrelationID:     op.CorrelationID,
+		Phase:             model.OperationPhaseInProgress,
+		Webhooks:          webhooks,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		return errors.Wrapf(err, "while creating operation for %s with ID %s", op.ResourceType, op.ResourceID)
+	}
+
+	return nil
+}
+
+// RecordProgress stores the reported progress of the webhooks of the operation in progress for the given resource.
+// Progress reported for a resource without an operation in progress is ignored, as the operation may have been scheduled before the history was recorded.
+func (s *service) RecordProgress(ctx context.Context, resourceType resource.Type, resourceID string, webhooks []operation.WebhookStatus) error {
+	item, err := s.getInProgress(ctx, resourceType, resourceID)
+	if err != nil || item == nil {
+		return err
+	}
+
+	mergeWebhooks(item, webhooks)
+	item.UpdatedAt = s.timestampGen()
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return errors.Wrapf(err, "while updating progress of operation with ID %s", item.ID)
+	}
+
+	return nil
+}
+
+// RecordResult finishes the operation in progress for the given resource with the given error, or successfully if the error is empty.
+// A result reported for a resource without an operation in progress is ignored, as the operation may have been scheduled before the history was recorded.
+func (s *service) RecordResult(ctx context.Context, resourceType resource.Type, resourceID string, errorMsg string, webhooks []operation.WebhookStatus) error {
+	item, err := s.getInProgress(ctx, resourceType, resourceID)
+	if err != nil || item == nil {
+		return err
+	}
+
+	mergeWebhooks(item, webhooks)
+	item.Finish(&errorMsg, s.timestampGen())
+
+	if err := s.repo.Update(ctx, item); err != nil {
+		return errors.Wrapf(err, "while finishing operation with ID %s", item.ID)
+	}
+
+	return nil
+}
+
+// ListByResource returns the operations history of the given resource, the most recent operation first.
+// The resource has to be visible in the tenant of the caller.
+func (s *service) ListByResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	switch resourceType {
+	case resource.Application:
+		exists, err := s.appRepo.Exists(ctx, tnt, resourceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while checking existence of Application with ID %s", resourceID)
+		}
+		if !exists {
+			return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+		}
+	case resource.FormationAssignment:
+		formationAssignment, err := s.formationAssRepo.GetGlobalByID(ctx, resourceID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting Formation Assignment with ID %s", resourceID)
+		}
+		if formationAssignment.TenantID != tnt {
+			return nil, apperrors.NewNotFoundError(resource.FormationAssignment, resourceID)
+		}
+	default:
+		return nil, apperrors.NewInvalidDataError("unsupported resource type %q", resourceType)
+	}
+
+	return s.ListForResource(ctx, resourceType, resourceID)
+}
+
+// ListForResource returns the operations history of the given resource without checking its visibility in the tenant of the caller.
+// It is meant to be used for resources which are already loaded for the caller, e.g. for the fields of an Application.
+func (s *service) ListForResource(ctx context.Context, resourceType resource.Type, resourceID string) ([]*model.Operation, error) {
+	operations, err := s.repo.ListByResource(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing operations for %s with ID %s", resourceType, resourceID)
+	}
+
+	return operations, nil
+}
+
+func (s *service) getInProgress(ctx context.Context, resourceType resource.Type, resourceID string) (*model.Operation, error) {
+	item, err := s.repo.GetInProgressByResource(ctx, resourceType, resourceID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).Infof("No operation in progress for %s with ID %s found in the operations history", resourceType, resourceID)
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting operation in progress for %s with ID %s", resourceType, resourceID)
+	}
+
+	return item, nil
+}
+
+func mergeWebhooks(item *model.Operation, statuses []operation.WebhookStatus) {
+	for _, status := range statuses {
+		var webhook *model.OperationWebhook
+		for _, w := range item.Webhooks {
+			if w.WebhookID == status.WebhookID {
+				webhook = w
+				break
+			}
+		}
+		if webhook == nil {
+			webhook = &model.OperationWebhook{WebhookID: status.WebhookID}
+			item.Webhooks = append(item.Webhooks, webhook)
+		}
+
+		webhook.State = model.OperationPhaseInProgress
+		if status.State != "" {
+			webhook.State = model.OperationPhase(status.State)
+		}
+		webhook.RetriesCount = status.RetriesCount
+		webhook.PollURL = status.WebhookPollURL
+		webhook.LastPollTimestamp = status.LastPollTimestamp
+	}
+}
@@ -0,0 +1,473 @@
+package operationhistory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RecordScheduled(t *testing.T) {
+	// GIVEN
+	testError := errors.New(testErr)
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, "external-tenant")
+	notFoundErr := apperrors.NewNotFoundError(resource.OperationHistory, resourceID)
+
+	op := &operation.Operation{
+		OperationType:     operation.OperationTypeCreate,
+		OperationCategory: "registerApplication",
+		ResourceID:        resourceID,
+		ResourceType:      resource.Application,
+		CorrelationID:     correlationID,
+		WebhookIDs:        []string{webhookID},
+	}
+
+	expected := &model.Operation{
+		ID:                operationID,
+		ResourceType:      resource.Application,
+		ResourceID:        resourceID,
+		TenantID:          str.Ptr(tenantID),
+		OperationType:     "Create",
+		OperationCategory: "registerApplication",
+		CorrelationID:     correlationID,
+		Phase:             model.OperationPhaseInProgress,
+		Webhooks:          []*model.OperationWebhook{{WebhookID: webhookID, State: model.OperationPhaseInProgress}},
+		CreatedAt:         fixedTime,
+		UpdatedAt:         fixedTime,
+	}
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		RepoFn             func() *automock.OperationHistoryRepository
+		UIDSvcFn           func() *automock.UIDService
+		ExpectedErrMessage string
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, expected).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDService,
+		},
+		{
+			Name:    "Success without tenant in the context",
+			Context: context.TODO(),
+			RepoFn: func() *automock.OperationHistoryRepository {
+				withoutTenant := *expected
+				withoutTenant.TenantID = nil
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", context.TODO(), resource.Application, resourceID).Return(nil, notFoundErr).Once()
+				repo.On("Create", context.TODO(), &withoutTenant).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDService,
+		},
+		{
+			Name:    "Success when superseding an operation in progress",
+			Context: ctx,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Phase == model.OperationPhaseFailed && op.FinishedAt != nil && op.Webhooks[0].State == model.OperationPhaseFailed
+				})).Return(nil).Once()
+				repo.On("Create", ctx, expected).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDService,
+		},
+		{
+			Name:    "Error when getting the operation in progress fails",
+			Context: ctx,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name:    "Error when finishing the superseded operation fails",
+			Context: ctx,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while finishing superseded operation",
+		},
+		{
+			Name:    "Error when creating the operation fails",
+			Context: ctx,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, expected).Return(testError).Once()
+				return repo
+			},
+			UIDSvcFn:           fixUIDService,
+			ExpectedErrMessage: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := &automock.UIDService{}
+			if testCase.UIDSvcFn != nil {
+				uidSvc = testCase.UIDSvcFn()
+			}
+			svc := operationhistory.NewService(repo, nil, nil, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return fixedTime })
+
+			// WHEN
+			err := svc.RecordScheduled(testCase.Context, op)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
+
+func TestService_RecordProgress(t *testing.T) {
+	// GIVEN
+	testError := errors.New(testErr)
+	ctx := context.TODO()
+	lastPoll := fixedTime.Add(time.Minute)
+	otherWebhookID := "5e0ce8d6-1e0c-4c66-8b43-4a40f7a9a2d1"
+	webhooks := []operation.WebhookStatus{
+		{WebhookID: webhookID, RetriesCount: 2, WebhookPollURL: pollURL, LastPollTimestamp: &lastPoll},
+		{WebhookID: otherWebhookID, State: operation.OperationStatusSucceeded},
+	}
+
+	testCases := []struct {
+		Name               string
+		RepoFn             func() *automock.OperationHistoryRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(op *model.Operation) bool {
+					return op.Phase == model.OperationPhaseInProgress && len(op.Webhooks) == 2 &&
+						op.Webhooks[0].State == model.OperationPhaseInProgress && op.Webhooks[0].RetriesCount == 2 && *op.Webhooks[0].LastPollTimestamp == lastPoll &&
+						op.Webhooks[1].WebhookID == otherWebhookID && op.Webhooks[1].State == model.OperationPhaseSucceeded && op.UpdatedAt.Equal(lastPoll)
+				})).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Success when there is no operation in progress",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundError(resource.OperationHistory, resourceID)).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when getting the operation in progress fails",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name: "Error when updating the operation fails",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while updating progress of operation",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := operationhistory.NewService(repo, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return lastPoll })
+
+			// WHEN
+			err := svc.RecordProgress(ctx, resource.Application, resourceID, webhooks)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecordResult(t *testing.T) {
+	// GIVEN
+	testError := errors.New(testErr)
+	ctx := context.TODO()
+	finishedAt := fixedTime.Add(time.Hour)
+
+	testCases := []struct {
+		Name               string
+		ErrorMsg           string
+		RepoFn             func() *automock.OperationHistoryRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				expected := fixOperationModel(model.OperationPhaseSucceeded)
+				expected.UpdatedAt = finishedAt
+				expected.FinishedAt = &finishedAt
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, expected).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:     "Success with error",
+			ErrorMsg: testErr,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				expected := fixOperationModel(model.OperationPhaseFailed)
+				expected.Error = str.Ptr(testErr)
+				expected.UpdatedAt = finishedAt
+				expected.FinishedAt = &finishedAt
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, expected).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Success when there is no operation in progress",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundError(resource.OperationHistory, resourceID)).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error when updating the operation fails",
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("GetInProgressByResource", ctx, resource.Application, resourceID).Return(fixOperationModel(model.OperationPhaseInProgress), nil).Once()
+				repo.On("Update", ctx, mock.Anything).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while finishing operation",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := operationhistory.NewService(repo, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return finishedAt })
+
+			// WHEN
+			err := svc.RecordResult(ctx, resource.Application, resourceID, testCase.ErrorMsg, []operation.WebhookStatus{{WebhookID: webhookID, RetriesCount: 1, WebhookPollURL: pollURL}})
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListByResource(t *testing.T) {
+	// GIVEN
+	testError := errors.New(testErr)
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, "external-tenant")
+	operations := []*model.Operation{fixOperationModel(model.OperationPhaseSucceeded)}
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		ResourceType       resource.Type
+		RepoFn             func() *automock.OperationHistoryRepository
+		AppRepoFn          func() *automock.ApplicationRepository
+		FormationAssRepoFn func() *automock.FormationAssignmentRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name:         "Success for Application",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("ListByResource", ctx, resource.Application, resourceID).Return(operations, nil).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("Exists", ctx, tenantID, resourceID).Return(true, nil).Once()
+				return appRepo
+			},
+		},
+		{
+			Name:         "Not found error when the Application is not visible in the tenant",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("Exists", ctx, tenantID, resourceID).Return(false, nil).Once()
+				return appRepo
+			},
+			ExpectedErrMessage: "Object not found",
+		},
+		{
+			Name:         "Error when checking the Application fails",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("Exists", ctx, tenantID, resourceID).Return(false, testError).Once()
+				return appRepo
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name:         "Success for Formation Assignment",
+			Context:      ctx,
+			ResourceType: resource.FormationAssignment,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("ListByResource", ctx, resource.FormationAssignment, resourceID).Return(operations, nil).Once()
+				return repo
+			},
+			FormationAssRepoFn: func() *automock.FormationAssignmentRepository {
+				formationAssRepo := &automock.FormationAssignmentRepository{}
+				formationAssRepo.On("GetGlobalByID", ctx, resourceID).Return(&model.FormationAssignment{ID: resourceID, TenantID: tenantID}, nil).Once()
+				return formationAssRepo
+			},
+		},
+		{
+			Name:         "Not found error when the Formation Assignment is in another tenant",
+			Context:      ctx,
+			ResourceType: resource.FormationAssignment,
+			FormationAssRepoFn: func() *automock.FormationAssignmentRepository {
+				formationAssRepo := &automock.FormationAssignmentRepository{}
+				formationAssRepo.On("GetGlobalByID", ctx, resourceID).Return(&model.FormationAssignment{ID: resourceID, TenantID: "other-tenant"}, nil).Once()
+				return formationAssRepo
+			},
+			ExpectedErrMessage: "Object not found",
+		},
+		{
+			Name:         "Error when getting the Formation Assignment fails",
+			Context:      ctx,
+			ResourceType: resource.FormationAssignment,
+			FormationAssRepoFn: func() *automock.FormationAssignmentRepository {
+				formationAssRepo := &automock.FormationAssignmentRepository{}
+				formationAssRepo.On("GetGlobalByID", ctx, resourceID).Return(nil, testError).Once()
+				return formationAssRepo
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name:               "Error when the resource type is not supported",
+			Context:            ctx,
+			ResourceType:       resource.Runtime,
+			ExpectedErrMessage: "unsupported resource type",
+		},
+		{
+			Name:               "Error when tenant is missing in the context",
+			Context:            context.TODO(),
+			ResourceType:       resource.Application,
+			ExpectedErrMessage: "while loading tenant from context",
+		},
+		{
+			Name:         "Error when listing the operations fails",
+			Context:      ctx,
+			ResourceType: resource.Application,
+			RepoFn: func() *automock.OperationHistoryRepository {
+				repo := &automock.OperationHistoryRepository{}
+				repo.On("ListByResource", ctx, resource.Application, resourceID).Return(nil, testError).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				appRepo := &automock.ApplicationRepository{}
+				appRepo.On("Exists", ctx, tenantID, resourceID).Return(true, nil).Once()
+				return appRepo
+			},
+			ExpectedErrMessage: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := &automock.OperationHistoryRepository{}
+			if testCase.RepoFn != nil {
+				repo = testCase.RepoFn()
+			}
+			appRepo := &automock.ApplicationRepository{}
+			if testCase.AppRepoFn != nil {
+				appRepo = testCase.AppRepoFn()
+			}
+			formationAssRepo := &automock.FormationAssignmentRepository{}
+			if testCase.FormationAssRepoFn != nil {
+				formationAssRepo = testCase.FormationAssRepoFn()
+			}
+			svc := operationhistory.NewService(repo, appRepo, formationAssRepo, nil)
+
+			// WHEN
+			result, err := svc.ListByResource(testCase.Context, testCase.ResourceType, resourceID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, operations, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, appRepo, formationAssRepo)
+		})
+	}
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(operationID).Once()
+	return uidSvc
+}
@@ -0,0 +1,65 @@
+package ordreport
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter creates a new converter of the ORD aggregation reports
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToGraphQL converts the provided service-layer ORDAggregationReport model to a graphql ORDAggregationReport
+func (c *converter) ToGraphQL(in *model.ORDAggregationReport) *graphql.ORDAggregationReport {
+	if in == nil {
+		return nil
+	}
+
+	accepted := make([]*graphql.ORDReportedResource, 0, len(in.AcceptedResources))
+	for _, r := range in.AcceptedResources {
+		if r == nil {
+			continue
+		}
+		accepted = append(accepted, &graphql.ORDReportedResource{
+			Type:  graphql.ORDResourceType(r.Type),
+			OrdID: r.OrdID,
+		})
+	}
+
+	rejected := make([]*graphql.ORDRejectedResource, 0, len(in.RejectedResources))
+	for _, r := range in.RejectedResources {
+		if r == nil {
+			continue
+		}
+		rejected = append(rejected, &graphql.ORDRejectedResource{
+			Type:      graphql.ORDResourceType(r.Type),
+			OrdID:     stringPtrOrNil(r.OrdID),
+			Rule:      r.Rule,
+			FieldPath: stringPtrOrNil(r.FieldPath),
+			Message:   r.Message,
+		})
+	}
+
+	tombstones := append([]string{}, in.AppliedTombstones...)
+
+	return &graphql.ORDAggregationReport{
+		Status:            graphql.ORDAggregationStatus(in.Status),
+		Error:             in.Error,
+		DocumentsFetched:  in.DocumentsFetched,
+		AcceptedResources: accepted,
+		RejectedResources: rejected,
+		AppliedTombstones: tombstones,
+		StartedAt:         graphql.Timestamp(in.StartedAt),
+		FinishedAt:        graphql.Timestamp(in.FinishedAt),
+	}
+}
+
+func stringPtrOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,47 @@
+package ordreport_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result := ordreport.NewConverter().ToGraphQL(fixReportModel())
+
+		// THEN
+		assert.Equal(t, fixReportGraphQL(), result)
+	})
+
+	t.Run("Success for successful aggregation without resources", func(t *testing.T) {
+		// GIVEN
+		in := &model.ORDAggregationReport{
+			ApplicationID: appID,
+			Status:        model.ORDAggregationStatusSucceeded,
+			StartedAt:     startedAt,
+			FinishedAt:    finishedAt,
+		}
+
+		// WHEN
+		result := ordreport.NewConverter().ToGraphQL(in)
+
+		// THEN
+		assert.Equal(t, &graphql.ORDAggregationReport{
+			Status:            graphql.ORDAggregationStatusSucceeded,
+			AcceptedResources: []*graphql.ORDReportedResource{},
+			RejectedResources: []*graphql.ORDRejectedResource{},
+			AppliedTombstones: []string{},
+			StartedAt:         graphql.Timestamp(startedAt),
+			FinishedAt:        graphql.Timestamp(finishedAt),
+		}, result)
+	})
+
+	t.Run("Returns nil for nil input", func(t *testing.T) {
+		assert.Nil(t, ordreport.NewConverter().ToGraphQL(nil))
+	})
+}
@@ -0,0 +1,19 @@
+package ordreport
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Entity represents the report of the latest ORD aggregation of an application in the database
+type Entity struct {
+	ApplicationID     string         `db:"app_id"`
+	Status            string         `db:"status"`
+	Error             sql.NullString `db:"error"`
+	DocumentsFetched  int            `db:"documents_fetched"`
+	AcceptedResources string         `db:"accepted_resources"`
+	RejectedResources string         `db:"rejected_resources"`
+	AppliedTombstones string         `db:"applied_tombstones"`
+	StartedAt         time.Time      `db:"started_at"`
+	FinishedAt        time.Time      `db:"finished_at"`
+}
@@ -0,0 +1,60 @@
+package ordreport_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	appID          = "8fb2bf3e-7a31-4a47-a1bd-5c4bd9d3f7c5"
+	packageORDID   = "ns:package:PACKAGE_ID:v1"
+	apiORDID       = "ns:apiResource:API_ID:v1"
+	tombstoneORDID = "ns:apiResource:API_ID2:v1"
+	errMsg         = "invalid documents"
+	testErr        = "test error"
+)
+
+var (
+	startedAt  = time.Date(2022, 9, 26, 12, 0, 0, 0, time.UTC)
+	finishedAt = startedAt.Add(time.Second)
+)
+
+func fixReportModel() *model.ORDAggregationReport {
+	return &model.ORDAggregationReport{
+		ApplicationID:    appID,
+		Status:           model.ORDAggregationStatusFailed,
+		Error:            str.Ptr(errMsg),
+		DocumentsFetched: 1,
+		AcceptedResources: []*model.ORDReportedResource{
+			{Type: model.ORDResourceTypePackage, OrdID: packageORDID},
+		},
+		RejectedResources: []*model.ORDRejectedResource{
+			{Type: model.ORDResourceTypeAPI, OrdID: apiORDID, Rule: "validation_required", FieldPath: "partOfPackage", Message: "cannot be blank"},
+			{Type: model.ORDResourceTypeDocument, Rule: "validation_invalid", Message: "invalid document"},
+		},
+		AppliedTombstones: []string{tombstoneORDID},
+		StartedAt:         startedAt,
+		FinishedAt:        finishedAt,
+	}
+}
+
+func fixReportGraphQL() *graphql.ORDAggregationReport {
+	return &graphql.ORDAggregationReport{
+		Status:           graphql.ORDAggregationStatusFailed,
+		Error:            str.Ptr(errMsg),
+		DocumentsFetched: 1,
+		AcceptedResources: []*graphql.ORDReportedResource{
+			{Type: graphql.ORDResourceTypePackage, OrdID: packageORDID},
+		},
+		RejectedResources: []*graphql.ORDRejectedResource{
+			{Type: graphql.ORDResourceTypeAPI, OrdID: str.Ptr(apiORDID), Rule: "validation_required", FieldPath: str.Ptr("partOfPackage"), Message: "cannot be blank"},
+			{Type: graphql.ORDResourceTypeDocument, Rule: "validation_invalid", Message: "invalid document"},
+		},
+		AppliedTombstones: []string{tombstoneORDID},
+		StartedAt:         graphql.Timestamp(startedAt),
+		FinishedAt:        graphql.Timestamp(finishedAt),
+	}
+}
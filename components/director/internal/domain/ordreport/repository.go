@@ -0,0 +1,139 @@
+package ordreport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	selectedColumns = "app_id, status, error, documents_fetched, accepted_resources, rejected_resources, applied_tombstones, started_at, finished_at"
+
+	upsertQuery = `INSERT INTO public.ord_aggregation_reports (` + selectedColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (app_id) DO UPDATE SET status = EXCLUDED.status, error = EXCLUDED.error, documents_fetched = EXCLUDED.documents_fetched,
+			accepted_resources = EXCLUDED.accepted_resources, rejected_resources = EXCLUDED.rejected_resources, applied_tombstones = EXCLUDED.applied_tombstones,
+			started_at = EXCLUDED.started_at, finished_at = EXCLUDED.finished_at`
+
+	getByApplicationIDQuery = `SELECT ` + selectedColumns + ` FROM public.ord_aggregation_reports WHERE app_id = $1`
+)
+
+type repository struct{}
+
+// NewRepository creates a new repository of the ORD aggregation reports
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// Upsert stores the report as the report of the latest aggregation of its application
+func (r *repository) Upsert(ctx context.Context, item *model.ORDAggregationReport) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	ent, err := toEntity(item)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", upsertQuery)
+	_, err = persist.ExecContext(ctx, upsertQuery, ent.ApplicationID, ent.Status, ent.Error, ent.DocumentsFetched,
+		ent.AcceptedResources, ent.RejectedResources, ent.AppliedTombstones, ent.StartedAt, ent.FinishedAt)
+	return persistence.MapSQLError(ctx, err, resource.ORDAggregationReport, resource.Upsert, "while upserting the ORD aggregation report of app with ID %s", item.ApplicationID)
+}
+
+// GetByApplicationID returns the report of the latest aggregation of the application or nil if the application was never aggregated
+func (r *repository) GetByApplicationID(ctx context.Context, appID string) (*model.ORDAggregationReport, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var ent Entity
+	log.C(ctx).Debugf("Executing DB query: %s", getByApplicationIDQuery)
+	if err = persist.GetContext(ctx, &ent, getByApplicationIDQuery, appID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, persistence.MapSQLError(ctx, err, resource.ORDAggregationReport, resource.Get, "while getting the ORD aggregation report of app with ID %s", appID)
+	}
+
+	return fromEntity(ent)
+}
+
+func toEntity(in *model.ORDAggregationReport) (Entity, error) {
+	accepted := in.AcceptedResources
+	if accepted == nil {
+		accepted = []*model.ORDReportedResource{}
+	}
+	acceptedJSON, err := json.Marshal(accepted)
+	if err != nil {
+		return Entity{}, errors.Wrapf(err, "while marshalling accepted resources of the ORD aggregation report of app with ID %s", in.ApplicationID)
+	}
+
+	rejected := in.RejectedResources
+	if rejected == nil {
+		rejected = []*model.ORDRejectedResource{}
+	}
+	rejectedJSON, err := json.Marshal(rejected)
+	if err != nil {
+		return Entity{}, errors.Wrapf(err, "while marshalling rejected resources of the ORD aggregation report of app with ID %s", in.ApplicationID)
+	}
+
+	tombstones := in.AppliedTombstones
+	if tombstones == nil {
+		tombstones = []string{}
+	}
+	tombstonesJSON, err := json.Marshal(tombstones)
+	if err != nil {
+		return Entity{}, errors.Wrapf(err, "while marshalling applied tombstones of the ORD aggregation report of app with ID %s", in.ApplicationID)
+	}
+
+	return Entity{
+		ApplicationID:     in.ApplicationID,
+		Status:            string(in.Status),
+		Error:             repo.NewNullableString(in.Error),
+		DocumentsFetched:  in.DocumentsFetched,
+		AcceptedResources: string(acceptedJSON),
+		RejectedResources: string(rejectedJSON),
+		AppliedTombstones: string(tombstonesJSON),
+		StartedAt:         in.StartedAt,
+		FinishedAt:        in.FinishedAt,
+	}, nil
+}
+
+func fromEntity(ent Entity) (*model.ORDAggregationReport, error) {
+	var accepted []*model.ORDReportedResource
+	if err := json.Unmarshal([]byte(ent.AcceptedResources), &accepted); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling accepted resources of the ORD aggregation report of app with ID %s", ent.ApplicationID)
+	}
+
+	var rejected []*model.ORDRejectedResource
+	if err := json.Unmarshal([]byte(ent.RejectedResources), &rejected); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling rejected resources of the ORD aggregation report of app with ID %s", ent.ApplicationID)
+	}
+
+	var tombstones []string
+	if err := json.Unmarshal([]byte(ent.AppliedTombstones), &tombstones); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling applied tombstones of the ORD aggregation report of app with ID %s", ent.ApplicationID)
+	}
+
+	return &model.ORDAggregationReport{
+		ApplicationID:     ent.ApplicationID,
+		Status:            model.ORDAggregationStatus(ent.Status),
+		Error:             repo.StringPtrFromNullableString(ent.Error),
+		DocumentsFetched:  ent.DocumentsFetched,
+		AcceptedResources: accepted,
+		RejectedResources: rejected,
+		AppliedTombstones: tombstones,
+		StartedAt:         ent.StartedAt,
+		FinishedAt:        ent.FinishedAt,
+	}, nil
+}
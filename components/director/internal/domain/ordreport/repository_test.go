@@ -0,0 +1,178 @@
+package ordreport_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	columns           = []string{"app_id", "status", "error", "documents_fetched", "accepted_resources", "rejected_resources", "applied_tombstones", "started_at", "finished_at"}
+	acceptedJSON      = `[{"type":"PACKAGE","ord_id":"` + packageORDID + `"}]`
+	rejectedJSON      = `[{"type":"API","ord_id":"` + apiORDID + `","rule":"validation_required","field_path":"partOfPackage","message":"cannot be blank"},{"type":"DOCUMENT","rule":"validation_invalid","message":"invalid document"}]`
+	appliedTombstones = `["` + tombstoneORDID + `"]`
+)
+
+func TestRepository_Upsert(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO public.ord_aggregation_reports (app_id, status, error, documents_fetched, accepted_resources, rejected_resources, applied_tombstones, started_at, finished_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (app_id) DO UPDATE SET status = EXCLUDED.status, error = EXCLUDED.error, documents_fetched = EXCLUDED.documents_fetched,
+			accepted_resources = EXCLUDED.accepted_resources, rejected_resources = EXCLUDED.rejected_resources, applied_tombstones = EXCLUDED.applied_tombstones,
+			started_at = EXCLUDED.started_at, finished_at = EXCLUDED.finished_at`)
+	args := []driver.Value{appID, "FAILED", sql.NullString{String: errMsg, Valid: true}, 1, acceptedJSON, rejectedJSON, appliedTombstones, startedAt, finishedAt}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := ordreport.NewRepository().Upsert(ctx, fixReportModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Success stores empty lists for a report without resources", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(appID, "FAILED", sql.NullString{String: errMsg, Valid: true}, 0, "[]", "[]", "[]", startedAt, finishedAt).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		report := fixReportModel()
+		report.DocumentsFetched = 0
+		report.AcceptedResources = nil
+		report.RejectedResources = nil
+		report.AppliedTombstones = nil
+
+		// WHEN
+		err := ordreport.NewRepository().Upsert(ctx, report)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when upsert fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := ordreport.NewRepository().Upsert(ctx, fixReportModel())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		err := ordreport.NewRepository().Upsert(context.TODO(), fixReportModel())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_GetByApplicationID(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT app_id, status, error, documents_fetched, accepted_resources, rejected_resources, applied_tombstones, started_at, finished_at FROM public.ord_aggregation_reports WHERE app_id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(appID, "FAILED", errMsg, 1, acceptedJSON, rejectedJSON, appliedTombstones, startedAt, finishedAt)
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		report, err := ordreport.NewRepository().GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixReportModel(), report)
+	})
+
+	t.Run("Returns nil when the application was never aggregated", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnRows(sqlmock.NewRows(columns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		report, err := ordreport.NewRepository().GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Error when select fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := ordreport.NewRepository().GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when the stored resources are malformed", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(appID, "FAILED", errMsg, 1, "{", rejectedJSON, appliedTombstones, startedAt, finishedAt)
+		dbMock.ExpectQuery(query).WithArgs(appID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := ordreport.NewRepository().GetByApplicationID(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling accepted resources")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		_, err := ordreport.NewRepository().GetByApplicationID(context.TODO(), appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
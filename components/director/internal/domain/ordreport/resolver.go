@@ -0,0 +1,66 @@
+package ordreport
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// ORDAggregationReportService is responsible for the service-layer ORD aggregation report operations needed by the resolver
+//go:generate mockery --name=ORDAggregationReportService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDAggregationReportService interface {
+	GetForApplication(ctx context.Context, appID string) (*model.ORDAggregationReport, error)
+}
+
+// ORDAggregationReportConverter converts ORD aggregation reports between the model.ORDAggregationReport service-layer representation and the graphql-layer representation
+//go:generate mockery --name=ORDAggregationReportConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDAggregationReportConverter interface {
+	ToGraphQL(in *model.ORDAggregationReport) *graphql.ORDAggregationReport
+}
+
+// Resolver is an object responsible for resolver-layer ORD aggregation report operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      ORDAggregationReportService
+	conv     ORDAggregationReportConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer ORD aggregation report operations
+func NewResolver(transact persistence.Transactioner, svc ORDAggregationReportService, conv ORDAggregationReportConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// ApplicationORDAggregationReport returns the report of the latest ORD aggregation of the given Application or nil if it was never aggregated
+func (r *Resolver) ApplicationORDAggregationReport(ctx context.Context, obj *graphql.Application) (*graphql.ORDAggregationReport, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	report, err := r.svc.GetForApplication(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while getting the ORD aggregation report of Application with ID %s: %v", obj.ID, err)
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(report), nil
+}
@@ -0,0 +1,126 @@
+package ordreport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ApplicationORDAggregationReport(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testError := errors.New(testErr)
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.ORDAggregationReportService
+		ConverterFn    func() *automock.ORDAggregationReportConverter
+		ExpectedResult *graphql.ORDAggregationReport
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ORDAggregationReportService {
+				svc := &automock.ORDAggregationReportService{}
+				svc.On("GetForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixReportModel(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ORDAggregationReportConverter {
+				conv := &automock.ORDAggregationReportConverter{}
+				conv.On("ToGraphQL", fixReportModel()).Return(fixReportGraphQL()).Once()
+				return conv
+			},
+			ExpectedResult: fixReportGraphQL(),
+		},
+		{
+			Name: "Success when the Application was never aggregated",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ORDAggregationReportService {
+				svc := &automock.ORDAggregationReportService{}
+				svc.On("GetForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ORDAggregationReportConverter {
+				conv := &automock.ORDAggregationReportConverter{}
+				conv.On("ToGraphQL", (*model.ORDAggregationReport)(nil)).Return(nil).Once()
+				return conv
+			},
+		},
+		{
+			Name: "Returns error when getting the report fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ORDAggregationReportService {
+				svc := &automock.ORDAggregationReportService{}
+				svc.On("GetForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, testError).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ORDAggregationReportConverter { return &automock.ORDAggregationReportConverter{} },
+			ExpectedErr: testError,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   func() *automock.ORDAggregationReportService { return &automock.ORDAggregationReportService{} },
+			ConverterFn: func() *automock.ORDAggregationReportConverter { return &automock.ORDAggregationReportConverter{} },
+			ExpectedErr: testError,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ORDAggregationReportService {
+				svc := &automock.ORDAggregationReportService{}
+				svc.On("GetForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixReportModel(), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ORDAggregationReportConverter { return &automock.ORDAggregationReportConverter{} },
+			ExpectedErr: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordreport.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ApplicationORDAggregationReport(ctx, app)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		// WHEN
+		_, err := ordreport.NewResolver(nil, nil, nil).ApplicationORDAggregationReport(ctx, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
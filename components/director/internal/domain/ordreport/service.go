@@ -0,0 +1,46 @@
+package ordreport
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// ORDAggregationReportRepository is responsible for the repo-layer ORD aggregation report operations
+//go:generate mockery --name=ORDAggregationReportRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDAggregationReportRepository interface {
+	Upsert(ctx context.Context, item *model.ORDAggregationReport) error
+	GetByApplicationID(ctx context.Context, appID string) (*model.ORDAggregationReport, error)
+}
+
+type service struct {
+	repo ORDAggregationReportRepository
+}
+
+// NewService creates a new service which stores the reports of the ORD aggregations
+func NewService(repo ORDAggregationReportRepository) *service {
+	return &service{
+		repo: repo,
+	}
+}
+
+// Upsert stores the report as the report of the latest aggregation of its application, replacing the previous one
+func (s *service) Upsert(ctx context.Context, report *model.ORDAggregationReport) error {
+	if err := s.repo.Upsert(ctx, report); err != nil {
+		return errors.Wrapf(err, "while storing the ORD aggregation report of app with ID %s", report.ApplicationID)
+	}
+
+	return nil
+}
+
+// GetForApplication returns the report of the latest aggregation of the application or nil if the application was never aggregated.
+// It does not check the visibility of the application in the tenant of the caller, so it is meant to be used for applications which are already loaded for the caller.
+func (s *service) GetForApplication(ctx context.Context, appID string) (*model.ORDAggregationReport, error) {
+	report, err := s.repo.GetByApplicationID(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting the ORD aggregation report of app with ID %s", appID)
+	}
+
+	return report, nil
+}
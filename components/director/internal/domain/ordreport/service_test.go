@@ -0,0 +1,76 @@
+package ordreport_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Upsert(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	report := fixReportModel()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ORDAggregationReportRepository{}
+		repo.On("Upsert", ctx, report).Return(nil).Once()
+		defer mock.AssertExpectationsForObjects(t, repo)
+
+		// WHEN
+		err := ordreport.NewService(repo).Upsert(ctx, report)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when storing the report fails", func(t *testing.T) {
+		repo := &automock.ORDAggregationReportRepository{}
+		repo.On("Upsert", ctx, report).Return(errors.New(testErr)).Once()
+		defer mock.AssertExpectationsForObjects(t, repo)
+
+		// WHEN
+		err := ordreport.NewService(repo).Upsert(ctx, report)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr)
+	})
+}
+
+func TestService_GetForApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	report := fixReportModel()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ORDAggregationReportRepository{}
+		repo.On("GetByApplicationID", ctx, appID).Return(report, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, repo)
+
+		// WHEN
+		result, err := ordreport.NewService(repo).GetForApplication(ctx, appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, report, result)
+	})
+
+	t.Run("Error when getting the report fails", func(t *testing.T) {
+		repo := &automock.ORDAggregationReportRepository{}
+		repo.On("GetByApplicationID", ctx, appID).Return(nil, errors.New(testErr)).Once()
+		defer mock.AssertExpectationsForObjects(t, repo)
+
+		// WHEN
+		_, err := ordreport.NewService(repo).GetForApplication(ctx, appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr)
+	})
+}
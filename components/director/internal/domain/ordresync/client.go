@@ -0,0 +1,72 @@
+package ordresync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/pkg/errors"
+)
+
+// Config is the configuration needed for the on-demand ORD aggregation API
+type Config struct {
+	AggregatorURL string `envconfig:"optional,APP_ORD_AGGREGATOR_URL"`
+}
+
+// Client is responsible for making HTTP requests.
+//go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Client interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Aggregator calls the ORD aggregator API which queues a single application for immediate aggregation and reports the outcome of the job.
+//go:generate mockery --name=Aggregator --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Aggregator interface {
+	Resync(ctx context.Context, appID string) (*ord.AggregationJob, error)
+}
+
+type aggregator struct {
+	client        Client
+	aggregatorURL string
+}
+
+// NewAggregator returns object responsible for on-demand ORD aggregation of single applications
+func NewAggregator(client Client, config Config) Aggregator {
+	return &aggregator{
+		client:        client,
+		aggregatorURL: config.AggregatorURL,
+	}
+}
+
+// Resync queues the application for aggregation and returns the job, which may still be queued or running if it did not finish before the ORD aggregator responded.
+func (a *aggregator) Resync(ctx context.Context, appID string) (*ord.AggregationJob, error) {
+	if a.aggregatorURL == "" {
+		return nil, apperrors.NewInvalidOperationError("on-demand Open Resource Discovery aggregation is not enabled")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/%s", a.aggregatorURL, appID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while calling ORD aggregator API")
+	}
+	defer httputils.Close(ctx, resp.Body)
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return nil, errors.Errorf("received status code %d when trying to aggregate ORD documents of application with ID %s", resp.StatusCode, appID)
+	}
+
+	job := &ord.AggregationJob{}
+	if err := json.NewDecoder(resp.Body).Decode(job); err != nil {
+		return nil, errors.Wrap(err, "while decoding ORD aggregator response")
+	}
+
+	return job, nil
+}
@@ -0,0 +1,122 @@
+package ordresync_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync/automock"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	aggregatorURL = "https://compass-gateway-internal.kyma.local/ord-aggregator/v1/aggregate"
+	appID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+)
+
+func TestAggregator_Resync(t *testing.T) {
+	testErr := errors.New("error")
+
+	responseWithBody := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+		}
+	}
+
+	testCases := []struct {
+		Name             string
+		AggregatorURL    string
+		Client           func() *automock.Client
+		ExpectedJob      *ord.AggregationJob
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:          "Success when the job finished",
+			AggregatorURL: aggregatorURL,
+			Client: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+					return req.Method == http.MethodPost && req.URL.String() == fmt.Sprintf("%s/%s", aggregatorURL, appID)
+				})).Return(responseWithBody(http.StatusOK, fmt.Sprintf(`{"applicationID":%q,"status":"FAILED","error":"test error"}`, appID)), nil).Once()
+				return client
+			},
+			ExpectedJob: &ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusFailed, Error: "test error"},
+		},
+		{
+			Name:          "Success when the job is still running",
+			AggregatorURL: aggregatorURL,
+			Client: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(responseWithBody(http.StatusAccepted, fmt.Sprintf(`{"applicationID":%q,"status":"RUNNING"}`, appID)), nil).Once()
+				return client
+			},
+			ExpectedJob: &ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusRunning},
+		},
+		{
+			Name:             "Error when on-demand aggregation is not enabled",
+			Client:           func() *automock.Client { return &automock.Client{} },
+			ExpectedErrorMsg: "on-demand Open Resource Discovery aggregation is not enabled",
+		},
+		{
+			Name:          "Error when cannot make the request",
+			AggregatorURL: aggregatorURL,
+			Client: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(nil, testErr).Once()
+				return client
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:          "Error when status code is not 200 or 202",
+			AggregatorURL: aggregatorURL,
+			Client: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(responseWithBody(http.StatusServiceUnavailable, ""), nil).Once()
+				return client
+			},
+			ExpectedErrorMsg: fmt.Sprintf("received status code %d when trying to aggregate ORD documents of application with ID %s", http.StatusServiceUnavailable, appID),
+		},
+		{
+			Name:          "Error when the response cannot be decoded",
+			AggregatorURL: aggregatorURL,
+			Client: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("Do", mock.Anything).Return(responseWithBody(http.StatusOK, "invalid"), nil).Once()
+				return client
+			},
+			ExpectedErrorMsg: "while decoding ORD aggregator response",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			httpClient := testCase.Client()
+			aggregator := ordresync.NewAggregator(httpClient, ordresync.Config{AggregatorURL: testCase.AggregatorURL})
+
+			// WHEN
+			job, err := aggregator.Resync(context.TODO(), appID)
+
+			// THEN
+			if len(testCase.ExpectedErrorMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+				assert.Nil(t, job)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedJob, job)
+			}
+
+			httpClient.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package ordresync
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// aggregationFailedMessage is reported to the callers instead of the error of a failed aggregation, which may contain internal details
+const aggregationFailedMessage = "aggregation of the Open Resource Discovery documents of the application failed"
+
+// ApplicationService is responsible for the service-layer Application operations needed by the resolver
+//go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationService interface {
+	Exist(ctx context.Context, id string) (bool, error)
+}
+
+// Resolver is an object responsible for resolver-layer on-demand ORD aggregation operations
+type Resolver struct {
+	transact   persistence.Transactioner
+	appSvc     ApplicationService
+	aggregator Aggregator
+}
+
+// NewResolver returns a new object responsible for resolver-layer on-demand ORD aggregation operations
+func NewResolver(transact persistence.Transactioner, appSvc ApplicationService, aggregator Aggregator) *Resolver {
+	return &Resolver{
+		transact:   transact,
+		appSvc:     appSvc,
+		aggregator: aggregator,
+	}
+}
+
+// ResyncOpenResourceDiscovery queues the application for immediate aggregation of its ORD documents and returns the outcome of the aggregation
+func (r *Resolver) ResyncOpenResourceDiscovery(ctx context.Context, applicationID string) (*graphql.OpenResourceDiscoveryResync, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	exists, err := r.appSvc.Exist(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apperrors.NewNotFoundError(resource.Application, applicationID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	job, err := r.aggregator.Resync(ctx, applicationID)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while aggregating ORD documents of application with ID %s: %v", applicationID, err)
+		return nil, err
+	}
+
+	result := &graphql.OpenResourceDiscoveryResync{
+		ApplicationID: job.ApplicationID,
+		Status:        graphql.OpenResourceDiscoveryResyncStatus(job.Status),
+	}
+	if job.Error != "" {
+		log.C(ctx).Errorf("Aggregation of ORD documents of application with ID %s failed: %s", applicationID, job.Error)
+		result.Error = str.Ptr(aggregationFailedMessage)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package ordresync_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync/automock"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ResyncOpenResourceDiscovery(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name             string
+		TxFn             func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppSvcFn         func() *automock.ApplicationService
+		AggregatorFn     func() *automock.Aggregator
+		ExpectedResult   *graphql.OpenResourceDiscoveryResync
+		ExpectedErrorMsg string
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(true, nil).Once()
+				return appSvc
+			},
+			AggregatorFn: func() *automock.Aggregator {
+				aggregator := &automock.Aggregator{}
+				aggregator.On("Resync", mock.Anything, appID).Return(&ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusSucceeded}, nil).Once()
+				return aggregator
+			},
+			ExpectedResult: &graphql.OpenResourceDiscoveryResync{ApplicationID: appID, Status: graphql.OpenResourceDiscoveryResyncStatusSucceeded},
+		},
+		{
+			Name: "Success when the aggregation failed",
+			TxFn: txGen.ThatSucceeds,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(true, nil).Once()
+				return appSvc
+			},
+			AggregatorFn: func() *automock.Aggregator {
+				aggregator := &automock.Aggregator{}
+				aggregator.On("Resync", mock.Anything, appID).Return(&ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusFailed, Error: testErr.Error()}, nil).Once()
+				return aggregator
+			},
+			ExpectedResult: &graphql.OpenResourceDiscoveryResync{ApplicationID: appID, Status: graphql.OpenResourceDiscoveryResyncStatusFailed, Error: str.Ptr("aggregation of the Open Resource Discovery documents of the application failed")},
+		},
+		{
+			Name:             "Returns error when transaction begin fails",
+			TxFn:             txGen.ThatFailsOnBegin,
+			AppSvcFn:         func() *automock.ApplicationService { return &automock.ApplicationService{} },
+			AggregatorFn:     func() *automock.Aggregator { return &automock.Aggregator{} },
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "Returns error when checking application existence fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(false, testErr).Once()
+				return appSvc
+			},
+			AggregatorFn:     func() *automock.Aggregator { return &automock.Aggregator{} },
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "Returns not found error when application does not exist",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(false, nil).Once()
+				return appSvc
+			},
+			AggregatorFn:     func() *automock.Aggregator { return &automock.Aggregator{} },
+			ExpectedErrorMsg: "Object not found",
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(true, nil).Once()
+				return appSvc
+			},
+			AggregatorFn:     func() *automock.Aggregator { return &automock.Aggregator{} },
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "Returns error when the aggregation cannot be scheduled",
+			TxFn: txGen.ThatSucceeds,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Exist", txtest.CtxWithDBMatcher(), appID).Return(true, nil).Once()
+				return appSvc
+			},
+			AggregatorFn: func() *automock.Aggregator {
+				aggregator := &automock.Aggregator{}
+				aggregator.On("Resync", mock.Anything, appID).Return(nil, testErr).Once()
+				return aggregator
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appSvc := testCase.AppSvcFn()
+			aggregator := testCase.AggregatorFn()
+
+			resolver := ordresync.NewResolver(transact, appSvc, aggregator)
+
+			// WHEN
+			result, err := resolver.ResyncOpenResourceDiscovery(ctx, appID)
+
+			// THEN
+			if len(testCase.ExpectedErrorMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, appSvc, aggregator)
+		})
+	}
+}
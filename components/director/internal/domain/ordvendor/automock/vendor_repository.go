@@ -162,6 +162,29 @@ func (_m *VendorRepository) ListByApplicationID(ctx context.Context, tenantID st
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *VendorRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Vendor, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 []*model.Vendor
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Vendor); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Vendor)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGlobal provides a mock function with given fields: ctx
 func (_m *VendorRepository) ListGlobal(ctx context.Context) ([]*model.Vendor, error) {
 	ret := _m.Called(ctx)
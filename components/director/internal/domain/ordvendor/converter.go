@@ -5,6 +5,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 type converter struct {
@@ -52,3 +53,33 @@ func (c *converter) FromEntity(entity *Entity) (*model.Vendor, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer Vendor model to a graphql Vendor
+func (c *converter) ToGraphQL(in *model.Vendor) *graphql.Vendor {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Vendor{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		ApplicationID:       in.ApplicationID,
+		Title:               in.Title,
+		Partners:            graphql.NewJSONFromRawMessage(in.Partners),
+		Labels:              graphql.NewJSONFromRawMessage(in.Labels),
+		DocumentationLabels: graphql.NewJSONFromRawMessage(in.DocumentationLabels),
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer Vendor models to graphql Vendors
+func (c *converter) MultipleToGraphQL(in []*model.Vendor) []*graphql.Vendor {
+	out := make([]*graphql.Vendor, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(item))
+	}
+
+	return out
+}
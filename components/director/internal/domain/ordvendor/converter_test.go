@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,29 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordvendor.NewConverter()
+
+		gql := conv.ToGraphQL(fixVendorModel())
+
+		assert.Equal(t, fixGQLVendor(), gql)
+	})
+
+	t.Run("Returns nil if Vendor model is nil", func(t *testing.T) {
+		conv := ordvendor.NewConverter()
+
+		gql := conv.ToGraphQL(nil)
+
+		require.Nil(t, gql)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := ordvendor.NewConverter()
+
+	gqls := conv.MultipleToGraphQL([]*model.Vendor{fixVendorModel(), nil})
+
+	assert.Equal(t, []*graphql.Vendor{fixGQLVendor()}, gqls)
+}
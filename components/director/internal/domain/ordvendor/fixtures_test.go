@@ -10,6 +10,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -109,3 +110,20 @@ func fixVendorRowWithTitle(title string) []driver.Value {
 func fixVendorUpdateArgs() []driver.Value {
 	return []driver.Value{"title", repo.NewValidNullableString("{}"), repo.NewValidNullableString(partners), repo.NewValidNullableString("[]")}
 }
+
+func fixGQLVendor() *graphql.Vendor {
+	return &graphql.Vendor{
+		ID:                  vendorID,
+		OrdID:               ordID,
+		ApplicationID:       str.Ptr(appID),
+		Title:               "title",
+		Partners:            jsonPtr(partners),
+		Labels:              jsonPtr("{}"),
+		DocumentationLabels: jsonPtr("[]"),
+	}
+}
+
+func jsonPtr(in string) *graphql.JSON {
+	out := graphql.JSON(in)
+	return &out
+}
@@ -179,6 +179,23 @@ func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.Vendor, error)
 	return vendors, nil
 }
 
+// ListByApplicationIDs gets all Vendors for the given application ids
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Vendor, error) {
+	vendorCollection := vendorCollection{}
+	if err := r.lister.List(ctx, resource.Vendor, tenantID, &vendorCollection, repo.NewInConditionForStringValues("app_id", appIDs)); err != nil {
+		return nil, err
+	}
+	vendors := make([]*model.Vendor, 0, vendorCollection.Len())
+	for _, vendor := range vendorCollection {
+		vendorModel, err := r.conv.FromEntity(&vendor)
+		if err != nil {
+			return nil, err
+		}
+		vendors = append(vendors, vendorModel)
+	}
+	return vendors, nil
+}
+
 type vendorCollection []Entity
 
 // Len missing godoc
@@ -283,6 +283,35 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Vendors",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT ord_id, app_id, title, labels, partners, id, documentation_labels FROM public.vendors WHERE app_id IN ($1) AND (id IN (SELECT id FROM vendors_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixVendorColumns()).AddRow(fixVendorRowWithTitle("title1")...).AddRow(fixVendorRowWithTitle("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixVendorColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   ordvendor.NewRepository,
+		ExpectedModelEntities: []interface{}{fixVendorModelWithTitle("title1"), fixVendorModelWithTitle("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityVendorWithTitle("title1"), fixEntityVendorWithTitle("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+}
+
 func TestPgRepository_ListGlobal(t *testing.T) {
 	suite := testdb.RepoListTestSuite{
 		Name: "List Global Vendors",
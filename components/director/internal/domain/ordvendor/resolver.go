@@ -0,0 +1,121 @@
+package ordvendor
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// VendorService is responsible for the service-layer Vendor operations needed by the resolver
+//go:generate mockery --name=VendorService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type VendorService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Vendor, error)
+	ListGlobal(ctx context.Context) ([]*model.Vendor, error)
+}
+
+// VendorConverter converts Vendors between the model.Vendor service-layer representation and the graphql-layer representation
+//go:generate mockery --name=VendorConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type VendorConverter interface {
+	MultipleToGraphQL(in []*model.Vendor) []*graphql.Vendor
+}
+
+// Resolver is an object responsible for resolver-layer Vendor operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      VendorService
+	conv     VendorConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Vendor operations
+func NewResolver(transact persistence.Transactioner, svc VendorService, conv VendorConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Vendors returns the Vendors of the given Application. The Vendors of all Applications in the query are loaded in a single batch
+func (r *Resolver) Vendors(ctx context.Context, obj *graphql.Application) ([]*graphql.Vendor, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamVendor{ID: obj.ID, Ctx: ctx}
+	return dataloader.VendorFor(ctx).VendorByID.Load(param)
+}
+
+// VendorsDataLoader loads the Vendors of all requested Applications and returns them in the order of the keys
+func (r *Resolver) VendorsDataLoader(keys []dataloader.ParamVendor) ([][]*graphql.Vendor, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	vendors, err := r.svc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	vendorsByAppID := make(map[string][]*graphql.Vendor, len(keys))
+	for _, vendor := range r.conv.MultipleToGraphQL(vendors) {
+		if vendor.ApplicationID == nil {
+			continue
+		}
+		vendorsByAppID[*vendor.ApplicationID] = append(vendorsByAppID[*vendor.ApplicationID], vendor)
+	}
+
+	gqlVendors := make([][]*graphql.Vendor, 0, len(keys))
+	for _, key := range keys {
+		if vendors, ok := vendorsByAppID[key.ID]; ok {
+			gqlVendors = append(gqlVendors, vendors)
+			continue
+		}
+		gqlVendors = append(gqlVendors, []*graphql.Vendor{})
+	}
+
+	return gqlVendors, nil
+}
+
+// GlobalVendors returns the Vendors which are not owned by any Application
+func (r *Resolver) GlobalVendors(ctx context.Context) ([]*graphql.Vendor, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	vendors, err := r.svc.ListGlobal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(vendors), nil
+}
@@ -0,0 +1,230 @@
+package ordvendor_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Vendors(t *testing.T) {
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := ordvendor.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Vendors(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_VendorsDataLoader(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondAppID := "secondAppID"
+	keys := []dataloader.ParamVendor{{ID: appID, Ctx: ctx}, {ID: secondAppID, Ctx: ctx}}
+	appIDs := []string{appID, secondAppID}
+
+	models := []*model.Vendor{fixVendorModel()}
+	gqls := []*graphql.Vendor{fixGQLVendor()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.VendorService
+		ConverterFn    func() *automock.VendorConverter
+		ExpectedResult [][]*graphql.Vendor
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				conv := &automock.VendorConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Vendor{gqls, {}},
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedVendorService,
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordvendor.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.VendorsDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := ordvendor.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, errs := resolver.VendorsDataLoader([]dataloader.ParamVendor{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+	})
+}
+
+func TestResolver_GlobalVendors(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	models := []*model.Vendor{fixGlobalVendorModel()}
+	gqls := []*graphql.Vendor{fixGQLVendor()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.VendorService
+		ConverterFn    func() *automock.VendorConverter
+		ExpectedResult []*graphql.Vendor
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				conv := &automock.VendorConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: gqls,
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedVendorService,
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedVendorConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordvendor.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.GlobalVendors(ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func unusedVendorService() *automock.VendorService {
+	return &automock.VendorService{}
+}
+
+func unusedVendorConverter() *automock.VendorConverter {
+	return &automock.VendorConverter{}
+}
@@ -22,6 +22,7 @@ type VendorRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Vendor, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Vendor, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Vendor, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Vendor, error)
 	ListGlobal(ctx context.Context) ([]*model.Vendor, error)
 }
 
@@ -175,6 +176,16 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 	return s.vendorRepo.ListByApplicationID(ctx, tnt, appID)
 }
 
+// ListByApplicationIDs returns the Vendors of all given Applications.
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Vendor, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.vendorRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+}
+
 // ListGlobal returns a list of Global Vendors (with NULL app_id).
 func (s *service) ListGlobal(ctx context.Context) ([]*model.Vendor, error) {
 	return s.vendorRepo.ListGlobal(ctx)
@@ -111,6 +111,29 @@ func (_m *PackageRepository) ListByApplicationID(ctx context.Context, tenantID s
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *PackageRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 []*model.Package
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Package); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Package)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, tenant, item
 func (_m *PackageRepository) Update(ctx context.Context, tenant string, item *model.Package) error {
 	ret := _m.Called(ctx, tenant, item)
@@ -5,6 +5,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 type converter struct {
@@ -82,3 +83,47 @@ func (c *converter) FromEntity(entity *Entity) (*model.Package, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer Package model to a graphql Package
+func (c *converter) ToGraphQL(in *model.Package) *graphql.Package {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Package{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		ApplicationID:       in.ApplicationID,
+		Vendor:              in.Vendor,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Description:         in.Description,
+		Version:             in.Version,
+		PackageLinks:        graphql.NewJSONFromRawMessage(in.PackageLinks),
+		Links:               graphql.NewJSONFromRawMessage(in.Links),
+		LicenseType:         in.LicenseType,
+		SupportInfo:         in.SupportInfo,
+		Tags:                graphql.NewJSONFromRawMessage(in.Tags),
+		Countries:           graphql.NewJSONFromRawMessage(in.Countries),
+		Labels:              graphql.NewJSONFromRawMessage(in.Labels),
+		PolicyLevel:         in.PolicyLevel,
+		CustomPolicyLevel:   in.CustomPolicyLevel,
+		PartOfProducts:      graphql.NewJSONFromRawMessage(in.PartOfProducts),
+		LineOfBusiness:      graphql.NewJSONFromRawMessage(in.LineOfBusiness),
+		Industry:            graphql.NewJSONFromRawMessage(in.Industry),
+		DocumentationLabels: graphql.NewJSONFromRawMessage(in.DocumentationLabels),
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer Package models to graphql Packages
+func (c *converter) MultipleToGraphQL(in []*model.Package) []*graphql.Package {
+	out := make([]*graphql.Package, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(item))
+	}
+
+	return out
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,29 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gql := conv.ToGraphQL(fixPackageModel())
+
+		assert.Equal(t, fixGQLPackage(), gql)
+	})
+
+	t.Run("Returns nil if Package model is nil", func(t *testing.T) {
+		conv := ordpackage.NewConverter()
+
+		gql := conv.ToGraphQL(nil)
+
+		require.Nil(t, gql)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := ordpackage.NewConverter()
+
+	gqls := conv.MultipleToGraphQL([]*model.Package{fixPackageModel(), nil})
+
+	assert.Equal(t, []*graphql.Package{fixGQLPackage()}, gqls)
+}
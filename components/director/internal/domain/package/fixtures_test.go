@@ -11,6 +11,7 @@ import (
 
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -139,3 +140,41 @@ func fixPackageUpdateArgs() []driver.Value {
 		"test", repo.NewValidNullableString("[]"), repo.NewValidNullableString("[]"), repo.NewValidNullableString("{}"), "test", nil, repo.NewValidNullableString("[\"test\"]"),
 		repo.NewValidNullableString("[]"), repo.NewValidNullableString("[]"), repo.NewValidNullableString(resourceHash), repo.NewValidNullableString("[]"), "support-info"}
 }
+
+func fixGQLPackage() *graphql.Package {
+	return fixGQLPackageWithTitle("title")
+}
+
+func fixGQLPackageWithTitle(title string) *graphql.Package {
+	vendorID := "vendorID"
+	licenceType := "test"
+	supportInfo := "support-info"
+	return &graphql.Package{
+		ID:                  packageID,
+		ApplicationID:       appID,
+		OrdID:               ordID,
+		Vendor:              &vendorID,
+		Title:               title,
+		ShortDescription:    "short desc",
+		Description:         "desc",
+		Version:             "v1.0.5",
+		PackageLinks:        jsonPtr("{}"),
+		Links:               jsonPtr("[]"),
+		LicenseType:         &licenceType,
+		SupportInfo:         &supportInfo,
+		Tags:                jsonPtr("[]"),
+		Countries:           jsonPtr("[]"),
+		Labels:              jsonPtr("{}"),
+		PolicyLevel:         "test",
+		CustomPolicyLevel:   nil,
+		PartOfProducts:      jsonPtr("[\"test\"]"),
+		LineOfBusiness:      jsonPtr("[]"),
+		Industry:            jsonPtr("[]"),
+		DocumentationLabels: jsonPtr("[]"),
+	}
+}
+
+func jsonPtr(in string) *graphql.JSON {
+	out := graphql.JSON(in)
+	return &out
+}
@@ -114,6 +114,23 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return pkgs, nil
 }
 
+// ListByApplicationIDs gets all Packages for the given application ids
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error) {
+	pkgCollection := pkgCollection{}
+	if err := r.lister.List(ctx, resource.Package, tenantID, &pkgCollection, repo.NewInConditionForStringValues("app_id", appIDs)); err != nil {
+		return nil, err
+	}
+	pkgs := make([]*model.Package, 0, pkgCollection.Len())
+	for _, pkg := range pkgCollection {
+		pkgModel, err := r.conv.FromEntity(&pkg)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkgModel)
+	}
+	return pkgs, nil
+}
+
 type pkgCollection []Entity
 
 // Len missing godoc
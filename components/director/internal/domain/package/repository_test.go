@@ -179,3 +179,32 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Packages",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, ord_id, vendor, title, short_description, description, version, package_links, links, licence_type, tags, countries, labels, policy_level, custom_policy_level, part_of_products, line_of_business, industry, resource_hash, documentation_labels, support_info FROM public.packages WHERE app_id IN ($1) AND (id IN (SELECT id FROM packages_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns()).AddRow(fixPackageRowWithTitle("title1")...).AddRow(fixPackageRowWithTitle("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixPackageColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   ordpackage.NewRepository,
+		ExpectedModelEntities: []interface{}{fixPackageModelWithTitle("title1"), fixPackageModelWithTitle("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityPackageWithTitle("title1"), fixEntityPackageWithTitle("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+}
@@ -0,0 +1,95 @@
+package ordpackage
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// PackageService is responsible for the service-layer Package operations needed by the resolver
+//go:generate mockery --name=PackageService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PackageService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Package, error)
+}
+
+// PackageConverter converts Packages between the model.Package service-layer representation and the graphql-layer representation
+//go:generate mockery --name=PackageConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type PackageConverter interface {
+	MultipleToGraphQL(in []*model.Package) []*graphql.Package
+}
+
+// Resolver is an object responsible for resolver-layer Package operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      PackageService
+	conv     PackageConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Package operations
+func NewResolver(transact persistence.Transactioner, svc PackageService, conv PackageConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Packages returns the Packages of the given Application. The Packages of all Applications in the query are loaded in a single batch
+func (r *Resolver) Packages(ctx context.Context, obj *graphql.Application) ([]*graphql.Package, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamPackage{ID: obj.ID, Ctx: ctx}
+	return dataloader.PackageFor(ctx).PackageByID.Load(param)
+}
+
+// PackagesDataLoader loads the Packages of all requested Applications and returns them in the order of the keys
+func (r *Resolver) PackagesDataLoader(keys []dataloader.ParamPackage) ([][]*graphql.Package, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgs, err := r.svc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	pkgsByAppID := make(map[string][]*graphql.Package, len(keys))
+	for _, pkg := range r.conv.MultipleToGraphQL(pkgs) {
+		pkgsByAppID[pkg.ApplicationID] = append(pkgsByAppID[pkg.ApplicationID], pkg)
+	}
+
+	gqlPackages := make([][]*graphql.Package, 0, len(keys))
+	for _, key := range keys {
+		if pkgs, ok := pkgsByAppID[key.ID]; ok {
+			gqlPackages = append(gqlPackages, pkgs)
+			continue
+		}
+		gqlPackages = append(gqlPackages, []*graphql.Package{})
+	}
+
+	return gqlPackages, nil
+}
@@ -0,0 +1,142 @@
+package ordpackage_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Packages(t *testing.T) {
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Packages(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_PackagesDataLoader(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondAppID := "secondAppID"
+	keys := []dataloader.ParamPackage{{ID: appID, Ctx: ctx}, {ID: secondAppID, Ctx: ctx}}
+	appIDs := []string{appID, secondAppID}
+
+	models := []*model.Package{fixPackageModel()}
+	gqls := []*graphql.Package{fixGQLPackage()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.PackageService
+		ConverterFn    func() *automock.PackageConverter
+		ExpectedResult [][]*graphql.Package
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Package{gqls, {}},
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedPackageConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedPackageService,
+			ConverterFn: unusedPackageConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedPackageConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordpackage.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.PackagesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := ordpackage.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, errs := resolver.PackagesDataLoader([]dataloader.ParamPackage{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+	})
+}
+
+func unusedPackageService() *automock.PackageService {
+	return &automock.PackageService{}
+}
+
+func unusedPackageConverter() *automock.PackageConverter {
+	return &automock.PackageConverter{}
+}
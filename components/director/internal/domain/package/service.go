@@ -18,6 +18,7 @@ type PackageRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Package, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Package, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Package, error)
 }
 
 // UIDService missing godoc
@@ -132,3 +133,13 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 
 	return s.pkgRepo.ListByApplicationID(ctx, tnt, appID)
 }
+
+// ListByApplicationIDs returns the Packages of all given Applications.
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Package, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pkgRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+}
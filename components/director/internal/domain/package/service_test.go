@@ -460,3 +460,75 @@ func TestService_ListByApplicationID(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
 	})
 }
+
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	pkgs := []*model.Package{
+		fixPackageModel(),
+		fixPackageModel(),
+		fixPackageModel(),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.PackageRepository
+		ExpectedResult     []*model.Package
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID}).Return(pkgs, nil).Once()
+				return repo
+			},
+			ExpectedResult:     pkgs,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Package listing failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := ordpackage.NewService(repo, nil)
+
+			// WHEN
+			docs, err := svc.ListByApplicationIDs(ctx, []string{appID})
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, docs)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := ordpackage.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
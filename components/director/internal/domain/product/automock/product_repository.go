@@ -162,6 +162,29 @@ func (_m *ProductRepository) ListByApplicationID(ctx context.Context, tenantID s
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *ProductRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Product, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 []*model.Product
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Product); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Product)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGlobal provides a mock function with given fields: ctx
 func (_m *ProductRepository) ListGlobal(ctx context.Context) ([]*model.Product, error) {
 	ret := _m.Called(ctx)
@@ -5,6 +5,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 type converter struct {
@@ -58,3 +59,36 @@ func (c *converter) FromEntity(entity *Entity) (*model.Product, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer Product model to a graphql Product
+func (c *converter) ToGraphQL(in *model.Product) *graphql.Product {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Product{
+		ID:                  in.ID,
+		OrdID:               in.OrdID,
+		ApplicationID:       in.ApplicationID,
+		Title:               in.Title,
+		ShortDescription:    in.ShortDescription,
+		Vendor:              in.Vendor,
+		Parent:              in.Parent,
+		CorrelationIDs:      graphql.NewJSONFromRawMessage(in.CorrelationIDs),
+		Labels:              graphql.NewJSONFromRawMessage(in.Labels),
+		DocumentationLabels: graphql.NewJSONFromRawMessage(in.DocumentationLabels),
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer Product models to graphql Products
+func (c *converter) MultipleToGraphQL(in []*model.Product) []*graphql.Product {
+	out := make([]*graphql.Product, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(item))
+	}
+
+	return out
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,29 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		gql := conv.ToGraphQL(fixProductModel())
+
+		assert.Equal(t, fixGQLProduct(), gql)
+	})
+
+	t.Run("Returns nil if Product model is nil", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		gql := conv.ToGraphQL(nil)
+
+		require.Nil(t, gql)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := product.NewConverter()
+
+	gqls := conv.MultipleToGraphQL([]*model.Product{fixProductModel(), nil})
+
+	assert.Equal(t, []*graphql.Product{fixGQLProduct()}, gqls)
+}
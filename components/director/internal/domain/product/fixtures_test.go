@@ -11,6 +11,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -116,3 +117,24 @@ func fixProductRowWithTitle(title string) []driver.Value {
 func fixProductUpdateArgs() []driver.Value {
 	return []driver.Value{"title", "short desc", "vendorID", "parent", repo.NewValidNullableString("{}"), repo.NewValidNullableString(correlationIDs), repo.NewValidNullableString("{}")}
 }
+
+func fixGQLProduct() *graphql.Product {
+	parent := "parent"
+	return &graphql.Product{
+		ID:                  productID,
+		OrdID:               ordID,
+		ApplicationID:       str.Ptr(appID),
+		Title:               "title",
+		ShortDescription:    "short desc",
+		Vendor:              "vendorID",
+		Parent:              &parent,
+		CorrelationIDs:      jsonPtr(correlationIDs),
+		Labels:              jsonPtr("{}"),
+		DocumentationLabels: jsonPtr("{}"),
+	}
+}
+
+func jsonPtr(in string) *graphql.JSON {
+	out := graphql.JSON(in)
+	return &out
+}
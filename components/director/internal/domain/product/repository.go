@@ -179,6 +179,23 @@ func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.Product, error)
 	return products, nil
 }
 
+// ListByApplicationIDs gets all Products for the given application ids
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Product, error) {
+	productCollection := productCollection{}
+	if err := r.lister.List(ctx, resource.Product, tenantID, &productCollection, repo.NewInConditionForStringValues("app_id", appIDs)); err != nil {
+		return nil, err
+	}
+	products := make([]*model.Product, 0, productCollection.Len())
+	for _, product := range productCollection {
+		productModel, err := r.conv.FromEntity(&product)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, productModel)
+	}
+	return products, nil
+}
+
 type productCollection []Entity
 
 // Len missing godoc
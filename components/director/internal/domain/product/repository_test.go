@@ -285,6 +285,35 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Products",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT ord_id, app_id, title, short_description, vendor, parent, labels, correlation_ids, id, documentation_labels FROM public.products WHERE app_id IN ($1) AND (id IN (SELECT id FROM products_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixProductColumns()).AddRow(fixProductRowWithTitle("title1")...).AddRow(fixProductRowWithTitle("title2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixProductColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   product.NewRepository,
+		ExpectedModelEntities: []interface{}{fixProductModelWithTitle("title1"), fixProductModelWithTitle("title2")},
+		ExpectedDBEntities:    []interface{}{fixEntityProductWithTitle("title1"), fixEntityProductWithTitle("title2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+}
+
 func TestPgRepository_ListGlobal(t *testing.T) {
 	suite := testdb.RepoListTestSuite{
 		Name: "List Global",
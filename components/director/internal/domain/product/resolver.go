@@ -0,0 +1,121 @@
+package product
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// ProductService is responsible for the service-layer Product operations needed by the resolver
+//go:generate mockery --name=ProductService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ProductService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Product, error)
+	ListGlobal(ctx context.Context) ([]*model.Product, error)
+}
+
+// ProductConverter converts Products between the model.Product service-layer representation and the graphql-layer representation
+//go:generate mockery --name=ProductConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ProductConverter interface {
+	MultipleToGraphQL(in []*model.Product) []*graphql.Product
+}
+
+// Resolver is an object responsible for resolver-layer Product operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      ProductService
+	conv     ProductConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Product operations
+func NewResolver(transact persistence.Transactioner, svc ProductService, conv ProductConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Products returns the Products of the given Application. The Products of all Applications in the query are loaded in a single batch
+func (r *Resolver) Products(ctx context.Context, obj *graphql.Application) ([]*graphql.Product, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamProduct{ID: obj.ID, Ctx: ctx}
+	return dataloader.ProductFor(ctx).ProductByID.Load(param)
+}
+
+// ProductsDataLoader loads the Products of all requested Applications and returns them in the order of the keys
+func (r *Resolver) ProductsDataLoader(keys []dataloader.ParamProduct) ([][]*graphql.Product, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	products, err := r.svc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	productsByAppID := make(map[string][]*graphql.Product, len(keys))
+	for _, product := range r.conv.MultipleToGraphQL(products) {
+		if product.ApplicationID == nil {
+			continue
+		}
+		productsByAppID[*product.ApplicationID] = append(productsByAppID[*product.ApplicationID], product)
+	}
+
+	gqlProducts := make([][]*graphql.Product, 0, len(keys))
+	for _, key := range keys {
+		if products, ok := productsByAppID[key.ID]; ok {
+			gqlProducts = append(gqlProducts, products)
+			continue
+		}
+		gqlProducts = append(gqlProducts, []*graphql.Product{})
+	}
+
+	return gqlProducts, nil
+}
+
+// GlobalProducts returns the Products which are not owned by any Application
+func (r *Resolver) GlobalProducts(ctx context.Context) ([]*graphql.Product, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	products, err := r.svc.ListGlobal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(products), nil
+}
@@ -0,0 +1,230 @@
+package product_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Products(t *testing.T) {
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := product.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Products(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_ProductsDataLoader(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondAppID := "secondAppID"
+	keys := []dataloader.ParamProduct{{ID: appID, Ctx: ctx}, {ID: secondAppID, Ctx: ctx}}
+	appIDs := []string{appID, secondAppID}
+
+	models := []*model.Product{fixProductModel()}
+	gqls := []*graphql.Product{fixGQLProduct()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.ProductService
+		ConverterFn    func() *automock.ProductConverter
+		ExpectedResult [][]*graphql.Product
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				conv := &automock.ProductConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Product{gqls, {}},
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedProductService,
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := product.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.ProductsDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := product.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, errs := resolver.ProductsDataLoader([]dataloader.ParamProduct{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+	})
+}
+
+func TestResolver_GlobalProducts(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	models := []*model.Product{fixGlobalProductModel()}
+	gqls := []*graphql.Product{fixGQLProduct()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.ProductService
+		ConverterFn    func() *automock.ProductConverter
+		ExpectedResult []*graphql.Product
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				conv := &automock.ProductConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: gqls,
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedProductService,
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedProductConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := product.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.GlobalProducts(ctx)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func unusedProductService() *automock.ProductService {
+	return &automock.ProductService{}
+}
+
+func unusedProductConverter() *automock.ProductConverter {
+	return &automock.ProductConverter{}
+}
@@ -22,6 +22,7 @@ type ProductRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Product, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.Product, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Product, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Product, error)
 	ListGlobal(ctx context.Context) ([]*model.Product, error)
 }
 
@@ -174,6 +175,16 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 	return s.productRepo.ListByApplicationID(ctx, tnt, appID)
 }
 
+// ListByApplicationIDs returns the Products of all given Applications.
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Product, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.productRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+}
+
 // ListGlobal returns a list of global products (with NULL app_id).
 func (s *service) ListGlobal(ctx context.Context) ([]*model.Product, error) {
 	return s.productRepo.ListGlobal(ctx)
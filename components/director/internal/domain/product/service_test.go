@@ -670,6 +670,78 @@ func TestService_ListByApplicationID(t *testing.T) {
 	})
 }
 
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	products := []*model.Product{
+		fixProductModel(),
+		fixProductModel(),
+		fixProductModel(),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ProductRepository
+		ExpectedResult     []*model.Product
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ProductRepository {
+				repo := &automock.ProductRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID}).Return(products, nil).Once()
+				return repo
+			},
+			ExpectedResult:     products,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Product listing failed",
+			RepositoryFn: func() *automock.ProductRepository {
+				repo := &automock.ProductRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, []string{appID}).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := product.NewService(repo, nil)
+
+			// WHEN
+			docs, err := svc.ListByApplicationIDs(ctx, []string{appID})
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, docs)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := product.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListGlobal(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
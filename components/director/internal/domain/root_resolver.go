@@ -9,9 +9,12 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/retry"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formationtemplate"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/changeevent"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/subscription"
 
 	"github.com/kyma-incubator/compass/components/director/internal/selfregmanager"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
 
 	pkgadapters "github.com/kyma-incubator/compass/components/director/pkg/adapters"
 
@@ -31,18 +34,28 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/formation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/formationassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordreport"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordresync"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	runtimectx "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/viewer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
@@ -56,6 +69,7 @@ import (
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/time"
 	hydraClient "github.com/ory/hydra-client-go/client"
@@ -89,6 +103,16 @@ type RootResolver struct {
 	scenarioAssignment *scenarioassignment.Resolver
 	subscription       *subscription.Resolver
 	formationTemplate  *formationtemplate.Resolver
+	changeEvent        *changeevent.Resolver
+	ordPackage         *ordpackage.Resolver
+	product            *product.Resolver
+	vendor             *ordvendor.Resolver
+	tombstone          *tombstone.Resolver
+	search             *search.Resolver
+	tenantCatalog      *tenantcatalog.Resolver
+	operationHistory   *operationhistory.Resolver
+	ordResync          *ordresync.Resolver
+	ordReport          *ordreport.Resolver
 }
 
 // NewRootResolver missing godoc
@@ -109,6 +133,10 @@ func NewRootResolver(
 	accessStrategyExecutorProvider *accessstrategy.Provider,
 	subscriptionConfig subscription.Config,
 	tenantOnDemandAPIConfig tenant.FetchOnDemandAPIConfig,
+	ordResyncConfig ordresync.Config,
+	bundleInstanceAuthConfig bundleinstanceauth.Config,
+	changeEventBroker *changeevent.Broker,
+	formationAssignmentScheduler operation.Scheduler,
 ) (*RootResolver, error) {
 	oAuth20HTTPClient := &http.Client{
 		Timeout:   oAuth20Cfg.HTTPClientTimeout,
@@ -148,6 +176,15 @@ func NewRootResolver(
 	formationConv := formation.NewConverter()
 	runtimeConverter := runtime.NewConverter(webhookConverter)
 	formationTemplateConverter := formationtemplate.NewConverter()
+	changeEventConverter := changeevent.NewConverter()
+	formationAssignmentConverter := formationassignment.NewConverter()
+	pkgConverter := ordpackage.NewConverter()
+	productConverter := product.NewConverter()
+	vendorConverter := ordvendor.NewConverter()
+	tombstoneConverter := tombstone.NewConverter()
+	searchConverter := search.NewConverter()
+	operationHistoryConverter := operationhistory.NewConverter()
+	ordReportConverter := ordreport.NewConverter()
 
 	healthcheckRepo := healthcheck.NewRepository()
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
@@ -171,13 +208,23 @@ func NewRootResolver(
 	bundleReferenceRepo := bundlereferences.NewRepository(bundleReferenceConv)
 	formationTemplateRepo := formationtemplate.NewRepository(formationTemplateConverter)
 	formationRepo := formation.NewRepository(formationConv)
+	changeEventRepo := changeevent.NewRepository()
+	formationAssignmentRepo := formationassignment.NewRepository(formationAssignmentConverter)
+	pkgRepo := ordpackage.NewRepository(pkgConverter)
+	productRepo := product.NewRepository(productConverter)
+	vendorRepo := ordvendor.NewRepository(vendorConverter)
+	tombstoneRepo := tombstone.NewRepository(tombstoneConverter)
+	searchRepo := search.NewRepository(searchConverter)
+	operationHistoryRepo := operationhistory.NewRepository()
+	ordReportRepo := ordreport.NewRepository()
 
 	uidSvc := uid.NewService()
 	labelSvc := label.NewLabelService(labelRepo, labelDefRepo, uidSvc)
+	changeEventSvc := changeevent.NewService(changeEventRepo, labelRepo, uidSvc)
 	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc, labelSvc, labelRepo)
 
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, tenantRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
-	fetchRequestSvc := fetchrequest.NewServiceWithRetry(fetchRequestRepo, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor)
+	fetchRequestSvc := fetchrequest.NewServiceWithRetry(fetchRequestRepo, httpClient, accessStrategyExecutorProvider, retryHTTPExecutor, specvalidation.NewValidator(featuresConfig.SpecNormalizationEnabled))
 	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
 	bundleReferenceSvc := bundlereferences.NewService(bundleReferenceRepo, uidSvc)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc, bundleReferenceSvc)
@@ -193,15 +240,27 @@ func NewRootResolver(
 	eventingSvc := eventing.NewService(appNameNormalizer, runtimeRepo, labelRepo)
 	bundleSvc := bundleutil.NewService(bundleRepo, apiSvc, eventAPISvc, docSvc, uidSvc)
 	timeService := time.NewService()
-	bundleInstanceAuthSvc := bundleinstanceauth.NewService(bundleInstanceAuthRepo, uidSvc)
-	formationSvc := formation.NewService(labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo)
-	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, labelDefSvc, bundleSvc, uidSvc, formationSvc, selfRegConfig.SelfRegisterDistinguishLabelKey)
+	bundleInstanceAuthSvc := bundleinstanceauth.NewService(bundleInstanceAuthRepo, uidSvc, bundleInstanceAuthConfig.RotationGracePeriod)
+	formationAssignmentSvc := formationassignment.NewService(formationAssignmentRepo, applicationRepo, runtimeRepo, runtimeContextRepo, labelRepo, webhookRepo, uidSvc, formationAssignmentScheduler)
+	formationSvc := formation.NewService(labelDefRepo, labelRepo, formationRepo, formationTemplateRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentRepo, scenarioAssignmentSvc, tenantSvc, runtimeRepo, runtimeContextRepo, applicationRepo, changeEventSvc, formationAssignmentSvc)
+	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelSvc, labelDefSvc, bundleSvc, uidSvc, formationSvc, changeEventSvc, selfRegConfig.SelfRegisterDistinguishLabelKey)
 	runtimeContextSvc := runtimectx.NewService(runtimeContextRepo, labelRepo, labelSvc, formationSvc, tenantSvc, uidSvc)
-	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, labelDefSvc, labelSvc, uidSvc, formationSvc, tenantSvc, webhookSvc, runtimeContextSvc, featuresConfig.ProtectedLabelPattern, featuresConfig.ImmutableLabelPattern, featuresConfig.RuntimeTypeLabelKey, featuresConfig.KymaRuntimeTypeLabelValue)
+	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, labelDefSvc, labelSvc, uidSvc, formationSvc, tenantSvc, webhookSvc, runtimeContextSvc, changeEventSvc, featuresConfig.ProtectedLabelPattern, featuresConfig.ImmutableLabelPattern, featuresConfig.RuntimeTypeLabelKey, featuresConfig.KymaRuntimeTypeLabelValue)
 	tokenSvc := onetimetoken.NewTokenService(systemAuthSvc, appSvc, appConverter, tenantSvc, internalFQDNHTTPClient, onetimetoken.NewTokenGenerator(tokenLength), oneTimeTokenCfg, pairingAdapters, timeService)
 	subscriptionSvc := subscription.NewService(runtimeSvc, runtimeContextSvc, tenantSvc, labelSvc, appTemplateSvc, appConverter, appSvc, uidSvc, subscriptionConfig.ConsumerSubaccountLabelKey, subscriptionConfig.SubscriptionLabelKey, subscriptionConfig.RuntimeTypeLabelKey, subscriptionConfig.ProviderLabelKey)
 	tenantOnDemandSvc := tenant.NewFetchOnDemandService(internalGatewayHTTPClient, tenantOnDemandAPIConfig)
+	ordAggregator := ordresync.NewAggregator(internalGatewayHTTPClient, ordResyncConfig)
 	formationTemplateSvc := formationtemplate.NewService(formationTemplateRepo, uidSvc, formationTemplateConverter)
+	pkgSvc := ordpackage.NewService(pkgRepo, uidSvc)
+	productSvc := product.NewService(productRepo, uidSvc)
+	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
+	tombstoneSvc := tombstone.NewService(tombstoneRepo, uidSvc)
+	searchSvc := search.NewService(searchRepo, labelRepo)
+	operationHistorySvc := operationhistory.NewService(operationHistoryRepo, applicationRepo, formationAssignmentRepo, uidSvc)
+	ordReportSvc := ordreport.NewService(ordReportRepo)
+	tenantCatalogSvc := tenantcatalog.NewService(appSvc, appTemplateSvc, webhookSvc, bundleSvc, apiSvc, eventAPISvc, docSvc, specSvc,
+		appConverter, appTemplateConverter, webhookConverter, bundleConverter, apiConverter, eventAPIConverter, docConverter,
+		tenantcatalog.NewConverter(), cfgProvider)
 
 	return &RootResolver{
 		appNameNormalizer:  appNameNormalizer,
@@ -211,7 +270,7 @@ func NewRootResolver(
 		eventAPI:           eventdef.NewResolver(transact, eventAPISvc, bundleSvc, bundleReferenceSvc, eventAPIConverter, frConverter, specSvc, specConverter),
 		eventing:           eventing.NewResolver(transact, eventingSvc, appSvc),
 		doc:                document.NewResolver(transact, docSvc, appSvc, bundleSvc, frConverter),
-		formation:          formation.NewResolver(transact, formationSvc, formationConv, tenantOnDemandSvc),
+		formation:          formation.NewResolver(transact, formationSvc, formationConv, tenantOnDemandSvc, appConverter, runtimeConverter, runtimeContextConverter, assignmentConv, tenantSvc),
 		runtime:            runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, bundleInstanceAuthSvc, selfRegisterManager, uidSvc, subscriptionSvc, runtimeContextSvc, runtimeContextConverter, webhookSvc, webhookConverter, tenantOnDemandSvc, formationSvc),
 		runtimeContext:     runtimectx.NewResolver(transact, runtimeContextSvc, runtimeContextConverter),
 		healthCheck:        healthcheck.NewResolver(healthCheckSvc),
@@ -228,6 +287,16 @@ func NewRootResolver(
 		scenarioAssignment: scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv, tenantSvc, tenantOnDemandSvc, formationSvc),
 		subscription:       subscription.NewResolver(transact, subscriptionSvc),
 		formationTemplate:  formationtemplate.NewResolver(transact, formationTemplateConverter, formationTemplateSvc),
+		changeEvent:        changeevent.NewResolver(transact, changeEventBroker, changeEventRepo, labelRepo, changeEventConverter),
+		ordPackage:         ordpackage.NewResolver(transact, pkgSvc, pkgConverter),
+		product:            product.NewResolver(transact, productSvc, productConverter),
+		vendor:             ordvendor.NewResolver(transact, vendorSvc, vendorConverter),
+		tombstone:          tombstone.NewResolver(transact, tombstoneSvc, tombstoneConverter),
+		search:             search.NewResolver(transact, searchSvc, searchConverter),
+		tenantCatalog:      tenantcatalog.NewResolver(transact, tenantCatalogSvc),
+		operationHistory:   operationhistory.NewResolver(transact, operationHistorySvc, operationHistoryConverter),
+		ordResync:          ordresync.NewResolver(transact, appSvc, ordAggregator),
+		ordReport:          ordreport.NewResolver(transact, ordReportSvc, ordReportConverter),
 	}, nil
 }
 
@@ -271,6 +340,51 @@ func (r *RootResolver) RuntimeContextsDataloader(ids []dataloader.ParamRuntimeCo
 	return r.runtime.RuntimeContextsDataLoader(ids)
 }
 
+// FormationApplicationsDataloader retrieves a page of Applications for each Formation
+func (r *RootResolver) FormationApplicationsDataloader(ids []dataloader.ParamFormationApplication) ([]*graphql.ApplicationPage, []error) {
+	return r.formation.ApplicationsDataLoader(ids)
+}
+
+// FormationRuntimesDataloader retrieves a page of Runtimes for each Formation
+func (r *RootResolver) FormationRuntimesDataloader(ids []dataloader.ParamFormationRuntime) ([]*graphql.RuntimePage, []error) {
+	return r.formation.RuntimesDataLoader(ids)
+}
+
+// FormationRuntimeContextsDataloader retrieves a page of Runtime Contexts for each Formation
+func (r *RootResolver) FormationRuntimeContextsDataloader(ids []dataloader.ParamFormationRuntimeContext) ([]*graphql.RuntimeContextPage, []error) {
+	return r.formation.RuntimeContextsDataLoader(ids)
+}
+
+// FormationTenantAssignmentsDataloader retrieves a page of Automatic Scenario Assignments for each Formation
+func (r *RootResolver) FormationTenantAssignmentsDataloader(ids []dataloader.ParamFormationTenantAssignment) ([]*graphql.AutomaticScenarioAssignmentPage, []error) {
+	return r.formation.TenantAssignmentsDataLoader(ids)
+}
+
+// FormationStatusDataloader retrieves the status of each Formation
+func (r *RootResolver) FormationStatusDataloader(ids []dataloader.ParamFormationStatus) ([]*graphql.FormationStatus, []error) {
+	return r.formation.StatusDataLoader(ids)
+}
+
+// PackagesDataloader retrieves the ORD Packages for each Application
+func (r *RootResolver) PackagesDataloader(ids []dataloader.ParamPackage) ([][]*graphql.Package, []error) {
+	return r.ordPackage.PackagesDataLoader(ids)
+}
+
+// ProductsDataloader retrieves the ORD Products for each Application
+func (r *RootResolver) ProductsDataloader(ids []dataloader.ParamProduct) ([][]*graphql.Product, []error) {
+	return r.product.ProductsDataLoader(ids)
+}
+
+// VendorsDataloader retrieves the ORD Vendors for each Application
+func (r *RootResolver) VendorsDataloader(ids []dataloader.ParamVendor) ([][]*graphql.Vendor, []error) {
+	return r.vendor.VendorsDataLoader(ids)
+}
+
+// TombstonesDataloader retrieves the ORD Tombstones for each Application
+func (r *RootResolver) TombstonesDataloader(ids []dataloader.ParamTombstone) ([][]*graphql.Tombstone, []error) {
+	return r.tombstone.TombstonesDataLoader(ids)
+}
+
 // Mutation missing godoc
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
@@ -301,6 +415,11 @@ func (r *RootResolver) RuntimeContext() graphql.RuntimeContextResolver {
 	return &runtimeContextResolver{r}
 }
 
+// Formation returns the resolver of the Formation fields
+func (r *RootResolver) Formation() graphql.FormationResolver {
+	return &formationResolver{r}
+}
+
 // APISpec missing godoc
 func (r *RootResolver) APISpec() graphql.APISpecResolver {
 	return &apiSpecResolver{r}
@@ -341,6 +460,21 @@ func (r *RootResolver) Tenant() graphql.TenantResolver {
 	return &tenantResolver{r}
 }
 
+// Subscription returns the resolver of the change events subscriptions
+func (r *RootResolver) Subscription() graphql.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+// ApplicationEvent returns the resolver of the application change events
+func (r *RootResolver) ApplicationEvent() graphql.ApplicationEventResolver {
+	return &applicationEventResolver{r}
+}
+
+// RuntimeEvent returns the resolver of the runtime change events
+func (r *RootResolver) RuntimeEvent() graphql.RuntimeEventResolver {
+	return &runtimeEventResolver{r}
+}
+
 type queryResolver struct {
 	*RootResolver
 }
@@ -361,13 +495,38 @@ func (r *queryResolver) FormationTemplates(ctx context.Context, first *int, afte
 	return r.formationTemplate.FormationTemplates(ctx, first, after)
 }
 
+// Products returns the global ORD Products
+func (r *queryResolver) Products(ctx context.Context) ([]*graphql.Product, error) {
+	return r.product.GlobalProducts(ctx)
+}
+
+// Vendors returns the global ORD Vendors
+func (r *queryResolver) Vendors(ctx context.Context) ([]*graphql.Vendor, error) {
+	return r.vendor.GlobalVendors(ctx)
+}
+
+// Search performs a full-text search over the Application resources
+func (r *queryResolver) Search(ctx context.Context, term string, kinds []graphql.SearchResultKind, first *int, after *graphql.PageCursor) (*graphql.SearchResultPage, error) {
+	return r.search.Search(ctx, term, kinds, first, after)
+}
+
+// ExportTenantCatalog exports the Applications and Application Templates of the tenant
+func (r *queryResolver) ExportTenantCatalog(ctx context.Context, format *graphql.TenantCatalogFormat) (graphql.CLOB, error) {
+	return r.tenantCatalog.ExportTenantCatalog(ctx, format)
+}
+
+// Operations retrieves the operations history of the given resource
+func (r *queryResolver) Operations(ctx context.Context, resourceID string, resourceType graphql.OperationResourceType) ([]*graphql.Operation, error) {
+	return r.operationHistory.Operations(ctx, resourceID, resourceType)
+}
+
 // Viewer missing godoc
 func (r *queryResolver) Viewer(ctx context.Context) (*graphql.Viewer, error) {
 	return r.viewer.Viewer(ctx)
 }
 
 // Applications missing godoc
-func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
 	consumerInfo, err := consumer.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -378,7 +537,7 @@ func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.Labe
 		return r.app.ApplicationsForRuntime(ctx, consumerInfo.ConsumerID, first, after)
 	}
 
-	return r.app.Applications(ctx, filter, first, after)
+	return r.app.Applications(ctx, filter, labelExpression, orderBy, first, after)
 }
 
 // Application missing godoc
@@ -387,8 +546,8 @@ func (r *queryResolver) Application(ctx context.Context, id string) (*graphql.Ap
 }
 
 // ApplicationTemplates missing godoc
-func (r *queryResolver) ApplicationTemplates(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationTemplatePage, error) {
-	return r.appTemplate.ApplicationTemplates(ctx, filter, first, after)
+func (r *queryResolver) ApplicationTemplates(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.ApplicationTemplatePage, error) {
+	return r.appTemplate.ApplicationTemplates(ctx, filter, labelExpression, orderBy, first, after)
 }
 
 // ApplicationTemplate missing godoc
@@ -396,6 +555,11 @@ func (r *queryResolver) ApplicationTemplate(ctx context.Context, id string) (*gr
 	return r.appTemplate.ApplicationTemplate(ctx, id)
 }
 
+// ApplicationsMergePlan returns the changes which merging the Source Application into the Destination Application would make
+func (r *queryResolver) ApplicationsMergePlan(ctx context.Context, destID, srcID string, conflictPolicy *graphql.ApplicationMergeConflictPolicy) (*graphql.ApplicationMergePlan, error) {
+	return r.app.ApplicationsMergePlan(ctx, destID, srcID, conflictPolicy)
+}
+
 // ApplicationsForRuntime missing godoc
 func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
 	apps, err := r.app.ApplicationsForRuntime(ctx, runtimeID, first, after)
@@ -429,8 +593,8 @@ func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID st
 }
 
 // Runtimes missing godoc
-func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	return r.runtime.Runtimes(ctx, filter, first, after)
+func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	return r.runtime.Runtimes(ctx, filter, labelExpression, orderBy, first, after)
 }
 
 // Runtime missing godoc
@@ -524,6 +688,11 @@ func (r *mutationResolver) UpdateFormationTemplate(ctx context.Context, id strin
 	return r.formationTemplate.UpdateFormationTemplate(ctx, id, in)
 }
 
+// ImportTenantCatalog imports Applications and Application Templates into the tenant
+func (r *mutationResolver) ImportTenantCatalog(ctx context.Context, in graphql.CLOB, dryRun *bool) (*graphql.TenantCatalogImportResult, error) {
+	return r.tenantCatalog.ImportTenantCatalog(ctx, in, dryRun)
+}
+
 func (r *mutationResolver) AssignFormation(ctx context.Context, objectID string, objectType graphql.FormationObjectType, formation graphql.FormationInput) (*graphql.Formation, error) {
 	return r.formation.AssignFormation(ctx, objectID, objectType, formation)
 }
@@ -532,6 +701,10 @@ func (r *mutationResolver) UnassignFormation(ctx context.Context, objectID strin
 	return r.formation.UnassignFormation(ctx, objectID, objectType, formation)
 }
 
+func (r *mutationResolver) ResynchronizeFormationNotifications(ctx context.Context, formationName string) (*graphql.Formation, error) {
+	return r.formation.ResynchronizeFormationNotifications(ctx, formationName)
+}
+
 func (r *mutationResolver) CreateFormation(ctx context.Context, formationInput graphql.FormationInput) (*graphql.Formation, error) {
 	return r.formation.CreateFormation(ctx, formationInput)
 }
@@ -562,8 +735,13 @@ func (r *mutationResolver) UnpairApplication(ctx context.Context, id string, _ *
 
 // MergeApplications Merges properties from Source Application into Destination Application, provided that the Destination's
 // Application does not have a value set for a given property. Then the Source Application is being deleted.
-func (r *mutationResolver) MergeApplications(ctx context.Context, destID, srcID string) (*graphql.Application, error) {
-	return r.app.MergeApplications(ctx, destID, srcID)
+func (r *mutationResolver) MergeApplications(ctx context.Context, destID, srcID string, conflictPolicy *graphql.ApplicationMergeConflictPolicy) (*graphql.Application, error) {
+	return r.app.MergeApplications(ctx, destID, srcID, conflictPolicy)
+}
+
+// ResyncOpenResourceDiscovery queues the application for immediate aggregation of its ORD documents
+func (r *mutationResolver) ResyncOpenResourceDiscovery(ctx context.Context, applicationID string) (*graphql.OpenResourceDiscoveryResync, error) {
+	return r.ordResync.ResyncOpenResourceDiscovery(ctx, applicationID)
 }
 
 // CreateApplicationTemplate missing godoc
@@ -809,6 +987,11 @@ func (r *mutationResolver) RequestBundleInstanceAuthDeletion(ctx context.Context
 	return r.bundleInstanceAuth.RequestBundleInstanceAuthDeletion(ctx, authID)
 }
 
+// RequestBundleInstanceAuthRotation requests new credentials for a BundleInstanceAuth
+func (r *mutationResolver) RequestBundleInstanceAuthRotation(ctx context.Context, authID string) (*graphql.BundleInstanceAuth, error) {
+	return r.bundleInstanceAuth.RequestBundleInstanceAuthRotation(ctx, authID)
+}
+
 // AddBundle missing godoc
 func (r *mutationResolver) AddBundle(ctx context.Context, applicationID string, in graphql.BundleCreateInput) (*graphql.Bundle, error) {
 	return r.mpBundle.AddBundle(ctx, applicationID, in)
@@ -897,6 +1080,36 @@ func (r *applicationResolver) Bundle(ctx context.Context, obj *graphql.Applicati
 	return r.app.Bundle(ctx, obj, id)
 }
 
+// Packages retrieves the ORD Packages of the Application
+func (r *applicationResolver) Packages(ctx context.Context, obj *graphql.Application) ([]*graphql.Package, error) {
+	return r.ordPackage.Packages(ctx, obj)
+}
+
+// Products retrieves the ORD Products of the Application
+func (r *applicationResolver) Products(ctx context.Context, obj *graphql.Application) ([]*graphql.Product, error) {
+	return r.product.Products(ctx, obj)
+}
+
+// Vendors retrieves the ORD Vendors of the Application
+func (r *applicationResolver) Vendors(ctx context.Context, obj *graphql.Application) ([]*graphql.Vendor, error) {
+	return r.vendor.Vendors(ctx, obj)
+}
+
+// Tombstones retrieves the ORD Tombstones of the Application
+func (r *applicationResolver) Tombstones(ctx context.Context, obj *graphql.Application) ([]*graphql.Tombstone, error) {
+	return r.tombstone.Tombstones(ctx, obj)
+}
+
+// Operations retrieves the operations history of the Application
+func (r *applicationResolver) Operations(ctx context.Context, obj *graphql.Application) ([]*graphql.Operation, error) {
+	return r.operationHistory.ApplicationOperations(ctx, obj)
+}
+
+// OrdAggregationReport retrieves the report of the latest ORD aggregation of the Application
+func (r *applicationResolver) OrdAggregationReport(ctx context.Context, obj *graphql.Application) (*graphql.ORDAggregationReport, error) {
+	return r.ordReport.ApplicationORDAggregationReport(ctx, obj)
+}
+
 type applicationTemplateResolver struct {
 	*RootResolver
 }
@@ -944,6 +1157,33 @@ func (r *runtimeResolver) RuntimeContext(ctx context.Context, obj *graphql.Runti
 	return r.runtime.RuntimeContext(ctx, obj, id)
 }
 
+type formationResolver struct{ *RootResolver }
+
+// Applications missing godoc
+func (r *formationResolver) Applications(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	return r.formation.Applications(ctx, obj, first, after)
+}
+
+// Runtimes missing godoc
+func (r *formationResolver) Runtimes(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	return r.formation.Runtimes(ctx, obj, first, after)
+}
+
+// RuntimeContexts missing godoc
+func (r *formationResolver) RuntimeContexts(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.RuntimeContextPage, error) {
+	return r.formation.RuntimeContexts(ctx, obj, first, after)
+}
+
+// TenantAssignments missing godoc
+func (r *formationResolver) TenantAssignments(ctx context.Context, obj *graphql.Formation, first *int, after *graphql.PageCursor) (*graphql.AutomaticScenarioAssignmentPage, error) {
+	return r.formation.TenantAssignments(ctx, obj, first, after)
+}
+
+// Status missing godoc
+func (r *formationResolver) Status(ctx context.Context, obj *graphql.Formation) (*graphql.FormationStatus, error) {
+	return r.formation.Status(ctx, obj)
+}
+
 type apiSpecResolver struct{ *RootResolver }
 
 // FetchRequest missing godoc
@@ -1071,3 +1311,46 @@ func (r *queryResolver) TenantByInternalID(ctx context.Context, id string) (*gra
 func (r *queryResolver) TenantByLowestOwnerForResource(ctx context.Context, resource, objectID string) (string, error) {
 	return r.tenant.TenantByLowestOwnerForResource(ctx, resource, objectID)
 }
+
+type subscriptionResolver struct {
+	*RootResolver
+}
+
+// ApplicationEvents streams the change events of the applications
+func (r *subscriptionResolver) ApplicationEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.ApplicationEvent, error) {
+	return r.changeEvent.ApplicationEvents(ctx, types)
+}
+
+// RuntimeEvents streams the change events of the runtimes
+func (r *subscriptionResolver) RuntimeEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.RuntimeEvent, error) {
+	return r.changeEvent.RuntimeEvents(ctx, types)
+}
+
+// FormationEvents streams the change events of the formations
+func (r *subscriptionResolver) FormationEvents(ctx context.Context, types []graphql.ChangeEventType) (<-chan *graphql.FormationEvent, error) {
+	return r.changeEvent.FormationEvents(ctx, types)
+}
+
+type applicationEventResolver struct {
+	*RootResolver
+}
+
+// Application returns the current state of the changed application
+func (r *applicationEventResolver) Application(ctx context.Context, obj *graphql.ApplicationEvent) (*graphql.Application, error) {
+	if obj == nil || obj.Type == graphql.ChangeEventTypeDeleted {
+		return nil, nil
+	}
+	return r.app.Application(ctx, obj.ApplicationID)
+}
+
+type runtimeEventResolver struct {
+	*RootResolver
+}
+
+// Runtime returns the current state of the changed runtime
+func (r *runtimeEventResolver) Runtime(ctx context.Context, obj *graphql.RuntimeEvent) (*graphql.Runtime, error) {
+	if obj == nil || obj.Type == graphql.ChangeEventTypeDeleted {
+		return nil, nil
+	}
+	return r.runtime.Runtime(ctx, obj.RuntimeID)
+}
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	pageorder "github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
@@ -136,13 +137,13 @@ func (_m *RuntimeRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
-// List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+// List provides a mock function with given fields: ctx, tenant, filter, order, pageSize, cursor
+func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, tenant, filter, order, pageSize, cursor)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, *pageorder.Order, int, string) *model.RuntimePage); ok {
+		r0 = rf(ctx, tenant, filter, order, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -150,8 +151,8 @@ func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, *pageorder.Order, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, order, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
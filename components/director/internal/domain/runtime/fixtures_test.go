@@ -8,11 +8,13 @@ import (
 	pkgmodel "github.com/kyma-incubator/compass/components/director/pkg/model"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -299,3 +301,9 @@ func fixRtmCtxPage(rtmCtxs []*model.RuntimeContext) *model.RuntimeContextPage {
 		TotalCount: len(rtmCtxs),
 	}
 }
+
+func changeEventServiceThatPublishes() *automock.ChangeEventService {
+	svc := &automock.ChangeEventService{}
+	svc.On("Publish", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return svc
+}
@@ -13,12 +13,14 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 )
 
 const runtimeTable string = `public.runtimes`
 
 var (
 	runtimeColumns = []string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}
+	orderColumns   = map[pageorder.Field]string{pageorder.FieldID: "id", pageorder.FieldName: "name", pageorder.FieldCreatedAt: "creation_timestamp"}
 )
 
 // EntityConverter missing godoc
@@ -183,6 +185,20 @@ func (r *pgRepository) ListAll(ctx context.Context, tenant string, filter []*lab
 	return r.listRuntimes(ctx, tenant, filter, false)
 }
 
+// ListAllByIDs returns all runtimes in a tenant with the given IDs
+func (r *pgRepository) ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.Runtime, error) {
+	if len(ids) == 0 {
+		return []*model.Runtime{}, nil
+	}
+
+	var entities RuntimeCollection
+	if err := r.lister.List(ctx, resource.Runtime, tenant, &entities, repo.NewInConditionForStringValues("id", ids)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
 // ListOwnedRuntimes returns all runtimes in a tenant that match given label filter and owner check to true
 func (r *pgRepository) ListOwnedRuntimes(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) ([]*model.Runtime, error) {
 	return r.listRuntimes(ctx, tenant, filter, true)
@@ -197,7 +213,7 @@ func (r RuntimeCollection) Len() int {
 }
 
 // List missing godoc
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error) {
 	var runtimesCollection RuntimeCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -213,7 +229,12 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Runtime, tenant, pageSize, cursor, "name", &runtimesCollection, conditions...)
+	orderBy, err := repo.NewOrderByForPage(order, orderColumns, repo.NewAscOrderBy("name"))
+	if err != nil {
+		return nil, err
+	}
+
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.Runtime, tenant, pageSize, cursor, orderBy, &runtimesCollection, conditions...)
 
 	if err != nil {
 		return nil, err
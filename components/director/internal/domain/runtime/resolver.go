@@ -17,6 +17,7 @@ import (
 	labelPkg "github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -49,7 +50,7 @@ type RuntimeService interface {
 	GetByTokenIssuer(ctx context.Context, issuer string) (*model.Runtime, error)
 	GetByFilters(ctx context.Context, filters []*labelfilter.LabelFilter) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
@@ -200,8 +201,12 @@ func NewResolver(transact persistence.Transactioner, runtimeService RuntimeServi
 
 // Runtimes missing godoc
 // TODO: Proper error handling
-func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	labelFilter := labelfilter.MultipleFromGraphQL(filter)
+func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, labelExpression *string, orderBy *graphql.PageOrder, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	labelFilter, err := labelfilter.MultipleFromGraphQLWithExpression(filter, labelExpression)
+	if err != nil {
+		return nil, err
+	}
+
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
@@ -219,7 +224,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	runtimesPage, err := r.runtimeService.List(ctx, labelFilter, *first, cursor)
+	runtimesPage, err := r.runtimeService.List(ctx, labelFilter, pageorder.FromGraphQL(orderBy), *first, cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/rtmtest"
 
@@ -1845,7 +1846,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				svc.On("List", contextParam, filter, (*pageorder.Order)(nil), first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -1869,7 +1870,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, (*pageorder.Order)(nil), first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn:       UnusedRuntimeConverter,
@@ -1894,7 +1895,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
-			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, nil, nil, testCase.InputFirst, testCase.InputAfter)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/pkg/errors"
@@ -33,7 +35,7 @@ type runtimeRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
 	GetByFiltersGlobal(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error)
 	ListByFiltersGlobal(context.Context, []*labelfilter.LabelFilter) ([]*model.Runtime, error)
 	Create(ctx context.Context, tenant string, item *model.Runtime) error
 	Update(ctx context.Context, tenant string, item *model.Runtime) error
@@ -75,6 +77,11 @@ type uidService interface {
 	Generate() string
 }
 
+//go:generate mockery --exported --name=changeEventService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type changeEventService interface {
+	Publish(ctx context.Context, tenantID string, in model.ChangeEventInput) error
+}
+
 type service struct {
 	repo      runtimeRepository
 	labelRepo labelRepository
@@ -86,6 +93,7 @@ type service struct {
 	tenantSvc             tenantService
 	webhookService        WebhookService
 	runtimeContextService RuntimeContextService
+	changeEventService    changeEventService
 
 	protectedLabelPattern     string
 	immutableLabelPattern     string
@@ -103,6 +111,7 @@ func NewService(repo runtimeRepository,
 	tenantService tenantService,
 	webhookService WebhookService,
 	runtimeContextService RuntimeContextService,
+	changeEventService changeEventService,
 	protectedLabelPattern, immutableLabelPattern, runtimeTypeLabelKey, kymaRuntimeTypeLabelValue string) *service {
 	return &service{
 		repo:                      repo,
@@ -114,6 +123,7 @@ func NewService(repo runtimeRepository,
 		tenantSvc:                 tenantService,
 		webhookService:            webhookService,
 		runtimeContextService:     runtimeContextService,
+		changeEventService:        changeEventService,
 		protectedLabelPattern:     protectedLabelPattern,
 		immutableLabelPattern:     immutableLabelPattern,
 		runtimeTypeLabelKey:       runtimeTypeLabelKey,
@@ -122,7 +132,7 @@ func NewService(repo runtimeRepository,
 }
 
 // List missing godoc
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, order *pageorder.Order, pageSize int, cursor string) (*model.RuntimePage, error) {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
@@ -132,7 +142,7 @@ func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, p
 		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
 	}
 
-	return s.repo.List(ctx, rtmTenant, filter, pageSize, cursor)
+	return s.repo.List(ctx, rtmTenant, filter, order, pageSize, cursor)
 }
 
 // Get missing godoc
@@ -308,6 +318,10 @@ func (s *service) CreateWithMandatoryLabels(ctx context.Context, in model.Runtim
 		}
 	}
 
+	if err = s.publishChangeEvent(ctx, rtmTenant, model.ChangeEventTypeCreated, id, ""); err != nil {
+		return err
+	}
+
 	// The runtime is created successfully, however there can be ASAs in the parent that should be processed.
 	tnt, err := s.tenantSvc.GetTenantByID(ctx, rtmTenant)
 	if err != nil {
@@ -378,7 +392,7 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeUpdateI
 		return errors.Wrapf(err, "while creating multiple labels for Runtime")
 	}
 
-	return nil
+	return s.publishChangeEvent(ctx, rtmTenant, model.ChangeEventTypeUpdated, id, "")
 }
 
 // Delete deletes all RuntimeContexts associated with the runtime with ID `id` and then deletes the runtime and its labels
@@ -403,6 +417,11 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	// The event is published before the deletion, so that the tenants with access to the runtime are still known
+	if err = s.publishChangeEvent(ctx, rtmTenant, model.ChangeEventTypeDeleted, id, ""); err != nil {
+		return err
+	}
+
 	if err = s.repo.Delete(ctx, rtmTenant, id); err != nil {
 		return errors.Wrapf(err, "while deleting Runtime")
 	}
@@ -441,7 +460,7 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		}
 	}
 
-	return nil
+	return s.publishChangeEvent(ctx, rtmTenant, model.ChangeEventTypeLabelChanged, id, labelInput.Key)
 }
 
 // GetLabel missing godoc
@@ -518,6 +537,20 @@ func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string)
 		}
 	}
 
+	return s.publishChangeEvent(ctx, rtmTenant, model.ChangeEventTypeLabelChanged, runtimeID, key)
+}
+
+func (s *service) publishChangeEvent(ctx context.Context, rtmTenant string, eventType model.ChangeEventType, runtimeID, labelKey string) error {
+	err := s.changeEventService.Publish(ctx, rtmTenant, model.ChangeEventInput{
+		Type:         eventType,
+		ResourceType: resource.Runtime,
+		ResourceID:   runtimeID,
+		LabelKey:     labelKey,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while publishing change event for Runtime with id %s", runtimeID)
+	}
+
 	return nil
 }
 
@@ -18,8 +18,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -829,7 +831,7 @@ func TestService_CreateWithMandatoryLabels(t *testing.T) {
 			tenantSvc := testCase.TenantSvcFn()
 			mandatoryLabels := testCase.MandatoryLabels()
 			webhookSvc := testCase.WebhookServiceFn()
-			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engineSvc, tenantSvc, webhookSvc, nil, protectedLabelPattern, immutableLabelPattern, runtimeTypeLabelKey, kymaRuntimeTypeLabelValue)
+			svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engineSvc, tenantSvc, webhookSvc, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, runtimeTypeLabelKey, kymaRuntimeTypeLabelValue)
 
 			// WHEN
 			err := svc.CreateWithMandatoryLabels(testCase.Context, testCase.Input, runtimeID, mandatoryLabels)
@@ -851,7 +853,7 @@ func TestService_CreateWithMandatoryLabels(t *testing.T) {
 		uuidSvc := &automock.UidService{}
 		uuidSvc.On("Generate").Return(testUUID).Once()
 
-		svc := runtime.NewService(nil, nil, nil, nil, uuidSvc, nil, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, uuidSvc, nil, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 		// WHEN
 		_, err := svc.Create(context.TODO(), model.RuntimeRegisterInput{})
 		// then
@@ -1306,7 +1308,7 @@ func TestService_Update(t *testing.T) {
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
 			engineSvc := testCase.FormationServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -1324,7 +1326,7 @@ func TestService_Update(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		err := svc.Update(context.TODO(), "id", model.RuntimeUpdateInput{})
 		// then
@@ -1560,7 +1562,7 @@ func TestService_Delete(t *testing.T) {
 			labelRepo := testCase.LabelRepoFn()
 			engine := testCase.FormationServiceFn()
 			rtmCtxSvc := testCase.RuntimeContextSvcFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, engine, nil, nil, rtmCtxSvc, "", "", "", "")
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, engine, nil, nil, rtmCtxSvc, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
 			err := svc.Delete(ctx, testCase.InputID)
@@ -1578,7 +1580,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		err := svc.Delete(context.TODO(), "id")
 		// then
@@ -1640,7 +1642,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
 			rtm, err := svc.Get(ctx, testCase.InputID)
@@ -1659,7 +1661,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		_, err := svc.Get(context.TODO(), "id")
 		// then
@@ -1721,7 +1723,7 @@ func TestService_GetByTokenIssuer(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
 			rtm, err := svc.GetByTokenIssuer(ctx, tokenIssuer)
@@ -1794,7 +1796,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
 			rtmRepo := testCase.RepositoryFn()
-			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+			svc := runtime.NewService(rtmRepo, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputRuntimeID)
@@ -1813,7 +1815,7 @@ func TestService_Exist(t *testing.T) {
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		_, err := svc.Exist(context.TODO(), "id")
 		// then
@@ -1843,6 +1845,7 @@ func TestService_List(t *testing.T) {
 	first := 2
 	after := "test"
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
+	order := pageorder.NewAsc(pageorder.FieldCreatedAt)
 
 	tnt := "tenant"
 	externalTnt := "external-tnt"
@@ -1863,7 +1866,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				repo.On("List", ctx, tnt, filter, order, first, after).Return(runtimePage, nil).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -1876,7 +1879,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when runtime listing failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, order, first, after).Return(nil, testErr).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -1909,10 +1912,10 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
-			rtm, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
+			rtm, err := svc.List(ctx, testCase.InputLabelFilters, order, testCase.InputPageSize, testCase.InputCursor)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -1928,9 +1931,9 @@ func TestService_List(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
-		_, err := svc.List(context.TODO(), nil, 1, "")
+		_, err := svc.List(context.TODO(), nil, nil, 1, "")
 		// then
 		require.Error(t, err)
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
@@ -2042,7 +2045,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), "", "", "", "")
 
 			// WHEN
 			l, err := svc.GetLabel(ctx, testCase.InputRuntimeID, testCase.InputLabel.Key)
@@ -2062,7 +2065,7 @@ func TestService_GetLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		_, err := svc.GetLabel(context.TODO(), "id", "key")
 		// then
@@ -2186,7 +2189,7 @@ func TestService_ListLabels(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			l, err := svc.ListLabels(ctx, testCase.InputRuntimeID)
@@ -2206,7 +2209,7 @@ func TestService_ListLabels(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", "", "", "")
 		// WHEN
 		_, err := svc.ListLabels(context.TODO(), "id")
 		// then
@@ -2306,6 +2309,7 @@ func TestService_SetLabel(t *testing.T) {
 		LabelUpsertServiceFn func() *automock.LabelUpsertService
 		LabelRepositoryFn    func() *automock.LabelRepository
 		FormationServiceFn   func() *automock.FormationService
+		ChangeEventServiceFn func() *automock.ChangeEventService
 		InputRuntimeID       string
 		InputLabel           *model.LabelInput
 		ExpectedErrMessage   string
@@ -2589,6 +2593,29 @@ func TestService_SetLabel(t *testing.T) {
 			InputLabel:           &modelProtectedLabelInput,
 			ExpectedErrMessage:   "could not set unmodifiable label with key protected_defaultEventing",
 		},
+		{
+			Name: "Returns error when publishing change event failed",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, &modelLabelInput).Return(nil).Once()
+				return svc
+			},
+			LabelRepositoryFn:  unusedLabelRepository,
+			FormationServiceFn: unusedFormationService,
+			ChangeEventServiceFn: func() *automock.ChangeEventService {
+				svc := &automock.ChangeEventService{}
+				svc.On("Publish", ctx, tnt, model.ChangeEventInput{Type: model.ChangeEventTypeLabelChanged, ResourceType: resource.Runtime, ResourceID: runtimeID, LabelKey: labelKey}).Return(testErr).Once()
+				return svc
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &modelLabelInput,
+			ExpectedErrMessage: testErr.Error(),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2597,7 +2624,11 @@ func TestService_SetLabel(t *testing.T) {
 			labelSvc := testCase.LabelUpsertServiceFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			engineSvc := testCase.FormationServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			changeEventSvc := changeEventServiceThatPublishes()
+			if testCase.ChangeEventServiceFn != nil {
+				changeEventSvc = testCase.ChangeEventServiceFn()
+			}
+			svc := runtime.NewService(repo, labelRepo, nil, labelSvc, nil, engineSvc, nil, nil, nil, changeEventSvc, protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -2609,13 +2640,13 @@ func TestService_SetLabel(t *testing.T) {
 				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
 			}
 
-			mock.AssertExpectationsForObjects(t, repo, labelSvc, labelRepo, engineSvc)
+			mock.AssertExpectationsForObjects(t, repo, labelSvc, labelRepo, engineSvc, changeEventSvc)
 		})
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 		// WHEN
 		err := svc.SetLabel(context.TODO(), &model.LabelInput{})
 		// then
@@ -2827,7 +2858,7 @@ func TestService_DeleteLabel(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			engineSvc := testCase.FormationServiceFn()
-			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, engineSvc, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepo, nil, nil, nil, engineSvc, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			err := svc.DeleteLabel(ctx, testCase.InputRuntimeID, testCase.InputKey)
@@ -2846,7 +2877,7 @@ func TestService_DeleteLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// GIVEN
-		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 		// WHEN
 		err := svc.DeleteLabel(context.TODO(), "id", "key")
 		// then
@@ -2902,7 +2933,7 @@ func TestService_GetByFiltersGlobal(t *testing.T) {
 			scenariosService := &automock.ScenariosService{}
 			formationService := &automock.FormationService{}
 			uidSvc := &automock.UidService{}
-			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			actualRuntime, err := svc.GetByFiltersGlobal(ctx, filters)
@@ -2971,7 +3002,7 @@ func TestService_GetByFilters(t *testing.T) {
 			scenariosService := &automock.ScenariosService{}
 			formationService := &automock.FormationService{}
 			uidSvc := &automock.UidService{}
-			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, ".*_defaultEventing$", immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, changeEventServiceThatPublishes(), ".*_defaultEventing$", immutableLabelPattern, "", "")
 
 			// WHEN
 			actualRuntime, err := svc.GetByFilters(testCase.Context, filters)
@@ -3036,7 +3067,7 @@ func TestService_ListByFiltersGlobal(t *testing.T) {
 			scenariosService := &automock.ScenariosService{}
 			formationService := &automock.FormationService{}
 			uidSvc := &automock.UidService{}
-			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, protectedLabelPattern, immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, changeEventServiceThatPublishes(), protectedLabelPattern, immutableLabelPattern, "", "")
 
 			// WHEN
 			actualRuntimes, err := svc.ListByFiltersGlobal(ctx, filters)
@@ -3109,7 +3140,7 @@ func TestService_ListByFilters(t *testing.T) {
 			scenariosService := &automock.ScenariosService{}
 			formationService := &automock.FormationService{}
 			uidSvc := &automock.UidService{}
-			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, ".*_defaultEventing$", immutableLabelPattern, "", "")
+			svc := runtime.NewService(repo, labelRepository, scenariosService, labelUpsertService, uidSvc, formationService, nil, nil, nil, changeEventServiceThatPublishes(), ".*_defaultEventing$", immutableLabelPattern, "", "")
 
 			// WHEN
 			actualRuntimes, err := svc.ListByFilters(testCase.Context, filters)
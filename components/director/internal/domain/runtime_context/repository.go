@@ -3,7 +3,6 @@ package runtimectx
 import (
 	"context"
 
-	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -169,7 +168,7 @@ func (r *pgRepository) List(ctx context.Context, runtimeID string, tenant string
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, resource.RuntimeContext, tenant, pageSize, cursor, "id", &runtimeCtxsCollection, conditions...)
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.RuntimeContext, tenant, pageSize, cursor, repo.NewAscOrderBy("id"), &runtimeCtxsCollection, conditions...)
 
 	if err != nil {
 		return nil, err
@@ -191,7 +190,7 @@ func (r *pgRepository) List(ctx context.Context, runtimeID string, tenant string
 func (r *pgRepository) ListByRuntimeIDs(ctx context.Context, tenantID string, runtimeIDs []string, pageSize int, cursor string) ([]*model.RuntimeContextPage, error) {
 	var runtimeCtxsCollection RuntimeContextCollection
 
-	counts, err := r.unionLister.List(ctx, resource.RuntimeContext, tenantID, runtimeIDs, "runtime_id", pageSize, cursor, orderByColumns, &runtimeCtxsCollection)
+	counts, pages, err := r.unionLister.List(ctx, resource.RuntimeContext, tenantID, runtimeIDs, "runtime_id", pageSize, cursor, orderByColumns, &runtimeCtxsCollection)
 	if err != nil {
 		return nil, err
 	}
@@ -202,28 +201,9 @@ func (r *pgRepository) ListByRuntimeIDs(ctx context.Context, tenantID string, ru
 		runtimeContextByID[runtimeContextEntity.RuntimeID] = append(runtimeContextByID[runtimeContextEntity.RuntimeID], rc)
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
-	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
-	}
-
 	runtimeContextPages := make([]*model.RuntimeContextPage, 0, len(runtimeIDs))
 	for _, runtimeID := range runtimeIDs {
-		totalCount := counts[runtimeID]
-		hasNextPage := false
-		endCursor := ""
-		if totalCount > offset+len(runtimeContextByID[runtimeID]) {
-			hasNextPage = true
-			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
-		}
-
-		page := &pagination.Page{
-			StartCursor: cursor,
-			EndCursor:   endCursor,
-			HasNextPage: hasNextPage,
-		}
-
-		runtimeContextPages = append(runtimeContextPages, &model.RuntimeContextPage{Data: runtimeContextByID[runtimeID], TotalCount: totalCount, PageInfo: page})
+		runtimeContextPages = append(runtimeContextPages, &model.RuntimeContextPage{Data: runtimeContextByID[runtimeID], TotalCount: counts[runtimeID], PageInfo: pages[runtimeID]})
 	}
 
 	return runtimeContextPages, nil
@@ -240,6 +220,20 @@ func (r *pgRepository) ListAll(ctx context.Context, tenant string) ([]*model.Run
 	return r.multipleFromEntities(entities), nil
 }
 
+// ListAllByIDs retrieves all RuntimeContext objects with the given IDs from the database that are visible for `tenant`
+func (r *pgRepository) ListAllByIDs(ctx context.Context, tenant string, ids []string) ([]*model.RuntimeContext, error) {
+	if len(ids) == 0 {
+		return []*model.RuntimeContext{}, nil
+	}
+
+	var entities RuntimeContextCollection
+	if err := r.lister.List(ctx, resource.RuntimeContext, tenant, &entities, repo.NewInConditionForStringValues("id", ids)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
 // ListAllForRuntime retrieves all RuntimeContext objects for runtime with ID `runtimeID`  from the database that are visible for `tenant`
 func (r *pgRepository) ListAllForRuntime(ctx context.Context, tenant, runtimeID string) ([]*model.RuntimeContext, error) {
 	var entities RuntimeContextCollection
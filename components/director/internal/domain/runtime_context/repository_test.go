@@ -168,7 +168,7 @@ func TestPgRepository_List(t *testing.T) {
 			{
 				Query: regexp.QuoteMeta(`SELECT id, runtime_id, key, value FROM public.runtime_contexts WHERE (runtime_id = $1
 												AND id IN (SELECT "runtime_context_id" FROM public.labels WHERE "runtime_context_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_contexts_labels_tenants WHERE tenant_id = $2)) AND "key" = $3 AND "value" ?| array[$4])
-												AND (id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $5))) ORDER BY id LIMIT 2 OFFSET 0`),
+												AND (id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $5))) ORDER BY id ASC LIMIT 3`),
 				Args:     []driver.Value{runtimeID, tenantID, model.ScenariosKey, "scenario", tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -228,6 +228,11 @@ func TestPgRepository_ListByRuntimeIDs(t *testing.T) {
 	runtimeCtxModel1 := fixModelRuntimeCtxWithIDAndRuntimeID(runtimeCtx1ID, onePageRuntimeID)
 	runtimeCtxModel2 := fixModelRuntimeCtxWithIDAndRuntimeID(runtimeCtx2ID, multiplePagesRuntimeID)
 
+	runtimeCtx3ID := "f8cb4a4e-ebd3-4e63-8a9f-f3e6a4dcfe2b"
+	runtimeCtxEntity3 := fixEntityRuntimeCtxWithIDAndRuntimeID(runtimeCtx3ID, multiplePagesRuntimeID)
+	multiplePagesEndCursor, err := pagination.EncodeKeysetCursor(runtimeCtx2ID)
+	require.NoError(t, err)
+
 	suite := testdb.RepoListPageableTestSuite{
 		Name: "ListByRuntimeIDs Runtime Contexts",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
@@ -236,13 +241,15 @@ func TestPgRepository_ListByRuntimeIDs(t *testing.T) {
 												UNION
 												(SELECT id, runtime_id, key, value FROM public.runtime_contexts WHERE (id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $5)) AND runtime_id = $6 ORDER BY runtime_id ASC, id ASC LIMIT $7 OFFSET $8)
 												UNION
-												(SELECT id, runtime_id, key, value FROM public.runtime_contexts WHERE (id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $9)) AND runtime_id = $10 ORDER BY runtime_id ASC, id ASC LIMIT $11 OFFSET $12)`),
-				Args:     []driver.Value{tenantID, emptyPageRuntimeID, pageSize, 0, tenantID, onePageRuntimeID, pageSize, 0, tenantID, multiplePagesRuntimeID, pageSize, 0},
+												(SELECT id, runtime_id, key, value FROM public.runtime_contexts WHERE (id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $9)) AND runtime_id = $10 ORDER BY runtime_id ASC, id ASC LIMIT $11 OFFSET $12)
+												ORDER BY runtime_id ASC, id ASC`),
+				Args:     []driver.Value{tenantID, emptyPageRuntimeID, pageSize + 1, 0, tenantID, onePageRuntimeID, pageSize + 1, 0, tenantID, multiplePagesRuntimeID, pageSize + 1, 0},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).
 						AddRow(runtimeCtxEntity1.ID, runtimeCtxEntity1.RuntimeID, runtimeCtxEntity1.Key, runtimeCtxEntity1.Value).
-						AddRow(runtimeCtxEntity2.ID, runtimeCtxEntity2.RuntimeID, runtimeCtxEntity2.Key, runtimeCtxEntity2.Value),
+						AddRow(runtimeCtxEntity2.ID, runtimeCtxEntity2.RuntimeID, runtimeCtxEntity2.Key, runtimeCtxEntity2.Value).
+						AddRow(runtimeCtxEntity3.ID, runtimeCtxEntity3.RuntimeID, runtimeCtxEntity3.Key, runtimeCtxEntity3.Value),
 					}
 				},
 			},
@@ -289,7 +296,7 @@ func TestPgRepository_ListByRuntimeIDs(t *testing.T) {
 					Data: []*model.RuntimeContext{runtimeCtxModel2},
 					PageInfo: &pagination.Page{
 						StartCursor: "",
-						EndCursor:   pagination.EncodeNextOffsetCursor(0, pageSize),
+						EndCursor:   multiplePagesEndCursor,
 						HasNextPage: true,
 					},
 					TotalCount: 2,
@@ -391,6 +398,48 @@ func TestPgRepository_ListAllForRuntime(t *testing.T) {
 	suite.Run(t)
 }
 
+func TestPgRepository_ListAllByIDs(t *testing.T) {
+	runtimeCtx1ID := "aec0e9c5-06da-4625-9f8a-bda17ab8c3b9"
+	runtimeCtx2ID := "ccdbef8f-b97a-490c-86e2-2bab2862a6e4"
+	runtimeCtxEntity1 := fixEntityRuntimeCtxWithID(runtimeCtx1ID)
+	runtimeCtxEntity2 := fixEntityRuntimeCtxWithID(runtimeCtx2ID)
+
+	runtimeCtxModel1 := fixModelRuntimeCtxWithID(runtimeCtx1ID)
+	runtimeCtxModel2 := fixModelRuntimeCtxWithID(runtimeCtx2ID)
+
+	suite := testdb.RepoListTestSuite{
+		Name: "List Runtime Contexts",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query: regexp.QuoteMeta(`SELECT id, runtime_id, key, value FROM public.runtime_contexts WHERE id IN ($1, $2) AND
+												(id IN (SELECT id FROM tenant_runtime_contexts WHERE tenant_id = $3))`),
+				Args:     []driver.Value{runtimeCtx1ID, runtimeCtx2ID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).
+						AddRow(runtimeCtxEntity1.ID, runtimeCtxEntity1.RuntimeID, runtimeCtxEntity1.Key, runtimeCtxEntity1.Value).
+						AddRow(runtimeCtxEntity2.ID, runtimeCtxEntity2.RuntimeID, runtimeCtxEntity2.Key, runtimeCtxEntity2.Value),
+					}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		ExpectedDBEntities:        []interface{}{runtimeCtxEntity1, runtimeCtxEntity2},
+		ExpectedModelEntities:     []interface{}{runtimeCtxModel1, runtimeCtxModel2},
+		RepoConstructorFunc:       runtimectx.NewRepository,
+		MethodArgs:                []interface{}{tenantID, []string{runtimeCtx1ID, runtimeCtx2ID}},
+		MethodName:                "ListAllByIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
 func TestPgRepository_Create(t *testing.T) {
 	var nilRuntimeCtxModel *model.RuntimeContext
 	runtimeCtxModel := fixModelRuntimeCtx()
@@ -28,6 +28,7 @@ func NewRepository(conv EntityConverter) *repository {
 		lister:          repo.NewListerWithEmbeddedTenant(tableName, tenantColumn, columns),
 		singleGetter:    repo.NewSingleGetterWithEmbeddedTenant(tableName, tenantColumn, columns),
 		pageableQuerier: repo.NewPageableQuerierWithEmbeddedTenant(tableName, tenantColumn, columns),
+		unionLister:     repo.NewUnionListerWithEmbeddedTenant(tableName, tenantColumn, columns),
 		deleter:         repo.NewDeleterWithEmbeddedTenant(tableName, tenantColumn),
 		conv:            conv,
 	}
@@ -38,6 +39,7 @@ type repository struct {
 	singleGetter    repo.SingleGetter
 	lister          repo.Lister
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	deleter         repo.Deleter
 	conv            EntityConverter
 }
@@ -93,6 +95,61 @@ func (r *repository) ListForTargetTenant(ctx context.Context, tenantID string, t
 	return items, nil
 }
 
+// ListForScenarioNames lists the automatic scenario assignments for the given scenario names
+func (r *repository) ListForScenarioNames(ctx context.Context, tenantID string, scenarioNames []string) ([]*model.AutomaticScenarioAssignment, error) {
+	if len(scenarioNames) == 0 {
+		return []*model.AutomaticScenarioAssignment{}, nil
+	}
+
+	var out EntityCollection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(scenarioColumn, scenarioNames),
+	}
+
+	if err := r.lister.List(ctx, resource.AutomaticScenarioAssigment, tenantID, &out, conditions...); err != nil {
+		return nil, errors.Wrap(err, "while getting automatic scenario assignments from db")
+	}
+
+	items := make([]*model.AutomaticScenarioAssignment, 0, len(out))
+
+	for _, v := range out {
+		item := r.conv.FromEntity(v)
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// ListForScenarioNamesPages lists a page of the automatic scenario assignments for each of the given scenario names.
+// The assignments of each scenario are ordered by their target tenant.
+func (r *repository) ListForScenarioNamesPages(ctx context.Context, tenantID string, scenarioNames []string, pageSize int, cursor string) ([]*model.AutomaticScenarioAssignmentPage, error) {
+	if len(scenarioNames) == 0 {
+		return []*model.AutomaticScenarioAssignmentPage{}, nil
+	}
+
+	var out EntityCollection
+	orderBy := repo.OrderByParams{repo.NewAscOrderBy(scenarioColumn), repo.NewAscOrderBy(targetTenantColumn)}
+
+	counts, pages, err := r.unionLister.List(ctx, resource.AutomaticScenarioAssigment, tenantID, scenarioNames, scenarioColumn, pageSize, cursor, orderBy, &out, repo.NewInConditionForStringValues(scenarioColumn, scenarioNames))
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting automatic scenario assignments from db")
+	}
+
+	itemsByScenario := make(map[string][]*model.AutomaticScenarioAssignment, len(scenarioNames))
+	for _, v := range out {
+		item := r.conv.FromEntity(v)
+		itemsByScenario[v.Scenario] = append(itemsByScenario[v.Scenario], &item)
+	}
+
+	assignmentPages := make([]*model.AutomaticScenarioAssignmentPage, 0, len(scenarioNames))
+	for _, scenarioName := range scenarioNames {
+		assignmentPages = append(assignmentPages, &model.AutomaticScenarioAssignmentPage{Data: itemsByScenario[scenarioName], TotalCount: counts[scenarioName], PageInfo: pages[scenarioName]})
+	}
+
+	return assignmentPages, nil
+}
+
 // GetForScenarioName missing godoc
 func (r *repository) GetForScenarioName(ctx context.Context, tenantID, scenarioName string) (model.AutomaticScenarioAssignment, error) {
 	var ent Entity
@@ -113,7 +170,7 @@ func (r *repository) GetForScenarioName(ctx context.Context, tenantID, scenarioN
 // List missing godoc
 func (r *repository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.AutomaticScenarioAssignmentPage, error) {
 	var collection EntityCollection
-	page, totalCount, err := r.pageableQuerier.List(ctx, resource.AutomaticScenarioAssigment, tenantID, pageSize, cursor, scenarioColumn, &collection)
+	page, totalCount, err := r.pageableQuerier.List(ctx, resource.AutomaticScenarioAssigment, tenantID, pageSize, cursor, repo.NewAscOrderBy(scenarioColumn), &collection)
 	if err != nil {
 		return nil, err
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -227,10 +228,154 @@ func TestRepository_ListForTargetTenant(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForScenarioNames(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		scenarioEntities := []scenarioassignment.Entity{fixEntityWithScenarioName(scenarioName),
+			fixEntityWithScenarioName("scenario-B")}
+		scenarioModels := []model.AutomaticScenarioAssignment{fixModelWithScenarioName(scenarioName),
+			fixModelWithScenarioName("scenario-B")}
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", scenarioEntities[0]).Return(scenarioModels[0]).Once()
+		mockConverter.On("FromEntity", scenarioEntities[1]).Return(scenarioModels[1]).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rowsToReturn := fixSQLRows([]sqlRow{
+			{scenario: scenarioName, tenantID: tenantID, targetTenantID: targetTenantID},
+			{scenario: "scenario-B", tenantID: tenantID, targetTenantID: targetTenantID},
+		})
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT scenario, tenant_id, target_tenant_id FROM public.automatic_scenario_assignments WHERE tenant_id = $1 AND scenario IN ($2, $3)`)).
+			WithArgs(tenantID, scenarioName, "scenario-B").
+			WillReturnRows(rowsToReturn)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListForScenarioNames(ctx, tenantID, []string{scenarioName, "scenario-B"})
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, scenarioModels[0], *result[0])
+		assert.Equal(t, scenarioModels[1], *result[1])
+	})
+
+	t.Run("Success without querying when there are no scenario names", func(t *testing.T) {
+		// GIVEN
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.ListForScenarioNames(context.TODO(), tenantID, nil)
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("DB error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT .*").WillReturnError(fixError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.ListForScenarioNames(ctx, tenantID, []string{scenarioName})
+
+		// THEN
+		require.EqualError(t, err, "while getting automatic scenario assignments from db: Internal Server Error: Unexpected error while executing SQL query")
+		assert.Nil(t, result)
+	})
+}
+
+func TestRepository_ListForScenarioNamesPages(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		secondTargetTenantID := "targetTenantID2"
+		firstScenarioEntity := fixEntityWithScenarioName(scenarioName)
+		secondScenarioEntity := fixEntityWithScenarioName("scenario-B")
+		firstScenarioModel := fixModelWithScenarioName(scenarioName)
+		secondScenarioModel := fixModelWithScenarioName("scenario-B")
+
+		nextPageCursor, err := pagination.EncodeKeysetCursor(targetTenantID)
+		require.NoError(t, err)
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", firstScenarioEntity).Return(firstScenarioModel).Once()
+		mockConverter.On("FromEntity", secondScenarioEntity).Return(secondScenarioModel).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rowsToReturn := fixSQLRows([]sqlRow{
+			{scenario: scenarioName, tenantID: tenantID, targetTenantID: targetTenantID},
+			{scenario: scenarioName, tenantID: tenantID, targetTenantID: secondTargetTenantID},
+			{scenario: "scenario-B", tenantID: tenantID, targetTenantID: targetTenantID},
+		})
+		dbMock.ExpectQuery(regexp.QuoteMeta(`(SELECT scenario, tenant_id, target_tenant_id FROM public.automatic_scenario_assignments WHERE tenant_id = $1 AND scenario IN ($2, $3) AND scenario = $4 ORDER BY scenario ASC, target_tenant_id ASC LIMIT $5 OFFSET $6)
+												UNION
+												(SELECT scenario, tenant_id, target_tenant_id FROM public.automatic_scenario_assignments WHERE tenant_id = $7 AND scenario IN ($8, $9) AND scenario = $10 ORDER BY scenario ASC, target_tenant_id ASC LIMIT $11 OFFSET $12)
+												ORDER BY scenario ASC, target_tenant_id ASC`)).
+			WithArgs(tenantID, scenarioName, "scenario-B", scenarioName, 2, 0, tenantID, scenarioName, "scenario-B", "scenario-B", 2, 0).
+			WillReturnRows(rowsToReturn)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT scenario AS id, COUNT(*) AS total_count FROM public.automatic_scenario_assignments WHERE tenant_id = $1 AND scenario IN ($2, $3) GROUP BY scenario ORDER BY scenario ASC`)).
+			WithArgs(tenantID, scenarioName, "scenario-B").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(scenarioName, 2).AddRow("scenario-B", 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListForScenarioNamesPages(ctx, tenantID, []string{scenarioName, "scenario-B"}, 1, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.AutomaticScenarioAssignmentPage{
+			{Data: []*model.AutomaticScenarioAssignment{&firstScenarioModel}, PageInfo: &pagination.Page{EndCursor: nextPageCursor, HasNextPage: true}, TotalCount: 2},
+			{Data: []*model.AutomaticScenarioAssignment{&secondScenarioModel}, PageInfo: &pagination.Page{}, TotalCount: 1},
+		}, result)
+	})
+
+	t.Run("Success without querying when there are no scenario names", func(t *testing.T) {
+		// GIVEN
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.ListForScenarioNamesPages(context.TODO(), tenantID, nil, 1, "")
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("DB error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(".*").WillReturnError(fixError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenarioassignment.NewRepository(nil)
+
+		// WHEN
+		result, err := repo.ListForScenarioNamesPages(ctx, tenantID, []string{scenarioName}, 1, "")
+
+		// THEN
+		require.EqualError(t, err, "while getting automatic scenario assignments from db: Internal Server Error: Unexpected error while executing SQL query")
+		assert.Nil(t, result)
+	})
+}
+
 func TestRepository_List(t *testing.T) {
 	// GIVEN
-	ExpectedLimit := 3
-	ExpectedOffset := 0
+	ExpectedLimit := 4
 
 	inputPageSize := 3
 	inputCursor := ""
@@ -247,7 +392,7 @@ func TestRepository_List(t *testing.T) {
 
 	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.automatic_scenario_assignments
 		WHERE tenant_id = \$1
-		ORDER BY scenario LIMIT %d OFFSET %d`, ExpectedLimit, ExpectedOffset)
+		ORDER BY scenario ASC LIMIT %d`, ExpectedLimit)
 
 	rawCountQuery := `SELECT COUNT(*) FROM public.automatic_scenario_assignments WHERE tenant_id = $1`
 	countQuery := regexp.QuoteMeta(rawCountQuery)
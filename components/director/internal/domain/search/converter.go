@@ -0,0 +1,75 @@
+package search
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter creates a new search result converter
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// FromEntity converts a search result Entity to model.SearchResult
+func (c *converter) FromEntity(entity *Entity) *model.SearchResult {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.SearchResult{
+		Kind:          model.SearchResultKind(entity.Kind),
+		ID:            entity.ID,
+		ApplicationID: repo.StringPtrFromNullableString(entity.ApplicationID),
+		Name:          entity.Name,
+		Description:   repo.StringPtrFromNullableString(entity.Description),
+		OrdID:         repo.StringPtrFromNullableString(entity.OrdID),
+		Rank:          entity.Rank,
+	}
+}
+
+// ToGraphQL converts model.SearchResult to graphql.SearchResult
+func (c *converter) ToGraphQL(in *model.SearchResult) *graphql.SearchResult {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.SearchResult{
+		Kind:          graphql.SearchResultKind(in.Kind),
+		ID:            in.ID,
+		ApplicationID: in.ApplicationID,
+		Name:          in.Name,
+		Description:   in.Description,
+		OrdID:         in.OrdID,
+		Rank:          in.Rank,
+	}
+}
+
+// MultipleToGraphQL converts multiple model.SearchResult to graphql.SearchResult
+func (c *converter) MultipleToGraphQL(in []*model.SearchResult) []*graphql.SearchResult {
+	out := make([]*graphql.SearchResult, 0, len(in))
+	for _, result := range in {
+		if result == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(result))
+	}
+
+	return out
+}
+
+// KindsFromGraphQL converts the graphql search result kinds to model.SearchResultKind
+func (c *converter) KindsFromGraphQL(in []graphql.SearchResultKind) []model.SearchResultKind {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]model.SearchResultKind, 0, len(in))
+	for _, kind := range in {
+		out = append(out, model.SearchResultKind(kind))
+	}
+
+	return out
+}
@@ -0,0 +1,48 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := search.NewConverter()
+
+	t.Run("Success", func(t *testing.T) {
+		assert.Equal(t, fixModelSearchResult(), conv.FromEntity(fixEntitySearchResult()))
+	})
+
+	t.Run("Returns nil for nil entity", func(t *testing.T) {
+		assert.Nil(t, conv.FromEntity(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := search.NewConverter()
+
+	t.Run("Success", func(t *testing.T) {
+		out := conv.MultipleToGraphQL([]*model.SearchResult{fixModelSearchResult(), nil})
+		assert.Equal(t, []*graphql.SearchResult{fixGQLSearchResult()}, out)
+	})
+
+	t.Run("Returns empty slice for nil input", func(t *testing.T) {
+		assert.Equal(t, []*graphql.SearchResult{}, conv.MultipleToGraphQL(nil))
+	})
+}
+
+func TestConverter_KindsFromGraphQL(t *testing.T) {
+	conv := search.NewConverter()
+
+	t.Run("Success", func(t *testing.T) {
+		out := conv.KindsFromGraphQL([]graphql.SearchResultKind{graphql.SearchResultKindAPIDefinition, graphql.SearchResultKindDocument})
+		assert.Equal(t, []model.SearchResultKind{model.SearchResultKindAPIDefinition, model.SearchResultKindDocument}, out)
+	})
+
+	t.Run("Returns nil for nil input", func(t *testing.T) {
+		assert.Nil(t, conv.KindsFromGraphQL(nil))
+	})
+}
@@ -0,0 +1,17 @@
+package search
+
+import "database/sql"
+
+// Entity is a single row of the full-text search query
+type Entity struct {
+	Kind          string         `db:"kind"`
+	ID            string         `db:"id"`
+	ApplicationID sql.NullString `db:"app_id"`
+	Name          string         `db:"name"`
+	Description   sql.NullString `db:"description"`
+	OrdID         sql.NullString `db:"ord_id"`
+	Rank          float64        `db:"rank"`
+}
+
+// EntityCollection missing godoc
+type EntityCollection []Entity
@@ -0,0 +1,72 @@
+package search_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	tenantID    = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalID  = "eb2d5110-ca3a-11eb-b8bc-0242ac130003"
+	runtimeID   = "e6b1a7ba-3d2f-4e45-bd6c-0f1a1b2c3d4e"
+	bundleID    = "ddddddddd-dddd-dddd-dddd-dddddddddddd"
+	appID       = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	term        = "orders"
+	name        = "Orders"
+	description = "Manages orders"
+	ordID       = "ns:consumptionBundle:ORDERS:v1"
+	rank        = 0.6
+	scenario    = "DEFAULT"
+)
+
+func fixModelSearchResult() *model.SearchResult {
+	return &model.SearchResult{
+		Kind:          model.SearchResultKindBundle,
+		ID:            bundleID,
+		ApplicationID: str(appID),
+		Name:          name,
+		Description:   str(description),
+		OrdID:         str(ordID),
+		Rank:          rank,
+	}
+}
+
+func fixGQLSearchResult() *graphql.SearchResult {
+	return &graphql.SearchResult{
+		Kind:          graphql.SearchResultKindBundle,
+		ID:            bundleID,
+		ApplicationID: str(appID),
+		Name:          name,
+		Description:   str(description),
+		OrdID:         str(ordID),
+		Rank:          rank,
+	}
+}
+
+func fixEntitySearchResult() *search.Entity {
+	return &search.Entity{
+		Kind:          string(model.SearchResultKindBundle),
+		ID:            bundleID,
+		ApplicationID: sql.NullString{String: appID, Valid: true},
+		Name:          name,
+		Description:   sql.NullString{String: description, Valid: true},
+		OrdID:         sql.NullString{String: ordID, Valid: true},
+		Rank:          rank,
+	}
+}
+
+func fixSearchResultColumns() []string {
+	return []string{"kind", "id", "app_id", "name", "description", "ord_id", "rank"}
+}
+
+func fixSearchResultRow() []driver.Value {
+	return []driver.Value{string(model.SearchResultKindBundle), bundleID, appID, name, description, ordID, rank}
+}
+
+func str(s string) *string {
+	return &s
+}
@@ -0,0 +1,291 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	labelsTable = "public.labels"
+
+	// searchQueryCTE parses the search term once, so that all searched tables can reference it as search_query.query
+	searchQueryCTE = "WITH search_query AS (SELECT plainto_tsquery('simple', ?) AS query) "
+	matchCondition = "search_vector @@ search_query.query"
+	rankColumn     = "ts_rank(search_vector, search_query.query)"
+
+	// labelVector must be the same expression as the one of the labels_app_search_vector_idx index
+	labelVector = "to_tsvector('simple', key || ' ' || coalesce(value::text, ''))"
+	// labelMatchRank is added to the rank of Applications with a matching label
+	labelMatchRank = "0.1::real"
+
+	countQuery = searchQueryCTE + "SELECT COUNT(*) FROM (%s) AS results"
+	// pageQuery selects one result more than the page size, so that it can be determined whether there is a next page
+	pageQuery = searchQueryCTE + "SELECT kind, id, app_id, name, description, ord_id, rank FROM (%s) AS results%s ORDER BY rank DESC, kind ASC, id ASC LIMIT %d%s"
+	// keysetCondition selects the results after the last result of the previous page, whose rank, kind and ID are stored in the cursor.
+	// The rank is sorted in the opposite direction of the kind and the ID, so they cannot be compared as a single row.
+	keysetCondition = " WHERE (rank < ? OR (rank = ? AND (kind, id) > (?, ?)))"
+	keysetLength    = 3
+)
+
+// searchable describes how the resources of a given kind are matched
+type searchable struct {
+	resourceType      resource.Type
+	table             string
+	appIDColumn       string
+	nameColumn        string
+	descriptionColumn string
+	ordIDColumn       string
+	matchLabels       bool
+}
+
+var searchables = map[model.SearchResultKind]searchable{
+	model.SearchResultKindApplication: {
+		resourceType:      resource.Application,
+		table:             "public.applications",
+		appIDColumn:       "id",
+		nameColumn:        "name",
+		descriptionColumn: "description",
+		ordIDColumn:       "NULL",
+		matchLabels:       true,
+	},
+	model.SearchResultKindBundle: {
+		resourceType:      resource.Bundle,
+		table:             "public.bundles",
+		appIDColumn:       "app_id",
+		nameColumn:        "name",
+		descriptionColumn: "description",
+		ordIDColumn:       "ord_id",
+	},
+	model.SearchResultKindAPIDefinition: {
+		resourceType:      resource.API,
+		table:             "public.api_definitions",
+		appIDColumn:       "app_id",
+		nameColumn:        "name",
+		descriptionColumn: "description",
+		ordIDColumn:       "ord_id",
+	},
+	model.SearchResultKindEventDefinition: {
+		resourceType:      resource.EventDefinition,
+		table:             "public.event_api_definitions",
+		appIDColumn:       "app_id",
+		nameColumn:        "name",
+		descriptionColumn: "description",
+		ordIDColumn:       "ord_id",
+	},
+	model.SearchResultKindDocument: {
+		resourceType:      resource.Document,
+		table:             "public.documents",
+		appIDColumn:       "app_id",
+		nameColumn:        "title",
+		descriptionColumn: "description",
+		ordIDColumn:       "NULL",
+	},
+}
+
+// EntityConverter missing godoc
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EntityConverter interface {
+	FromEntity(entity *Entity) *model.SearchResult
+}
+
+type pgRepository struct {
+	conv EntityConverter
+}
+
+// NewRepository creates a new full-text search repository
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv: conv,
+	}
+}
+
+// Search returns a page of the resources visible in the tenant which match the filter, ordered by relevance
+func (r *pgRepository) Search(ctx context.Context, tenantID string, filter model.SearchFilter, pageSize int, cursor string) (*model.SearchResultPage, error) {
+	decodedCursor, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	unionQuery, args, err := buildUnionQuery(tenantID, filter)
+	if err != nil {
+		return nil, err
+	}
+	args = append([]interface{}{filter.Term}, args...)
+
+	pageCondition, pageArgs, err := buildPageCondition(decodedCursor)
+	if err != nil {
+		return nil, err
+	}
+	offset := ""
+	if decodedCursor.Offset > 0 {
+		offset = fmt.Sprintf(" OFFSET %d", decodedCursor.Offset)
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	stmt := repo.Rebind(fmt.Sprintf(countQuery, unionQuery))
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	var totalCount int
+	if err = persist.GetContext(ctx, &totalCount, stmt, args...); err != nil {
+		return nil, errors.Wrap(err, "while counting search results")
+	}
+
+	stmt = repo.Rebind(fmt.Sprintf(pageQuery, unionQuery, pageCondition, pageSize+1, offset))
+	log.C(ctx).Debugf("Executing DB query: %s", stmt)
+	var entities EntityCollection
+	if err = persist.SelectContext(ctx, &entities, stmt, append(args, pageArgs...)...); err != nil {
+		return nil, errors.Wrap(err, "while listing search results")
+	}
+
+	hasNextPage := len(entities) > pageSize
+	endCursor := ""
+	if hasNextPage {
+		entities = entities[:pageSize]
+		last := entities[pageSize-1]
+		if endCursor, err = pagination.EncodeKeysetCursor(last.Rank, last.Kind, last.ID); err != nil {
+			return nil, errors.Wrap(err, "while encoding next page cursor")
+		}
+	}
+
+	results := make([]*model.SearchResult, 0, len(entities))
+	for _, entity := range entities {
+		results = append(results, r.conv.FromEntity(&entity))
+	}
+
+	return &model.SearchResultPage{
+		Data:       results,
+		TotalCount: totalCount,
+		PageInfo: &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		},
+	}, nil
+}
+
+// buildPageCondition selects the results after the ones of the previous pages if the cursor is a keyset cursor
+func buildPageCondition(cursor *pagination.Cursor) (string, []interface{}, error) {
+	if cursor.Keyset == nil {
+		return "", nil, nil
+	}
+
+	if len(cursor.Keyset) != keysetLength {
+		return "", nil, apperrors.NewInvalidDataError("cursor is not correct")
+	}
+
+	rank, kind, id := cursor.Keyset[0], cursor.Keyset[1], cursor.Keyset[2]
+	return keysetCondition, []interface{}{rank, rank, kind, id}, nil
+}
+
+func buildUnionQuery(tenantID string, filter model.SearchFilter) (string, []interface{}, error) {
+	kinds := filter.Kinds
+	if len(kinds) == 0 {
+		kinds = model.SearchResultKinds
+	}
+
+	queries := make([]string, 0, len(kinds))
+	var args []interface{}
+	for _, kind := range kinds {
+		s, ok := searchables[kind]
+		if !ok {
+			return "", nil, errors.Errorf("unsupported search result kind %q", kind)
+		}
+
+		query, queryArgs, err := buildQuery(kind, s, tenantID, filter.Scenarios)
+		if err != nil {
+			return "", nil, err
+		}
+		queries = append(queries, query)
+		args = append(args, queryArgs...)
+	}
+
+	return strings.Join(queries, " UNION ALL "), args, nil
+}
+
+func buildQuery(kind model.SearchResultKind, s searchable, tenantID string, scenarios []string) (string, []interface{}, error) {
+	var args []interface{}
+
+	rank := rankColumn
+	match := matchCondition
+	if s.matchLabels {
+		labelMatch, labelMatchArgs, err := buildLabelMatch(s, tenantID)
+		if err != nil {
+			return "", nil, err
+		}
+		rank = fmt.Sprintf("%s + CASE WHEN %s THEN %s ELSE 0 END", rankColumn, labelMatch, labelMatchRank)
+		match = fmt.Sprintf("(%s OR %s)", matchCondition, labelMatch)
+		args = append(args, labelMatchArgs...)
+		args = append(args, labelMatchArgs...)
+	}
+
+	tenantIsolation, err := repo.NewTenantIsolationCondition(s.resourceType, tenantID, false)
+	if err != nil {
+		return "", nil, err
+	}
+	conditions := []repo.Condition{tenantIsolation}
+
+	if scenarios != nil {
+		scenariosCondition, err := buildScenariosCondition(s, tenantID, scenarios)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, scenariosCondition)
+	}
+
+	var stmtBuilder strings.Builder
+	stmtBuilder.WriteString(fmt.Sprintf("SELECT '%s' AS kind, id, %s AS app_id, %s AS name, %s AS description, %s AS ord_id, %s AS rank FROM %s, search_query WHERE %s",
+		kind, s.appIDColumn, s.nameColumn, s.descriptionColumn, s.ordIDColumn, rank, s.table, match))
+	for _, condition := range conditions {
+		stmtBuilder.WriteString(" AND ")
+		stmtBuilder.WriteString(condition.GetQueryPart())
+		if conditionArgs, ok := condition.GetQueryArgs(); ok {
+			args = append(args, conditionArgs...)
+		}
+	}
+
+	return stmtBuilder.String(), args, nil
+}
+
+// buildLabelMatch matches the labels of the Application which are visible in the tenant
+func buildLabelMatch(s searchable, tenantID string) (string, []interface{}, error) {
+	tenantIsolation, err := repo.NewTenantIsolationCondition(resource.ApplicationLabel, tenantID, false)
+	if err != nil {
+		return "", nil, err
+	}
+	args, _ := tenantIsolation.GetQueryArgs()
+
+	query := fmt.Sprintf("EXISTS (SELECT 1 FROM %s l WHERE l.app_id = %s.id AND %s @@ search_query.query AND %s)", labelsTable, s.table, labelVector, tenantIsolation.GetQueryPart())
+	return query, args, nil
+}
+
+// buildScenariosCondition restricts the resources to the ones of Applications which are in at least one of the scenarios
+func buildScenariosCondition(s searchable, tenantID string, scenarios []string) (repo.Condition, error) {
+	tenantIsolation, err := repo.NewTenantIsolationCondition(resource.ApplicationLabel, tenantID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	scenariosMatch := repo.NewJSONArrMatchAnyStringCondition("value", scenarios...)
+	subquery := fmt.Sprintf("SELECT app_id FROM %s WHERE key = ? AND %s AND %s", labelsTable, scenariosMatch.GetQueryPart(), tenantIsolation.GetQueryPart())
+
+	args := []interface{}{model.ScenariosKey}
+	scenariosArgs, _ := scenariosMatch.GetQueryArgs()
+	args = append(args, scenariosArgs...)
+	tenantArgs, _ := tenantIsolation.GetQueryArgs()
+	args = append(args, tenantArgs...)
+
+	return repo.NewInConditionForSubQuery(s.appIDColumn, subquery, args), nil
+}
@@ -0,0 +1,206 @@
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	bundleBranch  = `SELECT 'BUNDLE' AS kind, id, app_id AS app_id, name AS name, description AS description, ord_id AS ord_id, ts_rank(search_vector, search_query.query) AS rank FROM public.bundles, search_query WHERE search_vector @@ search_query.query AND (id IN (SELECT id FROM bundles_tenants WHERE tenant_id = $2))`
+	appLabelMatch = `EXISTS (SELECT 1 FROM public.labels l WHERE l.app_id = public.applications.id AND to_tsvector('simple', key || ' ' || coalesce(value::text, '')) @@ search_query.query AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = %s)))`
+)
+
+func TestPgRepository_Search(t *testing.T) {
+	filter := model.SearchFilter{
+		Term:  term,
+		Kinds: []model.SearchResultKind{model.SearchResultKindBundle},
+	}
+	countQuery := regexp.QuoteMeta(`WITH search_query AS (SELECT plainto_tsquery('simple', $1) AS query) SELECT COUNT(*) FROM (` + bundleBranch + `) AS results`)
+	pageQuery := regexp.QuoteMeta(`WITH search_query AS (SELECT plainto_tsquery('simple', $1) AS query) SELECT kind, id, app_id, name, description, ord_id, rank FROM (`+bundleBranch+`) AS results ORDER BY rank DESC, kind ASC, id ASC LIMIT 3`) + "$"
+	keysetPageQuery := regexp.QuoteMeta(`WITH search_query AS (SELECT plainto_tsquery('simple', $1) AS query) SELECT kind, id, app_id, name, description, ord_id, rank FROM (`+bundleBranch+`) AS results WHERE (rank < $3 OR (rank = $4 AND (kind, id) > ($5, $6))) ORDER BY rank DESC, kind ASC, id ASC LIMIT 3`) + "$"
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(term, tenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		dbMock.ExpectQuery(pageQuery).WithArgs(term, tenantID).WillReturnRows(sqlmock.NewRows(fixSearchResultColumns()).
+			AddRow(fixSearchResultRow()...).
+			AddRow(fixSearchResultRow()...).
+			AddRow(fixSearchResultRow()...))
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", fixEntitySearchResult()).Return(fixModelSearchResult()).Twice()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := search.NewRepository(conv)
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, filter, 2, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.SearchResult{fixModelSearchResult(), fixModelSearchResult()}, page.Data)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.True(t, page.PageInfo.HasNextPage)
+		expectedCursor, err := pagination.EncodeKeysetCursor(rank, string(model.SearchResultKindBundle), bundleID)
+		require.NoError(t, err)
+		assert.Equal(t, expectedCursor, page.PageInfo.EndCursor)
+	})
+
+	t.Run("Success with keyset cursor", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		cursor, err := pagination.EncodeKeysetCursor(rank, string(model.SearchResultKindBundle), bundleID)
+		require.NoError(t, err)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(term, tenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		dbMock.ExpectQuery(keysetPageQuery).WithArgs(term, tenantID, "0.6", "0.6", string(model.SearchResultKindBundle), bundleID).WillReturnRows(sqlmock.NewRows(fixSearchResultColumns()).AddRow(fixSearchResultRow()...))
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", fixEntitySearchResult()).Return(fixModelSearchResult()).Once()
+		defer conv.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := search.NewRepository(conv)
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, filter, 2, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.SearchResult{fixModelSearchResult()}, page.Data)
+		assert.Equal(t, 3, page.TotalCount)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Empty(t, page.PageInfo.EndCursor)
+	})
+
+	t.Run("Success when searching Applications of given scenarios", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		appFilter := model.SearchFilter{
+			Term:      term,
+			Kinds:     []model.SearchResultKind{model.SearchResultKindApplication},
+			Scenarios: []string{scenario},
+		}
+		appBranch := `SELECT 'APPLICATION' AS kind, id, id AS app_id, name AS name, description AS description, NULL AS ord_id, ts_rank(search_vector, search_query.query) + CASE WHEN ` + appLabelMatch + ` THEN 0.1::real ELSE 0 END AS rank FROM public.applications, search_query WHERE (search_vector @@ search_query.query OR ` + appLabelMatch + `) AND (id IN (SELECT id FROM tenant_applications WHERE tenant_id = $4)) AND id IN (SELECT app_id FROM public.labels WHERE key = $5 AND value ?| array[$6] AND (id IN (SELECT id FROM application_labels_tenants WHERE tenant_id = $7)))`
+		appBranch = regexp.QuoteMeta(`WITH search_query AS (SELECT plainto_tsquery('simple', $1) AS query) SELECT COUNT(*) FROM (` + fmt.Sprintf(appBranch, "$2", "$3") + `) AS results`)
+
+		dbMock.ExpectQuery(appBranch).WithArgs(term, tenantID, tenantID, tenantID, model.ScenariosKey, scenario, tenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		dbMock.ExpectQuery(regexp.QuoteMeta(`LIMIT 3`)).WithArgs(term, tenantID, tenantID, tenantID, model.ScenariosKey, scenario, tenantID).WillReturnRows(sqlmock.NewRows(fixSearchResultColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		page, err := repo.Search(ctx, tenantID, appFilter, 2, "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.Equal(t, 0, page.TotalCount)
+		assert.False(t, page.PageInfo.HasNextPage)
+	})
+
+	t.Run("Error when count fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(term, tenantID).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Search(ctx, tenantID, filter, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while counting search results")
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(countQuery).WithArgs(term, tenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		dbMock.ExpectQuery(pageQuery).WithArgs(term, tenantID).WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Search(ctx, tenantID, filter, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while listing search results")
+	})
+
+	t.Run("Error when kind is not supported", func(t *testing.T) {
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Search(context.TODO(), tenantID, model.SearchFilter{Term: term, Kinds: []model.SearchResultKind{"UNKNOWN"}}, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unsupported search result kind "UNKNOWN"`)
+	})
+
+	t.Run("Error when cursor is invalid", func(t *testing.T) {
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Search(context.TODO(), tenantID, filter, 2, "invalid")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding page cursor")
+	})
+
+	t.Run("Error when keyset cursor does not match the order of the results", func(t *testing.T) {
+		repo := search.NewRepository(nil)
+		cursor, err := pagination.EncodeKeysetCursor(bundleID)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = repo.Search(context.TODO(), tenantID, filter, 2, cursor)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cursor is not correct")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		repo := search.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.Search(context.TODO(), tenantID, filter, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
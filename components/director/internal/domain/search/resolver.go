@@ -0,0 +1,88 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// SearchService missing godoc
+//go:generate mockery --name=SearchService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SearchService interface {
+	Search(ctx context.Context, filter model.SearchFilter, pageSize int, cursor string) (*model.SearchResultPage, error)
+}
+
+// SearchConverter missing godoc
+//go:generate mockery --name=SearchConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SearchConverter interface {
+	MultipleToGraphQL(in []*model.SearchResult) []*graphql.SearchResult
+	KindsFromGraphQL(in []graphql.SearchResultKind) []model.SearchResultKind
+}
+
+// Resolver is responsible for the full-text search query
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      SearchService
+	conv     SearchConverter
+}
+
+// NewResolver creates a new full-text search resolver
+func NewResolver(transact persistence.Transactioner, svc SearchService, conv SearchConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Search returns a page of the resources which match the term, ordered by relevance
+func (r *Resolver) Search(ctx context.Context, term string, kinds []graphql.SearchResultKind, first *int, after *graphql.PageCursor) (*graphql.SearchResultPage, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, apperrors.NewInvalidDataError("search term must not be empty")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	filter := model.SearchFilter{
+		Term:  term,
+		Kinds: r.conv.KindsFromGraphQL(kinds),
+	}
+
+	page, err := r.svc.Search(ctx, filter, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.SearchResultPage{
+		Data:       r.conv.MultipleToGraphQL(page.Data),
+		TotalCount: page.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage: page.PageInfo.HasNextPage,
+		},
+	}, nil
+}
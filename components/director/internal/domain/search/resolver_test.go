@@ -0,0 +1,165 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Search(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := graphql.PageCursor("")
+	gqlKinds := []graphql.SearchResultKind{graphql.SearchResultKindBundle}
+	modelKinds := []model.SearchResultKind{model.SearchResultKindBundle}
+	filter := model.SearchFilter{Term: term, Kinds: modelKinds}
+
+	modelPage := &model.SearchResultPage{
+		Data:       []*model.SearchResult{fixModelSearchResult()},
+		PageInfo:   &pagination.Page{StartCursor: "", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+	gqlPage := &graphql.SearchResultPage{
+		Data:       []*graphql.SearchResult{fixGQLSearchResult()},
+		PageInfo:   &graphql.PageInfo{StartCursor: "", EndCursor: "end", HasNextPage: true},
+		TotalCount: 3,
+	}
+
+	testCases := []struct {
+		Name            string
+		Term            string
+		First           *int
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.SearchService
+		ConverterFn     func() *automock.SearchConverter
+		ExpectedPage    *graphql.SearchResultPage
+		ExpectedErr     string
+	}{
+		{
+			Name:            "Success",
+			Term:            "  " + term + " ",
+			First:           &first,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.SearchService {
+				svc := &automock.SearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), filter, first, "").Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SearchConverter {
+				conv := &automock.SearchConverter{}
+				conv.On("KindsFromGraphQL", gqlKinds).Return(modelKinds).Once()
+				conv.On("MultipleToGraphQL", modelPage.Data).Return(gqlPage.Data).Once()
+				return conv
+			},
+			ExpectedPage: gqlPage,
+		},
+		{
+			Name:            "Error when search fails",
+			Term:            term,
+			First:           &first,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.SearchService {
+				svc := &automock.SearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), filter, first, "").Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SearchConverter {
+				conv := &automock.SearchConverter{}
+				conv.On("KindsFromGraphQL", gqlKinds).Return(modelKinds).Once()
+				return conv
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction begin fails",
+			Term:            term,
+			First:           &first,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn:       unusedSearchService,
+			ConverterFn:     unusedSearchConverter,
+			ExpectedErr:     testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction commit fails",
+			Term:            term,
+			First:           &first,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.SearchService {
+				svc := &automock.SearchService{}
+				svc.On("Search", txtest.CtxWithDBMatcher(), filter, first, "").Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.SearchConverter {
+				conv := &automock.SearchConverter{}
+				conv.On("KindsFromGraphQL", gqlKinds).Return(modelKinds).Once()
+				return conv
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Error when term is blank",
+			Term:            "  ",
+			First:           &first,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn:       unusedSearchService,
+			ConverterFn:     unusedSearchConverter,
+			ExpectedErr:     "search term must not be empty",
+		},
+		{
+			Name:            "Error when first is missing",
+			Term:            term,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn:       unusedSearchService,
+			ConverterFn:     unusedSearchConverter,
+			ExpectedErr:     "missing required parameter 'first'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := search.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.Search(context.TODO(), testCase.Term, gqlKinds, testCase.First, &after)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPage, result)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func unusedSearchService() *automock.SearchService {
+	return &automock.SearchService{}
+}
+
+func unusedSearchConverter() *automock.SearchConverter {
+	return &automock.SearchConverter{}
+}
@@ -0,0 +1,89 @@
+package search
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/pkg/errors"
+)
+
+// SearchRepository missing godoc
+//go:generate mockery --name=SearchRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SearchRepository interface {
+	Search(ctx context.Context, tenantID string, filter model.SearchFilter, pageSize int, cursor string) (*model.SearchResultPage, error)
+}
+
+// LabelRepository missing godoc
+//go:generate mockery --name=LabelRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+}
+
+type service struct {
+	repo      SearchRepository
+	labelRepo LabelRepository
+}
+
+// NewService creates a new full-text search service
+func NewService(repo SearchRepository, labelRepo LabelRepository) *service {
+	return &service{
+		repo:      repo,
+		labelRepo: labelRepo,
+	}
+}
+
+// Search returns a page of the resources which match the filter, ordered by relevance.
+// When the caller is a Runtime, only resources of Applications which are in the same scenario as the Runtime are returned.
+func (s *service) Search(ctx context.Context, filter model.SearchFilter, pageSize int, cursor string) (*model.SearchResultPage, error) {
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if consumerInfo.ConsumerType == consumer.Runtime {
+		scenarios, err := s.runtimeScenarios(ctx, tnt, consumerInfo.ConsumerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(scenarios) == 0 {
+			log.C(ctx).Infof("Runtime with ID %s is not in any scenario, no search results are visible for it", consumerInfo.ConsumerID)
+			return &model.SearchResultPage{
+				Data:       []*model.SearchResult{},
+				PageInfo:   &pagination.Page{StartCursor: cursor},
+				TotalCount: 0,
+			}, nil
+		}
+
+		filter.Scenarios = scenarios
+	}
+
+	return s.repo.Search(ctx, tnt, filter, pageSize, cursor)
+}
+
+func (s *service) runtimeScenarios(ctx context.Context, tenantID, runtimeID string) ([]string, error) {
+	scenariosLabel, err := s.labelRepo.GetByKey(ctx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while fetching scenarios for runtime with ID %s", runtimeID)
+	}
+
+	return label.ValueToStringsSlice(scenariosLabel.Value)
+}
@@ -0,0 +1,168 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/search/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Search(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	filter := model.SearchFilter{Term: term}
+	scenariosFilter := model.SearchFilter{Term: term, Scenarios: []string{scenario}}
+	page := &model.SearchResultPage{
+		Data:       []*model.SearchResult{fixModelSearchResult()},
+		PageInfo:   &pagination.Page{},
+		TotalCount: 1,
+	}
+
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalID)
+	userCtx := consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User})
+	runtimeCtx := consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
+	scenariosLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{scenario}}
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		PageSize          int
+		RepoFn            func() *automock.SearchRepository
+		LabelRepoFn       func() *automock.LabelRepository
+		ExpectedPage      *model.SearchResultPage
+		ExpectedErrString string
+	}{
+		{
+			Name:     "Success for non-runtime consumer",
+			Context:  userCtx,
+			PageSize: 2,
+			RepoFn: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.On("Search", userCtx, tenantID, filter, 2, "").Return(page, nil).Once()
+				return repo
+			},
+			LabelRepoFn:  unusedLabelRepo,
+			ExpectedPage: page,
+		},
+		{
+			Name:     "Success for runtime consumer restricts the results to its scenarios",
+			Context:  runtimeCtx,
+			PageSize: 2,
+			RepoFn: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.On("Search", runtimeCtx, tenantID, scenariosFilter, 2, "").Return(page, nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", runtimeCtx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(scenariosLabel, nil).Once()
+				return repo
+			},
+			ExpectedPage: page,
+		},
+		{
+			Name:     "Returns empty page for runtime consumer without scenarios",
+			Context:  runtimeCtx,
+			PageSize: 2,
+			RepoFn:   unusedSearchRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", runtimeCtx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, model.ScenariosKey)).Once()
+				return repo
+			},
+			ExpectedPage: &model.SearchResultPage{Data: []*model.SearchResult{}, PageInfo: &pagination.Page{}, TotalCount: 0},
+		},
+		{
+			Name:     "Error when fetching runtime scenarios fails",
+			Context:  runtimeCtx,
+			PageSize: 2,
+			RepoFn:   unusedSearchRepo,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", runtimeCtx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrString: "while fetching scenarios for runtime",
+		},
+		{
+			Name:     "Error when search fails",
+			Context:  userCtx,
+			PageSize: 2,
+			RepoFn: func() *automock.SearchRepository {
+				repo := &automock.SearchRepository{}
+				repo.On("Search", userCtx, tenantID, filter, 2, "").Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn:       unusedLabelRepo,
+			ExpectedErrString: testErr.Error(),
+		},
+		{
+			Name:              "Error when page size is out of range",
+			Context:           userCtx,
+			PageSize:          201,
+			RepoFn:            unusedSearchRepo,
+			LabelRepoFn:       unusedLabelRepo,
+			ExpectedErrString: "page size must be between 1 and 200",
+		},
+		{
+			Name:              "Error when consumer is missing in the context",
+			Context:           ctx,
+			PageSize:          2,
+			RepoFn:            unusedSearchRepo,
+			LabelRepoFn:       unusedLabelRepo,
+			ExpectedErrString: "cannot read consumer from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			svc := search.NewService(repo, labelRepo)
+
+			// WHEN
+			result, err := svc.Search(testCase.Context, model.SearchFilter{Term: term}, testCase.PageSize, "")
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrString)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPage, result)
+			}
+
+			repo.AssertExpectations(t)
+			labelRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when tenant is missing in the context", func(t *testing.T) {
+		svc := search.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.Search(context.TODO(), filter, 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func unusedSearchRepo() *automock.SearchRepository {
+	return &automock.SearchRepository{}
+}
+
+func unusedLabelRepo() *automock.LabelRepository {
+	return &automock.LabelRepository{}
+}
@@ -16,20 +16,9 @@ type FetchRequestService struct {
 	mock.Mock
 }
 
-// HandleSpec provides a mock function with given fields: ctx, fr
-func (_m *FetchRequestService) HandleSpec(ctx context.Context, fr *model.FetchRequest) *string {
-	ret := _m.Called(ctx, fr)
-
-	var r0 *string
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest) *string); ok {
-		r0 = rf(ctx, fr)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
-		}
-	}
-
-	return r0
+// HandleSpec provides a mock function with given fields: ctx, fr, _a2
+func (_m *FetchRequestService) HandleSpec(ctx context.Context, fr *model.FetchRequest, _a2 *model.Spec) {
+	_m.Called(ctx, fr, _a2)
 }
 
 // NewFetchRequestService creates a new instance of FetchRequestService. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
@@ -128,7 +128,7 @@ func (r *repository) ListByReferenceObjectIDs(ctx context.Context, tenant string
 	}
 
 	var specs SpecCollection
-	_, err = r.unionLister.List(ctx, objectType.GetResourceType(), tenant, objectIDs, objectFieldName, pageSize, cursor, orderByColumns, &specs, conditions...)
+	_, _, err = r.unionLister.List(ctx, objectType.GetResourceType(), tenant, objectIDs, objectFieldName, pageSize, cursor, orderByColumns, &specs, conditions...)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +43,7 @@ type UIDService interface {
 // FetchRequestService missing godoc
 //go:generate mockery --name=FetchRequestService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type FetchRequestService interface {
-	HandleSpec(ctx context.Context, fr *model.FetchRequest) *string
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, spec *model.Spec)
 }
 
 type service struct {
@@ -130,7 +130,7 @@ func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecIn
 			return "", errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		s.fetchRequestService.HandleSpec(ctx, fr, spec)
 
 		if err = s.repo.Update(ctx, tnt, spec); err != nil {
 			return "", errors.Wrapf(err, "while updating %s Specification with id %q", objectType, id)
@@ -166,7 +166,7 @@ func (s *service) UpdateByReferenceObjectID(ctx context.Context, id string, in m
 			return errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		s.fetchRequestService.HandleSpec(ctx, fr, spec)
 	}
 
 	if err = s.repo.Update(ctx, tnt, spec); err != nil {
@@ -219,7 +219,7 @@ func (s *service) RefetchSpec(ctx context.Context, id string, objectType model.S
 	}
 
 	if fetchRequest != nil {
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fetchRequest)
+		s.fetchRequestService.HandleSpec(ctx, fetchRequest, spec)
 	}
 
 	if err = s.repo.Update(ctx, tnt, spec); err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -372,7 +373,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Return()
 				return svc
 			},
 			Input:       *specInputWithFR,
@@ -400,7 +401,9 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&specData)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Run(func(args mock.Arguments) {
+					args.Get(2).(*model.Spec).Data = &specData
+				}).Return()
 				return svc
 			},
 			Input:       *specInputWithFR,
@@ -471,7 +474,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Return()
 				return svc
 			},
 			Input:       *specInputWithFR,
@@ -579,7 +582,9 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&specData)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Run(func(args mock.Arguments) {
+					args.Get(2).(*model.Spec).Data = &specData
+				}).Return()
 				return svc
 			},
 			InputID:     specID,
@@ -608,7 +613,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Return()
 				return svc
 			},
 			InputID:     specID,
@@ -857,7 +862,9 @@ func TestService_RefetchSpec(t *testing.T) {
 			},
 			FetchRequestSvcFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&dataBytes)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("*model.Spec")).Run(func(args mock.Arguments) {
+					args.Get(2).(*model.Spec).Data = &dataBytes
+				}).Return()
 				return svc
 			},
 			ExpectedAPISpec: modelSpec,
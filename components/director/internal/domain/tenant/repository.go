@@ -167,7 +167,7 @@ func (r *pgRepository) ListPageBySearchTerm(ctx context.Context, searchTerm stri
 		&repo.ConditionTree{Operand: repo.NewEqualCondition(statusColumn, tenant.Active)},
 		repo.Or(repo.ConditionTreesFromConditions(likeConditions)...))
 
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobalWithAdditionalConditions(ctx, pageSize, cursor, externalNameColumn, &entityCollection, conditions)
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobalWithAdditionalConditions(ctx, pageSize, cursor, repo.NewAscOrderBy(externalNameColumn), &entityCollection, conditions)
 	if err != nil {
 		return nil, errors.Wrap(err, "while listing tenants from DB")
 	}
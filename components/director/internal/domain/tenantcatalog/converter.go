@@ -0,0 +1,340 @@
+package tenantcatalog
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+// NewConverter creates a new converter of the resources of a tenant to tenant catalog entries
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ApplicationToInput converts an Application with its related resources to a tenant catalog Application.
+// The scenarios label and the identifiers which are specific to the environment, such as the integration system, are not exported.
+func (c *converter) ApplicationToInput(app *model.Application, templateName *string, labels map[string]*model.Label, webhooks []*model.Webhook, bundles []*graphql.BundleCreateInput) *Application {
+	if app == nil {
+		return nil
+	}
+
+	var statusCondition *graphql.ApplicationStatusCondition
+	if app.Status != nil {
+		condition := graphql.ApplicationStatusCondition(app.Status.Condition)
+		statusCondition = &condition
+	}
+
+	return &Application{
+		Template: templateName,
+		ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+			Name:            app.Name,
+			ProviderName:    app.ProviderName,
+			Description:     app.Description,
+			Labels:          c.LabelsToInput(labels),
+			Webhooks:        c.WebhooksToInput(webhooks),
+			HealthCheckURL:  app.HealthCheckURL,
+			BaseURL:         app.BaseURL,
+			StatusCondition: statusCondition,
+			Bundles:         bundles,
+		},
+	}
+}
+
+// ApplicationTemplateToInput converts an Application Template with its labels and webhooks to a tenant catalog Application Template
+func (c *converter) ApplicationTemplateToInput(appTemplate *model.ApplicationTemplate, labels map[string]*model.Label, webhooks []*model.Webhook) (*graphql.ApplicationTemplateInput, error) {
+	if appTemplate == nil {
+		return nil, nil
+	}
+
+	var appInput graphql.ApplicationRegisterInput
+	if err := json.Unmarshal([]byte(appTemplate.ApplicationInputJSON), &appInput); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshalling application input of application template with name %q", appTemplate.Name)
+	}
+
+	placeholders := make([]*graphql.PlaceholderDefinitionInput, 0, len(appTemplate.Placeholders))
+	for _, placeholder := range appTemplate.Placeholders {
+		placeholderType := graphql.PlaceholderType(placeholder.ValueType())
+		var optional *bool
+		if placeholder.Optional {
+			isOptional := true
+			optional = &isOptional
+		}
+		placeholders = append(placeholders, &graphql.PlaceholderDefinitionInput{
+			Name:          placeholder.Name,
+			Description:   placeholder.Description,
+			Type:          &placeholderType,
+			DefaultValue:  placeholder.DefaultValue,
+			Optional:      optional,
+			Pattern:       placeholder.Pattern,
+			AllowedValues: placeholder.AllowedValues,
+		})
+	}
+
+	return &graphql.ApplicationTemplateInput{
+		Name:                 appTemplate.Name,
+		Webhooks:             c.WebhooksToInput(webhooks),
+		Description:          appTemplate.Description,
+		Labels:               c.LabelsToInput(labels),
+		ApplicationInput:     &appInput,
+		Placeholders:         placeholders,
+		AccessLevel:          graphql.ApplicationTemplateAccessLevel(appTemplate.AccessLevel),
+		ApplicationNamespace: appTemplate.ApplicationNamespace,
+	}, nil
+}
+
+// BundleToInput converts a Bundle with its definitions and documents to a tenant catalog Bundle
+func (c *converter) BundleToInput(bundle *model.Bundle, apis []*graphql.APIDefinitionInput, events []*graphql.EventDefinitionInput, documents []*graphql.DocumentInput) *graphql.BundleCreateInput {
+	if bundle == nil {
+		return nil
+	}
+
+	var schema *graphql.JSONSchema
+	if bundle.InstanceAuthRequestInputSchema != nil {
+		value := graphql.JSONSchema(*bundle.InstanceAuthRequestInputSchema)
+		schema = &value
+	}
+
+	return &graphql.BundleCreateInput{
+		Name:                           bundle.Name,
+		Description:                    bundle.Description,
+		InstanceAuthRequestInputSchema: schema,
+		DefaultInstanceAuth:            c.AuthToInput(bundle.DefaultInstanceAuth),
+		APIDefinitions:                 apis,
+		EventDefinitions:               events,
+		Documents:                      documents,
+	}
+}
+
+// APIDefinitionToInput converts an API Definition with its spec to a tenant catalog API Definition
+func (c *converter) APIDefinitionToInput(apiDef *model.APIDefinition, spec *model.Spec, fetchRequest *model.FetchRequest) *graphql.APIDefinitionInput {
+	if apiDef == nil {
+		return nil
+	}
+
+	var specInput *graphql.APISpecInput
+	if spec != nil && spec.APIType != nil {
+		specInput = &graphql.APISpecInput{
+			Data:         clobPtr(spec.Data),
+			Type:         graphql.APISpecType(*spec.APIType),
+			Format:       graphql.SpecFormat(spec.Format),
+			FetchRequest: c.FetchRequestToInput(fetchRequest),
+		}
+	}
+
+	return &graphql.APIDefinitionInput{
+		Name:        apiDef.Name,
+		Description: apiDef.Description,
+		TargetURL:   api.ExtractTargetURLFromJSONArray(apiDef.TargetURLs),
+		Group:       apiDef.Group,
+		Spec:        specInput,
+		Version:     c.VersionToInput(apiDef.Version),
+	}
+}
+
+// EventDefinitionToInput converts an Event Definition with its spec to a tenant catalog Event Definition
+func (c *converter) EventDefinitionToInput(eventDef *model.EventDefinition, spec *model.Spec, fetchRequest *model.FetchRequest) *graphql.EventDefinitionInput {
+	if eventDef == nil {
+		return nil
+	}
+
+	var specInput *graphql.EventSpecInput
+	if spec != nil && spec.EventType != nil {
+		specInput = &graphql.EventSpecInput{
+			Data:         clobPtr(spec.Data),
+			Type:         graphql.EventSpecType(*spec.EventType),
+			Format:       graphql.SpecFormat(spec.Format),
+			FetchRequest: c.FetchRequestToInput(fetchRequest),
+		}
+	}
+
+	return &graphql.EventDefinitionInput{
+		Name:        eventDef.Name,
+		Description: eventDef.Description,
+		Spec:        specInput,
+		Group:       eventDef.Group,
+		Version:     c.VersionToInput(eventDef.Version),
+	}
+}
+
+// DocumentToInput converts a Document to a tenant catalog Document
+func (c *converter) DocumentToInput(document *model.Document, fetchRequest *model.FetchRequest) *graphql.DocumentInput {
+	if document == nil {
+		return nil
+	}
+
+	return &graphql.DocumentInput{
+		Title:        document.Title,
+		DisplayName:  document.DisplayName,
+		Description:  document.Description,
+		Format:       graphql.DocumentFormat(document.Format),
+		Kind:         document.Kind,
+		Data:         clobPtr(document.Data),
+		FetchRequest: c.FetchRequestToInput(fetchRequest),
+	}
+}
+
+// FetchRequestToInput converts a FetchRequest to a tenant catalog FetchRequest
+func (c *converter) FetchRequestToInput(fetchRequest *model.FetchRequest) *graphql.FetchRequestInput {
+	if fetchRequest == nil {
+		return nil
+	}
+
+	var mode *graphql.FetchMode
+	if fetchRequest.Mode != "" {
+		value := graphql.FetchMode(fetchRequest.Mode)
+		mode = &value
+	}
+
+	return &graphql.FetchRequestInput{
+		URL:    fetchRequest.URL,
+		Auth:   c.AuthToInput(fetchRequest.Auth),
+		Mode:   mode,
+		Filter: fetchRequest.Filter,
+	}
+}
+
+// WebhooksToInput converts multiple Webhooks to tenant catalog Webhooks
+func (c *converter) WebhooksToInput(webhooks []*model.Webhook) []*graphql.WebhookInput {
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	inputs := make([]*graphql.WebhookInput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		if webhook == nil {
+			continue
+		}
+
+		var mode *graphql.WebhookMode
+		if webhook.Mode != nil {
+			value := graphql.WebhookMode(*webhook.Mode)
+			mode = &value
+		}
+
+		inputs = append(inputs, &graphql.WebhookInput{
+			Type:             graphql.WebhookType(webhook.Type),
+			URL:              webhook.URL,
+			Auth:             c.AuthToInput(webhook.Auth),
+			Mode:             mode,
+			CorrelationIDKey: webhook.CorrelationIDKey,
+			RetryInterval:    webhook.RetryInterval,
+			Timeout:          webhook.Timeout,
+			URLTemplate:      webhook.URLTemplate,
+			InputTemplate:    webhook.InputTemplate,
+			HeaderTemplate:   webhook.HeaderTemplate,
+			OutputTemplate:   webhook.OutputTemplate,
+			StatusTemplate:   webhook.StatusTemplate,
+			Signature:        webhookSignatureToInput(webhook.Signature),
+		})
+	}
+
+	return inputs
+}
+
+func webhookSignatureToInput(signature *model.WebhookSignature) *graphql.WebhookSignatureInput {
+	if signature == nil {
+		return nil
+	}
+
+	return &graphql.WebhookSignatureInput{
+		Secret:          signature.Secret,
+		SignatureHeader: signature.SignatureHeader,
+		TimestampHeader: signature.TimestampHeader,
+		NonceHeader:     signature.NonceHeader,
+	}
+}
+
+// AuthToInput converts an Auth to a tenant catalog Auth. One-time tokens and credential requests are not exported.
+func (c *converter) AuthToInput(auth *model.Auth) *graphql.AuthInput {
+	if auth == nil {
+		return nil
+	}
+
+	var credential *graphql.CredentialDataInput
+	if auth.Credential.Basic != nil {
+		credential = &graphql.CredentialDataInput{
+			Basic: &graphql.BasicCredentialDataInput{
+				Username: auth.Credential.Basic.Username,
+				Password: auth.Credential.Basic.Password,
+			},
+		}
+	} else if auth.Credential.Oauth != nil {
+		credential = &graphql.CredentialDataInput{
+			Oauth: &graphql.OAuthCredentialDataInput{
+				ClientID:     auth.Credential.Oauth.ClientID,
+				ClientSecret: auth.Credential.Oauth.ClientSecret,
+				URL:          auth.Credential.Oauth.URL,
+			},
+		}
+	}
+
+	input := &graphql.AuthInput{
+		Credential:           credential,
+		AccessStrategy:       auth.AccessStrategy,
+		ClientCertificateRef: auth.ClientCertificateRef,
+	}
+
+	if len(auth.AdditionalHeaders) != 0 {
+		input.AdditionalHeaders = auth.AdditionalHeaders
+	}
+
+	if len(auth.AdditionalQueryParams) != 0 {
+		input.AdditionalQueryParams = auth.AdditionalQueryParams
+	}
+
+	if auth.CertCommonName != "" {
+		certCommonName := auth.CertCommonName
+		input.CertCommonName = &certCommonName
+	}
+
+	return input
+}
+
+// VersionToInput converts a Version to a tenant catalog Version
+func (c *converter) VersionToInput(version *model.Version) *graphql.VersionInput {
+	if version == nil {
+		return nil
+	}
+
+	return &graphql.VersionInput{
+		Value:           version.Value,
+		Deprecated:      version.Deprecated,
+		DeprecatedSince: version.DeprecatedSince,
+		ForRemoval:      version.ForRemoval,
+	}
+}
+
+// LabelsToInput converts labels to tenant catalog labels, leaving out the scenarios label
+func (c *converter) LabelsToInput(labels map[string]*model.Label) graphql.Labels {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	out := make(graphql.Labels, len(labels))
+	for key, label := range labels {
+		if key == model.ScenariosKey || label == nil {
+			continue
+		}
+		out[key] = label.Value
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
+
+func clobPtr(data *string) *graphql.CLOB {
+	if data == nil {
+		return nil
+	}
+
+	clob := graphql.CLOB(*data)
+	return &clob
+}
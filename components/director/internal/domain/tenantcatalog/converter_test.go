@@ -0,0 +1,148 @@
+package tenantcatalog_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ApplicationToInput(t *testing.T) {
+	t.Run("Success leaves out the scenarios label and the environment specific identifiers", func(t *testing.T) {
+		// GIVEN
+		conv := tenantcatalog.NewConverter()
+		bundles := []*graphql.BundleCreateInput{fixGQLBundle()}
+
+		// WHEN
+		result := conv.ApplicationToInput(fixModelApplication(str.Ptr(appTemplateID)), str.Ptr(appTemplateName), fixModelLabels(), fixModelWebhooks(), bundles)
+
+		// THEN
+		assert.Equal(t, fixCatalogApplication(str.Ptr(appTemplateName)), result)
+	})
+
+	t.Run("Returns nil for nil application", func(t *testing.T) {
+		assert.Nil(t, tenantcatalog.NewConverter().ApplicationToInput(nil, nil, nil, nil, nil))
+	})
+}
+
+func TestConverter_ApplicationTemplateToInput(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := tenantcatalog.NewConverter()
+		labels := map[string]*model.Label{labelKey: {Key: labelKey, Value: labelValue}}
+		expected := fixGQLApplicationTemplate()
+		expected.Labels = graphql.Labels{labelKey: labelValue}
+		expected.Webhooks = fixGQLWebhooks()
+
+		// WHEN
+		result, err := conv.ApplicationTemplateToInput(fixModelApplicationTemplate(), labels, fixModelWebhooks())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Error when the application input is not valid JSON", func(t *testing.T) {
+		// GIVEN
+		appTemplate := fixModelApplicationTemplate()
+		appTemplate.ApplicationInputJSON = "{"
+
+		// WHEN
+		_, err := tenantcatalog.NewConverter().ApplicationTemplateToInput(appTemplate, nil, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling application input")
+	})
+}
+
+func TestConverter_BundleToInput(t *testing.T) {
+	// GIVEN
+	conv := tenantcatalog.NewConverter()
+	apis := []*graphql.APIDefinitionInput{fixGQLAPIDefinition()}
+	events := []*graphql.EventDefinitionInput{fixGQLEventDefinition()}
+	documents := []*graphql.DocumentInput{fixGQLDocument()}
+
+	// WHEN
+	result := conv.BundleToInput(fixModelBundle(), apis, events, documents)
+
+	// THEN
+	assert.Equal(t, fixGQLBundle(), result)
+}
+
+func TestConverter_APIDefinitionToInput(t *testing.T) {
+	// GIVEN
+	conv := tenantcatalog.NewConverter()
+
+	// WHEN
+	result := conv.APIDefinitionToInput(fixModelAPIDefinition(), fixModelAPISpec(), fixModelFetchRequest(apiSpecID, model.APISpecFetchRequestReference))
+
+	// THEN
+	assert.Equal(t, fixGQLAPIDefinition(), result)
+}
+
+func TestConverter_EventDefinitionToInput(t *testing.T) {
+	// GIVEN
+	conv := tenantcatalog.NewConverter()
+
+	// WHEN
+	result := conv.EventDefinitionToInput(fixModelEventDefinition(), fixModelEventSpec(), fixModelFetchRequest(eventSpecID, model.EventSpecFetchRequestReference))
+
+	// THEN
+	assert.Equal(t, fixGQLEventDefinition(), result)
+}
+
+func TestConverter_DocumentToInput(t *testing.T) {
+	// GIVEN
+	conv := tenantcatalog.NewConverter()
+
+	// WHEN
+	result := conv.DocumentToInput(fixModelDocument(), fixModelFetchRequest(docID, model.DocumentFetchRequestReference))
+
+	// THEN
+	assert.Equal(t, fixGQLDocument(), result)
+}
+
+func TestConverter_AuthToInput(t *testing.T) {
+	t.Run("Success for OAuth credentials", func(t *testing.T) {
+		// GIVEN
+		auth := &model.Auth{
+			Credential: model.CredentialData{
+				Oauth: &model.OAuthCredentialData{ClientID: "id", ClientSecret: "secret", URL: "https://example.com/token"},
+			},
+			CertCommonName: "cn",
+		}
+
+		// WHEN
+		result := tenantcatalog.NewConverter().AuthToInput(auth)
+
+		// THEN
+		assert.Equal(t, &graphql.AuthInput{
+			Credential: &graphql.CredentialDataInput{
+				Oauth: &graphql.OAuthCredentialDataInput{ClientID: "id", ClientSecret: "secret", URL: "https://example.com/token"},
+			},
+			CertCommonName: str.Ptr("cn"),
+		}, result)
+	})
+
+	t.Run("Returns nil for nil auth", func(t *testing.T) {
+		assert.Nil(t, tenantcatalog.NewConverter().AuthToInput(nil))
+	})
+}
+
+func TestConverter_LabelsToInput(t *testing.T) {
+	t.Run("Returns nil when only the scenarios label is present", func(t *testing.T) {
+		// GIVEN
+		labels := map[string]*model.Label{model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"DEFAULT"}}}
+
+		// WHEN
+		result := tenantcatalog.NewConverter().LabelsToInput(labels)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
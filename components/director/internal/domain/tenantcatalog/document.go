@@ -0,0 +1,92 @@
+package tenantcatalog
+
+import (
+	"encoding/json"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+// CurrentVersion is the version of the tenant catalog documents produced by the export
+const CurrentVersion = "v1"
+
+// Document is a declarative description of the application catalog of a tenant
+type Document struct {
+	Version              string                              `json:"version"`
+	ApplicationTemplates []*graphql.ApplicationTemplateInput `json:"applicationTemplates,omitempty"`
+	Applications         []*Application                      `json:"applications,omitempty"`
+}
+
+// Application is an Application of the tenant catalog
+type Application struct {
+	// Template is the name of the Application Template the Application is created from
+	Template *string `json:"template,omitempty"`
+	graphql.ApplicationRegisterInput
+}
+
+// Marshal serializes the document in the given format
+func Marshal(doc *Document, format graphql.TenantCatalogFormat) ([]byte, error) {
+	switch format {
+	case graphql.TenantCatalogFormatJSON:
+		return json.MarshalIndent(doc, "", "  ")
+	case graphql.TenantCatalogFormatYaml:
+		return yaml.Marshal(doc)
+	default:
+		return nil, apperrors.NewInvalidDataError("unsupported tenant catalog format %q", format)
+	}
+}
+
+// Unmarshal parses a document in either JSON or YAML format and validates it
+func Unmarshal(content []byte) (*Document, error) {
+	doc := &Document{}
+	if err := yaml.Unmarshal(content, doc, yaml.DisallowUnknownFields); err != nil {
+		return nil, apperrors.NewInvalidDataError("while parsing tenant catalog: %s", err)
+	}
+
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Validate checks the version of the document and validates its Applications and Application Templates
+func (d *Document) Validate() error {
+	if d.Version != CurrentVersion {
+		return apperrors.NewInvalidDataError("unsupported tenant catalog version %q, expected %q", d.Version, CurrentVersion)
+	}
+
+	templateNames := make(map[string]bool, len(d.ApplicationTemplates))
+	for _, template := range d.ApplicationTemplates {
+		if template == nil {
+			return apperrors.NewInvalidDataError("application template must not be empty")
+		}
+		if templateNames[template.Name] {
+			return apperrors.NewInvalidDataError("application template with name %q is defined more than once", template.Name)
+		}
+		templateNames[template.Name] = true
+
+		if err := template.Validate(); err != nil {
+			return errors.Wrapf(err, "while validating application template with name %q", template.Name)
+		}
+	}
+
+	appNames := make(map[string]bool, len(d.Applications))
+	for _, app := range d.Applications {
+		if app == nil {
+			return apperrors.NewInvalidDataError("application must not be empty")
+		}
+		if appNames[app.Name] {
+			return apperrors.NewInvalidDataError("application with name %q is defined more than once", app.Name)
+		}
+		appNames[app.Name] = true
+
+		if err := app.ApplicationRegisterInput.Validate(); err != nil {
+			return errors.Wrapf(err, "while validating application with name %q", app.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package tenantcatalog_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	for _, format := range []graphql.TenantCatalogFormat{graphql.TenantCatalogFormatYaml, graphql.TenantCatalogFormatJSON} {
+		t.Run(format.String(), func(t *testing.T) {
+			// GIVEN
+			doc := fixDocument()
+
+			// WHEN
+			content, err := tenantcatalog.Marshal(doc, format)
+			require.NoError(t, err)
+			result, err := tenantcatalog.Unmarshal(content)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, doc, result)
+		})
+	}
+}
+
+func TestMarshal_UnsupportedFormat(t *testing.T) {
+	// WHEN
+	_, err := tenantcatalog.Marshal(fixDocument(), graphql.TenantCatalogFormat("XML"))
+
+	// THEN
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported tenant catalog format")
+}
+
+func TestUnmarshal(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Content           string
+		ExpectedErrString string
+	}{
+		{
+			Name:    "Success for YAML",
+			Content: "version: v1\napplications:\n- name: app\n",
+		},
+		{
+			Name:    "Success for JSON",
+			Content: `{"version":"v1","applications":[{"name":"app"}]}`,
+		},
+		{
+			Name:              "Error when the content is malformed",
+			Content:           "version: [",
+			ExpectedErrString: "while parsing tenant catalog",
+		},
+		{
+			Name:              "Error when the content has unknown fields",
+			Content:           "version: v1\nruntimes: []\n",
+			ExpectedErrString: "while parsing tenant catalog",
+		},
+		{
+			Name:              "Error when the version is not supported",
+			Content:           "version: v2\n",
+			ExpectedErrString: `unsupported tenant catalog version "v2"`,
+		},
+		{
+			Name:              "Error when an application is defined more than once",
+			Content:           "version: v1\napplications:\n- name: app\n- name: app\n",
+			ExpectedErrString: `application with name "app" is defined more than once`,
+		},
+		{
+			Name:              "Error when an application template is defined more than once",
+			Content:           "version: v1\napplicationTemplates:\n- name: template\n  accessLevel: GLOBAL\n  applicationInput:\n    name: app\n- name: template\n  accessLevel: GLOBAL\n  applicationInput:\n    name: app\n",
+			ExpectedErrString: `application template with name "template" is defined more than once`,
+		},
+		{
+			Name:              "Error when an application is not valid",
+			Content:           "version: v1\napplications:\n- description: app without name\n",
+			ExpectedErrString: "while validating application",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			doc, err := tenantcatalog.Unmarshal([]byte(testCase.Content))
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrString)
+				assert.Nil(t, doc)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, doc.Applications, 1)
+				assert.Equal(t, appName, doc.Applications[0].Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package tenantcatalog_test
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	tenantID               = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID       = "external-tenant"
+	appID                  = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	appName                = "app"
+	appTemplateID          = "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	appTemplateName        = "template"
+	bundleID               = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	bundleName             = "bundle"
+	apiID                  = "11111111-1111-1111-1111-111111111111"
+	apiName                = "api"
+	apiSpecID              = "22222222-2222-2222-2222-222222222222"
+	eventID                = "33333333-3333-3333-3333-333333333333"
+	eventName              = "event"
+	eventSpecID            = "44444444-4444-4444-4444-444444444444"
+	docID                  = "55555555-5555-5555-5555-555555555555"
+	docTitle               = "doc"
+	webhookURL             = "https://example.com/webhook"
+	webhookSignatureSecret = "a3f2b8c9d4e5f6a7b8c9d0e1f2a3b4c5"
+	fetchRequestURL        = "https://example.com/spec"
+	targetURL              = "https://example.com/api"
+	specData               = `{"openapi":"3.0.0"}`
+	labelKey               = "env"
+	labelValue             = "dev"
+	username               = "user"
+	password               = "pass"
+)
+
+func fixModelApplication(templateID *string) *model.Application {
+	return &model.Application{
+		Name:                  appName,
+		Description:           str.Ptr("description"),
+		BaseURL:               str.Ptr("https://example.com"),
+		ApplicationTemplateID: templateID,
+		IntegrationSystemID:   str.Ptr("int-sys-id"),
+		LocalTenantID:         str.Ptr("local-tenant-id"),
+		Status:                &model.ApplicationStatus{Condition: model.ApplicationStatusConditionInitial},
+		BaseEntity:            &model.BaseEntity{ID: appID},
+	}
+}
+
+func fixModelApplicationTemplate() *model.ApplicationTemplate {
+	return &model.ApplicationTemplate{
+		ID:                   appTemplateID,
+		Name:                 appTemplateName,
+		Description:          str.Ptr("template description"),
+		ApplicationInputJSON: `{"name":"{{name}}","description":"app from template"}`,
+		Placeholders: []model.ApplicationTemplatePlaceholder{
+			{Name: "name", Description: str.Ptr("the name of the app")},
+		},
+		AccessLevel: model.GlobalApplicationTemplateAccessLevel,
+	}
+}
+
+func fixModelLabels() map[string]*model.Label {
+	return map[string]*model.Label{
+		labelKey:           {Key: labelKey, Value: labelValue},
+		model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"DEFAULT"}},
+	}
+}
+
+func fixModelAuth() *model.Auth {
+	return &model.Auth{
+		Credential: model.CredentialData{
+			Basic: &model.BasicCredentialData{Username: username, Password: password},
+		},
+		AdditionalHeaders: map[string][]string{"X-Header": {"value"}},
+		OneTimeToken:      &model.OneTimeToken{Token: "ott"},
+	}
+}
+
+func fixModelWebhooks() []*model.Webhook {
+	return []*model.Webhook{
+		{
+			ID:         "webhook-id",
+			ObjectID:   appID,
+			ObjectType: model.ApplicationWebhookReference,
+			Type:       model.WebhookTypeConfigurationChanged,
+			URL:        str.Ptr(webhookURL),
+			Auth:       fixModelAuth(),
+			Signature:  &model.WebhookSignature{Secret: webhookSignatureSecret},
+		},
+	}
+}
+
+func fixModelBundle() *model.Bundle {
+	return &model.Bundle{
+		ApplicationID:                  appID,
+		Name:                           bundleName,
+		Description:                    str.Ptr("bundle description"),
+		InstanceAuthRequestInputSchema: str.Ptr(`{"type":"object"}`),
+		DefaultInstanceAuth:            fixModelAuth(),
+		BaseEntity:                     &model.BaseEntity{ID: bundleID},
+	}
+}
+
+func fixModelAPIDefinition() *model.APIDefinition {
+	return &model.APIDefinition{
+		ApplicationID: appID,
+		Name:          apiName,
+		TargetURLs:    json.RawMessage(`["` + targetURL + `"]`),
+		Version:       &model.Version{Value: "v1"},
+		BaseEntity:    &model.BaseEntity{ID: apiID},
+	}
+}
+
+func fixModelEventDefinition() *model.EventDefinition {
+	return &model.EventDefinition{
+		ApplicationID: appID,
+		Name:          eventName,
+		BaseEntity:    &model.BaseEntity{ID: eventID},
+	}
+}
+
+func fixModelDocument() *model.Document {
+	return &model.Document{
+		BundleID:    bundleID,
+		AppID:       appID,
+		Title:       docTitle,
+		DisplayName: "Doc",
+		Description: "doc description",
+		Format:      model.DocumentFormatMarkdown,
+		Data:        str.Ptr("# Doc"),
+		BaseEntity:  &model.BaseEntity{ID: docID},
+	}
+}
+
+func fixModelAPISpec() *model.Spec {
+	apiType := model.APISpecTypeOpenAPI
+	return &model.Spec{
+		ID:         apiSpecID,
+		ObjectType: model.APISpecReference,
+		ObjectID:   apiID,
+		Data:       str.Ptr(specData),
+		Format:     model.SpecFormatJSON,
+		APIType:    &apiType,
+	}
+}
+
+func fixModelEventSpec() *model.Spec {
+	eventType := model.EventSpecTypeAsyncAPI
+	return &model.Spec{
+		ID:         eventSpecID,
+		ObjectType: model.EventSpecReference,
+		ObjectID:   eventID,
+		Data:       str.Ptr(specData),
+		Format:     model.SpecFormatJSON,
+		EventType:  &eventType,
+	}
+}
+
+func fixModelFetchRequest(objectID string, objectType model.FetchRequestReferenceObjectType) *model.FetchRequest {
+	return &model.FetchRequest{
+		ID:         "fr-" + objectID,
+		URL:        fetchRequestURL,
+		Auth:       fixModelAuth(),
+		Mode:       model.FetchModeSingle,
+		ObjectType: objectType,
+		ObjectID:   objectID,
+	}
+}
+
+func fixGQLAuth() *graphql.AuthInput {
+	return &graphql.AuthInput{
+		Credential: &graphql.CredentialDataInput{
+			Basic: &graphql.BasicCredentialDataInput{Username: username, Password: password},
+		},
+		AdditionalHeaders: graphql.HTTPHeaders{"X-Header": {"value"}},
+	}
+}
+
+func fixGQLWebhooks() []*graphql.WebhookInput {
+	return []*graphql.WebhookInput{
+		{
+			Type:      graphql.WebhookTypeConfigurationChanged,
+			URL:       str.Ptr(webhookURL),
+			Auth:      fixGQLAuth(),
+			Signature: &graphql.WebhookSignatureInput{Secret: webhookSignatureSecret},
+		},
+	}
+}
+
+func fixGQLFetchRequest() *graphql.FetchRequestInput {
+	mode := graphql.FetchModeSingle
+	return &graphql.FetchRequestInput{
+		URL:  fetchRequestURL,
+		Auth: fixGQLAuth(),
+		Mode: &mode,
+	}
+}
+
+func fixGQLAPIDefinition() *graphql.APIDefinitionInput {
+	data := graphql.CLOB(specData)
+	return &graphql.APIDefinitionInput{
+		Name:      apiName,
+		TargetURL: targetURL,
+		Spec: &graphql.APISpecInput{
+			Data:         &data,
+			Type:         graphql.APISpecTypeOpenAPI,
+			Format:       graphql.SpecFormatJSON,
+			FetchRequest: fixGQLFetchRequest(),
+		},
+		Version: &graphql.VersionInput{Value: "v1"},
+	}
+}
+
+func fixGQLEventDefinition() *graphql.EventDefinitionInput {
+	data := graphql.CLOB(specData)
+	return &graphql.EventDefinitionInput{
+		Name: eventName,
+		Spec: &graphql.EventSpecInput{
+			Data:         &data,
+			Type:         graphql.EventSpecTypeAsyncAPI,
+			Format:       graphql.SpecFormatJSON,
+			FetchRequest: fixGQLFetchRequest(),
+		},
+	}
+}
+
+func fixGQLDocument() *graphql.DocumentInput {
+	data := graphql.CLOB("# Doc")
+	return &graphql.DocumentInput{
+		Title:        docTitle,
+		DisplayName:  "Doc",
+		Description:  "doc description",
+		Format:       graphql.DocumentFormatMarkdown,
+		Data:         &data,
+		FetchRequest: fixGQLFetchRequest(),
+	}
+}
+
+func fixGQLBundle() *graphql.BundleCreateInput {
+	schema := graphql.JSONSchema(`{"type":"object"}`)
+	return &graphql.BundleCreateInput{
+		Name:                           bundleName,
+		Description:                    str.Ptr("bundle description"),
+		InstanceAuthRequestInputSchema: &schema,
+		DefaultInstanceAuth:            fixGQLAuth(),
+		APIDefinitions:                 []*graphql.APIDefinitionInput{fixGQLAPIDefinition()},
+		EventDefinitions:               []*graphql.EventDefinitionInput{fixGQLEventDefinition()},
+		Documents:                      []*graphql.DocumentInput{fixGQLDocument()},
+	}
+}
+
+func fixGQLApplicationTemplate() *graphql.ApplicationTemplateInput {
+	placeholderType := graphql.PlaceholderTypeString
+	return &graphql.ApplicationTemplateInput{
+		Name:        appTemplateName,
+		Description: str.Ptr("template description"),
+		ApplicationInput: &graphql.ApplicationRegisterInput{
+			Name:        "{{name}}",
+			Description: str.Ptr("app from template"),
+		},
+		Placeholders: []*graphql.PlaceholderDefinitionInput{
+			{Name: "name", Description: str.Ptr("the name of the app"), Type: &placeholderType},
+		},
+		AccessLevel: graphql.ApplicationTemplateAccessLevelGlobal,
+	}
+}
+
+func fixCatalogApplication(template *string) *tenantcatalog.Application {
+	condition := graphql.ApplicationStatusConditionInitial
+	return &tenantcatalog.Application{
+		Template: template,
+		ApplicationRegisterInput: graphql.ApplicationRegisterInput{
+			Name:            appName,
+			Description:     str.Ptr("description"),
+			BaseURL:         str.Ptr("https://example.com"),
+			Labels:          graphql.Labels{labelKey: labelValue},
+			Webhooks:        fixGQLWebhooks(),
+			StatusCondition: &condition,
+			Bundles:         []*graphql.BundleCreateInput{fixGQLBundle()},
+		},
+	}
+}
+
+func fixDocument() *tenantcatalog.Document {
+	return &tenantcatalog.Document{
+		Version:              tenantcatalog.CurrentVersion,
+		ApplicationTemplates: []*graphql.ApplicationTemplateInput{fixGQLApplicationTemplate()},
+		Applications:         []*tenantcatalog.Application{fixCatalogApplication(str.Ptr(appTemplateName))},
+	}
+}
@@ -0,0 +1,194 @@
+package tenantcatalog
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+// The same scopes definitions as the ones used by the @sanitize directive for the corresponding GraphQL fields
+const (
+	applicationWebhooksScopes         = "graphql.field.application.webhooks"
+	applicationTemplateWebhooksScopes = "graphql.field.application_template.webhooks"
+	webhookAuthScopes                 = "graphql.field.webhooks.auth"
+	webhookSignatureScopes            = "graphql.field.webhooks.signature"
+	bundleDefaultInstanceAuthScopes   = "graphql.field.bundle.default_instance_auth"
+	apiSpecFetchRequestScopes         = "graphql.field.api_spec.fetch_request"
+	eventSpecFetchRequestScopes       = "graphql.field.event_spec.fetch_request"
+	documentFetchRequestScopes        = "graphql.field.document.fetch_request"
+	fetchRequestAuthScopes            = "graphql.field.fetch_request.auth"
+)
+
+// ScopesGetter missing godoc
+//
+//go:generate mockery --name=ScopesGetter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ScopesGetter interface {
+	GetRequiredScopes(scopesDefinition string) ([]string, error)
+}
+
+// redactor removes from a tenant catalog the fields which the caller is not allowed to read
+type redactor struct {
+	scopesGetter ScopesGetter
+	actualScopes []string
+	allowed      map[string]bool
+}
+
+func newRedactor(ctx context.Context, scopesGetter ScopesGetter) (*redactor, error) {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redactor{
+		scopesGetter: scopesGetter,
+		actualScopes: actualScopes,
+		allowed:      make(map[string]bool),
+	}, nil
+}
+
+func (r *redactor) redact(doc *Document) error {
+	for _, appTemplate := range doc.ApplicationTemplates {
+		webhooks, err := r.redactWebhooks(appTemplate.Webhooks, applicationTemplateWebhooksScopes)
+		if err != nil {
+			return err
+		}
+		appTemplate.Webhooks = webhooks
+
+		if appTemplate.ApplicationInput != nil {
+			if err := r.redactApplication(appTemplate.ApplicationInput); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, app := range doc.Applications {
+		if err := r.redactApplication(&app.ApplicationRegisterInput); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *redactor) redactApplication(app *graphql.ApplicationRegisterInput) error {
+	webhooks, err := r.redactWebhooks(app.Webhooks, applicationWebhooksScopes)
+	if err != nil {
+		return err
+	}
+	app.Webhooks = webhooks
+
+	for _, bundle := range app.Bundles {
+		allowed, err := r.isAllowed(bundleDefaultInstanceAuthScopes)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			bundle.DefaultInstanceAuth = nil
+		}
+
+		for _, apiDef := range bundle.APIDefinitions {
+			if apiDef.Spec == nil {
+				continue
+			}
+			if apiDef.Spec.FetchRequest, err = r.redactFetchRequest(apiDef.Spec.FetchRequest, apiSpecFetchRequestScopes); err != nil {
+				return err
+			}
+		}
+
+		for _, eventDef := range bundle.EventDefinitions {
+			if eventDef.Spec == nil {
+				continue
+			}
+			if eventDef.Spec.FetchRequest, err = r.redactFetchRequest(eventDef.Spec.FetchRequest, eventSpecFetchRequestScopes); err != nil {
+				return err
+			}
+		}
+
+		for _, document := range bundle.Documents {
+			if document.FetchRequest, err = r.redactFetchRequest(document.FetchRequest, documentFetchRequestScopes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *redactor) redactWebhooks(webhooks []*graphql.WebhookInput, scopesDefinition string) ([]*graphql.WebhookInput, error) {
+	if len(webhooks) == 0 {
+		return webhooks, nil
+	}
+
+	allowed, err := r.isAllowed(scopesDefinition)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, nil
+	}
+
+	authAllowed, err := r.isAllowed(webhookAuthScopes)
+	if err != nil {
+		return nil, err
+	}
+	if !authAllowed {
+		for _, webhook := range webhooks {
+			webhook.Auth = nil
+		}
+	}
+
+	signatureAllowed, err := r.isAllowed(webhookSignatureScopes)
+	if err != nil {
+		return nil, err
+	}
+	if !signatureAllowed {
+		for _, webhook := range webhooks {
+			webhook.Signature = nil
+		}
+	}
+
+	return webhooks, nil
+}
+
+func (r *redactor) redactFetchRequest(fetchRequest *graphql.FetchRequestInput, scopesDefinition string) (*graphql.FetchRequestInput, error) {
+	if fetchRequest == nil {
+		return nil, nil
+	}
+
+	allowed, err := r.isAllowed(scopesDefinition)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, nil
+	}
+
+	authAllowed, err := r.isAllowed(fetchRequestAuthScopes)
+	if err != nil {
+		return nil, err
+	}
+	if !authAllowed {
+		fetchRequest.Auth = nil
+	}
+
+	return fetchRequest, nil
+}
+
+func (r *redactor) isAllowed(scopesDefinition string) (bool, error) {
+	if allowed, ok := r.allowed[scopesDefinition]; ok {
+		return allowed, nil
+	}
+
+	requiredScopes, err := r.scopesGetter.GetRequiredScopes(scopesDefinition)
+	if err != nil {
+		return false, errors.Wrap(err, "while getting required scopes")
+	}
+
+	allowed := str.Matches(r.actualScopes, requiredScopes)
+	r.allowed[scopesDefinition] = allowed
+
+	return allowed, nil
+}
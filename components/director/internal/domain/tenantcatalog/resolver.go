@@ -0,0 +1,109 @@
+package tenantcatalog
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// TenantCatalogService missing godoc
+//
+//go:generate mockery --name=TenantCatalogService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TenantCatalogService interface {
+	Export(ctx context.Context) (*Document, error)
+	Import(ctx context.Context, doc *Document, dryRun bool) ([]*model.TenantCatalogChange, error)
+}
+
+// Resolver is responsible for the export and import of tenant catalogs
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      TenantCatalogService
+}
+
+// NewResolver creates a new tenant catalog resolver
+func NewResolver(transact persistence.Transactioner, svc TenantCatalogService) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+	}
+}
+
+// ExportTenantCatalog serializes the tenant catalog of the tenant in the context in the given format
+func (r *Resolver) ExportTenantCatalog(ctx context.Context, format *graphql.TenantCatalogFormat) (graphql.CLOB, error) {
+	catalogFormat := graphql.TenantCatalogFormatYaml
+	if format != nil {
+		catalogFormat = *format
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return "", err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	doc, err := r.svc.Export(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	content, err := Marshal(doc, catalogFormat)
+	if err != nil {
+		return "", errors.Wrap(err, "while serializing tenant catalog")
+	}
+
+	log.C(ctx).Infof("Successfully exported tenant catalog with %d applications and %d application templates", len(doc.Applications), len(doc.ApplicationTemplates))
+	return graphql.CLOB(content), nil
+}
+
+// ImportTenantCatalog applies the serialized tenant catalog to the tenant in the context. In dry-run mode nothing is changed.
+func (r *Resolver) ImportTenantCatalog(ctx context.Context, in graphql.CLOB, dryRun *bool) (*graphql.TenantCatalogImportResult, error) {
+	doc, err := Unmarshal([]byte(in))
+	if err != nil {
+		return nil, err
+	}
+
+	isDryRun := dryRun != nil && *dryRun
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	changes, err := r.svc.Import(ctx, doc, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isDryRun {
+		if err = tx.Commit(); err != nil {
+			return nil, err
+		}
+	}
+
+	gqlChanges := make([]*graphql.TenantCatalogChange, 0, len(changes))
+	for _, change := range changes {
+		gqlChanges = append(gqlChanges, &graphql.TenantCatalogChange{
+			Action:       graphql.TenantCatalogChangeAction(change.Action),
+			ResourceType: graphql.TenantCatalogResourceType(change.ResourceType),
+			Path:         change.Path,
+		})
+	}
+
+	return &graphql.TenantCatalogImportResult{
+		DryRun:  isDryRun,
+		Changes: gqlChanges,
+	}, nil
+}
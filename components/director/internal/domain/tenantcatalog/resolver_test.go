@@ -0,0 +1,238 @@
+package tenantcatalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ExportTenantCatalog(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	jsonFormat := graphql.TenantCatalogFormatJSON
+	unknownFormat := graphql.TenantCatalogFormat("XML")
+
+	doc := fixDocument()
+	yamlContent, err := tenantcatalog.Marshal(doc, graphql.TenantCatalogFormatYaml)
+	require.NoError(t, err)
+	jsonContent, err := tenantcatalog.Marshal(doc, graphql.TenantCatalogFormatJSON)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name            string
+		Format          *graphql.TenantCatalogFormat
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.TenantCatalogService
+		ExpectedContent graphql.CLOB
+		ExpectedErr     string
+	}{
+		{
+			Name:            "Success in YAML by default",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(doc, nil).Once()
+				return svc
+			},
+			ExpectedContent: graphql.CLOB(yamlContent),
+		},
+		{
+			Name:            "Success in JSON",
+			Format:          &jsonFormat,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(doc, nil).Once()
+				return svc
+			},
+			ExpectedContent: graphql.CLOB(jsonContent),
+		},
+		{
+			Name:            "Error when the format is not supported",
+			Format:          &unknownFormat,
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(doc, nil).Once()
+				return svc
+			},
+			ExpectedErr: "while serializing tenant catalog",
+		},
+		{
+			Name:            "Error when export fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn:       unusedTenantCatalogService,
+			ExpectedErr:     testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Export", txtest.CtxWithDBMatcher()).Return(doc, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := tenantcatalog.NewResolver(transact, svc)
+
+			// WHEN
+			result, err := resolver.ExportTenantCatalog(context.TODO(), testCase.Format)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedContent, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc)
+		})
+	}
+}
+
+func TestResolver_ImportTenantCatalog(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	dryRun := true
+	content, err := tenantcatalog.Marshal(fixDocument(), graphql.TenantCatalogFormatYaml)
+	require.NoError(t, err)
+
+	changes := []*model.TenantCatalogChange{
+		{Action: model.TenantCatalogChangeActionCreate, ResourceType: model.TenantCatalogResourceTypeApplication, Path: "applications/" + appName},
+	}
+	expectedChanges := []*graphql.TenantCatalogChange{
+		{Action: graphql.TenantCatalogChangeActionCreate, ResourceType: graphql.TenantCatalogResourceTypeApplication, Path: "applications/" + appName},
+	}
+
+	testCases := []struct {
+		Name            string
+		Content         graphql.CLOB
+		DryRun          *bool
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.TenantCatalogService
+		ExpectedResult  *graphql.TenantCatalogImportResult
+		ExpectedErr     string
+	}{
+		{
+			Name:            "Success",
+			Content:         graphql.CLOB(content),
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), fixDocument(), false).Return(changes, nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.TenantCatalogImportResult{DryRun: false, Changes: expectedChanges},
+		},
+		{
+			Name:            "Success in dry-run mode does not commit",
+			Content:         graphql.CLOB(content),
+			DryRun:          &dryRun,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), fixDocument(), true).Return(changes, nil).Once()
+				return svc
+			},
+			ExpectedResult: &graphql.TenantCatalogImportResult{DryRun: true, Changes: expectedChanges},
+		},
+		{
+			Name:            "Error when the content is not valid",
+			Content:         "version: v0",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn:       unusedTenantCatalogService,
+			ExpectedErr:     "unsupported tenant catalog version",
+		},
+		{
+			Name:            "Error when import fails",
+			Content:         graphql.CLOB(content),
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), fixDocument(), false).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction begin fails",
+			Content:         graphql.CLOB(content),
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn:       unusedTenantCatalogService,
+			ExpectedErr:     testErr.Error(),
+		},
+		{
+			Name:            "Error when transaction commit fails",
+			Content:         graphql.CLOB(content),
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TenantCatalogService {
+				svc := &automock.TenantCatalogService{}
+				svc.On("Import", txtest.CtxWithDBMatcher(), fixDocument(), false).Return(changes, nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := tenantcatalog.NewResolver(transact, svc)
+
+			// WHEN
+			result, err := resolver.ImportTenantCatalog(context.TODO(), testCase.Content, testCase.DryRun)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc)
+		})
+	}
+}
+
+func unusedTenantCatalogService() *automock.TenantCatalogService {
+	return &automock.TenantCatalogService{}
+}
@@ -0,0 +1,857 @@
+package tenantcatalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const pageSize = 200
+
+// ApplicationService missing godoc
+//
+//go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationService interface {
+	ListAll(ctx context.Context) ([]*model.Application, error)
+	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
+	Create(ctx context.Context, in model.ApplicationRegisterInput) (string, error)
+	CreateFromTemplate(ctx context.Context, in model.ApplicationRegisterInput, appTemplateID *string) (string, error)
+	SetLabel(ctx context.Context, label *model.LabelInput) error
+}
+
+// ApplicationTemplateService missing godoc
+//
+//go:generate mockery --name=ApplicationTemplateService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationTemplateService interface {
+	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
+	ListByName(ctx context.Context, name string) ([]*model.ApplicationTemplate, error)
+	ListLabels(ctx context.Context, appTemplateID string) (map[string]*model.Label, error)
+	Create(ctx context.Context, in model.ApplicationTemplateInput) (string, error)
+}
+
+// WebhookService missing godoc
+//
+//go:generate mockery --name=WebhookService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookService interface {
+	ListForApplication(ctx context.Context, applicationID string) ([]*model.Webhook, error)
+	ListForApplicationTemplate(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error)
+	Create(ctx context.Context, owningResourceID string, in model.WebhookInput, objectType model.WebhookReferenceObjectType) (string, error)
+}
+
+// BundleService missing godoc
+//
+//go:generate mockery --name=BundleService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type BundleService interface {
+	ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error)
+	Create(ctx context.Context, applicationID string, in model.BundleCreateInput) (string, error)
+}
+
+// APIService missing godoc
+//
+//go:generate mockery --name=APIService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type APIService interface {
+	ListByBundleIDs(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error)
+	ListFetchRequests(ctx context.Context, specIDs []string) ([]*model.FetchRequest, error)
+	CreateInBundle(ctx context.Context, appID, bundleID string, in model.APIDefinitionInput, spec *model.SpecInput) (string, error)
+}
+
+// EventDefinitionService missing godoc
+//
+//go:generate mockery --name=EventDefinitionService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EventDefinitionService interface {
+	ListByBundleIDs(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.EventDefinitionPage, error)
+	ListFetchRequests(ctx context.Context, specIDs []string) ([]*model.FetchRequest, error)
+	CreateInBundle(ctx context.Context, appID, bundleID string, in model.EventDefinitionInput, spec *model.SpecInput) (string, error)
+}
+
+// DocumentService missing godoc
+//
+//go:generate mockery --name=DocumentService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DocumentService interface {
+	ListByBundleIDs(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error)
+	ListFetchRequests(ctx context.Context, documentIDs []string) ([]*model.FetchRequest, error)
+	CreateInBundle(ctx context.Context, appID, bundleID string, in model.DocumentInput) (string, error)
+}
+
+// SpecService missing godoc
+//
+//go:generate mockery --name=SpecService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SpecService interface {
+	ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.Spec, error)
+}
+
+// CatalogConverter missing godoc
+//
+//go:generate mockery --name=CatalogConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type CatalogConverter interface {
+	ApplicationToInput(app *model.Application, templateName *string, labels map[string]*model.Label, webhooks []*model.Webhook, bundles []*graphql.BundleCreateInput) *Application
+	ApplicationTemplateToInput(appTemplate *model.ApplicationTemplate, labels map[string]*model.Label, webhooks []*model.Webhook) (*graphql.ApplicationTemplateInput, error)
+	BundleToInput(bundle *model.Bundle, apis []*graphql.APIDefinitionInput, events []*graphql.EventDefinitionInput, documents []*graphql.DocumentInput) *graphql.BundleCreateInput
+	APIDefinitionToInput(apiDef *model.APIDefinition, spec *model.Spec, fetchRequest *model.FetchRequest) *graphql.APIDefinitionInput
+	EventDefinitionToInput(eventDef *model.EventDefinition, spec *model.Spec, fetchRequest *model.FetchRequest) *graphql.EventDefinitionInput
+	DocumentToInput(document *model.Document, fetchRequest *model.FetchRequest) *graphql.DocumentInput
+}
+
+// ApplicationConverter missing godoc
+//
+//go:generate mockery --name=ApplicationConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationConverter interface {
+	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
+}
+
+// ApplicationTemplateConverter missing godoc
+//
+//go:generate mockery --name=ApplicationTemplateConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationTemplateConverter interface {
+	InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error)
+}
+
+// WebhookConverter missing godoc
+//
+//go:generate mockery --name=WebhookConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type WebhookConverter interface {
+	InputFromGraphQL(in *graphql.WebhookInput) (*model.WebhookInput, error)
+}
+
+// BundleConverter missing godoc
+//
+//go:generate mockery --name=BundleConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type BundleConverter interface {
+	CreateInputFromGraphQL(in graphql.BundleCreateInput) (model.BundleCreateInput, error)
+}
+
+// APIConverter missing godoc
+//
+//go:generate mockery --name=APIConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type APIConverter interface {
+	InputFromGraphQL(in *graphql.APIDefinitionInput) (*model.APIDefinitionInput, *model.SpecInput, error)
+}
+
+// EventDefinitionConverter missing godoc
+//
+//go:generate mockery --name=EventDefinitionConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type EventDefinitionConverter interface {
+	InputFromGraphQL(in *graphql.EventDefinitionInput) (*model.EventDefinitionInput, *model.SpecInput, error)
+}
+
+// DocumentConverter missing godoc
+//
+//go:generate mockery --name=DocumentConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type DocumentConverter interface {
+	InputFromGraphQL(in *graphql.DocumentInput) (*model.DocumentInput, error)
+}
+
+type service struct {
+	appSvc          ApplicationService
+	appTemplateSvc  ApplicationTemplateService
+	webhookSvc      WebhookService
+	bundleSvc       BundleService
+	apiSvc          APIService
+	eventSvc        EventDefinitionService
+	docSvc          DocumentService
+	specSvc         SpecService
+	appConv         ApplicationConverter
+	appTemplateConv ApplicationTemplateConverter
+	webhookConv     WebhookConverter
+	bundleConv      BundleConverter
+	apiConv         APIConverter
+	eventConv       EventDefinitionConverter
+	docConv         DocumentConverter
+	conv            CatalogConverter
+	scopesGetter    ScopesGetter
+}
+
+// NewService creates a new tenant catalog service
+func NewService(appSvc ApplicationService, appTemplateSvc ApplicationTemplateService, webhookSvc WebhookService, bundleSvc BundleService, apiSvc APIService, eventSvc EventDefinitionService, docSvc DocumentService, specSvc SpecService,
+	appConv ApplicationConverter, appTemplateConv ApplicationTemplateConverter, webhookConv WebhookConverter, bundleConv BundleConverter, apiConv APIConverter, eventConv EventDefinitionConverter, docConv DocumentConverter,
+	conv CatalogConverter, scopesGetter ScopesGetter) *service {
+	return &service{
+		appSvc:          appSvc,
+		appTemplateSvc:  appTemplateSvc,
+		webhookSvc:      webhookSvc,
+		bundleSvc:       bundleSvc,
+		apiSvc:          apiSvc,
+		eventSvc:        eventSvc,
+		docSvc:          docSvc,
+		specSvc:         specSvc,
+		appConv:         appConv,
+		appTemplateConv: appTemplateConv,
+		webhookConv:     webhookConv,
+		bundleConv:      bundleConv,
+		apiConv:         apiConv,
+		eventConv:       eventConv,
+		docConv:         docConv,
+		conv:            conv,
+		scopesGetter:    scopesGetter,
+	}
+}
+
+// Export builds the tenant catalog of the tenant in the context.
+// The fields which the caller is not allowed to read according to the @sanitize rules of the GraphQL API are redacted.
+func (s *service) Export(ctx context.Context) (*Document, error) {
+	apps, err := s.appSvc.ListAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing applications")
+	}
+
+	doc := &Document{Version: CurrentVersion}
+	templateNames := make(map[string]string)
+	for _, app := range apps {
+		var templateName *string
+		if app.ApplicationTemplateID != nil {
+			name, err := s.exportApplicationTemplate(ctx, doc, templateNames, *app.ApplicationTemplateID)
+			if err != nil {
+				return nil, err
+			}
+			templateName = &name
+		}
+
+		labels, err := s.appSvc.ListLabels(ctx, app.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing labels of application with id %s", app.ID)
+		}
+
+		webhooks, err := s.webhookSvc.ListForApplication(ctx, app.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing webhooks of application with id %s", app.ID)
+		}
+
+		bundles, err := s.exportBundles(ctx, app.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.Applications = append(doc.Applications, s.conv.ApplicationToInput(app, templateName, labels, webhooks, bundles))
+	}
+
+	sort.SliceStable(doc.ApplicationTemplates, func(i, j int) bool {
+		return doc.ApplicationTemplates[i].Name < doc.ApplicationTemplates[j].Name
+	})
+	sort.SliceStable(doc.Applications, func(i, j int) bool {
+		return doc.Applications[i].Name < doc.Applications[j].Name
+	})
+
+	redactor, err := newRedactor(ctx, s.scopesGetter)
+	if err != nil {
+		return nil, err
+	}
+	if err := redactor.redact(doc); err != nil {
+		return nil, errors.Wrap(err, "while redacting tenant catalog")
+	}
+
+	return doc, nil
+}
+
+// Import applies the tenant catalog to the tenant in the context and returns the applied changes.
+// Resources are matched by name and only the missing ones are created, apart from the labels of existing Applications which are set to the values in the catalog.
+// In dry-run mode the changes are only computed.
+func (s *service) Import(ctx context.Context, doc *Document, dryRun bool) ([]*model.TenantCatalogChange, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	changes := make([]*model.TenantCatalogChange, 0)
+	templateIDs := make(map[string]string)
+	for _, appTemplate := range doc.ApplicationTemplates {
+		existing, err := s.appTemplateSvc.ListByName(ctx, appTemplate.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing application templates with name %q", appTemplate.Name)
+		}
+		if len(existing) > 0 {
+			templateIDs[appTemplate.Name] = existing[0].ID
+			continue
+		}
+
+		changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeApplicationTemplate, "applicationTemplates", appTemplate.Name))
+		if dryRun {
+			templateIDs[appTemplate.Name] = ""
+			continue
+		}
+
+		in, err := s.appTemplateConv.InputFromGraphQL(*appTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting application template with name %q", appTemplate.Name)
+		}
+
+		id, err := s.appTemplateSvc.Create(ctx, in)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating application template with name %q", appTemplate.Name)
+		}
+		templateIDs[appTemplate.Name] = id
+	}
+
+	existingApps, err := s.appSvc.ListAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing applications")
+	}
+	appIDs := make(map[string]string, len(existingApps))
+	for _, app := range existingApps {
+		appIDs[app.Name] = app.ID
+	}
+
+	for _, app := range doc.Applications {
+		path := fmt.Sprintf("applications/%s", app.Name)
+		if appID, ok := appIDs[app.Name]; ok {
+			appChanges, err := s.importIntoApplication(ctx, appID, path, app, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, appChanges...)
+			continue
+		}
+
+		changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeApplication, "applications", app.Name))
+		if err := s.createApplication(ctx, app, templateIDs, dryRun); err != nil {
+			return nil, err
+		}
+	}
+
+	log.C(ctx).Infof("Tenant catalog import with dry run %t resulted in %d changes", dryRun, len(changes))
+	return changes, nil
+}
+
+func (s *service) exportApplicationTemplate(ctx context.Context, doc *Document, templateNames map[string]string, id string) (string, error) {
+	if name, ok := templateNames[id]; ok {
+		return name, nil
+	}
+
+	appTemplate, err := s.appTemplateSvc.Get(ctx, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while getting application template with id %s", id)
+	}
+
+	labels, err := s.appTemplateSvc.ListLabels(ctx, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while listing labels of application template with id %s", id)
+	}
+
+	webhooks, err := s.webhookSvc.ListForApplicationTemplate(ctx, id)
+	if err != nil {
+		return "", errors.Wrapf(err, "while listing webhooks of application template with id %s", id)
+	}
+
+	in, err := s.conv.ApplicationTemplateToInput(appTemplate, labels, webhooks)
+	if err != nil {
+		return "", err
+	}
+
+	doc.ApplicationTemplates = append(doc.ApplicationTemplates, in)
+	templateNames[id] = appTemplate.Name
+
+	return appTemplate.Name, nil
+}
+
+func (s *service) exportBundles(ctx context.Context, appID string) ([]*graphql.BundleCreateInput, error) {
+	bundles, err := s.bundleSvc.ListByApplicationIDNoPaging(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing bundles of application with id %s", appID)
+	}
+
+	inputs := make([]*graphql.BundleCreateInput, 0, len(bundles))
+	for _, bundle := range bundles {
+		apis, err := s.exportAPIDefinitions(ctx, bundle.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		events, err := s.exportEventDefinitions(ctx, bundle.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		documents, err := s.exportDocuments(ctx, bundle.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, s.conv.BundleToInput(bundle, apis, events, documents))
+	}
+
+	return inputs, nil
+}
+
+func (s *service) exportAPIDefinitions(ctx context.Context, bundleID string) ([]*graphql.APIDefinitionInput, error) {
+	apis, err := s.listAPIDefinitions(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(apis))
+	for _, apiDef := range apis {
+		ids = append(ids, apiDef.ID)
+	}
+
+	specs, fetchRequests, err := s.listSpecs(ctx, model.APISpecReference, ids, s.apiSvc.ListFetchRequests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing specs of API definitions of bundle with id %s", bundleID)
+	}
+
+	inputs := make([]*graphql.APIDefinitionInput, 0, len(apis))
+	for _, apiDef := range apis {
+		spec := specs[apiDef.ID]
+		inputs = append(inputs, s.conv.APIDefinitionToInput(apiDef, spec, fetchRequestForSpec(spec, fetchRequests)))
+	}
+
+	return inputs, nil
+}
+
+func (s *service) exportEventDefinitions(ctx context.Context, bundleID string) ([]*graphql.EventDefinitionInput, error) {
+	events, err := s.listEventDefinitions(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, eventDef := range events {
+		ids = append(ids, eventDef.ID)
+	}
+
+	specs, fetchRequests, err := s.listSpecs(ctx, model.EventSpecReference, ids, s.eventSvc.ListFetchRequests)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing specs of event definitions of bundle with id %s", bundleID)
+	}
+
+	inputs := make([]*graphql.EventDefinitionInput, 0, len(events))
+	for _, eventDef := range events {
+		spec := specs[eventDef.ID]
+		inputs = append(inputs, s.conv.EventDefinitionToInput(eventDef, spec, fetchRequestForSpec(spec, fetchRequests)))
+	}
+
+	return inputs, nil
+}
+
+func (s *service) exportDocuments(ctx context.Context, bundleID string) ([]*graphql.DocumentInput, error) {
+	documents, err := s.listDocuments(ctx, bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(documents))
+	for _, document := range documents {
+		ids = append(ids, document.ID)
+	}
+
+	fetchRequests := make(map[string]*model.FetchRequest)
+	if len(ids) > 0 {
+		frs, err := s.docSvc.ListFetchRequests(ctx, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing fetch requests of documents of bundle with id %s", bundleID)
+		}
+		for _, fr := range frs {
+			fetchRequests[fr.ObjectID] = fr
+		}
+	}
+
+	inputs := make([]*graphql.DocumentInput, 0, len(documents))
+	for _, document := range documents {
+		inputs = append(inputs, s.conv.DocumentToInput(document, fetchRequests[document.ID]))
+	}
+
+	return inputs, nil
+}
+
+// listSpecs returns the first spec of each of the objects and the fetch requests of the specs, mapped by spec ID
+func (s *service) listSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string, listFetchRequests func(ctx context.Context, specIDs []string) ([]*model.FetchRequest, error)) (map[string]*model.Spec, map[string]*model.FetchRequest, error) {
+	specs := make(map[string]*model.Spec)
+	fetchRequests := make(map[string]*model.FetchRequest)
+	if len(objectIDs) == 0 {
+		return specs, fetchRequests, nil
+	}
+
+	allSpecs, err := s.specSvc.ListByReferenceObjectIDs(ctx, objectType, objectIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	specIDs := make([]string, 0, len(allSpecs))
+	for _, spec := range allSpecs {
+		if _, ok := specs[spec.ObjectID]; ok {
+			continue
+		}
+		specs[spec.ObjectID] = spec
+		specIDs = append(specIDs, spec.ID)
+	}
+
+	if len(specIDs) == 0 {
+		return specs, fetchRequests, nil
+	}
+
+	frs, err := listFetchRequests(ctx, specIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, fr := range frs {
+		fetchRequests[fr.ObjectID] = fr
+	}
+
+	return specs, fetchRequests, nil
+}
+
+func (s *service) listAPIDefinitions(ctx context.Context, bundleID string) ([]*model.APIDefinition, error) {
+	var apis []*model.APIDefinition
+	cursor := ""
+	for {
+		pages, err := s.apiSvc.ListByBundleIDs(ctx, []string{bundleID}, pageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing API definitions of bundle with id %s", bundleID)
+		}
+		if len(pages) == 0 {
+			return apis, nil
+		}
+
+		apis = append(apis, pages[0].Data...)
+		if pages[0].PageInfo == nil || !pages[0].PageInfo.HasNextPage {
+			return apis, nil
+		}
+		cursor = pages[0].PageInfo.EndCursor
+	}
+}
+
+func (s *service) listEventDefinitions(ctx context.Context, bundleID string) ([]*model.EventDefinition, error) {
+	var events []*model.EventDefinition
+	cursor := ""
+	for {
+		pages, err := s.eventSvc.ListByBundleIDs(ctx, []string{bundleID}, pageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing event definitions of bundle with id %s", bundleID)
+		}
+		if len(pages) == 0 {
+			return events, nil
+		}
+
+		events = append(events, pages[0].Data...)
+		if pages[0].PageInfo == nil || !pages[0].PageInfo.HasNextPage {
+			return events, nil
+		}
+		cursor = pages[0].PageInfo.EndCursor
+	}
+}
+
+func (s *service) listDocuments(ctx context.Context, bundleID string) ([]*model.Document, error) {
+	var documents []*model.Document
+	cursor := ""
+	for {
+		pages, err := s.docSvc.ListByBundleIDs(ctx, []string{bundleID}, pageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing documents of bundle with id %s", bundleID)
+		}
+		if len(pages) == 0 {
+			return documents, nil
+		}
+
+		documents = append(documents, pages[0].Data...)
+		if pages[0].PageInfo == nil || !pages[0].PageInfo.HasNextPage {
+			return documents, nil
+		}
+		cursor = pages[0].PageInfo.EndCursor
+	}
+}
+
+func (s *service) createApplication(ctx context.Context, app *Application, templateIDs map[string]string, dryRun bool) error {
+	var templateID *string
+	if app.Template != nil {
+		id, ok := templateIDs[*app.Template]
+		if !ok {
+			existing, err := s.appTemplateSvc.ListByName(ctx, *app.Template)
+			if err != nil {
+				return errors.Wrapf(err, "while listing application templates with name %q", *app.Template)
+			}
+			if len(existing) == 0 {
+				return apperrors.NewNotFoundErrorWithMessage(resource.ApplicationTemplate, *app.Template, fmt.Sprintf("application template with name %q of application with name %q is neither in the catalog nor in the environment", *app.Template, app.Name))
+			}
+			id = existing[0].ID
+			templateIDs[*app.Template] = id
+		}
+		templateID = &id
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	in, err := s.appConv.CreateInputFromGraphQL(ctx, app.ApplicationRegisterInput)
+	if err != nil {
+		return errors.Wrapf(err, "while converting application with name %q", app.Name)
+	}
+
+	if templateID != nil {
+		_, err = s.appSvc.CreateFromTemplate(ctx, in, templateID)
+	} else {
+		_, err = s.appSvc.Create(ctx, in)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while creating application with name %q", app.Name)
+	}
+
+	return nil
+}
+
+func (s *service) importIntoApplication(ctx context.Context, appID, path string, app *Application, dryRun bool) ([]*model.TenantCatalogChange, error) {
+	changes, err := s.importLabels(ctx, appID, path, app.Labels, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookChanges, err := s.importWebhooks(ctx, appID, path, app.Webhooks, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, webhookChanges...)
+
+	bundles, err := s.bundleSvc.ListByApplicationIDNoPaging(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing bundles of application with id %s", appID)
+	}
+	bundleIDs := make(map[string]string, len(bundles))
+	for _, bundle := range bundles {
+		bundleIDs[bundle.Name] = bundle.ID
+	}
+
+	for _, bundle := range app.Bundles {
+		if bundleID, ok := bundleIDs[bundle.Name]; ok {
+			bundleChanges, err := s.importIntoBundle(ctx, appID, bundleID, fmt.Sprintf("%s/bundles/%s", path, bundle.Name), bundle, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, bundleChanges...)
+			continue
+		}
+
+		changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeBundle, path+"/bundles", bundle.Name))
+		if dryRun {
+			continue
+		}
+
+		in, err := s.bundleConv.CreateInputFromGraphQL(*bundle)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting bundle with name %q", bundle.Name)
+		}
+		if _, err := s.bundleSvc.Create(ctx, appID, in); err != nil {
+			return nil, errors.Wrapf(err, "while creating bundle with name %q", bundle.Name)
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *service) importLabels(ctx context.Context, appID, path string, labels graphql.Labels, dryRun bool) ([]*model.TenantCatalogChange, error) {
+	changes := make([]*model.TenantCatalogChange, 0)
+	if len(labels) == 0 {
+		return changes, nil
+	}
+
+	existing, err := s.appSvc.ListLabels(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing labels of application with id %s", appID)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		action := model.TenantCatalogChangeActionCreate
+		if existingLabel, ok := existing[key]; ok {
+			equal, err := jsonEqual(existingLabel.Value, labels[key])
+			if err != nil {
+				return nil, errors.Wrapf(err, "while comparing values of label with key %q", key)
+			}
+			if equal {
+				continue
+			}
+			action = model.TenantCatalogChangeActionUpdate
+		}
+
+		changes = append(changes, newChange(action, model.TenantCatalogResourceTypeLabel, path+"/labels", key))
+		if dryRun {
+			continue
+		}
+
+		if err := s.appSvc.SetLabel(ctx, &model.LabelInput{
+			Key:        key,
+			Value:      labels[key],
+			ObjectID:   appID,
+			ObjectType: model.ApplicationLabelableObject,
+		}); err != nil {
+			return nil, errors.Wrapf(err, "while setting label with key %q", key)
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *service) importWebhooks(ctx context.Context, appID, path string, webhooks []*graphql.WebhookInput, dryRun bool) ([]*model.TenantCatalogChange, error) {
+	changes := make([]*model.TenantCatalogChange, 0)
+	if len(webhooks) == 0 {
+		return changes, nil
+	}
+
+	existing, err := s.webhookSvc.ListForApplication(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing webhooks of application with id %s", appID)
+	}
+	existingTypes := make(map[model.WebhookType]bool, len(existing))
+	for _, webhook := range existing {
+		existingTypes[webhook.Type] = true
+	}
+
+	for _, webhook := range webhooks {
+		if existingTypes[model.WebhookType(webhook.Type)] {
+			continue
+		}
+		existingTypes[model.WebhookType(webhook.Type)] = true
+
+		changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeWebhook, path+"/webhooks", string(webhook.Type)))
+		if dryRun {
+			continue
+		}
+
+		in, err := s.webhookConv.InputFromGraphQL(webhook)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting webhook with type %s", webhook.Type)
+		}
+		if _, err := s.webhookSvc.Create(ctx, appID, *in, model.ApplicationWebhookReference); err != nil {
+			return nil, errors.Wrapf(err, "while creating webhook with type %s", webhook.Type)
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *service) importIntoBundle(ctx context.Context, appID, bundleID, path string, bundle *graphql.BundleCreateInput, dryRun bool) ([]*model.TenantCatalogChange, error) {
+	changes := make([]*model.TenantCatalogChange, 0)
+
+	if len(bundle.APIDefinitions) > 0 {
+		apis, err := s.listAPIDefinitions(ctx, bundleID)
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string]bool, len(apis))
+		for _, apiDef := range apis {
+			existing[apiDef.Name] = true
+		}
+
+		for _, apiDef := range bundle.APIDefinitions {
+			if existing[apiDef.Name] {
+				continue
+			}
+
+			changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeAPIDefinition, path+"/apiDefinitions", apiDef.Name))
+			if dryRun {
+				continue
+			}
+
+			in, spec, err := s.apiConv.InputFromGraphQL(apiDef)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while converting API definition with name %q", apiDef.Name)
+			}
+			if _, err := s.apiSvc.CreateInBundle(ctx, appID, bundleID, *in, spec); err != nil {
+				return nil, errors.Wrapf(err, "while creating API definition with name %q", apiDef.Name)
+			}
+		}
+	}
+
+	if len(bundle.EventDefinitions) > 0 {
+		events, err := s.listEventDefinitions(ctx, bundleID)
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string]bool, len(events))
+		for _, eventDef := range events {
+			existing[eventDef.Name] = true
+		}
+
+		for _, eventDef := range bundle.EventDefinitions {
+			if existing[eventDef.Name] {
+				continue
+			}
+
+			changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeEventDefinition, path+"/eventDefinitions", eventDef.Name))
+			if dryRun {
+				continue
+			}
+
+			in, spec, err := s.eventConv.InputFromGraphQL(eventDef)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while converting event definition with name %q", eventDef.Name)
+			}
+			if _, err := s.eventSvc.CreateInBundle(ctx, appID, bundleID, *in, spec); err != nil {
+				return nil, errors.Wrapf(err, "while creating event definition with name %q", eventDef.Name)
+			}
+		}
+	}
+
+	if len(bundle.Documents) > 0 {
+		documents, err := s.listDocuments(ctx, bundleID)
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[string]bool, len(documents))
+		for _, document := range documents {
+			existing[document.Title] = true
+		}
+
+		for _, document := range bundle.Documents {
+			if existing[document.Title] {
+				continue
+			}
+
+			changes = append(changes, newChange(model.TenantCatalogChangeActionCreate, model.TenantCatalogResourceTypeDocument, path+"/documents", document.Title))
+			if dryRun {
+				continue
+			}
+
+			in, err := s.docConv.InputFromGraphQL(document)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while converting document with title %q", document.Title)
+			}
+			if _, err := s.docSvc.CreateInBundle(ctx, appID, bundleID, *in); err != nil {
+				return nil, errors.Wrapf(err, "while creating document with title %q", document.Title)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+func newChange(action model.TenantCatalogChangeAction, resourceType model.TenantCatalogResourceType, parentPath, name string) *model.TenantCatalogChange {
+	return &model.TenantCatalogChange{
+		Action:       action,
+		ResourceType: resourceType,
+		Path:         fmt.Sprintf("%s/%s", parentPath, name),
+	}
+}
+
+func fetchRequestForSpec(spec *model.Spec, fetchRequests map[string]*model.FetchRequest) *model.FetchRequest {
+	if spec == nil {
+		return nil
+	}
+
+	return fetchRequests[spec.ID]
+}
+
+// jsonEqual compares the JSON representations of the values, so that label values read from the database and parsed from the catalog are comparable
+func jsonEqual(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(aJSON, bJSON), nil
+}
@@ -0,0 +1,418 @@
+package tenantcatalog_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantcatalog/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	readScope   = "application:read"
+	secretScope = "application.auths:read"
+)
+
+type testMocks struct {
+	appSvc          *automock.ApplicationService
+	appTemplateSvc  *automock.ApplicationTemplateService
+	webhookSvc      *automock.WebhookService
+	bundleSvc       *automock.BundleService
+	apiSvc          *automock.APIService
+	eventSvc        *automock.EventDefinitionService
+	docSvc          *automock.DocumentService
+	specSvc         *automock.SpecService
+	appConv         *automock.ApplicationConverter
+	appTemplateConv *automock.ApplicationTemplateConverter
+	webhookConv     *automock.WebhookConverter
+	bundleConv      *automock.BundleConverter
+	apiConv         *automock.APIConverter
+	eventConv       *automock.EventDefinitionConverter
+	docConv         *automock.DocumentConverter
+	conv            *automock.CatalogConverter
+	scopesGetter    *automock.ScopesGetter
+}
+
+func newTestMocks(t *testing.T) *testMocks {
+	return &testMocks{
+		appSvc:          automock.NewApplicationService(t),
+		appTemplateSvc:  automock.NewApplicationTemplateService(t),
+		webhookSvc:      automock.NewWebhookService(t),
+		bundleSvc:       automock.NewBundleService(t),
+		apiSvc:          automock.NewAPIService(t),
+		eventSvc:        automock.NewEventDefinitionService(t),
+		docSvc:          automock.NewDocumentService(t),
+		specSvc:         automock.NewSpecService(t),
+		appConv:         automock.NewApplicationConverter(t),
+		appTemplateConv: automock.NewApplicationTemplateConverter(t),
+		webhookConv:     automock.NewWebhookConverter(t),
+		bundleConv:      automock.NewBundleConverter(t),
+		apiConv:         automock.NewAPIConverter(t),
+		eventConv:       automock.NewEventDefinitionConverter(t),
+		docConv:         automock.NewDocumentConverter(t),
+		conv:            automock.NewCatalogConverter(t),
+		scopesGetter:    automock.NewScopesGetter(t),
+	}
+}
+
+func (m *testMocks) newService() tenantcatalog.TenantCatalogService {
+	return tenantcatalog.NewService(m.appSvc, m.appTemplateSvc, m.webhookSvc, m.bundleSvc, m.apiSvc, m.eventSvc, m.docSvc, m.specSvc,
+		m.appConv, m.appTemplateConv, m.webhookConv, m.bundleConv, m.apiConv, m.eventConv, m.docConv, m.conv, m.scopesGetter)
+}
+
+func TestService_Export(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+	allScopesCtx := scope.SaveToContext(ctx, []string{readScope, secretScope})
+	readScopesCtx := scope.SaveToContext(ctx, []string{readScope})
+
+	expectedRedactedApp := fixCatalogApplication(str.Ptr(appTemplateName))
+	for _, webhook := range expectedRedactedApp.Webhooks {
+		webhook.Auth = nil
+		webhook.Signature = nil
+	}
+	redactedBundle := expectedRedactedApp.Bundles[0]
+	redactedBundle.DefaultInstanceAuth = nil
+	redactedBundle.APIDefinitions[0].Spec.FetchRequest.Auth = nil
+	redactedBundle.EventDefinitions[0].Spec.FetchRequest.Auth = nil
+	redactedBundle.Documents[0].FetchRequest.Auth = nil
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		MocksFn           func(ctx context.Context, m *testMocks)
+		ExpectedDocument  *tenantcatalog.Document
+		ExpectedErrString string
+	}{
+		{
+			Name:    "Success",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				expectCatalogReads(ctx, m)
+				m.scopesGetter.On("GetRequiredScopes", mock.Anything).Return(scopesForDefinition, nil)
+			},
+			ExpectedDocument: fixDocument(),
+		},
+		{
+			Name:    "Success redacts the credentials the caller is not allowed to read",
+			Context: readScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				expectCatalogReads(ctx, m)
+				m.scopesGetter.On("GetRequiredScopes", mock.Anything).Return(scopesForDefinition, nil)
+			},
+			ExpectedDocument: &tenantcatalog.Document{
+				Version:              tenantcatalog.CurrentVersion,
+				ApplicationTemplates: []*graphql.ApplicationTemplateInput{fixGQLApplicationTemplate()},
+				Applications:         []*tenantcatalog.Application{expectedRedactedApp},
+			},
+		},
+		{
+			Name:    "Success for tenant without applications",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+			},
+			ExpectedDocument: &tenantcatalog.Document{Version: tenantcatalog.CurrentVersion},
+		},
+		{
+			Name:    "Error when listing applications fails",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return(nil, testErr).Once()
+			},
+			ExpectedErrString: "while listing applications",
+		},
+		{
+			Name:    "Error when getting the application template fails",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{fixModelApplication(str.Ptr(appTemplateID))}, nil).Once()
+				m.appTemplateSvc.On("Get", ctx, appTemplateID).Return(nil, testErr).Once()
+			},
+			ExpectedErrString: "while getting application template with id " + appTemplateID,
+		},
+		{
+			Name:    "Error when listing bundles fails",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{fixModelApplication(nil)}, nil).Once()
+				m.appSvc.On("ListLabels", ctx, appID).Return(fixModelLabels(), nil).Once()
+				m.webhookSvc.On("ListForApplication", ctx, appID).Return(fixModelWebhooks(), nil).Once()
+				m.bundleSvc.On("ListByApplicationIDNoPaging", ctx, appID).Return(nil, testErr).Once()
+			},
+			ExpectedErrString: "while listing bundles of application with id " + appID,
+		},
+		{
+			Name:    "Error when there are no scopes in the context",
+			Context: ctx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+			},
+			ExpectedErrString: "cannot read scopes from context",
+		},
+		{
+			Name:    "Error when getting the required scopes fails",
+			Context: allScopesCtx,
+			MocksFn: func(ctx context.Context, m *testMocks) {
+				expectCatalogReads(ctx, m)
+				m.scopesGetter.On("GetRequiredScopes", mock.Anything).Return(nil, testErr).Once()
+			},
+			ExpectedErrString: "while redacting tenant catalog",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			m := newTestMocks(t)
+			testCase.MocksFn(testCase.Context, m)
+
+			svc := m.newService()
+
+			// WHEN
+			doc, err := svc.Export(testCase.Context)
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrString)
+				assert.Nil(t, doc)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDocument, doc)
+			}
+		})
+	}
+}
+
+func TestService_Import(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+
+	appPath := "applications/" + appName
+	bundlePath := appPath + "/bundles/" + bundleName
+
+	createChanges := []*model.TenantCatalogChange{
+		{Action: model.TenantCatalogChangeActionCreate, ResourceType: model.TenantCatalogResourceTypeApplicationTemplate, Path: "applicationTemplates/" + appTemplateName},
+		{Action: model.TenantCatalogChangeActionCreate, ResourceType: model.TenantCatalogResourceTypeApplication, Path: appPath},
+	}
+	updateChanges := []*model.TenantCatalogChange{
+		{Action: model.TenantCatalogChangeActionUpdate, ResourceType: model.TenantCatalogResourceTypeLabel, Path: appPath + "/labels/" + labelKey},
+		{Action: model.TenantCatalogChangeActionCreate, ResourceType: model.TenantCatalogResourceTypeWebhook, Path: appPath + "/webhooks/" + string(graphql.WebhookTypeConfigurationChanged)},
+		{Action: model.TenantCatalogChangeActionCreate, ResourceType: model.TenantCatalogResourceTypeEventDefinition, Path: bundlePath + "/eventDefinitions/" + eventName},
+	}
+
+	appTemplateInput := model.ApplicationTemplateInput{Name: appTemplateName}
+	appInput := model.ApplicationRegisterInput{Name: appName}
+	webhookInput := &model.WebhookInput{Type: model.WebhookTypeConfigurationChanged, URL: str.Ptr(webhookURL)}
+	eventInput := &model.EventDefinitionInput{Name: eventName}
+	eventSpecInput := &model.SpecInput{Format: model.SpecFormatJSON}
+
+	docWithUnknownTemplate := &tenantcatalog.Document{
+		Version:      tenantcatalog.CurrentVersion,
+		Applications: []*tenantcatalog.Application{fixCatalogApplication(str.Ptr("unknown"))},
+	}
+
+	testCases := []struct {
+		Name              string
+		Document          *tenantcatalog.Document
+		DryRun            bool
+		MocksFn           func(m *testMocks)
+		ExpectedChanges   []*model.TenantCatalogChange
+		ExpectedErrString string
+	}{
+		{
+			Name:     "Success creates the missing application template and application",
+			Document: fixDocument(),
+			MocksFn: func(m *testMocks) {
+				m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{}, nil).Once()
+				m.appTemplateConv.On("InputFromGraphQL", *fixGQLApplicationTemplate()).Return(appTemplateInput, nil).Once()
+				m.appTemplateSvc.On("Create", ctx, appTemplateInput).Return(appTemplateID, nil).Once()
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+				m.appConv.On("CreateInputFromGraphQL", ctx, fixCatalogApplication(nil).ApplicationRegisterInput).Return(appInput, nil).Once()
+				m.appSvc.On("CreateFromTemplate", ctx, appInput, str.Ptr(appTemplateID)).Return(appID, nil).Once()
+			},
+			ExpectedChanges: createChanges,
+		},
+		{
+			Name:     "Success in dry-run mode does not create anything",
+			Document: fixDocument(),
+			DryRun:   true,
+			MocksFn: func(m *testMocks) {
+				m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{}, nil).Once()
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+			},
+			ExpectedChanges: createChanges,
+		},
+		{
+			Name:     "Success updates the existing application",
+			Document: fixDocument(),
+			MocksFn: func(m *testMocks) {
+				expectExistingApplication(ctx, m)
+				m.appSvc.On("SetLabel", ctx, &model.LabelInput{Key: labelKey, Value: labelValue, ObjectID: appID, ObjectType: model.ApplicationLabelableObject}).Return(nil).Once()
+				m.webhookConv.On("InputFromGraphQL", fixGQLWebhooks()[0]).Return(webhookInput, nil).Once()
+				m.webhookSvc.On("Create", ctx, appID, *webhookInput, model.ApplicationWebhookReference).Return("webhook-id", nil).Once()
+				m.eventConv.On("InputFromGraphQL", fixGQLEventDefinition()).Return(eventInput, eventSpecInput, nil).Once()
+				m.eventSvc.On("CreateInBundle", ctx, appID, bundleID, *eventInput, eventSpecInput).Return(eventID, nil).Once()
+			},
+			ExpectedChanges: updateChanges,
+		},
+		{
+			Name:     "Success in dry-run mode does not update the existing application",
+			Document: fixDocument(),
+			DryRun:   true,
+			MocksFn: func(m *testMocks) {
+				expectExistingApplication(ctx, m)
+			},
+			ExpectedChanges: updateChanges,
+		},
+		{
+			Name:              "Error when the document is not valid",
+			Document:          &tenantcatalog.Document{Version: "v0"},
+			MocksFn:           func(m *testMocks) {},
+			ExpectedErrString: "unsupported tenant catalog version",
+		},
+		{
+			Name:     "Error when the application template of an application is unknown",
+			Document: docWithUnknownTemplate,
+			DryRun:   true,
+			MocksFn: func(m *testMocks) {
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+				m.appTemplateSvc.On("ListByName", ctx, "unknown").Return([]*model.ApplicationTemplate{}, nil).Once()
+			},
+			ExpectedErrString: `application template with name "unknown" of application with name "app" is neither in the catalog nor in the environment`,
+		},
+		{
+			Name:     "Error when creating the application template fails",
+			Document: fixDocument(),
+			MocksFn: func(m *testMocks) {
+				m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{}, nil).Once()
+				m.appTemplateConv.On("InputFromGraphQL", *fixGQLApplicationTemplate()).Return(appTemplateInput, nil).Once()
+				m.appTemplateSvc.On("Create", ctx, appTemplateInput).Return("", testErr).Once()
+			},
+			ExpectedErrString: `while creating application template with name "template"`,
+		},
+		{
+			Name:     "Error when creating the application fails",
+			Document: fixDocument(),
+			MocksFn: func(m *testMocks) {
+				m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{fixModelApplicationTemplate()}, nil).Once()
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{}, nil).Once()
+				m.appConv.On("CreateInputFromGraphQL", ctx, fixCatalogApplication(nil).ApplicationRegisterInput).Return(appInput, nil).Once()
+				m.appSvc.On("CreateFromTemplate", ctx, appInput, str.Ptr(appTemplateID)).Return("", testErr).Once()
+			},
+			ExpectedErrString: `while creating application with name "app"`,
+		},
+		{
+			Name:     "Error when setting a label fails",
+			Document: fixDocument(),
+			MocksFn: func(m *testMocks) {
+				m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{fixModelApplicationTemplate()}, nil).Once()
+				m.appSvc.On("ListAll", ctx).Return([]*model.Application{fixModelApplication(str.Ptr(appTemplateID))}, nil).Once()
+				m.appSvc.On("ListLabels", ctx, appID).Return(map[string]*model.Label{}, nil).Once()
+				m.appSvc.On("SetLabel", ctx, mock.Anything).Return(testErr).Once()
+			},
+			ExpectedErrString: `while setting label with key "env"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			m := newTestMocks(t)
+			testCase.MocksFn(m)
+
+			svc := m.newService()
+
+			// WHEN
+			changes, err := svc.Import(ctx, testCase.Document, testCase.DryRun)
+
+			// THEN
+			if testCase.ExpectedErrString != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrString)
+				assert.Nil(t, changes)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedChanges, changes)
+			}
+		})
+	}
+}
+
+// expectCatalogReads sets up the reads of an Application created from a template with one bundle holding one API Definition, Event Definition and Document
+func expectCatalogReads(ctx context.Context, m *testMocks) {
+	app := fixModelApplication(str.Ptr(appTemplateID))
+	appTemplate := fixModelApplicationTemplate()
+	labels := fixModelLabels()
+	webhooks := fixModelWebhooks()
+	bundle := fixModelBundle()
+	apiDef := fixModelAPIDefinition()
+	eventDef := fixModelEventDefinition()
+	document := fixModelDocument()
+	apiSpec := fixModelAPISpec()
+	eventSpec := fixModelEventSpec()
+	apiFetchRequest := fixModelFetchRequest(apiSpecID, model.APISpecFetchRequestReference)
+	eventFetchRequest := fixModelFetchRequest(eventSpecID, model.EventSpecFetchRequestReference)
+	docFetchRequest := fixModelFetchRequest(docID, model.DocumentFetchRequestReference)
+
+	m.appSvc.On("ListAll", ctx).Return([]*model.Application{app}, nil).Once()
+	m.appTemplateSvc.On("Get", ctx, appTemplateID).Return(appTemplate, nil).Once()
+	m.appTemplateSvc.On("ListLabels", ctx, appTemplateID).Return(map[string]*model.Label{}, nil).Once()
+	m.webhookSvc.On("ListForApplicationTemplate", ctx, appTemplateID).Return([]*model.Webhook{}, nil).Once()
+	m.conv.On("ApplicationTemplateToInput", appTemplate, map[string]*model.Label{}, []*model.Webhook{}).Return(fixGQLApplicationTemplate(), nil).Once()
+
+	m.appSvc.On("ListLabels", ctx, appID).Return(labels, nil).Once()
+	m.webhookSvc.On("ListForApplication", ctx, appID).Return(webhooks, nil).Once()
+	m.bundleSvc.On("ListByApplicationIDNoPaging", ctx, appID).Return([]*model.Bundle{bundle}, nil).Once()
+
+	m.apiSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.APIDefinitionPage{{Data: []*model.APIDefinition{apiDef}, PageInfo: &pagination.Page{}}}, nil).Once()
+	m.specSvc.On("ListByReferenceObjectIDs", ctx, model.APISpecReference, []string{apiID}).Return([]*model.Spec{apiSpec}, nil).Once()
+	m.apiSvc.On("ListFetchRequests", ctx, []string{apiSpecID}).Return([]*model.FetchRequest{apiFetchRequest}, nil).Once()
+	m.conv.On("APIDefinitionToInput", apiDef, apiSpec, apiFetchRequest).Return(fixGQLAPIDefinition()).Once()
+
+	m.eventSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.EventDefinitionPage{{Data: []*model.EventDefinition{eventDef}, PageInfo: &pagination.Page{}}}, nil).Once()
+	m.specSvc.On("ListByReferenceObjectIDs", ctx, model.EventSpecReference, []string{eventID}).Return([]*model.Spec{eventSpec}, nil).Once()
+	m.eventSvc.On("ListFetchRequests", ctx, []string{eventSpecID}).Return([]*model.FetchRequest{eventFetchRequest}, nil).Once()
+	m.conv.On("EventDefinitionToInput", eventDef, eventSpec, eventFetchRequest).Return(fixGQLEventDefinition()).Once()
+
+	m.docSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.DocumentPage{{Data: []*model.Document{document}, PageInfo: &pagination.Page{}}}, nil).Once()
+	m.docSvc.On("ListFetchRequests", ctx, []string{docID}).Return([]*model.FetchRequest{docFetchRequest}, nil).Once()
+	m.conv.On("DocumentToInput", document, docFetchRequest).Return(fixGQLDocument()).Once()
+
+	gqlBundle := fixGQLBundle()
+	m.conv.On("BundleToInput", bundle, gqlBundle.APIDefinitions, gqlBundle.EventDefinitions, gqlBundle.Documents).Return(gqlBundle).Once()
+	m.conv.On("ApplicationToInput", app, str.Ptr(appTemplateName), labels, webhooks, []*graphql.BundleCreateInput{gqlBundle}).Return(fixCatalogApplication(str.Ptr(appTemplateName))).Once()
+}
+
+// expectExistingApplication sets up the reads of an existing Application with a different label value, no webhooks and an existing bundle which lacks the Event Definition of the catalog
+func expectExistingApplication(ctx context.Context, m *testMocks) {
+	m.appTemplateSvc.On("ListByName", ctx, appTemplateName).Return([]*model.ApplicationTemplate{fixModelApplicationTemplate()}, nil).Once()
+	m.appSvc.On("ListAll", ctx).Return([]*model.Application{fixModelApplication(str.Ptr(appTemplateID))}, nil).Once()
+	m.appSvc.On("ListLabels", ctx, appID).Return(map[string]*model.Label{labelKey: {Key: labelKey, Value: "prod"}}, nil).Once()
+	m.webhookSvc.On("ListForApplication", ctx, appID).Return([]*model.Webhook{}, nil).Once()
+	m.bundleSvc.On("ListByApplicationIDNoPaging", ctx, appID).Return([]*model.Bundle{fixModelBundle()}, nil).Once()
+	m.apiSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.APIDefinitionPage{{Data: []*model.APIDefinition{fixModelAPIDefinition()}, PageInfo: &pagination.Page{}}}, nil).Once()
+	m.eventSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.EventDefinitionPage{{Data: []*model.EventDefinition{}, PageInfo: &pagination.Page{}}}, nil).Once()
+	m.docSvc.On("ListByBundleIDs", ctx, []string{bundleID}, 200, "").Return([]*model.DocumentPage{{Data: []*model.Document{fixModelDocument()}, PageInfo: &pagination.Page{}}}, nil).Once()
+}
+
+// scopesForDefinition requires the secret scope for the credentials and the read scope for everything else
+func scopesForDefinition(scopesDefinition string) []string {
+	switch scopesDefinition {
+	case "graphql.field.webhooks.auth", "graphql.field.webhooks.signature", "graphql.field.fetch_request.auth", "graphql.field.bundle.default_instance_auth":
+		return []string{secretScope}
+	default:
+		return []string{readScope}
+	}
+}
@@ -4,11 +4,10 @@ package automock
 
 import (
 	context "context"
+	testing "testing"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
-
-	testing "testing"
 )
 
 // TombstoneRepository is an autogenerated mock type for the TombstoneRepository type
@@ -111,6 +110,29 @@ func (_m *TombstoneRepository) ListByApplicationID(ctx context.Context, tenantID
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, appIDs
+func (_m *TombstoneRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Tombstone, error) {
+	ret := _m.Called(ctx, tenantID, appIDs)
+
+	var r0 []*model.Tombstone
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Tombstone); ok {
+		r0 = rf(ctx, tenantID, appIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tombstone)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, appIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, tenant, item
 func (_m *TombstoneRepository) Update(ctx context.Context, tenant string, item *model.Tombstone) error {
 	ret := _m.Called(ctx, tenant, item)
@@ -4,6 +4,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 type converter struct {
@@ -45,3 +46,30 @@ func (c *converter) FromEntity(entity *Entity) (*model.Tombstone, error) {
 
 	return output, nil
 }
+
+// ToGraphQL converts the provided service-layer Tombstone model to a graphql Tombstone
+func (c *converter) ToGraphQL(in *model.Tombstone) *graphql.Tombstone {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Tombstone{
+		ID:            in.ID,
+		OrdID:         in.OrdID,
+		ApplicationID: in.ApplicationID,
+		RemovalDate:   in.RemovalDate,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer Tombstone models to graphql Tombstones
+func (c *converter) MultipleToGraphQL(in []*model.Tombstone) []*graphql.Tombstone {
+	out := make([]*graphql.Tombstone, 0, len(in))
+	for _, item := range in {
+		if item == nil {
+			continue
+		}
+		out = append(out, c.ToGraphQL(item))
+	}
+
+	return out
+}
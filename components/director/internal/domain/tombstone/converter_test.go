@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,3 +49,29 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		gql := conv.ToGraphQL(fixTombstoneModel())
+
+		assert.Equal(t, fixGQLTombstone(), gql)
+	})
+
+	t.Run("Returns nil if Tombstone model is nil", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		gql := conv.ToGraphQL(nil)
+
+		require.Nil(t, gql)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := tombstone.NewConverter()
+
+	gqls := conv.MultipleToGraphQL([]*model.Tombstone{fixTombstoneModel(), nil})
+
+	assert.Equal(t, []*graphql.Tombstone{fixGQLTombstone()}, gqls)
+}
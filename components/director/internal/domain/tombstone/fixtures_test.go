@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -62,3 +63,12 @@ func fixTombstoneRowWithID(id string) []driver.Value {
 func fixTombstoneUpdateArgs() []driver.Value {
 	return []driver.Value{"removalDate"}
 }
+
+func fixGQLTombstone() *graphql.Tombstone {
+	return &graphql.Tombstone{
+		ID:            tombstoneID,
+		OrdID:         ordID,
+		ApplicationID: appID,
+		RemovalDate:   "removalDate",
+	}
+}
@@ -111,6 +111,23 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return tombstones, nil
 }
 
+// ListByApplicationIDs gets all Tombstones for the given application ids
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Tombstone, error) {
+	tombstoneCollection := tombstoneCollection{}
+	if err := r.lister.List(ctx, resource.Tombstone, tenantID, &tombstoneCollection, repo.NewInConditionForStringValues("app_id", appIDs)); err != nil {
+		return nil, err
+	}
+	tombstones := make([]*model.Tombstone, 0, tombstoneCollection.Len())
+	for _, tombstone := range tombstoneCollection {
+		tombstoneModel, err := r.conv.FromEntity(&tombstone)
+		if err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, tombstoneModel)
+	}
+	return tombstones, nil
+}
+
 type tombstoneCollection []Entity
 
 // Len missing godoc
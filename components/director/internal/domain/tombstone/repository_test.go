@@ -184,3 +184,32 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 
 	suite.Run(t)
 }
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Tombstones",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT ord_id, app_id, removal_date, id FROM public.tombstones WHERE app_id IN ($1) AND (id IN (SELECT id FROM tombstones_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixTombstoneColumns()).AddRow(fixTombstoneRowWithID("id1")...).AddRow(fixTombstoneRowWithID("id2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixTombstoneColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   tombstone.NewRepository,
+		ExpectedModelEntities: []interface{}{fixTombstoneModelWithID("id1"), fixTombstoneModelWithID("id2")},
+		ExpectedDBEntities:    []interface{}{fixEntityTombstoneWithID("id1"), fixEntityTombstoneWithID("id2")},
+		MethodArgs:            []interface{}{tenantID, []string{appID}},
+		MethodName:            "ListByApplicationIDs",
+	}
+
+	suite.Run(t)
+}
@@ -0,0 +1,95 @@
+package tombstone
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+// TombstoneService is responsible for the service-layer Tombstone operations needed by the resolver
+//go:generate mockery --name=TombstoneService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TombstoneService interface {
+	ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Tombstone, error)
+}
+
+// TombstoneConverter converts Tombstones between the model.Tombstone service-layer representation and the graphql-layer representation
+//go:generate mockery --name=TombstoneConverter --output=automock --outpkg=automock --case=underscore --disable-version-string
+type TombstoneConverter interface {
+	MultipleToGraphQL(in []*model.Tombstone) []*graphql.Tombstone
+}
+
+// Resolver is an object responsible for resolver-layer Tombstone operations
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      TombstoneService
+	conv     TombstoneConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer Tombstone operations
+func NewResolver(transact persistence.Transactioner, svc TombstoneService, conv TombstoneConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+// Tombstones returns the Tombstones of the given Application. The Tombstones of all Applications in the query are loaded in a single batch
+func (r *Resolver) Tombstones(ctx context.Context, obj *graphql.Application) ([]*graphql.Tombstone, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	param := dataloader.ParamTombstone{ID: obj.ID, Ctx: ctx}
+	return dataloader.TombstoneFor(ctx).TombstoneByID.Load(param)
+}
+
+// TombstonesDataLoader loads the Tombstones of all requested Applications and returns them in the order of the keys
+func (r *Resolver) TombstonesDataLoader(keys []dataloader.ParamTombstone) ([][]*graphql.Tombstone, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tombstones, err := r.svc.ListByApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{err}
+	}
+
+	tombstonesByAppID := make(map[string][]*graphql.Tombstone, len(keys))
+	for _, tombstone := range r.conv.MultipleToGraphQL(tombstones) {
+		tombstonesByAppID[tombstone.ApplicationID] = append(tombstonesByAppID[tombstone.ApplicationID], tombstone)
+	}
+
+	gqlTombstones := make([][]*graphql.Tombstone, 0, len(keys))
+	for _, key := range keys {
+		if tombstones, ok := tombstonesByAppID[key.ID]; ok {
+			gqlTombstones = append(gqlTombstones, tombstones)
+			continue
+		}
+		gqlTombstones = append(gqlTombstones, []*graphql.Tombstone{})
+	}
+
+	return gqlTombstones, nil
+}
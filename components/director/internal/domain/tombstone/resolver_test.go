@@ -0,0 +1,142 @@
+package tombstone_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Tombstones(t *testing.T) {
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := tombstone.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Tombstones(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+}
+
+func TestResolver_TombstonesDataLoader(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondAppID := "secondAppID"
+	keys := []dataloader.ParamTombstone{{ID: appID, Ctx: ctx}, {ID: secondAppID, Ctx: ctx}}
+	appIDs := []string{appID, secondAppID}
+
+	models := []*model.Tombstone{fixTombstoneModel()}
+	gqls := []*graphql.Tombstone{fixGQLTombstone()}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn      func() *automock.TombstoneService
+		ConverterFn    func() *automock.TombstoneConverter
+		ExpectedResult [][]*graphql.Tombstone
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				conv := &automock.TombstoneConverter{}
+				conv.On("MultipleToGraphQL", models).Return(gqls).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.Tombstone{gqls, {}},
+		},
+		{
+			Name: "Returns error when listing fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: unusedTombstoneConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:        "Returns error when transaction begin fails",
+			TxFn:        txGen.ThatFailsOnBegin,
+			ServiceFn:   unusedTombstoneService,
+			ConverterFn: unusedTombstoneConverter,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), appIDs).Return(models, nil).Once()
+				return svc
+			},
+			ConverterFn: unusedTombstoneConverter,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := tombstone.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.TombstonesDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Empty(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := tombstone.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, errs := resolver.TombstonesDataLoader([]dataloader.ParamTombstone{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Applications found")
+	})
+}
+
+func unusedTombstoneService() *automock.TombstoneService {
+	return &automock.TombstoneService{}
+}
+
+func unusedTombstoneConverter() *automock.TombstoneConverter {
+	return &automock.TombstoneConverter{}
+}
@@ -18,6 +18,7 @@ type TombstoneRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Tombstone, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Tombstone, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, appIDs []string) ([]*model.Tombstone, error)
 }
 
 // UIDService missing godoc
@@ -131,3 +132,13 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 
 	return s.tombstoneRepo.ListByApplicationID(ctx, tnt, appID)
 }
+
+// ListByApplicationIDs returns the Tombstones of all given Applications.
+func (s *service) ListByApplicationIDs(ctx context.Context, appIDs []string) ([]*model.Tombstone, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tombstoneRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+}
@@ -80,6 +80,7 @@ func (c *converter) ToGraphQL(in *model.Webhook) (*graphql.Webhook, error) {
 		HeaderTemplate:        in.HeaderTemplate,
 		OutputTemplate:        in.OutputTemplate,
 		StatusTemplate:        in.StatusTemplate,
+		Signature:             signatureToGraphQL(in.Signature),
 	}, nil
 }
 
@@ -132,6 +133,7 @@ func (c *converter) InputFromGraphQL(in *graphql.WebhookInput) (*model.WebhookIn
 		HeaderTemplate:   in.HeaderTemplate,
 		OutputTemplate:   in.OutputTemplate,
 		StatusTemplate:   in.StatusTemplate,
+		Signature:        signatureInputFromGraphQL(in.Signature),
 	}, nil
 }
 
@@ -160,6 +162,11 @@ func (c *converter) ToEntity(in *model.Webhook) (*Entity, error) {
 		return nil, err
 	}
 
+	optionalSignature, err := c.toSignatureEntity(*in)
+	if err != nil {
+		return nil, err
+	}
+
 	var webhookMode sql.NullString
 	if in.Mode != nil {
 		webhookMode.String = string(*in.Mode)
@@ -199,6 +206,7 @@ func (c *converter) ToEntity(in *model.Webhook) (*Entity, error) {
 		HeaderTemplate:        repo.NewNullableString(in.HeaderTemplate),
 		OutputTemplate:        repo.NewNullableString(in.OutputTemplate),
 		StatusTemplate:        repo.NewNullableString(in.StatusTemplate),
+		Signature:             optionalSignature,
 	}, nil
 }
 
@@ -219,6 +227,23 @@ func (c *converter) toAuthEntity(in model.Webhook) (sql.NullString, error) {
 	return optionalAuth, nil
 }
 
+func (c *converter) toSignatureEntity(in model.Webhook) (sql.NullString, error) {
+	var optionalSignature sql.NullString
+	if in.Signature == nil {
+		return optionalSignature, nil
+	}
+
+	b, err := json.Marshal(in.Signature)
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling Signature")
+	}
+
+	if err := optionalSignature.Scan(b); err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while scanning optional Signature")
+	}
+	return optionalSignature, nil
+}
+
 // FromEntity missing godoc
 func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 	auth, err := c.fromEntityAuth(*in)
@@ -226,6 +251,11 @@ func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 		return nil, err
 	}
 
+	signature, err := c.fromEntitySignature(*in)
+	if err != nil {
+		return nil, err
+	}
+
 	var webhookMode *model.WebhookMode
 	if in.Mode.Valid {
 		webhookModeStr := model.WebhookMode(in.Mode.String)
@@ -253,6 +283,7 @@ func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 		HeaderTemplate:   repo.StringPtrFromNullableString(in.HeaderTemplate),
 		OutputTemplate:   repo.StringPtrFromNullableString(in.OutputTemplate),
 		StatusTemplate:   repo.StringPtrFromNullableString(in.StatusTemplate),
+		Signature:        signature,
 	}, nil
 }
 
@@ -278,6 +309,19 @@ func (c *converter) fromEntityAuth(in Entity) (*model.Auth, error) {
 	return auth, nil
 }
 
+func (c *converter) fromEntitySignature(in Entity) (*model.WebhookSignature, error) {
+	if !in.Signature.Valid {
+		return nil, nil
+	}
+
+	signature := &model.WebhookSignature{}
+	if err := json.Unmarshal([]byte(in.Signature.String), signature); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling Signature")
+	}
+
+	return signature, nil
+}
+
 func (c *converter) objectReferenceFromEntity(in Entity) (string, model.WebhookReferenceObjectType, error) {
 	if in.ApplicationID.Valid {
 		return in.ApplicationID.String, model.ApplicationWebhookReference, nil
@@ -297,3 +341,29 @@ func (c *converter) objectReferenceFromEntity(in Entity) (string, model.WebhookR
 
 	return "", "", fmt.Errorf("incorrect Object Reference ID and its type for Entity with ID '%s'", in.ID)
 }
+
+func signatureToGraphQL(in *model.WebhookSignature) *graphql.WebhookSignature {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.WebhookSignature{
+		Secret:          in.Secret,
+		SignatureHeader: in.SignatureHeader,
+		TimestampHeader: in.TimestampHeader,
+		NonceHeader:     in.NonceHeader,
+	}
+}
+
+func signatureInputFromGraphQL(in *graphql.WebhookSignatureInput) *model.WebhookSignatureInput {
+	if in == nil {
+		return nil
+	}
+
+	return &model.WebhookSignatureInput{
+		Secret:          in.Secret,
+		SignatureHeader: in.SignatureHeader,
+		TimestampHeader: in.TimestampHeader,
+		NonceHeader:     in.NonceHeader,
+	}
+}
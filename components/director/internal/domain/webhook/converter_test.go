@@ -213,6 +213,14 @@ func TestConverter_ToEntity(t *testing.T) {
 				Auth: sql.NullString{Valid: true, String: expectedBasicAuthAsString},
 			},
 		},
+		"success when Signature provided": {
+			in: &model.Webhook{
+				Signature: fixModelWebhookSignature(),
+			},
+			expected: &webhook.Entity{
+				Signature: sql.NullString{Valid: true, String: fixSignatureAsAString(t)},
+			},
+		},
 	}
 
 	for tn, tc := range testCases {
@@ -279,6 +287,22 @@ func TestConverter_FromEntity(t *testing.T) {
 				Auth:       fixBasicAuth(),
 			},
 		},
+		"success when Signature provided": {
+			inEntity: &webhook.Entity{
+				ID:            "givenID",
+				ApplicationID: repo.NewValidNullableString("appID"),
+				Signature: sql.NullString{
+					Valid:  true,
+					String: fixSignatureAsAString(t),
+				},
+			},
+			expectedModel: &model.Webhook{
+				ID:         "givenID",
+				ObjectID:   "appID",
+				ObjectType: model.ApplicationWebhookReference,
+				Signature:  fixModelWebhookSignature(),
+			},
+		},
 		"got error on unmarshaling JSON": {
 			inEntity: &webhook.Entity{
 				Auth: sql.NullString{
@@ -288,6 +312,15 @@ func TestConverter_FromEntity(t *testing.T) {
 			},
 			expectedErr: errors.New("while unmarshaling Auth: invalid character 'i' looking for beginning of value"),
 		},
+		"got error on unmarshaling Signature JSON": {
+			inEntity: &webhook.Entity{
+				Signature: sql.NullString{
+					Valid:  true,
+					String: "it is not even a proper JSON!",
+				},
+			},
+			expectedErr: errors.New("while unmarshaling Signature: invalid character 'i' looking for beginning of value"),
+		},
 	}
 
 	for tn, tc := range testCases {
@@ -25,6 +25,7 @@ type Entity struct {
 	HeaderTemplate        sql.NullString `db:"header_template"`
 	OutputTemplate        sql.NullString `db:"output_template"`
 	StatusTemplate        sql.NullString `db:"status_template"`
+	Signature             sql.NullString `db:"signature"`
 }
 
 // GetID returns the ID of the entity.
@@ -14,9 +14,13 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
-var fixColumns = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
+var fixColumns = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "signature"}
 
-var emptyTemplate = `{}`
+var (
+	emptyTemplate   = `{}`
+	signatureSecret = "a3f2b8c9d4e5f6a7b8c9d0e1f2a3b4c5"
+	signatureHeader = "X-Signature"
+)
 
 func stringPtr(s string) *string {
 	return &s
@@ -35,6 +39,7 @@ func fixApplicationModelWebhook(id, appID, tenant, url string) *model.Webhook {
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
 		OutputTemplate: &emptyTemplate,
+		Signature:      fixModelWebhookSignature(),
 	}
 }
 
@@ -82,6 +87,10 @@ func fixGQLWebhook(id, appID, url string) *graphql.Webhook {
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
 		OutputTemplate: &emptyTemplate,
+		Signature: &graphql.WebhookSignature{
+			Secret:          signatureSecret,
+			SignatureHeader: &signatureHeader,
+		},
 	}
 }
 
@@ -95,6 +104,10 @@ func fixModelWebhookInput(url string) *model.WebhookInput {
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
 		OutputTemplate: &emptyTemplate,
+		Signature: &model.WebhookSignatureInput{
+			Secret:          signatureSecret,
+			SignatureHeader: &signatureHeader,
+		},
 	}
 }
 
@@ -108,6 +121,10 @@ func fixGQLWebhookInput(url string) *graphql.WebhookInput {
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
 		OutputTemplate: &emptyTemplate,
+		Signature: &graphql.WebhookSignatureInput{
+			Secret:          signatureSecret,
+			SignatureHeader: &signatureHeader,
+		},
 	}
 }
 
@@ -134,6 +151,19 @@ func fixBasicAuth() *model.Auth {
 	}
 }
 
+func fixModelWebhookSignature() *model.WebhookSignature {
+	return &model.WebhookSignature{
+		Secret:          signatureSecret,
+		SignatureHeader: &signatureHeader,
+	}
+}
+
+func fixSignatureAsAString(t *testing.T) string {
+	b, err := json.Marshal(fixModelWebhookSignature())
+	require.NoError(t, err)
+	return string(b)
+}
+
 func fixAuthAsAString(t *testing.T) string {
 	b, err := json.Marshal(fixBasicAuth())
 	require.NoError(t, err)
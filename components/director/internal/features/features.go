@@ -10,4 +10,5 @@ type Config struct {
 	TokenPrefix                  string `envconfig:"APP_TOKEN_PREFIX,default=sb-"`
 	RuntimeTypeLabelKey          string `envconfig:"APP_RUNTIME_TYPE_LABEL_KEY,default=runtimeType"`
 	KymaRuntimeTypeLabelValue    string `envconfig:"APP_KYMA_RUNTIME_TYPE_LABEL_VALUE,default=kyma"`
+	SpecNormalizationEnabled     bool   `envconfig:"default=false,APP_SPEC_NORMALIZATION_ENABLED"`
 }
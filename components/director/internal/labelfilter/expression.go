@@ -0,0 +1,489 @@
+package labelfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+const (
+	// MaxExpressionLength is the maximum length of a label filter expression
+	MaxExpressionLength = 4096
+	// MaxExpressionDepth is the maximum nesting depth of a label filter expression
+	MaxExpressionDepth = 16
+	// MaxExpressionPredicates is the maximum number of predicates in a label filter expression
+	MaxExpressionPredicates = 32
+)
+
+// Operator is an operator of a label filter expression
+type Operator string
+
+const (
+	// OperatorAnd matches objects matching both operands
+	OperatorAnd Operator = "AND"
+	// OperatorOr matches objects matching any of the operands
+	OperatorOr Operator = "OR"
+	// OperatorNot matches objects which do not match the operand
+	OperatorNot Operator = "NOT"
+	// OperatorExists matches objects which have a label with the given key
+	OperatorExists Operator = "EXISTS"
+	// OperatorEqual matches objects whose label value is, or contains, the given value
+	OperatorEqual Operator = "="
+	// OperatorNotEqual matches objects which have the label, but its value is not, and does not contain, the given value
+	OperatorNotEqual Operator = "!="
+	// OperatorIn matches objects whose label value is, or contains, any of the given values
+	OperatorIn Operator = "IN"
+	// OperatorLessThan matches objects whose label value is less than the given value
+	OperatorLessThan Operator = "<"
+	// OperatorLessThanOrEqual matches objects whose label value is less than or equal to the given value
+	OperatorLessThanOrEqual Operator = "<="
+	// OperatorGreaterThan matches objects whose label value is greater than the given value
+	OperatorGreaterThan Operator = ">"
+	// OperatorGreaterThanOrEqual matches objects whose label value is greater than or equal to the given value
+	OperatorGreaterThanOrEqual Operator = ">="
+	// OperatorMatches matches objects whose string label value matches the given pattern. Patterns are a restricted subset of regular expressions, see validatePattern.
+	OperatorMatches Operator = "=~"
+)
+
+// Expression is a parsed label filter expression.
+//
+// Logical expressions (AND, OR, NOT) have Operands. Predicates have a label Key and, depending on the operator, Values.
+// Each value is either a string, a json.Number or a bool.
+type Expression struct {
+	Operator Operator
+	Operands []*Expression
+	Key      string
+	Values   []interface{}
+}
+
+// String returns the canonical representation of the expression
+func (e *Expression) String() string {
+	switch e.Operator {
+	case OperatorAnd, OperatorOr:
+		operands := make([]string, 0, len(e.Operands))
+		for _, o := range e.Operands {
+			operands = append(operands, o.String())
+		}
+		return "(" + strings.Join(operands, fmt.Sprintf(" %s ", e.Operator)) + ")"
+	case OperatorNot:
+		return fmt.Sprintf("NOT %s", e.Operands[0])
+	case OperatorExists:
+		return fmt.Sprintf("EXISTS(%s)", quoteKey(e.Key))
+	case OperatorIn:
+		values := make([]string, 0, len(e.Values))
+		for _, v := range e.Values {
+			values = append(values, formatValue(v))
+		}
+		return fmt.Sprintf("%s IN (%s)", quoteKey(e.Key), strings.Join(values, ", "))
+	default:
+		return fmt.Sprintf("%s %s %s", quoteKey(e.Key), e.Operator, formatValue(e.Values[0]))
+	}
+}
+
+// ParseExpression parses a label filter expression.
+//
+// The grammar supports the logical operators AND, OR and NOT, parentheses, and the following predicates:
+//   - EXISTS(key)
+//   - key = value, key != value
+//   - key IN (value, ...)
+//   - key < value, key <= value, key > value, key >= value, where value is a number or a string
+//   - key =~ "pattern", where pattern is a restricted regular expression
+//
+// Keywords are case-insensitive. Keys are either identifiers or backtick-quoted strings. Values are double-quoted strings,
+// numbers, true or false.
+func ParseExpression(in string) (*Expression, error) {
+	if len(in) > MaxExpressionLength {
+		return nil, apperrors.NewInvalidDataError("label filter expression must not be longer than %d characters", MaxExpressionLength)
+	}
+
+	tokens, err := tokenize(in)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.atEnd() {
+		return nil, p.errorf("unexpected %s", p.peek())
+	}
+
+	return expr, nil
+}
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenKey
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	typ   tokenType
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.typ == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q at position %d", t.value, t.pos)
+}
+
+var numberRegex = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?`)
+
+func tokenize(in string) ([]token, error) {
+	var tokens []token
+	for pos := 0; pos < len(in); {
+		c := rune(in[pos])
+		switch {
+		case unicode.IsSpace(c):
+			pos++
+		case c == '(':
+			tokens = append(tokens, token{typ: tokenLeftParen, value: "(", pos: pos})
+			pos++
+		case c == ')':
+			tokens = append(tokens, token{typ: tokenRightParen, value: ")", pos: pos})
+			pos++
+		case c == ',':
+			tokens = append(tokens, token{typ: tokenComma, value: ",", pos: pos})
+			pos++
+		case c == '"':
+			end := pos + 1
+			for ; end < len(in) && in[end] != '"'; end++ {
+				if in[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(in) {
+				return nil, apperrors.NewInvalidDataError("label filter expression contains unterminated string at position %d", pos)
+			}
+			value, err := strconv.Unquote(in[pos : end+1])
+			if err != nil {
+				return nil, apperrors.NewInvalidDataError("label filter expression contains invalid string at position %d", pos)
+			}
+			tokens = append(tokens, token{typ: tokenString, value: value, pos: pos})
+			pos = end + 1
+		case c == '`':
+			end := strings.IndexByte(in[pos+1:], '`')
+			if end < 0 {
+				return nil, apperrors.NewInvalidDataError("label filter expression contains unterminated key at position %d", pos)
+			}
+			tokens = append(tokens, token{typ: tokenKey, value: in[pos+1 : pos+1+end], pos: pos})
+			pos += end + 2
+		case strings.ContainsRune("=!<>", c):
+			op := matchOperator(in[pos:])
+			if op == "" {
+				return nil, apperrors.NewInvalidDataError("label filter expression contains unexpected character %q at position %d", c, pos)
+			}
+			tokens = append(tokens, token{typ: tokenOperator, value: op, pos: pos})
+			pos += len(op)
+		case c == '-' || unicode.IsDigit(c):
+			number := numberRegex.FindString(in[pos:])
+			if number == "" {
+				return nil, apperrors.NewInvalidDataError("label filter expression contains invalid number at position %d", pos)
+			}
+			tokens = append(tokens, token{typ: tokenNumber, value: number, pos: pos})
+			pos += len(number)
+		case isIdentifierStart(c):
+			end := pos + 1
+			for ; end < len(in) && isIdentifierPart(rune(in[end])); end++ {
+			}
+			tokens = append(tokens, token{typ: tokenIdentifier, value: in[pos:end], pos: pos})
+			pos = end
+		default:
+			return nil, apperrors.NewInvalidDataError("label filter expression contains unexpected character %q at position %d", c, pos)
+		}
+	}
+
+	return append(tokens, token{typ: tokenEOF, pos: len(in)}), nil
+}
+
+func matchOperator(in string) string {
+	for _, op := range []Operator{OperatorNotEqual, OperatorLessThanOrEqual, OperatorGreaterThanOrEqual, OperatorMatches, OperatorEqual, OperatorLessThan, OperatorGreaterThan} {
+		if strings.HasPrefix(in, string(op)) {
+			return string(op)
+		}
+	}
+	return ""
+}
+
+func isIdentifierStart(c rune) bool {
+	return c == '_' || (c < unicode.MaxASCII && unicode.IsLetter(c))
+}
+
+func isIdentifierPart(c rune) bool {
+	return isIdentifierStart(c) || unicode.IsDigit(c) || c == '-' || c == '.' || c == '/'
+}
+
+type parser struct {
+	tokens     []token
+	pos        int
+	predicates int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) atEnd() bool {
+	return p.peek().typ == tokenEOF
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.typ == tokenIdentifier && strings.EqualFold(t.value, keyword)
+}
+
+func (p *parser) expect(typ tokenType, what string) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, p.errorf("expected %s, but got %s", what, t)
+	}
+	return t, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return apperrors.NewInvalidDataError("invalid label filter expression: %s", fmt.Sprintf(format, args...))
+}
+
+func (p *parser) checkDepth(depth int) error {
+	if depth > MaxExpressionDepth {
+		return p.errorf("nesting depth must not exceed %d", MaxExpressionDepth)
+	}
+	return nil
+}
+
+func (p *parser) parseOr(depth int) (*Expression, error) {
+	return p.parseBinary(depth, string(OperatorOr), OperatorOr, p.parseAnd)
+}
+
+func (p *parser) parseAnd(depth int) (*Expression, error) {
+	return p.parseBinary(depth, string(OperatorAnd), OperatorAnd, p.parseNot)
+}
+
+func (p *parser) parseBinary(depth int, keyword string, op Operator, parseOperand func(int) (*Expression, error)) (*Expression, error) {
+	if err := p.checkDepth(depth); err != nil {
+		return nil, err
+	}
+
+	first, err := parseOperand(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	operands := []*Expression{first}
+	for p.isKeyword(keyword) {
+		p.next()
+		operand, err := parseOperand(depth)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Expression{Operator: op, Operands: operands}, nil
+}
+
+func (p *parser) parseNot(depth int) (*Expression, error) {
+	if !p.isKeyword(string(OperatorNot)) {
+		return p.parsePrimary(depth)
+	}
+
+	p.next()
+	if err := p.checkDepth(depth + 1); err != nil {
+		return nil, err
+	}
+	operand, err := p.parseNot(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Expression{Operator: OperatorNot, Operands: []*Expression{operand}}, nil
+}
+
+func (p *parser) parsePrimary(depth int) (*Expression, error) {
+	if p.peek().typ == tokenLeftParen {
+		p.next()
+		expr, err := p.parseOr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, `")"`); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	p.predicates++
+	if p.predicates > MaxExpressionPredicates {
+		return nil, p.errorf("number of predicates must not exceed %d", MaxExpressionPredicates)
+	}
+
+	if p.isKeyword(string(OperatorExists)) {
+		p.next()
+		if _, err := p.expect(tokenLeftParen, `"("`); err != nil {
+			return nil, err
+		}
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen, `")"`); err != nil {
+			return nil, err
+		}
+		return &Expression{Operator: OperatorExists, Key: key}, nil
+	}
+
+	key, err := p.parseKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isKeyword(string(OperatorIn)) {
+		p.next()
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		return &Expression{Operator: OperatorIn, Key: key, Values: values}, nil
+	}
+
+	opToken, err := p.expect(tokenOperator, "operator")
+	if err != nil {
+		return nil, err
+	}
+	op := Operator(opToken.value)
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case OperatorLessThan, OperatorLessThanOrEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual:
+		if _, ok := value.(bool); ok {
+			return nil, p.errorf("operator %s cannot be used with boolean values", op)
+		}
+	case OperatorMatches:
+		pattern, ok := value.(string)
+		if !ok {
+			return nil, p.errorf("operator %s requires a string value", op)
+		}
+		if err := validatePattern(pattern); err != nil {
+			return nil, p.errorf("invalid pattern %q: %s", pattern, err)
+		}
+	}
+
+	return &Expression{Operator: op, Key: key, Values: []interface{}{value}}, nil
+}
+
+func (p *parser) parseKey() (string, error) {
+	t := p.next()
+	switch {
+	case t.typ == tokenKey && t.value != "":
+		return t.value, nil
+	case t.typ == tokenIdentifier && !isKeyword(t.value):
+		return t.value, nil
+	default:
+		return "", p.errorf("expected label key, but got %s", t)
+	}
+}
+
+func (p *parser) parseValueList() ([]interface{}, error) {
+	if _, err := p.expect(tokenLeftParen, `"("`); err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		if p.peek().typ != tokenComma {
+			break
+		}
+		p.next()
+	}
+
+	if _, err := p.expect(tokenRightParen, `")"`); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	t := p.next()
+	switch {
+	case t.typ == tokenString:
+		return t.value, nil
+	case t.typ == tokenNumber:
+		return json.Number(t.value), nil
+	case t.typ == tokenIdentifier && strings.EqualFold(t.value, "true"):
+		return true, nil
+	case t.typ == tokenIdentifier && strings.EqualFold(t.value, "false"):
+		return false, nil
+	default:
+		return nil, p.errorf("expected value, but got %s", t)
+	}
+}
+
+func isKeyword(s string) bool {
+	for _, keyword := range []Operator{OperatorAnd, OperatorOr, OperatorNot, OperatorIn, OperatorExists} {
+		if strings.EqualFold(s, string(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteKey(key string) string {
+	for i, c := range key {
+		if (i == 0 && !isIdentifierStart(c)) || !isIdentifierPart(c) {
+			return "`" + key + "`"
+		}
+	}
+	if isKeyword(key) {
+		return "`" + key + "`"
+	}
+	return key
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
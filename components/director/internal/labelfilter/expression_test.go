@@ -0,0 +1,254 @@
+package labelfilter_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         string
+		Expected      *labelfilter.Expression
+		ExpectedError string
+	}{
+		{
+			Name:  "Exists",
+			Input: `exists(region)`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorExists,
+				Key:      "region",
+			},
+		},
+		{
+			Name:  "Equal string",
+			Input: `region = "eu10"`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorEqual,
+				Key:      "region",
+				Values:   []interface{}{"eu10"},
+			},
+		},
+		{
+			Name:  "Quoted key with escaped string",
+			Input: "`in` != \"a \\\"quoted\\\" value\"",
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorNotEqual,
+				Key:      "in",
+				Values:   []interface{}{`a "quoted" value`},
+			},
+		},
+		{
+			Name:  "Number and boolean values",
+			Input: `count >= -1.5 AND isNormalized = true`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorAnd,
+				Operands: []*labelfilter.Expression{
+					{Operator: labelfilter.OperatorGreaterThanOrEqual, Key: "count", Values: []interface{}{json.Number("-1.5")}},
+					{Operator: labelfilter.OperatorEqual, Key: "isNormalized", Values: []interface{}{true}},
+				},
+			},
+		},
+		{
+			Name:  "In",
+			Input: `runtimeType in ("kyma", "cf")`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorIn,
+				Key:      "runtimeType",
+				Values:   []interface{}{"kyma", "cf"},
+			},
+		},
+		{
+			Name:  "Matches",
+			Input: `name =~ "^test-.*$"`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorMatches,
+				Key:      "name",
+				Values:   []interface{}{"^test-.*$"},
+			},
+		},
+		{
+			Name:  "AND binds tighter than OR",
+			Input: `EXISTS(a) OR EXISTS(b) AND NOT EXISTS(c)`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorOr,
+				Operands: []*labelfilter.Expression{
+					{Operator: labelfilter.OperatorExists, Key: "a"},
+					{
+						Operator: labelfilter.OperatorAnd,
+						Operands: []*labelfilter.Expression{
+							{Operator: labelfilter.OperatorExists, Key: "b"},
+							{
+								Operator: labelfilter.OperatorNot,
+								Operands: []*labelfilter.Expression{{Operator: labelfilter.OperatorExists, Key: "c"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "Parentheses",
+			Input: `(EXISTS(a) OR EXISTS(b)) AND EXISTS(c)`,
+			Expected: &labelfilter.Expression{
+				Operator: labelfilter.OperatorAnd,
+				Operands: []*labelfilter.Expression{
+					{
+						Operator: labelfilter.OperatorOr,
+						Operands: []*labelfilter.Expression{
+							{Operator: labelfilter.OperatorExists, Key: "a"},
+							{Operator: labelfilter.OperatorExists, Key: "b"},
+						},
+					},
+					{Operator: labelfilter.OperatorExists, Key: "c"},
+				},
+			},
+		},
+		{
+			Name:          "Error when expression is empty",
+			Input:         ``,
+			ExpectedError: "expected label key, but got end of expression",
+		},
+		{
+			Name:          "Error when value is missing",
+			Input:         `region =`,
+			ExpectedError: "expected value, but got end of expression",
+		},
+		{
+			Name:          "Error when keyword is used as key",
+			Input:         `and = "foo"`,
+			ExpectedError: "expected label key",
+		},
+		{
+			Name:          "Error when parenthesis is not closed",
+			Input:         `(EXISTS(a) OR EXISTS(b)`,
+			ExpectedError: `expected ")"`,
+		},
+		{
+			Name:          "Error when there are trailing tokens",
+			Input:         `EXISTS(a) EXISTS(b)`,
+			ExpectedError: "unexpected",
+		},
+		{
+			Name:          "Error when string is not terminated",
+			Input:         `region = "eu10`,
+			ExpectedError: "unterminated string",
+		},
+		{
+			Name:          "Error when character is unexpected",
+			Input:         `region ! "eu10"`,
+			ExpectedError: "unexpected character",
+		},
+		{
+			Name:          "Error when comparing booleans",
+			Input:         `isNormalized > false`,
+			ExpectedError: "cannot be used with boolean values",
+		},
+		{
+			Name:          "Error when pattern is not a string",
+			Input:         `name =~ 1`,
+			ExpectedError: "requires a string value",
+		},
+		{
+			Name:          "Error when pattern is invalid",
+			Input:         `name =~ "("`,
+			ExpectedError: "invalid pattern",
+		},
+		{
+			Name:          "Error when expression is too long",
+			Input:         strings.Repeat(" ", labelfilter.MaxExpressionLength+1),
+			ExpectedError: "must not be longer than",
+		},
+		{
+			Name:          "Error when expression is nested too deep",
+			Input:         strings.Repeat("NOT ", labelfilter.MaxExpressionDepth+1) + "EXISTS(a)",
+			ExpectedError: "nesting depth must not exceed",
+		},
+		{
+			Name:          "Error when expression has too many predicates",
+			Input:         strings.TrimSuffix(strings.Repeat("EXISTS(a) OR ", labelfilter.MaxExpressionPredicates+1), " OR "),
+			ExpectedError: "number of predicates must not exceed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			expr, err := labelfilter.ParseExpression(testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, expr)
+		})
+	}
+}
+
+func TestParseExpression_Pattern(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Pattern       string
+		ExpectedError string
+	}{
+		{Name: "Literal", Pattern: `abc`},
+		{Name: "Anchors and wildcard", Pattern: `^test-.*$`},
+		{Name: "Escaped metacharacters", Pattern: `^a\.b\(c\)$`},
+		{Name: "Character classes", Pattern: `[a-zA-Z0-9_-]+[^-x]?`},
+		{Name: "Error when pattern is too long", Pattern: strings.Repeat("a", labelfilter.MaxPatternLength+1), ExpectedError: "must not be longer than"},
+		{Name: "Error on group", Pattern: `(ab)+`, ExpectedError: `'(' is not supported`},
+		{Name: "Error on alternation", Pattern: `a|b`, ExpectedError: `'|' is not supported`},
+		{Name: "Error on bounded repetition", Pattern: `a{1,1000}`, ExpectedError: `'{' is not supported`},
+		{Name: "Error on escape sequence", Pattern: `\d+`, ExpectedError: "only metacharacters can be escaped"},
+		{Name: "Error on back reference", Pattern: `(a)\1`, ExpectedError: `'(' is not supported`},
+		{Name: "Error on nested quantifiers", Pattern: `a*+`, ExpectedError: "must follow a character or a character class"},
+		{Name: "Error on leading quantifier", Pattern: `*a`, ExpectedError: "must follow a character or a character class"},
+		{Name: "Error on anchor in the middle", Pattern: `a^b`, ExpectedError: "only at the start"},
+		{Name: "Error on nested character class", Pattern: `[[:alpha:]]`, ExpectedError: "not supported in character class"},
+		{Name: "Error on invalid range", Pattern: `[z-a]`, ExpectedError: "invalid range"},
+		{Name: "Error on unterminated character class", Pattern: `[abc`, ExpectedError: "unterminated character class"},
+		{Name: "Error on empty character class", Pattern: `[]`, ExpectedError: "empty character class"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			expr, err := labelfilter.ParseExpression(`name =~ ` + strconv.Quote(testCase.Pattern))
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []interface{}{testCase.Pattern}, expr.Values)
+		})
+	}
+}
+
+func TestExpression_String(t *testing.T) {
+	// GIVEN
+	input := "runtimeType in (\"kyma\", \"cf\") and not (region = \"eu10\" or `my key` < 10) and exists(`and`) and name =~ \"^a\""
+	expected := "(runtimeType IN (\"kyma\", \"cf\") AND NOT (region = \"eu10\" OR `my key` < 10) AND EXISTS(`and`) AND name =~ \"^a\")"
+
+	// WHEN
+	expr, err := labelfilter.ParseExpression(input)
+	require.NoError(t, err)
+
+	// THEN
+	assert.Equal(t, expected, expr.String())
+
+	reparsed, err := labelfilter.ParseExpression(expr.String())
+	require.NoError(t, err)
+	assert.Equal(t, expr, reparsed)
+}
@@ -6,6 +6,8 @@ import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 type LabelFilter struct {
 	Key   string
 	Query *string
+	// Expression is a boolean expression over the labels of an object. If it is set, Key and Query are not used.
+	Expression *Expression
 }
 
 // FromGraphQL missing godoc
@@ -27,12 +29,33 @@ func MultipleFromGraphQL(in []*graphql.LabelFilter) []*LabelFilter {
 	return filters
 }
 
+// MultipleFromGraphQLWithExpression converts the graphql.LabelFilter list and the optional label expression to LabelFilter.
+// The expression, if provided, is parsed and appended as an additional filter.
+func MultipleFromGraphQLWithExpression(in []*graphql.LabelFilter, expression *string) ([]*LabelFilter, error) {
+	filters := MultipleFromGraphQL(in)
+	if expression == nil {
+		return filters, nil
+	}
+
+	parsed, err := ParseExpression(*expression)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(filters, NewForExpression(parsed)), nil
+}
+
 // NewForKey missing godoc
 func NewForKey(key string) *LabelFilter {
-	return &LabelFilter{key, nil}
+	return &LabelFilter{Key: key}
 }
 
 // NewForKeyWithQuery missing godoc
 func NewForKeyWithQuery(key, query string) *LabelFilter {
-	return &LabelFilter{key, &query}
+	return &LabelFilter{Key: key, Query: &query}
+}
+
+// NewForExpression creates a LabelFilter for the given expression
+func NewForExpression(expression *Expression) *LabelFilter {
+	return &LabelFilter{Expression: expression}
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFromGraphQL(t *testing.T) {
@@ -72,3 +74,51 @@ func TestMultipleFromGraphQL(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestMultipleFromGraphQLWithExpression(t *testing.T) {
+	query := "foo"
+	in := []*graphql.LabelFilter{
+		{
+			Key:   "label",
+			Query: &query,
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expected := []*labelfilter.LabelFilter{
+			{
+				Key:   "label",
+				Query: &query,
+			},
+			{
+				Expression: &labelfilter.Expression{Operator: labelfilter.OperatorExists, Key: "label2"},
+			},
+		}
+
+		result, err := labelfilter.MultipleFromGraphQLWithExpression(in, str.Ptr(`EXISTS(label2)`))
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success without expression", func(t *testing.T) {
+		expected := []*labelfilter.LabelFilter{
+			{
+				Key:   "label",
+				Query: &query,
+			},
+		}
+
+		result, err := labelfilter.MultipleFromGraphQLWithExpression(in, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Error when expression is invalid", func(t *testing.T) {
+		_, err := labelfilter.MultipleFromGraphQLWithExpression(in, str.Ptr(`label =`))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid label filter expression")
+	})
+}
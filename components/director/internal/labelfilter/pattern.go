@@ -0,0 +1,107 @@
+package labelfilter
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MaxPatternLength is the maximum length of a pattern used with the =~ operator
+const MaxPatternLength = 256
+
+// patternMetacharacters are the characters which have a special meaning in a pattern and must be escaped to be matched literally
+const patternMetacharacters = `\.^$*+?()[]{}|-/`
+
+// validatePattern checks that the pattern of the =~ operator uses only the subset of the regular expression syntax
+// which has the same meaning in Go and PostgreSQL, and which cannot be evaluated with catastrophic backtracking:
+// literal characters, escaped metacharacters, ".", character classes with literal characters and ranges,
+// the quantifiers "*", "+" and "?" applied to a single character or character class, "^" at the start and "$" at the end.
+// Groups, alternations, bounded repetitions, back references and escape sequences such as \d are not supported.
+func validatePattern(pattern string) error {
+	if len(pattern) > MaxPatternLength {
+		return errors.Errorf("pattern must not be longer than %d characters", MaxPatternLength)
+	}
+
+	runes := []rune(pattern)
+	canQuantify := false
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case c == '\\':
+			if i+1 >= len(runes) || !strings.ContainsRune(patternMetacharacters, runes[i+1]) {
+				return errors.Errorf("only metacharacters can be escaped in pattern at position %d", i)
+			}
+			i++
+			canQuantify = true
+		case c == '^':
+			if i != 0 {
+				return errors.Errorf("%q is supported only at the start of pattern", c)
+			}
+			canQuantify = false
+		case c == '$':
+			if i != len(runes)-1 {
+				return errors.Errorf("%q is supported only at the end of pattern", c)
+			}
+			canQuantify = false
+		case c == '*' || c == '+' || c == '?':
+			if !canQuantify {
+				return errors.Errorf("quantifier %q at position %d must follow a character or a character class", c, i)
+			}
+			canQuantify = false
+		case c == '[':
+			end, err := validateCharacterClass(runes, i)
+			if err != nil {
+				return err
+			}
+			i = end
+			canQuantify = true
+		case strings.ContainsRune("(){}|]", c):
+			return errors.Errorf("%q is not supported in pattern", c)
+		default:
+			canQuantify = true
+		}
+	}
+
+	return nil
+}
+
+// validateCharacterClass validates the character class starting at the given position and returns the position of its closing bracket.
+// Ranges are supported only between ASCII letters or digits, and "-" is matched literally only at the start or at the end of the class.
+func validateCharacterClass(runes []rune, start int) (int, error) {
+	i := start + 1
+	if i < len(runes) && runes[i] == '^' {
+		i++
+	}
+
+	first := i
+	for i < len(runes) && runes[i] != ']' {
+		c := runes[i]
+		switch {
+		case c == '[' || c == '\\':
+			return 0, errors.Errorf("%q is not supported in character class at position %d", c, i)
+		case i+2 < len(runes) && runes[i+1] == '-' && runes[i+2] != ']':
+			lo, hi := c, runes[i+2]
+			if !isRangeBound(lo) || !isRangeBound(hi) || lo > hi {
+				return 0, errors.Errorf("invalid range in character class at position %d", i)
+			}
+			i += 3
+		case c == '-' && i != first && (i+1 >= len(runes) || runes[i+1] != ']'):
+			return 0, errors.Errorf("%q must be at the start or at the end of character class at position %d", c, i)
+		default:
+			i++
+		}
+	}
+
+	if i >= len(runes) {
+		return 0, errors.Errorf("unterminated character class at position %d", start)
+	}
+	if i == first {
+		return 0, errors.Errorf("empty character class at position %d", start)
+	}
+
+	return i, nil
+}
+
+func isRangeBound(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
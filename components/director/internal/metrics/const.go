@@ -9,6 +9,10 @@ const (
 	TenantFetcherSubsystem = "tenantfetcher"
 	// TenantFetcherJobName missing godoc
 	TenantFetcherJobName = TenantFetcherSubsystem
+	// ORDAggregatorSubsystem is the subsystem of the metrics of the ORD aggregator
+	ORDAggregatorSubsystem = "ord_aggregator"
+	// ORDAggregatorJobName is the Pushgateway job name of the ORD aggregator
+	ORDAggregatorJobName = ORDAggregatorSubsystem
 	// InstanceIDKeyName missing godoc
 	InstanceIDKeyName = "instance"
 )
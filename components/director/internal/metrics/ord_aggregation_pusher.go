@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+// ORDAggregationPusher records the metrics of an ORD aggregation run and pushes them to Pushgateway.
+// The outcome and duration of the aggregations are aggregated over all applications, so that the number of series does not grow
+// with the number of applications. Only the applications whose last aggregation failed are exposed individually.
+type ORDAggregationPusher struct {
+	aggregationsTotal   *prometheus.CounterVec
+	aggregationDuration *prometheus.HistogramVec
+	consecutiveFailures *prometheus.GaugeVec
+	pusher              *push.Pusher
+	instanceID          uuid.UUID
+}
+
+// NewORDAggregationPusher creates a new ORDAggregationPusher
+func NewORDAggregationPusher(endpoint string, timeout time.Duration) *ORDAggregationPusher {
+	aggregationsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: ORDAggregatorSubsystem,
+		Name:      "application_aggregations_total",
+		Help:      "Total ORD aggregations of applications per result",
+	}, []string{"result"})
+	aggregationDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: ORDAggregatorSubsystem,
+		Name:      "application_aggregation_duration_seconds",
+		Help:      "Duration of the ORD aggregations of applications per result",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"result"})
+	consecutiveFailures := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: ORDAggregatorSubsystem,
+		Name:      "application_consecutive_failures",
+		Help:      "Number of consecutive failed ORD aggregations of the applications whose last aggregation failed",
+	}, []string{"app_id"})
+
+	instanceID := uuid.New()
+	log.D().WithField(InstanceIDKeyName, instanceID).Infof("Initializing ORD Aggregation Metrics Pusher...")
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(aggregationsTotal, aggregationDuration, consecutiveFailures)
+	pusher := push.New(endpoint, ORDAggregatorJobName).Gatherer(registry).Client(&http.Client{
+		Timeout: timeout,
+	})
+
+	return &ORDAggregationPusher{
+		aggregationsTotal:   aggregationsTotal,
+		aggregationDuration: aggregationDuration,
+		consecutiveFailures: consecutiveFailures,
+		pusher:              pusher,
+		instanceID:          instanceID,
+	}
+}
+
+// RecordApplicationAggregation records the outcome of the aggregation of a single application.
+// The series of the application is removed as soon as its aggregation succeeds.
+func (p *ORDAggregationPusher) RecordApplicationAggregation(appID string, result ord.AggregationResult, duration time.Duration, consecutiveFailures int) {
+	p.aggregationsTotal.WithLabelValues(string(result)).Inc()
+	p.aggregationDuration.WithLabelValues(string(result)).Observe(duration.Seconds())
+
+	if consecutiveFailures > 0 {
+		p.consecutiveFailures.WithLabelValues(appID).Set(float64(consecutiveFailures))
+	} else {
+		p.consecutiveFailures.DeleteLabelValues(appID)
+	}
+}
+
+// Push pushes the recorded metrics to Pushgateway. The metrics of the previous run are replaced entirely,
+// so that the series of the applications which no longer fail, or no longer exist, are not kept in Pushgateway.
+func (p *ORDAggregationPusher) Push() {
+	log.D().WithField(InstanceIDKeyName, p.instanceID).Info("Pushing ORD aggregation metrics...")
+	if err := p.pusher.Push(); err != nil {
+		wrappedErr := errors.Wrap(err, "while pushing ORD aggregation metrics to Pushgateway")
+		log.D().WithField(InstanceIDKeyName, p.instanceID).Error(wrappedErr)
+	}
+}
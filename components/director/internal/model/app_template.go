@@ -1,9 +1,12 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
@@ -67,10 +70,120 @@ func (in ApplicationFromTemplateInputValues) FindPlaceholderValue(name string) (
 	return "", fmt.Errorf("value for placeholder name '%s' not found", name)
 }
 
+// PlaceholderType is the type of the value of an Application Template placeholder
+type PlaceholderType string
+
+const (
+	// PlaceholderTypeString represents a placeholder whose value is rendered as a JSON string
+	PlaceholderTypeString PlaceholderType = "STRING"
+	// PlaceholderTypeNumber represents a placeholder whose value is rendered as a JSON number
+	PlaceholderTypeNumber PlaceholderType = "NUMBER"
+	// PlaceholderTypeBoolean represents a placeholder whose value is rendered as a JSON boolean
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	// PlaceholderTypeJSON represents a placeholder whose value is an arbitrary JSON value
+	PlaceholderTypeJSON PlaceholderType = "JSON"
+)
+
+var jsonNumberRegex = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
 // ApplicationTemplatePlaceholder missing godoc
 type ApplicationTemplatePlaceholder struct {
 	Name        string
 	Description *string
+	// Type is the type of the value. Placeholders without type are strings.
+	Type PlaceholderType `json:",omitempty"`
+	// DefaultValue is used when no value is provided for the placeholder
+	DefaultValue *string `json:",omitempty"`
+	// Optional placeholders without a value and a default value are rendered as an empty string, or as null if they are not strings
+	Optional bool `json:",omitempty"`
+	// Pattern is a regular expression which the whole value must match
+	Pattern *string `json:",omitempty"`
+	// AllowedValues restricts the value to one of the listed ones
+	AllowedValues []string `json:",omitempty"`
+}
+
+// ValueType returns the type of the placeholder value, defaulting to string
+func (p ApplicationTemplatePlaceholder) ValueType() PlaceholderType {
+	if p.Type == "" {
+		return PlaceholderTypeString
+	}
+	return p.Type
+}
+
+// Validate checks that the type and the pattern of the placeholder are valid and that its default and allowed values satisfy them
+func (p ApplicationTemplatePlaceholder) Validate() error {
+	switch p.ValueType() {
+	case PlaceholderTypeString, PlaceholderTypeNumber, PlaceholderTypeBoolean, PlaceholderTypeJSON:
+	default:
+		return errors.Errorf("unknown type %q", p.Type)
+	}
+
+	if p.Pattern != nil {
+		if _, err := regexp.Compile(*p.Pattern); err != nil {
+			return errors.Wrap(err, "invalid pattern")
+		}
+	}
+
+	for _, value := range p.AllowedValues {
+		if err := p.validateType(value); err != nil {
+			return errors.Wrapf(err, "invalid allowed value %q", value)
+		}
+	}
+
+	if p.DefaultValue != nil {
+		if err := p.ValidateValue(*p.DefaultValue); err != nil {
+			return errors.Wrap(err, "invalid default value")
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks that the value is of the placeholder type and satisfies its pattern and allowed values
+func (p ApplicationTemplatePlaceholder) ValidateValue(value string) error {
+	if err := p.validateType(value); err != nil {
+		return err
+	}
+
+	if p.Pattern != nil {
+		pattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", *p.Pattern))
+		if err != nil {
+			return errors.Wrap(err, "invalid pattern")
+		}
+		if !pattern.MatchString(value) {
+			return errors.Errorf("value %q does not match pattern %q", value, *p.Pattern)
+		}
+	}
+
+	if len(p.AllowedValues) > 0 {
+		for _, allowed := range p.AllowedValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return errors.Errorf("value %q is not one of the allowed values %q", value, p.AllowedValues)
+	}
+
+	return nil
+}
+
+func (p ApplicationTemplatePlaceholder) validateType(value string) error {
+	switch p.ValueType() {
+	case PlaceholderTypeNumber:
+		if !jsonNumberRegex.MatchString(value) {
+			return errors.Errorf("value %q is not a number", value)
+		}
+	case PlaceholderTypeBoolean:
+		if value != "true" && value != "false" {
+			return errors.Errorf("value %q is not a boolean", value)
+		}
+	case PlaceholderTypeJSON:
+		if !json.Valid([]byte(value)) {
+			return errors.Errorf("value %q is not valid JSON", value)
+		}
+	}
+
+	return nil
 }
 
 // ApplicationTemplateValueInput missing godoc
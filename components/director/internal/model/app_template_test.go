@@ -135,3 +135,130 @@ func TestApplicationFromTemplateInputValues_FindPlaceholderValue(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationTemplatePlaceholder_Validate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Placeholder model.ApplicationTemplatePlaceholder
+		ExpectedErr string
+	}{
+		{
+			Name:        "Success for untyped placeholder",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a"},
+		},
+		{
+			Name: "Success for typed placeholder with constraints",
+			Placeholder: model.ApplicationTemplatePlaceholder{
+				Name:          "a",
+				Type:          model.PlaceholderTypeNumber,
+				DefaultValue:  str.Ptr("1"),
+				Pattern:       str.Ptr("[0-9]"),
+				AllowedValues: []string{"1", "2"},
+			},
+		},
+		{
+			Name:        "Error for unknown type",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: "DATE"},
+			ExpectedErr: `unknown type "DATE"`,
+		},
+		{
+			Name:        "Error for invalid pattern",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Pattern: str.Ptr("[a-")},
+			ExpectedErr: "invalid pattern",
+		},
+		{
+			Name:        "Error for allowed value of another type",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeBoolean, AllowedValues: []string{"yes"}},
+			ExpectedErr: `invalid allowed value "yes"`,
+		},
+		{
+			Name:        "Error for default value not matching the pattern",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", DefaultValue: str.Ptr("ABC"), Pattern: str.Ptr("[a-z]+")},
+			ExpectedErr: "invalid default value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Placeholder.Validate()
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplicationTemplatePlaceholder_ValidateValue(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Placeholder model.ApplicationTemplatePlaceholder
+		Value       string
+		ExpectedErr string
+	}{
+		{
+			Name:        "Success for string",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a"},
+			Value:       `"quoted" \ value`,
+		},
+		{
+			Name:        "Success for number",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeNumber},
+			Value:       "-1.5e3",
+		},
+		{
+			Name:        "Success for JSON",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeJSON},
+			Value:       `{"key": [1, 2]}`,
+		},
+		{
+			Name:        "Error for invalid number",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeNumber},
+			Value:       "0x10",
+			ExpectedErr: `value "0x10" is not a number`,
+		},
+		{
+			Name:        "Error for invalid boolean",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeBoolean},
+			Value:       "True",
+			ExpectedErr: `value "True" is not a boolean`,
+		},
+		{
+			Name:        "Error for invalid JSON",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Type: model.PlaceholderTypeJSON},
+			Value:       "{",
+			ExpectedErr: `value "{" is not valid JSON`,
+		},
+		{
+			Name:        "Error when only part of the value matches the pattern",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", Pattern: str.Ptr("[a-z]+")},
+			Value:       "abc1",
+			ExpectedErr: `value "abc1" does not match pattern "[a-z]+"`,
+		},
+		{
+			Name:        "Error for value which is not allowed",
+			Placeholder: model.ApplicationTemplatePlaceholder{Name: "a", AllowedValues: []string{"eu", "us"}},
+			Value:       "ap",
+			ExpectedErr: `value "ap" is not one of the allowed values ["eu" "us"]`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Placeholder.ValidateValue(testCase.Value)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				assert.EqualError(t, err, testCase.ExpectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package model
+
+// ApplicationMergeConflictPolicy defines how a label, which both merged applications have with different values, is resolved
+type ApplicationMergeConflictPolicy string
+
+const (
+	// ApplicationMergeConflictPolicyPreferSource takes the value of the source application
+	ApplicationMergeConflictPolicyPreferSource ApplicationMergeConflictPolicy = "PREFER_SOURCE"
+	// ApplicationMergeConflictPolicyPreferDestination keeps the value of the destination application
+	ApplicationMergeConflictPolicyPreferDestination ApplicationMergeConflictPolicy = "PREFER_DESTINATION"
+	// ApplicationMergeConflictPolicyFail rejects the merge
+	ApplicationMergeConflictPolicyFail ApplicationMergeConflictPolicy = "FAIL"
+)
+
+// ApplicationMergeLabelAction describes what happens with a label of the destination application during a merge
+type ApplicationMergeLabelAction string
+
+const (
+	// ApplicationMergeLabelActionAdded is used for labels which only the source application has
+	ApplicationMergeLabelActionAdded ApplicationMergeLabelAction = "ADDED"
+	// ApplicationMergeLabelActionKept is used for labels whose destination value is left as is
+	ApplicationMergeLabelActionKept ApplicationMergeLabelAction = "KEPT"
+	// ApplicationMergeLabelActionOverwritten is used for labels whose destination value is replaced by the source value
+	ApplicationMergeLabelActionOverwritten ApplicationMergeLabelAction = "OVERWRITTEN"
+	// ApplicationMergeLabelActionCombined is used for labels whose source and destination values are combined
+	ApplicationMergeLabelActionCombined ApplicationMergeLabelAction = "COMBINED"
+)
+
+// ApplicationMergeLabel describes how a single label is merged
+type ApplicationMergeLabel struct {
+	Key              string
+	Action           ApplicationMergeLabelAction
+	Conflict         bool
+	SourceValue      interface{}
+	DestinationValue interface{}
+	Value            interface{}
+}
+
+// ApplicationMergePlan describes the changes which merging a source application into a destination application makes
+type ApplicationMergePlan struct {
+	DestinationID  string
+	SourceID       string
+	ConflictPolicy ApplicationMergeConflictPolicy
+	Labels         []*ApplicationMergeLabel
+	AddedScenarios []string
+	RemovedBundles []*Bundle
+}
+
+// Conflicts returns the keys of the labels which have different values in the source and destination applications
+func (p *ApplicationMergePlan) Conflicts() []string {
+	conflicts := make([]string, 0)
+	for _, l := range p.Labels {
+		if l.Conflict {
+			conflicts = append(conflicts, l.Key)
+		}
+	}
+	return conflicts
+}
+
+// LabelValues returns the labels of the destination application after the merge
+func (p *ApplicationMergePlan) LabelValues() map[string]interface{} {
+	values := make(map[string]interface{}, len(p.Labels))
+	for _, l := range p.Labels {
+		values[l.Key] = l.Value
+	}
+	return values
+}
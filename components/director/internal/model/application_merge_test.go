@@ -0,0 +1,28 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+func TestApplicationMergePlan(t *testing.T) {
+	plan := &model.ApplicationMergePlan{
+		Labels: []*model.ApplicationMergeLabel{
+			{Key: "foo", Action: model.ApplicationMergeLabelActionKept, Conflict: true, SourceValue: "src", DestinationValue: "dest", Value: "dest"},
+			{Key: "bar", Action: model.ApplicationMergeLabelActionAdded, SourceValue: "src", Value: "src"},
+			{Key: "baz", Action: model.ApplicationMergeLabelActionOverwritten, Conflict: true, SourceValue: "src", DestinationValue: "dest", Value: "src"},
+		},
+	}
+
+	t.Run("Conflicts", func(t *testing.T) {
+		assert.Equal(t, []string{"foo", "baz"}, plan.Conflicts())
+		assert.Equal(t, []string{}, (&model.ApplicationMergePlan{}).Conflicts())
+	})
+
+	t.Run("LabelValues", func(t *testing.T) {
+		assert.Equal(t, map[string]interface{}{"foo": "dest", "bar": "src", "baz": "src"}, plan.LabelValues())
+	})
+}
@@ -1,5 +1,11 @@
 package model
 
+import (
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+)
+
 // Auth missing godoc
 type Auth struct {
 	Credential            CredentialData
@@ -9,6 +15,34 @@ type Auth struct {
 	RequestAuth           *CredentialRequestAuth
 	OneTimeToken          *OneTimeToken
 	CertCommonName        string
+	ClientCertificateRef  *string
+}
+
+// ToAccessStrategyCredentials returns the credentials used by the access strategies requiring them
+func (a *Auth) ToAccessStrategyCredentials() *accessstrategy.Credentials {
+	if a == nil {
+		return nil
+	}
+
+	credentials := &accessstrategy.Credentials{}
+	if a.Credential.Basic != nil {
+		credentials.Basic = &accessstrategy.BasicCredentials{
+			Username: a.Credential.Basic.Username,
+			Password: a.Credential.Basic.Password,
+		}
+	}
+	if a.Credential.Oauth != nil {
+		credentials.OAuth = &accessstrategy.OAuthCredentials{
+			ClientID:     a.Credential.Oauth.ClientID,
+			ClientSecret: a.Credential.Oauth.ClientSecret,
+			TokenURL:     a.Credential.Oauth.URL,
+		}
+	}
+	if len(a.AdditionalHeaders) > 0 {
+		credentials.Headers = http.Header(a.AdditionalHeaders)
+	}
+
+	return credentials
 }
 
 // CredentialRequestAuth missing godoc
@@ -51,6 +85,7 @@ type AuthInput struct {
 	AdditionalQueryParams map[string][]string
 	RequestAuth           *CredentialRequestAuthInput
 	OneTimeToken          *OneTimeToken
+	ClientCertificateRef  *string
 }
 
 // ToAuth missing godoc
@@ -76,6 +111,7 @@ func (i *AuthInput) ToAuth() *Auth {
 		AdditionalHeaders:     i.AdditionalHeaders,
 		RequestAuth:           requestAuth,
 		OneTimeToken:          i.OneTimeToken,
+		ClientCertificateRef:  i.ClientCertificateRef,
 	}
 }
 
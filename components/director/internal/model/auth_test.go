@@ -2,9 +2,11 @@ package model_test
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -13,6 +15,7 @@ var accessStrategy = "accessStrategy"
 
 func TestAuthInput_ToAuth(t *testing.T) {
 	// GIVEN
+	clientCertificateRef := "webhook-client-cert"
 	testCases := []struct {
 		Name     string
 		Input    *model.AuthInput
@@ -38,6 +41,7 @@ func TestAuthInput_ToAuth(t *testing.T) {
 						TokenEndpointURL: "test",
 					},
 				},
+				ClientCertificateRef: &clientCertificateRef,
 			},
 			Expected: &model.Auth{
 				Credential: model.CredentialData{
@@ -57,6 +61,7 @@ func TestAuthInput_ToAuth(t *testing.T) {
 						TokenEndpointURL: "test",
 					},
 				},
+				ClientCertificateRef: &clientCertificateRef,
 			},
 		},
 		{
@@ -318,3 +323,67 @@ func TestCSRFTokenCredentialRequestAuthInput_ToCSRFTokenCredentialRequestAuth(t
 		})
 	}
 }
+
+func TestAuth_ToAccessStrategyCredentials(t *testing.T) {
+	// GIVEN
+	testCases := []struct {
+		Name     string
+		Input    *model.Auth
+		Expected *accessstrategy.Credentials
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.Auth{
+				Credential: model.CredentialData{
+					Basic: &model.BasicCredentialData{
+						Username: "user",
+						Password: "pass",
+					},
+					Oauth: &model.OAuthCredentialData{
+						ClientID:     "client-id",
+						ClientSecret: "client-secret",
+						URL:          "foo.bar/token",
+					},
+				},
+				AccessStrategy: &accessStrategy,
+				AdditionalHeaders: map[string][]string{
+					"header": {"value1", "value2"},
+				},
+			},
+			Expected: &accessstrategy.Credentials{
+				Basic: &accessstrategy.BasicCredentials{
+					Username: "user",
+					Password: "pass",
+				},
+				OAuth: &accessstrategy.OAuthCredentials{
+					ClientID:     "client-id",
+					ClientSecret: "client-secret",
+					TokenURL:     "foo.bar/token",
+				},
+				Headers: http.Header{
+					"header": {"value1", "value2"},
+				},
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.Auth{},
+			Expected: &accessstrategy.Credentials{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// WHEN
+			result := testCase.Input.ToAccessStrategyCredentials()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
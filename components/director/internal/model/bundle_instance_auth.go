@@ -17,6 +17,10 @@ type BundleInstanceAuth struct {
 	InputParams      *string
 	Auth             *Auth
 	Status           *BundleInstanceAuthStatus
+	ExpiresAt        *time.Time
+	// PreviousAuth holds the rotated credentials which stay valid until PreviousAuthExpiresAt
+	PreviousAuth          *Auth
+	PreviousAuthExpiresAt *time.Time
 }
 
 // SetDefaultStatus missing godoc
@@ -29,13 +33,13 @@ func (a *BundleInstanceAuth) SetDefaultStatus(condition BundleInstanceAuthStatus
 
 	switch condition {
 	case BundleInstanceAuthStatusConditionSucceeded:
-		reason = "CredentialsProvided"
+		reason = BundleInstanceAuthStatusReasonCredentialsProvided
 		message = "Credentials were provided."
 	case BundleInstanceAuthStatusConditionPending:
-		reason = "CredentialsNotProvided"
+		reason = BundleInstanceAuthStatusReasonCredentialsNotProvided
 		message = "Credentials were not yet provided."
 	case BundleInstanceAuthStatusConditionUnused:
-		reason = "PendingDeletion"
+		reason = BundleInstanceAuthStatusReasonPendingDeletion
 		message = "Credentials for given Bundle Instance Auth are ready for being deleted by Application or Integration System."
 	default:
 		return errors.Errorf("invalid status condition: %s", condition)
@@ -51,6 +55,37 @@ func (a *BundleInstanceAuth) SetDefaultStatus(condition BundleInstanceAuthStatus
 	return nil
 }
 
+// SetRotationStatus sets the status of the BundleInstanceAuth according to the state of its credentials rotation
+func (a *BundleInstanceAuth) SetRotationStatus(rotated bool, timestamp time.Time) {
+	if a == nil {
+		return
+	}
+
+	if rotated {
+		a.Status = &BundleInstanceAuthStatus{
+			Condition: BundleInstanceAuthStatusConditionSucceeded,
+			Timestamp: timestamp,
+			Message:   "Credentials were rotated. The previous credentials are valid until the end of the grace period.",
+			Reason:    BundleInstanceAuthStatusReasonCredentialsRotated,
+		}
+		return
+	}
+
+	a.Status = &BundleInstanceAuthStatus{
+		Condition: BundleInstanceAuthStatusConditionPending,
+		Timestamp: timestamp,
+		Message:   "Credentials rotation was requested. The current credentials are valid until new ones are provided.",
+		Reason:    BundleInstanceAuthStatusReasonRotationRequested,
+	}
+}
+
+// IsRotationRequested returns true if the BundleInstanceAuth waits for its rotated credentials
+func (a *BundleInstanceAuth) IsRotationRequested() bool {
+	return a != nil && a.Status != nil &&
+		a.Status.Condition == BundleInstanceAuthStatusConditionPending &&
+		a.Status.Reason == BundleInstanceAuthStatusReasonRotationRequested
+}
+
 // BundleInstanceAuthStatus missing godoc
 type BundleInstanceAuthStatus struct {
 	Condition BundleInstanceAuthStatusCondition
@@ -73,6 +108,21 @@ const (
 	BundleInstanceAuthStatusConditionUnused BundleInstanceAuthStatusCondition = "UNUSED"
 )
 
+const (
+	// BundleInstanceAuthStatusReasonCredentialsProvided is set when the credentials were provided
+	BundleInstanceAuthStatusReasonCredentialsProvided = "CredentialsProvided"
+	// BundleInstanceAuthStatusReasonCredentialsNotProvided is set when the credentials were not yet provided
+	BundleInstanceAuthStatusReasonCredentialsNotProvided = "CredentialsNotProvided"
+	// BundleInstanceAuthStatusReasonPendingDeletion is set when the credentials are ready for being deleted
+	BundleInstanceAuthStatusReasonPendingDeletion = "PendingDeletion"
+	// BundleInstanceAuthStatusReasonRotationRequested is set when new credentials were requested and the current ones are still valid
+	BundleInstanceAuthStatusReasonRotationRequested = "RotationRequested"
+	// BundleInstanceAuthStatusReasonCredentialsRotated is set when the credentials were replaced and the previous ones are in their grace period
+	BundleInstanceAuthStatusReasonCredentialsRotated = "CredentialsRotated"
+	// BundleInstanceAuthStatusReasonCredentialsExpired is set when the credentials expired and were removed
+	BundleInstanceAuthStatusReasonCredentialsExpired = "CredentialsExpired"
+)
+
 // BundleInstanceAuthRequestInput type for requestBundleInstanceAuthCreation
 type BundleInstanceAuthRequestInput struct {
 	ID          *string
@@ -97,8 +147,9 @@ func (ri BundleInstanceAuthRequestInput) ToBundleInstanceAuth(id, bundleID, tena
 
 // BundleInstanceAuthSetInput type for setBundleInstanceAuth
 type BundleInstanceAuthSetInput struct {
-	Auth   *AuthInput
-	Status *BundleInstanceAuthStatusInput
+	Auth      *AuthInput
+	Status    *BundleInstanceAuthStatusInput
+	ExpiresAt *time.Time
 }
 
 // BundleInstanceAuthStatusInput missing godoc
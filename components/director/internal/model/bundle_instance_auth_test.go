@@ -186,3 +186,45 @@ func TestBundleInstanceAuth_SetDefaultStatus(t *testing.T) {
 		assert.Nil(t, instanceAuth)
 	})
 }
+
+func TestBundleInstanceAuth_SetRotationStatus(t *testing.T) {
+	// GIVEN
+	timestamp := time.Now()
+
+	t.Run("Success when rotation was requested", func(t *testing.T) {
+		instanceAuth := BundleInstanceAuth{}
+
+		// WHEN
+		instanceAuth.SetRotationStatus(false, timestamp)
+
+		// THEN
+		assert.Equal(t, BundleInstanceAuthStatusConditionPending, instanceAuth.Status.Condition)
+		assert.Equal(t, "RotationRequested", instanceAuth.Status.Reason)
+		assert.Equal(t, timestamp, instanceAuth.Status.Timestamp)
+		assert.True(t, instanceAuth.IsRotationRequested())
+	})
+
+	t.Run("Success when credentials were rotated", func(t *testing.T) {
+		instanceAuth := BundleInstanceAuth{}
+
+		// WHEN
+		instanceAuth.SetRotationStatus(true, timestamp)
+
+		// THEN
+		assert.Equal(t, BundleInstanceAuthStatusConditionSucceeded, instanceAuth.Status.Condition)
+		assert.Equal(t, "CredentialsRotated", instanceAuth.Status.Reason)
+		assert.Equal(t, timestamp, instanceAuth.Status.Timestamp)
+		assert.False(t, instanceAuth.IsRotationRequested())
+	})
+
+	t.Run("Success if nil", func(t *testing.T) {
+		var instanceAuth *BundleInstanceAuth
+
+		// WHEN
+		instanceAuth.SetRotationStatus(true, timestamp)
+
+		// THEN
+		assert.Nil(t, instanceAuth)
+		assert.False(t, instanceAuth.IsRotationRequested())
+	})
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// ChangeEventType represents the kind of change that happened to a resource
+type ChangeEventType string
+
+const (
+	// ChangeEventTypeCreated is used when a resource is created
+	ChangeEventTypeCreated ChangeEventType = "CREATED"
+	// ChangeEventTypeUpdated is used when a resource is updated
+	ChangeEventTypeUpdated ChangeEventType = "UPDATED"
+	// ChangeEventTypeDeleted is used when a resource is deleted
+	ChangeEventTypeDeleted ChangeEventType = "DELETED"
+	// ChangeEventTypeLabelChanged is used when a label of a resource is set or deleted
+	ChangeEventTypeLabelChanged ChangeEventType = "LABEL_CHANGED"
+	// ChangeEventTypeAssigned is used when an object is assigned to a formation
+	ChangeEventTypeAssigned ChangeEventType = "ASSIGNED"
+	// ChangeEventTypeUnassigned is used when an object is unassigned from a formation
+	ChangeEventTypeUnassigned ChangeEventType = "UNASSIGNED"
+)
+
+// ChangeEvent represents a change of an Application, Runtime or Formation which is delivered to the GraphQL subscribers
+type ChangeEvent struct {
+	ID           string          `json:"id"`
+	Type         ChangeEventType `json:"type"`
+	ResourceType resource.Type   `json:"resourceType"`
+	ResourceID   string          `json:"resourceID"`
+	ResourceName string          `json:"resourceName,omitempty"`
+	LabelKey     string          `json:"labelKey,omitempty"`
+	ObjectID     string          `json:"objectID,omitempty"`
+	ObjectType   string          `json:"objectType,omitempty"`
+	Scenarios    []string        `json:"scenarios,omitempty"`
+	TenantIDs    []string        `json:"tenantIDs"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+	// Trimmed denotes an event which carries only the IDs of the change, as the whole event exceeds the maximum size of a notification.
+	// The tenants with access to the resource and the scenarios of the resource have to be fetched again by the receiver.
+	Trimmed bool `json:"trimmed,omitempty"`
+}
+
+// ChangeEventInput contains the data about the change which is provided by the domain services
+type ChangeEventInput struct {
+	Type         ChangeEventType
+	ResourceType resource.Type
+	ResourceID   string
+	ResourceName string
+	LabelKey     string
+	ObjectID     string
+	ObjectType   string
+}
+
+// Trim returns a copy of the event which carries only the IDs of the change
+func (e *ChangeEvent) Trim() *ChangeEvent {
+	return &ChangeEvent{
+		ID:           e.ID,
+		Type:         e.Type,
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		ObjectID:     e.ObjectID,
+		OccurredAt:   e.OccurredAt,
+		Trimmed:      true,
+	}
+}
+
+// HasTenant returns true if the given tenant has access to the changed resource
+func (e *ChangeEvent) HasTenant(tenantID string) bool {
+	for _, t := range e.TenantIDs {
+		if t == tenantID {
+			return true
+		}
+	}
+	return false
+}
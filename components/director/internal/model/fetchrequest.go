@@ -50,15 +50,15 @@ type FetchRequestStatus struct {
 	Timestamp time.Time
 }
 
-// FetchMode is a legacy never delivered feature.
+// FetchMode defines how the fetched document is turned into a spec.
 type FetchMode string
 
 const (
-	// FetchModeSingle is a legacy never delivered feature.
+	// FetchModeSingle is used when the fetched document is the spec itself.
 	FetchModeSingle FetchMode = "SINGLE"
-	// FetchModeBundle is a legacy never delivered feature.
+	// FetchModeBundle is used when the fetched document is a zip, tar or tar.gz archive containing the spec.
 	FetchModeBundle FetchMode = "BUNDLE"
-	// FetchModeIndex is a legacy never delivered feature.
+	// FetchModeIndex is used when the fetched document is an index listing the URLs of several specs.
 	FetchModeIndex FetchMode = "INDEX"
 )
 
@@ -19,3 +19,24 @@ type FormationPage struct {
 	PageInfo   *pagination.Page
 	TotalCount int
 }
+
+// FormationStatusCondition represents the condition of a Formation based on its participants
+type FormationStatusCondition string
+
+const (
+	// FormationStatusConditionEmpty is the condition of a Formation without participants
+	FormationStatusConditionEmpty FormationStatusCondition = "EMPTY"
+	// FormationStatusConditionIncomplete is the condition of a Formation which has either applications or runtimes and runtime contexts, but not both
+	FormationStatusConditionIncomplete FormationStatusCondition = "INCOMPLETE"
+	// FormationStatusConditionReady is the condition of a Formation which has at least one application and at least one runtime or runtime context
+	FormationStatusConditionReady FormationStatusCondition = "READY"
+)
+
+// FormationStatus is the status of a Formation computed from its participants
+type FormationStatus struct {
+	Condition              FormationStatusCondition
+	ApplicationsCount      int
+	RuntimesCount          int
+	RuntimeContextsCount   int
+	TenantAssignmentsCount int
+}
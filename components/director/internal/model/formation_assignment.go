@@ -0,0 +1,41 @@
+package model
+
+// FormationOperation represents the operation on a formation participant which a notification is sent for
+type FormationOperation string
+
+const (
+	// AssignFormation represents the assignment of a participant to a formation
+	AssignFormation FormationOperation = "assign"
+	// UnassignFormation represents the unassignment of a participant from a formation
+	UnassignFormation FormationOperation = "unassign"
+)
+
+// FormationAssignmentState represents the state of the notification sent for a formation assignment
+type FormationAssignmentState string
+
+const (
+	// FormationAssignmentStateInitial is the state of a notification which is not yet scheduled
+	FormationAssignmentStateInitial FormationAssignmentState = "INITIAL"
+	// FormationAssignmentStateInProgress is the state of a notification which is scheduled but not yet delivered
+	FormationAssignmentStateInProgress FormationAssignmentState = "IN_PROGRESS"
+	// FormationAssignmentStateReady is the state of a successfully delivered notification
+	FormationAssignmentStateReady FormationAssignmentState = "READY"
+	// FormationAssignmentStateFailed is the state of a notification which could not be delivered
+	FormationAssignmentStateFailed FormationAssignmentState = "FAILED"
+)
+
+// FormationAssignment represents the notification of the Target participant of a formation
+// about the (un)assignment of the Source participant to the same formation
+type FormationAssignment struct {
+	ID          string
+	FormationID string
+	TenantID    string
+	Source      string
+	SourceType  LabelableObject
+	Target      string
+	TargetType  LabelableObject
+	WebhookID   string
+	Operation   FormationOperation
+	State       FormationAssignmentState
+	Error       *string
+}
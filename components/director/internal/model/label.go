@@ -2,6 +2,7 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
@@ -16,6 +17,13 @@ type Label struct {
 	Version    int
 }
 
+// LabelPage is a page of labels.
+type LabelPage struct {
+	Data       []*Label
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
 // LabelInput is an input for creating a new label.
 type LabelInput struct {
 	Key        string
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// OperationPhase represents the phase of an asynchronous operation or of the execution of one of its webhooks
+type OperationPhase string
+
+const (
+	// OperationPhaseInProgress is the phase of an operation which is not yet finished
+	OperationPhaseInProgress OperationPhase = "IN_PROGRESS"
+	// OperationPhaseSucceeded is the phase of a successfully finished operation
+	OperationPhaseSucceeded OperationPhase = "SUCCEEDED"
+	// OperationPhaseFailed is the phase of a failed operation
+	OperationPhaseFailed OperationPhase = "FAILED"
+)
+
+// Operation is an entry of the operations history, which records an asynchronous operation scheduled for a resource
+// along with the progress of its webhooks and its result
+type Operation struct {
+	ID                string
+	ResourceType      resource.Type
+	ResourceID        string
+	TenantID          *string
+	OperationType     string
+	OperationCategory string
+	CorrelationID     string
+	Phase             OperationPhase
+	Error             *string
+	Webhooks          []*OperationWebhook
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	FinishedAt        *time.Time
+}
+
+// OperationWebhook represents the progress of the execution of a webhook of an Operation
+type OperationWebhook struct {
+	WebhookID         string         `json:"webhook_id"`
+	State             OperationPhase `json:"state"`
+	RetriesCount      int            `json:"retries_count"`
+	PollURL           string         `json:"poll_url,omitempty"`
+	LastPollTimestamp *time.Time     `json:"last_poll_timestamp,omitempty"`
+}
+
+// Finish completes the operation with the given error, or successfully if there is no error.
+// The webhooks which are still in progress get the phase of the operation.
+func (o *Operation) Finish(errorMsg *string, finishedAt time.Time) {
+	o.Phase = OperationPhaseSucceeded
+	o.Error = nil
+	if errorMsg != nil && *errorMsg != "" {
+		o.Phase = OperationPhaseFailed
+		o.Error = errorMsg
+	}
+
+	for _, webhook := range o.Webhooks {
+		if webhook.State == OperationPhaseInProgress {
+			webhook.State = o.Phase
+		}
+	}
+
+	o.UpdatedAt = finishedAt
+	o.FinishedAt = &finishedAt
+}
@@ -0,0 +1,61 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperation_Finish(t *testing.T) {
+	finishedAt := time.Date(2022, 8, 29, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		ErrorMsg      *string
+		ExpectedPhase model.OperationPhase
+		ExpectedError *string
+	}{
+		{
+			Name:          "Succeeded without error",
+			ExpectedPhase: model.OperationPhaseSucceeded,
+		},
+		{
+			Name:          "Succeeded with empty error",
+			ErrorMsg:      str.Ptr(""),
+			ExpectedPhase: model.OperationPhaseSucceeded,
+		},
+		{
+			Name:          "Failed with error",
+			ErrorMsg:      str.Ptr("test error"),
+			ExpectedPhase: model.OperationPhaseFailed,
+			ExpectedError: str.Ptr("test error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			op := &model.Operation{
+				Phase: model.OperationPhaseInProgress,
+				Webhooks: []*model.OperationWebhook{
+					{WebhookID: "in-progress", State: model.OperationPhaseInProgress},
+					{WebhookID: "failed", State: model.OperationPhaseFailed},
+				},
+			}
+
+			// WHEN
+			op.Finish(testCase.ErrorMsg, finishedAt)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedPhase, op.Phase)
+			assert.Equal(t, testCase.ExpectedError, op.Error)
+			assert.Equal(t, testCase.ExpectedPhase, op.Webhooks[0].State)
+			assert.Equal(t, model.OperationPhaseFailed, op.Webhooks[1].State)
+			assert.Equal(t, finishedAt, op.UpdatedAt)
+			assert.Equal(t, finishedAt, *op.FinishedAt)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// ORDAggregationStatus is the outcome of an ORD aggregation of an Application
+type ORDAggregationStatus string
+
+const (
+	// ORDAggregationStatusSucceeded is the status of an aggregation whose documents were applied
+	ORDAggregationStatusSucceeded ORDAggregationStatus = "SUCCEEDED"
+	// ORDAggregationStatusFailed is the status of an aggregation whose documents could not be fetched, were rejected or could not be applied
+	ORDAggregationStatusFailed ORDAggregationStatus = "FAILED"
+)
+
+// ORDResourceType is the type of a resource described in the ORD documents
+type ORDResourceType string
+
+const (
+	// ORDResourceTypeDocument is the type of the ORD document itself, including its described system instance
+	ORDResourceTypeDocument ORDResourceType = "DOCUMENT"
+	// ORDResourceTypePackage is the type of an ORD package
+	ORDResourceTypePackage ORDResourceType = "PACKAGE"
+	// ORDResourceTypeConsumptionBundle is the type of an ORD consumption bundle
+	ORDResourceTypeConsumptionBundle ORDResourceType = "CONSUMPTION_BUNDLE"
+	// ORDResourceTypeProduct is the type of an ORD product
+	ORDResourceTypeProduct ORDResourceType = "PRODUCT"
+	// ORDResourceTypeVendor is the type of an ORD vendor
+	ORDResourceTypeVendor ORDResourceType = "VENDOR"
+	// ORDResourceTypeAPI is the type of an ORD API resource
+	ORDResourceTypeAPI ORDResourceType = "API"
+	// ORDResourceTypeEvent is the type of an ORD event resource
+	ORDResourceTypeEvent ORDResourceType = "EVENT"
+	// ORDResourceTypeTombstone is the type of an ORD tombstone
+	ORDResourceTypeTombstone ORDResourceType = "TOMBSTONE"
+)
+
+// ORDAggregationReport is the report of the latest ORD aggregation of an Application.
+// It lists the resources which were accepted and the ones which were rejected along with the failing validation rule,
+// so that the providers of the documents can fix them.
+type ORDAggregationReport struct {
+	ApplicationID     string
+	Status            ORDAggregationStatus
+	Error             *string
+	DocumentsFetched  int
+	AcceptedResources []*ORDReportedResource
+	RejectedResources []*ORDRejectedResource
+	AppliedTombstones []string
+	StartedAt         time.Time
+	FinishedAt        time.Time
+}
+
+// ORDReportedResource identifies a resource described in the ORD documents
+type ORDReportedResource struct {
+	Type  ORDResourceType `json:"type"`
+	OrdID string          `json:"ord_id"`
+}
+
+// ORDRejectedResource is a resource described in the ORD documents which failed the validation.
+// A resource which fails several rules is reported once for each of them.
+type ORDRejectedResource struct {
+	Type      ORDResourceType `json:"type"`
+	OrdID     string          `json:"ord_id,omitempty"`
+	Rule      string          `json:"rule"`
+	FieldPath string          `json:"field_path,omitempty"`
+	Message   string          `json:"message"`
+}
+
+// Finish completes the report with the given error, or successfully if there is no error
+func (r *ORDAggregationReport) Finish(err error, finishedAt time.Time) {
+	r.Status = ORDAggregationStatusSucceeded
+	r.Error = nil
+	if err != nil {
+		errMsg := err.Error()
+		r.Status = ORDAggregationStatusFailed
+		r.Error = &errMsg
+	}
+	r.FinishedAt = finishedAt
+}
@@ -0,0 +1,48 @@
+package model_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestORDAggregationReport_Finish(t *testing.T) {
+	finishedAt := time.Date(2022, 9, 26, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name           string
+		Err            error
+		ExpectedStatus model.ORDAggregationStatus
+		ExpectedError  *string
+	}{
+		{
+			Name:           "Succeeded without error",
+			ExpectedStatus: model.ORDAggregationStatusSucceeded,
+		},
+		{
+			Name:           "Failed with error",
+			Err:            errors.New("test error"),
+			ExpectedStatus: model.ORDAggregationStatusFailed,
+			ExpectedError:  str.Ptr("test error"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			report := &model.ORDAggregationReport{Error: str.Ptr("previous error")}
+
+			// WHEN
+			report.Finish(testCase.Err, finishedAt)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedStatus, report.Status)
+			assert.Equal(t, testCase.ExpectedError, report.Error)
+			assert.Equal(t, finishedAt, report.FinishedAt)
+		})
+	}
+}
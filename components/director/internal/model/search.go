@@ -0,0 +1,54 @@
+package model
+
+import "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
+// SearchResultKind is the kind of resource matched by a full-text search
+type SearchResultKind string
+
+const (
+	// SearchResultKindApplication represents an Application search result
+	SearchResultKindApplication SearchResultKind = "APPLICATION"
+	// SearchResultKindBundle represents a Bundle search result
+	SearchResultKindBundle SearchResultKind = "BUNDLE"
+	// SearchResultKindAPIDefinition represents an API Definition search result
+	SearchResultKindAPIDefinition SearchResultKind = "API_DEFINITION"
+	// SearchResultKindEventDefinition represents an Event Definition search result
+	SearchResultKindEventDefinition SearchResultKind = "EVENT_DEFINITION"
+	// SearchResultKindDocument represents a Document search result
+	SearchResultKindDocument SearchResultKind = "DOCUMENT"
+)
+
+// SearchResultKinds are all kinds of resources which can be searched
+var SearchResultKinds = []SearchResultKind{
+	SearchResultKindApplication,
+	SearchResultKindBundle,
+	SearchResultKindAPIDefinition,
+	SearchResultKindEventDefinition,
+	SearchResultKindDocument,
+}
+
+// SearchFilter restricts the resources matched by a full-text search
+type SearchFilter struct {
+	Term  string
+	Kinds []SearchResultKind
+	// Scenarios restricts the results to resources of Applications in at least one of the scenarios. Nil means no restriction.
+	Scenarios []string
+}
+
+// SearchResult is a resource matching a full-text search term
+type SearchResult struct {
+	Kind          SearchResultKind
+	ID            string
+	ApplicationID *string
+	Name          string
+	Description   *string
+	OrdID         *string
+	Rank          float64
+}
+
+// SearchResultPage missing godoc
+type SearchResultPage struct {
+	Data       []*SearchResult
+	PageInfo   *pagination.Page
+	TotalCount int
+}
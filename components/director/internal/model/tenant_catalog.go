@@ -0,0 +1,41 @@
+package model
+
+// TenantCatalogChangeAction is the kind of change applied while importing a tenant catalog
+type TenantCatalogChangeAction string
+
+const (
+	// TenantCatalogChangeActionCreate represents the creation of a missing resource
+	TenantCatalogChangeActionCreate TenantCatalogChangeAction = "CREATE"
+	// TenantCatalogChangeActionUpdate represents the update of an existing resource
+	TenantCatalogChangeActionUpdate TenantCatalogChangeAction = "UPDATE"
+)
+
+// TenantCatalogResourceType is the type of resource changed while importing a tenant catalog
+type TenantCatalogResourceType string
+
+const (
+	// TenantCatalogResourceTypeApplicationTemplate represents an Application Template
+	TenantCatalogResourceTypeApplicationTemplate TenantCatalogResourceType = "APPLICATION_TEMPLATE"
+	// TenantCatalogResourceTypeApplication represents an Application
+	TenantCatalogResourceTypeApplication TenantCatalogResourceType = "APPLICATION"
+	// TenantCatalogResourceTypeLabel represents an Application label
+	TenantCatalogResourceTypeLabel TenantCatalogResourceType = "LABEL"
+	// TenantCatalogResourceTypeWebhook represents an Application webhook
+	TenantCatalogResourceTypeWebhook TenantCatalogResourceType = "WEBHOOK"
+	// TenantCatalogResourceTypeBundle represents a Bundle
+	TenantCatalogResourceTypeBundle TenantCatalogResourceType = "BUNDLE"
+	// TenantCatalogResourceTypeAPIDefinition represents an API Definition
+	TenantCatalogResourceTypeAPIDefinition TenantCatalogResourceType = "API_DEFINITION"
+	// TenantCatalogResourceTypeEventDefinition represents an Event Definition
+	TenantCatalogResourceTypeEventDefinition TenantCatalogResourceType = "EVENT_DEFINITION"
+	// TenantCatalogResourceTypeDocument represents a Document
+	TenantCatalogResourceTypeDocument TenantCatalogResourceType = "DOCUMENT"
+)
+
+// TenantCatalogChange is a change which is applied, or would be applied in dry-run mode, while importing a tenant catalog
+type TenantCatalogChange struct {
+	Action       TenantCatalogChangeAction
+	ResourceType TenantCatalogResourceType
+	// Path is the location of the resource in the catalog, for example applications/my-app/bundles/my-bundle
+	Path string
+}
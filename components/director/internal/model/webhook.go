@@ -21,6 +21,15 @@ type Webhook struct {
 	HeaderTemplate   *string
 	OutputTemplate   *string
 	StatusTemplate   *string
+	Signature        *WebhookSignature
+}
+
+// WebhookSignature represents the settings for signing the requests sent to a webhook.
+type WebhookSignature struct {
+	Secret          string
+	SignatureHeader *string
+	TimestampHeader *string
+	NonceHeader     *string
 }
 
 // WebhookInput represents a webhook input for creating/updating webhooks.
@@ -37,6 +46,15 @@ type WebhookInput struct {
 	HeaderTemplate   *string
 	OutputTemplate   *string
 	StatusTemplate   *string
+	Signature        *WebhookSignatureInput
+}
+
+// WebhookSignatureInput represents the input for the settings for signing the requests sent to a webhook.
+type WebhookSignatureInput struct {
+	Secret          string
+	SignatureHeader *string
+	TimestampHeader *string
+	NonceHeader     *string
 }
 
 // WebhookType represents the type of the webhook.
@@ -53,6 +71,8 @@ const (
 	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
 	// WebhookTypeUnpairApplication represents a webhook that is called when an application is unpaired.
 	WebhookTypeUnpairApplication WebhookType = "UNPAIR_APPLICATION"
+	// WebhookTypeFormationAssignmentNotification represents a webhook that is called when a participant is assigned to or unassigned from a formation of the webhook owner.
+	WebhookTypeFormationAssignmentNotification WebhookType = "FORMATION_ASSIGNMENT_NOTIFICATION"
 )
 
 // WebhookMode represents the mode of the webhook.
@@ -118,5 +138,20 @@ func (i *WebhookInput) ToWebhook(id, objID string, objectType WebhookReferenceOb
 		HeaderTemplate:   i.HeaderTemplate,
 		OutputTemplate:   i.OutputTemplate,
 		StatusTemplate:   i.StatusTemplate,
+		Signature:        i.Signature.ToWebhookSignature(),
+	}
+}
+
+// ToWebhookSignature converts the given input to webhook signature settings.
+func (i *WebhookSignatureInput) ToWebhookSignature() *WebhookSignature {
+	if i == nil {
+		return nil
+	}
+
+	return &WebhookSignature{
+		Secret:          i.Secret,
+		SignatureHeader: i.SignatureHeader,
+		TimestampHeader: i.TimestampHeader,
+		NonceHeader:     i.NonceHeader,
 	}
 }
@@ -15,6 +15,8 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 	template := `{}`
 	webhookMode := model.WebhookModeSync
 	webhookURL := "foourl"
+	signatureSecret := "secret"
+	signatureHeader := "X-Signature"
 	testCases := []struct {
 		Name     string
 		Input    *model.WebhookInput
@@ -36,6 +38,10 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 				InputTemplate:  &template,
 				HeaderTemplate: &template,
 				OutputTemplate: &template,
+				Signature: &model.WebhookSignatureInput{
+					Secret:          signatureSecret,
+					SignatureHeader: &signatureHeader,
+				},
 			},
 			Expected: &model.Webhook{
 				ObjectID:   applicationID,
@@ -54,6 +60,10 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 				InputTemplate:  &template,
 				HeaderTemplate: &template,
 				OutputTemplate: &template,
+				Signature: &model.WebhookSignature{
+					Secret:          signatureSecret,
+					SignatureHeader: &signatureHeader,
+				},
 			},
 		},
 		{
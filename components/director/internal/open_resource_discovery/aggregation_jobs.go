@@ -0,0 +1,196 @@
+package ord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// JobStatus is the status of an on-demand aggregation job
+type JobStatus string
+
+const (
+	// JobStatusQueued is the status of a job waiting for a free worker
+	JobStatusQueued JobStatus = "QUEUED"
+	// JobStatusRunning is the status of a job which is being processed
+	JobStatusRunning JobStatus = "RUNNING"
+	// JobStatusSucceeded is the status of a job which finished successfully
+	JobStatusSucceeded JobStatus = "SUCCEEDED"
+	// JobStatusFailed is the status of a job which finished with an error
+	JobStatusFailed JobStatus = "FAILED"
+)
+
+// ErrJobQueueFull is returned when an application cannot be scheduled for aggregation because the job queue is full
+var ErrJobQueueFull = errors.New("aggregation job queue is full")
+
+// AggregationJob is the on-demand aggregation of the ORD documents of a single application
+type AggregationJob struct {
+	ApplicationID string    `json:"applicationID"`
+	Status        JobStatus `json:"status"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// Finished returns true if the job either succeeded or failed
+func (j AggregationJob) Finished() bool {
+	return j.Status == JobStatusSucceeded || j.Status == JobStatusFailed
+}
+
+// ApplicationProcessor performs the aggregation of the ORD documents of a single application
+//go:generate mockery --name=ApplicationProcessor --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ApplicationProcessor interface {
+	ProcessApplication(ctx context.Context, appID string) error
+}
+
+// JobQueueConfig contains configuration for the on-demand aggregation job queue
+type JobQueueConfig struct {
+	QueueSize      int           `envconfig:"default=100,APP_ON_DEMAND_AGGREGATION_QUEUE_SIZE"`
+	Workers        int           `envconfig:"default=2,APP_ON_DEMAND_AGGREGATION_WORKERS"`
+	FinishedJobTTL time.Duration `envconfig:"default=1h,APP_ON_DEMAND_AGGREGATION_FINISHED_JOB_TTL"`
+}
+
+type job struct {
+	AggregationJob
+	done       chan struct{}
+	finishedAt time.Time
+}
+
+// JobQueue schedules on-demand aggregation jobs for single applications and processes them with a fixed number of workers.
+// Scheduling an application which already has a queued or running job returns the existing job instead of creating a new one.
+// Finished jobs are kept for JobQueueConfig.FinishedJobTTL, so that their outcome can be retrieved, and evicted afterwards.
+type JobQueue struct {
+	config    JobQueueConfig
+	processor ApplicationProcessor
+
+	queue chan *job
+
+	mu   sync.Mutex
+	jobs map[string]*job
+	// finished contains the finished jobs in the order they finished in, so that the expired ones can be evicted from the front
+	finished []*job
+}
+
+// NewJobQueue creates a new JobQueue
+func NewJobQueue(config JobQueueConfig, processor ApplicationProcessor) *JobQueue {
+	return &JobQueue{
+		config:    config,
+		processor: processor,
+		queue:     make(chan *job, config.QueueSize),
+		jobs:      make(map[string]*job),
+	}
+}
+
+// Run starts the workers and blocks until the context is cancelled
+func (q *JobQueue) Run(ctx context.Context) {
+	wg := &sync.WaitGroup{}
+	wg.Add(q.config.Workers)
+
+	log.C(ctx).Infof("Starting %d on-demand aggregation workers...", q.config.Workers)
+	for i := 0; i < q.config.Workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-q.queue:
+					q.process(ctx, j)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	log.C(ctx).Info("On-demand aggregation workers stopped")
+}
+
+// Schedule queues the application for aggregation. It returns the current state of the job and a channel which is closed when the job finishes.
+func (q *JobQueue) Schedule(appID string) (AggregationJob, <-chan struct{}, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.evictExpired()
+	if existing, ok := q.jobs[appID]; ok && !existing.Finished() {
+		return existing.AggregationJob, existing.done, nil
+	}
+
+	j := &job{
+		AggregationJob: AggregationJob{
+			ApplicationID: appID,
+			Status:        JobStatusQueued,
+		},
+		done: make(chan struct{}),
+	}
+
+	select {
+	case q.queue <- j:
+	default:
+		return AggregationJob{}, nil, ErrJobQueueFull
+	}
+
+	q.jobs[appID] = j
+	return j.AggregationJob, j.done, nil
+}
+
+// Get returns the latest job for the application
+func (q *JobQueue) Get(appID string) (AggregationJob, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.evictExpired()
+	j, ok := q.jobs[appID]
+	if !ok {
+		return AggregationJob{}, false
+	}
+	return j.AggregationJob, true
+}
+
+func (q *JobQueue) process(ctx context.Context, j *job) {
+	q.setStatus(j, JobStatusRunning, nil)
+
+	ctx = addFieldToLogger(ctx, "app_id", j.ApplicationID)
+
+	log.C(ctx).Info("Processing on-demand aggregation job")
+	if err := q.processor.ProcessApplication(ctx, j.ApplicationID); err != nil {
+		log.C(ctx).WithError(err).Errorf("On-demand aggregation job failed: %v", err)
+		q.setStatus(j, JobStatusFailed, err)
+	} else {
+		log.C(ctx).Info("On-demand aggregation job succeeded")
+		q.setStatus(j, JobStatusSucceeded, nil)
+	}
+
+	close(j.done)
+}
+
+func (q *JobQueue) setStatus(j *job, status JobStatus, err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	j.Status = status
+	if err != nil {
+		j.Error = err.Error()
+	}
+
+	if j.Finished() {
+		j.finishedAt = time.Now()
+		q.finished = append(q.finished, j)
+	}
+}
+
+// evictExpired removes the jobs which finished more than FinishedJobTTL ago. It must be called with the lock held.
+func (q *JobQueue) evictExpired() {
+	now := time.Now()
+	for len(q.finished) > 0 && now.Sub(q.finished[0].finishedAt) >= q.config.FinishedJobTTL {
+		expired := q.finished[0]
+		q.finished[0] = nil
+		q.finished = q.finished[1:]
+
+		// the application may have been scheduled again since the job finished
+		if q.jobs[expired.ApplicationID] == expired {
+			delete(q.jobs, expired.ApplicationID)
+		}
+	}
+}
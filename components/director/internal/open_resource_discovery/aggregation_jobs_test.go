@@ -0,0 +1,170 @@
+package ord_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobQueue(t *testing.T) {
+	const appID2 = "testApp2"
+	testErr := errors.New("test error")
+	config := ord.JobQueueConfig{QueueSize: 1, Workers: 1, FinishedJobTTL: time.Hour}
+
+	waitFor := func(t *testing.T, done <-chan struct{}) {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the aggregation job")
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		processor := &automock.ApplicationProcessor{}
+		processor.On("ProcessApplication", mock.Anything, appID).Return(nil).Once()
+		defer processor.AssertExpectations(t)
+
+		queue := ord.NewJobQueue(config, processor)
+		go queue.Run(ctx)
+
+		// WHEN
+		job, done, err := queue.Schedule(appID)
+		require.NoError(t, err)
+		assert.Equal(t, ord.JobStatusQueued, job.Status)
+		waitFor(t, done)
+
+		// THEN
+		job, ok := queue.Get(appID)
+		require.True(t, ok)
+		assert.Equal(t, ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusSucceeded}, job)
+	})
+
+	t.Run("Reports the error of a failed job", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		processor := &automock.ApplicationProcessor{}
+		processor.On("ProcessApplication", mock.Anything, appID).Return(testErr).Once()
+		defer processor.AssertExpectations(t)
+
+		queue := ord.NewJobQueue(config, processor)
+		go queue.Run(ctx)
+
+		// WHEN
+		_, done, err := queue.Schedule(appID)
+		require.NoError(t, err)
+		waitFor(t, done)
+
+		// THEN
+		job, ok := queue.Get(appID)
+		require.True(t, ok)
+		assert.Equal(t, ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusFailed, Error: testErr.Error()}, job)
+	})
+
+	t.Run("Returns the pending job when the application is already scheduled", func(t *testing.T) {
+		// GIVEN
+		queue := ord.NewJobQueue(config, &automock.ApplicationProcessor{})
+
+		_, firstDone, err := queue.Schedule(appID)
+		require.NoError(t, err)
+
+		// WHEN
+		job, secondDone, err := queue.Schedule(appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, ord.JobStatusQueued, job.Status)
+		assert.Equal(t, firstDone, secondDone)
+	})
+
+	t.Run("Schedules a new job when the previous one has finished", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		processor := &automock.ApplicationProcessor{}
+		processor.On("ProcessApplication", mock.Anything, appID).Return(testErr).Once()
+		processor.On("ProcessApplication", mock.Anything, appID).Return(nil).Once()
+		defer processor.AssertExpectations(t)
+
+		queue := ord.NewJobQueue(config, processor)
+		go queue.Run(ctx)
+
+		_, done, err := queue.Schedule(appID)
+		require.NoError(t, err)
+		waitFor(t, done)
+
+		// WHEN
+		_, done, err = queue.Schedule(appID)
+		require.NoError(t, err)
+		waitFor(t, done)
+
+		// THEN
+		job, ok := queue.Get(appID)
+		require.True(t, ok)
+		assert.Equal(t, ord.AggregationJob{ApplicationID: appID, Status: ord.JobStatusSucceeded}, job)
+	})
+
+	t.Run("Evicts the finished job once its TTL expires", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		processor := &automock.ApplicationProcessor{}
+		processor.On("ProcessApplication", mock.Anything, appID).Return(nil).Once()
+		defer processor.AssertExpectations(t)
+
+		queue := ord.NewJobQueue(ord.JobQueueConfig{QueueSize: 1, Workers: 1, FinishedJobTTL: time.Millisecond}, processor)
+		go queue.Run(ctx)
+
+		_, done, err := queue.Schedule(appID)
+		require.NoError(t, err)
+		waitFor(t, done)
+
+		// WHEN
+		time.Sleep(2 * time.Millisecond)
+		_, ok := queue.Get(appID)
+
+		// THEN
+		assert.False(t, ok)
+	})
+
+	t.Run("Returns error when the queue is full", func(t *testing.T) {
+		// GIVEN
+		queue := ord.NewJobQueue(config, &automock.ApplicationProcessor{})
+
+		_, _, err := queue.Schedule(appID)
+		require.NoError(t, err)
+
+		// WHEN
+		_, _, err = queue.Schedule(appID2)
+
+		// THEN
+		require.Equal(t, ord.ErrJobQueueFull, err)
+		_, ok := queue.Get(appID2)
+		assert.False(t, ok)
+	})
+
+	t.Run("Get returns false when the application was not scheduled", func(t *testing.T) {
+		// GIVEN
+		queue := ord.NewJobQueue(config, &automock.ApplicationProcessor{})
+
+		// WHEN
+		_, ok := queue.Get(appID)
+
+		// THEN
+		assert.False(t, ok)
+	})
+}
@@ -18,13 +18,13 @@ type Client struct {
 	mock.Mock
 }
 
-// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, app, webhook
-func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (ord.Documents, string, error) {
-	ret := _m.Called(ctx, app, webhook)
+// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, app, webhook, cache
+func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *ord.FetchCache) (ord.Documents, string, *ord.FetchCache, error) {
+	ret := _m.Called(ctx, app, webhook, cache)
 
 	var r0 ord.Documents
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, *model.Webhook) ord.Documents); ok {
-		r0 = rf(ctx, app, webhook)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, *model.Webhook, *ord.FetchCache) ord.Documents); ok {
+		r0 = rf(ctx, app, webhook, cache)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ord.Documents)
@@ -32,20 +32,29 @@ func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *
 	}
 
 	var r1 string
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Application, *model.Webhook) string); ok {
-		r1 = rf(ctx, app, webhook)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Application, *model.Webhook, *ord.FetchCache) string); ok {
+		r1 = rf(ctx, app, webhook, cache)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(context.Context, *model.Application, *model.Webhook) error); ok {
-		r2 = rf(ctx, app, webhook)
+	var r2 *ord.FetchCache
+	if rf, ok := ret.Get(2).(func(context.Context, *model.Application, *model.Webhook, *ord.FetchCache) *ord.FetchCache); ok {
+		r2 = rf(ctx, app, webhook, cache)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(*ord.FetchCache)
+		}
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func(context.Context, *model.Application, *model.Webhook, *ord.FetchCache) error); ok {
+		r3 = rf(ctx, app, webhook, cache)
+	} else {
+		r3 = ret.Error(3)
 	}
 
-	return r0, r1, r2
+	return r0, r1, r2, r3
 }
 
 // NewClient creates a new instance of Client. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
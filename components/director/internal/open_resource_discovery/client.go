@@ -19,10 +19,71 @@ import (
 	"github.com/pkg/errors"
 )
 
+// ErrNotModified is returned when neither the well-known configuration nor any of the ORD documents changed since they were cached
+var ErrNotModified = errors.New("ORD documents have not been modified")
+
+// Validators are the cache validators returned by the provider of an ORD resource
+type Validators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+func validatorsFromResponse(resp *http.Response) Validators {
+	return Validators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+}
+
+func (v Validators) empty() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+func (v Validators) headers() http.Header {
+	if v.empty() {
+		return nil
+	}
+
+	headers := http.Header{}
+	if v.ETag != "" {
+		headers.Set("If-None-Match", v.ETag)
+	}
+	if v.LastModified != "" {
+		headers.Set("If-Modified-Since", v.LastModified)
+	}
+	return headers
+}
+
+// FetchCache holds what is needed to fetch the ORD documents of an application conditionally -
+// the well-known configuration and the validators of the configuration and of the documents, keyed by their URL
+type FetchCache struct {
+	Config     *WellKnownConfig      `json:"config,omitempty"`
+	Validators map[string]Validators `json:"validators,omitempty"`
+}
+
+func newFetchCache() *FetchCache {
+	return &FetchCache{
+		Validators: make(map[string]Validators),
+	}
+}
+
+func (c *FetchCache) validators(url string) Validators {
+	if c == nil {
+		return Validators{}
+	}
+	return c.Validators[url]
+}
+
+func (c *FetchCache) setValidators(url string, validators Validators) {
+	if !validators.empty() {
+		c.Validators[url] = validators
+	}
+}
+
 // Client represents ORD documents client
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Client interface {
-	FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (Documents, string, error)
+	FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *FetchCache) (Documents, string, *FetchCache, error)
 }
 
 type client struct {
@@ -38,62 +99,102 @@ func NewClient(httpClient *http.Client, accessStrategyExecutorProvider accessstr
 	}
 }
 
-// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint
-func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (Documents, string, error) {
-	config, err := c.fetchConfig(ctx, app, webhook)
+type documentToFetch struct {
+	url            string
+	accessStrategy accessstrategy.Type
+	document       *Document
+}
+
+// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint.
+// If a cache from a previous fetch is provided, the requests are conditional and ErrNotModified is returned when nothing changed.
+// Otherwise, the documents are returned together with a cache holding the validators of the fetched resources.
+func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *FetchCache) (Documents, string, *FetchCache, error) {
+	newCache := newFetchCache()
+
+	config, modified, err := c.fetchConfig(ctx, app, webhook, cache, newCache)
 	if err != nil {
-		return nil, "", err
+		return nil, "", nil, err
 	}
 
 	baseURL, err := calculateBaseURL(*webhook.URL, *config)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while calculating baseURL")
+		return nil, "", nil, errors.Wrap(err, "while calculating baseURL")
 	}
 
 	err = config.Validate(baseURL)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while validating ORD config")
+		return nil, "", nil, errors.Wrap(err, "while validating ORD config")
 	}
 
-	docs := make([]*Document, 0)
+	// the documents are secured with the credentials of the ORD webhook, if their access strategy requires any
+	credentials := webhook.Auth.ToAccessStrategyCredentials()
+	documents := make([]*documentToFetch, 0, len(config.OpenResourceDiscoveryV1.Documents))
 	for _, docDetails := range config.OpenResourceDiscoveryV1.Documents {
 		documentURL, err := buildDocumentURL(docDetails.URL, baseURL)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "error building document URL")
+			return nil, "", nil, errors.Wrap(err, "error building document URL")
 		}
 		strategy, ok := docDetails.AccessStrategies.GetSupported()
 		if !ok {
 			log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", documentURL)
 			continue
 		}
-		doc, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy)
-		if err != nil {
-			return nil, "", errors.Wrapf(err, "error fetching ORD document from: %s", documentURL)
+
+		toFetch := &documentToFetch{url: documentURL, accessStrategy: strategy}
+		toFetch.document, err = c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy, credentials, cache.validators(documentURL), newCache)
+		if err != nil && err != ErrNotModified {
+			return nil, "", nil, errors.Wrapf(err, "error fetching ORD document from: %s", documentURL)
+		}
+		if err == nil {
+			modified = true
 		}
 
-		docs = append(docs, doc)
+		documents = append(documents, toFetch)
+	}
+
+	if !modified {
+		log.C(ctx).Info("ORD documents have not been modified since the previous fetch")
+		return nil, "", nil, ErrNotModified
 	}
 
-	return docs, baseURL, nil
+	// all the documents are processed together, so the ones which have not been modified since the previous fetch are needed as well
+	docs := make([]*Document, 0, len(documents))
+	for _, toFetch := range documents {
+		if toFetch.document == nil {
+			toFetch.document, err = c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, toFetch.url, toFetch.accessStrategy, credentials, Validators{}, newCache)
+			if err != nil {
+				return nil, "", nil, errors.Wrapf(err, "error fetching ORD document from: %s", toFetch.url)
+			}
+		}
+		docs = append(docs, toFetch.document)
+	}
+
+	return docs, baseURL, newCache, nil
 }
 
-func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type) (*Document, error) {
+func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, credentials *accessstrategy.Credentials, validators Validators, newCache *FetchCache) (*Document, error) {
 	log.C(ctx).Infof("Fetching ORD Document %q with Access Strategy %q", documentURL, accessStrategy)
 	executor, err := c.accessStrategyExecutorProvider.Provide(accessStrategy)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := executor.Execute(c.Client, documentURL)
+	resp, err := executor.Execute(ctx, c.Client, documentURL, validators.headers(), credentials)
 	if err != nil {
 		return nil, err
 	}
 
 	defer closeBody(ctx, resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified && !validators.empty() {
+		newCache.setValidators(documentURL, validators)
+		return nil, ErrNotModified
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
 	}
+	newCache.setValidators(documentURL, validatorsFromResponse(resp))
 
 	resp.Body = http.MaxBytesReader(nil, resp.Body, 2097152)
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
@@ -113,50 +214,67 @@ func closeBody(ctx context.Context, body io.ReadCloser) {
 	}
 }
 
-func (c *client) fetchConfig(ctx context.Context, app *model.Application, webhook *model.Webhook) (*WellKnownConfig, error) {
+// fetchConfig returns the well-known configuration and whether it was modified since it was cached
+func (c *client) fetchConfig(ctx context.Context, app *model.Application, webhook *model.Webhook, cache, newCache *FetchCache) (*WellKnownConfig, bool, error) {
+	// the configuration is needed even if it was not modified, so the request is conditional only if the configuration is cached
+	var validators Validators
+	if cache != nil && cache.Config != nil {
+		validators = cache.validators(*webhook.URL)
+	}
+	headers := validators.headers()
+
 	var resp *http.Response
 	var err error
 	if webhook.Auth != nil && webhook.Auth.AccessStrategy != nil && len(*webhook.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) ORD webhook is configured with %q access strategy.", app.Name, app.ID, app.Type, *webhook.Auth.AccessStrategy)
 		executor, err := c.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*webhook.Auth.AccessStrategy))
 		if err != nil {
-			return nil, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
+			return nil, false, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
 		}
-		resp, err = executor.Execute(c.Client, *webhook.URL)
+		resp, err = executor.Execute(ctx, c.Client, *webhook.URL, headers, webhook.Auth.ToAccessStrategyCredentials())
 		if err != nil {
-			return nil, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
+			return nil, false, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
 		}
 	} else if webhook.Auth != nil {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) configuration endpoint is secured and webhook credentials will be used", app.Name, app.ID, app.Type)
-		resp, err = httputil.GetRequestWithCredentials(ctx, c.Client, *webhook.URL, webhook.Auth)
+		resp, err = httputil.GetRequestWithCredentials(ctx, c.Client, *webhook.URL, headers, webhook.Auth)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
+			return nil, false, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
 		}
 	} else {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) configuration endpoint is not secured", app.Name, app.ID, app.Type)
-		resp, err = httputil.GetRequestWithoutCredentials(c.Client, *webhook.URL)
+		resp, err = httputil.GetRequestWithoutCredentials(c.Client, *webhook.URL, headers)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
+			return nil, false, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
 		}
 	}
 
 	defer closeBody(ctx, resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified && !validators.empty() {
+		newCache.Config = cache.Config
+		newCache.setValidators(*webhook.URL, validators)
+		return cache.Config, false, nil
+	}
+
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading response body")
+		return nil, false, errors.Wrap(err, "error reading response body")
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d Body: %s", resp.StatusCode, string(bodyBytes))
+		return nil, false, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d Body: %s", resp.StatusCode, string(bodyBytes))
 	}
 
 	config := WellKnownConfig{}
 	if err := json.Unmarshal(bodyBytes, &config); err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling json body")
+		return nil, false, errors.Wrap(err, "error unmarshaling json body")
 	}
 
-	return &config, nil
+	newCache.Config = &config
+	newCache.setValidators(*webhook.URL, validatorsFromResponse(resp))
+
+	return &config, true, nil
 }
 
 func buildDocumentURL(docURL, baseURL string) (string, error) {
@@ -180,7 +180,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				require.NoError(t, err)
 
 				executor := &automock.Executor{}
-				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint).Return(&http.Response{
+				executor.On("Execute", mock.Anything, mock.Anything, baseURL+ord.WellKnownEndpoint, http.Header(nil), &accessstrategy.Credentials{}).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
 				}, nil).Once()
@@ -197,6 +197,44 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			},
 			ExpectedBaseURL: baseURL,
 		},
+		{
+			Name: "Well-known config success fetch with access strategy using the webhook credentials",
+			Credentials: &model.Auth{
+				Credential: model.CredentialData{
+					Basic: &model.BasicCredentialData{
+						Username: "user",
+						Password: "pass",
+					},
+				},
+			},
+			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
+				data, err := json.Marshal(fixWellKnownConfig())
+				require.NoError(t, err)
+
+				credentials := &accessstrategy.Credentials{
+					Basic: &accessstrategy.BasicCredentials{
+						Username: "user",
+						Password: "pass",
+					},
+				}
+				executor := &automock.Executor{}
+				executor.On("Execute", mock.Anything, mock.Anything, baseURL+ord.WellKnownEndpoint, http.Header(nil), credentials).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}, nil).Once()
+
+				executorProvider := &automock.ExecutorProvider{}
+				executorProvider.On("Provide", accessstrategy.BasicAccessStrategy).Return(executor, nil).Once()
+				executorProvider.On("Provide", accessstrategy.OpenAccessStrategy).Return(accessstrategy.NewOpenAccessStrategyExecutor(), nil).Once()
+				return executorProvider
+			},
+			AccessStrategy: string(accessstrategy.BasicAccessStrategy),
+			RoundTripFunc:  successfulRoundTripFunc(t, false, false),
+			ExpectedResult: ord.Documents{
+				fixORDDocument(),
+			},
+			ExpectedBaseURL: baseURL,
+		},
 		{
 			Name: "Well-known config fetch with access strategy fails when access strategy provider returns error",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
@@ -212,7 +250,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			Name: "Well-known config fetch with access strategy fails when access strategy executor returns error",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &automock.Executor{}
-				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint).Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, mock.Anything, baseURL+ord.WellKnownEndpoint, http.Header(nil), &accessstrategy.Credentials{}).Return(nil, testErr).Once()
 
 				executorProvider := &automock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -390,7 +428,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				testWebhook.Auth.AccessStrategy = &test.AccessStrategy
 			}
 
-			docs, actualBaseURL, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook)
+			docs, actualBaseURL, _, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook, nil)
 
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -408,3 +446,85 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_FetchOpenResourceDiscoveryDocumentsConditionally(t *testing.T) {
+	configETag := `"config-etag"`
+	docLastModified := "Mon, 19 Sep 2022 12:00:00 GMT"
+
+	roundTripFunc := func(configModified, docModified bool) func(req *http.Request) *http.Response {
+		return func(req *http.Request) *http.Response {
+			var data []byte
+			var err error
+			header := http.Header{}
+			if strings.Contains(req.URL.String(), ord.WellKnownEndpoint) {
+				if !configModified && req.Header.Get("If-None-Match") == configETag {
+					return &http.Response{StatusCode: http.StatusNotModified, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+				}
+				data, err = json.Marshal(fixWellKnownConfig())
+				require.NoError(t, err)
+				header.Set("ETag", configETag)
+			} else if strings.Contains(req.URL.String(), ordDocURI) {
+				if !docModified && req.Header.Get("If-Modified-Since") == docLastModified {
+					return &http.Response{StatusCode: http.StatusNotModified, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+				}
+				data, err = json.Marshal(fixORDDocument())
+				require.NoError(t, err)
+				header.Set("Last-Modified", docLastModified)
+			} else {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: ioutil.NopCloser(bytes.NewBuffer(nil))}
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+			}
+		}
+	}
+
+	testCases := []struct {
+		Name           string
+		ConfigModified bool
+		DocModified    bool
+		ExpectedErr    error
+	}{
+		{
+			Name:        "Returns ErrNotModified when neither the config nor the documents were modified",
+			ExpectedErr: ord.ErrNotModified,
+		},
+		{
+			Name:        "Returns all documents when only the documents were modified",
+			DocModified: true,
+		},
+		{
+			Name:           "Returns all documents when only the config was modified",
+			ConfigModified: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			testApp := fixApplicationPage().Data[0]
+			testWebhook := fixWebhooks()[0]
+			testWebhook.URL = str.Ptr(baseURL + ord.WellKnownEndpoint)
+			executorProvider := accessstrategy.NewDefaultExecutorProvider(certloader.NewCertificateCache())
+
+			client := ord.NewClient(NewTestClient(roundTripFunc(test.ConfigModified, test.DocModified)), executorProvider)
+
+			docs, _, cache, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook, nil)
+			require.NoError(t, err)
+			require.Equal(t, ord.Documents{fixORDDocument()}, docs)
+			require.Equal(t, configETag, cache.Validators[baseURL+ord.WellKnownEndpoint].ETag)
+			require.Equal(t, docLastModified, cache.Validators[baseURL+ordDocURI].LastModified)
+
+			docs, _, newCache, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook, cache)
+			if test.ExpectedErr != nil {
+				require.Equal(t, test.ExpectedErr, err)
+				require.Nil(t, newCache)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, ord.Documents{fixORDDocument()}, docs)
+			require.Equal(t, cache, newCache)
+		})
+	}
+}
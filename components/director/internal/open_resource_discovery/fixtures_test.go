@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 
@@ -575,6 +576,35 @@ func fixWebhooks() []*model.Webhook {
 	}
 }
 
+func fixWebhookWithBasicCredentials() *model.Webhook {
+	webhook := fixWebhooks()[0]
+	webhook.Auth = &model.Auth{
+		Credential: model.CredentialData{
+			Basic: &model.BasicCredentialData{
+				Username: "user",
+				Password: "pass",
+			},
+		},
+	}
+	return webhook
+}
+
+// fixResourceDefinitionsSecuredWithBasicCredentials returns a copy of the given resource definitions, the first of which is secured with the basic access strategy
+func fixResourceDefinitionsSecuredWithBasicCredentials(resourceDefinitions []*model.APIResourceDefinition) []*model.APIResourceDefinition {
+	secured := make([]*model.APIResourceDefinition, 0, len(resourceDefinitions))
+	for _, rd := range resourceDefinitions {
+		rdCopy := *rd
+		secured = append(secured, &rdCopy)
+	}
+	secured[0].AccessStrategy = []accessstrategy.AccessStrategy{
+		{
+			Type:       accessstrategy.CustomAccessStrategy,
+			CustomType: accessstrategy.BasicAccessStrategy,
+		},
+	}
+	return secured
+}
+
 func fixVendors() []*model.Vendor {
 	return []*model.Vendor{
 		{
@@ -1161,6 +1191,32 @@ func fixGlobalRegistryORDDocument() *ord.Document {
 	}
 }
 
+func fixScheduleConfig() ord.ScheduleConfig {
+	return ord.ScheduleConfig{
+		SyncInterval: time.Minute,
+		MaxBackoff:   time.Hour,
+	}
+}
+
+func fixFetchCache() *ord.FetchCache {
+	return &ord.FetchCache{
+		Config: fixWellKnownConfig(),
+		Validators: map[string]ord.Validators{
+			baseURL + ord.WellKnownEndpoint: {ETag: `"config-etag"`},
+			baseURL + ordDocURI:             {LastModified: "Mon, 19 Sep 2022 12:00:00 GMT"},
+		},
+	}
+}
+
+func fixAggregationSchedule(consecutiveFailures int) *ord.AggregationSchedule {
+	return &ord.AggregationSchedule{
+		ApplicationID:       appID,
+		NextDueAt:           time.Now().Add(-time.Minute),
+		ConsecutiveFailures: consecutiveFailures,
+		FetchCache:          fixFetchCache(),
+	}
+}
+
 func removeWhitespace(s string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(strings.ReplaceAll(s, " ", ""), "\n", ""), "\t", "")
 }
@@ -53,10 +53,10 @@ func (s *globalRegistryService) SyncGlobalResources(ctx context.Context) (map[st
 			ID: "global-registry",
 		},
 	}
-	documents, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, &model.Webhook{
+	documents, _, _, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, &model.Webhook{
 		Type: model.WebhookTypeOpenResourceDiscovery,
 		URL:  &s.config.URL,
-	})
+	}, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while fetching global registry documents from %s", s.config.URL)
 	}
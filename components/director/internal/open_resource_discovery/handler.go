@@ -0,0 +1,115 @@
+package ord
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// JobScheduler schedules on-demand aggregation jobs and keeps track of their outcome
+//go:generate mockery --name=JobScheduler --output=automock --outpkg=automock --case=underscore --disable-version-string
+type JobScheduler interface {
+	Schedule(appID string) (AggregationJob, <-chan struct{}, error)
+	Get(appID string) (AggregationJob, bool)
+}
+
+// HandlerConfig contains configuration for the on-demand aggregation handler
+type HandlerConfig struct {
+	AggregateEndpoint string        `envconfig:"default=/v1/aggregate/{appId},APP_AGGREGATE_ENDPOINT"`
+	AppIDPathParam    string        `envconfig:"default=appId,APP_AGGREGATE_APP_ID_PATH_PARAM"`
+	ResponseTimeout   time.Duration `envconfig:"default=30s,APP_AGGREGATE_RESPONSE_TIMEOUT"`
+}
+
+type handler struct {
+	scheduler JobScheduler
+	config    HandlerConfig
+}
+
+// NewAggregationHTTPHandler returns a new HTTP handler, responsible for on-demand aggregation of the ORD documents of single applications.
+func NewAggregationHTTPHandler(scheduler JobScheduler, config HandlerConfig) *handler {
+	return &handler{
+		scheduler: scheduler,
+		config:    config,
+	}
+}
+
+// ScheduleAggregation queues the application for aggregation and waits for the outcome of the job until the response timeout elapses.
+// A finished job is reported with 200 OK, and a job which is still queued or running with 202 Accepted.
+func (h *handler) ScheduleAggregation(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	appID, ok := h.appIDFromRequest(ctx, writer, request)
+	if !ok {
+		return
+	}
+
+	job, done, err := h.scheduler.Schedule(appID)
+	if err != nil {
+		if err == ErrJobQueueFull {
+			httputils.RespondWithError(ctx, writer, http.StatusServiceUnavailable, err)
+			return
+		}
+		httputils.RespondWithError(ctx, writer, http.StatusInternalServerError, errors.Errorf("failed to schedule aggregation of app with id %q", appID))
+		return
+	}
+
+	log.C(ctx).Infof("Aggregation of app with id %q is %s", appID, job.Status)
+
+	timer := time.NewTimer(h.config.ResponseTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		if finished, ok := h.scheduler.Get(appID); ok {
+			job = finished
+		}
+	case <-timer.C:
+		job, _ = h.scheduler.Get(appID)
+	case <-ctx.Done():
+		log.C(ctx).Infof("Request cancelled while waiting for aggregation of app with id %q", appID)
+		return
+	}
+
+	respondWithJob(ctx, writer, job)
+}
+
+// GetAggregation reports the outcome of the latest aggregation job of the application
+func (h *handler) GetAggregation(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	appID, ok := h.appIDFromRequest(ctx, writer, request)
+	if !ok {
+		return
+	}
+
+	job, ok := h.scheduler.Get(appID)
+	if !ok {
+		httputils.RespondWithError(ctx, writer, http.StatusNotFound, errors.Errorf("aggregation of app with id %q was not scheduled", appID))
+		return
+	}
+
+	respondWithJob(ctx, writer, job)
+}
+
+func (h *handler) appIDFromRequest(ctx context.Context, writer http.ResponseWriter, request *http.Request) (string, bool) {
+	appID := mux.Vars(request)[h.config.AppIDPathParam]
+	if _, err := uuid.Parse(appID); err != nil {
+		httputils.RespondWithError(ctx, writer, http.StatusBadRequest, errors.Errorf("invalid app id %q", appID))
+		return "", false
+	}
+	return appID, true
+}
+
+func respondWithJob(ctx context.Context, writer http.ResponseWriter, job AggregationJob) {
+	status := http.StatusAccepted
+	if job.Finished() {
+		status = http.StatusOK
+	}
+	httputils.RespondWithBody(ctx, writer, status, job)
+}
@@ -0,0 +1,181 @@
+package ord_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ScheduleAggregation(t *testing.T) {
+	const (
+		applicationID = "ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1"
+		endpoint      = "/v1/aggregate/{appId}"
+	)
+
+	config := ord.HandlerConfig{
+		AggregateEndpoint: endpoint,
+		AppIDPathParam:    "appId",
+		ResponseTimeout:   10 * time.Millisecond,
+	}
+
+	closedChannel := func() <-chan struct{} {
+		done := make(chan struct{})
+		close(done)
+		return done
+	}
+
+	testCases := []struct {
+		Name               string
+		AppID              string
+		SchedulerFn        func() *automock.JobScheduler
+		ExpectedStatusCode int
+		ExpectedBody       string
+	}{
+		{
+			Name:  "Responds with the outcome of the finished job",
+			AppID: applicationID,
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Schedule", applicationID).Return(ord.AggregationJob{ApplicationID: applicationID, Status: ord.JobStatusQueued}, closedChannel(), nil).Once()
+				scheduler.On("Get", applicationID).Return(ord.AggregationJob{ApplicationID: applicationID, Status: ord.JobStatusFailed, Error: "test error"}, true).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody:       `{"applicationID":"ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1","status":"FAILED","error":"test error"}`,
+		},
+		{
+			Name:  "Responds with accepted when the job does not finish in time",
+			AppID: applicationID,
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Schedule", applicationID).Return(ord.AggregationJob{ApplicationID: applicationID, Status: ord.JobStatusQueued}, make(<-chan struct{}), nil).Once()
+				scheduler.On("Get", applicationID).Return(ord.AggregationJob{ApplicationID: applicationID, Status: ord.JobStatusRunning}, true).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusAccepted,
+			ExpectedBody:       `{"applicationID":"ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1","status":"RUNNING"}`,
+		},
+		{
+			Name:  "Responds with service unavailable when the queue is full",
+			AppID: applicationID,
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Schedule", applicationID).Return(ord.AggregationJob{}, nil, ord.ErrJobQueueFull).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedBody:       `{"errors":[{"message":"aggregation job queue is full"}]}`,
+		},
+		{
+			Name:  "Responds with internal server error when scheduling fails",
+			AppID: applicationID,
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Schedule", applicationID).Return(ord.AggregationJob{}, nil, errors.New("test error")).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedBody:       `{"errors":[{"message":"failed to schedule aggregation of app with id \"ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1\""}]}`,
+		},
+		{
+			Name:               "Responds with bad request when the application ID is invalid",
+			AppID:              "invalid",
+			SchedulerFn:        func() *automock.JobScheduler { return &automock.JobScheduler{} },
+			ExpectedStatusCode: http.StatusBadRequest,
+			ExpectedBody:       `{"errors":[{"message":"invalid app id \"invalid\""}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scheduler := testCase.SchedulerFn()
+			defer mock.AssertExpectationsForObjects(t, scheduler)
+
+			handler := ord.NewAggregationHTTPHandler(scheduler, config)
+			router := mux.NewRouter()
+			router.HandleFunc(endpoint, handler.ScheduleAggregation).Methods(http.MethodPost)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/aggregate/"+testCase.AppID, nil)
+			w := httptest.NewRecorder()
+
+			// WHEN
+			router.ServeHTTP(w, req)
+
+			// THEN
+			resp := w.Result()
+			require.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+			assert.JSONEq(t, testCase.ExpectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_GetAggregation(t *testing.T) {
+	const (
+		applicationID = "ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1"
+		endpoint      = "/v1/aggregate/{appId}"
+	)
+
+	config := ord.HandlerConfig{
+		AggregateEndpoint: endpoint,
+		AppIDPathParam:    "appId",
+	}
+
+	testCases := []struct {
+		Name               string
+		SchedulerFn        func() *automock.JobScheduler
+		ExpectedStatusCode int
+		ExpectedBody       string
+	}{
+		{
+			Name: "Responds with the latest job",
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Get", applicationID).Return(ord.AggregationJob{ApplicationID: applicationID, Status: ord.JobStatusSucceeded}, true).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedBody:       `{"applicationID":"ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1","status":"SUCCEEDED"}`,
+		},
+		{
+			Name: "Responds with not found when the application was not scheduled",
+			SchedulerFn: func() *automock.JobScheduler {
+				scheduler := &automock.JobScheduler{}
+				scheduler.On("Get", applicationID).Return(ord.AggregationJob{}, false).Once()
+				return scheduler
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectedBody:       `{"errors":[{"message":"aggregation of app with id \"ab4f0ce4-d1ab-4f39-a1e4-2d0d6d9bf2b1\" was not scheduled"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scheduler := testCase.SchedulerFn()
+			defer mock.AssertExpectationsForObjects(t, scheduler)
+
+			handler := ord.NewAggregationHTTPHandler(scheduler, config)
+			router := mux.NewRouter()
+			router.HandleFunc(endpoint, handler.GetAggregation).Methods(http.MethodGet)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/aggregate/"+applicationID, nil)
+			w := httptest.NewRecorder()
+
+			// WHEN
+			router.ServeHTTP(w, req)
+
+			// THEN
+			resp := w.Result()
+			require.Equal(t, testCase.ExpectedStatusCode, resp.StatusCode)
+			assert.JSONEq(t, testCase.ExpectedBody, w.Body.String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ord
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -23,7 +24,7 @@ type WebhookService interface {
 // ApplicationService is responsible for the service-layer Application operations.
 //go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore --disable-version-string
 type ApplicationService interface {
-	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
+	Get(ctx context.Context, id string) (*model.Application, error)
 	GetForUpdate(ctx context.Context, id string) (*model.Application, error)
 }
 
@@ -129,3 +130,23 @@ type TombstoneService interface {
 type TenantService interface {
 	GetLowestOwnerForResource(ctx context.Context, resourceType resource.Type, objectID string) (string, error)
 }
+
+// ScheduleRepository is responsible for the repo-layer operations on the aggregation schedule of the applications.
+//go:generate mockery --name=ScheduleRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ScheduleRepository interface {
+	ListDueApplicationIDs(ctx context.Context, now time.Time) ([]string, error)
+	Get(ctx context.Context, appID string) (*AggregationSchedule, error)
+	Upsert(ctx context.Context, schedule *AggregationSchedule) error
+}
+
+// MetricsRecorder records the outcome of the aggregation of single applications.
+//go:generate mockery --name=MetricsRecorder --output=automock --outpkg=automock --case=underscore --disable-version-string
+type MetricsRecorder interface {
+	RecordApplicationAggregation(appID string, result AggregationResult, duration time.Duration, consecutiveFailures int)
+}
+
+// ORDAggregationReportService is responsible for storing the reports of the aggregation of the applications.
+//go:generate mockery --name=ORDAggregationReportService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ORDAggregationReportService interface {
+	Upsert(ctx context.Context, report *model.ORDAggregationReport) error
+}
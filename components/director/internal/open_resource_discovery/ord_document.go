@@ -101,7 +101,7 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 	)
 	for _, doc := range docs {
 		if !isBaseURLConfigured && (doc.DescribedSystemInstance == nil || doc.DescribedSystemInstance.BaseURL == nil) {
-			errs = multierror.Append(errs, errors.New("no baseURL was provided neither from /well-known URL, nor from config, nor from describedSystemInstance"))
+			errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeDocument, "", RuleMissingBaseURL, "describedSystemInstance.baseUrl", "no baseURL was provided neither from /well-known URL, nor from config, nor from describedSystemInstance"))
 			continue
 		}
 
@@ -111,11 +111,11 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 
 		if doc.DescribedSystemInstance != nil {
 			if err := ValidateSystemInstanceInput(doc.DescribedSystemInstance); err != nil {
-				errs = multierror.Append(errs, errors.Wrap(err, "error validating system instance"))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeDocument, "", "describedSystemInstance", err, "error validating system instance"))
 			}
 		}
 		if doc.DescribedSystemInstance != nil && doc.DescribedSystemInstance.BaseURL != nil && *doc.DescribedSystemInstance.BaseURL != baseURL {
-			errs = multierror.Append(errs, errors.New("describedSystemInstance should be the same as the one providing the documents"))
+			errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeDocument, "", RuleSystemInstanceMismatch, "describedSystemInstance.baseUrl", "describedSystemInstance should be the same as the one providing the documents"))
 		}
 	}
 
@@ -130,7 +130,7 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 	for _, doc := range docs {
 		for _, pkg := range doc.Packages {
 			if _, ok := packageIDs[pkg.OrdID]; ok {
-				errs = multierror.Append(errs, errors.Errorf("found duplicate package with ord id %q", pkg.OrdID))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypePackage, pkg.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate package with ord id %q", pkg.OrdID))
 				continue
 			}
 			packageIDs[pkg.OrdID] = true
@@ -140,68 +140,68 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 
 	for _, doc := range docs {
 		if err := validateDocumentInput(doc); err != nil {
-			errs = multierror.Append(errs, errors.Wrap(err, "error validating document"))
+			errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeDocument, "", "", err, "error validating document"))
 		}
 
 		for _, pkg := range doc.Packages {
 			if err := validatePackageInput(pkg, packagesFromDB, resourceHashes); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating package with ord id %q", pkg.OrdID))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypePackage, pkg.OrdID, "", err, "error validating package with ord id %q", pkg.OrdID))
 			}
 		}
 		for _, bndl := range doc.ConsumptionBundles {
 			if err := validateBundleInput(bndl); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating bundle with ord id %q", stringPtrToString(bndl.OrdID)))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeConsumptionBundle, stringPtrToString(bndl.OrdID), "", err, "error validating bundle with ord id %q", stringPtrToString(bndl.OrdID)))
 			}
 			if bndl.OrdID != nil {
 				if _, ok := bundleIDs[*bndl.OrdID]; ok {
-					errs = multierror.Append(errs, errors.Errorf("found duplicate bundle with ord id %q", *bndl.OrdID))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeConsumptionBundle, *bndl.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate bundle with ord id %q", *bndl.OrdID))
 				}
 				bundleIDs[*bndl.OrdID] = true
 			}
 		}
 		for _, product := range doc.Products {
 			if err := validateProductInput(product); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating product with ord id %q", product.OrdID))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeProduct, product.OrdID, "", err, "error validating product with ord id %q", product.OrdID))
 			}
 			if _, ok := productIDs[product.OrdID]; ok {
-				errs = multierror.Append(errs, errors.Errorf("found duplicate product with ord id %q", product.OrdID))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeProduct, product.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate product with ord id %q", product.OrdID))
 			}
 			productIDs[product.OrdID] = true
 		}
 		for _, api := range doc.APIResources {
 			if err := validateAPIInput(api, packagePolicyLevels, apisFromDB, resourceHashes); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating api with ord id %q", stringPtrToString(api.OrdID)))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeAPI, stringPtrToString(api.OrdID), "", err, "error validating api with ord id %q", stringPtrToString(api.OrdID)))
 			}
 			if api.OrdID != nil {
 				if _, ok := apiIDs[*api.OrdID]; ok {
-					errs = multierror.Append(errs, errors.Errorf("found duplicate api with ord id %q", *api.OrdID))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeAPI, *api.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate api with ord id %q", *api.OrdID))
 				}
 				apiIDs[*api.OrdID] = true
 			}
 		}
 		for _, event := range doc.EventResources {
 			if err := validateEventInput(event, packagePolicyLevels, eventsFromDB, resourceHashes); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating event with ord id %q", stringPtrToString(event.OrdID)))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeEvent, stringPtrToString(event.OrdID), "", err, "error validating event with ord id %q", stringPtrToString(event.OrdID)))
 			}
 			if event.OrdID != nil {
 				if _, ok := eventIDs[*event.OrdID]; ok {
-					errs = multierror.Append(errs, errors.Errorf("found duplicate event with ord id %q", *event.OrdID))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeEvent, *event.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate event with ord id %q", *event.OrdID))
 				}
 				eventIDs[*event.OrdID] = true
 			}
 		}
 		for _, vendor := range doc.Vendors {
 			if err := validateVendorInput(vendor); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating vendor with ord id %q", vendor.OrdID))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeVendor, vendor.OrdID, "", err, "error validating vendor with ord id %q", vendor.OrdID))
 			}
 			if _, ok := vendorIDs[vendor.OrdID]; ok {
-				errs = multierror.Append(errs, errors.Errorf("found duplicate vendor with ord id %q", vendor.OrdID))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeVendor, vendor.OrdID, RuleDuplicateOrdID, "ordId", "found duplicate vendor with ord id %q", vendor.OrdID))
 			}
 			vendorIDs[vendor.OrdID] = true
 		}
 		for _, tombstone := range doc.Tombstones {
 			if err := validateTombstoneInput(tombstone); err != nil {
-				errs = multierror.Append(errs, errors.Wrapf(err, "error validating tombstone with ord id %q", tombstone.OrdID))
+				errs = multierror.Append(errs, newResourceValidationError(model.ORDResourceTypeTombstone, tombstone.OrdID, "", err, "error validating tombstone with ord id %q", tombstone.OrdID))
 			}
 		}
 	}
@@ -210,28 +210,28 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 	for _, doc := range docs {
 		for _, pkg := range doc.Packages {
 			if pkg.Vendor != nil && !vendorIDs[*pkg.Vendor] && !globalResourcesOrdIDs[*pkg.Vendor] {
-				errs = multierror.Append(errs, errors.Errorf("package with id %q has a reference to unknown vendor %q", pkg.OrdID, *pkg.Vendor))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypePackage, pkg.OrdID, RuleUnknownReference, "vendor", "package with id %q has a reference to unknown vendor %q", pkg.OrdID, *pkg.Vendor))
 			}
 			ordIDs := gjson.ParseBytes(pkg.PartOfProducts).Array()
 			for _, productID := range ordIDs {
 				if !productIDs[productID.String()] && !globalResourcesOrdIDs[productID.String()] {
-					errs = multierror.Append(errs, errors.Errorf("package with id %q has a reference to unknown product %q", pkg.OrdID, productID.String()))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypePackage, pkg.OrdID, RuleUnknownReference, "partOfProducts", "package with id %q has a reference to unknown product %q", pkg.OrdID, productID.String()))
 				}
 			}
 		}
 		for _, product := range doc.Products {
 			if !vendorIDs[product.Vendor] && !globalResourcesOrdIDs[product.Vendor] {
-				errs = multierror.Append(errs, errors.Errorf("product with id %q has a reference to unknown vendor %q", product.OrdID, product.Vendor))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeProduct, product.OrdID, RuleUnknownReference, "vendor", "product with id %q has a reference to unknown vendor %q", product.OrdID, product.Vendor))
 			}
 		}
 		for _, api := range doc.APIResources {
 			if api.OrdPackageID != nil && !packageIDs[*api.OrdPackageID] {
-				errs = multierror.Append(errs, errors.Errorf("api with id %q has a reference to unknown package %q", *api.OrdID, *api.OrdPackageID))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeAPI, *api.OrdID, RuleUnknownReference, "partOfPackage", "api with id %q has a reference to unknown package %q", *api.OrdID, *api.OrdPackageID))
 			}
 			if api.PartOfConsumptionBundles != nil {
 				for _, apiBndlRef := range api.PartOfConsumptionBundles {
 					if !bundleIDs[apiBndlRef.BundleOrdID] {
-						errs = multierror.Append(errs, errors.Errorf("api with id %q has a reference to unknown bundle %q", *api.OrdID, apiBndlRef.BundleOrdID))
+						errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeAPI, *api.OrdID, RuleUnknownReference, "partOfConsumptionBundles", "api with id %q has a reference to unknown bundle %q", *api.OrdID, apiBndlRef.BundleOrdID))
 					}
 				}
 			}
@@ -239,18 +239,18 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 			ordIDs := gjson.ParseBytes(api.PartOfProducts).Array()
 			for _, productID := range ordIDs {
 				if !productIDs[productID.String()] && !globalResourcesOrdIDs[productID.String()] {
-					errs = multierror.Append(errs, errors.Errorf("api with id %q has a reference to unknown product %q", *api.OrdID, productID.String()))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeAPI, *api.OrdID, RuleUnknownReference, "partOfProducts", "api with id %q has a reference to unknown product %q", *api.OrdID, productID.String()))
 				}
 			}
 		}
 		for _, event := range doc.EventResources {
 			if event.OrdPackageID != nil && !packageIDs[*event.OrdPackageID] {
-				errs = multierror.Append(errs, errors.Errorf("event with id %q has a reference to unknown package %q", *event.OrdID, *event.OrdPackageID))
+				errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeEvent, *event.OrdID, RuleUnknownReference, "partOfPackage", "event with id %q has a reference to unknown package %q", *event.OrdID, *event.OrdPackageID))
 			}
 			if event.PartOfConsumptionBundles != nil {
 				for _, eventBndlRef := range event.PartOfConsumptionBundles {
 					if !bundleIDs[eventBndlRef.BundleOrdID] {
-						errs = multierror.Append(errs, errors.Errorf("event with id %q has a reference to unknown bundle %q", *event.OrdID, eventBndlRef.BundleOrdID))
+						errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeEvent, *event.OrdID, RuleUnknownReference, "partOfConsumptionBundles", "event with id %q has a reference to unknown bundle %q", *event.OrdID, eventBndlRef.BundleOrdID))
 					}
 				}
 			}
@@ -258,7 +258,7 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 			ordIDs := gjson.ParseBytes(event.PartOfProducts).Array()
 			for _, productID := range ordIDs {
 				if !productIDs[productID.String()] && !globalResourcesOrdIDs[productID.String()] {
-					errs = multierror.Append(errs, errors.Errorf("event with id %q has a reference to unknown product %q", *event.OrdID, productID.String()))
+					errs = multierror.Append(errs, newResourceRuleError(model.ORDResourceTypeEvent, *event.OrdID, RuleUnknownReference, "partOfProducts", "event with id %q has a reference to unknown product %q", *event.OrdID, productID.String()))
 				}
 			}
 		}
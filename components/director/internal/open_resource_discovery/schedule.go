@@ -0,0 +1,72 @@
+package ord
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// AggregationResult is the outcome of the aggregation of the ORD documents of a single application
+type AggregationResult string
+
+const (
+	// AggregationResultProcessed is the result of an aggregation which fetched and processed the ORD documents
+	AggregationResultProcessed AggregationResult = "processed"
+	// AggregationResultNotModified is the result of an aggregation which skipped the processing as the ORD documents were not modified
+	AggregationResultNotModified AggregationResult = "not_modified"
+	// AggregationResultFailed is the result of an aggregation which failed to fetch or process the ORD documents
+	AggregationResultFailed AggregationResult = "failed"
+)
+
+// ScheduleConfig contains configuration for the scheduling of the aggregation of the applications
+type ScheduleConfig struct {
+	SyncInterval time.Duration `envconfig:"default=1m,APP_ORD_SYNC_INTERVAL"`
+	MaxBackoff   time.Duration `envconfig:"default=6h,APP_ORD_MAX_BACKOFF"`
+}
+
+// AggregationSchedule is the aggregation state of a single application, which determines when its ORD documents are fetched next
+type AggregationSchedule struct {
+	ApplicationID       string
+	NextDueAt           time.Time
+	ConsecutiveFailures int
+	LastError           *string
+	LastAggregatedAt    *time.Time
+	// FetchCache is the cache of the last successfully processed ORD documents
+	FetchCache *FetchCache
+}
+
+func newAggregationSchedule(appID string) *AggregationSchedule {
+	return &AggregationSchedule{
+		ApplicationID: appID,
+	}
+}
+
+// succeeded schedules the next aggregation after the sync interval. The cache is replaced unless it is nil, which is the case when the documents were not modified.
+func (s *AggregationSchedule) succeeded(now time.Time, cache *FetchCache, config ScheduleConfig) {
+	s.ConsecutiveFailures = 0
+	s.LastError = nil
+	s.LastAggregatedAt = &now
+	if cache != nil {
+		s.FetchCache = cache
+	}
+	s.NextDueAt = now.Add(config.SyncInterval)
+}
+
+// failed backs off exponentially from the sync interval up to the max backoff.
+// The cache is kept, so that the documents which failed to be processed are not skipped as not modified on the next aggregation.
+func (s *AggregationSchedule) failed(now time.Time, err error, config ScheduleConfig) {
+	s.ConsecutiveFailures++
+	s.LastError = str.Ptr(err.Error())
+	s.NextDueAt = now.Add(backoff(config, s.ConsecutiveFailures))
+}
+
+func backoff(config ScheduleConfig, failures int) time.Duration {
+	delay := config.SyncInterval
+	for i := 0; i < failures && delay < config.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > config.MaxBackoff {
+		return config.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,135 @@
+package ord
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	scheduleColumns = "app_id, next_due_at, consecutive_failures, last_error, last_aggregated_at, fetch_cache"
+
+	// listDueQuery returns the applications with an ORD webhook which were never aggregated or whose next aggregation is due
+	listDueQuery = `SELECT a.id FROM public.applications a
+		LEFT JOIN public.ord_aggregation_schedule s ON s.app_id = a.id
+		WHERE (s.app_id IS NULL OR s.next_due_at <= $1)
+			AND EXISTS (SELECT 1 FROM public.webhooks w WHERE w.app_id = a.id AND w.type = '` + string(model.WebhookTypeOpenResourceDiscovery) + `')
+		ORDER BY s.next_due_at NULLS FIRST, a.id`
+
+	getScheduleQuery = `SELECT ` + scheduleColumns + ` FROM public.ord_aggregation_schedule WHERE app_id = $1`
+
+	upsertScheduleQuery = `INSERT INTO public.ord_aggregation_schedule (` + scheduleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (app_id) DO UPDATE SET next_due_at = EXCLUDED.next_due_at, consecutive_failures = EXCLUDED.consecutive_failures,
+			last_error = EXCLUDED.last_error, last_aggregated_at = EXCLUDED.last_aggregated_at, fetch_cache = EXCLUDED.fetch_cache`
+)
+
+type scheduleEntity struct {
+	ApplicationID       string         `db:"app_id"`
+	NextDueAt           time.Time      `db:"next_due_at"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	LastError           sql.NullString `db:"last_error"`
+	LastAggregatedAt    sql.NullTime   `db:"last_aggregated_at"`
+	FetchCache          sql.NullString `db:"fetch_cache"`
+}
+
+type scheduleRepository struct{}
+
+// NewScheduleRepository creates a new repository of the aggregation schedule of the applications
+func NewScheduleRepository() *scheduleRepository {
+	return &scheduleRepository{}
+}
+
+// ListDueApplicationIDs returns the IDs of the applications with an ORD webhook whose aggregation is due at the given time, including the ones which were never aggregated
+func (r *scheduleRepository) ListDueApplicationIDs(ctx context.Context, now time.Time) ([]string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var appIDs []string
+	log.C(ctx).Debugf("Executing DB query: %s", listDueQuery)
+	if err := persist.SelectContext(ctx, &appIDs, listDueQuery, now); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.ORDAggregationSchedule, resource.List, "while listing the applications due for aggregation")
+	}
+
+	return appIDs, nil
+}
+
+// Get returns the aggregation schedule of the application or nil if the application was never aggregated
+func (r *scheduleRepository) Get(ctx context.Context, appID string) (*AggregationSchedule, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var ent scheduleEntity
+	log.C(ctx).Debugf("Executing DB query: %s", getScheduleQuery)
+	if err := persist.GetContext(ctx, &ent, getScheduleQuery, appID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, persistence.MapSQLError(ctx, err, resource.ORDAggregationSchedule, resource.Get, "while getting the aggregation schedule of app with ID %s", appID)
+	}
+
+	return scheduleFromEntity(ent)
+}
+
+// Upsert stores the aggregation schedule of the application
+func (r *scheduleRepository) Upsert(ctx context.Context, schedule *AggregationSchedule) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	fetchCache := sql.NullString{}
+	if schedule.FetchCache != nil {
+		marshalled, err := json.Marshal(schedule.FetchCache)
+		if err != nil {
+			return errors.Wrapf(err, "while marshalling the fetch cache of app with ID %s", schedule.ApplicationID)
+		}
+		fetchCache = repo.NewValidNullableString(string(marshalled))
+	}
+
+	lastAggregatedAt := sql.NullTime{}
+	if schedule.LastAggregatedAt != nil {
+		lastAggregatedAt = sql.NullTime{Time: *schedule.LastAggregatedAt, Valid: true}
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", upsertScheduleQuery)
+	_, err = persist.ExecContext(ctx, upsertScheduleQuery, schedule.ApplicationID, schedule.NextDueAt, schedule.ConsecutiveFailures,
+		repo.NewNullableString(schedule.LastError), lastAggregatedAt, fetchCache)
+	return persistence.MapSQLError(ctx, err, resource.ORDAggregationSchedule, resource.Upsert, "while upserting the aggregation schedule of app with ID %s", schedule.ApplicationID)
+}
+
+func scheduleFromEntity(ent scheduleEntity) (*AggregationSchedule, error) {
+	var fetchCache *FetchCache
+	if ent.FetchCache.Valid {
+		fetchCache = &FetchCache{}
+		if err := json.Unmarshal([]byte(ent.FetchCache.String), fetchCache); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling the fetch cache of app with ID %s", ent.ApplicationID)
+		}
+	}
+
+	var lastAggregatedAt *time.Time
+	if ent.LastAggregatedAt.Valid {
+		lastAggregatedAt = &ent.LastAggregatedAt.Time
+	}
+
+	return &AggregationSchedule{
+		ApplicationID:       ent.ApplicationID,
+		NextDueAt:           ent.NextDueAt,
+		ConsecutiveFailures: ent.ConsecutiveFailures,
+		LastError:           repo.StringPtrFromNullableString(ent.LastError),
+		LastAggregatedAt:    lastAggregatedAt,
+		FetchCache:          fetchCache,
+	}, nil
+}
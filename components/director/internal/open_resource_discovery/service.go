@@ -4,7 +4,9 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
@@ -23,12 +25,14 @@ const applicationTypeLabel = "applicationType"
 // ServiceConfig contains configuration for the ORD aggregator service
 type ServiceConfig struct {
 	maxParallelApplicationProcessors int
+	schedule                         ScheduleConfig
 }
 
 // NewServiceConfig creates new ServiceConfig from the supplied parameters
-func NewServiceConfig(maxParallelApplicationProcessors int) ServiceConfig {
+func NewServiceConfig(maxParallelApplicationProcessors int, schedule ScheduleConfig) ServiceConfig {
 	return ServiceConfig{
 		maxParallelApplicationProcessors: maxParallelApplicationProcessors,
+		schedule:                         schedule,
 	}
 }
 
@@ -38,7 +42,9 @@ type Service struct {
 
 	transact persistence.Transactioner
 
-	labelRepo labelRepository
+	labelRepo    labelRepository
+	scheduleRepo ScheduleRepository
+	reportSvc    ORDAggregationReportService
 
 	appSvc             ApplicationService
 	webhookSvc         WebhookService
@@ -55,15 +61,19 @@ type Service struct {
 
 	globalRegistrySvc GlobalRegistryService
 	ordClient         Client
+
+	metricsRecorder MetricsRecorder
 }
 
-// NewAggregatorService returns a new object responsible for service-layer ORD operations.
-func NewAggregatorService(config ServiceConfig, transact persistence.Transactioner, labelRepo labelRepository, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client) *Service {
+// NewAggregatorService returns a new object responsible for service-layer ORD operations. The metrics recorder is optional and may be nil.
+func NewAggregatorService(config ServiceConfig, transact persistence.Transactioner, labelRepo labelRepository, scheduleRepo ScheduleRepository, reportSvc ORDAggregationReportService, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, globalRegistrySvc GlobalRegistryService, client Client, metricsRecorder MetricsRecorder) *Service {
 	return &Service{
 		config:             config,
 		transact:           transact,
 		appSvc:             appSvc,
 		labelRepo:          labelRepo,
+		scheduleRepo:       scheduleRepo,
+		reportSvc:          reportSvc,
 		webhookSvc:         webhookSvc,
 		bundleSvc:          bundleSvc,
 		bundleReferenceSvc: bundleReferenceSvc,
@@ -77,10 +87,11 @@ func NewAggregatorService(config ServiceConfig, transact persistence.Transaction
 		tenantSvc:          tenantSvc,
 		globalRegistrySvc:  globalRegistrySvc,
 		ordClient:          client,
+		metricsRecorder:    metricsRecorder,
 	}
 }
 
-// SyncORDDocuments performs resync of ORD information provided via ORD documents for each application
+// SyncORDDocuments performs resync of ORD information provided via ORD documents for each application which is due for aggregation according to its schedule
 func (s *Service) SyncORDDocuments(ctx context.Context) error {
 	globalResourcesOrdIDs, err := s.globalRegistrySvc.SyncGlobalResources(ctx)
 	if err != nil {
@@ -95,13 +106,13 @@ func (s *Service) SyncORDDocuments(ctx context.Context) error {
 		globalResourcesOrdIDs = make(map[string]bool)
 	}
 
-	pageCount := 1
-	pageSize := 200
-
-	pageCursor := ""
-	hasNextPage := true
+	appIDs, err := s.listDueApplicationIDs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the applications due for aggregation")
+	}
+	log.C(ctx).Infof("%d applications are due for aggregation", len(appIDs))
 
-	queue := make(chan *model.Application)
+	queue := make(chan string)
 	appErrors := int32(0)
 
 	wg := &sync.WaitGroup{}
@@ -112,89 +123,197 @@ func (s *Service) SyncORDDocuments(ctx context.Context) error {
 		go func() {
 			defer wg.Done()
 
-			for app := range queue {
-				if err := s.processApp(ctx, app, globalResourcesOrdIDs); err != nil {
-					log.C(ctx).WithError(err).Errorf("error while processing app %q", app.ID)
+			for appID := range queue {
+				if err := s.processApp(ctx, appID, globalResourcesOrdIDs, false); err != nil {
+					if _, ok := err.(*documentsError); ok {
+						continue
+					}
+					log.C(ctx).WithError(err).Errorf("error while processing app %q", appID)
 					atomic.AddInt32(&appErrors, 1)
 				}
 			}
 		}()
 	}
 
-	var fetchErr error = nil
-	for hasNextPage {
-		page, err := s.listAppPage(ctx, pageSize, pageCursor)
-		if err != nil {
-			log.C(ctx).WithError(err).Errorf("error while fetching application page number %d", pageCount)
-			fetchErr = err
-			break
-		}
-
-		for _, app := range page.Data {
-			queue <- app
-		}
-
-		pageCursor = page.PageInfo.EndCursor
-		hasNextPage = page.PageInfo.HasNextPage
-		pageCount++
+	for _, appID := range appIDs {
+		queue <- appID
 	}
 
 	close(queue)
 	wg.Wait()
 
-	if fetchErr != nil && appErrors != 0 {
-		return errors.Wrapf(fetchErr, "failed to process %d applications and failed to fetch the next application page", appErrors)
-	} else if appErrors != 0 {
+	if appErrors != 0 {
 		return errors.Errorf("failed to process %d applications", appErrors)
-	} else if fetchErr != nil {
-		return errors.Wrapf(fetchErr, "failed to fetch the next application page")
 	}
 	return nil
 }
 
-func (s *Service) listAppPage(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (s *Service) listDueApplicationIDs(ctx context.Context) ([]string, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
 		return nil, err
 	}
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
 	ctx = persistence.SaveToContext(ctx, tx)
-	page, err := s.appSvc.ListGlobal(ctx, pageSize, cursor)
+
+	appIDs, err := s.scheduleRepo.ListDueApplicationIDs(ctx, time.Now())
 	if err != nil {
 		return nil, err
 	}
 
-	if len(page.Data) > 0 {
-		applicationIDs := make([]string, 0, page.TotalCount)
-		for _, app := range page.Data {
-			applicationIDs = append(applicationIDs, app.ID)
-		}
+	return appIDs, tx.Commit()
+}
 
-		labels, err := s.labelRepo.ListGlobalByKeyAndObjects(ctx, model.ApplicationLabelableObject, applicationIDs, applicationTypeLabel)
-		if err != nil {
-			return nil, err
+// ProcessApplication performs resync of ORD information provided via ORD documents for a single application.
+// Unlike SyncORDDocuments, it reports the failures to fetch or process the documents of the application.
+func (s *Service) ProcessApplication(ctx context.Context, appID string) error {
+	globalResourcesOrdIDs, err := s.globalRegistrySvc.ListGlobalResources(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error while listing existing global resource: %s. Proceeding with empty globalResourceOrdIDs... Validation of Documents relying on global resources might fail.", err)
+	}
+
+	if globalResourcesOrdIDs == nil {
+		globalResourcesOrdIDs = make(map[string]bool)
+	}
+
+	if err := s.processApp(ctx, appID, globalResourcesOrdIDs, true); err != nil {
+		if docErr, ok := err.(*documentsError); ok {
+			return docErr.error
 		}
+		return err
+	}
+	return nil
+}
+
+// processApp aggregates the ORD documents of the application, schedules its next aggregation and stores the report of the aggregation.
+// Unless forced, the documents are fetched conditionally and are not processed if they were not modified since the last successful aggregation.
+// In that case the report of the last aggregation is kept, as it still describes the documents.
+func (s *Service) processApp(ctx context.Context, appID string, globalResourcesOrdIDs map[string]bool, force bool) error {
+	start := time.Now()
+
+	app, schedule, err := s.getApplication(ctx, appID)
+	if err != nil {
+		return err
+	}
+
+	var cache *FetchCache
+	if !force {
+		cache = schedule.FetchCache
+	}
+
+	report := &model.ORDAggregationReport{
+		ApplicationID: appID,
+		StartedAt:     start,
+	}
+
+	cache, err = s.syncApp(ctx, app, cache, globalResourcesOrdIDs, report)
+
+	now := time.Now()
+	result := AggregationResultProcessed
+	switch {
+	case err == ErrNotModified:
+		result = AggregationResultNotModified
+		schedule.succeeded(now, nil, s.config.schedule)
+		report = nil
+		err = nil
+	case err != nil:
+		result = AggregationResultFailed
+		schedule.failed(now, err, s.config.schedule)
+		// The changes of a failed aggregation are rolled back, including the deletions of the tombstoned resources
+		report.AppliedTombstones = nil
+		report.Finish(err, now)
+	default:
+		schedule.succeeded(now, cache, s.config.schedule)
+		report.Finish(nil, now)
+	}
+
+	if saveErr := s.saveResults(ctx, schedule, report); saveErr != nil {
+		log.C(ctx).WithError(saveErr).Errorf("Failed to save the aggregation schedule and report of app with id %q: %v", appID, saveErr)
+	}
+
+	if s.metricsRecorder != nil {
+		s.metricsRecorder.RecordApplicationAggregation(appID, result, now.Sub(start), schedule.ConsecutiveFailures)
+	}
+
+	return err
+}
+
+// saveResults stores the aggregation schedule of the application along with the report of the aggregation, if there is one
+func (s *Service) saveResults(ctx context.Context, schedule *AggregationSchedule, report *model.ORDAggregationReport) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.scheduleRepo.Upsert(ctx, schedule); err != nil {
+		return err
+	}
 
-		appLabelsMap := make(map[string]interface{}, page.TotalCount)
-		for i := range labels {
-			appLabelsMap[labels[i].ObjectID] = labels[i].Value
+	if report != nil {
+		if err := s.reportSvc.Upsert(ctx, report); err != nil {
+			return err
 		}
+	}
 
-		for i := range page.Data {
-			appType, ok := appLabelsMap[page.Data[i].ID].(string)
-			if ok {
-				page.Data[i].Type = appType
-			}
+	return tx.Commit()
+}
+
+func (s *Service) getApplication(ctx context.Context, appID string) (*model.Application, *AggregationSchedule, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tnt, err := s.tenantSvc.GetLowestOwnerForResource(ctx, resource.Application, appID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting owner of app with id %q", appID)
+	}
+
+	ctx = tenant.SaveToContext(ctx, tnt, "")
+
+	app, err := s.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting app with id %q", appID)
+	}
+
+	labels, err := s.labelRepo.ListGlobalByKeyAndObjects(ctx, model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, l := range labels {
+		if appType, ok := l.Value.(string); ok {
+			app.Type = appType
 		}
 	}
 
-	return page, tx.Commit()
+	schedule, err := s.scheduleRepo.Get(ctx, appID)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while getting the aggregation schedule of app with id %q", appID)
+	}
+	if schedule == nil {
+		schedule = newAggregationSchedule(appID)
+	}
+
+	return app, schedule, tx.Commit()
+}
+
+// documentsError is returned when the ORD documents of an application could not be fetched or processed.
+// The periodic aggregation logs it and proceeds with the next application.
+type documentsError struct {
+	error
 }
 
-func (s *Service) processApp(ctx context.Context, app *model.Application, globalResourcesOrdIDs map[string]bool) error {
+// syncApp fetches and processes the ORD documents of the application and records the outcome in the given report.
+// It returns the cache of the fetched documents or ErrNotModified if they were not modified since the given cache was returned.
+func (s *Service) syncApp(ctx context.Context, app *model.Application, cache *FetchCache, globalResourcesOrdIDs map[string]bool, report *model.ORDAggregationReport) (*FetchCache, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
@@ -203,44 +322,55 @@ func (s *Service) processApp(ctx context.Context, app *model.Application, global
 
 	tnt, err := s.tenantSvc.GetLowestOwnerForResource(ctx, resource.Application, app.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ctx = tenant.SaveToContext(ctx, tnt, "")
 
 	if _, err := s.appSvc.GetForUpdate(ctx, app.ID); err != nil {
-		return errors.Wrapf(err, "error while locking app with id %q for update", app.ID)
+		return nil, errors.Wrapf(err, "error while locking app with id %q for update", app.ID)
 	}
 
 	webhooks, err := s.webhookSvc.ListForApplicationWithSelectForUpdate(ctx, app.ID)
 	if err != nil {
-		return errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
+		return nil, errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
 	}
-	var documents Documents
-	var baseURL string
+
+	var ordWebhook *model.Webhook
 	for _, wh := range webhooks {
 		if wh.Type == model.WebhookTypeOpenResourceDiscovery && wh.URL != nil {
-			ctx = addFieldToLogger(ctx, "app_id", app.ID)
-			documents, baseURL, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, wh)
-			if err != nil {
-				log.C(ctx).WithError(err).Errorf("error fetching ORD document for webhook with id %q: %v", wh.ID, err)
-			}
+			ordWebhook = wh
 			break
 		}
 	}
+	if ordWebhook == nil {
+		return nil, &documentsError{errors.Errorf("app with id %q has no %s webhook", app.ID, model.WebhookTypeOpenResourceDiscovery)}
+	}
+
+	ctx = addFieldToLogger(ctx, "app_id", app.ID)
+	documents, baseURL, newCache, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, ordWebhook, cache)
+	if err == ErrNotModified {
+		return nil, err
+	}
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("error fetching ORD document for webhook with id %q: %v", ordWebhook.ID, err)
+		return nil, &documentsError{errors.Wrapf(err, "error fetching ORD document for webhook with id %q", ordWebhook.ID)}
+	}
+	report.DocumentsFetched = len(documents)
+
 	if len(documents) > 0 {
 		log.C(ctx).Info("Processing ORD documents")
-		if err := s.processDocuments(ctx, app.ID, baseURL, documents, globalResourcesOrdIDs); err != nil {
+		if err := s.processDocuments(ctx, app.ID, baseURL, ordWebhook.Auth, documents, globalResourcesOrdIDs, report); err != nil {
 			log.C(ctx).WithError(err).Errorf("error processing ORD documents: %v", err)
-		} else {
-			log.C(ctx).Info("Successfully processed ORD documents")
-			return tx.Commit()
+			return nil, &documentsError{errors.Wrap(err, "error processing ORD documents")}
 		}
+		log.C(ctx).Info("Successfully processed ORD documents")
+		return newCache, tx.Commit()
 	}
-	return nil
+	return newCache, nil
 }
 
-func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, documents Documents, globalResourcesOrdIDs map[string]bool) error {
+func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, webhookAuth *model.Auth, documents Documents, globalResourcesOrdIDs map[string]bool, report *model.ORDAggregationReport) error {
 	apiDataFromDB, eventDataFromDB, packageDataFromDB, err := s.fetchResources(ctx, appID)
 	if err != nil {
 		return err
@@ -251,8 +381,11 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		return err
 	}
 
-	if err := documents.Validate(baseURL, apiDataFromDB, eventDataFromDB, packageDataFromDB, resourceHashes, globalResourcesOrdIDs); err != nil {
-		return errors.Wrap(err, "invalid documents")
+	validationErr := documents.Validate(baseURL, apiDataFromDB, eventDataFromDB, packageDataFromDB, resourceHashes, globalResourcesOrdIDs)
+	report.RejectedResources = RejectedResources(validationErr)
+	report.AcceptedResources = documents.acceptedResources(report.RejectedResources)
+	if validationErr != nil {
+		return errors.Wrap(validationErr, "invalid documents")
 	}
 
 	if err := documents.Sanitize(baseURL); err != nil {
@@ -296,12 +429,12 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		return err
 	}
 
-	apisFromDB, err := s.processAPIs(ctx, appID, bundlesFromDB, packagesFromDB, apisInput, resourceHashes)
+	apisFromDB, err := s.processAPIs(ctx, appID, webhookAuth, bundlesFromDB, packagesFromDB, apisInput, resourceHashes)
 	if err != nil {
 		return err
 	}
 
-	eventsFromDB, err := s.processEvents(ctx, appID, bundlesFromDB, packagesFromDB, eventsInput, resourceHashes)
+	eventsFromDB, err := s.processEvents(ctx, appID, webhookAuth, bundlesFromDB, packagesFromDB, eventsInput, resourceHashes)
 	if err != nil {
 		return err
 	}
@@ -312,9 +445,11 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 	}
 
 	for _, ts := range tombstonesFromDB {
+		applied := false
 		if i, found := searchInSlice(len(packagesFromDB), func(i int) bool {
 			return packagesFromDB[i].OrdID == ts.OrdID
 		}); found {
+			applied = true
 			if err := s.packageSvc.Delete(ctx, packagesFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
@@ -322,6 +457,7 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		if i, found := searchInSlice(len(apisFromDB), func(i int) bool {
 			return equalStrings(apisFromDB[i].OrdID, &ts.OrdID)
 		}); found {
+			applied = true
 			if err := s.apiSvc.Delete(ctx, apisFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
@@ -329,6 +465,7 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		if i, found := searchInSlice(len(eventsFromDB), func(i int) bool {
 			return equalStrings(eventsFromDB[i].OrdID, &ts.OrdID)
 		}); found {
+			applied = true
 			if err := s.eventSvc.Delete(ctx, eventsFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
@@ -336,6 +473,7 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		if i, found := searchInSlice(len(bundlesFromDB), func(i int) bool {
 			return equalStrings(bundlesFromDB[i].OrdID, &ts.OrdID)
 		}); found {
+			applied = true
 			if err := s.bundleSvc.Delete(ctx, bundlesFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
@@ -343,6 +481,7 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		if i, found := searchInSlice(len(vendorsFromDB), func(i int) bool {
 			return vendorsFromDB[i].OrdID == ts.OrdID
 		}); found {
+			applied = true
 			if err := s.vendorSvc.Delete(ctx, vendorsFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
@@ -350,12 +489,15 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		if i, found := searchInSlice(len(productsFromDB), func(i int) bool {
 			return productsFromDB[i].OrdID == ts.OrdID
 		}); found {
+			applied = true
 			if err := s.productSvc.Delete(ctx, productsFromDB[i].ID); err != nil {
 				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
+		if applied {
+			report.AppliedTombstones = append(report.AppliedTombstones, ts.OrdID)
+		}
 	}
-
 	return nil
 }
 
@@ -419,7 +561,7 @@ func (s *Service) processBundles(ctx context.Context, appID string, bundles []*m
 	return s.bundleSvc.ListByApplicationIDNoPaging(ctx, appID)
 }
 
-func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, apis []*model.APIDefinitionInput, resourceHashes map[string]uint64) ([]*model.APIDefinition, error) {
+func (s *Service) processAPIs(ctx context.Context, appID string, webhookAuth *model.Auth, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, apis []*model.APIDefinitionInput, resourceHashes map[string]uint64) ([]*model.APIDefinition, error) {
 	apisFromDB, err := s.apiSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing apis for app with id %q", appID)
@@ -427,7 +569,7 @@ func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB [
 
 	for _, api := range apis {
 		apiHash := resourceHashes[str.PtrStrToStr(api.OrdID)]
-		if err := s.resyncAPI(ctx, appID, apisFromDB, bundlesFromDB, packagesFromDB, *api, apiHash); err != nil {
+		if err := s.resyncAPI(ctx, appID, webhookAuth, apisFromDB, bundlesFromDB, packagesFromDB, *api, apiHash); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing api with ORD ID %q", *api.OrdID)
 		}
 	}
@@ -435,7 +577,7 @@ func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB [
 	return s.apiSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processEvents(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, events []*model.EventDefinitionInput, resourceHashes map[string]uint64) ([]*model.EventDefinition, error) {
+func (s *Service) processEvents(ctx context.Context, appID string, webhookAuth *model.Auth, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, events []*model.EventDefinitionInput, resourceHashes map[string]uint64) ([]*model.EventDefinition, error) {
 	eventsFromDB, err := s.eventSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing events for app with id %q", appID)
@@ -443,7 +585,7 @@ func (s *Service) processEvents(ctx context.Context, appID string, bundlesFromDB
 
 	for _, event := range events {
 		eventHash := resourceHashes[str.PtrStrToStr(event.OrdID)]
-		if err := s.resyncEvent(ctx, appID, eventsFromDB, bundlesFromDB, packagesFromDB, *event, eventHash); err != nil {
+		if err := s.resyncEvent(ctx, appID, webhookAuth, eventsFromDB, bundlesFromDB, packagesFromDB, *event, eventHash); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing event with ORD ID %q", *event.OrdID)
 		}
 	}
@@ -510,7 +652,7 @@ func (s *Service) resyncVendor(ctx context.Context, appID string, vendorsFromDB
 	return err
 }
 
-func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*model.APIDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, api model.APIDefinitionInput, apiHash uint64) error {
+func (s *Service) resyncAPI(ctx context.Context, appID string, webhookAuth *model.Auth, apisFromDB []*model.APIDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, api model.APIDefinitionInput, apiHash uint64) error {
 	ctx = addFieldToLogger(ctx, "api_ord_id", *api.OrdID)
 	i, isAPIFound := searchInSlice(len(apisFromDB), func(i int) bool {
 		return equalStrings(apisFromDB[i].OrdID, api.OrdID)
@@ -528,7 +670,7 @@ func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*mod
 
 	specs := make([]*model.SpecInput, 0, len(api.ResourceDefinitions))
 	for _, resourceDef := range api.ResourceDefinitions {
-		specs = append(specs, resourceDef.ToSpec())
+		specs = append(specs, withWebhookCredentials(resourceDef.ToSpec(), webhookAuth))
 	}
 
 	if !isAPIFound {
@@ -562,7 +704,7 @@ func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*mod
 	return nil
 }
 
-func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []*model.EventDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, event model.EventDefinitionInput, eventHash uint64) error {
+func (s *Service) resyncEvent(ctx context.Context, appID string, webhookAuth *model.Auth, eventsFromDB []*model.EventDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, event model.EventDefinitionInput, eventHash uint64) error {
 	ctx = addFieldToLogger(ctx, "event_ord_id", *event.OrdID)
 	i, isEventFound := searchInSlice(len(eventsFromDB), func(i int) bool {
 		return equalStrings(eventsFromDB[i].OrdID, event.OrdID)
@@ -588,7 +730,7 @@ func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []
 
 	specs := make([]*model.SpecInput, 0, len(event.ResourceDefinitions))
 	for _, resourceDef := range event.ResourceDefinitions {
-		specs = append(specs, resourceDef.ToSpec())
+		specs = append(specs, withWebhookCredentials(resourceDef.ToSpec(), webhookAuth))
 	}
 
 	if !isEventFound {
@@ -870,6 +1012,34 @@ func extractBundleReferencesForDeletion(allBundleIDsForAPI []string, defaultTarg
 	return bundleIDsToBeDeleted
 }
 
+// withWebhookCredentials adds the credentials of the ORD webhook to the fetch request of the spec, if its access strategy requires them
+func withWebhookCredentials(spec *model.SpecInput, webhookAuth *model.Auth) *model.SpecInput {
+	if webhookAuth == nil || spec.FetchRequest == nil || spec.FetchRequest.Auth == nil || spec.FetchRequest.Auth.AccessStrategy == nil {
+		return spec
+	}
+	if !accessstrategy.Type(*spec.FetchRequest.Auth.AccessStrategy).RequiresCredentials() {
+		return spec
+	}
+
+	auth := spec.FetchRequest.Auth
+	auth.AdditionalHeaders = webhookAuth.AdditionalHeaders
+	auth.Credential = &model.CredentialDataInput{}
+	if basic := webhookAuth.Credential.Basic; basic != nil {
+		auth.Credential.Basic = &model.BasicCredentialDataInput{
+			Username: basic.Username,
+			Password: basic.Password,
+		}
+	}
+	if oauth := webhookAuth.Credential.Oauth; oauth != nil {
+		auth.Credential.Oauth = &model.OAuthCredentialDataInput{
+			ClientID:     oauth.ClientID,
+			ClientSecret: oauth.ClientSecret,
+			URL:          oauth.URL,
+		}
+	}
+	return spec
+}
+
 func equalStrings(first, second *string) bool {
 	return first != nil && second != nil && *first == *second
 }
@@ -3,7 +3,9 @@ package ord_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -13,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -48,7 +51,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 	packagePreSanitizedHash, err := ord.HashObject(fixORDDocument().Packages[0])
 	require.NoError(t, err)
 
-	secondTransactionNotCommited := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	// the applications are listed, read, synced and scheduled in separate transactions, out of which only the sync is not committed
+	syncTransactionNotCommited := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Times(3)
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Times(4)
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(4)
+		return persistTx, transact
+	}
+
+	applicationReadNotCommited := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 		persistTx := &persistenceautomock.PersistenceTx{}
 		persistTx.On("Commit").Return(nil).Once()
 
@@ -71,19 +85,53 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		return labelRepo
 	}
 
-	successfulAppListAndGet := func() *automock.ApplicationService {
+	successfulAppGet := func() *automock.ApplicationService {
 		appSvc := &automock.ApplicationService{}
-		appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+		app := *testApplication
+		app.Type = ""
+		appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(&app, nil).Once()
 		appSvc.On("GetForUpdate", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil, nil).Once()
 		return appSvc
 	}
 
 	successfulTenantSvc := func() *automock.TenantService {
 		tenantSvc := &automock.TenantService{}
-		tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+		tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Twice()
 		return tenantSvc
 	}
 
+	scheduleRepoThatExpectsUpsert := func(matcher func(schedule *ord.AggregationSchedule) bool) func() *automock.ScheduleRepository {
+		return func() *automock.ScheduleRepository {
+			scheduleRepo := &automock.ScheduleRepository{}
+			scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return([]string{appID}, nil).Once()
+			scheduleRepo.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+			scheduleRepo.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(matcher)).Return(nil).Once()
+			return scheduleRepo
+		}
+	}
+
+	successfulScheduleUpdate := scheduleRepoThatExpectsUpsert(func(schedule *ord.AggregationSchedule) bool {
+		return schedule.ApplicationID == appID && schedule.ConsecutiveFailures == 0 && schedule.LastError == nil && schedule.FetchCache != nil
+	})
+
+	failedScheduleUpdate := scheduleRepoThatExpectsUpsert(func(schedule *ord.AggregationSchedule) bool {
+		return schedule.ApplicationID == appID && schedule.ConsecutiveFailures == 1 && schedule.LastError != nil && schedule.FetchCache == nil
+	})
+
+	reportSvcThatExpectsUpsert := func(matcher func(report *model.ORDAggregationReport) bool) func() *automock.ORDAggregationReportService {
+		return func() *automock.ORDAggregationReportService {
+			reportSvc := &automock.ORDAggregationReportService{}
+			reportSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(matcher)).Return(nil).Once()
+			return reportSvc
+		}
+	}
+
+	successfulScheduleList := func() *automock.ScheduleRepository {
+		scheduleRepo := &automock.ScheduleRepository{}
+		scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return([]string{appID}, nil).Once()
+		return scheduleRepo
+	}
+
 	successfulWebhookList := func() *automock.WebhookService {
 		whSvc := &automock.WebhookService{}
 		whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
@@ -315,7 +363,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{fixORDDocument()}, baseURL, fixFetchCache(), nil)
 		return client
 	}
 
@@ -323,6 +371,8 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		Name              string
 		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		labelRepoFn       func() *automock.LabelRepository
+		scheduleRepoFn    func() *automock.ScheduleRepository
+		reportSvcFn       func() *automock.ORDAggregationReportService
 		appSvcFn          func() *automock.ApplicationService
 		webhookSvcFn      func() *automock.WebhookService
 		bundleSvcFn       func() *automock.BundleService
@@ -337,15 +387,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		tenantSvcFn       func() *automock.TenantService
 		globalRegistrySvc func() *automock.GlobalRegistryService
 		clientFn          func() *automock.Client
+		metricsRecorderFn func() *automock.MetricsRecorder
 		ExpectedErr       error
 	}{
 		{
 			Name: "Success when resources are already in db and APIs/Events versions are incremented should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
-			},
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			scheduleRepoFn: successfulScheduleUpdate,
+			reportSvcFn: reportSvcThatExpectsUpsert(func(report *model.ORDAggregationReport) bool {
+				return report.ApplicationID == appID && report.Status == model.ORDAggregationStatusSucceeded && report.Error == nil &&
+					report.DocumentsFetched == 1 && len(report.AcceptedResources) > 0 && len(report.RejectedResources) == 0 &&
+					assert.ObjectsAreEqual([]string{api2ORDID}, report.AppliedTombstones)
+			}),
 			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppListAndGet,
+			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
 			webhookSvcFn:   successfulWebhookList,
 			bundleSvcFn:    successfulBundleUpdate,
@@ -377,10 +434,11 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		{
 			Name: "Success when resources are already in db and APIs/Events versions are NOT incremented should Update them and refetch only failed API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
+			scheduleRepoFn: successfulScheduleUpdate,
 			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppListAndGet,
+			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
 			webhookSvcFn:   successfulWebhookList,
 			bundleSvcFn:    successfulBundleUpdate,
@@ -419,13 +477,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		{
 			Name: "Success when resources are not in db should Create them",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppListAndGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			scheduleRepoFn: successfulScheduleUpdate,
+			labelRepoFn:    successfulLabelRepo,
+			appSvcFn:       successfulAppGet,
+			tenantSvcFn:    successfulTenantSvc,
+			webhookSvcFn:   successfulWebhookList,
+			bundleSvcFn:    successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -450,16 +509,77 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			globalRegistrySvc: successfulGlobalRegistrySvc,
 			clientFn:          successfulClientFetch,
 		},
+		{
+			Name: "Success when resources are not in db should Create them with the webhook credentials for the specs secured with them",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(4)
+			},
+			scheduleRepoFn: successfulScheduleUpdate,
+			labelRepoFn:    successfulLabelRepo,
+			appSvcFn:       successfulAppGet,
+			tenantSvcFn:    successfulTenantSvc,
+			webhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return([]*model.Webhook{fixWebhookWithBasicCredentials()}, nil).Once()
+				return whSvc
+			},
+			bundleSvcFn: successfulBundleCreate,
+			apiSvcFn: func() *automock.APIService {
+				api := *sanitizedDoc.APIResources[0]
+				api.ResourceDefinitions = fixResourceDefinitionsSecuredWithBasicCredentials(api.ResourceDefinitions)
+
+				specs := fixAPI1SpecInputs()
+				specs[0].FetchRequest.Auth = &model.AuthInput{
+					AccessStrategy: str.Ptr(string(accessstrategy.BasicAccessStrategy)),
+					Credential: &model.CredentialDataInput{
+						Basic: &model.BasicCredentialDataInput{
+							Username: "user",
+							Password: "pass",
+						},
+					},
+				}
+
+				apiSvc := &automock.APIService{}
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				apiSvc.On("Create", txtest.CtxWithDBMatcher(), appID, nilBundleID, str.Ptr(packageID), api, specs, map[string]string{bundleID: sanitizedDoc.APIResources[0].PartOfConsumptionBundles[0].DefaultTargetURL}, mock.Anything, "").Return("", nil).Once()
+				apiSvc.On("Create", txtest.CtxWithDBMatcher(), appID, nilBundleID, str.Ptr(packageID), *sanitizedDoc.APIResources[1], fixAPI2SpecInputs(), map[string]string{bundleID: "http://localhost:8080/some-api/v1"}, mock.Anything, "").Return("", nil).Once()
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
+				apiSvc.On("Delete", txtest.CtxWithDBMatcher(), api2ID).Return(nil).Once()
+				return apiSvc
+			},
+			eventSvcFn:   successfulEventCreate,
+			packageSvcFn: successfulPackageCreate,
+			productSvcFn: successfulProductCreate,
+			vendorSvcFn:  successfulVendorCreate,
+			tombstoneSvcFn: func() *automock.TombstoneService {
+				tombstoneSvc := &automock.TombstoneService{}
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				tombstoneSvc.On("Create", txtest.CtxWithDBMatcher(), appID, *sanitizedDoc.Tombstones[0]).Return("", nil).Once()
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
+				return tombstoneSvc
+			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			clientFn: func() *automock.Client {
+				doc := fixORDDocument()
+				doc.APIResources[0].ResourceDefinitions = fixResourceDefinitionsSecuredWithBasicCredentials(doc.APIResources[0].ResourceDefinitions)
+
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, fixWebhookWithBasicCredentials(), (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, baseURL, fixFetchCache(), nil)
+				return client
+			},
+		},
 		{
 			Name: "Error when synchronizing global resources from global registry should get them from DB and proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppListAndGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			scheduleRepoFn: successfulScheduleUpdate,
+			labelRepoFn:    successfulLabelRepo,
+			appSvcFn:       successfulAppGet,
+			tenantSvcFn:    successfulTenantSvc,
+			webhookSvcFn:   successfulWebhookList,
+			bundleSvcFn:    successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -492,13 +612,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		{
 			Name: "Error when synchronizing global resources from global registry and get them from DB should proceed with the rest of the sync",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppListAndGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			scheduleRepoFn: successfulScheduleUpdate,
+			labelRepoFn:    successfulLabelRepo,
+			appSvcFn:       successfulAppGet,
+			tenantSvcFn:    successfulTenantSvc,
+			webhookSvcFn:   successfulWebhookList,
+			bundleSvcFn:    successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -528,6 +649,66 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			clientFn: successfulClientFetch,
 		},
+		{
+			Name:            "Does not resync resources when ORD documents were not modified since the last aggregation",
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn: func() *automock.ScheduleRepository {
+				scheduleRepo := &automock.ScheduleRepository{}
+				scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return([]string{appID}, nil).Once()
+				scheduleRepo.On("Get", txtest.CtxWithDBMatcher(), appID).Return(fixAggregationSchedule(0), nil).Once()
+				scheduleRepo.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(schedule *ord.AggregationSchedule) bool {
+					return schedule.ConsecutiveFailures == 0 && schedule.LastAggregatedAt != nil && time.Until(schedule.NextDueAt) > 0 && assert.ObjectsAreEqual(fixFetchCache(), schedule.FetchCache)
+				})).Return(nil).Once()
+				return scheduleRepo
+			},
+			reportSvcFn: func() *automock.ORDAggregationReportService {
+				return &automock.ORDAggregationReportService{}
+			},
+			labelRepoFn:  successfulLabelRepo,
+			appSvcFn:     successfulAppGet,
+			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn: successfulWebhookList,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, fixFetchCache()).Return(nil, "", nil, ord.ErrNotModified).Once()
+				return client
+			},
+			metricsRecorderFn: func() *automock.MetricsRecorder {
+				metricsRecorder := &automock.MetricsRecorder{}
+				metricsRecorder.On("RecordApplicationAggregation", appID, ord.AggregationResultNotModified, mock.Anything, 0).Once()
+				return metricsRecorder
+			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+		},
+		{
+			Name:            "Backs off exponentially and keeps the fetch cache when ORD documents fetch fails again",
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn: func() *automock.ScheduleRepository {
+				scheduleRepo := &automock.ScheduleRepository{}
+				scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return([]string{appID}, nil).Once()
+				scheduleRepo.On("Get", txtest.CtxWithDBMatcher(), appID).Return(fixAggregationSchedule(2), nil).Once()
+				scheduleRepo.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(schedule *ord.AggregationSchedule) bool {
+					untilDue := time.Until(schedule.NextDueAt)
+					return schedule.ConsecutiveFailures == 3 && schedule.LastError != nil && untilDue > 7*time.Minute && untilDue <= 8*time.Minute && assert.ObjectsAreEqual(fixFetchCache(), schedule.FetchCache)
+				})).Return(nil).Once()
+				return scheduleRepo
+			},
+			labelRepoFn:  successfulLabelRepo,
+			appSvcFn:     successfulAppGet,
+			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn: successfulWebhookList,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, fixFetchCache()).Return(nil, "", nil, testErr).Once()
+				return client
+			},
+			metricsRecorderFn: func() *automock.MetricsRecorder {
+				metricsRecorder := &automock.MetricsRecorder{}
+				metricsRecorder.On("RecordApplicationAggregation", appID, ord.AggregationResultFailed, mock.Anything, 3).Once()
+				return metricsRecorder
+			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+		},
 		{
 			Name:              "Returns error when transaction opening fails",
 			TransactionerFn:   txGen.ThatFailsOnBegin,
@@ -535,44 +716,68 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			globalRegistrySvc: successfulGlobalRegistrySvc,
 		},
 		{
-			Name:            "Returns error when app list fails",
+			Name:            "Returns error when listing the applications due for aggregation fails",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
-			appSvcFn: func() *automock.ApplicationService {
-				appSvc := &automock.ApplicationService{}
-				appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(nil, testErr).Once()
-				return appSvc
+			scheduleRepoFn: func() *automock.ScheduleRepository {
+				scheduleRepo := &automock.ScheduleRepository{}
+				scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil, testErr).Once()
+				return scheduleRepo
 			},
 			globalRegistrySvc: successfulGlobalRegistrySvc,
 			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns error when labels list fails",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: applicationReadNotCommited,
+			scheduleRepoFn:  successfulScheduleList,
 			labelRepoFn: func() *automock.LabelRepository {
 				labelRepo := &automock.LabelRepository{}
 				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, mock.Anything, applicationTypeLabel).Return(nil, testErr).Once()
 				return labelRepo
 			},
+			tenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(testApplication, nil).Once()
 				return appSvc
 			},
 			globalRegistrySvc: successfulGlobalRegistrySvc,
-			ExpectedErr:       testErr,
+			ExpectedErr:       errors.New("failed to process 1 app"),
 		},
 		{
 			Name:            "Returns error when get tenant fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
+			TransactionerFn: applicationReadNotCommited,
+			scheduleRepoFn:  successfulScheduleList,
 			tenantSvcFn: func() *automock.TenantService {
 				tenantSvc := &automock.TenantService{}
 				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
 				return tenantSvc
 			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			ExpectedErr:       errors.New("failed to process 1 app"),
+		},
+		{
+			Name:            "Returns error when getting the aggregation schedule fails",
+			TransactionerFn: applicationReadNotCommited,
+			scheduleRepoFn: func() *automock.ScheduleRepository {
+				scheduleRepo := &automock.ScheduleRepository{}
+				scheduleRepo.On("ListDueApplicationIDs", txtest.CtxWithDBMatcher(), mock.Anything).Return([]string{appID}, nil).Once()
+				scheduleRepo.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return scheduleRepo
+			},
+			labelRepoFn: successfulLabelRepo,
+			tenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(testApplication, nil).Once()
 				return appSvc
 			},
 			globalRegistrySvc: successfulGlobalRegistrySvc,
@@ -580,12 +785,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when application locking fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
 			tenantSvcFn:     successfulTenantSvc,
 			appSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
-				appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(testApplication, nil).Once()
 				appSvc.On("GetForUpdate", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil, testErr).Once()
 				return appSvc
 			},
@@ -594,11 +800,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources when event list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
 			webhookSvcFn:    successfulWebhookList,
 			clientFn:        successfulClientFetch,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
@@ -614,11 +821,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources when api list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
 			webhookSvcFn:    successfulWebhookList,
 			clientFn:        successfulClientFetch,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
@@ -628,19 +836,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			globalRegistrySvc: successfulGlobalRegistrySvc,
 		},
 		{
-			Name: "Returns error when webhook list fails",
-			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Once()
-
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Twice()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Twice()
-				return persistTx, transact
-			},
-			labelRepoFn: successfulLabelRepo,
-			appSvcFn:    successfulAppListAndGet,
-			tenantSvcFn: successfulTenantSvc,
+			Name:            "Returns error when webhook list fails",
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
+			labelRepoFn:     successfulLabelRepo,
+			appSvcFn:        successfulAppGet,
+			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn: func() *automock.WebhookService {
 				whSvc := &automock.WebhookService{}
 				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -651,30 +852,45 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Skips app when ORD documents fetch fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(nil, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(nil, "", nil, testErr)
 				return client
 			},
 			globalRegistrySvc: successfulGlobalRegistrySvc,
 		},
 		{
 			Name:            "Does not resync resources for invalid ORD documents",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
+			reportSvcFn: reportSvcThatExpectsUpsert(func(report *model.ORDAggregationReport) bool {
+				expectedRejection := &model.ORDRejectedResource{
+					Type:      model.ORDResourceTypeVendor,
+					Rule:      "validation_required",
+					FieldPath: "ordId",
+					Message:   "cannot be blank",
+				}
+				isRejected := false
+				for _, rejection := range report.RejectedResources {
+					isRejected = isRejected || assert.ObjectsAreEqual(expectedRejection, rejection)
+				}
+				return report.Status == model.ORDAggregationStatusFailed && report.Error != nil && report.DocumentsFetched == 1 && isRejected && len(report.AppliedTombstones) == 0
+			}),
+			labelRepoFn:  successfulLabelRepo,
+			appSvcFn:     successfulAppGet,
+			tenantSvcFn:  successfulTenantSvc,
+			webhookSvcFn: successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors[0].OrdID = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 			apiSvcFn:          successfulEmptyAPIList,
@@ -684,9 +900,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if vendor list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -702,9 +919,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if vendor update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -721,9 +939,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if vendor create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -740,9 +959,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -759,9 +979,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -779,9 +1000,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -799,9 +1021,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -819,9 +1042,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -840,9 +1064,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -861,9 +1086,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -881,9 +1107,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -902,9 +1129,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -923,9 +1151,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -944,9 +1173,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if fetching bundle ids for api fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -972,9 +1202,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -995,9 +1226,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -1017,9 +1249,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api spec delete fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1045,9 +1278,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api spec create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1074,9 +1308,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api spec list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1101,9 +1336,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api spec get fetch request fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1129,9 +1365,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api spec refetch fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1158,9 +1395,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1181,9 +1419,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if fetching bundle ids for event fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1213,9 +1452,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1237,9 +1477,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -1260,9 +1501,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec delete fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1296,9 +1538,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1335,9 +1578,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1371,9 +1615,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec get fetch request fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1408,9 +1653,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec refetch fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1447,9 +1693,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone list fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1470,9 +1717,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone update fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -1494,9 +1742,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone create fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -1516,9 +1765,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -1548,9 +1798,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -1583,15 +1834,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = packageORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if event resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -1625,15 +1877,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = event1ORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if vendor resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -1666,15 +1919,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = vendorORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if product resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -1706,15 +1960,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = productORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if bundle resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  failedScheduleUpdate,
 			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppListAndGet,
+			appSvcFn:        successfulAppGet,
 			tenantSvcFn:     successfulTenantSvc,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn: func() *automock.BundleService {
@@ -1746,20 +2001,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = bundleORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name: "Success when resources are not in db and no SAP Vendor is declared in Documents should Create them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppListAndGet,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			scheduleRepoFn: successfulScheduleUpdate,
+			labelRepoFn:    successfulLabelRepo,
+			appSvcFn:       successfulAppGet,
+			tenantSvcFn:    successfulTenantSvc,
+			webhookSvcFn:   successfulWebhookList,
+			bundleSvcFn:    successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1786,17 +2042,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
 		{
 			Name: "Success when resources are already in db and no SAP Vendor is declared in Documents should Update them as SAP Vendor is coming from the Global Registry",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(4)
 			},
+			scheduleRepoFn: successfulScheduleUpdate,
 			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppListAndGet,
+			appSvcFn:       successfulAppGet,
 			tenantSvcFn:    successfulTenantSvc,
 			webhookSvcFn:   successfulWebhookList,
 			bundleSvcFn:    successfulBundleUpdate,
@@ -1828,7 +2085,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, fixFetchCache(), nil)
 				return client
 			},
 		},
@@ -1897,9 +2154,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			if test.clientFn != nil {
 				client = test.clientFn()
 			}
+			scheduleRepo := &automock.ScheduleRepository{}
+			if test.scheduleRepoFn != nil {
+				scheduleRepo = test.scheduleRepoFn()
+			}
+			reportSvc := &automock.ORDAggregationReportService{}
+			if test.reportSvcFn != nil {
+				reportSvc = test.reportSvcFn()
+			} else {
+				reportSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Maybe()
+			}
+			metricsRecorder := &automock.MetricsRecorder{}
+			if test.metricsRecorderFn != nil {
+				metricsRecorder = test.metricsRecorderFn()
+			} else {
+				metricsRecorder.On("RecordApplicationAggregation", appID, mock.Anything, mock.Anything, mock.Anything).Maybe()
+			}
 
-			ordCfg := ord.NewServiceConfig(4)
-			svc := ord.NewAggregatorService(ordCfg, tx, labelRepo, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, client)
+			ordCfg := ord.NewServiceConfig(4, fixScheduleConfig())
+			svc := ord.NewAggregatorService(ordCfg, tx, labelRepo, scheduleRepo, reportSvc, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, client, metricsRecorder)
 			err := svc.SyncORDDocuments(context.TODO())
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -1908,7 +2181,241 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, labelRepo, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, client)
+			mock.AssertExpectationsForObjects(t, tx, labelRepo, scheduleRepo, reportSvc, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, globalRegistrySvc, client, metricsRecorder)
+		})
+	}
+}
+
+func TestService_ProcessApplication(t *testing.T) {
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testApplication := fixApplicationPage().Data[0]
+	testWebhook := fixWebhooks()[0]
+
+	// the application is read, synced and scheduled in separate transactions, out of which only the sync is not committed
+	syncTransactionNotCommited := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Twice()
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Times(3)
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(3)
+		return persistTx, transact
+	}
+
+	// the documents are fetched unconditionally, so the fetch cache of the schedule is not passed to the client
+	scheduleRepoThatExpectsUpsert := func(consecutiveFailures int) func() *automock.ScheduleRepository {
+		return func() *automock.ScheduleRepository {
+			scheduleRepo := &automock.ScheduleRepository{}
+			scheduleRepo.On("Get", txtest.CtxWithDBMatcher(), appID).Return(fixAggregationSchedule(0), nil).Once()
+			scheduleRepo.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(schedule *ord.AggregationSchedule) bool {
+				return schedule.ApplicationID == appID && schedule.ConsecutiveFailures == consecutiveFailures
+			})).Return(nil).Once()
+			return scheduleRepo
+		}
+	}
+
+	successfulGlobalRegistrySvc := func() *automock.GlobalRegistryService {
+		globalRegistrySvcFn := &automock.GlobalRegistryService{}
+		globalRegistrySvcFn.On("ListGlobalResources", context.TODO()).Return(map[string]bool{vendorORDID: true}, nil).Once()
+		return globalRegistrySvcFn
+	}
+
+	successfulTenantSvc := func() *automock.TenantService {
+		tenantSvc := &automock.TenantService{}
+		tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Twice()
+		return tenantSvc
+	}
+
+	successfulLabelRepo := func() *automock.LabelRepository {
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return([]*model.Label{
+			{
+				Value:    testApplicationType,
+				ObjectID: appID,
+			},
+		}, nil).Once()
+		return labelRepo
+	}
+
+	successfulAppGet := func() *automock.ApplicationService {
+		appSvc := &automock.ApplicationService{}
+		app := *testApplication
+		app.Type = ""
+		appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(&app, nil).Once()
+		appSvc.On("GetForUpdate", txtest.CtxWithDBMatcher(), appID).Return(&app, nil).Once()
+		return appSvc
+	}
+
+	testCases := []struct {
+		Name              string
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		scheduleRepoFn    func() *automock.ScheduleRepository
+		globalRegistrySvc func() *automock.GlobalRegistryService
+		tenantSvcFn       func() *automock.TenantService
+		labelRepoFn       func() *automock.LabelRepository
+		appSvcFn          func() *automock.ApplicationService
+		webhookSvcFn      func() *automock.WebhookService
+		clientFn          func() *automock.Client
+		ExpectedErr       error
+	}{
+		{
+			Name:              "Success when the application does not provide any ORD documents",
+			TransactionerFn:   syncTransactionNotCommited,
+			scheduleRepoFn:    scheduleRepoThatExpectsUpsert(0),
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			tenantSvcFn:       successfulTenantSvc,
+			labelRepoFn:       successfulLabelRepo,
+			appSvcFn:          successfulAppGet,
+			webhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+				return whSvc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{}, baseURL, fixFetchCache(), nil).Once()
+				return client
+			},
+		},
+		{
+			Name:            "Success when listing global resources fails",
+			TransactionerFn: syncTransactionNotCommited,
+			scheduleRepoFn:  scheduleRepoThatExpectsUpsert(0),
+			globalRegistrySvc: func() *automock.GlobalRegistryService {
+				globalRegistrySvcFn := &automock.GlobalRegistryService{}
+				globalRegistrySvcFn.On("ListGlobalResources", context.TODO()).Return(nil, testErr).Once()
+				return globalRegistrySvcFn
+			},
+			tenantSvcFn: successfulTenantSvc,
+			labelRepoFn: successfulLabelRepo,
+			appSvcFn:    successfulAppGet,
+			webhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+				return whSvc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(ord.Documents{}, baseURL, fixFetchCache(), nil).Once()
+				return client
+			},
+		},
+		{
+			Name:              "Returns error when ORD documents fetching fails",
+			TransactionerFn:   syncTransactionNotCommited,
+			scheduleRepoFn:    scheduleRepoThatExpectsUpsert(1),
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			tenantSvcFn:       successfulTenantSvc,
+			labelRepoFn:       successfulLabelRepo,
+			appSvcFn:          successfulAppGet,
+			webhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+				return whSvc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*ord.FetchCache)(nil)).Return(nil, "", nil, testErr).Once()
+				return client
+			},
+			ExpectedErr: errors.New("error fetching ORD document for webhook with id"),
+		},
+		{
+			Name:              "Returns error when the application has no ORD webhook",
+			TransactionerFn:   syncTransactionNotCommited,
+			scheduleRepoFn:    scheduleRepoThatExpectsUpsert(1),
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			tenantSvcFn:       successfulTenantSvc,
+			labelRepoFn:       successfulLabelRepo,
+			appSvcFn:          successfulAppGet,
+			webhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplicationWithSelectForUpdate", txtest.CtxWithDBMatcher(), appID).Return([]*model.Webhook{}, nil).Once()
+				return whSvc
+			},
+			ExpectedErr: errors.New("has no OPEN_RESOURCE_DISCOVERY webhook"),
+		},
+		{
+			Name: "Returns error when getting the application owner fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommit()
+			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			tenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when getting the application fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatDoesntExpectCommit()
+			},
+			globalRegistrySvc: successfulGlobalRegistrySvc,
+			tenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
+			appSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return appSvc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			_, tx := test.TransactionerFn()
+
+			globalRegistrySvc := &automock.GlobalRegistryService{}
+			if test.globalRegistrySvc != nil {
+				globalRegistrySvc = test.globalRegistrySvc()
+			}
+			tenantSvc := &automock.TenantService{}
+			if test.tenantSvcFn != nil {
+				tenantSvc = test.tenantSvcFn()
+			}
+			labelRepo := &automock.LabelRepository{}
+			if test.labelRepoFn != nil {
+				labelRepo = test.labelRepoFn()
+			}
+			appSvc := &automock.ApplicationService{}
+			if test.appSvcFn != nil {
+				appSvc = test.appSvcFn()
+			}
+			whSvc := &automock.WebhookService{}
+			if test.webhookSvcFn != nil {
+				whSvc = test.webhookSvcFn()
+			}
+			client := &automock.Client{}
+			if test.clientFn != nil {
+				client = test.clientFn()
+			}
+			scheduleRepo := &automock.ScheduleRepository{}
+			if test.scheduleRepoFn != nil {
+				scheduleRepo = test.scheduleRepoFn()
+			}
+			reportSvc := &automock.ORDAggregationReportService{}
+			reportSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Maybe()
+
+			ordCfg := ord.NewServiceConfig(4, fixScheduleConfig())
+			svc := ord.NewAggregatorService(ordCfg, tx, labelRepo, scheduleRepo, reportSvc, appSvc, whSvc, nil, nil, nil, nil, nil, nil, nil, nil, nil, tenantSvc, globalRegistrySvc, client, nil)
+			err := svc.ProcessApplication(context.TODO(), appID)
+			if test.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, tx, scheduleRepo, globalRegistrySvc, tenantSvc, labelRepo, appSvc, whSvc, client)
 		})
 	}
 }
@@ -0,0 +1,202 @@
+package ord
+
+import (
+	"sort"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hashicorp/go-multierror"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	// RuleInvalid is reported for the failed validations which do not provide a rule of their own
+	RuleInvalid = "validation_invalid"
+	// RuleDuplicateOrdID is reported for a resource whose ORD ID is already used by another resource of the same type
+	RuleDuplicateOrdID = "validation_duplicate_ord_id"
+	// RuleUnknownReference is reported for a resource which references a resource that is neither described in the documents nor global
+	RuleUnknownReference = "validation_unknown_reference"
+	// RuleMissingBaseURL is reported for a document whose base URL can be determined neither from the well-known configuration nor from its described system instance
+	RuleMissingBaseURL = "validation_missing_base_url"
+	// RuleSystemInstanceMismatch is reported for a document whose described system instance is not the one providing the documents
+	RuleSystemInstanceMismatch = "validation_system_instance_mismatch"
+)
+
+// ResourceValidationError is the validation error of a single resource described in the ORD documents.
+// It keeps track of the resource and of the failed rules, so that they can be reported back to the provider of the documents.
+type ResourceValidationError struct {
+	ResourceType model.ORDResourceType
+	OrdID        string
+	// Rule is the failed rule. It is empty if the failed rules are provided by the cause of the error.
+	Rule string
+	// FieldPath is the path of the failed field, or of the object whose fields are validated by the cause of the error
+	FieldPath string
+
+	cause error
+	err   error
+}
+
+// Error returns the message of the error
+func (e *ResourceValidationError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the cause of the error
+func (e *ResourceValidationError) Unwrap() error {
+	return e.cause
+}
+
+// Rejections returns a rejected resource for each of the failed rules
+func (e *ResourceValidationError) Rejections() []*model.ORDRejectedResource {
+	if e.Rule != "" || e.cause == nil {
+		rule := e.Rule
+		if rule == "" {
+			rule = RuleInvalid
+		}
+		return []*model.ORDRejectedResource{e.rejection(rule, e.FieldPath, e.Error())}
+	}
+
+	var rejections []*model.ORDRejectedResource
+	collectFieldErrors(e.cause, e.FieldPath, func(fieldPath, rule, message string) {
+		rejections = append(rejections, e.rejection(rule, fieldPath, message))
+	})
+	return rejections
+}
+
+func (e *ResourceValidationError) rejection(rule, fieldPath, message string) *model.ORDRejectedResource {
+	return &model.ORDRejectedResource{
+		Type:      e.ResourceType,
+		OrdID:     e.OrdID,
+		Rule:      rule,
+		FieldPath: fieldPath,
+		Message:   message,
+	}
+}
+
+// RejectedResources returns the rejected resources reported by the given error of the Documents' Validate method.
+// Errors which are not about a resource described in the documents are reported as rejections of the documents.
+func RejectedResources(err error) []*model.ORDRejectedResource {
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if merr, ok := err.(*multierror.Error); ok {
+		errs = merr.Errors
+	}
+
+	rejections := make([]*model.ORDRejectedResource, 0, len(errs))
+	for _, e := range errs {
+		resourceErr, ok := e.(*ResourceValidationError)
+		if !ok {
+			resourceErr = &ResourceValidationError{ResourceType: model.ORDResourceTypeDocument, err: e}
+		}
+		rejections = append(rejections, resourceErr.Rejections()...)
+	}
+
+	return rejections
+}
+
+// newResourceValidationError wraps the errors of the validation rules of a resource, reported by the cause, with the given message
+func newResourceValidationError(resourceType model.ORDResourceType, ordID, fieldPath string, cause error, format string, args ...interface{}) *ResourceValidationError {
+	return &ResourceValidationError{
+		ResourceType: resourceType,
+		OrdID:        ordID,
+		FieldPath:    fieldPath,
+		cause:        cause,
+		err:          errors.Wrapf(cause, format, args...),
+	}
+}
+
+// newResourceRuleError returns the error of the given failed rule of a resource
+func newResourceRuleError(resourceType model.ORDResourceType, ordID, rule, fieldPath string, format string, args ...interface{}) *ResourceValidationError {
+	return &ResourceValidationError{
+		ResourceType: resourceType,
+		OrdID:        ordID,
+		Rule:         rule,
+		FieldPath:    fieldPath,
+		err:          errors.Errorf(format, args...),
+	}
+}
+
+// collectFieldErrors calls the given function for each of the failed fields reported by the validation error, with the full path of the field.
+// The fields of the same object are reported in alphabetical order.
+func collectFieldErrors(err error, fieldPath string, collect func(fieldPath, rule, message string)) {
+	switch e := err.(type) {
+	case validation.Errors:
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if e[key] != nil {
+				collectFieldErrors(e[key], joinFieldPath(fieldPath, key), collect)
+			}
+		}
+	case validation.Error:
+		rule := e.Code()
+		if rule == "" {
+			rule = RuleInvalid
+		}
+		collect(fieldPath, rule, e.Error())
+	default:
+		collect(fieldPath, RuleInvalid, err.Error())
+	}
+}
+
+func joinFieldPath(fieldPath, key string) string {
+	if _, err := strconv.Atoi(key); err == nil {
+		return fieldPath + "[" + key + "]"
+	}
+	if fieldPath == "" {
+		return key
+	}
+	return fieldPath + "." + key
+}
+
+// acceptedResources returns the resources described in the documents which are not among the given rejected resources
+func (docs Documents) acceptedResources(rejected []*model.ORDRejectedResource) []*model.ORDReportedResource {
+	rejectedIDs := make(map[model.ORDReportedResource]bool, len(rejected))
+	for _, r := range rejected {
+		rejectedIDs[model.ORDReportedResource{Type: r.Type, OrdID: r.OrdID}] = true
+	}
+
+	accepted := make([]*model.ORDReportedResource, 0)
+	add := func(resourceType model.ORDResourceType, ordID string) {
+		resource := model.ORDReportedResource{Type: resourceType, OrdID: ordID}
+		if ordID == "" || rejectedIDs[resource] {
+			return
+		}
+		// resources described more than once are rejected as duplicates, so each one of the accepted resources is added once
+		accepted = append(accepted, &resource)
+	}
+
+	for _, doc := range docs {
+		for _, pkg := range doc.Packages {
+			add(model.ORDResourceTypePackage, pkg.OrdID)
+		}
+		for _, bndl := range doc.ConsumptionBundles {
+			add(model.ORDResourceTypeConsumptionBundle, stringPtrToString(bndl.OrdID))
+		}
+		for _, product := range doc.Products {
+			add(model.ORDResourceTypeProduct, product.OrdID)
+		}
+		for _, vendor := range doc.Vendors {
+			add(model.ORDResourceTypeVendor, vendor.OrdID)
+		}
+		for _, api := range doc.APIResources {
+			add(model.ORDResourceTypeAPI, stringPtrToString(api.OrdID))
+		}
+		for _, event := range doc.EventResources {
+			add(model.ORDResourceTypeEvent, stringPtrToString(event.OrdID))
+		}
+		for _, tombstone := range doc.Tombstones {
+			add(model.ORDResourceTypeTombstone, tombstone.OrdID)
+		}
+	}
+
+	return accepted
+}
@@ -0,0 +1,108 @@
+package ord_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectedResources(t *testing.T) {
+	var tests = []struct {
+		Name               string
+		DocumentProvider   func() []*ord.Document
+		ExpectedRejections []*model.ORDRejectedResource
+	}{
+		{
+			Name: "No rejections for valid documents",
+			DocumentProvider: func() []*ord.Document {
+				return []*ord.Document{fixORDDocument()}
+			},
+		},
+		{
+			Name: "Failed field rules and the broken references to the resource are reported",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.Vendors[0].OrdID = ""
+
+				return []*ord.Document{doc}
+			},
+			ExpectedRejections: []*model.ORDRejectedResource{
+				{
+					Type:      model.ORDResourceTypeVendor,
+					Rule:      "validation_required",
+					FieldPath: "ordId",
+					Message:   "cannot be blank",
+				},
+				{
+					Type:      model.ORDResourceTypePackage,
+					OrdID:     packageORDID,
+					Rule:      ord.RuleUnknownReference,
+					FieldPath: "vendor",
+					Message:   `package with id "ns:package:PACKAGE_ID:v1" has a reference to unknown vendor "sap:vendor:SAP:"`,
+				},
+				{
+					Type:      model.ORDResourceTypeProduct,
+					OrdID:     productORDID,
+					Rule:      ord.RuleUnknownReference,
+					FieldPath: "vendor",
+					Message:   `product with id "sap:product:id:" has a reference to unknown vendor "sap:vendor:SAP:"`,
+				},
+			},
+		},
+		{
+			Name: "Duplicate resources are reported",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.Products = append(doc.Products, doc.Products[0])
+
+				return []*ord.Document{doc}
+			},
+			ExpectedRejections: []*model.ORDRejectedResource{
+				{
+					Type:      model.ORDResourceTypeProduct,
+					OrdID:     productORDID,
+					Rule:      ord.RuleDuplicateOrdID,
+					FieldPath: "ordId",
+					Message:   `found duplicate product with ord id "sap:product:id:"`,
+				},
+			},
+		},
+		{
+			Name: "Failed rules of nested fields are reported with the full field path",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.DescribedSystemInstance.CorrelationIDs = json.RawMessage(`["foo"]`)
+
+				return []*ord.Document{doc}
+			},
+			ExpectedRejections: []*model.ORDRejectedResource{
+				{
+					Type:      model.ORDResourceTypeDocument,
+					Rule:      ord.RuleInvalid,
+					FieldPath: "describedSystemInstance.correlationIds",
+					Message:   fmt.Sprintf("elements should match %q", ord.CorrelationIDsRegex),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			docs := ord.Documents(test.DocumentProvider())
+			err := docs.Validate(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes, nil)
+
+			rejections := ord.RejectedResources(err)
+			if len(test.ExpectedRejections) == 0 {
+				require.NoError(t, err)
+				require.Empty(t, rejections)
+				return
+			}
+			require.Error(t, err)
+			require.ElementsMatch(t, test.ExpectedRejections, rejections)
+		})
+	}
+}
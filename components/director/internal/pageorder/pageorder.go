@@ -0,0 +1,51 @@
+package pageorder
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+// Field is a field by which the items of the pages can be ordered
+type Field string
+
+const (
+	// FieldID orders the items by their ID
+	FieldID Field = "ID"
+	// FieldName orders the items by their name
+	FieldName Field = "NAME"
+	// FieldCreatedAt orders the items by their creation time
+	FieldCreatedAt Field = "CREATED_AT"
+)
+
+// Order is the order of the items of the pages requested by the client
+type Order struct {
+	Field     Field
+	Direction pagination.SortOrder
+}
+
+// FromGraphQL converts graphql.PageOrder to Order. It returns nil if no order is requested.
+func FromGraphQL(in *graphql.PageOrder) *Order {
+	if in == nil {
+		return nil
+	}
+
+	direction := pagination.AscendingOrder
+	if in.Direction != nil && *in.Direction == graphql.PageOrderDirectionDesc {
+		direction = pagination.DescendingOrder
+	}
+
+	return &Order{
+		Field:     Field(in.Field),
+		Direction: direction,
+	}
+}
+
+// NewAsc returns an ascending Order by the given field
+func NewAsc(field Field) *Order {
+	return &Order{Field: field, Direction: pagination.AscendingOrder}
+}
+
+// NewDesc returns a descending Order by the given field
+func NewDesc(field Field) *Order {
+	return &Order{Field: field, Direction: pagination.DescendingOrder}
+}
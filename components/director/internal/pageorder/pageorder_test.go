@@ -0,0 +1,37 @@
+package pageorder_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		direction := graphql.PageOrderDirectionDesc
+		in := &graphql.PageOrder{
+			Field:     graphql.PageOrderFieldCreatedAt,
+			Direction: &direction,
+		}
+
+		result := pageorder.FromGraphQL(in)
+
+		assert.Equal(t, pageorder.NewDesc(pageorder.FieldCreatedAt), result)
+	})
+
+	t.Run("Ascending by default", func(t *testing.T) {
+		in := &graphql.PageOrder{
+			Field: graphql.PageOrderFieldName,
+		}
+
+		result := pageorder.FromGraphQL(in)
+
+		assert.Equal(t, pageorder.NewAsc(pageorder.FieldName), result)
+	})
+
+	t.Run("Nil when no order is requested", func(t *testing.T) {
+		assert.Nil(t, pageorder.FromGraphQL(nil))
+	})
+}
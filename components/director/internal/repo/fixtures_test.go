@@ -13,10 +13,13 @@ const (
 
 	appID           = "appID"
 	appID2          = "appID2"
+	appID3          = "appID3"
 	appName         = "appName"
 	appName2        = "appName"
+	appName3        = "appName"
 	appDescription  = "appDesc"
 	appDescription2 = "appDesc"
+	appDescription3 = "appDesc"
 
 	bundleID          = "bundleID"
 	bundleName        = "bundleName"
@@ -2,9 +2,13 @@ package repo
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
+	"reflect"
 	"strings"
 
+	"github.com/jmoiron/sqlx/reflectx"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -14,21 +18,27 @@ import (
 	"github.com/pkg/errors"
 )
 
+const idColumn = "id"
+
+// keysetMapper maps the columns to the fields of the entities the same way sqlx does
+var keysetMapper = reflectx.NewMapperFunc("db", strings.ToLower)
+
 // PageableQuerier is an interface for listing with paging of tenant scoped entities with either externally managed tenant accesses (m2m table or view) or embedded tenant in them.
 type PageableQuerier interface {
-	List(ctx context.Context, resourceType resource.Type, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
+	List(ctx context.Context, resourceType resource.Type, tenant string, pageSize int, cursor string, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
 }
 
 // PageableQuerierGlobal is an interface for listing with paging of global entities.
 type PageableQuerierGlobal interface {
-	ListGlobal(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection) (*pagination.Page, int, error)
-	ListGlobalWithAdditionalConditions(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, conditions *ConditionTree) (*pagination.Page, int, error)
-	ListGlobalWithSelectForUpdate(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection) (*pagination.Page, int, error)
+	ListGlobal(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection) (*pagination.Page, int, error)
+	ListGlobalWithAdditionalConditions(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection, conditions *ConditionTree) (*pagination.Page, int, error)
+	ListGlobalWithSelectForUpdate(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection) (*pagination.Page, int, error)
 }
 
 type universalPageableQuerier struct {
 	tableName       string
 	selectedColumns string
+	hasIDColumn     bool
 	tenantColumn    *string
 	resourceType    resource.Type
 }
@@ -38,6 +48,7 @@ func NewPageableQuerierWithEmbeddedTenant(tableName string, tenantColumn string,
 	return &universalPageableQuerier{
 		tableName:       tableName,
 		selectedColumns: strings.Join(selectedColumns, ", "),
+		hasIDColumn:     containsColumn(selectedColumns, idColumn),
 		tenantColumn:    &tenantColumn,
 	}
 }
@@ -47,6 +58,7 @@ func NewPageableQuerier(tableName string, selectedColumns []string) PageableQuer
 	return &universalPageableQuerier{
 		tableName:       tableName,
 		selectedColumns: strings.Join(selectedColumns, ", "),
+		hasIDColumn:     containsColumn(selectedColumns, idColumn),
 	}
 }
 
@@ -55,6 +67,7 @@ func NewPageableQuerierGlobal(resourceType resource.Type, tableName string, sele
 	return &universalPageableQuerier{
 		tableName:       tableName,
 		selectedColumns: strings.Join(selectedColumns, ", "),
+		hasIDColumn:     containsColumn(selectedColumns, idColumn),
 		resourceType:    resourceType,
 	}
 }
@@ -67,14 +80,14 @@ type Collection interface {
 // List lists a page of tenant scoped entities with tenant isolation subquery.
 // If the tenantColumn is configured the isolation is based on equal condition on tenantColumn.
 // If the tenantColumn is not configured an entity with externally managed tenant accesses in m2m table / view is assumed.
-func (g *universalPageableQuerier) List(ctx context.Context, resourceType resource.Type, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error) {
+func (g *universalPageableQuerier) List(ctx context.Context, resourceType resource.Type, tenant string, pageSize int, cursor string, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error) {
 	if tenant == "" {
 		return nil, -1, apperrors.NewTenantRequiredError()
 	}
 
 	if g.tenantColumn != nil {
 		additionalConditions = append(Conditions{NewEqualCondition(*g.tenantColumn, tenant)}, additionalConditions...)
-		return g.list(ctx, resourceType, pageSize, cursor, orderByColumn, dest, NoLock, And(ConditionTreesFromConditions(additionalConditions)...))
+		return g.list(ctx, resourceType, pageSize, cursor, orderBy, dest, NoLock, And(ConditionTreesFromConditions(additionalConditions)...))
 	}
 
 	tenantIsolation, err := NewTenantIsolationCondition(resourceType, tenant, false)
@@ -84,41 +97,59 @@ func (g *universalPageableQuerier) List(ctx context.Context, resourceType resour
 
 	additionalConditions = append(additionalConditions, tenantIsolation)
 
-	return g.list(ctx, resourceType, pageSize, cursor, orderByColumn, dest, NoLock, And(ConditionTreesFromConditions(additionalConditions)...))
+	return g.list(ctx, resourceType, pageSize, cursor, orderBy, dest, NoLock, And(ConditionTreesFromConditions(additionalConditions)...))
 }
 
 // ListGlobal lists a page of global entities without tenant isolation.
-func (g *universalPageableQuerier) ListGlobal(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection) (*pagination.Page, int, error) {
-	return g.list(ctx, g.resourceType, pageSize, cursor, orderByColumn, dest, NoLock, nil)
+func (g *universalPageableQuerier) ListGlobal(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection) (*pagination.Page, int, error) {
+	return g.list(ctx, g.resourceType, pageSize, cursor, orderBy, dest, NoLock, nil)
 }
 
 // ListGlobalWithSelectForUpdate lists a page of global entities without tenant isolation.
-func (g *universalPageableQuerier) ListGlobalWithSelectForUpdate(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection) (*pagination.Page, int, error) {
-	return g.list(ctx, g.resourceType, pageSize, cursor, orderByColumn, dest, ForUpdateLock, nil)
+func (g *universalPageableQuerier) ListGlobalWithSelectForUpdate(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection) (*pagination.Page, int, error) {
+	return g.list(ctx, g.resourceType, pageSize, cursor, orderBy, dest, ForUpdateLock, nil)
 }
 
 // ListGlobalWithAdditionalConditions lists a page of global entities without tenant isolation.
-func (g *universalPageableQuerier) ListGlobalWithAdditionalConditions(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, conditions *ConditionTree) (*pagination.Page, int, error) {
-	return g.list(ctx, g.resourceType, pageSize, cursor, orderByColumn, dest, NoLock, conditions)
+func (g *universalPageableQuerier) ListGlobalWithAdditionalConditions(ctx context.Context, pageSize int, cursor string, orderBy OrderBy, dest Collection, conditions *ConditionTree) (*pagination.Page, int, error) {
+	return g.list(ctx, g.resourceType, pageSize, cursor, orderBy, dest, NoLock, conditions)
 }
 
-func (g *universalPageableQuerier) list(ctx context.Context, resourceType resource.Type, pageSize int, cursor string, orderByColumn string, dest Collection, lockClause string, conditions *ConditionTree) (*pagination.Page, int, error) {
+func (g *universalPageableQuerier) list(ctx context.Context, resourceType resource.Type, pageSize int, cursor string, orderBy OrderBy, dest Collection, lockClause string, conditions *ConditionTree) (*pagination.Page, int, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
+	decodedCursor, err := pagination.DecodeCursor(cursor)
 	if err != nil {
 		return nil, -1, errors.Wrap(err, "while decoding page cursor")
 	}
 
-	paginationSQL, err := pagination.ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset, orderByColumn)
+	orderedColumns := g.orderedColumns(orderBy.Field)
+	sortOrder := pagination.SortOrder(orderBy.Dir)
+
+	paginationSQL, err := pagination.ConvertOrderedColumnsLimitAndOffsetToSQL(orderedColumns, sortOrder, pageSize, decodedCursor.Offset)
 	if err != nil {
 		return nil, -1, errors.Wrap(err, "while converting offset and limit to cursor")
 	}
 
-	query, args, err := buildSelectQueryFromTree(g.tableName, g.selectedColumns, conditions, OrderByParams{}, lockClause, true)
+	pageConditions := conditions
+	if decodedCursor.Keyset != nil {
+		keysetSQL, keysetArgs, err := pagination.ConvertKeysetToSQL(decodedCursor.Keyset, orderedColumns, sortOrder)
+		if err != nil {
+			return nil, -1, errors.Wrap(err, "while converting cursor to condition")
+		}
+
+		keysetConditionTree := &ConditionTree{Operand: &keysetCondition{query: keysetSQL, args: keysetArgs}}
+		if conditions == nil {
+			pageConditions = keysetConditionTree
+		} else {
+			pageConditions = And(conditions, keysetConditionTree)
+		}
+	}
+
+	query, args, err := buildSelectQueryFromTree(g.tableName, g.selectedColumns, pageConditions, OrderByParams{}, lockClause, true)
 	if err != nil {
 		return nil, -1, errors.Wrap(err, "while building list query")
 	}
@@ -136,16 +167,24 @@ func (g *universalPageableQuerier) list(ctx context.Context, resourceType resour
 		return nil, -1, persistence.MapSQLError(ctx, err, resourceType, resource.List, "while fetching list page of objects from '%s' table", g.tableName)
 	}
 
-	var countQuery = query
-	if IsLockClauseProvided(lockClause) {
-		countQuery = strings.ReplaceAll(query, " "+lockClause, "")
+	countQuery, countArgs, err := buildSelectQueryFromTree(g.tableName, g.selectedColumns, conditions, OrderByParams{}, NoLock, true)
+	if err != nil {
+		return nil, -1, errors.Wrap(err, "while building count query")
 	}
-	totalCount, err := g.getTotalCount(ctx, resourceType, persist, countQuery, args)
+
+	totalCount, err := g.getTotalCount(ctx, resourceType, persist, countQuery, countArgs)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	hasNextPage, endCursor := g.getNextPageAndCursor(totalCount, offset, pageSize, dest.Len())
+	hasNextPage := dest.Len() > pageSize
+	endCursor := ""
+	if hasNextPage {
+		if endCursor, err = trimToPageAndEncodeCursor(dest, pageSize, orderedColumns); err != nil {
+			return nil, -1, errors.Wrap(err, "while encoding next page cursor")
+		}
+	}
+
 	return &pagination.Page{
 		StartCursor: cursor,
 		EndCursor:   endCursor,
@@ -153,14 +192,12 @@ func (g *universalPageableQuerier) list(ctx context.Context, resourceType resour
 	}, totalCount, nil
 }
 
-func (g *universalPageableQuerier) getNextPageAndCursor(totalCount, offset, pageSize, totalLen int) (bool, string) {
-	hasNextPage := false
-	endCursor := ""
-	if totalCount > offset+totalLen {
-		hasNextPage = true
-		endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+// orderedColumns returns the columns the pages are ordered by. The ID is added as a tie-breaker, so that the keyset of every item is unique.
+func (g *universalPageableQuerier) orderedColumns(orderByColumn string) []string {
+	if orderByColumn == idColumn || !g.hasIDColumn {
+		return []string{orderByColumn}
 	}
-	return hasNextPage, endCursor
+	return []string{orderByColumn, idColumn}
 }
 
 func (g *universalPageableQuerier) getTotalCount(ctx context.Context, resourceType resource.Type, persist persistence.PersistenceOp, query string, args []interface{}) (int, error) {
@@ -177,3 +214,76 @@ func (g *universalPageableQuerier) getTotalCount(ctx context.Context, resourceTy
 func IsLockClauseProvided(lockClause string) bool {
 	return strings.TrimSpace(lockClause) != NoLock
 }
+
+type keysetCondition struct {
+	query string
+	args  []interface{}
+}
+
+// GetQueryPart returns the keyset comparison
+func (c *keysetCondition) GetQueryPart() string {
+	return c.query
+}
+
+// GetQueryArgs returns the keyset values
+func (c *keysetCondition) GetQueryArgs() ([]interface{}, bool) {
+	return c.args, true
+}
+
+// trimToPageAndEncodeCursor removes the item selected after the last one of the page from dest and encodes the keyset of the last one as a cursor.
+func trimToPageAndEncodeCursor(dest Collection, pageSize int, orderedColumns []string) (string, error) {
+	collection := reflect.Indirect(reflect.ValueOf(dest))
+	if collection.Kind() != reflect.Slice || !collection.CanSet() {
+		return "", errors.Errorf("expected pointer to slice, got %T", dest)
+	}
+	collection.Set(collection.Slice(0, pageSize))
+
+	lastItem := reflect.Indirect(collection.Index(pageSize - 1))
+	keyset, err := keysetOf(lastItem, keysetMapper.TypeMap(lastItem.Type()).Names, orderedColumns)
+	if err != nil {
+		return "", err
+	}
+
+	return pagination.EncodeKeysetCursor(keyset...)
+}
+
+// keysetOf returns the values of the ordered columns of the item
+func keysetOf(item reflect.Value, fields map[string]*reflectx.FieldInfo, orderedColumns []string) ([]interface{}, error) {
+	keyset := make([]interface{}, 0, len(orderedColumns))
+	for _, column := range orderedColumns {
+		value, err := columnValue(item, fields, column)
+		if err != nil {
+			return nil, err
+		}
+		keyset = append(keyset, value)
+	}
+
+	return keyset, nil
+}
+
+// columnValue returns the value of the field of the item the column is mapped to, the same way it is stored in the database
+func columnValue(item reflect.Value, fields map[string]*reflectx.FieldInfo, column string) (interface{}, error) {
+	field, ok := fields[column]
+	if !ok {
+		return nil, errors.Errorf("column %s is not mapped to a field of %s", column, item.Type())
+	}
+
+	value := reflectx.FieldByIndexesReadOnly(item, field.Index).Interface()
+	if valuer, ok := value.(driver.Valuer); ok {
+		var err error
+		if value, err = valuer.Value(); err != nil {
+			return nil, errors.Wrapf(err, "while getting value of column %s", column)
+		}
+	}
+
+	return value, nil
+}
+
+func containsColumn(columns []string, column string) bool {
+	for _, c := range columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/stretchr/testify/assert"
@@ -32,14 +33,14 @@ func TestListPageable(t *testing.T) {
 		rows := sqlmock.NewRows(appColumns).
 			AddRow(appID, appName, appDescription).
 			AddRow(appID2, appName2, appDescription2)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 10 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 11", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 10, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 10, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, actualTotal)
 		assert.Len(t, dest, 2)
@@ -54,15 +55,16 @@ func TestListPageable(t *testing.T) {
 
 		rows := sqlmock.NewRows(appColumns).
 			AddRow(appID, appName, appDescription).
-			AddRow(appID2, appName2, appDescription2)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 2 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			AddRow(appID2, appName2, appDescription2).
+			AddRow(appID3, appName3, appDescription3)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 3", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 2)
@@ -75,23 +77,25 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rowsForPage1 := sqlmock.NewRows(appColumns).
-			AddRow(appID, appName, appDescription)
-		rowsForPage2 := sqlmock.NewRows(appColumns).
+			AddRow(appID, appName, appDescription).
 			AddRow(appID2, appName2, appDescription2)
+		rowsForPage2 := sqlmock.NewRows(appColumns).
+			AddRow(appID2, appName2, appDescription2).
+			AddRow(appID3, appName3, appDescription3)
 
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 1 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 2", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(rowsForPage1)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 1 OFFSET 1", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
-			WithArgs(tenantID).WillReturnRows(rowsForPage2)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE (%s AND (id) > ($2)) ORDER BY id ASC LIMIT 2", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID, appID).WillReturnRows(rowsForPage2)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var first AppCollection
 
-		actualFirstPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, "", "id", &first)
+		actualFirstPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, "", repo.NewAscOrderBy("id"), &first)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, first, 1)
@@ -99,7 +103,7 @@ func TestListPageable(t *testing.T) {
 		assert.NotEmpty(t, actualFirstPage.EndCursor)
 
 		var second AppCollection
-		actualSecondPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, actualFirstPage.EndCursor, "id", &second)
+		actualSecondPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, actualFirstPage.EndCursor, repo.NewAscOrderBy("id"), &second)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, second, 1)
@@ -107,25 +111,111 @@ func TestListPageable(t *testing.T) {
 		assert.NotEmpty(t, actualSecondPage.EndCursor)
 	})
 
+	t.Run("returns next page in descending order with ID as tie-breaker", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(appColumns).
+			AddRow(appID2, appName2, appDescription2).
+			AddRow(appID, appName, appDescription)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE (%s AND ((name, id) < ($2, $3) OR name IS NULL)) ORDER BY name DESC NULLS LAST, id DESC LIMIT 2", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID, appName3, appID3).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest AppCollection
+
+		cursor, err := pagination.EncodeKeysetCursor(appName3, appID3)
+		require.NoError(t, err)
+		expectedEndCursor, err := pagination.EncodeKeysetCursor(appName2, appID2)
+		require.NoError(t, err)
+
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, cursor, repo.NewDescOrderBy("name"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 3, actualTotal)
+		assert.Equal(t, AppCollection{*fixApp2}, dest)
+		assert.True(t, actualPage.HasNextPage)
+		assert.Equal(t, cursor, actualPage.StartCursor)
+		assert.Equal(t, expectedEndCursor, actualPage.EndCursor)
+	})
+
+	t.Run("returns next page after item with NULL sort key", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(appColumns).
+			AddRow(appID2, appName2, appDescription2)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE (%s AND (description IS NULL AND (id) > ($2))) ORDER BY description ASC NULLS LAST, id ASC LIMIT 2", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID, appID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest AppCollection
+
+		cursor, err := pagination.EncodeKeysetCursor(nil, appID)
+		require.NoError(t, err)
+
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, cursor, repo.NewAscOrderBy("description"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, actualTotal)
+		assert.Equal(t, AppCollection{*fixApp2}, dest)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
+	})
+
+	t.Run("returns page for offset cursor and continues with keyset cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(appColumns).
+			AddRow(appID2, appName2, appDescription2).
+			AddRow(appID3, appName3, appDescription3)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 2 OFFSET 1", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest AppCollection
+
+		expectedEndCursor, err := pagination.EncodeKeysetCursor(appID2)
+		require.NoError(t, err)
+
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 1, pagination.EncodeNextOffsetCursor(0, 1), repo.NewAscOrderBy("id"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 3, actualTotal)
+		assert.Equal(t, AppCollection{*fixApp2}, dest)
+		assert.True(t, actualPage.HasNextPage)
+		assert.Equal(t, expectedEndCursor, actualPage.EndCursor)
+	})
+
+	t.Run("returns error if keyset cursor does not match ordered columns", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		cursor, err := pagination.EncodeKeysetCursor(appID)
+		require.NoError(t, err)
+
+		_, _, err = sut.List(ctx, resourceType, tenantID, 2, cursor, repo.NewAscOrderBy("name"), nil)
+		require.EqualError(t, err, "while converting cursor to condition: Invalid data [reason=cursor is not correct]")
+	})
+
 	t.Run("returns page without conditions", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows(appColumns).
 			AddRow(appID, appName, appDescription)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 2 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 3", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns page with additional conditions", func(t *testing.T) {
@@ -134,7 +224,7 @@ func TestListPageable(t *testing.T) {
 
 		rows := sqlmock.NewRows(appColumns).
 			AddRow(appID, appName, appDescription)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE (name = $1 AND description != $2 AND %s) ORDER BY id LIMIT 2 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$3")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE (name = $1 AND description != $2 AND %s) ORDER BY id ASC LIMIT 3", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$3")))).
 			WithArgs(appName, appDescription2, tenantID).
 			WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE (name = $1 AND description != $2 AND %s)", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$3")))).
@@ -148,12 +238,12 @@ func TestListPageable(t *testing.T) {
 			repo.NewNotEqualCondition("description", appDescription2),
 		}
 
-		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest, conditions...)
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest, conditions...)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns empty page", func(t *testing.T) {
@@ -161,14 +251,14 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows(appColumns)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 2 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 3", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(0))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 0, actualTotal)
 		assert.Empty(t, dest)
@@ -177,25 +267,25 @@ func TestListPageable(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", nil)
+		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if empty tenant", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.List(ctx, resourceType, "", 2, "", "id", nil)
+		_, _, err := sut.List(ctx, resourceType, "", 2, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, apperrors.NewTenantRequiredError().Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "zzz", "", nil)
+		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "zzz", repo.NewAscOrderBy(""), nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
 	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, resourceType, tenantID, -3, "", "id", nil)
+		_, _, err := sut.List(ctx, resourceType, tenantID, -3, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, "while converting offset and limit to cursor: Invalid data [reason=page size cannot be smaller than 1]")
 	})
 
@@ -207,7 +297,7 @@ func TestListPageable(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest)
+		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -217,14 +307,14 @@ func TestListPageable(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows(appColumns)
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id LIMIT 2 OFFSET 0", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id, name, description FROM %s WHERE %s ORDER BY id ASC LIMIT 3", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WillReturnError(someError())
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", "id", &dest)
+		_, _, err := sut.List(ctx, resourceType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 }
@@ -236,6 +326,7 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 	peterRow := []driver.Value{peterID, "Peter", "Griffin", 40}
 	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, ID: homerID}
 	homerRow := []driver.Value{homerID, "Homer", "Simpson", 55}
+	margeRow := []driver.Value{"margeID", "Marge", "Simpson", 38}
 
 	sut := repo.NewPageableQuerierWithEmbeddedTenant(userTableName, "tenant_id", []string{"id", "first_name", "last_name", "age"})
 
@@ -246,12 +337,12 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...).
 			AddRow(homerRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 10 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 11`)).WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 10, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 10, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, actualTotal)
 		assert.Len(t, dest, 2)
@@ -266,13 +357,14 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...).
-			AddRow(homerRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 2 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rows)
+			AddRow(homerRow...).
+			AddRow(margeRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 3`)).WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 2)
@@ -285,19 +377,21 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rowsForPage1 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
-			AddRow(peterRow...)
-		rowsForPage2 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
 			AddRow(homerRow...)
+		rowsForPage2 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
+			AddRow(homerRow...).
+			AddRow(margeRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 1 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rowsForPage1)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 2`)).WithArgs(tenantID).WillReturnRows(rowsForPage1)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 1 OFFSET 1`)).WithArgs(tenantID).WillReturnRows(rowsForPage2)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE (tenant_id = $1 AND (id) > ($2)) ORDER BY id ASC LIMIT 2`)).WithArgs(tenantID, peterID).WillReturnRows(rowsForPage2)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var first UserCollection
 
-		actualFirstPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 1, "", "id", &first)
+		actualFirstPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 1, "", repo.NewAscOrderBy("id"), &first)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, first, 1)
@@ -305,7 +399,7 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		assert.NotEmpty(t, actualFirstPage.EndCursor)
 
 		var second UserCollection
-		actualSecondPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 1, actualFirstPage.EndCursor, "id", &second)
+		actualSecondPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 1, actualFirstPage.EndCursor, repo.NewAscOrderBy("id"), &second)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, second, 1)
@@ -319,17 +413,17 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...)
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 2 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 3`)).WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns page with additional conditions", func(t *testing.T) {
@@ -338,7 +432,7 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...)
-		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, first_name, last_name, age FROM users WHERE (tenant_id = $1 AND first_name = $2 AND age != $3) ORDER BY id LIMIT 2 OFFSET 0")).
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, first_name, last_name, age FROM users WHERE (tenant_id = $1 AND first_name = $2 AND age != $3) ORDER BY id ASC LIMIT 3")).
 			WithArgs(tenantID, "Peter", 18).
 			WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM users WHERE (tenant_id = $1 AND first_name = $2 AND age != $3)")).
@@ -352,12 +446,12 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 			repo.NewNotEqualCondition("age", 18),
 		}
 
-		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest, conditions...)
+		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest, conditions...)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns empty page", func(t *testing.T) {
@@ -365,12 +459,12 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"})
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 2 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 3`)).WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users WHERE tenant_id = $1`)).WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(0))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 0, actualTotal)
 		assert.Empty(t, dest)
@@ -379,19 +473,19 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", "id", nil)
+		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, UserType, tenantID, 2, "zzz", "", nil)
+		_, _, err := sut.List(ctx, UserType, tenantID, 2, "zzz", repo.NewAscOrderBy(""), nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
 	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.List(ctx, UserType, tenantID, -3, "", "id", nil)
+		_, _, err := sut.List(ctx, UserType, tenantID, -3, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, "while converting offset and limit to cursor: Invalid data [reason=page size cannot be smaller than 1]")
 	})
 
@@ -403,7 +497,7 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -418,7 +512,7 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		ctx = persistence.SaveToContext(ctx, db)
 		var dest UserCollection
 
-		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Maximum processing timeout reached")
 	})
@@ -428,12 +522,12 @@ func TestListPageableWithEmbeddedTenant(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"})
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id LIMIT 2 OFFSET 0`)).WithArgs(tenantID).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY id ASC LIMIT 3`)).WithArgs(tenantID).WillReturnRows(rows)
 		mock.ExpectQuery(`SELECT COUNT\(\*\).*`).WillReturnError(someError())
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", "id", &dest)
+		_, _, err := sut.List(ctx, UserType, tenantID, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 }
@@ -445,6 +539,7 @@ func TestListPageableGlobal(t *testing.T) {
 	peterRow := []driver.Value{peterID, "Peter", "Griffin", 40}
 	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, ID: homerID}
 	homerRow := []driver.Value{homerID, "Homer", "Simpson", 55}
+	margeRow := []driver.Value{"margeID", "Marge", "Simpson", 38}
 
 	sut := repo.NewPageableQuerierGlobal("UserType", "users",
 		[]string{"id", "first_name", "last_name", "age"})
@@ -456,7 +551,7 @@ func TestListPageableGlobal(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobal(ctx, 10, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobal(ctx, 10, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, actualTotal)
 		assert.Len(t, dest, 2)
@@ -467,12 +562,12 @@ func TestListPageableGlobal(t *testing.T) {
 
 	t.Run("returns full page and has next page", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
-		mockListOnePageOfManyDBSelect(peterRow, homerRow, mock, repo.NoLock)
+		mockListOnePageOfManyDBSelect(peterRow, homerRow, margeRow, mock, repo.NoLock)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 2)
@@ -482,12 +577,12 @@ func TestListPageableGlobal(t *testing.T) {
 
 	t.Run("returns many pages and I can traverse it using cursor", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
-		mockListManyPagesDBSelect(peterRow, homerRow, mock, repo.NoLock)
+		mockListManyPagesDBSelect(peterRow, homerRow, margeRow, mock, repo.NoLock)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
 		var first UserCollection
-		actualFirstPage, actualTotal, err := sut.ListGlobal(ctx, 1, "", "id", &first)
+		actualFirstPage, actualTotal, err := sut.ListGlobal(ctx, 1, "", repo.NewAscOrderBy("id"), &first)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, first, 1)
@@ -495,7 +590,7 @@ func TestListPageableGlobal(t *testing.T) {
 		assert.NotEmpty(t, actualFirstPage.EndCursor)
 
 		var second UserCollection
-		actualSecondPage, actualTotal, err := sut.ListGlobal(ctx, 1, actualFirstPage.EndCursor, "id", &second)
+		actualSecondPage, actualTotal, err := sut.ListGlobal(ctx, 1, actualFirstPage.EndCursor, repo.NewAscOrderBy("id"), &second)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, second, 1)
@@ -510,12 +605,12 @@ func TestListPageableGlobal(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns page with additional conditions", func(t *testing.T) {
@@ -530,12 +625,12 @@ func TestListPageableGlobal(t *testing.T) {
 			repo.NewNotEqualCondition("age", 18),
 		}
 
-		actualPage, actualTotal, err := sut.ListGlobalWithAdditionalConditions(ctx, 2, "", "id", &dest, repo.And(repo.ConditionTreesFromConditions(conditions)...))
+		actualPage, actualTotal, err := sut.ListGlobalWithAdditionalConditions(ctx, 2, "", repo.NewAscOrderBy("id"), &dest, repo.And(repo.ConditionTreesFromConditions(conditions)...))
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns empty page", func(t *testing.T) {
@@ -545,7 +640,7 @@ func TestListPageableGlobal(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 0, actualTotal)
 		assert.Empty(t, dest)
@@ -554,19 +649,19 @@ func TestListPageableGlobal(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.ListGlobal(ctx, 2, "", "id", nil)
+		_, _, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.ListGlobal(ctx, 2, "zzz", "", nil)
+		_, _, err := sut.ListGlobal(ctx, 2, "zzz", repo.NewAscOrderBy(""), nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
 	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.ListGlobal(ctx, -3, "", "id", nil)
+		_, _, err := sut.ListGlobal(ctx, -3, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, "while converting offset and limit to cursor: Invalid data [reason=page size cannot be smaller than 1]")
 	})
 
@@ -578,7 +673,7 @@ func TestListPageableGlobal(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -593,7 +688,7 @@ func TestListPageableGlobal(t *testing.T) {
 		ctx = persistence.SaveToContext(ctx, db)
 		var dest UserCollection
 
-		_, _, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Maximum processing timeout reached")
 	})
@@ -605,7 +700,7 @@ func TestListPageableGlobal(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		_, _, err := sut.ListGlobal(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobal(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 }
@@ -617,6 +712,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 	peterRow := []driver.Value{peterID, "Peter", "Griffin", 40}
 	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, ID: homerID}
 	homerRow := []driver.Value{homerID, "Homer", "Simpson", 55}
+	margeRow := []driver.Value{"margeID", "Marge", "Simpson", 38}
 
 	sut := repo.NewPageableQuerierGlobal("UserType", "users",
 		[]string{"id", "first_name", "last_name", "age"})
@@ -628,7 +724,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 10, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 10, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, actualTotal)
 		assert.Len(t, dest, 2)
@@ -639,12 +735,12 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 
 	t.Run("returns full page and has next page", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
-		mockListOnePageOfManyDBSelect(peterRow, homerRow, mock, repo.ForUpdateLock)
+		mockListOnePageOfManyDBSelect(peterRow, homerRow, margeRow, mock, repo.ForUpdateLock)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 2)
@@ -654,12 +750,12 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 
 	t.Run("returns many pages and I can traverse it using cursor", func(t *testing.T) {
 		db, mock := testdb.MockDatabase(t)
-		mockListManyPagesDBSelect(peterRow, homerRow, mock, repo.ForUpdateLock)
+		mockListManyPagesDBSelect(peterRow, homerRow, margeRow, mock, repo.ForUpdateLock)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
 		var first UserCollection
-		actualFirstPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 1, "", "id", &first)
+		actualFirstPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 1, "", repo.NewAscOrderBy("id"), &first)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, first, 1)
@@ -667,7 +763,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		assert.NotEmpty(t, actualFirstPage.EndCursor)
 
 		var second UserCollection
-		actualSecondPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 1, actualFirstPage.EndCursor, "id", &second)
+		actualSecondPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 1, actualFirstPage.EndCursor, repo.NewAscOrderBy("id"), &second)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, second, 1)
@@ -682,12 +778,12 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 100, actualTotal)
 		assert.Len(t, dest, 1)
-		assert.True(t, actualPage.HasNextPage)
-		assert.NotEmpty(t, actualPage.EndCursor)
+		assert.False(t, actualPage.HasNextPage)
+		assert.Empty(t, actualPage.EndCursor)
 	})
 
 	t.Run("returns empty page", func(t *testing.T) {
@@ -697,7 +793,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		actualPage, actualTotal, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 0, actualTotal)
 		assert.Empty(t, dest)
@@ -706,19 +802,19 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", nil)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "zzz", "", nil)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "zzz", repo.NewAscOrderBy(""), nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
 	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, -3, "", "id", nil)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, -3, "", repo.NewAscOrderBy("id"), nil)
 		require.EqualError(t, err, "while converting offset and limit to cursor: Invalid data [reason=page size cannot be smaller than 1]")
 	})
 
@@ -730,7 +826,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -745,7 +841,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		ctx = persistence.SaveToContext(ctx, db)
 		var dest UserCollection
 
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Maximum processing timeout reached")
 	})
@@ -757,7 +853,7 @@ func TestListPageableGlobalWithSelectForUpdate(t *testing.T) {
 		defer mock.AssertExpectations(t)
 
 		var dest UserCollection
-		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", "id", &dest)
+		_, _, err := sut.ListGlobalWithSelectForUpdate(ctx, 2, "", repo.NewAscOrderBy("id"), &dest)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 }
@@ -766,41 +862,44 @@ func mockListOnePageDBSelect(peterRow []driver.Value, homerRow []driver.Value, m
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 		AddRow(peterRow...).
 		AddRow(homerRow...)
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 10 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 11` + PrepareLockClause(lockClause))).WillReturnRows(rows)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
 }
 
-func mockListOnePageOfManyDBSelect(peterRow []driver.Value, homerRow []driver.Value, mock testdb.DBMock, lockClause string) {
+func mockListOnePageOfManyDBSelect(peterRow []driver.Value, homerRow []driver.Value, margeRow []driver.Value, mock testdb.DBMock, lockClause string) {
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 		AddRow(peterRow...).
-		AddRow(homerRow...)
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 2 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rows)
+		AddRow(homerRow...).
+		AddRow(margeRow...)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 3` + PrepareLockClause(lockClause))).WillReturnRows(rows)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 }
 
-func mockListManyPagesDBSelect(peterRow []driver.Value, homerRow []driver.Value, mock testdb.DBMock, lockClause string) {
+func mockListManyPagesDBSelect(peterRow []driver.Value, homerRow []driver.Value, margeRow []driver.Value, mock testdb.DBMock, lockClause string) {
 	rowsForPage1 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
-		AddRow(peterRow...)
-	rowsForPage2 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
+		AddRow(peterRow...).
 		AddRow(homerRow...)
+	rowsForPage2 := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
+		AddRow(homerRow...).
+		AddRow(margeRow...)
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 1 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rowsForPage1)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 2` + PrepareLockClause(lockClause))).WillReturnRows(rowsForPage1)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 1 OFFSET 1` + PrepareLockClause(lockClause))).WillReturnRows(rowsForPage2)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users WHERE (id) > ($1) ORDER BY id ASC LIMIT 2` + PrepareLockClause(lockClause))).WithArgs(peterRow[0]).WillReturnRows(rowsForPage2)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 }
 
 func mockListOnePageDBSelectWithoutConditions(peterRow []driver.Value, mock testdb.DBMock, lockClause string) {
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 		AddRow(peterRow...)
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 2 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 3` + PrepareLockClause(lockClause))).WillReturnRows(rows)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(100))
 }
 
 func mockListOnePageDBSelectWithConditions(peterRow []driver.Value, mock testdb.DBMock, lockClause string) {
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
 		AddRow(peterRow...)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, first_name, last_name, age FROM users WHERE (first_name = $1 AND age != $2) ORDER BY id LIMIT 2 OFFSET 0"+PrepareLockClause(lockClause))).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, first_name, last_name, age FROM users WHERE (first_name = $1 AND age != $2) ORDER BY id ASC LIMIT 3"+PrepareLockClause(lockClause))).
 		WithArgs("Peter", 18).
 		WillReturnRows(rows)
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM users WHERE (first_name = $1 AND age != $2)")).
@@ -810,12 +909,12 @@ func mockListOnePageDBSelectWithConditions(peterRow []driver.Value, mock testdb.
 
 func mockListNoPagesDBSelect(mock testdb.DBMock, lockClause string) {
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"})
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 2 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 3` + PrepareLockClause(lockClause))).WillReturnRows(rows)
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM users`)).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(0))
 }
 
 func mockListPageableDBSelectWithCountError(mock testdb.DBMock, db *sqlx.DB, lockClause string) {
 	rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"})
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id LIMIT 2 OFFSET 0` + PrepareLockClause(lockClause))).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, first_name, last_name, age FROM users ORDER BY id ASC LIMIT 3` + PrepareLockClause(lockClause))).WillReturnRows(rows)
 	mock.ExpectQuery(`SELECT COUNT\(\*\).*`).WillReturnError(someError())
 }
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"reflect"
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -15,14 +16,14 @@ import (
 // UnionLister is an interface for listing tenant scoped entities with either externally managed tenant accesses (m2m table or view) or embedded tenant in them.
 // It lists entities based on multiple parent queries. For each parent a separate list with a separate tenant isolation subquery is created and the end result is a union of all the results.
 type UnionLister interface {
-	// List stores the result into dest and returns the total count of tuples for each id from ids
-	List(ctx context.Context, resourceType resource.Type, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, error)
+	// List stores the result into dest and returns the total count of tuples and the page info for each id from ids
+	List(ctx context.Context, resourceType resource.Type, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, map[string]*pagination.Page, error)
 }
 
 // UnionListerGlobal is an interface for listing global entities.
 // It lists entities based on multiple parent queries. For each parent a separate list with a separate tenant isolation subquery is created and the end result is a union of all the results.
 type UnionListerGlobal interface {
-	ListGlobal(ctx context.Context, ids []string, idsColumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, error)
+	ListGlobal(ctx context.Context, ids []string, idsColumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, map[string]*pagination.Page, error)
 	SetSelectedColumns(selectedColumns []string)
 	Clone() *unionLister
 }
@@ -80,9 +81,9 @@ func (l *unionLister) Clone() *unionLister {
 // List lists tenant scoped entities based on multiple parent queries. For each parent a separate list with a separate tenant isolation subquery is created and the end result is a union of all the results.
 // If the tenantColumn is configured the isolation is based on equal condition on tenantColumn.
 // If the tenantColumn is not configured an entity with externally managed tenant accesses in m2m table / view is assumed.
-func (l *unionLister) List(ctx context.Context, resourceType resource.Type, tenant string, ids []string, idscolumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, error) {
+func (l *unionLister) List(ctx context.Context, resourceType resource.Type, tenant string, ids []string, idscolumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, map[string]*pagination.Page, error) {
 	if tenant == "" {
-		return nil, apperrors.NewTenantRequiredError()
+		return nil, nil, apperrors.NewTenantRequiredError()
 	}
 
 	if l.tenantColumn != nil {
@@ -92,7 +93,7 @@ func (l *unionLister) List(ctx context.Context, resourceType resource.Type, tena
 
 	tenantIsolation, err := NewTenantIsolationCondition(resourceType, tenant, false)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	additionalConditions = append(additionalConditions, tenantIsolation)
@@ -101,7 +102,7 @@ func (l *unionLister) List(ctx context.Context, resourceType resource.Type, tena
 }
 
 // ListGlobal lists global entities without tenant isolation.
-func (l *unionLister) ListGlobal(ctx context.Context, ids []string, idscolumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, error) {
+func (l *unionLister) ListGlobal(ctx context.Context, ids []string, idscolumn string, pageSize int, cursor string, orderBy OrderByParams, dest Collection, additionalConditions ...Condition) (map[string]int, map[string]*pagination.Page, error) {
 	return l.list(ctx, l.resourceType, pageSize, cursor, orderBy, ids, idscolumn, dest, additionalConditions...)
 }
 
@@ -110,20 +111,32 @@ type queryStruct struct {
 	statement string
 }
 
-func (l *unionLister) list(ctx context.Context, resourceType resource.Type, pageSize int, cursor string, orderBy OrderByParams, ids []string, idsColumn string, dest Collection, conditions ...Condition) (map[string]int, error) {
+func (l *unionLister) list(ctx context.Context, resourceType resource.Type, pageSize int, cursor string, orderBy OrderByParams, ids []string, idsColumn string, dest Collection, conditions ...Condition) (map[string]int, map[string]*pagination.Page, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	offset, err := pagination.DecodeOffsetCursor(cursor)
+	decodedCursor, err := pagination.DecodeCursor(cursor)
 	if err != nil {
-		return nil, errors.Wrap(err, "while decoding page cursor")
+		return nil, nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	keysetColumns := unionKeysetColumns(orderBy, idsColumn)
+	pageConditions := conditions
+	if decodedCursor.Keyset != nil {
+		keysetSQL, keysetArgs, err := pagination.ConvertKeysetToSQL(decodedCursor.Keyset, keysetColumns, unionSortOrder(orderBy))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while converting cursor to condition")
+		}
+
+		pageConditions = append(append(make(Conditions, 0, len(conditions)+1), conditions...), &keysetCondition{query: keysetSQL, args: keysetArgs})
 	}
 
-	queries, err := l.buildQueries(ids, idsColumn, conditions, orderBy, pageSize, offset)
+	// One item more than the page size is selected for each parent, so that it can be determined whether there is a next page
+	queries, err := l.buildQueries(ids, idsColumn, pageConditions, orderBy, pageSize+1, decodedCursor.Offset)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	stmts := make([]string, 0, len(queries))
@@ -136,19 +149,27 @@ func (l *unionLister) list(ctx context.Context, resourceType resource.Type, page
 		args = append(args, q.args...)
 	}
 
-	query := buildUnionQuery(stmts)
+	query, err := buildUnionQuery(stmts, orderBy)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while building union query")
+	}
 
 	err = persist.SelectContext(ctx, dest, query, args...)
 	if err != nil {
-		return nil, persistence.MapSQLError(ctx, err, resourceType, resource.List, "while fetching list page of objects from '%s' table", l.tableName)
+		return nil, nil, persistence.MapSQLError(ctx, err, resourceType, resource.List, "while fetching list page of objects from '%s' table", l.tableName)
+	}
+
+	pages, err := trimToPagesAndEncodeCursors(dest, ids, idsColumn, pageSize, cursor, keysetColumns)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while encoding next page cursors")
 	}
 
 	totalCount, err := l.getTotalCount(ctx, resourceType, persist, idsColumn, []string{idsColumn}, OrderByParams{NewAscOrderBy(idsColumn)}, conditions)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return totalCount, nil
+	return totalCount, pages, nil
 }
 
 func (l *unionLister) buildQueries(ids []string, idsColumn string, conditions []Condition, orderBy OrderByParams, limit int, offset int) ([]queryStruct, error) {
@@ -167,6 +188,92 @@ func (l *unionLister) buildQueries(ids []string, idsColumn string, conditions []
 	return queries, nil
 }
 
+// unionKeysetColumns returns the columns the keyset cursors consist of. The ids column is left out, as it has the same value for all entities of a parent.
+// This way a cursor can be used for the entities of all parents, the same way an offset cursor can.
+func unionKeysetColumns(orderBy OrderByParams, idsColumn string) []string {
+	columns := make([]string, 0, len(orderBy))
+	for _, o := range orderBy {
+		if o.Field != idsColumn {
+			columns = append(columns, o.Field)
+		}
+	}
+
+	if len(columns) == 0 && len(orderBy) > 0 {
+		return []string{idsColumn}
+	}
+	return columns
+}
+
+func unionSortOrder(orderBy OrderByParams) pagination.SortOrder {
+	if len(orderBy) == 0 {
+		return pagination.AscendingOrder
+	}
+	return pagination.SortOrder(orderBy[0].Dir)
+}
+
+// trimToPagesAndEncodeCursors removes the item selected after the last one of the page of each parent from dest,
+// and returns the page info of each parent with the keyset of its last item encoded as the cursor to the next page.
+// The items of each parent are expected to be sorted in dest.
+func trimToPagesAndEncodeCursors(dest Collection, ids []string, idsColumn string, pageSize int, cursor string, keysetColumns []string) (map[string]*pagination.Page, error) {
+	pages := make(map[string]*pagination.Page, len(ids))
+	for _, id := range ids {
+		pages[id] = &pagination.Page{StartCursor: cursor}
+	}
+
+	collection := reflect.Indirect(reflect.ValueOf(dest))
+	if collection.Kind() != reflect.Slice || !collection.CanSet() {
+		return nil, errors.Errorf("expected pointer to slice, got %T", dest)
+	}
+
+	itemType := collection.Type().Elem()
+	if itemType.Kind() == reflect.Ptr {
+		itemType = itemType.Elem()
+	}
+	fields := keysetMapper.TypeMap(itemType).Names
+
+	pageItems := reflect.MakeSlice(collection.Type(), 0, collection.Len())
+	lastItems := make(map[string]reflect.Value, len(ids))
+	itemsCount := make(map[string]int, len(ids))
+	for i := 0; i < collection.Len(); i++ {
+		item := collection.Index(i)
+		value, err := columnValue(reflect.Indirect(item), fields, idsColumn)
+		if err != nil {
+			return nil, err
+		}
+
+		id, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("expected column %s to be a string, got %T", idsColumn, value)
+		}
+
+		itemsCount[id]++
+		if itemsCount[id] <= pageSize {
+			pageItems = reflect.Append(pageItems, item)
+			lastItems[id] = item
+			continue
+		}
+
+		keyset, err := keysetOf(reflect.Indirect(lastItems[id]), fields, keysetColumns)
+		if err != nil {
+			return nil, err
+		}
+
+		endCursor, err := pagination.EncodeKeysetCursor(keyset...)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := pages[id]; !ok {
+			pages[id] = &pagination.Page{StartCursor: cursor}
+		}
+		pages[id].EndCursor = endCursor
+		pages[id].HasNextPage = true
+	}
+
+	collection.Set(pageItems)
+	return pages, nil
+}
+
 type idToCount struct {
 	ID    string `db:"id"`
 	Count int    `db:"total_count"`
@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/stretchr/testify/assert"
@@ -32,16 +33,16 @@ func TestUnionList(t *testing.T) {
 			AddRow(appID, appName, appDescription).
 			AddRow(appID2, appName2, appDescription2)
 
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)",
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1"), appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$5")))).
-			WithArgs(tenantID, appID, 10, 0, tenantID, appID2, 10, 0).WillReturnRows(rows)
+			WithArgs(tenantID, appID, 11, 0, tenantID, appID2, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id AS id, COUNT(*) AS total_count FROM %s WHERE %s GROUP BY id ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1).AddRow(appID2, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		counts, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(counts))
 		assert.Equal(t, 1, counts[appID])
@@ -58,16 +59,16 @@ func TestUnionList(t *testing.T) {
 		rows := sqlmock.NewRows(appColumns).
 			AddRow(appID, appName, appDescription)
 
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE name = $1 AND %s AND id = $3 ORDER BY id ASC LIMIT $4 OFFSET $5) UNION (SELECT id, name, description FROM %s WHERE name = $6 AND %s AND id = $8 ORDER BY id ASC LIMIT $9 OFFSET $10)",
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE name = $1 AND %s AND id = $3 ORDER BY id ASC LIMIT $4 OFFSET $5) UNION (SELECT id, name, description FROM %s WHERE name = $6 AND %s AND id = $8 ORDER BY id ASC LIMIT $9 OFFSET $10) ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$2"), appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$7")))).
-			WithArgs(appName, tenantID, appID, 10, 0, appName, tenantID, appID2, 10, 0).WillReturnRows(rows)
+			WithArgs(appName, tenantID, appID, 11, 0, appName, tenantID, appID2, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id AS id, COUNT(*) AS total_count FROM %s WHERE name = $1 AND %s GROUP BY id ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$2")))).
 			WithArgs(appName, tenantID).WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		counts, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("name", appName))
+		counts, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("name", appName))
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(counts))
 		assert.Equal(t, 1, counts[appID])
@@ -80,12 +81,12 @@ func TestUnionList(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)",
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1"), appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$5")))).
-			WithArgs(tenantID, appID, 10, 0, tenantID, appID2, 10, 0).WillReturnError(someError())
+			WithArgs(tenantID, appID, 11, 0, tenantID, appID2, 11, 0).WillReturnError(someError())
 		var dest AppCollection
 
-		counts, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
@@ -100,15 +101,15 @@ func TestUnionList(t *testing.T) {
 			AddRow(appID, appName, appDescription).
 			AddRow(appID2, appName2, appDescription2)
 
-		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)",
+		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("(SELECT id, name, description FROM %s WHERE %s AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, name, description FROM %s WHERE %s AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1"), appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$5")))).
-			WithArgs(tenantID, appID, 10, 0, tenantID, appID2, 10, 0).WillReturnRows(rows)
+			WithArgs(tenantID, appID, 11, 0, tenantID, appID2, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta(fmt.Sprintf("SELECT id AS id, COUNT(*) AS total_count FROM %s WHERE %s GROUP BY id ORDER BY id ASC",
 			appTableName, fmt.Sprintf(tenantIsolationConditionWithoutOwnerCheckFmt, m2mTable, "$1")))).
 			WithArgs(tenantID).WillReturnError(someError())
 		var dest AppCollection
 
-		counts, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
@@ -116,19 +117,19 @@ func TestUnionList(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if empty tenant", func(t *testing.T) {
 		ctx := context.TODO()
-		_, err := sut.List(ctx, resourceType, "", []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.List(ctx, resourceType, "", []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, apperrors.NewTenantRequiredError().Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
@@ -140,7 +141,7 @@ func TestUnionList(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest AppCollection
 
-		_, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		_, _, err := sut.List(ctx, resourceType, tenantID, []string{appID, appID2}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -164,14 +165,14 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 			AddRow(peterRow...).
 			AddRow(homerRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)")).
-			WithArgs(tenantID, peterID, 10, 0, tenantID, homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC")).
+			WithArgs(tenantID, peterID, 11, 0, tenantID, homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users WHERE tenant_id = $1 GROUP BY id ORDER BY id ASC")).
 			WithArgs(tenantID).WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(peterID, 1).AddRow(homerID, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		counts, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(counts))
 		assert.Equal(t, 1, counts[peterID])
@@ -188,14 +189,14 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND first_name = $2 AND id = $3 ORDER BY id ASC LIMIT $4 OFFSET $5) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $6 AND first_name = $7 AND id = $8 ORDER BY id ASC LIMIT $9 OFFSET $10)")).
-			WithArgs(tenantID, "Peter", peterID, 10, 0, tenantID, "Peter", homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND first_name = $2 AND id = $3 ORDER BY id ASC LIMIT $4 OFFSET $5) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $6 AND first_name = $7 AND id = $8 ORDER BY id ASC LIMIT $9 OFFSET $10) ORDER BY id ASC")).
+			WithArgs(tenantID, "Peter", peterID, 11, 0, tenantID, "Peter", homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users WHERE tenant_id = $1 AND first_name = $2 GROUP BY id ORDER BY id ASC")).
 			WithArgs(tenantID, "Peter").WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(peterID, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		counts, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("first_name", "Peter"))
+		counts, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("first_name", "Peter"))
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(counts))
 		assert.Equal(t, 1, counts[peterID])
@@ -208,11 +209,11 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)")).
-			WithArgs(tenantID, peterID, 10, 0, tenantID, homerID, 10, 0).WillReturnError(someError())
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC")).
+			WithArgs(tenantID, peterID, 11, 0, tenantID, homerID, 11, 0).WillReturnError(someError())
 		var dest UserCollection
 
-		counts, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
@@ -227,13 +228,13 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 			AddRow(peterRow...).
 			AddRow(homerRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)")).
-			WithArgs(tenantID, peterID, 10, 0, tenantID, homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC")).
+			WithArgs(tenantID, peterID, 11, 0, tenantID, homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users WHERE tenant_id = $1 GROUP BY id ORDER BY id ASC")).
 			WithArgs(tenantID).WillReturnError(someError())
 		var dest UserCollection
 
-		counts, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
@@ -241,13 +242,13 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
@@ -259,7 +260,7 @@ func TestUnionListWithEmbeddedTenant(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		_, _, err := sut.List(ctx, UserType, tenantID, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -283,14 +284,14 @@ func TestUnionListGlobal(t *testing.T) {
 			AddRow(peterRow...).
 			AddRow(homerRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6)")).
-			WithArgs(peterID, 10, 0, homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6) ORDER BY id ASC")).
+			WithArgs(peterID, 11, 0, homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users GROUP BY id ORDER BY id ASC")).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(peterID, 1).AddRow(homerID, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		counts, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(counts))
 		assert.Equal(t, 1, counts[peterID])
@@ -307,14 +308,14 @@ func TestUnionListGlobal(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "age"}).
 			AddRow(peterRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE first_name = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE first_name = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8)")).
-			WithArgs("Peter", peterID, 10, 0, "Peter", homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE first_name = $1 AND id = $2 ORDER BY id ASC LIMIT $3 OFFSET $4) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE first_name = $5 AND id = $6 ORDER BY id ASC LIMIT $7 OFFSET $8) ORDER BY id ASC")).
+			WithArgs("Peter", peterID, 11, 0, "Peter", homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users WHERE first_name = $1 GROUP BY id ORDER BY id ASC")).
 			WithArgs("Peter").WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(peterID, 1))
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		counts, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("first_name", "Peter"))
+		counts, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest, repo.NewEqualCondition("first_name", "Peter"))
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(counts))
 		assert.Equal(t, 1, counts[peterID])
@@ -327,11 +328,11 @@ func TestUnionListGlobal(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		defer mock.AssertExpectations(t)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6)")).
-			WithArgs(peterID, 10, 0, homerID, 10, 0).WillReturnError(someError())
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6) ORDER BY id ASC")).
+			WithArgs(peterID, 11, 0, homerID, 11, 0).WillReturnError(someError())
 		var dest UserCollection
 
-		counts, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
@@ -345,27 +346,111 @@ func TestUnionListGlobal(t *testing.T) {
 			AddRow(peterRow...).
 			AddRow(homerRow...)
 
-		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6)")).
-			WithArgs(peterID, 10, 0, homerID, 10, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $1 ORDER BY id ASC LIMIT $2 OFFSET $3) UNION (SELECT id, tenant_id, first_name, last_name, age FROM users WHERE id = $4 ORDER BY id ASC LIMIT $5 OFFSET $6) ORDER BY id ASC")).
+			WithArgs(peterID, 11, 0, homerID, 11, 0).WillReturnRows(rows)
 		mock.ExpectQuery(regexp.QuoteMeta("SELECT id AS id, COUNT(*) AS total_count FROM users GROUP BY id ORDER BY id ASC")).WillReturnError(someError())
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		counts, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		counts, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 		require.Error(t, err)
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 		require.Nil(t, counts)
 	})
 
+	t.Run("success with next page", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(homerRow...).
+			AddRow(peterRow...)
+
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY tenant_id ASC, id ASC LIMIT $2 OFFSET $3) ORDER BY tenant_id ASC, id ASC")).
+			WithArgs(tenantID, 2, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT tenant_id AS id, COUNT(*) AS total_count FROM users GROUP BY tenant_id ORDER BY tenant_id ASC")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(tenantID, 2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		expectedEndCursor, err := pagination.EncodeKeysetCursor(homerID)
+		require.NoError(t, err)
+
+		counts, pages, err := sut.ListGlobal(ctx, []string{tenantID}, "tenant_id", 1, "", repo.OrderByParams{repo.NewAscOrderBy("tenant_id"), repo.NewAscOrderBy("id")}, &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, counts[tenantID])
+		assert.Equal(t, &pagination.Page{EndCursor: expectedEndCursor, HasNextPage: true}, pages[tenantID])
+		assert.Len(t, dest, 1)
+		assert.Equal(t, homer, dest[0])
+	})
+
+	t.Run("success with keyset cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE (id) > ($1) AND tenant_id = $2 ORDER BY tenant_id ASC, id ASC LIMIT $3 OFFSET $4) ORDER BY tenant_id ASC, id ASC")).
+			WithArgs(homerID, tenantID, 2, 0).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT tenant_id AS id, COUNT(*) AS total_count FROM users GROUP BY tenant_id ORDER BY tenant_id ASC")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(tenantID, 2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		cursor, err := pagination.EncodeKeysetCursor(homerID)
+		require.NoError(t, err)
+
+		counts, pages, err := sut.ListGlobal(ctx, []string{tenantID}, "tenant_id", 1, cursor, repo.OrderByParams{repo.NewAscOrderBy("tenant_id"), repo.NewAscOrderBy("id")}, &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, counts[tenantID])
+		assert.Equal(t, &pagination.Page{StartCursor: cursor}, pages[tenantID])
+		assert.Len(t, dest, 1)
+		assert.Equal(t, peter, dest[0])
+	})
+
+	t.Run("success with offset cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 ORDER BY tenant_id ASC, id ASC LIMIT $2 OFFSET $3) ORDER BY tenant_id ASC, id ASC")).
+			WithArgs(tenantID, 2, 1).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT tenant_id AS id, COUNT(*) AS total_count FROM users GROUP BY tenant_id ORDER BY tenant_id ASC")).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(tenantID, 2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		cursor := pagination.EncodeNextOffsetCursor(0, 1)
+
+		counts, pages, err := sut.ListGlobal(ctx, []string{tenantID}, "tenant_id", 1, cursor, repo.OrderByParams{repo.NewAscOrderBy("tenant_id"), repo.NewAscOrderBy("id")}, &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, counts[tenantID])
+		assert.Equal(t, &pagination.Page{StartCursor: cursor}, pages[tenantID])
+		assert.Len(t, dest, 1)
+		assert.Equal(t, peter, dest[0])
+	})
+
+	t.Run("returns error if keyset cursor does not match the order", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		cursor, err := pagination.EncodeKeysetCursor(homerID, tenantID)
+		require.NoError(t, err)
+
+		_, _, err = sut.ListGlobal(ctx, []string{tenantID}, "tenant_id", 1, cursor, repo.OrderByParams{repo.NewAscOrderBy("tenant_id"), repo.NewAscOrderBy("id")}, nil)
+		require.EqualError(t, err, "while converting cursor to condition: Invalid data [reason=cursor is not correct]")
+	})
+
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
-		_, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
 	})
 
 	t.Run("returns error if wrong cursor", func(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
-		_, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
+		_, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "zzz", repo.OrderByParams{repo.NewAscOrderBy("id")}, nil)
 		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
 	})
 
@@ -377,7 +462,7 @@ func TestUnionListGlobal(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		var dest UserCollection
 
-		_, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
+		_, _, err := sut.ListGlobal(ctx, []string{peterID, homerID}, "id", 10, "", repo.OrderByParams{repo.NewAscOrderBy("id")}, &dest)
 
 		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
@@ -1,5 +1,10 @@
 package repo
 
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/pageorder"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
 // OrderByDir is a type encapsulating the ORDER BY direction
 type OrderByDir string
 
@@ -37,3 +42,21 @@ type OrderByParams []OrderBy
 
 // NoOrderBy represents default ordering (no order specified)
 var NoOrderBy = OrderByParams{}
+
+// NewOrderByForPage returns the OrderBy for the page order requested by the client, or the default one if no order is requested.
+// The columns map the fields by which the entities can be ordered to the columns of their table.
+func NewOrderByForPage(order *pageorder.Order, columns map[pageorder.Field]string, defaultOrderBy OrderBy) (OrderBy, error) {
+	if order == nil {
+		return defaultOrderBy, nil
+	}
+
+	column, ok := columns[order.Field]
+	if !ok {
+		return OrderBy{}, apperrors.NewInvalidDataError("ordering by %s is not supported", order.Field)
+	}
+
+	return OrderBy{
+		Field: column,
+		Dir:   OrderByDir(order.Direction),
+	}, nil
+}
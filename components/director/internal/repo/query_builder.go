@@ -145,9 +145,9 @@ func buildSelectQuery(tableName string, selectedColumns string, conditions Condi
 	return stmtBuilder.String(), allArgs, nil
 }
 
-func buildUnionQuery(queries []string) string {
+func buildUnionQuery(queries []string, orderByParams OrderByParams) (string, error) {
 	if len(queries) == 0 {
-		return ""
+		return "", nil
 	}
 
 	for i := range queries {
@@ -158,7 +158,12 @@ func buildUnionQuery(queries []string) string {
 	var stmtBuilder strings.Builder
 	stmtBuilder.WriteString(unionQuery)
 
-	return getQueryFromBuilder(stmtBuilder)
+	// the order of the results of the subqueries is not preserved by the union
+	if err := writeOrderByPart(&stmtBuilder, orderByParams); err != nil {
+		return "", errors.Wrap(err, "while writing order by part")
+	}
+
+	return getQueryFromBuilder(stmtBuilder), nil
 }
 
 func buildCountQuery(tableName string, idColumn string, conditions Conditions, groupByParams GroupByParams, orderByParams OrderByParams, isRebindingNeeded bool) (string, []interface{}, error) {
@@ -278,12 +283,15 @@ var reverseTempReplace = []string{
 
 // sqlx doesn't detect ?| and ?& operators properly
 func getQueryFromBuilder(builder strings.Builder) string {
-	strToRebind := strings.NewReplacer(tempReplace...).Replace(builder.String())
-	strAfterRebind := sqlx.Rebind(sqlx.DOLLAR, strToRebind)
+	return Rebind(builder.String())
+}
 
-	query := strings.NewReplacer(reverseTempReplace...).Replace(strAfterRebind)
+// Rebind replaces the '?' placeholders in the query with positional ones, keeping the JSONB operators which contain '?' intact.
+func Rebind(query string) string {
+	strToRebind := strings.NewReplacer(tempReplace...).Replace(query)
+	strAfterRebind := sqlx.Rebind(sqlx.DOLLAR, strToRebind)
 
-	return query
+	return strings.NewReplacer(reverseTempReplace...).Replace(strAfterRebind)
 }
 
 func writeOrderByPart(builder *strings.Builder, orderByParams OrderByParams) error {
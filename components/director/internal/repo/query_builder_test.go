@@ -274,3 +274,14 @@ func TestQueryBuilderGlobal(t *testing.T) {
 func removeWhitespace(s string) string {
 	return strings.Join(strings.Fields(s), " ")
 }
+
+func TestRebind(t *testing.T) {
+	// GIVEN
+	query := "SELECT id FROM labels WHERE key = ? AND value ?| array[?] AND value ?& array[?]"
+
+	// WHEN
+	result := repo.Rebind(query)
+
+	// THEN
+	assert.Equal(t, "SELECT id FROM labels WHERE key = $1 AND value ?| array[$2] AND value ?& array[$3]", result)
+}
@@ -0,0 +1,311 @@
+package specvalidation
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+var edmxNamespaces = map[string]bool{
+	"http://docs.oasis-open.org/odata/ns/edmx":      true,
+	"http://schemas.microsoft.com/ado/2007/06/edmx": true,
+}
+
+type validator struct {
+	normalize bool
+}
+
+// NewValidator creates a spec validator. When normalize is enabled, YAML OpenAPI and AsyncAPI documents are converted to JSON.
+func NewValidator(normalize bool) *validator {
+	return &validator{
+		normalize: normalize,
+	}
+}
+
+// Validate checks that the data is a well-formed document of the declared spec type and format.
+// It returns the data and format which should be stored for the spec.
+func (v *validator) Validate(data string, format model.SpecFormat, apiType *model.APISpecType, eventType *model.EventSpecType) (string, model.SpecFormat, error) {
+	if strings.TrimSpace(data) == "" {
+		return "", "", errors.New("spec is empty")
+	}
+
+	var err error
+	switch {
+	case apiType != nil:
+		err = validateAPISpec(data, format, *apiType)
+	case eventType != nil:
+		err = validateEventSpec(data, format, *eventType)
+	default:
+		err = validateFormat(data, format)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	if !v.normalize || !isYAML(format) || !isNormalizable(apiType, eventType) {
+		return data, format, nil
+	}
+
+	normalized, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		return "", "", errors.Wrap(err, "while converting spec from YAML to JSON")
+	}
+
+	if format == model.SpecFormatTextYAML {
+		return string(normalized), model.SpecFormatApplicationJSON, nil
+	}
+
+	return string(normalized), model.SpecFormatJSON, nil
+}
+
+func validateAPISpec(data string, format model.SpecFormat, apiType model.APISpecType) error {
+	switch apiType {
+	case model.APISpecTypeOpenAPI:
+		return validateOpenAPI(data, format, "")
+	case model.APISpecTypeOpenAPIV2:
+		return validateOpenAPI(data, format, "2")
+	case model.APISpecTypeOpenAPIV3:
+		return validateOpenAPI(data, format, "3")
+	case model.APISpecTypeOdata:
+		if isXML(format) {
+			return validateEDMX(data)
+		}
+		return validateCSDLJSON(data, format)
+	case model.APISpecTypeEDMX:
+		if !isXML(format) {
+			return errors.Errorf("format %s is not supported for %s specs", format, apiType)
+		}
+		return validateEDMX(data)
+	case model.APISpecTypeCsdl:
+		return validateCSDLJSON(data, format)
+	default:
+		return validateFormat(data, format)
+	}
+}
+
+func validateEventSpec(data string, format model.SpecFormat, eventType model.EventSpecType) error {
+	switch eventType {
+	case model.EventSpecTypeAsyncAPI, model.EventSpecTypeAsyncAPIV2:
+		return validateAsyncAPI(data, format)
+	default:
+		return validateFormat(data, format)
+	}
+}
+
+// validateOpenAPI checks for an OpenAPI document of the expected major version, or of any supported version when it is empty.
+func validateOpenAPI(data string, format model.SpecFormat, expectedVersion string) error {
+	doc, err := parseDocument(data, format)
+	if err != nil {
+		return err
+	}
+
+	var version string
+	if swagger, ok := doc["swagger"].(string); ok && swagger == "2.0" {
+		version = "2"
+	} else if openapi, ok := doc["openapi"].(string); ok && strings.HasPrefix(openapi, "3.") {
+		version = "3"
+	} else {
+		return errors.New("spec is not an OpenAPI 2.0 or 3.x document")
+	}
+
+	if expectedVersion != "" && version != expectedVersion {
+		return errors.Errorf("expected OpenAPI %s document, got OpenAPI %s", expectedVersion, version)
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+
+	if version == "2" {
+		if _, ok := doc["paths"]; !ok {
+			return errors.New("OpenAPI document is missing \"paths\"")
+		}
+		return nil
+	}
+
+	_, hasPaths := doc["paths"]
+	_, hasComponents := doc["components"]
+	_, hasWebhooks := doc["webhooks"]
+	if !hasPaths && !hasComponents && !hasWebhooks {
+		return errors.New("OpenAPI document is missing \"paths\", \"components\" or \"webhooks\"")
+	}
+
+	return nil
+}
+
+func validateAsyncAPI(data string, format model.SpecFormat) error {
+	doc, err := parseDocument(data, format)
+	if err != nil {
+		return err
+	}
+
+	if asyncapi, ok := doc["asyncapi"].(string); !ok || !strings.HasPrefix(asyncapi, "2.") {
+		return errors.New("spec is not an AsyncAPI 2.x document")
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+
+	if _, ok := doc["channels"]; !ok {
+		return errors.New("AsyncAPI document is missing \"channels\"")
+	}
+
+	return nil
+}
+
+func validateInfo(doc map[string]interface{}) error {
+	info, ok := doc["info"].(map[string]interface{})
+	if !ok {
+		return errors.New("document is missing \"info\"")
+	}
+
+	for _, field := range []string{"title", "version"} {
+		if value, ok := info[field].(string); !ok || value == "" {
+			return errors.Errorf("document is missing \"info.%s\"", field)
+		}
+	}
+
+	return nil
+}
+
+func validateCSDLJSON(data string, format model.SpecFormat) error {
+	if !isJSON(format) {
+		return errors.Errorf("format %s is not supported for CSDL JSON specs", format)
+	}
+
+	doc, err := parseDocument(data, format)
+	if err != nil {
+		return err
+	}
+
+	if version, ok := doc["$Version"].(string); !ok || version == "" {
+		return errors.New("spec is not a CSDL JSON document: missing \"$Version\"")
+	}
+
+	return nil
+}
+
+// validateEDMX checks for a well-formed XML document with an edmx:Edmx root element containing edmx:DataServices.
+func validateEDMX(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	var depth int
+	var hasRoot, hasDataServices bool
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "spec is not a valid XML document")
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 1 {
+				if element.Name.Local != "Edmx" || !edmxNamespaces[element.Name.Space] {
+					return errors.Errorf("spec is not an EDMX document: unexpected root element %q", element.Name.Local)
+				}
+				hasRoot = true
+			}
+			if depth == 2 && element.Name.Local == "DataServices" {
+				hasDataServices = true
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("spec is not an EDMX document: missing root element")
+	}
+
+	if !hasDataServices {
+		return errors.New("EDMX document is missing \"DataServices\"")
+	}
+
+	return nil
+}
+
+// validateFormat checks that the data is well-formed in the declared format. Plain text and binary specs are not checked.
+func validateFormat(data string, format model.SpecFormat) error {
+	switch {
+	case isJSON(format):
+		if !json.Valid([]byte(data)) {
+			return errors.New("spec is not a valid JSON document")
+		}
+	case isYAML(format):
+		_, err := parseDocument(data, format)
+		return err
+	case isXML(format):
+		decoder := xml.NewDecoder(strings.NewReader(data))
+		var hasRoot bool
+		for {
+			token, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return errors.Wrap(err, "spec is not a valid XML document")
+			}
+			if _, ok := token.(xml.StartElement); ok {
+				hasRoot = true
+			}
+		}
+		if !hasRoot {
+			return errors.New("spec is not a valid XML document: missing root element")
+		}
+	}
+
+	return nil
+}
+
+func parseDocument(data string, format model.SpecFormat) (map[string]interface{}, error) {
+	doc := make(map[string]interface{})
+	switch {
+	case isJSON(format):
+		decoder := json.NewDecoder(bytes.NewBufferString(data))
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, errors.Wrap(err, "spec is not a valid JSON object")
+		}
+		if decoder.More() {
+			return nil, errors.New("spec is not a valid JSON object: unexpected data after the top-level object")
+		}
+	case isYAML(format):
+		if err := yaml.Unmarshal([]byte(data), &doc); err != nil {
+			return nil, errors.Wrap(err, "spec is not a valid YAML mapping")
+		}
+	default:
+		return nil, errors.Errorf("format %s is not supported for this spec type", format)
+	}
+
+	return doc, nil
+}
+
+func isNormalizable(apiType *model.APISpecType, eventType *model.EventSpecType) bool {
+	if apiType != nil {
+		return *apiType == model.APISpecTypeOpenAPI || *apiType == model.APISpecTypeOpenAPIV2 || *apiType == model.APISpecTypeOpenAPIV3
+	}
+
+	return eventType != nil && (*eventType == model.EventSpecTypeAsyncAPI || *eventType == model.EventSpecTypeAsyncAPIV2)
+}
+
+func isJSON(format model.SpecFormat) bool {
+	return format == model.SpecFormatJSON || format == model.SpecFormatApplicationJSON
+}
+
+func isYAML(format model.SpecFormat) bool {
+	return format == model.SpecFormatYaml || format == model.SpecFormatTextYAML
+}
+
+func isXML(format model.SpecFormat) bool {
+	return format == model.SpecFormatXML || format == model.SpecFormatApplicationXML
+}
@@ -0,0 +1,324 @@
+package specvalidation_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specvalidation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	openAPIV2JSON = `{"swagger":"2.0","info":{"title":"API","version":"1.0"},"paths":{}}`
+	openAPIV3YAML = `openapi: 3.0.1
+info:
+  title: API
+  version: "1.0"
+paths: {}
+`
+	asyncAPIYAML = `asyncapi: 2.0.0
+info:
+  title: Events
+  version: "1.0"
+channels: {}
+`
+	edmxV4 = `<?xml version="1.0" encoding="utf-8"?>
+<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">
+  <edmx:DataServices>
+    <Schema Namespace="Test" xmlns="http://docs.oasis-open.org/odata/ns/edm"/>
+  </edmx:DataServices>
+</edmx:Edmx>`
+	csdlJSON  = `{"$Version":"4.01","$EntityContainer":"Test.Container"}`
+	htmlPage  = `<!DOCTYPE html><html><head><meta charset="utf-8"><title>Error</title></head><body>Internal Server Error</body></html>`
+	plainText = "some text"
+)
+
+func TestValidator_Validate(t *testing.T) {
+	openAPI := model.APISpecTypeOpenAPI
+	openAPIV2 := model.APISpecTypeOpenAPIV2
+	openAPIV3 := model.APISpecTypeOpenAPIV3
+	odata := model.APISpecTypeOdata
+	edmx := model.APISpecTypeEDMX
+	csdl := model.APISpecTypeCsdl
+	wsdl := model.APISpecTypeWsdlV1
+	asyncAPI := model.EventSpecTypeAsyncAPI
+	asyncAPIV2 := model.EventSpecTypeAsyncAPIV2
+	customEvent := model.EventSpecTypeCustom
+
+	testCases := []struct {
+		Name               string
+		Data               string
+		Format             model.SpecFormat
+		APIType            *model.APISpecType
+		EventType          *model.EventSpecType
+		ExpectedErrMessage string
+	}{
+		{
+			Name:    "OpenAPI 2.0 JSON",
+			Data:    openAPIV2JSON,
+			Format:  model.SpecFormatJSON,
+			APIType: &openAPI,
+		},
+		{
+			Name:    "OpenAPI 3 YAML",
+			Data:    openAPIV3YAML,
+			Format:  model.SpecFormatTextYAML,
+			APIType: &openAPIV3,
+		},
+		{
+			Name:               "OpenAPI 3 declared as OpenAPI 2",
+			Data:               openAPIV3YAML,
+			Format:             model.SpecFormatYaml,
+			APIType:            &openAPIV2,
+			ExpectedErrMessage: "expected OpenAPI 2 document, got OpenAPI 3",
+		},
+		{
+			Name:               "OpenAPI without info title",
+			Data:               `{"openapi":"3.0.0","info":{"version":"1.0"},"paths":{}}`,
+			Format:             model.SpecFormatApplicationJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: `document is missing "info.title"`,
+		},
+		{
+			Name:               "OpenAPI without paths",
+			Data:               `{"swagger":"2.0","info":{"title":"API","version":"1.0"}}`,
+			Format:             model.SpecFormatJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: `OpenAPI document is missing "paths"`,
+		},
+		{
+			Name:               "OpenAPI declared as JSON but provided as YAML",
+			Data:               openAPIV3YAML,
+			Format:             model.SpecFormatJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: "spec is not a valid JSON object",
+		},
+		{
+			Name:               "HTML page declared as OpenAPI JSON",
+			Data:               htmlPage,
+			Format:             model.SpecFormatJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: "spec is not a valid JSON object",
+		},
+		{
+			Name:               "HTML page declared as OpenAPI YAML",
+			Data:               htmlPage,
+			Format:             model.SpecFormatYaml,
+			APIType:            &openAPI,
+			ExpectedErrMessage: "spec is not a valid YAML mapping",
+		},
+		{
+			Name:               "JSON document which is not OpenAPI",
+			Data:               `{"title":"API"}`,
+			Format:             model.SpecFormatJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: "spec is not an OpenAPI 2.0 or 3.x document",
+		},
+		{
+			Name:    "OData EDMX",
+			Data:    edmxV4,
+			Format:  model.SpecFormatXML,
+			APIType: &odata,
+		},
+		{
+			Name:    "OData CSDL JSON",
+			Data:    csdlJSON,
+			Format:  model.SpecFormatJSON,
+			APIType: &odata,
+		},
+		{
+			Name:    "EDMX",
+			Data:    edmxV4,
+			Format:  model.SpecFormatApplicationXML,
+			APIType: &edmx,
+		},
+		{
+			Name:               "EDMX with JSON format",
+			Data:               csdlJSON,
+			Format:             model.SpecFormatApplicationJSON,
+			APIType:            &edmx,
+			ExpectedErrMessage: "format application/json is not supported for edmx specs",
+		},
+		{
+			Name:               "EDMX without DataServices",
+			Data:               `<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx"></edmx:Edmx>`,
+			Format:             model.SpecFormatXML,
+			APIType:            &odata,
+			ExpectedErrMessage: `EDMX document is missing "DataServices"`,
+		},
+		{
+			Name:               "HTML page declared as EDMX",
+			Data:               htmlPage,
+			Format:             model.SpecFormatXML,
+			APIType:            &odata,
+			ExpectedErrMessage: `spec is not an EDMX document: unexpected root element "html"`,
+		},
+		{
+			Name:               "XML document which is not EDMX",
+			Data:               `<definitions/>`,
+			Format:             model.SpecFormatXML,
+			APIType:            &odata,
+			ExpectedErrMessage: `spec is not an EDMX document: unexpected root element "definitions"`,
+		},
+		{
+			Name:    "CSDL JSON",
+			Data:    csdlJSON,
+			Format:  model.SpecFormatApplicationJSON,
+			APIType: &csdl,
+		},
+		{
+			Name:               "CSDL JSON without version",
+			Data:               `{"$EntityContainer":"Test.Container"}`,
+			Format:             model.SpecFormatApplicationJSON,
+			APIType:            &csdl,
+			ExpectedErrMessage: `spec is not a CSDL JSON document: missing "$Version"`,
+		},
+		{
+			Name:      "AsyncAPI 2 YAML",
+			Data:      asyncAPIYAML,
+			Format:    model.SpecFormatYaml,
+			EventType: &asyncAPI,
+		},
+		{
+			Name:               "AsyncAPI without channels",
+			Data:               `{"asyncapi":"2.0.0","info":{"title":"Events","version":"1.0"}}`,
+			Format:             model.SpecFormatApplicationJSON,
+			EventType:          &asyncAPIV2,
+			ExpectedErrMessage: `AsyncAPI document is missing "channels"`,
+		},
+		{
+			Name:               "OpenAPI declared as AsyncAPI",
+			Data:               openAPIV2JSON,
+			Format:             model.SpecFormatJSON,
+			EventType:          &asyncAPI,
+			ExpectedErrMessage: "spec is not an AsyncAPI 2.x document",
+		},
+		{
+			Name:    "WSDL is checked to be well-formed XML",
+			Data:    `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/"></definitions>`,
+			Format:  model.SpecFormatApplicationXML,
+			APIType: &wsdl,
+		},
+		{
+			Name:               "Malformed WSDL",
+			Data:               `<definitions>`,
+			Format:             model.SpecFormatApplicationXML,
+			APIType:            &wsdl,
+			ExpectedErrMessage: "spec is not a valid XML document",
+		},
+		{
+			Name:               "Custom event spec with invalid JSON",
+			Data:               `{"events":`,
+			Format:             model.SpecFormatApplicationJSON,
+			EventType:          &customEvent,
+			ExpectedErrMessage: "spec is not a valid JSON document",
+		},
+		{
+			Name:      "Plain text custom spec is not checked",
+			Data:      plainText,
+			Format:    model.SpecFormatPlainText,
+			EventType: &customEvent,
+		},
+		{
+			Name:               "Empty spec",
+			Data:               "  \n",
+			Format:             model.SpecFormatJSON,
+			APIType:            &openAPI,
+			ExpectedErrMessage: "spec is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			validator := specvalidation.NewValidator(false)
+
+			// WHEN
+			data, format, err := validator.Validate(testCase.Data, testCase.Format, testCase.APIType, testCase.EventType)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.Data, data)
+				assert.Equal(t, testCase.Format, format)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+		})
+	}
+}
+
+func TestValidator_Validate_Normalization(t *testing.T) {
+	openAPI := model.APISpecTypeOpenAPI
+	openAPIV3 := model.APISpecTypeOpenAPIV3
+	raml := model.APISpecTypeRaml
+	asyncAPI := model.EventSpecTypeAsyncAPI
+
+	testCases := []struct {
+		Name           string
+		Data           string
+		Format         model.SpecFormat
+		APIType        *model.APISpecType
+		EventType      *model.EventSpecType
+		ExpectedData   string
+		ExpectedFormat model.SpecFormat
+	}{
+		{
+			Name:           "OpenAPI YAML is converted to JSON",
+			Data:           openAPIV3YAML,
+			Format:         model.SpecFormatYaml,
+			APIType:        &openAPI,
+			ExpectedData:   `{"info":{"title":"API","version":"1.0"},"openapi":"3.0.1","paths":{}}`,
+			ExpectedFormat: model.SpecFormatJSON,
+		},
+		{
+			Name:           "ORD OpenAPI text/yaml is converted to application/json",
+			Data:           openAPIV3YAML,
+			Format:         model.SpecFormatTextYAML,
+			APIType:        &openAPIV3,
+			ExpectedData:   `{"info":{"title":"API","version":"1.0"},"openapi":"3.0.1","paths":{}}`,
+			ExpectedFormat: model.SpecFormatApplicationJSON,
+		},
+		{
+			Name:           "AsyncAPI YAML is converted to JSON",
+			Data:           asyncAPIYAML,
+			Format:         model.SpecFormatYaml,
+			EventType:      &asyncAPI,
+			ExpectedData:   `{"asyncapi":"2.0.0","channels":{},"info":{"title":"Events","version":"1.0"}}`,
+			ExpectedFormat: model.SpecFormatJSON,
+		},
+		{
+			Name:           "JSON spec is left unchanged",
+			Data:           openAPIV2JSON,
+			Format:         model.SpecFormatJSON,
+			APIType:        &openAPI,
+			ExpectedData:   openAPIV2JSON,
+			ExpectedFormat: model.SpecFormatJSON,
+		},
+		{
+			Name:           "RAML spec is left unchanged",
+			Data:           "title: API\n",
+			Format:         model.SpecFormatTextYAML,
+			APIType:        &raml,
+			ExpectedData:   "title: API\n",
+			ExpectedFormat: model.SpecFormatTextYAML,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			validator := specvalidation.NewValidator(true)
+
+			// WHEN
+			data, format, err := validator.Validate(testCase.Data, testCase.Format, testCase.APIType, testCase.EventType)
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedData, data)
+			assert.Equal(t, testCase.ExpectedFormat, format)
+		})
+	}
+}
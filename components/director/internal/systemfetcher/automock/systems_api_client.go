@@ -8,6 +8,8 @@ import (
 
 	systemfetcher "github.com/kyma-incubator/compass/components/director/internal/systemfetcher"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // SystemsAPIClient is an autogenerated mock type for the systemsAPIClient type
@@ -15,13 +17,13 @@ type SystemsAPIClient struct {
 	mock.Mock
 }
 
-// FetchSystemsForTenant provides a mock function with given fields: ctx, tenant
-func (_m *SystemsAPIClient) FetchSystemsForTenant(ctx context.Context, tenant string) ([]systemfetcher.System, error) {
-	ret := _m.Called(ctx, tenant)
+// FetchSystemsForTenant provides a mock function with given fields: ctx, tenant, changedSince
+func (_m *SystemsAPIClient) FetchSystemsForTenant(ctx context.Context, tenant string, changedSince time.Time) ([]systemfetcher.System, error) {
+	ret := _m.Called(ctx, tenant, changedSince)
 
 	var r0 []systemfetcher.System
-	if rf, ok := ret.Get(0).(func(context.Context, string) []systemfetcher.System); ok {
-		r0 = rf(ctx, tenant)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []systemfetcher.System); ok {
+		r0 = rf(ctx, tenant, changedSince)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]systemfetcher.System)
@@ -29,8 +31,8 @@ func (_m *SystemsAPIClient) FetchSystemsForTenant(ctx context.Context, tenant st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, tenant)
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, tenant, changedSince)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -19,14 +20,21 @@ type APIClient interface {
 	Do(*http.Request, string) (*http.Response, error)
 }
 
+// DeltaFilterTimestampPlaceholder is replaced in the delta filter criteria with the time of the last synchronization of the systems of the tenant
+const DeltaFilterTimestampPlaceholder = "{{timestamp}}"
+
 // APIConfig missing godoc
 type APIConfig struct {
-	Endpoint        string        `envconfig:"APP_SYSTEM_INFORMATION_ENDPOINT"`
-	FilterCriteria  string        `envconfig:"APP_SYSTEM_INFORMATION_FILTER_CRITERIA"`
-	Timeout         time.Duration `envconfig:"APP_SYSTEM_INFORMATION_FETCH_TIMEOUT"`
-	PageSize        uint64        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SIZE"`
-	PagingSkipParam string        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SKIP_PARAM"`
-	PagingSizeParam string        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SIZE_PARAM"`
+	Endpoint       string `envconfig:"APP_SYSTEM_INFORMATION_ENDPOINT"`
+	FilterCriteria string `envconfig:"APP_SYSTEM_INFORMATION_FILTER_CRITERIA"`
+	// DeltaFilterCriteria is the filter used to fetch only the systems changed since the last synchronization.
+	// It contains DeltaFilterTimestampPlaceholder and replaces the FilterCriteria, so it should include its conditions as well.
+	// If it is empty, all the systems are fetched on each synchronization.
+	DeltaFilterCriteria string        `envconfig:"optional,APP_SYSTEM_INFORMATION_DELTA_FILTER_CRITERIA"`
+	Timeout             time.Duration `envconfig:"APP_SYSTEM_INFORMATION_FETCH_TIMEOUT"`
+	PageSize            uint64        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SIZE"`
+	PagingSkipParam     string        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SKIP_PARAM"`
+	PagingSizeParam     string        `envconfig:"APP_SYSTEM_INFORMATION_PAGE_SIZE_PARAM"`
 }
 
 // Client missing godoc
@@ -43,9 +51,10 @@ func NewClient(apiConfig APIConfig, client APIClient) *Client {
 	}
 }
 
-// FetchSystemsForTenant fetches systems from the service
-func (c *Client) FetchSystemsForTenant(ctx context.Context, tenant string) ([]System, error) {
-	qp := map[string]string{"$filter": c.apiConfig.FilterCriteria, "fetchAcrossZones": "true"}
+// FetchSystemsForTenant fetches systems from the service.
+// If changedSince is not zero and delta filter criteria are configured, only the systems changed since then are fetched.
+func (c *Client) FetchSystemsForTenant(ctx context.Context, tenant string, changedSince time.Time) ([]System, error) {
+	qp := map[string]string{"$filter": c.filterCriteria(changedSince), "fetchAcrossZones": "true"}
 	var systems []System
 
 	systemsFunc := c.getSystemsPagingFunc(ctx, &systems, tenant)
@@ -58,6 +67,13 @@ func (c *Client) FetchSystemsForTenant(ctx context.Context, tenant string) ([]Sy
 	return systems, nil
 }
 
+func (c *Client) filterCriteria(changedSince time.Time) string {
+	if changedSince.IsZero() || c.apiConfig.DeltaFilterCriteria == "" {
+		return c.apiConfig.FilterCriteria
+	}
+	return strings.ReplaceAll(c.apiConfig.DeltaFilterCriteria, DeltaFilterTimestampPlaceholder, changedSince.UTC().Format(time.RFC3339))
+}
+
 func (c *Client) fetchSystemsForTenant(ctx context.Context, url, tenant string) ([]System, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/oauth"
 
@@ -58,17 +59,18 @@ func TestFetchSystemsForTenant(t *testing.T) {
 	mock.expectedFilterCriteria = "filter1"
 
 	client := systemfetcher.NewClient(systemfetcher.APIConfig{
-		Endpoint:        url + "/fetch",
-		FilterCriteria:  "filter1",
-		PageSize:        4,
-		PagingSkipParam: "$skip",
-		PagingSizeParam: "$top",
+		Endpoint:            url + "/fetch",
+		FilterCriteria:      "filter1",
+		DeltaFilterCriteria: "filter1 and lastChangeDateTime gt '{{timestamp}}'",
+		PageSize:            4,
+		PagingSkipParam:     "$skip",
+		PagingSizeParam:     "$top",
 	}, mock.httpClient)
 
 	t.Run("Success", func(t *testing.T) {
 		mock.callNumber = 0
 		mock.pageCount = 1
-		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.NoError(t, err)
 		require.Len(t, systems, 1)
 		require.Equal(t, systems[0].TemplateID, "")
@@ -97,7 +99,7 @@ func TestFetchSystemsForTenant(t *testing.T) {
 		}]`)}
 		mock.callNumber = 0
 		mock.pageCount = 1
-		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.NoError(t, err)
 		require.Len(t, systems, 2)
 		require.Equal(t, systems[0].TemplateID, "type1")
@@ -123,7 +125,7 @@ func TestFetchSystemsForTenant(t *testing.T) {
 		}]`)}
 		mock.callNumber = 0
 		mock.pageCount = 2
-		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.NoError(t, err)
 		require.Len(t, systems, 5)
 	})
@@ -170,7 +172,7 @@ func TestFetchSystemsForTenant(t *testing.T) {
 		}]`)}
 		mock.callNumber = 0
 		mock.pageCount = 1
-		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.NoError(t, err)
 		require.Len(t, systems, 3)
 		require.Equal(t, systems[0].TemplateID, "type1")
@@ -182,7 +184,7 @@ func TestFetchSystemsForTenant(t *testing.T) {
 		mock.callNumber = 0
 		mock.pageCount = 1
 		mock.statusCodeToReturn = http.StatusBadRequest
-		_, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		_, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.Contains(t, err.Error(), "unexpected status code")
 	})
 
@@ -191,9 +193,42 @@ func TestFetchSystemsForTenant(t *testing.T) {
 		mock.pageCount = 1
 		mock.bodiesToReturn = [][]byte{[]byte("not a JSON")}
 		mock.statusCodeToReturn = http.StatusOK
-		_, err := client.FetchSystemsForTenant(context.Background(), "tenant1")
+		_, err := client.FetchSystemsForTenant(context.Background(), "tenant1", time.Time{})
 		require.Contains(t, err.Error(), "failed to unmarshal systems response")
 	})
+
+	t.Run("Success fetching only the systems changed since the given time", func(t *testing.T) {
+		systemfetcher.Mappings = nil
+		mock.callNumber = 0
+		mock.pageCount = 1
+		mock.bodiesToReturn = [][]byte{systemsJSON}
+		mock.expectedFilterCriteria = "filter1 and lastChangeDateTime gt '2022-10-03T10:00:00Z'"
+		defer func() {
+			mock.expectedFilterCriteria = "filter1"
+		}()
+
+		changedSince := time.Date(2022, 10, 3, 12, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+		systems, err := client.FetchSystemsForTenant(context.Background(), "tenant1", changedSince)
+		require.NoError(t, err)
+		require.Len(t, systems, 1)
+	})
+
+	t.Run("Success fetching all the systems when the delta filter criteria are not configured", func(t *testing.T) {
+		mock.callNumber = 0
+		mock.pageCount = 1
+		mock.bodiesToReturn = [][]byte{systemsJSON}
+
+		clientWithoutDelta := systemfetcher.NewClient(systemfetcher.APIConfig{
+			Endpoint:        url + "/fetch",
+			FilterCriteria:  "filter1",
+			PageSize:        4,
+			PagingSkipParam: "$skip",
+			PagingSizeParam: "$top",
+		}, mock.httpClient)
+		systems, err := clientWithoutDelta.FetchSystemsForTenant(context.Background(), "tenant1", time.Now())
+		require.NoError(t, err)
+		require.Len(t, systems, 1)
+	})
 }
 
 type mockData struct {
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -42,7 +43,13 @@ type systemsService interface {
 
 //go:generate mockery --name=systemsAPIClient --output=automock --outpkg=automock --case=underscore --exported=true --disable-version-string
 type systemsAPIClient interface {
-	FetchSystemsForTenant(ctx context.Context, tenant string) ([]System, error)
+	FetchSystemsForTenant(ctx context.Context, tenant string, changedSince time.Time) ([]System, error)
+}
+
+//go:generate mockery --name=watermarksRepository --output=automock --outpkg=automock --case=underscore --exported=true --disable-version-string
+type watermarksRepository interface {
+	List(ctx context.Context) ([]*Watermark, error)
+	Upsert(ctx context.Context, watermark *Watermark) error
 }
 
 //go:generate mockery --name=directorClient --output=automock --outpkg=automock --case=underscore --exported=true --disable-version-string
@@ -64,6 +71,12 @@ type Config struct {
 	DirectorSkipSSLValidation bool          `envconfig:"default=false,APP_DIRECTOR_SKIP_SSL_VALIDATION"`
 
 	EnableSystemDeletion bool `envconfig:"default=true,APP_ENABLE_SYSTEM_DELETION"`
+	// FullResyncInterval is the interval after which all the systems of a tenant are fetched, instead of only the ones changed since the last synchronization
+	FullResyncInterval time.Duration `envconfig:"default=12h,APP_SYSTEM_INFORMATION_FULL_RESYNC_INTERVAL"`
+	// DeltaOverlap is subtracted from the start of the synchronization of a tenant when it is stored as the time before which the changed systems are synchronized
+	DeltaOverlap time.Duration `envconfig:"default=5m,APP_SYSTEM_INFORMATION_DELTA_OVERLAP"`
+	// RateLimit is the maximum number of tenants whose systems are fetched per second. Zero disables the limit.
+	RateLimit int `envconfig:"default=20,APP_SYSTEM_INFORMATION_RATE_LIMIT"`
 }
 
 // SystemFetcher is responsible for synchronizing the existing applications in Compass and a pre-defined external source.
@@ -74,13 +87,15 @@ type SystemFetcher struct {
 	templateRenderer templateRenderer
 	systemsAPIClient systemsAPIClient
 	directorClient   directorClient
+	watermarkRepo    watermarksRepository
 
 	config  Config
 	workers chan struct{}
+	limiter *rate.Limiter
 }
 
 // NewSystemFetcher returns a new SystemFetcher.
-func NewSystemFetcher(tx persistence.Transactioner, ts tenantService, ss systemsService, tr templateRenderer, sac systemsAPIClient, directorClient directorClient, config Config) *SystemFetcher {
+func NewSystemFetcher(tx persistence.Transactioner, ts tenantService, ss systemsService, tr templateRenderer, sac systemsAPIClient, directorClient directorClient, watermarkRepo watermarksRepository, config Config) *SystemFetcher {
 	return &SystemFetcher{
 		transaction:      tx,
 		tenantService:    ts,
@@ -88,38 +103,31 @@ func NewSystemFetcher(tx persistence.Transactioner, ts tenantService, ss systems
 		templateRenderer: tr,
 		systemsAPIClient: sac,
 		directorClient:   directorClient,
+		watermarkRepo:    watermarkRepo,
 		workers:          make(chan struct{}, config.FetcherParallellism),
+		limiter:          newLimiter(config.RateLimit),
 		config:           config,
 	}
 }
 
-type tenantSystems struct {
-	tenant  *model.BusinessTenantMapping
-	systems []System
-}
-
-func splitBusinessTenantMappingsToChunks(slice []*model.BusinessTenantMapping, chunkSize int) [][]*model.BusinessTenantMapping {
-	var chunks [][]*model.BusinessTenantMapping
-	for {
-		if len(slice) == 0 {
-			break
-		}
-
-		if len(slice) < chunkSize {
-			chunkSize = len(slice)
-		}
-
-		chunks = append(chunks, slice[0:chunkSize])
-		slice = slice[chunkSize:]
+func newLimiter(rateLimit int) *rate.Limiter {
+	if rateLimit <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
 	}
+	return rate.NewLimiter(rate.Limit(rateLimit), rateLimit)
+}
 
-	return chunks
+type tenantSystems struct {
+	tenant    *model.BusinessTenantMapping
+	systems   []System
+	watermark *Watermark
 }
 
 // SyncSystems synchronizes applications between Compass and external source. It deletes the applications with deleted state in the external source from Compass,
 // and creates any new applications present in the external source.
+// Only the systems changed since the last synchronization of each tenant are fetched, unless a full resync of the tenant is due.
 func (s *SystemFetcher) SyncSystems(ctx context.Context) error {
-	allTenants, err := s.listTenants(ctx)
+	allTenants, watermarks, err := s.listTenants(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to list tenants")
 	}
@@ -143,51 +151,68 @@ func (s *SystemFetcher) SyncSystems(ctx context.Context) error {
 				log.C(ctx).Error(errors.Wrap(err, fmt.Sprintf("failed to save systems for tenant %s", tenantSystems.tenant.ExternalTenant)))
 				continue
 			}
+			// the watermark is moved only after all the fetched systems are saved, so that the failed ones are fetched again on the next synchronization
+			if err = s.saveWatermark(ctx, tenantSystems.watermark); err != nil {
+				log.C(ctx).Error(errors.Wrap(err, fmt.Sprintf("failed to save watermark for tenant %s", tenantSystems.tenant.ExternalTenant)))
+				continue
+			}
 
 			log.C(ctx).Info(fmt.Sprintf("Successfully synced systems for tenant %s", tenantSystems.tenant.ExternalTenant))
 		}
 	}()
 
-	chunks := splitBusinessTenantMappingsToChunks(tenants, 20)
-
-	for _, chunk := range chunks {
-		time.Sleep(time.Second * 1)
-
-		wg := sync.WaitGroup{}
-		for _, t := range chunk {
-			wg.Add(1)
-			s.workers <- struct{}{}
-			go func(t *model.BusinessTenantMapping) {
-				defer func() {
-					wg.Done()
-					<-s.workers
-				}()
-				systems, err := s.systemsAPIClient.FetchSystemsForTenant(ctx, t.ExternalTenant)
-				if err != nil {
-					log.C(ctx).Error(errors.Wrap(err, fmt.Sprintf("failed to fetch systems for tenant %s", t.ExternalTenant)))
-					return
-				}
-				if len(systems) > 0 {
-					systemsQueue <- tenantSystems{
-						tenant:  t,
-						systems: systems,
-					}
-				}
-			}(t)
+	wg := sync.WaitGroup{}
+	for _, t := range tenants {
+		if err := s.limiter.Wait(ctx); err != nil {
+			log.C(ctx).WithError(err).Errorf("Stopped fetching systems: %v", err)
+			break
 		}
 
-		wg.Wait()
+		wg.Add(1)
+		s.workers <- struct{}{}
+		go func(t *model.BusinessTenantMapping) {
+			defer func() {
+				wg.Done()
+				<-s.workers
+			}()
+
+			startedAt := time.Now()
+			watermark := watermarks[t.ID]
+			fullResync := shouldFullResync(watermark, s.config.FullResyncInterval, startedAt)
+
+			var changedSince time.Time
+			if fullResync {
+				log.C(ctx).Infof("Fetching all systems for tenant %s", t.ExternalTenant)
+			} else {
+				changedSince = watermark.LastChangeAt
+				log.C(ctx).Infof("Fetching systems changed since %s for tenant %s", changedSince, t.ExternalTenant)
+			}
+
+			systems, err := s.systemsAPIClient.FetchSystemsForTenant(ctx, t.ExternalTenant, changedSince)
+			if err != nil {
+				log.C(ctx).Error(errors.Wrap(err, fmt.Sprintf("failed to fetch systems for tenant %s", t.ExternalTenant)))
+				return
+			}
+			systemsQueue <- tenantSystems{
+				tenant:    t,
+				systems:   systems,
+				watermark: nextWatermark(t.ID, watermark, fullResync, startedAt, s.config.DeltaOverlap),
+			}
+		}(t)
 	}
+
+	wg.Wait()
 	close(systemsQueue)
 	wgDB.Wait()
 
 	return nil
 }
 
-func (s *SystemFetcher) listTenants(ctx context.Context) ([]*model.BusinessTenantMapping, error) {
+// listTenants returns all the tenants together with the watermarks of the ones whose systems were already synchronized, mapped by the tenant ID
+func (s *SystemFetcher) listTenants(ctx context.Context) ([]*model.BusinessTenantMapping, map[string]*Watermark, error) {
 	tx, err := s.transaction.Begin()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to begin transaction")
+		return nil, nil, errors.Wrap(err, "failed to begin transaction")
 	}
 	defer s.transaction.RollbackUnlessCommitted(ctx, tx)
 
@@ -195,15 +220,41 @@ func (s *SystemFetcher) listTenants(ctx context.Context) ([]*model.BusinessTenan
 
 	tenants, err := s.tenantService.List(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to retrieve tenants")
+		return nil, nil, errors.Wrap(err, "failed to retrieve tenants")
+	}
+
+	watermarks, err := s.watermarkRepo.List(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to retrieve watermarks")
 	}
 
 	err = tx.Commit()
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to commit while retrieving tenants")
+		return nil, nil, errors.Wrap(err, "failed to commit while retrieving tenants")
+	}
+
+	watermarksByTenant := make(map[string]*Watermark, len(watermarks))
+	for _, watermark := range watermarks {
+		watermarksByTenant[watermark.TenantID] = watermark
+	}
+
+	return tenants, watermarksByTenant, nil
+}
+
+func (s *SystemFetcher) saveWatermark(ctx context.Context, watermark *Watermark) error {
+	tx, err := s.transaction.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer s.transaction.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.watermarkRepo.Upsert(ctx, watermark); err != nil {
+		return err
 	}
 
-	return tenants, nil
+	return tx.Commit()
 }
 
 func (s *SystemFetcher) processSystemsForTenant(ctx context.Context, tenantMapping *model.BusinessTenantMapping, systems []System) error {
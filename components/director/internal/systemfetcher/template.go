@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
@@ -33,9 +34,8 @@ type renderer struct {
 	appTemplateService applicationTemplateService
 	appConverter       applicationConverter
 
-	appInputOverride     string
-	placeholdersMapping  []PlaceholderMapping
-	placeholdersOverride []model.ApplicationTemplatePlaceholder
+	appInputOverride    string
+	placeholdersMapping []PlaceholderMapping
 }
 
 // NewTemplateRenderer returns a new application input renderer by a given application template.
@@ -43,19 +43,12 @@ func NewTemplateRenderer(appTemplateService applicationTemplateService, appConve
 	if _, err := appConverter.CreateInputJSONToModel(context.Background(), appInputOverride); err != nil {
 		return nil, errors.Wrapf(err, "while converting override application input JSON into application input")
 	}
-	placeholders := make([]model.ApplicationTemplatePlaceholder, 0)
-	for _, p := range mapping {
-		placeholders = append(placeholders, model.ApplicationTemplatePlaceholder{
-			Name: p.PlaceholderName,
-		})
-	}
 
 	return &renderer{
-		appTemplateService:   appTemplateService,
-		appConverter:         appConverter,
-		appInputOverride:     appInputOverride,
-		placeholdersMapping:  mapping,
-		placeholdersOverride: placeholders,
+		appTemplateService:  appTemplateService,
+		appConverter:        appConverter,
+		appInputOverride:    appInputOverride,
+		placeholdersMapping: mapping,
 	}, nil
 }
 
@@ -70,7 +63,7 @@ func (r *renderer) ApplicationRegisterInputFromTemplate(ctx context.Context, sc
 		return nil, errors.Wrapf(err, "while getting template inputs for Application Template with name %s", appTemplate.Name)
 	}
 
-	appTemplate.Placeholders = r.placeholdersOverride
+	appTemplate.Placeholders = r.placeholdersOverride(appTemplate.Placeholders)
 	appTemplate.ApplicationInputJSON, err = r.mergedApplicationInput(appTemplate.ApplicationInputJSON, r.appInputOverride)
 	if err != nil {
 		return nil, errors.Wrap(err, "while merging application input from template and override application input")
@@ -95,10 +88,12 @@ func (r *renderer) getTemplateInputs(s System) (*model.ApplicationFromTemplateIn
 	}
 
 	inputValues := model.ApplicationFromTemplateInputValues{}
+	errs := validation.Errors{}
 	for _, pm := range r.placeholdersMapping {
 		placeholderInput := gjson.GetBytes(systemJSON, pm.SystemKey).String()
 		if len(placeholderInput) == 0 && !pm.Optional {
-			return nil, fmt.Errorf("missing or empty key %q in system input %s", pm.SystemKey, string(systemJSON))
+			errs[pm.PlaceholderName] = fmt.Errorf("missing or empty key %q", pm.SystemKey)
+			continue
 		}
 		inputValues = append(inputValues, &model.ApplicationTemplateValueInput{
 			Placeholder: pm.PlaceholderName,
@@ -106,9 +101,34 @@ func (r *renderer) getTemplateInputs(s System) (*model.ApplicationFromTemplateIn
 		})
 	}
 
+	if err := errs.Filter(); err != nil {
+		return nil, errors.Errorf("invalid system input %s: %s", string(systemJSON), err.Error())
+	}
+
 	return &inputValues, nil
 }
 
+// placeholdersOverride returns the placeholders of the mapping. The type, default value and constraints of a placeholder are taken from the
+// Application Template placeholder with the same name, if there is one.
+func (r *renderer) placeholdersOverride(templatePlaceholders []model.ApplicationTemplatePlaceholder) []model.ApplicationTemplatePlaceholder {
+	definitions := make(map[string]model.ApplicationTemplatePlaceholder, len(templatePlaceholders))
+	for _, placeholder := range templatePlaceholders {
+		definitions[placeholder.Name] = placeholder
+	}
+
+	placeholders := make([]model.ApplicationTemplatePlaceholder, 0, len(r.placeholdersMapping))
+	for _, pm := range r.placeholdersMapping {
+		placeholder, ok := definitions[pm.PlaceholderName]
+		if !ok {
+			placeholder = model.ApplicationTemplatePlaceholder{Name: pm.PlaceholderName}
+		}
+		placeholder.Optional = placeholder.Optional || pm.Optional
+		placeholders = append(placeholders, placeholder)
+	}
+
+	return placeholders
+}
+
 func (r *renderer) mergedApplicationInput(originalAppInputJSON, overrideAppInputJSON string) (string, error) {
 	var originalAppInput map[string]interface{}
 	var overrideAppInput map[string]interface{}
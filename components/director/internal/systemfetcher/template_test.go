@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/systemfetcher"
 	"github.com/kyma-incubator/compass/components/director/internal/systemfetcher/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -129,7 +130,7 @@ func TestApplicationRegisterInputFromTemplate(t *testing.T) {
 		{
 			name:             "Fails when app template has placeholders without assigned values",
 			system:           testSystem,
-			expectedErr:      errors.New("missing or empty key \"nonexistentKey\" in system input"),
+			expectedErr:      errors.New(`description: missing or empty key "nonexistentKey"; region: missing or empty key "anotherNonexistentKey".`),
 			appInputOverride: appInputOverride,
 			placeholderMappings: []systemfetcher.PlaceholderMapping{
 				{
@@ -140,6 +141,10 @@ func TestApplicationRegisterInputFromTemplate(t *testing.T) {
 					PlaceholderName: "description",
 					SystemKey:       "nonexistentKey",
 				},
+				{
+					PlaceholderName: "region",
+					SystemKey:       "anotherNonexistentKey",
+				},
 			},
 			setupAppTemplateSvc: func(testSystem systemfetcher.System, _ error) *automock.ApplicationTemplateService {
 				svc := &automock.ApplicationTemplateService{}
@@ -151,6 +156,42 @@ func TestApplicationRegisterInputFromTemplate(t *testing.T) {
 				return &automock.ApplicationConverter{}
 			},
 		},
+		{
+			name:             "Succeeds with the placeholder definitions of the app template",
+			system:           testSystem,
+			appInputOverride: appInputOverride,
+			placeholderMappings: []systemfetcher.PlaceholderMapping{
+				{
+					PlaceholderName: "name",
+					SystemKey:       "displayName",
+				},
+				{
+					PlaceholderName: "count",
+					SystemKey:       "nonexistentKey",
+					Optional:        true,
+				},
+			},
+			setupAppTemplateSvc: func(testSystem systemfetcher.System, _ error) *automock.ApplicationTemplateService {
+				appTemplateFromDB := *appTemplate
+				appTemplateFromDB.Placeholders = []model.ApplicationTemplatePlaceholder{
+					{Name: "name", Pattern: str.Ptr("[a-z]+")},
+					{Name: "count", Type: model.PlaceholderTypeNumber},
+					{Name: "unmapped"},
+				}
+				resultTemplate := *appTemplateWithOverrides
+				resultTemplate.Placeholders = []model.ApplicationTemplatePlaceholder{
+					{Name: "name", Pattern: str.Ptr("[a-z]+")},
+					{Name: "count", Type: model.PlaceholderTypeNumber, Optional: true},
+				}
+				inputValues := append(fixInputValuesForSystem(testSystem), &model.ApplicationTemplateValueInput{Placeholder: "count", Value: ""})
+
+				svc := &automock.ApplicationTemplateService{}
+				svc.On("Get", context.TODO(), testSystem.TemplateID).Return(&appTemplateFromDB, nil).Once()
+				svc.On("PrepareApplicationCreateInputJSON", &resultTemplate, inputValues).Return(appRegisterInputJSON, nil).Once()
+				return svc
+			},
+			setupAppConverter: appConvSvcNoErrors,
+		},
 		{
 			name:                "Fails when app input from template and overrides have a field with incorrect type",
 			system:              testSystem,
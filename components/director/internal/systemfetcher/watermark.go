@@ -0,0 +1,31 @@
+package systemfetcher
+
+import "time"
+
+// Watermark is the state of the synchronization of the systems of a single tenant
+type Watermark struct {
+	TenantID string
+	// LastChangeAt is the time before which the changed systems are already synchronized.
+	// It lags behind the start of the last synchronization by the delta overlap, so that the changes are not missed because of clock skew between the fetcher and the systems API.
+	LastChangeAt     time.Time
+	LastFullResyncAt time.Time
+}
+
+// shouldFullResync checks whether all the systems of the tenant should be fetched, instead of only the ones changed since the last synchronization
+func shouldFullResync(watermark *Watermark, fullResyncInterval time.Duration, now time.Time) bool {
+	return watermark == nil || now.After(watermark.LastFullResyncAt.Add(fullResyncInterval))
+}
+
+// nextWatermark returns the watermark of the synchronization of the tenant started at the given time.
+// The systems changed within the overlap before the start are fetched again on the next synchronization.
+func nextWatermark(tenantID string, watermark *Watermark, fullResync bool, startedAt time.Time, overlap time.Duration) *Watermark {
+	next := &Watermark{
+		TenantID:         tenantID,
+		LastChangeAt:     startedAt.Add(-overlap),
+		LastFullResyncAt: startedAt,
+	}
+	if !fullResync {
+		next.LastFullResyncAt = watermark.LastFullResyncAt
+	}
+	return next
+}
@@ -0,0 +1,70 @@
+package systemfetcher
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	watermarkColumns = "tenant_id, last_change_at, last_full_resync_at"
+
+	listWatermarksQuery = `SELECT ` + watermarkColumns + ` FROM public.system_fetcher_watermarks`
+
+	upsertWatermarkQuery = `INSERT INTO public.system_fetcher_watermarks (` + watermarkColumns + `)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (tenant_id) DO UPDATE SET last_change_at = EXCLUDED.last_change_at, last_full_resync_at = EXCLUDED.last_full_resync_at`
+)
+
+type watermarkEntity struct {
+	TenantID         string    `db:"tenant_id"`
+	LastChangeAt     time.Time `db:"last_change_at"`
+	LastFullResyncAt time.Time `db:"last_full_resync_at"`
+}
+
+type watermarkRepository struct{}
+
+// NewWatermarkRepository creates a new repository of the synchronization state of the systems of the tenants
+func NewWatermarkRepository() *watermarkRepository {
+	return &watermarkRepository{}
+}
+
+// List returns the watermarks of all the tenants whose systems were synchronized at least once
+func (r *watermarkRepository) List(ctx context.Context) ([]*Watermark, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var entities []watermarkEntity
+	log.C(ctx).Debugf("Executing DB query: %s", listWatermarksQuery)
+	if err := persist.SelectContext(ctx, &entities, listWatermarksQuery); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.SystemFetcherWatermark, resource.List, "while listing the system fetcher watermarks")
+	}
+
+	watermarks := make([]*Watermark, 0, len(entities))
+	for _, ent := range entities {
+		watermarks = append(watermarks, &Watermark{
+			TenantID:         ent.TenantID,
+			LastChangeAt:     ent.LastChangeAt,
+			LastFullResyncAt: ent.LastFullResyncAt,
+		})
+	}
+	return watermarks, nil
+}
+
+// Upsert stores the watermark of the tenant
+func (r *watermarkRepository) Upsert(ctx context.Context, watermark *Watermark) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", upsertWatermarkQuery)
+	_, err = persist.ExecContext(ctx, upsertWatermarkQuery, watermark.TenantID, watermark.LastChangeAt, watermark.LastFullResyncAt)
+	return persistence.MapSQLError(ctx, err, resource.SystemFetcherWatermark, resource.Upsert, "while upserting the system fetcher watermark of tenant with ID %s", watermark.TenantID)
+}
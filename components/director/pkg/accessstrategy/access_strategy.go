@@ -1,6 +1,7 @@
 package accessstrategy
 
 import (
+	"context"
 	"net/http"
 	"regexp"
 
@@ -12,6 +13,15 @@ import (
 var supportedAccessStrategies = map[Type]bool{
 	OpenAccessStrategy:    true,
 	CMPmTLSAccessStrategy: true,
+	BasicAccessStrategy:   true,
+	OAuthAccessStrategy:   true,
+	HeadersAccessStrategy: true,
+}
+
+var credentialsAccessStrategies = map[Type]bool{
+	BasicAccessStrategy:   true,
+	OAuthAccessStrategy:   true,
+	HeadersAccessStrategy: true,
 }
 
 // UnsupportedErr is an error produced when execution of unsupported access strategy takes place.
@@ -27,6 +37,15 @@ const (
 	// CustomAccessStrategy is an AccessStrategyType indicating that not a standard ORD security mechanism is used for the ORD document
 	CustomAccessStrategy Type = "custom"
 
+	// BasicAccessStrategy is a custom AccessStrategyType indicating that the ORD document is secured with the basic credentials of the remote system
+	BasicAccessStrategy Type = "sap.cmp:basic:v1"
+
+	// OAuthAccessStrategy is a custom AccessStrategyType indicating that the ORD document is secured with a token issued for the OAuth 2.0 client credentials of the remote system
+	OAuthAccessStrategy Type = "sap.cmp:oauth2-client-credentials:v1"
+
+	// HeadersAccessStrategy is a custom AccessStrategyType indicating that the ORD document is secured with the static headers configured for the remote system
+	HeadersAccessStrategy Type = "sap.cmp:headers:v1"
+
 	// MinDescriptionLength represents the minimal accepted length of the Description field
 	MinDescriptionLength = 1
 	// MaxDescriptionLength represents the minimal accepted length of the Description field
@@ -75,8 +94,49 @@ func (a Type) isSupported() bool {
 	return ok
 }
 
-// Executor defines an interface for execution of different access strategies
+// RequiresCredentials checks if the given AccessStrategy uses the credentials configured for the remote system
+func (a Type) RequiresCredentials() bool {
+	_, ok := credentialsAccessStrategies[a]
+	return ok
+}
+
+// Credentials are the credentials configured for the remote system, which are used by the access strategies requiring them
+type Credentials struct {
+	Basic   *BasicCredentials
+	OAuth   *OAuthCredentials
+	Headers http.Header
+}
+
+// BasicCredentials are the username and password used by the basic access strategy
+type BasicCredentials struct {
+	Username string
+	Password string
+}
+
+// OAuthCredentials are the OAuth 2.0 client credentials used by the OAuth access strategy
+type OAuthCredentials struct {
+	ClientID     string
+	ClientSecret string
+	TokenURL     string
+}
+
+// Executor defines an interface for execution of different access strategies.
+// The given headers, which may be nil, are added to the executed request.
+// The given credentials, which may be nil, are used only by the access strategies requiring them.
 //go:generate mockery --name=Executor --output=automock --outpkg=automock --case=underscore --disable-version-string
 type Executor interface {
-	Execute(client *http.Client, url string) (*http.Response, error)
+	Execute(ctx context.Context, client *http.Client, url string, headers http.Header, credentials *Credentials) (*http.Response, error)
+}
+
+func newGetRequest(url string, headers http.Header) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	return req, nil
 }
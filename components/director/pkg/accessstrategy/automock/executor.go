@@ -3,10 +3,14 @@
 package automock
 
 import (
+	context "context"
 	http "net/http"
-	testing "testing"
+
+	accessstrategy "github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
 
 	mock "github.com/stretchr/testify/mock"
+
+	testing "testing"
 )
 
 // Executor is an autogenerated mock type for the Executor type
@@ -14,13 +18,13 @@ type Executor struct {
 	mock.Mock
 }
 
-// Execute provides a mock function with given fields: client, url
-func (_m *Executor) Execute(client *http.Client, url string) (*http.Response, error) {
-	ret := _m.Called(client, url)
+// Execute provides a mock function with given fields: ctx, client, url, headers, credentials
+func (_m *Executor) Execute(ctx context.Context, client *http.Client, url string, headers http.Header, credentials *accessstrategy.Credentials) (*http.Response, error) {
+	ret := _m.Called(ctx, client, url, headers, credentials)
 
 	var r0 *http.Response
-	if rf, ok := ret.Get(0).(func(*http.Client, string) *http.Response); ok {
-		r0 = rf(client, url)
+	if rf, ok := ret.Get(0).(func(context.Context, *http.Client, string, http.Header, *accessstrategy.Credentials) *http.Response); ok {
+		r0 = rf(ctx, client, url, headers, credentials)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*http.Response)
@@ -28,8 +32,8 @@ func (_m *Executor) Execute(client *http.Client, url string) (*http.Response, er
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*http.Client, string) error); ok {
-		r1 = rf(client, url)
+	if rf, ok := ret.Get(1).(func(context.Context, *http.Client, string, http.Header, *accessstrategy.Credentials) error); ok {
+		r1 = rf(ctx, client, url, headers, credentials)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,30 @@
+package accessstrategy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type basicAccessStrategyExecutor struct{}
+
+// NewBasicAccessStrategyExecutor creates a new Executor for the Basic Access Strategy
+func NewBasicAccessStrategyExecutor() *basicAccessStrategyExecutor {
+	return &basicAccessStrategyExecutor{}
+}
+
+// Execute performs the access strategy's specific execution logic
+func (*basicAccessStrategyExecutor) Execute(_ context.Context, client *http.Client, documentURL string, headers http.Header, credentials *Credentials) (*http.Response, error) {
+	if credentials == nil || credentials.Basic == nil {
+		return nil, errors.New("basic credentials are not provided")
+	}
+
+	req, err := newGetRequest(documentURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(credentials.Basic.Username, credentials.Basic.Password)
+
+	return client.Do(req)
+}
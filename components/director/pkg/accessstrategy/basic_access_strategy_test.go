@@ -0,0 +1,52 @@
+package accessstrategy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBasicAccessStrategy(t *testing.T) {
+	testURL := "http://test"
+	headers := http.Header{"If-None-Match": []string{`"etag"`}}
+	credentials := &accessstrategy.Credentials{
+		Basic: &accessstrategy.BasicCredentials{
+			Username: "user",
+			Password: "pass",
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		client := newTestClient(func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, http.MethodGet, req.Method)
+			require.Equal(t, testURL, req.URL.String())
+			require.Equal(t, `"etag"`, req.Header.Get("If-None-Match"))
+
+			username, password, ok := req.BasicAuth()
+			require.True(t, ok)
+			require.Equal(t, "user", username)
+			require.Equal(t, "pass", password)
+			return expectedResp, nil
+		})
+
+		executor := accessstrategy.NewBasicAccessStrategyExecutor()
+		resp, err := executor.Execute(context.TODO(), client, testURL, headers, credentials)
+		require.NoError(t, err)
+		require.Equal(t, expectedResp, resp)
+	})
+
+	t.Run("Error when basic credentials are not provided", func(t *testing.T) {
+		client := newTestClient(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request is expected")
+			return nil, nil
+		})
+
+		executor := accessstrategy.NewBasicAccessStrategyExecutor()
+		_, err := executor.Execute(context.TODO(), client, testURL, headers, &accessstrategy.Credentials{})
+		require.EqualError(t, err, "basic credentials are not provided")
+	})
+}
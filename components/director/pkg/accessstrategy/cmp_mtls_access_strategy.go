@@ -1,6 +1,7 @@
 package accessstrategy
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
 
@@ -21,7 +22,7 @@ func NewCMPmTLSAccessStrategyExecutor(certCache certloader.Cache) *cmpMTLSAccess
 }
 
 // Execute performs the access strategy's specific execution logic
-func (as *cmpMTLSAccessStrategyExecutor) Execute(baseClient *http.Client, documentURL string) (*http.Response, error) {
+func (as *cmpMTLSAccessStrategyExecutor) Execute(_ context.Context, baseClient *http.Client, documentURL string, headers http.Header, _ *Credentials) (*http.Response, error) {
 	clientCert := as.certCache.Get()
 	if clientCert == nil {
 		return nil, errors.New("did not find client certificate in the cache")
@@ -39,5 +40,9 @@ func (as *cmpMTLSAccessStrategyExecutor) Execute(baseClient *http.Client, docume
 		Transport: tr,
 	}
 
-	return client.Get(documentURL)
+	req, err := newGetRequest(documentURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
 }
@@ -26,6 +26,9 @@ func NewDefaultExecutorProvider(certCache certloader.Cache) *Provider {
 		executors: map[Type]Executor{
 			OpenAccessStrategy:    &openAccessStrategyExecutor{},
 			CMPmTLSAccessStrategy: NewCMPmTLSAccessStrategyExecutor(certCache),
+			BasicAccessStrategy:   NewBasicAccessStrategyExecutor(),
+			OAuthAccessStrategy:   NewOAuthAccessStrategyExecutor(),
+			HeadersAccessStrategy: NewHeadersAccessStrategyExecutor(),
 		},
 	}
 }
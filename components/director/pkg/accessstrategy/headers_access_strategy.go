@@ -0,0 +1,36 @@
+package accessstrategy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type headersAccessStrategyExecutor struct{}
+
+// NewHeadersAccessStrategyExecutor creates a new Executor for the Headers Access Strategy
+func NewHeadersAccessStrategyExecutor() *headersAccessStrategyExecutor {
+	return &headersAccessStrategyExecutor{}
+}
+
+// Execute performs the access strategy's specific execution logic
+func (*headersAccessStrategyExecutor) Execute(_ context.Context, client *http.Client, documentURL string, headers http.Header, credentials *Credentials) (*http.Response, error) {
+	if credentials == nil || len(credentials.Headers) == 0 {
+		return nil, errors.New("headers are not provided")
+	}
+
+	req, err := newGetRequest(documentURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	// the configured headers take precedence over the ones with the same name, which are set by the caller
+	for key, values := range credentials.Headers {
+		req.Header.Del(key)
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return client.Do(req)
+}
@@ -0,0 +1,52 @@
+package accessstrategy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadersAccessStrategy(t *testing.T) {
+	testURL := "http://test"
+	headers := http.Header{
+		"If-None-Match": []string{`"etag"`},
+		"X-Api-Key":     []string{"caller-key"},
+	}
+	credentials := &accessstrategy.Credentials{
+		Headers: http.Header{
+			"X-Api-Key": []string{"key"},
+			"X-Tenant":  []string{"tenant"},
+		},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		client := newTestClient(func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, http.MethodGet, req.Method)
+			require.Equal(t, testURL, req.URL.String())
+			require.Equal(t, `"etag"`, req.Header.Get("If-None-Match"))
+			require.Equal(t, []string{"key"}, req.Header.Values("X-Api-Key"))
+			require.Equal(t, "tenant", req.Header.Get("X-Tenant"))
+			return expectedResp, nil
+		})
+
+		executor := accessstrategy.NewHeadersAccessStrategyExecutor()
+		resp, err := executor.Execute(context.TODO(), client, testURL, headers, credentials)
+		require.NoError(t, err)
+		require.Equal(t, expectedResp, resp)
+	})
+
+	t.Run("Error when headers are not provided", func(t *testing.T) {
+		client := newTestClient(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request is expected")
+			return nil, nil
+		})
+
+		executor := accessstrategy.NewHeadersAccessStrategyExecutor()
+		_, err := executor.Execute(context.TODO(), client, testURL, headers, nil)
+		require.EqualError(t, err, "headers are not provided")
+	})
+}
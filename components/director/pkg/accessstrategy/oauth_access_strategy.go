@@ -0,0 +1,67 @@
+package accessstrategy
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+type oauthAccessStrategyExecutor struct {
+	tokens map[OAuthCredentials]*oauth2.Token
+	mutex  sync.Mutex
+}
+
+// NewOAuthAccessStrategyExecutor creates a new Executor for the OAuth Access Strategy.
+// The issued tokens are cached per client credentials and reused until they expire.
+func NewOAuthAccessStrategyExecutor() *oauthAccessStrategyExecutor {
+	return &oauthAccessStrategyExecutor{
+		tokens: make(map[OAuthCredentials]*oauth2.Token),
+	}
+}
+
+// Execute performs the access strategy's specific execution logic
+func (as *oauthAccessStrategyExecutor) Execute(ctx context.Context, client *http.Client, documentURL string, headers http.Header, credentials *Credentials) (*http.Response, error) {
+	if credentials == nil || credentials.OAuth == nil {
+		return nil, errors.New("oauth credentials are not provided")
+	}
+
+	token, err := as.token(ctx, client, *credentials.OAuth)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching token from %q", credentials.OAuth.TokenURL)
+	}
+
+	req, err := newGetRequest(documentURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	token.SetAuthHeader(req)
+
+	return client.Do(req)
+}
+
+// token returns the cached token for the given client credentials, or issues a new one if there is no valid token in the cache
+func (as *oauthAccessStrategyExecutor) token(ctx context.Context, client *http.Client, credentials OAuthCredentials) (*oauth2.Token, error) {
+	as.mutex.Lock()
+	defer as.mutex.Unlock()
+
+	if token, ok := as.tokens[credentials]; ok && token.Valid() {
+		return token, nil
+	}
+
+	conf := &clientcredentials.Config{
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+		TokenURL:     credentials.TokenURL,
+	}
+	token, err := conf.Token(context.WithValue(ctx, oauth2.HTTPClient, client))
+	if err != nil {
+		return nil, err
+	}
+
+	as.tokens[credentials] = token
+	return token, nil
+}
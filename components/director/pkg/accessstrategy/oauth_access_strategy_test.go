@@ -0,0 +1,85 @@
+package accessstrategy_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthAccessStrategy(t *testing.T) {
+	testURL := "http://test"
+	tokenURL := "http://token"
+	headers := http.Header{"If-None-Match": []string{`"etag"`}}
+	credentials := &accessstrategy.Credentials{
+		OAuth: &accessstrategy.OAuthCredentials{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			TokenURL:     tokenURL,
+		},
+	}
+
+	newClient := func(t *testing.T, tokenRequests *int, tokenStatusCode int) *http.Client {
+		return newTestClient(func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() == tokenURL {
+				*tokenRequests++
+				require.Equal(t, http.MethodPost, req.Method)
+
+				clientID, clientSecret, ok := req.BasicAuth()
+				require.True(t, ok)
+				require.Equal(t, "client-id", clientID)
+				require.Equal(t, "client-secret", clientSecret)
+				return &http.Response{
+					StatusCode: tokenStatusCode,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       ioutil.NopCloser(bytes.NewBufferString(`{"access_token":"token","token_type":"bearer","expires_in":3600}`)),
+				}, nil
+			}
+
+			require.Equal(t, http.MethodGet, req.Method)
+			require.Equal(t, testURL, req.URL.String())
+			require.Equal(t, `"etag"`, req.Header.Get("If-None-Match"))
+			require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			return expectedResp, nil
+		})
+	}
+
+	t.Run("Success reusing the cached token", func(t *testing.T) {
+		tokenRequests := 0
+		client := newClient(t, &tokenRequests, http.StatusOK)
+
+		executor := accessstrategy.NewOAuthAccessStrategyExecutor()
+		for i := 0; i < 2; i++ {
+			resp, err := executor.Execute(context.TODO(), client, testURL, headers, credentials)
+			require.NoError(t, err)
+			require.Equal(t, expectedResp, resp)
+		}
+		require.Equal(t, 1, tokenRequests)
+	})
+
+	t.Run("Error when token cannot be fetched", func(t *testing.T) {
+		tokenRequests := 0
+		client := newClient(t, &tokenRequests, http.StatusUnauthorized)
+
+		executor := accessstrategy.NewOAuthAccessStrategyExecutor()
+		_, err := executor.Execute(context.TODO(), client, testURL, headers, credentials)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `while fetching token from "http://token"`)
+	})
+
+	t.Run("Error when oauth credentials are not provided", func(t *testing.T) {
+		client := newTestClient(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request is expected")
+			return nil, nil
+		})
+
+		executor := accessstrategy.NewOAuthAccessStrategyExecutor()
+		_, err := executor.Execute(context.TODO(), client, testURL, headers, nil)
+		require.EqualError(t, err, "oauth credentials are not provided")
+	})
+}
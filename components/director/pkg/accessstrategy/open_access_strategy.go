@@ -1,6 +1,7 @@
 package accessstrategy
 
 import (
+	"context"
 	"net/http"
 )
 
@@ -12,6 +13,10 @@ func NewOpenAccessStrategyExecutor() *openAccessStrategyExecutor {
 }
 
 // Execute performs the access strategy's specific execution logic
-func (*openAccessStrategyExecutor) Execute(client *http.Client, documentURL string) (*http.Response, error) {
-	return client.Get(documentURL)
+func (*openAccessStrategyExecutor) Execute(_ context.Context, client *http.Client, documentURL string, headers http.Header, _ *Credentials) (*http.Response, error) {
+	req, err := newGetRequest(documentURL, headers)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
 }
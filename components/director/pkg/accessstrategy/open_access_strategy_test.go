@@ -1,6 +1,7 @@
 package accessstrategy_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -13,10 +14,12 @@ import (
 
 func TestOpenAccessStrategy(t *testing.T) {
 	testURL := "http://test"
+	headers := http.Header{"If-None-Match": []string{`"etag"`}}
 
 	client := newTestClient(func(req *http.Request) (*http.Response, error) {
 		require.Equal(t, req.Method, http.MethodGet)
 		require.Equal(t, req.URL.String(), testURL)
+		require.Equal(t, `"etag"`, req.Header.Get("If-None-Match"))
 		return expectedResp, nil
 	})
 
@@ -25,7 +28,7 @@ func TestOpenAccessStrategy(t *testing.T) {
 	executor, err := provider.Provide(accessstrategy.OpenAccessStrategy)
 	require.NoError(t, err)
 
-	resp, err := executor.Execute(client, testURL)
+	resp, err := executor.Execute(context.TODO(), client, testURL, headers, nil)
 	require.NoError(t, err)
 	require.Equal(t, expectedResp, resp)
 }
@@ -3,6 +3,7 @@ package graphql
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -44,6 +45,8 @@ func (i PlaceholderDefinitionInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Name, validation.Required, inputvalidation.DNSName),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
+		validation.Field(&i.Pattern, validation.By(validRegexp)),
+		validation.Field(&i.AllowedValues, validation.Each(validation.Required)),
 	)
 }
 
@@ -139,3 +142,19 @@ func validPlaceholders(placeholders []*PlaceholderDefinitionInput, appInput *App
 	}
 	return nil
 }
+
+func validRegexp(value interface{}) error {
+	pattern, ok := value.(*string)
+	if !ok {
+		return errors.New("value could not be cast to string pointer")
+	}
+	if pattern == nil {
+		return nil
+	}
+
+	if _, err := regexp.Compile(*pattern); err != nil {
+		return errors.Wrap(err, "invalid regular expression")
+	}
+
+	return nil
+}
@@ -1,11 +1,17 @@
 package graphql
 
 import (
+	"regexp"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 )
 
+const clientCertificateRefLengthLimit = 253
+
+var clientCertificateRefRegexp = regexp.MustCompile("^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$")
+
 // Validate missing godoc
 func (i AuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
@@ -19,6 +25,7 @@ func (i AuthInput) Validate() error {
 		),
 		validation.Field(&i.Credential, validation.NilOrNotEmpty),
 		validation.Field(&i.RequestAuth),
+		validation.Field(&i.ClientCertificateRef, validation.NilOrNotEmpty, validation.Length(0, clientCertificateRefLengthLimit), validation.Match(clientCertificateRefRegexp)),
 	)
 }
 
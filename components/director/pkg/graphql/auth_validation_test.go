@@ -1,10 +1,12 @@
 package graphql_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
@@ -218,6 +220,61 @@ func TestAuthInput_Validate_RequestAuth(t *testing.T) {
 	}
 }
 
+func TestAuthInput_Validate_ClientCertificateRef(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("webhook-client-cert.v1"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         str.Ptr(inputvalidationtest.EmptyString),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - uppercase characters",
+			Value:         str.Ptr("Webhook-Client-Cert"),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - ending with dash",
+			Value:         str.Ptr("webhook-client-cert-"),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - too long",
+			Value:         str.Ptr(strings.Repeat("a", 254)),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidAuthInput()
+			sut.ClientCertificateRef = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestCredentialDataInput_Validate(t *testing.T) {
 	credential := fixValidCredentialDataInput()
 	basic := fixValidBasicCredentialDataInput()
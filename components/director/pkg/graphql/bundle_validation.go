@@ -49,6 +49,10 @@ func (i BundleInstanceAuthSetInput) Validate() error {
 		return apperrors.NewInvalidDataError("at least one field (Auth or Status) has to be provided")
 	}
 
+	if i.Auth == nil && i.ExpiresAt != nil {
+		return apperrors.NewInvalidDataError("expiresAt can be provided only together with auth")
+	}
+
 	if i.Status != nil {
 		if i.Auth != nil && i.Status.Condition != BundleInstanceAuthSetStatusConditionInputSucceeded {
 			return fmt.Errorf("status condition has to be equal to %s when the auth is provided", BundleInstanceAuthSetStatusConditionInputSucceeded)
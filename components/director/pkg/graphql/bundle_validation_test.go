@@ -2,6 +2,7 @@ package graphql_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
@@ -534,6 +535,7 @@ func TestBundleInstanceAuthSetInput_Validate(t *testing.T) {
 	//GIVEN
 	authInput := fixValidAuthInput()
 	str := "foo"
+	expiresAt := graphql.Timestamp(time.Now().Add(time.Hour))
 	testCases := []struct {
 		Name          string
 		Value         graphql.BundleInstanceAuthSetInput
@@ -546,6 +548,14 @@ func TestBundleInstanceAuthSetInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: true,
 		},
+		{
+			Name: "Auth and expiration",
+			Value: graphql.BundleInstanceAuthSetInput{
+				Auth:      &authInput,
+				ExpiresAt: &expiresAt,
+			},
+			ExpectedValid: true,
+		},
 		{
 			Name: "Failed Status",
 			Value: graphql.BundleInstanceAuthSetInput{
@@ -557,6 +567,18 @@ func TestBundleInstanceAuthSetInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: true,
 		},
+		{
+			Name: "Failed Status and expiration",
+			Value: graphql.BundleInstanceAuthSetInput{
+				Status: &graphql.BundleInstanceAuthStatusInput{
+					Condition: graphql.BundleInstanceAuthSetStatusConditionInputFailed,
+					Reason:    str,
+					Message:   str,
+				},
+				ExpiresAt: &expiresAt,
+			},
+			ExpectedValid: false,
+		},
 		{
 			Name: "Success Status",
 			Value: graphql.BundleInstanceAuthSetInput{
@@ -0,0 +1,19 @@
+package graphql
+
+// ApplicationEvent represents a change of an Application delivered to the subscribers
+type ApplicationEvent struct {
+	ID            string          `json:"id"`
+	Type          ChangeEventType `json:"type"`
+	ApplicationID string          `json:"applicationID"`
+	LabelKey      *string         `json:"labelKey"`
+	OccurredAt    Timestamp       `json:"occurredAt"`
+}
+
+// RuntimeEvent represents a change of a Runtime delivered to the subscribers
+type RuntimeEvent struct {
+	ID         string          `json:"id"`
+	Type       ChangeEventType `json:"type"`
+	RuntimeID  string          `json:"runtimeID"`
+	LabelKey   *string         `json:"labelKey"`
+	OccurredAt Timestamp       `json:"occurredAt"`
+}
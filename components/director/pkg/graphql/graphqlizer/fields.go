@@ -169,6 +169,7 @@ func (fp *GqlFieldsProvider) OmitForWebhooks(omittedProperties []string) string
 		"outputTemplate":   "outputTemplate",
 		"statusTemplate":   "statusTemplate",
 		"auth":             fmt.Sprintf("auth {%s}", fp.ForAuth()),
+		"signature":        fmt.Sprintf("signature {%s}", fp.ForWebhookSignature()),
 	}, omittedProperties)
 }
 
@@ -192,7 +193,18 @@ func (fp *GqlFieldsProvider) ForWebhooks() string {
 		statusTemplate
 		auth {
 		  %s
-		}`, fp.ForAuth())
+		}
+		signature {
+		  %s
+		}`, fp.ForAuth(), fp.ForWebhookSignature())
+}
+
+// ForWebhookSignature returns the fields of the settings for signing the requests sent to a webhook
+func (fp *GqlFieldsProvider) ForWebhookSignature() string {
+	return `secret
+		signatureHeader
+		timestampHeader
+		nonceHeader`
 }
 
 // OmitForAPIDefinition missing godoc
@@ -406,6 +418,7 @@ func (fp *GqlFieldsProvider) ForAuth() string {
 				expiresAt
 			}
 			certCommonName
+			clientCertificateRef
 			accessStrategy
 			additionalHeaders
 			additionalQueryParams
@@ -590,6 +603,7 @@ func (fp *GqlFieldsProvider) OmitForBundleInstanceAuth(omittedProperties []strin
 		"inputParams": "inputParams",
 		"auth":        fmt.Sprintf("auth {%s}", fp.ForAuth()),
 		"status":      fmt.Sprintf("status {%s}", fp.OmitForBundleInstanceAuthStatus(statusOmittedProperties)),
+		"expiresAt":   "expiresAt",
 	}, omittedProperties)
 }
 
@@ -602,7 +616,10 @@ func (fp *GqlFieldsProvider) ForBundleInstanceAuth() string {
 		auth {%s}
 		status {%s}
 		runtimeID
-		runtimeContextID`, fp.ForAuth(), fp.ForBundleInstanceAuthStatus())
+		runtimeContextID
+		expiresAt
+		previousAuth {%s}
+		previousAuthExpiresAt`, fp.ForAuth(), fp.ForBundleInstanceAuthStatus(), fp.ForAuth())
 }
 
 // OmitForBundleInstanceAuthStatus missing godoc
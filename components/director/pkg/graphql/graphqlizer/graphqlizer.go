@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strconv"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -278,7 +279,10 @@ func (g *Graphqlizer) AuthInputToGQL(in *graphql.AuthInput) (string, error) {
 		requestAuth: {{ .CertCommonName }},
 		{{- end }}
 		{{- if .OneTimeToken }}
-		oneTimeToken: {{ OneTimeTokenInputToGQL .OneTimeToken }}
+		oneTimeToken: {{ OneTimeTokenInputToGQL .OneTimeToken }},
+		{{- end }}
+		{{- if .ClientCertificateRef }}
+		clientCertificateRef: "{{ .ClientCertificateRef }}",
 		{{- end }}
 	}`)
 }
@@ -349,6 +353,25 @@ func (g *Graphqlizer) WebhookInputToGQL(in *graphql.WebhookInput) (string, error
 		{{- if .StatusTemplate }} 
 		statusTemplate: "{{.StatusTemplate }}",
 		{{- end }}
+		{{- if .Signature }}
+		signature: {{- WebhookSignatureInputToGQL .Signature }},
+		{{- end }}
+	}`)
+}
+
+// WebhookSignatureInputToGQL converts the settings for signing the requests sent to a webhook to a GraphQL input
+func (g *Graphqlizer) WebhookSignatureInputToGQL(in *graphql.WebhookSignatureInput) (string, error) {
+	return g.genericToGQL(in, `{
+		secret: "{{ .Secret }}",
+		{{- if .SignatureHeader }}
+		signatureHeader: "{{ .SignatureHeader }}",
+		{{- end }}
+		{{- if .TimestampHeader }}
+		timestampHeader: "{{ .TimestampHeader }}",
+		{{- end }}
+		{{- if .NonceHeader }}
+		nonceHeader: "{{ .NonceHeader }}",
+		{{- end }}
 	}`)
 }
 
@@ -645,6 +668,9 @@ func (g *Graphqlizer) BundleInstanceAuthSetInputToGQL(in graphql.BundleInstanceA
 		{{- if .Status }}
 		status: {{- BundleInstanceAuthStatusInputToGQL .Status }}
 		{{- end }}
+		{{- if .ExpiresAt }}
+		expiresAt: {{ TimestampToGQL .ExpiresAt }}
+		{{- end }}
 	}`)
 }
 
@@ -756,6 +782,7 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	fm["AuthInputToGQL"] = g.AuthInputToGQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
 	fm["WebhookInputToGQL"] = g.WebhookInputToGQL
+	fm["WebhookSignatureInputToGQL"] = g.WebhookSignatureInputToGQL
 	fm["APIDefinitionInputToGQL"] = g.APIDefinitionInputToGQL
 	fm["EventDefinitionInputToGQL"] = g.EventDefinitionInputToGQL
 	fm["APISpecInputToGQL"] = g.APISpecInputToGQL
@@ -772,6 +799,7 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	fm["BundleCreateInputToGQL"] = g.BundleCreateInputToGQL
 	fm["LabelSelectorInputToGQL"] = g.LabelSelectorInputToGQL
 	fm["OneTimeTokenInputToGQL"] = g.OneTimeTokenInputToGQL
+	fm["TimestampToGQL"] = timestampToGQL
 	fm["quote"] = strconv.Quote
 
 	t, err := template.New("tmpl").Funcs(fm).Parse(tmpl)
@@ -787,6 +815,10 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	return b.String(), nil
 }
 
+func timestampToGQL(in graphql.Timestamp) string {
+	return strconv.Quote(time.Time(in).Format(time.RFC3339))
+}
+
 func quoteCLOB(in *graphql.CLOB) *graphql.CLOB {
 	if in == nil {
 		return nil
@@ -2,6 +2,7 @@ package graphqlizer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
@@ -96,3 +97,23 @@ func TestGraphqlizer_LabelsToGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestGraphqlizer_BundleInstanceAuthSetInputToGQL(t *testing.T) {
+	// GIVEN
+	g := graphqlizer.Graphqlizer{}
+	expiresAt := graphql.Timestamp(time.Date(2022, 9, 12, 12, 0, 0, 0, time.UTC))
+
+	// WHEN
+	result, err := g.BundleInstanceAuthSetInputToGQL(graphql.BundleInstanceAuthSetInput{
+		Status: &graphql.BundleInstanceAuthStatusInput{
+			Condition: graphql.BundleInstanceAuthSetStatusConditionInputFailed,
+			Message:   "foo",
+			Reason:    "bar",
+		},
+		ExpiresAt: &expiresAt,
+	})
+
+	// THEN
+	require.NoError(t, err)
+	assert.Contains(t, result, `expiresAt: "2022-09-12T12:00:00Z"`)
+}
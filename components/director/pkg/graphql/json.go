@@ -37,3 +37,13 @@ func (j JSON) MarshalGQL(w io.Writer) {
 		log.D().Errorf("while writing %T: %s", j, err)
 	}
 }
+
+// NewJSONFromRawMessage returns nil for an empty or null raw message, so that it is exposed as a null JSON field.
+func NewJSONFromRawMessage(in json.RawMessage) *JSON {
+	if len(in) == 0 || string(in) == "null" {
+		return nil
+	}
+
+	out := JSON(in)
+	return &out
+}
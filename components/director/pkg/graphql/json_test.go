@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -83,3 +84,30 @@ func TestJSON_MarshalGQL(t *testing.T) {
 		})
 	}
 }
+
+func TestNewJSONFromRawMessage(t *testing.T) {
+	object := JSON(`{"key":"value"}`)
+
+	for name, tc := range map[string]struct {
+		input    json.RawMessage
+		expected *JSON
+	}{
+		//given
+		"object": {
+			input:    json.RawMessage(`{"key":"value"}`),
+			expected: &object,
+		},
+		"null": {
+			input:    json.RawMessage("null"),
+			expected: nil,
+		},
+		"empty": {
+			input:    nil,
+			expected: nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, NewJSONFromRawMessage(tc.input))
+		})
+	}
+}
@@ -80,6 +80,30 @@ type ApplicationFromTemplateInput struct {
 	Values       []*TemplateValueInput `json:"values"`
 }
 
+type ApplicationMergeLabel struct {
+	Key    string                      `json:"key"`
+	Action ApplicationMergeLabelAction `json:"action"`
+	// Set when both applications have the label with different values
+	Conflict         bool        `json:"conflict"`
+	SourceValue      interface{} `json:"sourceValue"`
+	DestinationValue interface{} `json:"destinationValue"`
+	// Value of the label on the destination application after the merge
+	Value interface{} `json:"value"`
+}
+
+type ApplicationMergePlan struct {
+	DestinationID  string                         `json:"destinationID"`
+	SourceID       string                         `json:"sourceID"`
+	ConflictPolicy ApplicationMergeConflictPolicy `json:"conflictPolicy"`
+	Labels         []*ApplicationMergeLabel       `json:"labels"`
+	// Scenarios of the source application which are added to the destination application
+	AddedScenarios []string `json:"addedScenarios"`
+	// Keys of the labels which have different values in both applications
+	Conflicts []string `json:"conflicts"`
+	// Bundles of the source application which are deleted together with it
+	RemovedBundles []*Bundle `json:"removedBundles"`
+}
+
 type ApplicationPage struct {
 	Data       []*Application `json:"data"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -170,6 +194,7 @@ type Auth struct {
 	RequestAuth                     *CredentialRequestAuth `json:"requestAuth"`
 	OneTimeToken                    OneTimeToken           `json:"oneTimeToken"`
 	CertCommonName                  *string                `json:"certCommonName"`
+	ClientCertificateRef            *string                `json:"clientCertificateRef"`
 }
 
 type AuthInput struct {
@@ -184,6 +209,9 @@ type AuthInput struct {
 	RequestAuth                     *CredentialRequestAuthInput `json:"requestAuth"`
 	CertCommonName                  *string                     `json:"certCommonName"`
 	OneTimeToken                    *OneTimeTokenInput          `json:"oneTimeToken"`
+	// Name of the client certificate which is used for mTLS calls instead of the global client certificate of Compass.
+	// **Validation:** valid Kubernetes resource name, max=253
+	ClientCertificateRef *string `json:"clientCertificateRef"`
 }
 
 type AutomaticScenarioAssignment struct {
@@ -241,6 +269,11 @@ type BundleInstanceAuth struct {
 	Status           *BundleInstanceAuthStatus `json:"status"`
 	RuntimeID        *string                   `json:"runtimeID"`
 	RuntimeContextID *string                   `json:"runtimeContextID"`
+	// When set, the auth is removed and the status is set to FAILED once the time is reached.
+	ExpiresAt *Timestamp `json:"expiresAt"`
+	// The credentials replaced by the last rotation. They stay valid until previousAuthExpiresAt.
+	PreviousAuth          *Auth      `json:"previousAuth"`
+	PreviousAuthExpiresAt *Timestamp `json:"previousAuthExpiresAt"`
 }
 
 type BundleInstanceAuthRequestInput struct {
@@ -257,6 +290,8 @@ type BundleInstanceAuthSetInput struct {
 	// **Validation:** Optional if the auth is provided.
 	// If the status condition is "FAILED", auth must be empty.
 	Status *BundleInstanceAuthStatusInput `json:"status"`
+	// **Validation:** Optional, can be provided only together with the auth. Must be in the future.
+	ExpiresAt *Timestamp `json:"expiresAt"`
 }
 
 type BundleInstanceAuthStatus struct {
@@ -269,6 +304,9 @@ type BundleInstanceAuthStatus struct {
 	// - CredentialsProvided
 	// - CredentialsNotProvided
 	// - PendingDeletion
+	// - RotationRequested
+	// - CredentialsRotated
+	// - CredentialsExpired
 	Reason string `json:"reason"`
 }
 
@@ -282,6 +320,9 @@ type BundleInstanceAuthStatusInput struct {
 	// - CredentialsProvided
 	// - CredentialsNotProvided
 	// - PendingDeletion
+	// - RotationRequested
+	// - CredentialsRotated
+	// - CredentialsExpired
 	//
 	// **Validation**: required, if condition is FAILED
 	Reason string `json:"reason"`
@@ -416,10 +457,12 @@ type FetchRequestInput struct {
 	URL string `json:"url"`
 	// Currently unsupported, providing it will result in a failure
 	Auth *AuthInput `json:"auth"`
-	// Currently unsupported, providing it will result in a failure
+	// SINGLE fetches the spec itself, BUNDLE fetches a zip, tar or tar.gz archive containing the spec,
+	// INDEX fetches a JSON document of the form {"specs": [{"name": "...", "url": "..."}]} listing spec URLs on the same host
 	Mode *FetchMode `json:"mode"`
 	// **Validation:** max=256
-	// Currently unsupported, providing it will result in a failure
+	// Glob pattern selecting exactly one file of the bundle or entry of the index. It is matched against the full path and the base name.
+	// Supported only for BUNDLE and INDEX modes.
 	Filter *string `json:"filter"`
 }
 
@@ -429,6 +472,17 @@ type FetchRequestStatus struct {
 	Timestamp Timestamp                   `json:"timestamp"`
 }
 
+type FormationEvent struct {
+	ID            string          `json:"id"`
+	Type          ChangeEventType `json:"type"`
+	FormationID   *string         `json:"formationID"`
+	FormationName string          `json:"formationName"`
+	// Set only for ASSIGNED and UNASSIGNED events
+	ObjectID   *string              `json:"objectID"`
+	ObjectType *FormationObjectType `json:"objectType"`
+	OccurredAt Timestamp            `json:"occurredAt"`
+}
+
 type FormationInput struct {
 	Name         string  `json:"name"`
 	TemplateName *string `json:"templateName"`
@@ -442,6 +496,15 @@ type FormationPage struct {
 
 func (FormationPage) IsPageable() {}
 
+type FormationStatus struct {
+	// READY when the formation has at least one application and at least one runtime or runtime context, INCOMPLETE when it has only one of them and EMPTY when it has no participants
+	Condition              FormationStatusCondition `json:"condition"`
+	ApplicationsCount      int                      `json:"applicationsCount"`
+	RuntimesCount          int                      `json:"runtimesCount"`
+	RuntimeContextsCount   int                      `json:"runtimeContextsCount"`
+	TenantAssignmentsCount int                      `json:"tenantAssignmentsCount"`
+}
+
 type FormationTemplate struct {
 	ID                     string       `json:"id"`
 	Name                   string       `json:"name"`
@@ -559,6 +622,37 @@ type OAuthCredentialDataInput struct {
 	URL string `json:"url"`
 }
 
+// Report of the latest ORD aggregation of an Application
+type ORDAggregationReport struct {
+	Status           ORDAggregationStatus `json:"status"`
+	Error            *string              `json:"error"`
+	DocumentsFetched int                  `json:"documentsFetched"`
+	// Resources described in the documents which passed the validation
+	AcceptedResources []*ORDReportedResource `json:"acceptedResources"`
+	// Resources described in the documents which failed the validation, once for each failed rule
+	RejectedResources []*ORDRejectedResource `json:"rejectedResources"`
+	// ORD IDs of the tombstones whose resources were deleted
+	AppliedTombstones []string  `json:"appliedTombstones"`
+	StartedAt         Timestamp `json:"startedAt"`
+	FinishedAt        Timestamp `json:"finishedAt"`
+}
+
+type ORDRejectedResource struct {
+	Type ORDResourceType `json:"type"`
+	// Empty for the rejections of the documents themselves
+	OrdID *string `json:"ordID"`
+	// Failed validation rule, e.g. validation_required or validation_unknown_reference
+	Rule string `json:"rule"`
+	// Path of the failed field in the resource, e.g. partOfConsumptionBundles[0].ordId
+	FieldPath *string `json:"fieldPath"`
+	Message   string  `json:"message"`
+}
+
+type ORDReportedResource struct {
+	Type  ORDResourceType `json:"type"`
+	OrdID string          `json:"ordID"`
+}
+
 type OneTimeTokenInput struct {
 	Token        string            `json:"token"`
 	ConnectorURL *string           `json:"connectorURL"`
@@ -571,15 +665,81 @@ type OneTimeTokenInput struct {
 	Type         *OneTimeTokenType `json:"type"`
 }
 
+type OpenResourceDiscoveryResync struct {
+	ApplicationID string `json:"applicationID"`
+	// QUEUED and RUNNING mean that the aggregation did not finish before the response was sent
+	Status OpenResourceDiscoveryResyncStatus `json:"status"`
+	Error  *string                           `json:"error"`
+}
+
+// ORD package of an Application
+type Operation struct {
+	ID                string                `json:"id"`
+	OperationType     OperationType         `json:"operationType"`
+	OperationCategory *string               `json:"operationCategory"`
+	ResourceType      OperationResourceType `json:"resourceType"`
+	ResourceID        string                `json:"resourceID"`
+	Phase             OperationPhase        `json:"phase"`
+	Error             *string               `json:"error"`
+	Webhooks          []*OperationWebhook   `json:"webhooks"`
+	CreatedAt         Timestamp             `json:"createdAt"`
+	UpdatedAt         Timestamp             `json:"updatedAt"`
+	FinishedAt        *Timestamp            `json:"finishedAt"`
+}
+
+type OperationWebhook struct {
+	WebhookID         string         `json:"webhookID"`
+	State             OperationPhase `json:"state"`
+	RetriesCount      int            `json:"retriesCount"`
+	PollURL           *string        `json:"pollURL"`
+	LastPollTimestamp *Timestamp     `json:"lastPollTimestamp"`
+}
+
+type Package struct {
+	ID                  string  `json:"id"`
+	OrdID               string  `json:"ordID"`
+	ApplicationID       string  `json:"applicationID"`
+	Vendor              *string `json:"vendor"`
+	Title               string  `json:"title"`
+	ShortDescription    string  `json:"shortDescription"`
+	Description         string  `json:"description"`
+	Version             string  `json:"version"`
+	PackageLinks        *JSON   `json:"packageLinks"`
+	Links               *JSON   `json:"links"`
+	LicenseType         *string `json:"licenseType"`
+	SupportInfo         *string `json:"supportInfo"`
+	Tags                *JSON   `json:"tags"`
+	Countries           *JSON   `json:"countries"`
+	Labels              *JSON   `json:"labels"`
+	PolicyLevel         string  `json:"policyLevel"`
+	CustomPolicyLevel   *string `json:"customPolicyLevel"`
+	PartOfProducts      *JSON   `json:"partOfProducts"`
+	LineOfBusiness      *JSON   `json:"lineOfBusiness"`
+	Industry            *JSON   `json:"industry"`
+	DocumentationLabels *JSON   `json:"documentationLabels"`
+}
+
 type PageInfo struct {
 	StartCursor PageCursor `json:"startCursor"`
 	EndCursor   PageCursor `json:"endCursor"`
 	HasNextPage bool       `json:"hasNextPage"`
 }
 
+type PageOrder struct {
+	// Field by which the items are ordered. Not every query supports every field.
+	Field PageOrderField `json:"field"`
+	// Items which have no value of the field are returned last, regardless of the direction.
+	Direction *PageOrderDirection `json:"direction"`
+}
+
 type PlaceholderDefinition struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
+	Name          string          `json:"name"`
+	Description   *string         `json:"description"`
+	Type          PlaceholderType `json:"type"`
+	DefaultValue  *string         `json:"defaultValue"`
+	Optional      bool            `json:"optional"`
+	Pattern       *string         `json:"pattern"`
+	AllowedValues []string        `json:"allowedValues"`
 }
 
 type PlaceholderDefinitionInput struct {
@@ -587,6 +747,32 @@ type PlaceholderDefinitionInput struct {
 	Name string `json:"name"`
 	// **Validation:**  max=2000
 	Description *string `json:"description"`
+	// Defaults to STRING. A string in the application input which consists only of a placeholder of another type is replaced with the typed value, for example "{{count}}" with 3
+	Type *PlaceholderType `json:"type"`
+	// Value used when no value is provided for the placeholder
+	// **Validation:** satisfies the type, the pattern and the allowed values
+	DefaultValue *string `json:"defaultValue"`
+	// Optional placeholders without a value and a default value are rendered as an empty string, or as null if they are not strings
+	Optional *bool `json:"optional"`
+	// Regular expression which the whole value must match
+	// **Validation:** valid regular expression
+	Pattern *string `json:"pattern"`
+	// **Validation:** each value satisfies the type
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// ORD product of an Application, or a global one when applicationID is empty
+type Product struct {
+	ID                  string  `json:"id"`
+	OrdID               string  `json:"ordID"`
+	ApplicationID       *string `json:"applicationID"`
+	Title               string  `json:"title"`
+	ShortDescription    string  `json:"shortDescription"`
+	Vendor              string  `json:"vendor"`
+	Parent              *string `json:"parent"`
+	CorrelationIDs      *JSON   `json:"correlationIDs"`
+	Labels              *JSON   `json:"labels"`
+	DocumentationLabels *JSON   `json:"documentationLabels"`
 }
 
 type RuntimeContextInput struct {
@@ -655,6 +841,27 @@ type RuntimeUpdateInput struct {
 	StatusCondition *RuntimeStatusCondition `json:"statusCondition"`
 }
 
+// A resource matching a full-text search term
+type SearchResult struct {
+	Kind SearchResultKind `json:"kind"`
+	ID   string           `json:"id"`
+	// ID of the Application which owns the resource, or of the Application itself
+	ApplicationID *string `json:"applicationID"`
+	Name          string  `json:"name"`
+	Description   *string `json:"description"`
+	OrdID         *string `json:"ordID"`
+	// Relevance of the resource for the search term, results are ordered by it in descending order
+	Rank float64 `json:"rank"`
+}
+
+type SearchResultPage struct {
+	Data       []*SearchResult `json:"data"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+func (SearchResultPage) IsPageable() {}
+
 type SystemAuthUpdateInput struct {
 	Auth *AuthInput `json:"auth"`
 }
@@ -665,6 +872,19 @@ type TemplateValueInput struct {
 	Value       string `json:"value"`
 }
 
+// A change which is applied, or would be applied in dry-run mode, while importing a tenant catalog
+type TenantCatalogChange struct {
+	Action       TenantCatalogChangeAction `json:"action"`
+	ResourceType TenantCatalogResourceType `json:"resourceType"`
+	// Location of the resource in the catalog, for example applications/my-app/bundles/my-bundle
+	Path string `json:"path"`
+}
+
+type TenantCatalogImportResult struct {
+	DryRun  bool                   `json:"dryRun"`
+	Changes []*TenantCatalogChange `json:"changes"`
+}
+
 type TenantPage struct {
 	Data       []*Tenant `json:"data"`
 	PageInfo   *PageInfo `json:"pageInfo"`
@@ -673,6 +893,25 @@ type TenantPage struct {
 
 func (TenantPage) IsPageable() {}
 
+// ORD tombstone of a removed resource of an Application
+type Tombstone struct {
+	ID            string `json:"id"`
+	OrdID         string `json:"ordID"`
+	ApplicationID string `json:"applicationID"`
+	RemovalDate   string `json:"removalDate"`
+}
+
+// ORD vendor of an Application, or a global one when applicationID is empty
+type Vendor struct {
+	ID                  string  `json:"id"`
+	OrdID               string  `json:"ordID"`
+	ApplicationID       *string `json:"applicationID"`
+	Title               string  `json:"title"`
+	Partners            *JSON   `json:"partners"`
+	Labels              *JSON   `json:"labels"`
+	DocumentationLabels *JSON   `json:"documentationLabels"`
+}
+
 type Version struct {
 	// for example 4.6
 	Value      string `json:"value"`
@@ -698,39 +937,60 @@ type Viewer struct {
 }
 
 type Webhook struct {
-	ID                    string       `json:"id"`
-	ApplicationID         *string      `json:"applicationID"`
-	ApplicationTemplateID *string      `json:"applicationTemplateID"`
-	RuntimeID             *string      `json:"runtimeID"`
-	IntegrationSystemID   *string      `json:"integrationSystemID"`
-	Type                  WebhookType  `json:"type"`
-	Mode                  *WebhookMode `json:"mode"`
-	CorrelationIDKey      *string      `json:"correlationIdKey"`
-	RetryInterval         *int         `json:"retryInterval"`
-	Timeout               *int         `json:"timeout"`
-	URL                   *string      `json:"url"`
-	Auth                  *Auth        `json:"auth"`
-	URLTemplate           *string      `json:"urlTemplate"`
-	InputTemplate         *string      `json:"inputTemplate"`
-	HeaderTemplate        *string      `json:"headerTemplate"`
-	OutputTemplate        *string      `json:"outputTemplate"`
-	StatusTemplate        *string      `json:"statusTemplate"`
+	ID                    string            `json:"id"`
+	ApplicationID         *string           `json:"applicationID"`
+	ApplicationTemplateID *string           `json:"applicationTemplateID"`
+	RuntimeID             *string           `json:"runtimeID"`
+	IntegrationSystemID   *string           `json:"integrationSystemID"`
+	Type                  WebhookType       `json:"type"`
+	Mode                  *WebhookMode      `json:"mode"`
+	CorrelationIDKey      *string           `json:"correlationIdKey"`
+	RetryInterval         *int              `json:"retryInterval"`
+	Timeout               *int              `json:"timeout"`
+	URL                   *string           `json:"url"`
+	Auth                  *Auth             `json:"auth"`
+	URLTemplate           *string           `json:"urlTemplate"`
+	InputTemplate         *string           `json:"inputTemplate"`
+	HeaderTemplate        *string           `json:"headerTemplate"`
+	OutputTemplate        *string           `json:"outputTemplate"`
+	StatusTemplate        *string           `json:"statusTemplate"`
+	Signature             *WebhookSignature `json:"signature"`
 }
 
 type WebhookInput struct {
 	Type WebhookType `json:"type"`
 	// **Validation:** valid URL, max=256
-	URL              *string      `json:"url"`
-	Auth             *AuthInput   `json:"auth"`
-	Mode             *WebhookMode `json:"mode"`
-	CorrelationIDKey *string      `json:"correlationIdKey"`
-	RetryInterval    *int         `json:"retryInterval"`
-	Timeout          *int         `json:"timeout"`
-	URLTemplate      *string      `json:"urlTemplate"`
-	InputTemplate    *string      `json:"inputTemplate"`
-	HeaderTemplate   *string      `json:"headerTemplate"`
-	OutputTemplate   *string      `json:"outputTemplate"`
-	StatusTemplate   *string      `json:"statusTemplate"`
+	URL              *string                `json:"url"`
+	Auth             *AuthInput             `json:"auth"`
+	Mode             *WebhookMode           `json:"mode"`
+	CorrelationIDKey *string                `json:"correlationIdKey"`
+	RetryInterval    *int                   `json:"retryInterval"`
+	Timeout          *int                   `json:"timeout"`
+	URLTemplate      *string                `json:"urlTemplate"`
+	InputTemplate    *string                `json:"inputTemplate"`
+	HeaderTemplate   *string                `json:"headerTemplate"`
+	OutputTemplate   *string                `json:"outputTemplate"`
+	StatusTemplate   *string                `json:"statusTemplate"`
+	Signature        *WebhookSignatureInput `json:"signature"`
+}
+
+type WebhookSignature struct {
+	Secret          string  `json:"secret"`
+	SignatureHeader *string `json:"signatureHeader"`
+	TimestampHeader *string `json:"timestampHeader"`
+	NonceHeader     *string `json:"nonceHeader"`
+}
+
+type WebhookSignatureInput struct {
+	// Secret used for the HMAC-SHA256 signature of the requests sent to the webhook.
+	// **Validation:** min=32, max=256
+	Secret string `json:"secret"`
+	// **Validation:** valid HTTP header name, max=128
+	SignatureHeader *string `json:"signatureHeader"`
+	// **Validation:** valid HTTP header name, max=128
+	TimestampHeader *string `json:"timestampHeader"`
+	// **Validation:** valid HTTP header name, max=128
+	NonceHeader *string `json:"nonceHeader"`
 }
 
 type APISpecType string
@@ -774,6 +1034,94 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationMergeConflictPolicy string
+
+const (
+	ApplicationMergeConflictPolicyPreferSource      ApplicationMergeConflictPolicy = "PREFER_SOURCE"
+	ApplicationMergeConflictPolicyPreferDestination ApplicationMergeConflictPolicy = "PREFER_DESTINATION"
+	ApplicationMergeConflictPolicyFail              ApplicationMergeConflictPolicy = "FAIL"
+)
+
+var AllApplicationMergeConflictPolicy = []ApplicationMergeConflictPolicy{
+	ApplicationMergeConflictPolicyPreferSource,
+	ApplicationMergeConflictPolicyPreferDestination,
+	ApplicationMergeConflictPolicyFail,
+}
+
+func (e ApplicationMergeConflictPolicy) IsValid() bool {
+	switch e {
+	case ApplicationMergeConflictPolicyPreferSource, ApplicationMergeConflictPolicyPreferDestination, ApplicationMergeConflictPolicyFail:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationMergeConflictPolicy) String() string {
+	return string(e)
+}
+
+func (e *ApplicationMergeConflictPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationMergeConflictPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationMergeConflictPolicy", str)
+	}
+	return nil
+}
+
+func (e ApplicationMergeConflictPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ApplicationMergeLabelAction string
+
+const (
+	ApplicationMergeLabelActionAdded       ApplicationMergeLabelAction = "ADDED"
+	ApplicationMergeLabelActionKept        ApplicationMergeLabelAction = "KEPT"
+	ApplicationMergeLabelActionOverwritten ApplicationMergeLabelAction = "OVERWRITTEN"
+	ApplicationMergeLabelActionCombined    ApplicationMergeLabelAction = "COMBINED"
+)
+
+var AllApplicationMergeLabelAction = []ApplicationMergeLabelAction{
+	ApplicationMergeLabelActionAdded,
+	ApplicationMergeLabelActionKept,
+	ApplicationMergeLabelActionOverwritten,
+	ApplicationMergeLabelActionCombined,
+}
+
+func (e ApplicationMergeLabelAction) IsValid() bool {
+	switch e {
+	case ApplicationMergeLabelActionAdded, ApplicationMergeLabelActionKept, ApplicationMergeLabelActionOverwritten, ApplicationMergeLabelActionCombined:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationMergeLabelAction) String() string {
+	return string(e)
+}
+
+func (e *ApplicationMergeLabelAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationMergeLabelAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationMergeLabelAction", str)
+	}
+	return nil
+}
+
+func (e ApplicationMergeLabelAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
@@ -1009,6 +1357,55 @@ func (e BundleInstanceAuthStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ChangeEventType string
+
+const (
+	ChangeEventTypeCreated      ChangeEventType = "CREATED"
+	ChangeEventTypeUpdated      ChangeEventType = "UPDATED"
+	ChangeEventTypeDeleted      ChangeEventType = "DELETED"
+	ChangeEventTypeLabelChanged ChangeEventType = "LABEL_CHANGED"
+	ChangeEventTypeAssigned     ChangeEventType = "ASSIGNED"
+	ChangeEventTypeUnassigned   ChangeEventType = "UNASSIGNED"
+)
+
+var AllChangeEventType = []ChangeEventType{
+	ChangeEventTypeCreated,
+	ChangeEventTypeUpdated,
+	ChangeEventTypeDeleted,
+	ChangeEventTypeLabelChanged,
+	ChangeEventTypeAssigned,
+	ChangeEventTypeUnassigned,
+}
+
+func (e ChangeEventType) IsValid() bool {
+	switch e {
+	case ChangeEventTypeCreated, ChangeEventTypeUpdated, ChangeEventTypeDeleted, ChangeEventTypeLabelChanged, ChangeEventTypeAssigned, ChangeEventTypeUnassigned:
+		return true
+	}
+	return false
+}
+
+func (e ChangeEventType) String() string {
+	return string(e)
+}
+
+func (e *ChangeEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeEventType", str)
+	}
+	return nil
+}
+
+func (e ChangeEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type DocumentFormat string
 
 const (
@@ -1218,6 +1615,49 @@ func (e FormationObjectType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FormationStatusCondition string
+
+const (
+	FormationStatusConditionEmpty      FormationStatusCondition = "EMPTY"
+	FormationStatusConditionIncomplete FormationStatusCondition = "INCOMPLETE"
+	FormationStatusConditionReady      FormationStatusCondition = "READY"
+)
+
+var AllFormationStatusCondition = []FormationStatusCondition{
+	FormationStatusConditionEmpty,
+	FormationStatusConditionIncomplete,
+	FormationStatusConditionReady,
+}
+
+func (e FormationStatusCondition) IsValid() bool {
+	switch e {
+	case FormationStatusConditionEmpty, FormationStatusConditionIncomplete, FormationStatusConditionReady:
+		return true
+	}
+	return false
+}
+
+func (e FormationStatusCondition) String() string {
+	return string(e)
+}
+
+func (e *FormationStatusCondition) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FormationStatusCondition(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FormationStatusCondition", str)
+	}
+	return nil
+}
+
+func (e FormationStatusCondition) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type HealthCheckStatusCondition string
 
 const (
@@ -1298,6 +1738,100 @@ func (e HealthCheckType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ORDAggregationStatus string
+
+const (
+	ORDAggregationStatusSucceeded ORDAggregationStatus = "SUCCEEDED"
+	ORDAggregationStatusFailed    ORDAggregationStatus = "FAILED"
+)
+
+var AllORDAggregationStatus = []ORDAggregationStatus{
+	ORDAggregationStatusSucceeded,
+	ORDAggregationStatusFailed,
+}
+
+func (e ORDAggregationStatus) IsValid() bool {
+	switch e {
+	case ORDAggregationStatusSucceeded, ORDAggregationStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ORDAggregationStatus) String() string {
+	return string(e)
+}
+
+func (e *ORDAggregationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ORDAggregationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ORDAggregationStatus", str)
+	}
+	return nil
+}
+
+func (e ORDAggregationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ORDResourceType string
+
+const (
+	ORDResourceTypeDocument          ORDResourceType = "DOCUMENT"
+	ORDResourceTypePackage           ORDResourceType = "PACKAGE"
+	ORDResourceTypeConsumptionBundle ORDResourceType = "CONSUMPTION_BUNDLE"
+	ORDResourceTypeProduct           ORDResourceType = "PRODUCT"
+	ORDResourceTypeVendor            ORDResourceType = "VENDOR"
+	ORDResourceTypeAPI               ORDResourceType = "API"
+	ORDResourceTypeEvent             ORDResourceType = "EVENT"
+	ORDResourceTypeTombstone         ORDResourceType = "TOMBSTONE"
+)
+
+var AllORDResourceType = []ORDResourceType{
+	ORDResourceTypeDocument,
+	ORDResourceTypePackage,
+	ORDResourceTypeConsumptionBundle,
+	ORDResourceTypeProduct,
+	ORDResourceTypeVendor,
+	ORDResourceTypeAPI,
+	ORDResourceTypeEvent,
+	ORDResourceTypeTombstone,
+}
+
+func (e ORDResourceType) IsValid() bool {
+	switch e {
+	case ORDResourceTypeDocument, ORDResourceTypePackage, ORDResourceTypeConsumptionBundle, ORDResourceTypeProduct, ORDResourceTypeVendor, ORDResourceTypeAPI, ORDResourceTypeEvent, ORDResourceTypeTombstone:
+		return true
+	}
+	return false
+}
+
+func (e ORDResourceType) String() string {
+	return string(e)
+}
+
+func (e *ORDResourceType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ORDResourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ORDResourceType", str)
+	}
+	return nil
+}
+
+func (e ORDResourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OneTimeTokenType string
 
 const (
@@ -1339,6 +1873,51 @@ func (e OneTimeTokenType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OpenResourceDiscoveryResyncStatus string
+
+const (
+	OpenResourceDiscoveryResyncStatusQueued    OpenResourceDiscoveryResyncStatus = "QUEUED"
+	OpenResourceDiscoveryResyncStatusRunning   OpenResourceDiscoveryResyncStatus = "RUNNING"
+	OpenResourceDiscoveryResyncStatusSucceeded OpenResourceDiscoveryResyncStatus = "SUCCEEDED"
+	OpenResourceDiscoveryResyncStatusFailed    OpenResourceDiscoveryResyncStatus = "FAILED"
+)
+
+var AllOpenResourceDiscoveryResyncStatus = []OpenResourceDiscoveryResyncStatus{
+	OpenResourceDiscoveryResyncStatusQueued,
+	OpenResourceDiscoveryResyncStatusRunning,
+	OpenResourceDiscoveryResyncStatusSucceeded,
+	OpenResourceDiscoveryResyncStatusFailed,
+}
+
+func (e OpenResourceDiscoveryResyncStatus) IsValid() bool {
+	switch e {
+	case OpenResourceDiscoveryResyncStatusQueued, OpenResourceDiscoveryResyncStatusRunning, OpenResourceDiscoveryResyncStatusSucceeded, OpenResourceDiscoveryResyncStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e OpenResourceDiscoveryResyncStatus) String() string {
+	return string(e)
+}
+
+func (e *OpenResourceDiscoveryResyncStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OpenResourceDiscoveryResyncStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OpenResourceDiscoveryResyncStatus", str)
+	}
+	return nil
+}
+
+func (e OpenResourceDiscoveryResyncStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationMode string
 
 const (
@@ -1380,6 +1959,90 @@ func (e OperationMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OperationPhase string
+
+const (
+	OperationPhaseInProgress OperationPhase = "IN_PROGRESS"
+	OperationPhaseSucceeded  OperationPhase = "SUCCEEDED"
+	OperationPhaseFailed     OperationPhase = "FAILED"
+)
+
+var AllOperationPhase = []OperationPhase{
+	OperationPhaseInProgress,
+	OperationPhaseSucceeded,
+	OperationPhaseFailed,
+}
+
+func (e OperationPhase) IsValid() bool {
+	switch e {
+	case OperationPhaseInProgress, OperationPhaseSucceeded, OperationPhaseFailed:
+		return true
+	}
+	return false
+}
+
+func (e OperationPhase) String() string {
+	return string(e)
+}
+
+func (e *OperationPhase) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationPhase(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationPhase", str)
+	}
+	return nil
+}
+
+func (e OperationPhase) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OperationResourceType string
+
+const (
+	OperationResourceTypeApplication         OperationResourceType = "APPLICATION"
+	OperationResourceTypeFormationAssignment OperationResourceType = "FORMATION_ASSIGNMENT"
+)
+
+var AllOperationResourceType = []OperationResourceType{
+	OperationResourceTypeApplication,
+	OperationResourceTypeFormationAssignment,
+}
+
+func (e OperationResourceType) IsValid() bool {
+	switch e {
+	case OperationResourceTypeApplication, OperationResourceTypeFormationAssignment:
+		return true
+	}
+	return false
+}
+
+func (e OperationResourceType) String() string {
+	return string(e)
+}
+
+func (e *OperationResourceType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationResourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationResourceType", str)
+	}
+	return nil
+}
+
+func (e OperationResourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationType string
 
 const (
@@ -1423,6 +2086,135 @@ func (e OperationType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PageOrderDirection string
+
+const (
+	PageOrderDirectionAsc  PageOrderDirection = "ASC"
+	PageOrderDirectionDesc PageOrderDirection = "DESC"
+)
+
+var AllPageOrderDirection = []PageOrderDirection{
+	PageOrderDirectionAsc,
+	PageOrderDirectionDesc,
+}
+
+func (e PageOrderDirection) IsValid() bool {
+	switch e {
+	case PageOrderDirectionAsc, PageOrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e PageOrderDirection) String() string {
+	return string(e)
+}
+
+func (e *PageOrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PageOrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PageOrderDirection", str)
+	}
+	return nil
+}
+
+func (e PageOrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PageOrderField string
+
+const (
+	PageOrderFieldID        PageOrderField = "ID"
+	PageOrderFieldName      PageOrderField = "NAME"
+	PageOrderFieldCreatedAt PageOrderField = "CREATED_AT"
+)
+
+var AllPageOrderField = []PageOrderField{
+	PageOrderFieldID,
+	PageOrderFieldName,
+	PageOrderFieldCreatedAt,
+}
+
+func (e PageOrderField) IsValid() bool {
+	switch e {
+	case PageOrderFieldID, PageOrderFieldName, PageOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e PageOrderField) String() string {
+	return string(e)
+}
+
+func (e *PageOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PageOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PageOrderField", str)
+	}
+	return nil
+}
+
+func (e PageOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PlaceholderType string
+
+const (
+	PlaceholderTypeString  PlaceholderType = "STRING"
+	PlaceholderTypeNumber  PlaceholderType = "NUMBER"
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	PlaceholderTypeJSON    PlaceholderType = "JSON"
+)
+
+var AllPlaceholderType = []PlaceholderType{
+	PlaceholderTypeString,
+	PlaceholderTypeNumber,
+	PlaceholderTypeBoolean,
+	PlaceholderTypeJSON,
+}
+
+func (e PlaceholderType) IsValid() bool {
+	switch e {
+	case PlaceholderTypeString, PlaceholderTypeNumber, PlaceholderTypeBoolean, PlaceholderTypeJSON:
+		return true
+	}
+	return false
+}
+
+func (e PlaceholderType) String() string {
+	return string(e)
+}
+
+func (e *PlaceholderType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlaceholderType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlaceholderType", str)
+	}
+	return nil
+}
+
+func (e PlaceholderType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
@@ -1468,6 +2260,53 @@ func (e RuntimeStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type SearchResultKind string
+
+const (
+	SearchResultKindApplication     SearchResultKind = "APPLICATION"
+	SearchResultKindBundle          SearchResultKind = "BUNDLE"
+	SearchResultKindAPIDefinition   SearchResultKind = "API_DEFINITION"
+	SearchResultKindEventDefinition SearchResultKind = "EVENT_DEFINITION"
+	SearchResultKindDocument        SearchResultKind = "DOCUMENT"
+)
+
+var AllSearchResultKind = []SearchResultKind{
+	SearchResultKindApplication,
+	SearchResultKindBundle,
+	SearchResultKindAPIDefinition,
+	SearchResultKindEventDefinition,
+	SearchResultKindDocument,
+}
+
+func (e SearchResultKind) IsValid() bool {
+	switch e {
+	case SearchResultKindApplication, SearchResultKindBundle, SearchResultKindAPIDefinition, SearchResultKindEventDefinition, SearchResultKindDocument:
+		return true
+	}
+	return false
+}
+
+func (e SearchResultKind) String() string {
+	return string(e)
+}
+
+func (e *SearchResultKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SearchResultKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SearchResultKind", str)
+	}
+	return nil
+}
+
+func (e SearchResultKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SpecFormat string
 
 const (
@@ -1554,6 +2393,141 @@ func (e SystemAuthReferenceType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TenantCatalogChangeAction string
+
+const (
+	TenantCatalogChangeActionCreate TenantCatalogChangeAction = "CREATE"
+	TenantCatalogChangeActionUpdate TenantCatalogChangeAction = "UPDATE"
+)
+
+var AllTenantCatalogChangeAction = []TenantCatalogChangeAction{
+	TenantCatalogChangeActionCreate,
+	TenantCatalogChangeActionUpdate,
+}
+
+func (e TenantCatalogChangeAction) IsValid() bool {
+	switch e {
+	case TenantCatalogChangeActionCreate, TenantCatalogChangeActionUpdate:
+		return true
+	}
+	return false
+}
+
+func (e TenantCatalogChangeAction) String() string {
+	return string(e)
+}
+
+func (e *TenantCatalogChangeAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantCatalogChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantCatalogChangeAction", str)
+	}
+	return nil
+}
+
+func (e TenantCatalogChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantCatalogFormat string
+
+const (
+	TenantCatalogFormatJSON TenantCatalogFormat = "JSON"
+	TenantCatalogFormatYaml TenantCatalogFormat = "YAML"
+)
+
+var AllTenantCatalogFormat = []TenantCatalogFormat{
+	TenantCatalogFormatJSON,
+	TenantCatalogFormatYaml,
+}
+
+func (e TenantCatalogFormat) IsValid() bool {
+	switch e {
+	case TenantCatalogFormatJSON, TenantCatalogFormatYaml:
+		return true
+	}
+	return false
+}
+
+func (e TenantCatalogFormat) String() string {
+	return string(e)
+}
+
+func (e *TenantCatalogFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantCatalogFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantCatalogFormat", str)
+	}
+	return nil
+}
+
+func (e TenantCatalogFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TenantCatalogResourceType string
+
+const (
+	TenantCatalogResourceTypeApplicationTemplate TenantCatalogResourceType = "APPLICATION_TEMPLATE"
+	TenantCatalogResourceTypeApplication         TenantCatalogResourceType = "APPLICATION"
+	TenantCatalogResourceTypeLabel               TenantCatalogResourceType = "LABEL"
+	TenantCatalogResourceTypeWebhook             TenantCatalogResourceType = "WEBHOOK"
+	TenantCatalogResourceTypeBundle              TenantCatalogResourceType = "BUNDLE"
+	TenantCatalogResourceTypeAPIDefinition       TenantCatalogResourceType = "API_DEFINITION"
+	TenantCatalogResourceTypeEventDefinition     TenantCatalogResourceType = "EVENT_DEFINITION"
+	TenantCatalogResourceTypeDocument            TenantCatalogResourceType = "DOCUMENT"
+)
+
+var AllTenantCatalogResourceType = []TenantCatalogResourceType{
+	TenantCatalogResourceTypeApplicationTemplate,
+	TenantCatalogResourceTypeApplication,
+	TenantCatalogResourceTypeLabel,
+	TenantCatalogResourceTypeWebhook,
+	TenantCatalogResourceTypeBundle,
+	TenantCatalogResourceTypeAPIDefinition,
+	TenantCatalogResourceTypeEventDefinition,
+	TenantCatalogResourceTypeDocument,
+}
+
+func (e TenantCatalogResourceType) IsValid() bool {
+	switch e {
+	case TenantCatalogResourceTypeApplicationTemplate, TenantCatalogResourceTypeApplication, TenantCatalogResourceTypeLabel, TenantCatalogResourceTypeWebhook, TenantCatalogResourceTypeBundle, TenantCatalogResourceTypeAPIDefinition, TenantCatalogResourceTypeEventDefinition, TenantCatalogResourceTypeDocument:
+		return true
+	}
+	return false
+}
+
+func (e TenantCatalogResourceType) String() string {
+	return string(e)
+}
+
+func (e *TenantCatalogResourceType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantCatalogResourceType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantCatalogResourceType", str)
+	}
+	return nil
+}
+
+func (e TenantCatalogResourceType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ViewerType string
 
 const (
@@ -1643,11 +2617,12 @@ func (e WebhookMode) MarshalGQL(w io.Writer) {
 type WebhookType string
 
 const (
-	WebhookTypeConfigurationChanged  WebhookType = "CONFIGURATION_CHANGED"
-	WebhookTypeRegisterApplication   WebhookType = "REGISTER_APPLICATION"
-	WebhookTypeUnregisterApplication WebhookType = "UNREGISTER_APPLICATION"
-	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
-	WebhookTypeUnpairApplication     WebhookType = "UNPAIR_APPLICATION"
+	WebhookTypeConfigurationChanged            WebhookType = "CONFIGURATION_CHANGED"
+	WebhookTypeRegisterApplication             WebhookType = "REGISTER_APPLICATION"
+	WebhookTypeUnregisterApplication           WebhookType = "UNREGISTER_APPLICATION"
+	WebhookTypeOpenResourceDiscovery           WebhookType = "OPEN_RESOURCE_DISCOVERY"
+	WebhookTypeUnpairApplication               WebhookType = "UNPAIR_APPLICATION"
+	WebhookTypeFormationAssignmentNotification WebhookType = "FORMATION_ASSIGNMENT_NOTIFICATION"
 )
 
 var AllWebhookType = []WebhookType{
@@ -1656,11 +2631,12 @@ var AllWebhookType = []WebhookType{
 	WebhookTypeUnregisterApplication,
 	WebhookTypeOpenResourceDiscovery,
 	WebhookTypeUnpairApplication,
+	WebhookTypeFormationAssignmentNotification,
 }
 
 func (e WebhookType) IsValid() bool {
 	switch e {
-	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication:
+	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication, WebhookTypeFormationAssignmentNotification:
 		return true
 	}
 	return false
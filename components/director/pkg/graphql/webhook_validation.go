@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"net/url"
+	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
@@ -9,6 +10,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
 )
 
+const webhookSignatureSecretMinLength = 32
+
+var httpHeaderNameRegexp = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
 // Validate missing godoc
 func (i WebhookInput) Validate() error {
 	if i.URL == nil && i.URLTemplate == nil {
@@ -26,7 +31,11 @@ func (i WebhookInput) Validate() error {
 		}
 	}
 
-	requestObject := webhook.RequestObject{Application: &Application{BaseEntity: &BaseEntity{}}}
+	var requestObject webhook.TemplateInput = &webhook.RequestObject{Application: &Application{BaseEntity: &BaseEntity{}}}
+	if i.Type == WebhookTypeFormationAssignmentNotification {
+		requestObject = &webhook.FormationAssignmentRequestObject{}
+	}
+
 	if i.URLTemplate != nil {
 		if _, err := requestObject.ParseURLTemplate(i.URLTemplate); err != nil {
 			return apperrors.NewInvalidDataError("failed to parse webhook url template: %s", err)
@@ -67,13 +76,24 @@ func (i WebhookInput) Validate() error {
 	}
 
 	return validation.ValidateStruct(&i,
-		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication)),
+		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication, WebhookTypeFormationAssignmentNotification)),
 		validation.Field(&i.URL, is.URL, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.CorrelationIDKey, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.Mode, validation.In(WebhookModeSync, WebhookModeAsync)),
 		validation.Field(&i.RetryInterval, validation.Min(0)),
 		validation.Field(&i.Timeout, validation.Min(0)),
 		validation.Field(&i.Auth),
+		validation.Field(&i.Signature),
+	)
+}
+
+// Validate validates the settings for signing the requests sent to a webhook
+func (i WebhookSignatureInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Secret, validation.Required, validation.RuneLength(webhookSignatureSecretMinLength, longStringLengthLimit)),
+		validation.Field(&i.SignatureHeader, validation.NilOrNotEmpty, validation.RuneLength(0, shortStringLengthLimit), validation.Match(httpHeaderNameRegexp)),
+		validation.Field(&i.TimestampHeader, validation.NilOrNotEmpty, validation.RuneLength(0, shortStringLengthLimit), validation.Match(httpHeaderNameRegexp)),
+		validation.Field(&i.NonceHeader, validation.NilOrNotEmpty, validation.RuneLength(0, shortStringLengthLimit), validation.Match(httpHeaderNameRegexp)),
 	)
 }
 
@@ -1,12 +1,14 @@
 package graphql_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +23,11 @@ func TestWebhookInput_Validate_Type(t *testing.T) {
 			Value:         graphql.WebhookTypeConfigurationChanged,
 			ExpectedValid: true,
 		},
+		{
+			Name:          "ExpectedValid - Formation assignment notification",
+			Value:         graphql.WebhookTypeFormationAssignmentNotification,
+			ExpectedValid: true,
+		},
 		{
 			Name:          "Invalid - Empty",
 			Value:         inputvalidationtest.EmptyString,
@@ -136,6 +143,148 @@ func TestWebhookInput_Validate_Auth(t *testing.T) {
 	}
 }
 
+func TestWebhookInput_Validate_Signature(t *testing.T) {
+	signature := fixValidWebhookSignatureInput()
+	testCases := []struct {
+		Name          string
+		Value         *graphql.WebhookSignatureInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         &signature,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Nested validation error",
+			Value:         &graphql.WebhookSignatureInput{},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidWebhookInput(inputvalidationtest.ValidURL)
+			sut.Signature = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookSignatureInput_Validate_Secret(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         strings.Repeat("s", 32),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Too short",
+			Value:         strings.Repeat("s", 31),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Too long",
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidWebhookSignatureInput()
+			sut.Secret = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestWebhookSignatureInput_Validate_Headers(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("X-Custom-Header"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         str.Ptr(inputvalidationtest.EmptyString),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid characters",
+			Value:         str.Ptr("X Custom: Header"),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Too long",
+			Value:         str.Ptr(inputvalidationtest.String129Long),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			for _, setHeader := range []func(in *graphql.WebhookSignatureInput, header *string){
+				func(in *graphql.WebhookSignatureInput, header *string) { in.SignatureHeader = header },
+				func(in *graphql.WebhookSignatureInput, header *string) { in.TimestampHeader = header },
+				func(in *graphql.WebhookSignatureInput, header *string) { in.NonceHeader = header },
+			} {
+				//GIVEN
+				sut := fixValidWebhookSignatureInput()
+				setHeader(&sut, testCase.Value)
+				// WHEN
+				err := sut.Validate()
+				// THEN
+				if testCase.ExpectedValid {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+				}
+			}
+		})
+	}
+}
+
 func TestWebhookInput_Validate_CorrelationIDKey(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -441,6 +590,49 @@ func TestWebhookInput_Validate_InputTemplate(t *testing.T) {
 	}
 }
 
+func TestWebhookInput_Validate_FormationAssignmentNotificationInputTemplate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name: "ExpectedValid",
+			Value: stringPtr(`{
+			  "operation": "{{.Operation}}",
+			  "formation": "{{.FormationName}}",
+			  "source_id": "{{.Source.ID}}",
+			  "target_id": "{{.Target.ID}}"
+			}`),
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - uses application request object",
+			Value: stringPtr(`{
+			  "app_id": "{{.Application.ID}}"
+			}`),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidWebhookInput(inputvalidationtest.ValidURL)
+			sut.Type = graphql.WebhookTypeFormationAssignmentNotification
+			sut.InputTemplate = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestWebhookInput_Validate_HeaderTemplate(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -775,3 +967,10 @@ func intPtr(n int) *int {
 func webhookModePtr(mode graphql.WebhookMode) *graphql.WebhookMode {
 	return &mode
 }
+
+func fixValidWebhookSignatureInput() graphql.WebhookSignatureInput {
+	return graphql.WebhookSignatureInput{
+		Secret:          strings.Repeat("s", 32),
+		SignatureHeader: str.Ptr("X-Signature"),
+	}
+}
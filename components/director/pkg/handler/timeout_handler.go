@@ -39,6 +39,31 @@ func WithTimeoutWithErrorMessage(h http.Handler, timeout time.Duration, msg []by
 	return newContentTypeHandler(postTimeoutLoggingHandler), nil
 }
 
+// WithWebsocketUpgradeBypass returns http.Handler which serves the websocket upgrade requests with upgradeHandler and all other requests with h.
+// It is used to exclude the long-living websocket connections from the request timeout.
+func WithWebsocketUpgradeBypass(h, upgradeHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isWebsocketUpgrade(r) {
+			upgradeHandler.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return false
+	}
+
+	for _, value := range strings.Split(r.Header.Get("Connection"), ",") {
+		if strings.EqualFold(strings.TrimSpace(value), "upgrade") {
+			return true
+		}
+	}
+	return false
+}
+
 func newTimeoutLoggingHandler(h http.Handler, timeout time.Duration, msg []byte) http.Handler {
 	return &timeoutLoggingHandler{
 		h:       h,
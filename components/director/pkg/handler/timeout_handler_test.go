@@ -73,6 +73,50 @@ func TestHandlerWithTimeout_LogCorrelationID(t *testing.T) {
 	assert.NotEqual(t, log.Configuration().BootstrapCorrelationID, reqID)
 }
 
+func TestWithWebsocketUpgradeBypass(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Headers        map[string]string
+		ExpectedStatus int
+	}{
+		{
+			Name:           "Websocket upgrade request is served by the upgrade handler",
+			Headers:        map[string]string{"Upgrade": "websocket", "Connection": "keep-alive, Upgrade"},
+			ExpectedStatus: http.StatusSwitchingProtocols,
+		},
+		{
+			Name:           "Request without Connection upgrade header is served by the default handler",
+			Headers:        map[string]string{"Upgrade": "websocket"},
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "Regular request is served by the default handler",
+			ExpectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			upgradeHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusSwitchingProtocols)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			for key, value := range testCase.Headers {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+
+			handler.WithWebsocketUpgradeBypass(h, upgradeHandler).ServeHTTP(w, req)
+
+			require.Equal(t, testCase.ExpectedStatus, w.Code)
+		})
+	}
+}
+
 func getStubHandleFunc(t *testing.T, timeout time.Duration) (http.HandlerFunc, func()) {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
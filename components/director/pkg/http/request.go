@@ -12,13 +12,13 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 )
 
-// GetRequestWithCredentials executes a GET http request to the given url with the provided auth credentials
-func GetRequestWithCredentials(ctx context.Context, client *http.Client, url string, auth *model.Auth) (*http.Response, error) {
+// GetRequestWithCredentials executes a GET http request with the given headers, which may be nil, to the given url with the provided auth credentials
+func GetRequestWithCredentials(ctx context.Context, client *http.Client, url string, headers http.Header, auth *model.Auth) (*http.Response, error) {
 	if auth == nil || (auth.Credential.Basic == nil && auth.Credential.Oauth == nil) {
 		return nil, apperrors.NewInvalidDataError("Credentials not provided")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := newGetRequest(url, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +54,25 @@ func secureClient(ctx context.Context, client *http.Client, auth *model.Auth) *h
 	return securedClient
 }
 
-// GetRequestWithoutCredentials executes a GET http request to the given url
-func GetRequestWithoutCredentials(client *http.Client, url string) (*http.Response, error) {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+// GetRequestWithoutCredentials executes a GET http request with the given headers, which may be nil, to the given url
+func GetRequestWithoutCredentials(client *http.Client, url string, headers http.Header) (*http.Response, error) {
+	req, err := newGetRequest(url, headers)
 	if err != nil {
 		return nil, err
 	}
 
 	return client.Do(req)
 }
+
+func newGetRequest(url string, headers http.Header) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	return req, nil
+}
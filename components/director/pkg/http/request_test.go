@@ -49,7 +49,7 @@ func TestRequestWithoutCredentials_Success(t *testing.T) {
 		return expectedResp
 	})
 
-	resp, err := httputil.GetRequestWithoutCredentials(client, testURL)
+	resp, err := httputil.GetRequestWithoutCredentials(client, testURL, nil)
 	require.NoError(t, err)
 	require.Equal(t, resp, expectedResp)
 }
@@ -59,7 +59,7 @@ func TestRequestWithoutCredentials_FailedRequest(t *testing.T) {
 		return nil
 	})
 
-	_, err := httputil.GetRequestWithoutCredentials(client, testURL)
+	_, err := httputil.GetRequestWithoutCredentials(client, testURL, nil)
 	require.ErrorIs(t, err, testErr)
 }
 
@@ -73,7 +73,7 @@ func TestRequestWithCredentials_SuccessWithBasicAuth(t *testing.T) {
 		return expectedResp
 	})
 
-	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, &model.Auth{
+	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Basic: &model.BasicCredentialData{
 				Username: user,
@@ -90,7 +90,7 @@ func TestRequestWithCredentials_FailedWithBasicAuth(t *testing.T) {
 		return nil
 	})
 
-	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, &model.Auth{
+	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Basic: &model.BasicCredentialData{
 				Username: "user",
@@ -135,7 +135,7 @@ func TestRequestWithCredentials_SuccessWithOAuth(t *testing.T) {
 		}
 	})
 
-	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, &model.Auth{
+	resp, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Oauth: &model.OAuthCredentialData{
 				ClientID:     clientID,
@@ -155,7 +155,7 @@ func TestRequestWithCredentials_FailedWithOAuthDueToInvalidCredentials(t *testin
 		}
 	})
 
-	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, &model.Auth{
+	_, err := httputil.GetRequestWithCredentials(context.Background(), client, testURL, nil, &model.Auth{
 		Credential: model.CredentialData{
 			Oauth: &model.OAuthCredentialData{},
 		},
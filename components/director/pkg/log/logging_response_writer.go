@@ -16,7 +16,13 @@
 
 package log
 
-import "net/http"
+import (
+	"bufio"
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
 
 type responseWriter struct {
 	http.ResponseWriter
@@ -39,3 +45,12 @@ func (lrw *responseWriter) WriteHeader(code int) {
 		lrw.wroteHeader = true
 	}
 }
+
+// Hijack allows the connection to be taken over, e.g. by the GraphQL subscriptions websocket transport
+func (lrw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := lrw.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("the underlying response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
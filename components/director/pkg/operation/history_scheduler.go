@@ -0,0 +1,52 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// HistoryScheduler is a Scheduler which records the operations scheduled by another Scheduler in the operations history
+type HistoryScheduler struct {
+	scheduler Scheduler
+	recorder  HistoryRecorder
+}
+
+// NewHistoryScheduler creates a new HistoryScheduler
+func NewHistoryScheduler(scheduler Scheduler, recorder HistoryRecorder) *HistoryScheduler {
+	return &HistoryScheduler{
+		scheduler: scheduler,
+		recorder:  recorder,
+	}
+}
+
+// Schedule schedules the operation and records it in the operations history within the transaction in the context.
+// The operation is recorded only after it is successfully scheduled, as the scheduling fails while another operation is in progress for the same resource.
+func (s *HistoryScheduler) Schedule(ctx context.Context, op *Operation) (string, error) {
+	operationID, err := s.scheduler.Schedule(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.recorder.RecordScheduled(ctx, op); err != nil {
+		return "", errors.Wrapf(err, "while recording operation for resource with ID %q in the operations history", op.ResourceID)
+	}
+
+	return operationID, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryScheduler_Schedule(t *testing.T) {
+	ctx := context.TODO()
+	operationID := "e8f7ec34-34ef-4b16-8a1d-1d7c4a6d2d32"
+	op := &operation.Operation{
+		OperationType: operation.OperationTypeCreate,
+		ResourceID:    resourceID,
+		ResourceType:  resource.Application,
+		WebhookIDs:    []string{webhookID1},
+	}
+
+	t.Run("records the scheduled operation in the history", func(t *testing.T) {
+		scheduler := &automock.Scheduler{}
+		scheduler.On("Schedule", ctx, op).Return(operationID, nil).Once()
+		historyRecorder := &automock.HistoryRecorder{}
+		historyRecorder.On("RecordScheduled", ctx, op).Return(nil).Once()
+		defer mock.AssertExpectationsForObjects(t, scheduler, historyRecorder)
+
+		id, err := operation.NewHistoryScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+
+		require.NoError(t, err)
+		require.Equal(t, operationID, id)
+	})
+
+	t.Run("does not record the operation when scheduling fails", func(t *testing.T) {
+		scheduler := &automock.Scheduler{}
+		scheduler.On("Schedule", ctx, op).Return("", mockedError()).Once()
+		historyRecorder := &automock.HistoryRecorder{}
+		defer mock.AssertExpectationsForObjects(t, scheduler, historyRecorder)
+
+		_, err := operation.NewHistoryScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+
+		require.EqualError(t, err, mockedError().Error())
+		historyRecorder.AssertNotCalled(t, "RecordScheduled", mock.Anything, mock.Anything)
+	})
+
+	t.Run("returns error when recording the operation fails", func(t *testing.T) {
+		scheduler := &automock.Scheduler{}
+		scheduler.On("Schedule", ctx, op).Return(operationID, nil).Once()
+		historyRecorder := &automock.HistoryRecorder{}
+		historyRecorder.On("RecordScheduled", ctx, op).Return(mockedError()).Once()
+		defer mock.AssertExpectationsForObjects(t, scheduler, historyRecorder)
+
+		_, err := operation.NewHistoryScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while recording operation for resource with ID")
+	})
+}
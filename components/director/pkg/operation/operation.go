@@ -69,6 +69,15 @@ type OperationResponse struct {
 	Error  *string         `json:"error,omitempty"`
 }
 
+// WebhookStatus describes the progress of the execution of a webhook of an Operation
+type WebhookStatus struct {
+	WebhookID         string          `json:"webhook_id"`
+	State             OperationStatus `json:"state,omitempty"`
+	RetriesCount      int             `json:"retries_count,omitempty"`
+	WebhookPollURL    string          `json:"webhook_poll_url,omitempty"`
+	LastPollTimestamp *time.Time      `json:"last_poll_timestamp,omitempty"`
+}
+
 // Operation represents a GraphQL mutation which has associated HTTP requests (Webhooks) that need to be executed
 // for the request to be completed fully. Objects of type Operation are meant to be constructed, enriched throughout
 // the flow of the original mutation with information such as ResourceID and ResourceType and finally scheduled through
@@ -89,7 +98,7 @@ type Operation struct {
 func (op *Operation) Validate() error {
 	return validation.ValidateStruct(op,
 		validation.Field(&op.ResourceID, is.UUID),
-		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application)))
+		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application, resource.FormationAssignment)))
 }
 
 // SaveToContext saves Operation to the context
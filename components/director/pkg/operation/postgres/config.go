@@ -0,0 +1,14 @@
+package postgres
+
+import "time"
+
+// Config contains the configuration of the processing of the operations scheduled in the database.
+// LockDuration must be longer than the timeout of a single webhook request, as the operation can be claimed by another worker after it expires.
+type Config struct {
+	WorkersCount    int           `envconfig:"default=4,APP_OPERATIONS_WORKERS_COUNT"`
+	PollInterval    time.Duration `envconfig:"default=5s,APP_OPERATIONS_POLL_INTERVAL"`
+	TimeoutFactor   int           `envconfig:"default=2,APP_OPERATIONS_TIMEOUT_FACTOR"`
+	WebhookTimeout  time.Duration `envconfig:"default=2h,APP_OPERATIONS_WEBHOOK_TIMEOUT"`
+	RequeueInterval time.Duration `envconfig:"default=2m,APP_OPERATIONS_REQUEUE_INTERVAL"`
+	LockDuration    time.Duration `envconfig:"default=5m,APP_OPERATIONS_LOCK_DURATION"`
+}
@@ -0,0 +1,47 @@
+package postgres_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	operationID   = "f0e2b2d1-5f07-4d6c-9c2a-1b9b7e4a3c11"
+	resourceID    = "a7d1c6f3-2b4e-4f3a-8d0c-5e6f7a8b9c0d"
+	webhookID     = "3c5e7a9b-1d2f-4a6b-8c0e-2f4a6b8c0d1e"
+	correlationID = "correlation-id"
+	requestObject = `{"Application":{"id":"a7d1c6f3-2b4e-4f3a-8d0c-5e6f7a8b9c0d","name":"app"},"TenantID":"tenant","Headers":{}}`
+	locationURL   = "https://test-domain.com/operation"
+	testErr       = "test error"
+)
+
+func fixScheduledOperation(opType operation.OperationType, webhookIDs ...string) *postgres.ScheduledOperation {
+	now := time.Now()
+	return &postgres.ScheduledOperation{
+		ID:            operationID,
+		ResourceType:  resource.Application,
+		ResourceID:    resourceID,
+		OperationType: opType,
+		CorrelationID: correlationID,
+		WebhookIDs:    webhookIDs,
+		RequestObject: requestObject,
+		Phase:         postgres.PhaseInProgress,
+		InitializedAt: now,
+		NextAttemptAt: now,
+		LockedUntil:   now.Add(5 * time.Minute),
+	}
+}
+
+func fixConfig() postgres.Config {
+	return postgres.Config{
+		WorkersCount:    1,
+		PollInterval:    time.Second,
+		TimeoutFactor:   2,
+		WebhookTimeout:  time.Hour,
+		RequeueInterval: time.Minute,
+		LockDuration:    5 * time.Minute,
+	}
+}
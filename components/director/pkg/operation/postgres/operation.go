@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Phase denotes the processing phase of a ScheduledOperation
+type Phase string
+
+const (
+	// PhaseInProgress denotes an operation which is waiting to be processed by a Worker
+	PhaseInProgress Phase = "IN_PROGRESS"
+	// PhaseSuccess denotes a successfully completed operation
+	PhaseSuccess Phase = "SUCCESS"
+	// PhaseFailed denotes a failed operation
+	PhaseFailed Phase = "FAILED"
+)
+
+// ErrClaimLost is returned when the state of an operation is stored by a worker whose claim on the operation has expired,
+// as the operation might have been claimed by another worker in the meantime
+var ErrClaimLost = errors.New("the claim of the operation has been lost")
+
+// ScheduledOperation is an operation stored in the database along with the state of its processing.
+// There is at most one ScheduledOperation per resource, which is reused when a new operation is scheduled for the resource.
+type ScheduledOperation struct {
+	ID                string
+	ResourceType      resource.Type
+	ResourceID        string
+	OperationType     operation.OperationType
+	OperationCategory string
+	CorrelationID     string
+	WebhookIDs        []string
+	RequestObject     string
+	Phase             Phase
+	Error             *string
+	WebhookPollURL    string
+	LastPollTimestamp *time.Time
+	RetriesCount      int
+	InitializedAt     time.Time
+	NextAttemptAt     time.Time
+	// LockedUntil is the expiry of the claim of the worker processing the operation, which fences the updates of the operation
+	LockedUntil time.Time
+}
+
+// Validate checks that the operation can be processed
+func (op *ScheduledOperation) Validate() error {
+	if webhookCount := len(op.WebhookIDs); webhookCount > 1 {
+		return fmt.Errorf("expected 0 or 1 webhook for execution, found: %d", webhookCount)
+	}
+
+	return nil
+}
+
+// HasPollURL checks if the webhook of the operation has been executed and returned a URL for polling its status
+func (op *ScheduledOperation) HasPollURL() bool {
+	return op.WebhookPollURL != ""
+}
@@ -0,0 +1,26 @@
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduledOperation_Validate(t *testing.T) {
+	t.Run("Success without webhooks", func(t *testing.T) {
+		require.NoError(t, fixScheduledOperation(operation.OperationTypeCreate).Validate())
+	})
+
+	t.Run("Success with a single webhook", func(t *testing.T) {
+		require.NoError(t, fixScheduledOperation(operation.OperationTypeCreate, webhookID).Validate())
+	})
+
+	t.Run("Error with multiple webhooks", func(t *testing.T) {
+		err := fixScheduledOperation(operation.OperationTypeCreate, webhookID, webhookID).Validate()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected 0 or 1 webhook for execution, found: 2")
+	})
+}
@@ -0,0 +1,368 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/reconcile"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+)
+
+// ResourceFetcherFunc defines a function which fetches the resource of an operation regardless of its tenant
+type ResourceFetcherFunc func(ctx context.Context, id string) (model.Entity, error)
+
+// WebhookFetcherFunc defines a function which fetches a webhook by ID regardless of its tenant
+type WebhookFetcherFunc func(ctx context.Context, id string) (*graphql.Webhook, error)
+
+// OperationUpdater applies the result of a finished operation to its resource, as the Operations API does for the operations-controller
+//
+//go:generate mockery --name=OperationUpdater --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationUpdater interface {
+	UpdateOperation(ctx context.Context, operation *operation.OperationRequest) error
+}
+
+// OperationProgressUpdater records the progress of an operation which is not yet finished, as the Operations API does for the operations-controller
+//
+//go:generate mockery --name=OperationProgressUpdater --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationProgressUpdater interface {
+	UpdateOperationProgress(ctx context.Context, operation *operation.OperationRequest) error
+}
+
+// webhookCall is the webhook request of an operation, which is executed outside of a database transaction.
+// Either the initial request or the poll request is set.
+type webhookCall struct {
+	webhook     *graphql.Webhook
+	request     *webhookclient.Request
+	pollRequest *webhookclient.PollRequest
+}
+
+// webhookResult is the outcome of a webhookCall
+type webhookResult struct {
+	response     *webhookdir.Response
+	pollResponse *webhookdir.ResponseStatus
+	err          error
+}
+
+type reconciler struct {
+	decider              *reconcile.Decider
+	transact             persistence.Transactioner
+	repo                 OperationRepository
+	webhookClient        webhookclient.Client
+	webhookFetcherFunc   WebhookFetcherFunc
+	resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc
+	operationUpdater     OperationUpdater
+	progressUpdater      OperationProgressUpdater
+}
+
+// NewReconciler creates a reconciler which executes the webhooks of the scheduled operations the same way as the operations-controller does for Operation CRs.
+// The readiness of the resources with a ResourceFetcherFunc is checked before executing their webhooks.
+func NewReconciler(cfg Config, transact persistence.Transactioner, repo OperationRepository, webhookClient webhookclient.Client, webhookFetcherFunc WebhookFetcherFunc, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, operationUpdater OperationUpdater, progressUpdater OperationProgressUpdater) *reconciler {
+	return &reconciler{
+		decider: reconcile.NewDecider(reconcile.Config{
+			WebhookTimeout:  cfg.WebhookTimeout,
+			TimeoutFactor:   cfg.TimeoutFactor,
+			RequeueInterval: cfg.RequeueInterval,
+		}),
+		transact:             transact,
+		repo:                 repo,
+		webhookClient:        webhookClient,
+		webhookFetcherFunc:   webhookFetcherFunc,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		operationUpdater:     operationUpdater,
+		progressUpdater:      progressUpdater,
+	}
+}
+
+// Reconcile advances the processing of the operation and stores its new state. The operation is either finalized or requeued for a later attempt.
+// The operation is prepared and its result is stored in separate transactions, as its webhook is executed outside of a database transaction.
+// An error is returned when the processing could not be advanced and should be retried.
+func (r *reconciler) Reconcile(ctx context.Context, op *ScheduledOperation) error {
+	var call *webhookCall
+	err := r.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		call, err = r.prepare(ctx, op)
+		return err
+	})
+	if err != nil || call == nil {
+		return err
+	}
+
+	result := r.execute(ctx, call)
+
+	return r.inTransaction(ctx, func(ctx context.Context) error {
+		return r.record(ctx, op, call, result)
+	})
+}
+
+// prepare returns the webhook call which has to be executed for the operation.
+// It returns nil if the operation has been finalized or requeued without executing a webhook.
+func (r *reconciler) prepare(ctx context.Context, op *ScheduledOperation) (*webhookCall, error) {
+	if err := op.Validate(); err != nil {
+		log.C(ctx).WithError(err).Error("Invalid operation")
+		return nil, r.finalizeStatusWithError(ctx, op, err)
+	}
+
+	requestObject, err := parseRequestObject(op)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Unable to parse request object")
+		return nil, r.finalizeStatusWithError(ctx, op, err)
+	}
+
+	if fetchResource, ok := r.resourceFetcherFuncs[op.ResourceType]; ok {
+		entity, err := fetchResource(ctx, op.ResourceID)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Unable to fetch %s with ID %s", op.ResourceType, op.ResourceID)
+			return nil, r.apply(ctx, op, r.decider.FetchResourceFailed(op.OperationType, string(op.ResourceType), apperrors.IsNotFoundError(err), err, op.InitializedAt, time.Now()))
+		}
+
+		if entity.GetReady() {
+			return nil, r.finalizeStatus(ctx, op, entity.GetError())
+		}
+	}
+
+	if len(op.WebhookIDs) == 0 {
+		log.C(ctx).Info("No webhook defined. Operation executed successfully")
+		return nil, r.finalizeStatusSuccess(ctx, op)
+	}
+
+	webhookEntity, err := r.webhookFetcherFunc(ctx, op.WebhookIDs[0])
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Unable to retrieve webhook")
+		if apperrors.IsNotFoundError(err) {
+			return nil, r.finalizeStatusWithError(ctx, op, fmt.Errorf("missing webhook with ID: %s", op.WebhookIDs[0]))
+		}
+		return nil, errors.Wrapf(err, "while fetching webhook with ID %s", op.WebhookIDs[0])
+	}
+
+	if r.decider.TimeoutReached(op.InitializedAt, webhookEntity, time.Now()) {
+		log.C(ctx).Info("Reconciliation timeout reached")
+		return nil, r.finalizeStatusWithError(ctx, op, webhookclient.ErrWebhookTimeoutReached)
+	}
+
+	if !op.HasPollURL() {
+		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
+		return &webhookCall{
+			webhook: webhookEntity,
+			request: webhookclient.NewRequest(*webhookEntity, requestObject, op.CorrelationID),
+		}, nil
+	}
+
+	log.C(ctx).Info("Webhook Poll URL is found. Will calculate next poll time")
+	if requeueAfter := r.decider.NextPollAfter(op.LastPollTimestamp, webhookEntity, time.Now()); requeueAfter > 0 {
+		log.C(ctx).Infof("Poll interval has not passed. Will requeue after: %s", requeueAfter)
+		return nil, r.requeue(ctx, op, requeueAfter)
+	}
+
+	return &webhookCall{
+		webhook:     webhookEntity,
+		pollRequest: webhookclient.NewPollRequest(*webhookEntity, requestObject, op.CorrelationID, op.WebhookPollURL),
+	}, nil
+}
+
+func (r *reconciler) execute(ctx context.Context, call *webhookCall) *webhookResult {
+	if call.pollRequest != nil {
+		response, err := r.webhookClient.Poll(ctx, call.pollRequest)
+		return &webhookResult{pollResponse: response, err: err}
+	}
+
+	response, err := r.webhookClient.Do(ctx, call.request)
+	return &webhookResult{response: response, err: err}
+}
+
+// record stores the new state of the operation based on the result of its webhook call
+func (r *reconciler) record(ctx context.Context, op *ScheduledOperation, call *webhookCall, result *webhookResult) error {
+	if call.pollRequest != nil {
+		if result.err != nil {
+			log.C(ctx).WithError(result.err).Error("Unable to execute Webhook Poll request")
+		} else {
+			log.C(ctx).Infof("Asynchronous webhook polling request has been executed successfully with response status: %s", *result.pollResponse.Status)
+		}
+
+		return r.apply(ctx, op, r.decider.WebhookPolled(call.webhook, result.pollResponse, result.err, op.InitializedAt, time.Now()))
+	}
+
+	if result.err != nil {
+		log.C(ctx).WithError(result.err).Error("Unable to execute Webhook request")
+	} else {
+		log.C(ctx).Info("Webhook initial request has been executed successfully")
+	}
+
+	return r.apply(ctx, op, r.decider.WebhookExecuted(op.OperationType, call.webhook, result.response, result.err, op.InitializedAt, time.Now()))
+}
+
+// apply stores the new state of the operation according to the decision
+func (r *reconciler) apply(ctx context.Context, op *ScheduledOperation, decision reconcile.Decision) error {
+	if decision.PollURL != "" {
+		op.WebhookPollURL = decision.PollURL
+		if err := r.updateProgress(ctx, op); err != nil {
+			return err
+		}
+	}
+
+	if decision.Polled {
+		lastPollTimestamp := time.Now()
+		op.LastPollTimestamp = &lastPollTimestamp
+		op.RetriesCount++
+		if err := r.updateProgress(ctx, op); err != nil {
+			return err
+		}
+	}
+
+	switch decision.Outcome {
+	case reconcile.OutcomeSuccess:
+		return r.finalizeStatusSuccess(ctx, op)
+	case reconcile.OutcomeFailure:
+		return r.finalizeStatusWithError(ctx, op, decision.Err)
+	case reconcile.OutcomeComplete:
+		var errorMsg *string
+		if decision.Err != nil {
+			errorMsg = str.Ptr(decision.Err.Error())
+		}
+		return r.finalizeStatus(ctx, op, errorMsg)
+	case reconcile.OutcomeDelete:
+		if err := r.repo.Delete(ctx, op.ID); err != nil {
+			return err
+		}
+
+		log.C(ctx).Info("Successfully deleted operation")
+		return nil
+	default:
+		return r.requeue(ctx, op, decision.RequeueAfter)
+	}
+}
+
+func (r *reconciler) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening database transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err := fn(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return errors.Wrap(tx.Commit(), "while committing database transaction")
+}
+
+func (r *reconciler) requeue(ctx context.Context, op *ScheduledOperation, after time.Duration) error {
+	op.NextAttemptAt = time.Now().Add(after)
+	return r.repo.Update(ctx, op)
+}
+
+func (r *reconciler) updateProgress(ctx context.Context, op *ScheduledOperation) error {
+	request := prepareOperationRequest(op, nil)
+	request.Webhooks = webhookStatuses(op, operation.OperationStatusInProgress)
+	return r.progressUpdater.UpdateOperationProgress(ctx, request)
+}
+
+// finalizeStatus completes the operation without notifying the resource, which is already ready or no longer exists
+func (r *reconciler) finalizeStatus(ctx context.Context, op *ScheduledOperation, errorMsg *string) error {
+	if errorMsg != nil && *errorMsg != "" {
+		op.Phase = PhaseFailed
+		op.Error = errorMsg
+	} else {
+		op.Phase = PhaseSuccess
+	}
+
+	return r.repo.Update(ctx, op)
+}
+
+func (r *reconciler) finalizeStatusSuccess(ctx context.Context, op *ScheduledOperation) error {
+	if err := r.operationUpdater.UpdateOperation(ctx, prepareOperationRequest(op, nil)); err != nil {
+		return err
+	}
+
+	op.Phase = PhaseSuccess
+	if err := r.repo.Update(ctx, op); err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("Successfully updated operation status to succeeded in %s", time.Since(op.InitializedAt))
+	return nil
+}
+
+func (r *reconciler) finalizeStatusWithError(ctx context.Context, op *ScheduledOperation, opErr error) error {
+	if err := r.operationUpdater.UpdateOperation(ctx, prepareOperationRequest(op, opErr)); err != nil {
+		return err
+	}
+
+	op.Phase = PhaseFailed
+	op.Error = str.Ptr(opErr.Error())
+	if err := r.repo.Update(ctx, op); err != nil {
+		return err
+	}
+
+	log.C(ctx).Info("Successfully updated operation status to failed")
+	return nil
+}
+
+func prepareOperationRequest(op *ScheduledOperation, err error) *operation.OperationRequest {
+	request := &operation.OperationRequest{
+		OperationType:     op.OperationType,
+		ResourceType:      op.ResourceType,
+		ResourceID:        op.ResourceID,
+		OperationCategory: op.OperationCategory,
+	}
+
+	state := operation.OperationStatusSucceeded
+	if err != nil {
+		request.Error = err.Error()
+		state = operation.OperationStatusFailed
+	}
+	request.Webhooks = webhookStatuses(op, state)
+
+	return request
+}
+
+// webhookStatuses describes the progress of the only webhook which is executed for the operation
+func webhookStatuses(op *ScheduledOperation, state operation.OperationStatus) []operation.WebhookStatus {
+	if len(op.WebhookIDs) == 0 {
+		return nil
+	}
+
+	return []operation.WebhookStatus{{
+		WebhookID:         op.WebhookIDs[0],
+		State:             state,
+		RetriesCount:      op.RetriesCount,
+		WebhookPollURL:    op.WebhookPollURL,
+		LastPollTimestamp: op.LastPollTimestamp,
+	}}
+}
+
+func parseRequestObject(op *ScheduledOperation) (webhookdir.TemplateInput, error) {
+	if op.ResourceType == resource.FormationAssignment {
+		requestObject := &webhookdir.FormationAssignmentRequestObject{}
+		if err := json.Unmarshal([]byte(op.RequestObject), requestObject); err != nil {
+			return nil, err
+		}
+		return requestObject, nil
+	}
+
+	requestObject := struct {
+		Application graphql.Application
+		TenantID    string
+		Headers     map[string]string
+	}{}
+	if err := json.Unmarshal([]byte(op.RequestObject), &requestObject); err != nil {
+		return nil, err
+	}
+
+	return &webhookdir.RequestObject{
+		Application: &requestObject.Application,
+		TenantID:    requestObject.TenantID,
+		Headers:     requestObject.Headers,
+	}, nil
+}
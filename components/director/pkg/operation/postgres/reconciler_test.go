@@ -0,0 +1,543 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	webhookclientmock "github.com/kyma-incubator/compass/components/director/pkg/webhook_client/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.TODO()
+	testError := errors.New(testErr)
+	txGen := txtest.NewTransactionContextGenerator(testError)
+	syncMode := graphql.WebhookModeSync
+	asyncMode := graphql.WebhookModeAsync
+	retryInterval := 60
+	syncWebhook := &graphql.Webhook{ID: webhookID, Mode: &syncMode}
+	asyncWebhook := &graphql.Webhook{ID: webhookID, Mode: &asyncMode, RetryInterval: &retryInterval}
+
+	fixPolledOperation := func() *postgres.ScheduledOperation {
+		op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+		op.WebhookPollURL = locationURL
+		return op
+	}
+
+	fixPollResponse := func(status string) *webhookdir.ResponseStatus {
+		return &webhookdir.ResponseStatus{
+			Status:                     str.Ptr(status),
+			SuccessStatusIdentifier:    str.Ptr("SUCCEEDED"),
+			InProgressStatusIdentifier: str.Ptr("IN_PROGRESS"),
+			FailedStatusIdentifier:     str.Ptr("FAILED"),
+		}
+	}
+
+	operationRequest := func(opType operation.OperationType, errMsg string) interface{} {
+		return mock.MatchedBy(func(request *operation.OperationRequest) bool {
+			return request.OperationType == opType && request.ResourceType == resource.Application && request.ResourceID == resourceID && request.Error == errMsg
+		})
+	}
+
+	progressRequest := func(pollURL string, retriesCount int) interface{} {
+		return mock.MatchedBy(func(request *operation.OperationRequest) bool {
+			return request.ResourceID == resourceID && len(request.Webhooks) == 1 && request.Webhooks[0].WebhookID == webhookID &&
+				request.Webhooks[0].State == operation.OperationStatusInProgress && request.Webhooks[0].WebhookPollURL == pollURL && request.Webhooks[0].RetriesCount == retriesCount
+		})
+	}
+
+	phaseMatcher := func(phase postgres.Phase, errMsg string) interface{} {
+		return mock.MatchedBy(func(op *postgres.ScheduledOperation) bool {
+			return op.Phase == phase && str.PtrStrToStr(op.Error) == errMsg
+		})
+	}
+
+	requeueMatcher := func(after time.Duration) interface{} {
+		return mock.MatchedBy(func(op *postgres.ScheduledOperation) bool {
+			return op.Phase == postgres.PhaseInProgress && op.NextAttemptAt.After(time.Now().Add(after-time.Minute)) && !op.NextAttemptAt.After(time.Now().Add(after))
+		})
+	}
+
+	testCases := []struct {
+		Name               string
+		Operation          *postgres.ScheduledOperation
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ResourceFetcherFn  postgres.ResourceFetcherFunc
+		WebhookFetcherFn   postgres.WebhookFetcherFunc
+		WebhookClientFn    func() *webhookclientmock.Client
+		RepoFn             func() *automock.OperationRepository
+		UpdaterFn          func() *automock.OperationUpdater
+		ProgressUpdaterFn  func() *automock.OperationProgressUpdater
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success when there are no webhooks",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate),
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "")).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Success when the resource is already ready",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			ResourceFetcherFn: func(ctx context.Context, id string) (model.Entity, error) {
+				return &model.BaseEntity{ID: resourceID, Ready: true, Error: str.Ptr(testErr)}, nil
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, testErr)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:      "Success when the deleted resource is not found",
+			Operation: fixScheduledOperation(operation.OperationTypeDelete, webhookID),
+			ResourceFetcherFn: func(ctx context.Context, id string) (model.Entity, error) {
+				return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:      "Requeue when fetching the resource fails",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			ResourceFetcherFn: func(ctx context.Context, id string) (model.Entity, error) {
+				return nil, testError
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), requeueMatcher(time.Minute)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Delete operation when fetching the resource fails after the timeout",
+			Operation: func() *postgres.ScheduledOperation {
+				op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+				op.InitializedAt = time.Now().Add(-3 * time.Hour)
+				return op
+			}(),
+			ResourceFetcherFn: func(ctx context.Context, id string) (model.Entity, error) {
+				return nil, testError
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Delete", txtest.CtxWithDBMatcher(), operationID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:      "Success when synchronous webhook is executed",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return syncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Do", ctx, mock.Anything).Return(&webhookdir.Response{}, nil).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "")).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Requeue for polling when asynchronous webhook is executed",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Do", ctx, mock.Anything).Return(&webhookdir.Response{Location: str.Ptr(locationURL)}, nil).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(op *postgres.ScheduledOperation) bool {
+					return op.Phase == postgres.PhaseInProgress && op.WebhookPollURL == locationURL
+				})).Return(nil).Once()
+				return repo
+			},
+			ProgressUpdaterFn: func() *automock.OperationProgressUpdater {
+				progressUpdater := &automock.OperationProgressUpdater{}
+				progressUpdater.On("UpdateOperationProgress", txtest.CtxWithDBMatcher(), progressRequest(locationURL, 0)).Return(nil).Once()
+				return progressUpdater
+			},
+		},
+		{
+			Name:      "Success when webhook of delete operation returns gone status",
+			Operation: fixScheduledOperation(operation.OperationTypeDelete, webhookID),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Do", ctx, mock.Anything).Return(&webhookdir.Response{GoneStatusCode: &[]int{410}[0]}, webhookclient.NewStatusGoneError(410)).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeDelete, "")).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Requeue when webhook execution fails",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Do", ctx, mock.Anything).Return(nil, testError).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), requeueMatcher(time.Duration(retryInterval)*time.Second)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:      "Failure when webhook execution fails with fatal error",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Do", ctx, mock.Anything).Return(nil, webhookclient.NewFatalError(testErr)).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, testErr)).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, testErr)).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name: "Failure when webhook timeout is reached",
+			Operation: func() *postgres.ScheduledOperation {
+				op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+				op.InitializedAt = time.Now().Add(-2 * time.Hour)
+				return op
+			}(),
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, webhookclient.ErrWebhookTimeoutReached.Error())).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, webhookclient.ErrWebhookTimeoutReached.Error())).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Requeue when webhook is still in progress",
+			Operation: fixPolledOperation(),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Poll", ctx, mock.Anything).Return(fixPollResponse("IN_PROGRESS"), nil).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(op *postgres.ScheduledOperation) bool {
+					return op.Phase == postgres.PhaseInProgress && op.RetriesCount == 1 && op.LastPollTimestamp != nil
+				})).Return(nil).Once()
+				return repo
+			},
+			ProgressUpdaterFn: func() *automock.OperationProgressUpdater {
+				progressUpdater := &automock.OperationProgressUpdater{}
+				progressUpdater.On("UpdateOperationProgress", txtest.CtxWithDBMatcher(), progressRequest(locationURL, 1)).Return(nil).Once()
+				return progressUpdater
+			},
+		},
+		{
+			Name:            "Error when recording the progress of the webhook fails",
+			Operation:       fixPolledOperation(),
+			TransactionerFn: transactionerThatFailsAfterWebhookCall,
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Poll", ctx, mock.Anything).Return(fixPollResponse("IN_PROGRESS"), nil).Once()
+				return client
+			},
+			ProgressUpdaterFn: func() *automock.OperationProgressUpdater {
+				progressUpdater := &automock.OperationProgressUpdater{}
+				progressUpdater.On("UpdateOperationProgress", txtest.CtxWithDBMatcher(), progressRequest(locationURL, 1)).Return(testError).Once()
+				return progressUpdater
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name: "Requeue without polling when retry interval has not passed",
+			Operation: func() *postgres.ScheduledOperation {
+				op := fixPolledOperation()
+				lastPoll := time.Now()
+				op.LastPollTimestamp = &lastPoll
+				return op
+			}(),
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), requeueMatcher(time.Duration(retryInterval)*time.Second)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:      "Success when webhook has succeeded",
+			Operation: fixPolledOperation(),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Poll", ctx, mock.MatchedBy(func(request *webhookclient.PollRequest) bool {
+					return request.PollURL == locationURL && request.CorrelationID == correlationID
+				})).Return(fixPollResponse("SUCCEEDED"), nil).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "")).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Failure when webhook has failed",
+			Operation: fixPolledOperation(),
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return asyncWebhook, nil
+			},
+			WebhookClientFn: func() *webhookclientmock.Client {
+				client := &webhookclientmock.Client{}
+				client.On("Poll", ctx, mock.Anything).Return(fixPollResponse("FAILED"), nil).Once()
+				return client
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, webhookclient.ErrFailedWebhookStatus.Error())).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, webhookclient.ErrFailedWebhookStatus.Error())).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:      "Failure when webhook is not found",
+			Operation: fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return nil, apperrors.NewNotFoundError(resource.Webhook, webhookID)
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, "missing webhook with ID: "+webhookID)).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "missing webhook with ID: "+webhookID)).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name: "Failure when request object is invalid",
+			Operation: func() *postgres.ScheduledOperation {
+				op := fixScheduledOperation(operation.OperationTypeCreate)
+				op.RequestObject = "{"
+				return op
+			}(),
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseFailed, "unexpected end of JSON input")).Return(nil).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "unexpected end of JSON input")).Return(nil).Once()
+				return updater
+			},
+		},
+		{
+			Name:            "Error when fetching the webhook fails",
+			Operation:       fixScheduledOperation(operation.OperationTypeCreate, webhookID),
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			WebhookFetcherFn: func(ctx context.Context, id string) (*graphql.Webhook, error) {
+				return nil, testError
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name:            "Error when updating the resource fails",
+			Operation:       fixScheduledOperation(operation.OperationTypeCreate),
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "")).Return(testError).Once()
+				return updater
+			},
+			ExpectedErrMessage: testErr,
+		},
+		{
+			Name:            "Error when storing the operation fails",
+			Operation:       fixScheduledOperation(operation.OperationTypeCreate),
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Update", txtest.CtxWithDBMatcher(), phaseMatcher(postgres.PhaseSuccess, "")).Return(testError).Once()
+				return repo
+			},
+			UpdaterFn: func() *automock.OperationUpdater {
+				updater := &automock.OperationUpdater{}
+				updater.On("UpdateOperation", txtest.CtxWithDBMatcher(), operationRequest(operation.OperationTypeCreate, "")).Return(nil).Once()
+				return updater
+			},
+			ExpectedErrMessage: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			transactionerFn := txGen.ThatSucceeds
+			if testCase.TransactionerFn != nil {
+				transactionerFn = testCase.TransactionerFn
+			}
+			persistTx, transact := transactionerFn()
+			repo := &automock.OperationRepository{}
+			if testCase.RepoFn != nil {
+				repo = testCase.RepoFn()
+			}
+			webhookClient := &webhookclientmock.Client{}
+			if testCase.WebhookClientFn != nil {
+				webhookClient = testCase.WebhookClientFn()
+			}
+			updater := &automock.OperationUpdater{}
+			if testCase.UpdaterFn != nil {
+				updater = testCase.UpdaterFn()
+			}
+			progressUpdater := &automock.OperationProgressUpdater{}
+			if testCase.ProgressUpdaterFn != nil {
+				progressUpdater = testCase.ProgressUpdaterFn()
+			}
+			resourceFetcherFuncs := map[resource.Type]postgres.ResourceFetcherFunc{}
+			if testCase.ResourceFetcherFn != nil {
+				resourceFetcherFuncs[resource.Application] = testCase.ResourceFetcherFn
+			}
+
+			reconciler := postgres.NewReconciler(fixConfig(), transact, repo, webhookClient, testCase.WebhookFetcherFn, resourceFetcherFuncs, updater, progressUpdater)
+
+			// WHEN
+			err := reconciler.Reconcile(ctx, testCase.Operation)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, repo, webhookClient, updater, progressUpdater)
+		})
+	}
+}
+
+// transactionerThatFailsAfterWebhookCall commits the transaction in which the operation is prepared and rolls back the one in which the result of its webhook is stored
+func transactionerThatFailsAfterWebhookCall() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Once()
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Twice()
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Twice()
+
+	return persistTx, transact
+}
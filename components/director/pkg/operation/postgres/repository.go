@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	selectedColumns = "id, resource_type, resource_id, operation_type, operation_category, correlation_id, webhook_ids, request_object, phase, error, webhook_poll_url, last_poll_timestamp, retries_count, initialized_at, next_attempt_at"
+
+	// upsertQuery reuses the row of the resource unless an operation is still in progress for it, in which case no row is returned
+	upsertQuery = `INSERT INTO public.scheduled_operations (` + selectedColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		ON CONFLICT (resource_type, resource_id) DO UPDATE SET operation_type = EXCLUDED.operation_type, operation_category = EXCLUDED.operation_category,
+			correlation_id = EXCLUDED.correlation_id, webhook_ids = EXCLUDED.webhook_ids, request_object = EXCLUDED.request_object, phase = EXCLUDED.phase,
+			error = EXCLUDED.error, webhook_poll_url = EXCLUDED.webhook_poll_url, last_poll_timestamp = EXCLUDED.last_poll_timestamp,
+			retries_count = EXCLUDED.retries_count, initialized_at = EXCLUDED.initialized_at, next_attempt_at = EXCLUDED.next_attempt_at, locked_until = NULL
+		WHERE scheduled_operations.phase <> 'IN_PROGRESS'
+		RETURNING id`
+
+	// claimNextDueQuery skips the operations claimed by the workers of all director replicas, so that each operation is processed by a single worker at a time.
+	// The row lock is held only until the claim is committed, while the claim itself expires at locked_until in case the worker never releases it.
+	claimNextDueQuery = `UPDATE public.scheduled_operations SET locked_until = $2
+		WHERE id = (SELECT id FROM public.scheduled_operations
+			WHERE phase = 'IN_PROGRESS' AND next_attempt_at <= $1 AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY next_attempt_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED)
+		RETURNING ` + selectedColumns + `, locked_until`
+
+	// updateQuery and postponeQuery are fenced by the claim of the worker, so that a worker whose claim has expired
+	// does not overwrite the state stored by the worker which has claimed the operation after it
+	updateQuery = `UPDATE public.scheduled_operations SET phase = $1, error = $2, webhook_poll_url = $3, last_poll_timestamp = $4, retries_count = $5, next_attempt_at = $6, locked_until = NULL WHERE id = $7 AND locked_until = $8`
+
+	postponeQuery = `UPDATE public.scheduled_operations SET next_attempt_at = $1, locked_until = NULL WHERE id = $2 AND phase = 'IN_PROGRESS' AND locked_until = $3`
+
+	deleteQuery = `DELETE FROM public.scheduled_operations WHERE id = $1`
+)
+
+type entity struct {
+	ID                string         `db:"id"`
+	ResourceType      string         `db:"resource_type"`
+	ResourceID        string         `db:"resource_id"`
+	OperationType     string         `db:"operation_type"`
+	OperationCategory sql.NullString `db:"operation_category"`
+	CorrelationID     sql.NullString `db:"correlation_id"`
+	WebhookIDs        sql.NullString `db:"webhook_ids"`
+	RequestObject     sql.NullString `db:"request_object"`
+	Phase             string         `db:"phase"`
+	Error             sql.NullString `db:"error"`
+	WebhookPollURL    sql.NullString `db:"webhook_poll_url"`
+	LastPollTimestamp sql.NullTime   `db:"last_poll_timestamp"`
+	RetriesCount      int            `db:"retries_count"`
+	InitializedAt     time.Time      `db:"initialized_at"`
+	NextAttemptAt     time.Time      `db:"next_attempt_at"`
+	LockedUntil       sql.NullTime   `db:"locked_until"`
+}
+
+type repository struct{}
+
+// NewRepository creates a new repository of scheduled operations
+func NewRepository() *repository {
+	return &repository{}
+}
+
+// Upsert stores the operation unless another operation is in progress for the same resource.
+// It returns the ID of the stored operation or an empty string if another operation is in progress.
+func (r *repository) Upsert(ctx context.Context, op *ScheduledOperation) (string, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "while loading persistence from context")
+	}
+
+	webhookIDs, err := json.Marshal(op.WebhookIDs)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling webhook IDs")
+	}
+
+	var id string
+	log.C(ctx).Debugf("Executing DB query: %s", upsertQuery)
+	err = persist.GetContext(ctx, &id, upsertQuery, op.ID, op.ResourceType, op.ResourceID, op.OperationType,
+		repo.NewValidNullableString(op.OperationCategory), repo.NewValidNullableString(op.CorrelationID), string(webhookIDs),
+		repo.NewValidNullableString(op.RequestObject), op.Phase, repo.NewNullableString(op.Error), repo.NewValidNullableString(op.WebhookPollURL),
+		newNullableTime(op.LastPollTimestamp), op.RetriesCount, op.InitializedAt, op.NextAttemptAt)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Upsert, "while upserting operation for %s with ID %s", op.ResourceType, op.ResourceID)
+	}
+
+	return id, nil
+}
+
+// ClaimNextDue claims the in progress operation with the earliest next attempt before the given time until the given lockedUntil time.
+// The claim is released when the operation is updated or postponed. It returns nil if there is no such operation which is not already claimed.
+func (r *repository) ClaimNextDue(ctx context.Context, now, lockedUntil time.Time) (*ScheduledOperation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading persistence from context")
+	}
+
+	var ent entity
+	log.C(ctx).Debugf("Executing DB query: %s", claimNextDueQuery)
+	if err = persist.GetContext(ctx, &ent, claimNextDueQuery, now, lockedUntil); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while claiming next due operation")
+	}
+
+	return fromEntity(ent)
+}
+
+// Update stores the processing state of the claimed operation and releases its claim.
+// It returns ErrClaimLost if the operation is no longer claimed with the claim of the operation.
+func (r *repository) Update(ctx context.Context, op *ScheduledOperation) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", updateQuery)
+	res, err := persist.ExecContext(ctx, updateQuery, op.Phase, repo.NewNullableString(op.Error), repo.NewValidNullableString(op.WebhookPollURL),
+		newNullableTime(op.LastPollTimestamp), op.RetriesCount, op.NextAttemptAt, op.ID, op.LockedUntil)
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while updating operation with ID %s", op.ID)
+	}
+
+	return checkClaimed(res, op.ID)
+}
+
+// Postpone moves the next attempt of the claimed in progress operation to the given time and releases its claim.
+// It returns ErrClaimLost if the operation is no longer claimed with the claim of the operation.
+func (r *repository) Postpone(ctx context.Context, op *ScheduledOperation, nextAttemptAt time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", postponeQuery)
+	res, err := persist.ExecContext(ctx, postponeQuery, nextAttemptAt, op.ID, op.LockedUntil)
+	if err != nil {
+		return persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while postponing operation with ID %s", op.ID)
+	}
+
+	return checkClaimed(res, op.ID)
+}
+
+// Delete deletes the operation
+func (r *repository) Delete(ctx context.Context, id string) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while loading persistence from context")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", deleteQuery)
+	_, err = persist.ExecContext(ctx, deleteQuery, id)
+	return persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Delete, "while deleting operation with ID %s", id)
+}
+
+func fromEntity(ent entity) (*ScheduledOperation, error) {
+	var webhookIDs []string
+	if ent.WebhookIDs.Valid {
+		if err := json.Unmarshal([]byte(ent.WebhookIDs.String), &webhookIDs); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling webhook IDs of operation with ID %s", ent.ID)
+		}
+	}
+
+	var lastPollTimestamp *time.Time
+	if ent.LastPollTimestamp.Valid {
+		lastPollTimestamp = &ent.LastPollTimestamp.Time
+	}
+
+	return &ScheduledOperation{
+		ID:                ent.ID,
+		ResourceType:      resource.Type(ent.ResourceType),
+		ResourceID:        ent.ResourceID,
+		OperationType:     operation.OperationType(ent.OperationType),
+		OperationCategory: ent.OperationCategory.String,
+		CorrelationID:     ent.CorrelationID.String,
+		WebhookIDs:        webhookIDs,
+		RequestObject:     ent.RequestObject.String,
+		Phase:             Phase(ent.Phase),
+		Error:             repo.StringPtrFromNullableString(ent.Error),
+		WebhookPollURL:    ent.WebhookPollURL.String,
+		LastPollTimestamp: lastPollTimestamp,
+		RetriesCount:      ent.RetriesCount,
+		InitializedAt:     ent.InitializedAt,
+		NextAttemptAt:     ent.NextAttemptAt,
+		LockedUntil:       ent.LockedUntil.Time,
+	}, nil
+}
+
+func checkClaimed(res sql.Result, id string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "while checking affected rows for operation with ID %s", id)
+	}
+
+	if affected == 0 {
+		return errors.Wrapf(ErrClaimLost, "operation with ID %s", id)
+	}
+
+	return nil
+}
+
+func newNullableTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -0,0 +1,310 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var columns = []string{"id", "resource_type", "resource_id", "operation_type", "operation_category", "correlation_id", "webhook_ids", "request_object", "phase", "error", "webhook_poll_url", "last_poll_timestamp", "retries_count", "initialized_at", "next_attempt_at"}
+
+func TestRepository_Upsert(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO public.scheduled_operations (id, resource_type, resource_id, operation_type, operation_category, correlation_id, webhook_ids, request_object, phase, error, webhook_poll_url, last_poll_timestamp, retries_count, initialized_at, next_attempt_at)`) +
+		`.*` + regexp.QuoteMeta(`ON CONFLICT (resource_type, resource_id) DO UPDATE`) + `.*` + regexp.QuoteMeta(`WHERE scheduled_operations.phase <> 'IN_PROGRESS'`)
+	op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+	args := []driver.Value{operationID, op.ResourceType, resourceID, op.OperationType, sql.NullString{}, sql.NullString{String: correlationID, Valid: true},
+		`["` + webhookID + `"]`, sql.NullString{String: requestObject, Valid: true}, op.Phase, sql.NullString{}, sql.NullString{}, sql.NullTime{}, 0, op.InitializedAt, op.NextAttemptAt}
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(operationID))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		id, err := postgres.NewRepository().Upsert(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, operationID, id)
+	})
+
+	t.Run("Returns empty ID when another operation is in progress", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		id, err := postgres.NewRepository().Upsert(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, id)
+	})
+
+	t.Run("Error when upsert fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(args...).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := postgres.NewRepository().Upsert(ctx, op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+
+	t.Run("Error when persistence is missing in the context", func(t *testing.T) {
+		// WHEN
+		_, err := postgres.NewRepository().Upsert(context.TODO(), op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading persistence from context")
+	})
+}
+
+func TestRepository_ClaimNextDue(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET locked_until = $2`) + `.*` + regexp.QuoteMeta(`FOR UPDATE SKIP LOCKED)`) + `.*` +
+		regexp.QuoteMeta(`RETURNING id, resource_type, resource_id, operation_type, operation_category, correlation_id, webhook_ids, request_object, phase, error, webhook_poll_url, last_poll_timestamp, retries_count, initialized_at, next_attempt_at, locked_until`)
+	now := time.Now()
+	lockedUntil := now.Add(5 * time.Minute)
+	lastPoll := now.Add(-time.Minute)
+	claimColumns := append(columns, "locked_until")
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(claimColumns).AddRow(operationID, "application", resourceID, "Create", nil, correlationID, `["`+webhookID+`"]`, requestObject,
+			"IN_PROGRESS", nil, locationURL, lastPoll, 2, now, now, lockedUntil)
+		dbMock.ExpectQuery(query).WithArgs(now, lockedUntil).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		op, err := postgres.NewRepository().ClaimNextDue(ctx, now, lockedUntil)
+
+		// THEN
+		require.NoError(t, err)
+		expected := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+		expected.InitializedAt, expected.NextAttemptAt = now, now
+		expected.LockedUntil = lockedUntil
+		expected.WebhookPollURL = locationURL
+		expected.LastPollTimestamp = &lastPoll
+		expected.RetriesCount = 2
+		assert.Equal(t, expected, op)
+	})
+
+	t.Run("Returns nil when there is no due operation", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(now, lockedUntil).WillReturnRows(sqlmock.NewRows(claimColumns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		op, err := postgres.NewRepository().ClaimNextDue(ctx, now, lockedUntil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, op)
+	})
+
+	t.Run("Error when claim fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(query).WithArgs(now, lockedUntil).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		_, err := postgres.NewRepository().ClaimNextDue(ctx, now, lockedUntil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET phase = $1, error = $2, webhook_poll_url = $3, last_poll_timestamp = $4, retries_count = $5, next_attempt_at = $6, locked_until = NULL WHERE id = $7 AND locked_until = $8`)
+	op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+	op.Phase = postgres.PhaseFailed
+	errMsg := testErr
+	op.Error = &errMsg
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).
+			WithArgs(postgres.PhaseFailed, sql.NullString{String: testErr, Valid: true}, sql.NullString{}, sql.NullTime{}, 0, op.NextAttemptAt, operationID, op.LockedUntil).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Update(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the claim has been lost", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Update(ctx, op)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, postgres.ErrClaimLost))
+	})
+
+	t.Run("Error when update fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Update(ctx, op)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+}
+
+func TestRepository_Postpone(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET next_attempt_at = $1, locked_until = NULL WHERE id = $2 AND phase = 'IN_PROGRESS' AND locked_until = $3`)
+	nextAttemptAt := time.Now()
+	op := fixScheduledOperation(operation.OperationTypeCreate, webhookID)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(nextAttemptAt, operationID, op.LockedUntil).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Postpone(ctx, op, nextAttemptAt)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the claim has been lost", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(nextAttemptAt, operationID, op.LockedUntil).WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Postpone(ctx, op, nextAttemptAt)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, postgres.ErrClaimLost))
+	})
+
+	t.Run("Error when update fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(nextAttemptAt, operationID, op.LockedUntil).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Postpone(ctx, op, nextAttemptAt)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM public.scheduled_operations WHERE id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(operationID).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Delete(ctx, operationID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when delete fails", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(query).WithArgs(operationID).WillReturnError(errors.New(testErr))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// WHEN
+		err := postgres.NewRepository().Delete(ctx, operationID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error")
+	})
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/pkg/errors"
+)
+
+// OperationRepository stores the scheduled operations and the state of their processing
+//go:generate mockery --name=OperationRepository --output=automock --outpkg=automock --case=underscore --disable-version-string
+type OperationRepository interface {
+	Upsert(ctx context.Context, op *ScheduledOperation) (string, error)
+	ClaimNextDue(ctx context.Context, now, lockedUntil time.Time) (*ScheduledOperation, error)
+	Update(ctx context.Context, op *ScheduledOperation) error
+	Postpone(ctx context.Context, op *ScheduledOperation, nextAttemptAt time.Time) error
+	Delete(ctx context.Context, id string) error
+}
+
+// UIDService generates UUIDs for the scheduled operations
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UIDService interface {
+	Generate() string
+}
+
+// Scheduler is an operation.Scheduler which stores the operations in the database, from where they are processed by the Worker
+type Scheduler struct {
+	repo   OperationRepository
+	uidSvc UIDService
+}
+
+// NewScheduler creates a new Scheduler
+func NewScheduler(repo OperationRepository, uidSvc UIDService) *Scheduler {
+	return &Scheduler{
+		repo:   repo,
+		uidSvc: uidSvc,
+	}
+}
+
+// Schedule stores the operation within the transaction in the context, so that it is processed only if the transaction is committed.
+// It fails if another operation is in progress for the same resource.
+func (s *Scheduler) Schedule(ctx context.Context, op *operation.Operation) (string, error) {
+	now := time.Now()
+	scheduledOp := &ScheduledOperation{
+		ID:                s.uidSvc.Generate(),
+		ResourceType:      op.ResourceType,
+		ResourceID:        op.ResourceID,
+		OperationType:     op.OperationType,
+		OperationCategory: op.OperationCategory,
+		CorrelationID:     op.CorrelationID,
+		WebhookIDs:        op.WebhookIDs,
+		RequestObject:     op.RequestObject,
+		Phase:             PhaseInProgress,
+		InitializedAt:     now,
+		NextAttemptAt:     now,
+	}
+
+	id, err := s.repo.Upsert(ctx, scheduledOp)
+	if err != nil {
+		return "", errors.Wrapf(err, "while scheduling operation for resource with ID %q", op.ResourceID)
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("another operation is in progress for resource with ID %q", op.ResourceID)
+	}
+
+	return id, nil
+}
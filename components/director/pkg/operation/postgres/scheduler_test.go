@@ -0,0 +1,96 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Schedule(t *testing.T) {
+	ctx := context.TODO()
+	op := &operation.Operation{
+		OperationType:     operation.OperationTypeCreate,
+		OperationCategory: "registerApplication",
+		ResourceID:        resourceID,
+		ResourceType:      resource.Application,
+		CorrelationID:     correlationID,
+		WebhookIDs:        []string{webhookID},
+		RequestObject:     requestObject,
+	}
+
+	scheduledOperationMatcher := mock.MatchedBy(func(scheduledOp *postgres.ScheduledOperation) bool {
+		return scheduledOp.ID == operationID && scheduledOp.ResourceID == resourceID && scheduledOp.ResourceType == resource.Application &&
+			scheduledOp.OperationType == operation.OperationTypeCreate && scheduledOp.OperationCategory == "registerApplication" &&
+			scheduledOp.CorrelationID == correlationID && scheduledOp.RequestObject == requestObject &&
+			len(scheduledOp.WebhookIDs) == 1 && scheduledOp.WebhookIDs[0] == webhookID &&
+			scheduledOp.Phase == postgres.PhaseInProgress && scheduledOp.NextAttemptAt.Equal(scheduledOp.InitializedAt)
+	})
+
+	testCases := []struct {
+		Name               string
+		RepoFn             func() *automock.OperationRepository
+		ExpectedID         string
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Upsert", ctx, scheduledOperationMatcher).Return(operationID, nil).Once()
+				return repo
+			},
+			ExpectedID: operationID,
+		},
+		{
+			Name: "Error when another operation is in progress",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Upsert", ctx, scheduledOperationMatcher).Return("", nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "another operation is in progress for resource with ID",
+		},
+		{
+			Name: "Error when storing the operation fails",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Upsert", ctx, scheduledOperationMatcher).Return("", errors.New(testErr)).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepoFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(operationID).Once()
+
+			scheduler := postgres.NewScheduler(repo, uidSvc)
+
+			// WHEN
+			id, err := scheduler.Schedule(ctx, op)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedID, id)
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
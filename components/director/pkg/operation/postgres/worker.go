@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// Reconciler advances the processing of a claimed operation. It is called outside of a database transaction, so that it can
+// execute the webhook of the operation without holding any database connection or row lock
+//go:generate mockery --name=Reconciler --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Reconciler interface {
+	Reconcile(ctx context.Context, op *ScheduledOperation) error
+}
+
+// Worker processes the operations scheduled in the database with a pool of workers. Each operation is claimed
+// while being processed, so that the workers of multiple director replicas can process the operations concurrently.
+type Worker struct {
+	cfg        Config
+	transact   persistence.Transactioner
+	repo       OperationRepository
+	reconciler Reconciler
+}
+
+// NewWorker creates a new Worker
+func NewWorker(cfg Config, transact persistence.Transactioner, repo OperationRepository, reconciler Reconciler) *Worker {
+	return &Worker{
+		cfg:        cfg,
+		transact:   transact,
+		repo:       repo,
+		reconciler: reconciler,
+	}
+}
+
+// Start processes the due operations with the configured number of workers until the context is cancelled
+func (w *Worker) Start(ctx context.Context) {
+	log.C(ctx).Infof("Starting %d operation workers", w.cfg.WorkersCount)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < w.cfg.WorkersCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.run(ctx)
+		}()
+	}
+	wg.Wait()
+
+	log.C(ctx).Info("Operation workers stopped")
+}
+
+func (w *Worker) run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.ProcessDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue processes the due operations one by one until there are no more due operations which are not locked by other workers.
+// The processing stops on error and is resumed on the next poll.
+func (w *Worker) ProcessDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		processed, err := w.processNext(ctx)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while processing operation: %v", err)
+			return
+		}
+
+		if !processed {
+			return
+		}
+	}
+}
+
+// processNext processes the next due operation and returns false if there is no such operation.
+// The operation is claimed in a short transaction, so that no row lock is held while the reconciler executes its webhook.
+func (w *Worker) processNext(ctx context.Context) (bool, error) {
+	op, err := w.claimNext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if op == nil {
+		return false, nil
+	}
+
+	ctx = withOperationLogger(ctx, op)
+	err = w.reconciler.Reconcile(ctx, op)
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, ErrClaimLost) {
+		log.C(ctx).WithError(err).Warn("The claim of the operation has expired while reconciling it. The operation is left to the worker which has claimed it")
+		return true, nil
+	}
+
+	log.C(ctx).WithError(err).Errorf("An error has occurred while reconciling operation: %v", err)
+	if err := w.postpone(ctx, op); err != nil && !errors.Is(err, ErrClaimLost) {
+		return true, err
+	}
+
+	return true, nil
+}
+
+func (w *Worker) claimNext(ctx context.Context) (*ScheduledOperation, error) {
+	tx, err := w.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening database transaction")
+	}
+	defer w.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	now := time.Now()
+	op, err := w.repo.ClaimNextDue(ctx, now, now.Add(w.cfg.LockDuration))
+	if err != nil {
+		return nil, errors.Wrap(err, "while claiming next due operation")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing claimed operation")
+	}
+
+	return op, nil
+}
+
+// postpone requeues the operation and releases its claim, as the reconciler could not store the new state of the operation
+func (w *Worker) postpone(ctx context.Context, op *ScheduledOperation) error {
+	tx, err := w.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening database transaction")
+	}
+	defer w.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := w.repo.Postpone(ctx, op, time.Now().Add(w.cfg.RequeueInterval)); err != nil {
+		return errors.Wrapf(err, "while postponing operation with ID %s", op.ID)
+	}
+
+	return tx.Commit()
+}
+
+func withOperationLogger(ctx context.Context, op *ScheduledOperation) context.Context {
+	logger := log.C(ctx).WithField("operation_id", op.ID).WithField(log.FieldRequestID, op.CorrelationID)
+	return log.ContextWithLogger(ctx, logger)
+}
@@ -0,0 +1,173 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/postgres/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_ProcessDue(t *testing.T) {
+	testError := errors.New(testErr)
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn          func() *automock.OperationRepository
+		ReconcilerFn    func() *automock.Reconciler
+	}{
+		{
+			Name: "Processes the due operations until there are no more",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(3)
+				return []*persistenceautomock.PersistenceTx{persistTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(fixScheduledOperation(operation.OperationTypeCreate), nil).Twice()
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(nil, nil).Once()
+				return repo
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				reconciler := &automock.Reconciler{}
+				reconciler.On("Reconcile", ctxWithoutDBMatcher(), fixScheduledOperationMatcher()).Return(nil).Twice()
+				return reconciler
+			},
+		},
+		{
+			Name: "Postpones the operation in a new transaction when reconciling fails",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				claimTx := &persistenceautomock.PersistenceTx{}
+				claimTx.On("Commit").Return(nil).Once()
+				postponeTx := &persistenceautomock.PersistenceTx{}
+				postponeTx.On("Commit").Return(nil).Once()
+				lastTx := &persistenceautomock.PersistenceTx{}
+				lastTx.On("Commit").Return(nil).Once()
+
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(claimTx, nil).Once()
+				transact.On("Begin").Return(postponeTx, nil).Once()
+				transact.On("Begin").Return(lastTx, nil).Once()
+				transact.On("RollbackUnlessCommitted", mock.Anything, claimTx).Return(false).Once()
+				transact.On("RollbackUnlessCommitted", mock.Anything, postponeTx).Return(false).Once()
+				transact.On("RollbackUnlessCommitted", mock.Anything, lastTx).Return(false).Once()
+				return []*persistenceautomock.PersistenceTx{claimTx, postponeTx, lastTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(fixScheduledOperation(operation.OperationTypeCreate), nil).Once()
+				repo.On("Postpone", txtest.CtxWithDBMatcher(), fixScheduledOperationMatcher(), mock.Anything).Return(nil).Once()
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(nil, nil).Once()
+				return repo
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				reconciler := &automock.Reconciler{}
+				reconciler.On("Reconcile", ctxWithoutDBMatcher(), fixScheduledOperationMatcher()).Return(testError).Once()
+				return reconciler
+			},
+		},
+		{
+			Name: "Does not postpone the operation when its claim has been lost while reconciling",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx, transact := txGen.ThatSucceedsMultipleTimes(2)
+				return []*persistenceautomock.PersistenceTx{persistTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(fixScheduledOperation(operation.OperationTypeCreate), nil).Once()
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(nil, nil).Once()
+				return repo
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				reconciler := &automock.Reconciler{}
+				reconciler.On("Reconcile", ctxWithoutDBMatcher(), fixScheduledOperationMatcher()).Return(postgres.ErrClaimLost).Once()
+				return reconciler
+			},
+		},
+		{
+			Name: "Stops processing when claiming fails",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx, transact := txGen.ThatDoesntExpectCommit()
+				return []*persistenceautomock.PersistenceTx{persistTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(nil, testError).Once()
+				return repo
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				return &automock.Reconciler{}
+			},
+		},
+		{
+			Name: "Stops processing when committing the claim fails",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx, transact := txGen.ThatFailsOnCommit()
+				return []*persistenceautomock.PersistenceTx{persistTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDue", txtest.CtxWithDBMatcher(), mock.Anything, mock.Anything).Return(fixScheduledOperation(operation.OperationTypeCreate), nil).Once()
+				return repo
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				return &automock.Reconciler{}
+			},
+		},
+		{
+			Name: "Stops processing when opening transaction fails",
+			TransactionerFn: func() ([]*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx, transact := txGen.ThatFailsOnBegin()
+				return []*persistenceautomock.PersistenceTx{persistTx}, transact
+			},
+			RepoFn: func() *automock.OperationRepository {
+				return &automock.OperationRepository{}
+			},
+			ReconcilerFn: func() *automock.Reconciler {
+				return &automock.Reconciler{}
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persistTxs, transact := testCase.TransactionerFn()
+			repo := testCase.RepoFn()
+			reconciler := testCase.ReconcilerFn()
+
+			worker := postgres.NewWorker(fixConfig(), transact, repo, reconciler)
+
+			// WHEN
+			worker.ProcessDue(context.TODO())
+
+			// THEN
+			mock.AssertExpectationsForObjects(t, transact, repo, reconciler)
+			for _, persistTx := range persistTxs {
+				persistTx.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func fixScheduledOperationMatcher() interface{} {
+	return mock.MatchedBy(func(op *postgres.ScheduledOperation) bool {
+		return op.ID == operationID
+	})
+}
+
+// ctxWithoutDBMatcher matches the context of the reconciler, which must be called outside of a database transaction
+func ctxWithoutDBMatcher() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		_, err := persistence.FromCtx(ctx)
+		return err != nil
+	})
+}
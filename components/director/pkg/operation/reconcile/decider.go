@@ -0,0 +1,165 @@
+package reconcile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+)
+
+// Outcome denotes how the processing of an operation proceeds
+type Outcome int
+
+const (
+	// OutcomeRequeue denotes an operation which is attempted again after Decision.RequeueAfter
+	OutcomeRequeue Outcome = iota
+	// OutcomeSuccess denotes an operation which has succeeded and whose resource has to be notified about it
+	OutcomeSuccess
+	// OutcomeFailure denotes an operation which has failed with Decision.Err and whose resource has to be notified about it
+	OutcomeFailure
+	// OutcomeComplete denotes an operation which is completed without notifying its resource, as the resource is already ready or no longer exists.
+	// The operation has failed if Decision.Err is set.
+	OutcomeComplete
+	// OutcomeDelete denotes an operation which is deleted, as its resource could not be fetched until the timeout of the operation
+	OutcomeDelete
+)
+
+// Decision is the next step in the processing of an operation
+type Decision struct {
+	Outcome Outcome
+	// RequeueAfter is the delay until the next attempt of a requeued operation
+	RequeueAfter time.Duration
+	// Err is the error the operation fails with, or the cause of the requeue of the operation
+	Err error
+	// PollURL is the URL returned by an asynchronous webhook, which has to be stored before the operation is requeued for polling
+	PollURL string
+	// Polled denotes that the status of an asynchronous webhook has been polled and is still in progress.
+	// The poll has to be recorded, i.e. its timestamp stored and its retries count incremented, before the outcome is applied.
+	Polled bool
+}
+
+// Config contains the timeouts and the intervals of the processing of the operations
+type Config struct {
+	WebhookTimeout  time.Duration
+	TimeoutFactor   int
+	RequeueInterval time.Duration
+}
+
+// Decider decides how the processing of an operation proceeds based on the result of its last step.
+// It is shared by the operations-controller and the database scheduler of the director, so that both process the operations the same way.
+type Decider struct {
+	cfg Config
+}
+
+// NewDecider creates a new Decider
+func NewDecider(cfg Config) *Decider {
+	return &Decider{cfg: cfg}
+}
+
+// Timeout returns the timeout of the operations executing the webhook
+func (d *Decider) Timeout(webhook *graphql.Webhook) time.Duration {
+	if webhook == nil || webhook.Timeout == nil {
+		return d.cfg.WebhookTimeout
+	}
+
+	return time.Duration(*webhook.Timeout) * time.Second
+}
+
+// TimeoutReached checks if the operation initialized at the given time has been in progress for longer than the timeout of its webhook
+func (d *Decider) TimeoutReached(initializedAt time.Time, webhook *graphql.Webhook, now time.Time) bool {
+	return now.After(initializedAt.Add(d.Timeout(webhook)))
+}
+
+// NextPollAfter returns the time remaining until the status of the webhook should be polled again, given the time it was last polled at
+func (d *Decider) NextPollAfter(lastPollTimestamp *time.Time, webhook *graphql.Webhook, now time.Time) time.Duration {
+	if lastPollTimestamp == nil || webhook.RetryInterval == nil {
+		return 0
+	}
+
+	nextPollTime := lastPollTimestamp.Add(time.Duration(*webhook.RetryInterval) * time.Second)
+	return nextPollTime.Sub(now)
+}
+
+// FetchResourceFailed decides how the operation proceeds when its resource, named resourceName in the errors, could not be fetched
+func (d *Decider) FetchResourceFailed(opType operation.OperationType, resourceName string, notFound bool, err error, initializedAt, now time.Time) Decision {
+	if now.After(initializedAt.Add(time.Duration(d.cfg.TimeoutFactor) * d.cfg.WebhookTimeout)) {
+		return Decision{Outcome: OutcomeDelete}
+	}
+
+	if notFound {
+		switch opType {
+		case operation.OperationTypeDelete:
+			return Decision{Outcome: OutcomeComplete}
+		case operation.OperationTypeUpdate:
+			return Decision{Outcome: OutcomeComplete, Err: fmt.Errorf("%s not found in director", resourceName)}
+		}
+	}
+
+	// requeue in case of async create (the resource is not created yet), or a temporary failure
+	return Decision{Outcome: OutcomeRequeue, RequeueAfter: d.cfg.RequeueInterval, Err: err}
+}
+
+// WebhookExecuted decides how the operation proceeds after the initial request of its webhook has been executed
+func (d *Decider) WebhookExecuted(opType operation.OperationType, webhook *graphql.Webhook, response *webhookdir.Response, err error, initializedAt, now time.Time) Decision {
+	if webhookclient.IsStatusGoneError(err) && opType == operation.OperationTypeDelete {
+		return Decision{Outcome: OutcomeSuccess}
+	}
+	if err != nil {
+		return d.requeueUnlessTimeoutOrFatalError(webhook, err, initializedAt, now)
+	}
+
+	mode := graphql.WebhookModeSync
+	if webhook.Mode != nil {
+		mode = *webhook.Mode
+	}
+
+	switch mode {
+	case graphql.WebhookModeAsync:
+		return Decision{Outcome: OutcomeRequeue, PollURL: *response.Location}
+	case graphql.WebhookModeSync:
+		return Decision{Outcome: OutcomeSuccess}
+	default:
+		return Decision{Outcome: OutcomeFailure, Err: fmt.Errorf("unsupported webhook mode: %s", mode)}
+	}
+}
+
+// WebhookPolled decides how the operation proceeds after the status of its asynchronous webhook has been polled
+func (d *Decider) WebhookPolled(webhook *graphql.Webhook, response *webhookdir.ResponseStatus, err error, initializedAt, now time.Time) Decision {
+	if err != nil {
+		return d.requeueUnlessTimeoutOrFatalError(webhook, err, initializedAt, now)
+	}
+
+	switch *response.Status {
+	case *response.InProgressStatusIdentifier:
+		decision := d.requeueUnlessTimeoutOrFatalError(webhook, webhookclient.ErrWebhookPollTimeExpired, initializedAt, now)
+		decision.Polled = true
+		return decision
+	case *response.SuccessStatusIdentifier:
+		return Decision{Outcome: OutcomeSuccess}
+	case *response.FailedStatusIdentifier:
+		return Decision{Outcome: OutcomeFailure, Err: webhookclient.ErrFailedWebhookStatus}
+	default:
+		return Decision{Outcome: OutcomeFailure, Err: fmt.Errorf("unexpected poll status response: %s", *response.Status)}
+	}
+}
+
+func (d *Decider) requeueUnlessTimeoutOrFatalError(webhook *graphql.Webhook, webhookErr error, initializedAt, now time.Time) Decision {
+	isFatalErr := webhookclient.IsFatalError(webhookErr)
+	if !d.TimeoutReached(initializedAt, webhook, now) && !isFatalErr {
+		requeueAfter := d.cfg.RequeueInterval
+		if webhook.RetryInterval != nil {
+			requeueAfter = time.Duration(*webhook.RetryInterval) * time.Second
+		}
+
+		return Decision{Outcome: OutcomeRequeue, RequeueAfter: requeueAfter}
+	}
+
+	if !isFatalErr {
+		webhookErr = fmt.Errorf("%s: %s", webhookclient.ErrWebhookTimeoutReached, webhookErr)
+	}
+
+	return Decision{Outcome: OutcomeFailure, Err: webhookErr}
+}
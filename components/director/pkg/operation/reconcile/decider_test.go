@@ -0,0 +1,330 @@
+package reconcile_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/reconcile"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testErr         = "test error"
+	locationURL     = "https://test-domain.com/operation"
+	requeueInterval = time.Minute
+	webhookTimeout  = time.Hour
+)
+
+func TestDecider_Timeout(t *testing.T) {
+	decider := fixDecider()
+
+	assert.Equal(t, webhookTimeout, decider.Timeout(nil))
+	assert.Equal(t, webhookTimeout, decider.Timeout(&graphql.Webhook{}))
+	assert.Equal(t, 30*time.Second, decider.Timeout(&graphql.Webhook{Timeout: intPtr(30)}))
+}
+
+func TestDecider_TimeoutReached(t *testing.T) {
+	decider := fixDecider()
+	initializedAt := time.Now()
+	webhook := &graphql.Webhook{Timeout: intPtr(60)}
+
+	assert.False(t, decider.TimeoutReached(initializedAt, webhook, initializedAt.Add(time.Second)))
+	assert.True(t, decider.TimeoutReached(initializedAt, webhook, initializedAt.Add(2*time.Minute)))
+	assert.False(t, decider.TimeoutReached(initializedAt, nil, initializedAt.Add(2*time.Minute)))
+}
+
+func TestDecider_NextPollAfter(t *testing.T) {
+	decider := fixDecider()
+	now := time.Now()
+	webhook := &graphql.Webhook{RetryInterval: intPtr(60)}
+
+	t.Run("Returns zero when the webhook has not been polled", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), decider.NextPollAfter(nil, webhook, now))
+	})
+
+	t.Run("Returns zero when there is no retry interval", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), decider.NextPollAfter(&now, &graphql.Webhook{}, now))
+	})
+
+	t.Run("Returns the time remaining until the retry interval passes", func(t *testing.T) {
+		lastPoll := now.Add(-10 * time.Second)
+
+		assert.Equal(t, 50*time.Second, decider.NextPollAfter(&lastPoll, webhook, now))
+	})
+}
+
+func TestDecider_FetchResourceFailed(t *testing.T) {
+	testError := errors.New(testErr)
+	now := time.Now()
+
+	testCases := []struct {
+		Name             string
+		OperationType    operation.OperationType
+		NotFound         bool
+		InitializedAt    time.Time
+		ExpectedDecision reconcile.Decision
+	}{
+		{
+			Name:             "Deletes the operation when the timeout multiplied by the timeout factor is reached",
+			OperationType:    operation.OperationTypeDelete,
+			NotFound:         true,
+			InitializedAt:    now.Add(-3 * webhookTimeout),
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeDelete},
+		},
+		{
+			Name:             "Completes delete operation when the resource is not found",
+			OperationType:    operation.OperationTypeDelete,
+			NotFound:         true,
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeComplete},
+		},
+		{
+			Name:             "Completes update operation with error when the resource is not found",
+			OperationType:    operation.OperationTypeUpdate,
+			NotFound:         true,
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeComplete, Err: errors.New("Application not found in director")},
+		},
+		{
+			Name:             "Requeues create operation when the resource is not found",
+			OperationType:    operation.OperationTypeCreate,
+			NotFound:         true,
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: requeueInterval, Err: testError},
+		},
+		{
+			Name:             "Requeues the operation when fetching the resource fails",
+			OperationType:    operation.OperationTypeDelete,
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: requeueInterval, Err: testError},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			decision := fixDecider().FetchResourceFailed(testCase.OperationType, "Application", testCase.NotFound, testError, testCase.InitializedAt, now)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedDecision, decision)
+		})
+	}
+}
+
+func TestDecider_WebhookExecuted(t *testing.T) {
+	now := time.Now()
+	asyncMode := graphql.WebhookModeAsync
+	syncMode := graphql.WebhookModeSync
+	unknownMode := graphql.WebhookMode("UNKNOWN")
+
+	testCases := []struct {
+		Name             string
+		OperationType    operation.OperationType
+		Webhook          *graphql.Webhook
+		Response         *webhookdir.Response
+		Err              error
+		InitializedAt    time.Time
+		ExpectedDecision reconcile.Decision
+	}{
+		{
+			Name:             "Requeues the operation for polling with the location of async webhook",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode},
+			Response:         &webhookdir.Response{Location: str.Ptr(locationURL)},
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, PollURL: locationURL},
+		},
+		{
+			Name:             "Succeeds for sync webhook",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &syncMode},
+			Response:         &webhookdir.Response{},
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeSuccess},
+		},
+		{
+			Name:             "Succeeds for webhook without mode",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{},
+			Response:         &webhookdir.Response{},
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeSuccess},
+		},
+		{
+			Name:             "Fails for unsupported webhook mode",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &unknownMode},
+			Response:         &webhookdir.Response{},
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: errors.New("unsupported webhook mode: UNKNOWN")},
+		},
+		{
+			Name:             "Succeeds for delete operation when the webhook returns its gone status",
+			OperationType:    operation.OperationTypeDelete,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode},
+			Response:         &webhookdir.Response{},
+			Err:              webhookclient.NewStatusGoneError(404),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeSuccess},
+		},
+		{
+			Name:             "Requeues non-delete operation after the default interval when the webhook returns its gone status",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode},
+			Response:         &webhookdir.Response{},
+			Err:              webhookclient.NewStatusGoneError(404),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: requeueInterval},
+		},
+		{
+			Name:             "Requeues the operation after the retry interval of the webhook when the webhook fails",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode, RetryInterval: intPtr(30)},
+			Err:              errors.New(testErr),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: 30 * time.Second},
+		},
+		{
+			Name:             "Fails when the webhook fails with fatal error",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode},
+			Err:              webhookclient.NewFatalError(testErr),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: webhookclient.NewFatalError(testErr)},
+		},
+		{
+			Name:             "Fails when the webhook fails after its timeout",
+			OperationType:    operation.OperationTypeCreate,
+			Webhook:          &graphql.Webhook{Mode: &asyncMode, Timeout: intPtr(60)},
+			Err:              errors.New(testErr),
+			InitializedAt:    now.Add(-2 * time.Minute),
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: errors.New("webhook timeout reached: " + testErr)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			decision := fixDecider().WebhookExecuted(testCase.OperationType, testCase.Webhook, testCase.Response, testCase.Err, testCase.InitializedAt, now)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedDecision.Outcome, decision.Outcome)
+			assert.Equal(t, testCase.ExpectedDecision.RequeueAfter, decision.RequeueAfter)
+			assert.Equal(t, testCase.ExpectedDecision.PollURL, decision.PollURL)
+			assert.False(t, decision.Polled)
+			assertDecisionError(t, testCase.ExpectedDecision.Err, decision.Err)
+		})
+	}
+}
+
+func TestDecider_WebhookPolled(t *testing.T) {
+	now := time.Now()
+	webhook := &graphql.Webhook{RetryInterval: intPtr(30), Timeout: intPtr(60)}
+
+	testCases := []struct {
+		Name             string
+		Status           string
+		Err              error
+		InitializedAt    time.Time
+		ExpectedDecision reconcile.Decision
+	}{
+		{
+			Name:             "Records the poll and requeues the operation when the webhook is in progress",
+			Status:           "IN_PROGRESS",
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: 30 * time.Second, Polled: true},
+		},
+		{
+			Name:             "Records the poll and fails when the webhook is in progress after its timeout",
+			Status:           "IN_PROGRESS",
+			InitializedAt:    now.Add(-2 * time.Minute),
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: errors.New("webhook timeout reached: polling time has expired"), Polled: true},
+		},
+		{
+			Name:             "Succeeds when the webhook has succeeded",
+			Status:           "SUCCEEDED",
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeSuccess},
+		},
+		{
+			Name:             "Fails when the webhook has failed",
+			Status:           "FAILED",
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: webhookclient.ErrFailedWebhookStatus},
+		},
+		{
+			Name:             "Fails when the status is unknown",
+			Status:           "UNKNOWN",
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: errors.New("unexpected poll status response: UNKNOWN")},
+		},
+		{
+			Name:             "Requeues the operation when polling fails",
+			Err:              errors.New(testErr),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeRequeue, RequeueAfter: 30 * time.Second},
+		},
+		{
+			Name:             "Fails when polling fails with fatal error",
+			Err:              webhookclient.NewFatalError(testErr),
+			InitializedAt:    now,
+			ExpectedDecision: reconcile.Decision{Outcome: reconcile.OutcomeFailure, Err: webhookclient.NewFatalError(testErr)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			var response *webhookdir.ResponseStatus
+			if testCase.Err == nil {
+				response = fixResponseStatus(testCase.Status)
+			}
+
+			// WHEN
+			decision := fixDecider().WebhookPolled(webhook, response, testCase.Err, testCase.InitializedAt, now)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedDecision.Outcome, decision.Outcome)
+			assert.Equal(t, testCase.ExpectedDecision.RequeueAfter, decision.RequeueAfter)
+			assert.Equal(t, testCase.ExpectedDecision.Polled, decision.Polled)
+			assert.Empty(t, decision.PollURL)
+			assertDecisionError(t, testCase.ExpectedDecision.Err, decision.Err)
+		})
+	}
+}
+
+func assertDecisionError(t *testing.T, expected, actual error) {
+	if expected == nil {
+		assert.NoError(t, actual)
+		return
+	}
+
+	assert.EqualError(t, actual, expected.Error())
+}
+
+func fixDecider() *reconcile.Decider {
+	return reconcile.NewDecider(reconcile.Config{
+		WebhookTimeout:  webhookTimeout,
+		TimeoutFactor:   2,
+		RequeueInterval: requeueInterval,
+	})
+}
+
+func fixResponseStatus(status string) *webhookdir.ResponseStatus {
+	return &webhookdir.ResponseStatus{
+		Status:                     str.Ptr(status),
+		SuccessStatusIdentifier:    str.Ptr("SUCCEEDED"),
+		InProgressStatusIdentifier: str.Ptr("IN_PROGRESS"),
+		FailedStatusIdentifier:     str.Ptr("FAILED"),
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
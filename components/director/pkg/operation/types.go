@@ -2,6 +2,8 @@ package operation
 
 import (
 	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 // Scheduler is responsible for scheduling any provided Operation entity for later processing
@@ -9,3 +11,11 @@ import (
 type Scheduler interface {
 	Schedule(ctx context.Context, op *Operation) (string, error)
 }
+
+// HistoryRecorder records the scheduled operations along with their progress and results in the operations history
+//go:generate mockery --name=HistoryRecorder --output=automock --outpkg=automock --case=underscore --disable-version-string
+type HistoryRecorder interface {
+	RecordScheduled(ctx context.Context, op *Operation) error
+	RecordProgress(ctx context.Context, resourceType resource.Type, resourceID string, webhooks []WebhookStatus) error
+	RecordResult(ctx context.Context, resourceType resource.Type, resourceID string, errorMsg string, webhooks []WebhookStatus) error
+}
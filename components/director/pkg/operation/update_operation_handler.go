@@ -40,11 +40,12 @@ import (
 
 // OperationRequest is the expected request body when updating certain operation status
 type OperationRequest struct {
-	OperationType     OperationType `json:"operation_type,omitempty"`
-	ResourceType      resource.Type `json:"resource_type"`
-	ResourceID        string        `json:"resource_id"`
-	Error             string        `json:"error"`
-	OperationCategory string        `json:"operation_category,omitempty"`
+	OperationType     OperationType   `json:"operation_type,omitempty"`
+	ResourceType      resource.Type   `json:"resource_type"`
+	ResourceID        string          `json:"resource_id"`
+	Error             string          `json:"error"`
+	OperationCategory string          `json:"operation_category,omitempty"`
+	Webhooks          []WebhookStatus `json:"webhooks,omitempty"`
 }
 
 // ResourceUpdaterFunc defines a function which updates a particular resource ready and error status
@@ -57,6 +58,7 @@ type updateOperationHandler struct {
 	transact             persistence.Transactioner
 	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
 	resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc
+	historyRecorder      HistoryRecorder
 }
 
 type errResponse struct {
@@ -72,11 +74,12 @@ type operationError struct {
 const OperationCategoryUnpairApplication = "unpairApplication"
 
 // NewUpdateOperationHandler creates a new handler struct to update resource by operation
-func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc) *updateOperationHandler {
+func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc, historyRecorder HistoryRecorder) *updateOperationHandler {
 	return &updateOperationHandler{
 		transact:             transact,
 		resourceUpdaterFuncs: resourceUpdaterFuncs,
 		resourceDeleterFuncs: resourceDeleterFuncs,
+		historyRecorder:      historyRecorder,
 	}
 }
 
@@ -95,10 +98,7 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 		return
 	}
 
-	if err := validation.ValidateStruct(operation,
-		validation.Field(&operation.ResourceID, is.UUID),
-		validation.Field(&operation.OperationType, validation.Required, validation.In(OperationTypeCreate, OperationTypeUpdate, OperationTypeDelete)),
-		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application))); err != nil {
+	if err := validateOperationRequest(operation); err != nil {
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid operation properties: %s", err), http.StatusBadRequest)
 		return
 	}
@@ -113,12 +113,28 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
+	if err := h.UpdateOperation(ctx, operation); err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// UpdateOperation marks the resource of the finished operation as ready with the operation error, or deletes it if it was successfully deleted.
+// The resource is updated and the result of the operation is recorded in the operations history within the transaction stored in the context.
+func (h *updateOperationHandler) UpdateOperation(ctx context.Context, operation *OperationRequest) error {
 	resourceUpdaterFunc := h.resourceUpdaterFuncs[operation.ResourceType]
 	opError, err := stringifiedJSONError(operation.Error)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while marshalling operation error: %s", err.Error())
-		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to marshal error"), http.StatusInternalServerError)
-		return
+		return apperrors.NewInternalError("Unable to marshal error")
 	}
 
 	appConditionStatus := determineApplicationFinalStatus(operation, opError)
@@ -128,33 +144,36 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 	case OperationTypeUpdate:
 		if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
 			log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
-			apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-			return
+			return apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID)
 		}
 	case OperationTypeDelete:
 		resourceDeleterFunc := h.resourceDeleterFuncs[operation.ResourceType]
 		if operation.Error != "" {
 			if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
 				log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
-				apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-				return
+				return apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID)
 			}
 		} else {
 			if err := resourceDeleterFunc(ctx, operation.ResourceID); err != nil {
 				log.C(ctx).WithError(err).Errorf("While deleting resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
-				apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to delete resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-				return
+				return apperrors.NewInternalError("Unable to delete resource %s with id %s", operation.ResourceType, operation.ResourceID)
 			}
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %s", err.Error())
-		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
-		return
+	if err := h.historyRecorder.RecordResult(ctx, operation.ResourceType, operation.ResourceID, operation.Error, operation.Webhooks); err != nil {
+		log.C(ctx).WithError(err).Errorf("While recording result of operation for resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
+		return apperrors.NewInternalError("Unable to record result of operation for resource %s with id %s", operation.ResourceType, operation.ResourceID)
 	}
 
-	writer.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func validateOperationRequest(operation *OperationRequest) error {
+	return validation.ValidateStruct(operation,
+		validation.Field(&operation.ResourceID, is.UUID),
+		validation.Field(&operation.OperationType, validation.Required, validation.In(OperationTypeCreate, OperationTypeUpdate, OperationTypeDelete)),
+		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application, resource.FormationAssignment)))
 }
 
 func operationRequestFromBody(ctx context.Context, request *http.Request) (*OperationRequest, *errResponse) {
@@ -27,9 +27,11 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
@@ -40,7 +42,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Method not allowed")
@@ -53,7 +55,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "/", reader)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Unable to decode body to JSON")
@@ -64,7 +66,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		writer := httptest.NewRecorder()
 		req := fixPostRequestWithBody(t, context.Background(), `{}`)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Invalid operation properties")
@@ -83,7 +85,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -102,7 +104,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, fixHistoryRecorder(nil))
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -121,7 +123,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return errors.New("failed to update")
 			},
-		}, nil)
+		}, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -141,7 +143,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string) error {
 				return errors.New("failed to delete")
 			},
-		})
+		}, nil)
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -215,6 +217,10 @@ func TestUpdateOperationHandler(t *testing.T) {
 				expectedErrorMsg := testCase.ExpectedError
 				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "error": "%s"}`, resourceID, resource.Application, testCase.OperationType, expectedErrorMsg))
 
+				historyRecorder := &automock.HistoryRecorder{}
+				historyRecorder.On("RecordResult", txtest.CtxWithDBMatcher(), resource.Application, resourceID, expectedErrorMsg, []operation.WebhookStatus(nil)).Return(nil).Once()
+				defer historyRecorder.AssertExpectations(t)
+
 				updateCalled := 0
 				deleteCalled := 0
 				handler := operation.NewUpdateOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceUpdaterFunc{
@@ -236,7 +242,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 						deleteCalled++
 						return nil
 					},
-				})
+				}, historyRecorder)
 
 				handler.ServeHTTP(writer, req)
 				require.Equal(t, testCase.UpdateCalled, updateCalled)
@@ -245,6 +251,69 @@ func TestUpdateOperationHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("when formation assignment operation has finished it should call the formation assignment updater", func(t *testing.T) {
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.FormationAssignment, operation.OperationTypeCreate))
+
+		updateCalled := 0
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
+				t.Fatal("application updater should not be called")
+				return nil
+			},
+			resource.FormationAssignment: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
+				require.Equal(t, resourceID, id)
+				require.True(t, ready)
+				require.Nil(t, errorMsg)
+				updateCalled++
+				return nil
+			},
+		}, map[resource.Type]operation.ResourceDeleterFunc{}, fixHistoryRecorder(nil))
+
+		handler.ServeHTTP(writer, req)
+		require.Equal(t, 1, updateCalled)
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+
+	t.Run("when recording the operation result fails it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "webhooks": [{"webhook_id": "%s", "state": "%s", "retries_count": 2}]}`,
+			resourceID, resource.Application, operation.OperationTypeCreate, webhookID1, operation.OperationStatusSucceeded))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		historyRecorder.On("RecordResult", txtest.CtxWithDBMatcher(), resource.Application, resourceID, "", []operation.WebhookStatus{{
+			WebhookID:    webhookID1,
+			State:        operation.OperationStatusSucceeded,
+			RetriesCount: 2,
+		}}).Return(mockedError()).Once()
+		defer historyRecorder.AssertExpectations(t)
+
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
+				return nil
+			},
+		}, nil, historyRecorder)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to record result of operation for resource application with id")
+	})
+}
+
+func fixHistoryRecorder(err error) *automock.HistoryRecorder {
+	historyRecorder := &automock.HistoryRecorder{}
+	historyRecorder.On("RecordResult", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(err)
+	historyRecorder.On("RecordProgress", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(err)
+	return historyRecorder
 }
 
 func fixPostRequestWithBody(t *testing.T, ctx context.Context, body string) *http.Request {
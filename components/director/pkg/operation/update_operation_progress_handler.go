@@ -0,0 +1,94 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+type updateOperationProgressHandler struct {
+	transact        persistence.Transactioner
+	historyRecorder HistoryRecorder
+}
+
+// NewUpdateOperationProgressHandler creates a new handler which records the progress of the operations in progress in the operations history
+func NewUpdateOperationProgressHandler(transact persistence.Transactioner, historyRecorder HistoryRecorder) *updateOperationProgressHandler {
+	return &updateOperationProgressHandler{
+		transact:        transact,
+		historyRecorder: historyRecorder,
+	}
+}
+
+// ServeHTTP handles the Operations API requests reporting the progress of an operation which is not yet finished
+func (h *updateOperationProgressHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	if request.Method != http.MethodPut {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	operation, errResp := operationRequestFromBody(ctx, request)
+	if errResp != nil {
+		apperrors.WriteAppError(ctx, writer, errResp.err, errResp.statusCode)
+		return
+	}
+
+	if err := validateOperationRequest(operation); err != nil {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid operation properties: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := h.UpdateOperationProgress(ctx, operation); err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusOK)
+}
+
+// UpdateOperationProgress records the progress of the webhooks of the operation in the operations history within the transaction stored in the context.
+// The resource of the operation is not changed, as the operation is not yet finished.
+func (h *updateOperationProgressHandler) UpdateOperationProgress(ctx context.Context, operation *OperationRequest) error {
+	if err := h.historyRecorder.RecordProgress(ctx, operation.ResourceType, operation.ResourceID, operation.Webhooks); err != nil {
+		log.C(ctx).WithError(err).Errorf("While recording progress of operation for resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
+		return apperrors.NewInternalError("Unable to record progress of operation for resource %s with id %s", operation.ResourceType, operation.ResourceID)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateOperationProgressHandler(t *testing.T) {
+	body := fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "webhooks": [{"webhook_id": "%s", "state": "%s", "retries_count": 1, "webhook_poll_url": "https://example.com/poll"}]}`,
+		resourceID, resource.Application, operation.OperationTypeCreate, webhookID1, operation.OperationStatusInProgress)
+	expectedWebhooks := []operation.WebhookStatus{{
+		WebhookID:      webhookID1,
+		State:          operation.OperationStatusInProgress,
+		RetriesCount:   1,
+		WebhookPollURL: "https://example.com/poll",
+	}}
+
+	t.Run("when request method is not PUT it should return method not allowed", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+		operation.NewUpdateOperationProgressHandler(nil, nil).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, writer.Code)
+	})
+
+	t.Run("when required input body properties are missing it should return bad request", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), `{}`)
+
+		operation.NewUpdateOperationProgressHandler(nil, nil).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		require.Contains(t, writer.Body.String(), "Invalid operation properties")
+	})
+
+	t.Run("when transaction fails to begin it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), body)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnBegin()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		operation.NewUpdateOperationProgressHandler(mockedTransactioner, nil).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to establish connection with database")
+	})
+
+	t.Run("when recording the progress fails it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), body)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		historyRecorder.On("RecordProgress", txtest.CtxWithDBMatcher(), resource.Application, resourceID, expectedWebhooks).Return(mockedError()).Once()
+		defer historyRecorder.AssertExpectations(t)
+
+		operation.NewUpdateOperationProgressHandler(mockedTransactioner, historyRecorder).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to record progress of operation for resource application with id")
+	})
+
+	t.Run("when transaction fails to commit it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), body)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		operation.NewUpdateOperationProgressHandler(mockedTransactioner, fixHistoryRecorder(nil)).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to finalize database operation")
+	})
+
+	t.Run("when progress is recorded it should return OK", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), body)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		historyRecorder.On("RecordProgress", txtest.CtxWithDBMatcher(), resource.Application, resourceID, expectedWebhooks).Return(nil).Once()
+		defer historyRecorder.AssertExpectations(t)
+
+		operation.NewUpdateOperationProgressHandler(mockedTransactioner, historyRecorder).ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+}
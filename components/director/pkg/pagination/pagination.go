@@ -2,6 +2,7 @@ package pagination
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,7 +12,20 @@ import (
 	"github.com/pkg/errors"
 )
 
-const surprise = "DpKtJ4j9jDq"
+const (
+	surprise           = "DpKtJ4j9jDq"
+	keysetCursorPrefix = "keyset:"
+)
+
+// SortOrder is the direction in which the items of a page are sorted
+type SortOrder string
+
+const (
+	// AscendingOrder sorts the items from the smallest to the largest sort key
+	AscendingOrder SortOrder = "ASC"
+	// DescendingOrder sorts the items from the largest to the smallest sort key
+	DescendingOrder SortOrder = "DESC"
+)
 
 // Page missing godoc
 type Page struct {
@@ -70,3 +84,117 @@ func ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset int, orderedColumn
 
 	return fmt.Sprintf(`ORDER BY %s LIMIT %d OFFSET %d`, orderedColumn, pageSize, offset), nil
 }
+
+// Cursor is a decoded page cursor.
+//
+// Keyset cursors hold the values of the ordered columns of the last item of the previous page.
+// Offset cursors hold the number of items to skip. They are no longer issued, but are still accepted so that clients can migrate.
+type Cursor struct {
+	Offset int
+	Keyset []interface{}
+}
+
+// DecodeCursor decodes both keyset and offset cursors. An empty cursor points to the first page.
+func DecodeCursor(cursor string) (*Cursor, error) {
+	if cursor == "" {
+		return &Cursor{}, nil
+	}
+
+	decodedValue, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor is not correct")
+	}
+
+	if !strings.HasPrefix(string(decodedValue), keysetCursorPrefix) {
+		offset, err := DecodeOffsetCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		return &Cursor{Offset: offset}, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(strings.TrimPrefix(string(decodedValue), keysetCursorPrefix)))
+	decoder.UseNumber()
+
+	var keyset []interface{}
+	if err := decoder.Decode(&keyset); err != nil || len(keyset) == 0 {
+		return nil, apperrors.NewInvalidDataError("cursor is not correct")
+	}
+
+	return &Cursor{Keyset: keyset}, nil
+}
+
+// EncodeKeysetCursor encodes the values of the ordered columns of the last item of a page as a cursor pointing to the next page
+func EncodeKeysetCursor(keyset ...interface{}) (string, error) {
+	value, err := json.Marshal(keyset)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling keyset cursor")
+	}
+
+	return base64.StdEncoding.EncodeToString(append([]byte(keysetCursorPrefix), value...)), nil
+}
+
+// ConvertKeysetToSQL converts a keyset to a condition selecting the items after it in the given sort order, and returns the condition together with its arguments.
+// The keyset values are passed as ? placeholders. The last ordered column must be unique and not NULL (usually the ID) for no item to be skipped.
+// If there are several ordered columns, the first one may be NULL. The NULL values are sorted last, see ConvertOrderedColumnsLimitAndOffsetToSQL.
+func ConvertKeysetToSQL(keyset []interface{}, orderedColumns []string, order SortOrder) (string, []interface{}, error) {
+	if len(keyset) != len(orderedColumns) {
+		return "", nil, apperrors.NewInvalidDataError("cursor is not correct")
+	}
+
+	operator := ">"
+	if order == DescendingOrder {
+		operator = "<"
+	}
+
+	if len(orderedColumns) == 1 {
+		return fmt.Sprintf("(%s) %s (?)", orderedColumns[0], operator), keyset, nil
+	}
+
+	// The row comparison is never true for NULL values, so they are matched separately
+	if keyset[0] == nil {
+		tiebreakers := orderedColumns[1:]
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(tiebreakers)), ", ")
+		return fmt.Sprintf("(%s IS NULL AND (%s) %s (%s))", orderedColumns[0], strings.Join(tiebreakers, ", "), operator, placeholders), keyset[1:], nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keyset)), ", ")
+	return fmt.Sprintf("((%s) %s (%s) OR %s IS NULL)", strings.Join(orderedColumns, ", "), operator, placeholders, orderedColumns[0]), keyset, nil
+}
+
+// ConvertOrderedColumnsLimitAndOffsetToSQL converts the ordered columns, the sort order, the page size and the offset to SQL.
+// If there are several ordered columns, the NULL values of the first one are sorted last regardless of the sort order, as ConvertKeysetToSQL expects.
+// One item more than the page size is selected, so that it can be determined whether there is a next page. The offset is omitted if it is 0.
+func ConvertOrderedColumnsLimitAndOffsetToSQL(orderedColumns []string, order SortOrder, pageSize, offset int) (string, error) {
+	if len(orderedColumns) == 0 {
+		return "", apperrors.NewInvalidDataError("to use pagination you must provide column to order by")
+	}
+
+	if order != AscendingOrder && order != DescendingOrder {
+		return "", apperrors.NewInvalidDataError("sort order %q is not supported", order)
+	}
+
+	if pageSize < 1 {
+		return "", apperrors.NewInvalidDataError("page size cannot be smaller than 1")
+	}
+
+	if offset < 0 {
+		return "", apperrors.NewInvalidDataError("offset cannot be smaller than 0")
+	}
+
+	orderByParts := make([]string, 0, len(orderedColumns))
+	for i, column := range orderedColumns {
+		if i == 0 && len(orderedColumns) > 1 {
+			orderByParts = append(orderByParts, fmt.Sprintf("%s %s NULLS LAST", column, order))
+			continue
+		}
+		orderByParts = append(orderByParts, fmt.Sprintf("%s %s", column, order))
+	}
+
+	sql := fmt.Sprintf(`ORDER BY %s LIMIT %d`, strings.Join(orderByParts, ", "), pageSize+1)
+	if offset > 0 {
+		sql = fmt.Sprintf("%s OFFSET %d", sql, offset)
+	}
+
+	return sql, nil
+}
@@ -2,6 +2,7 @@ package pagination
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"strconv"
 	"testing"
 
@@ -178,6 +179,164 @@ func TestDecodeAndEncodeCursorTogether(t *testing.T) {
 	})
 }
 
+func TestDecodeCursor(t *testing.T) {
+	keysetCursor, err := EncodeKeysetCursor("name", "id")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name           string
+		InputCursor    string
+		ExpectedCursor *Cursor
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Success for keyset cursor",
+			InputCursor:    keysetCursor,
+			ExpectedCursor: &Cursor{Keyset: []interface{}{"name", "id"}},
+		},
+		{
+			Name:           "Success for offset cursor",
+			InputCursor:    EncodeNextOffsetCursor(50, 50),
+			ExpectedCursor: &Cursor{Offset: 100},
+		},
+		{
+			Name:           "Success when cursor is empty",
+			InputCursor:    "",
+			ExpectedCursor: &Cursor{},
+		},
+		{
+			Name:        "Return error when keyset is not valid JSON",
+			InputCursor: base64.StdEncoding.EncodeToString([]byte(keysetCursorPrefix + "{")),
+			ExpectedErr: "cursor is not correct",
+		},
+		{
+			Name:        "Return error when keyset is empty",
+			InputCursor: base64.StdEncoding.EncodeToString([]byte(keysetCursorPrefix + "[]")),
+			ExpectedErr: "cursor is not correct",
+		},
+		{
+			Name:        "Return error when input is not valid BASE64 string",
+			InputCursor: "Zm9vLWJh-1cg==",
+			ExpectedErr: "cursor is not correct",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			cursor, err := DecodeCursor(testCase.InputCursor)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedCursor, cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeAndEncodeKeysetCursorTogether(t *testing.T) {
+	// WHEN
+	cursor, err := EncodeKeysetCursor("name", 42, true)
+	require.NoError(t, err)
+	decodedCursor, err := DecodeCursor(cursor)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"name", json.Number("42"), true}, decodedCursor.Keyset)
+}
+
+func TestConvertKeysetToSQL(t *testing.T) {
+	t.Run("Success for ascending order", func(t *testing.T) {
+		// WHEN
+		sql, args, err := ConvertKeysetToSQL([]interface{}{"name", "id"}, []string{"name", "id"}, AscendingOrder)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, `((name, id) > (?, ?) OR name IS NULL)`, sql)
+		assert.Equal(t, []interface{}{"name", "id"}, args)
+	})
+
+	t.Run("Success for descending order", func(t *testing.T) {
+		// WHEN
+		sql, args, err := ConvertKeysetToSQL([]interface{}{"id"}, []string{"id"}, DescendingOrder)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, `(id) < (?)`, sql)
+		assert.Equal(t, []interface{}{"id"}, args)
+	})
+
+	t.Run("Success when the sort key of the last item is NULL", func(t *testing.T) {
+		// WHEN
+		sql, args, err := ConvertKeysetToSQL([]interface{}{nil, "id"}, []string{"created_at", "id"}, DescendingOrder)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, `(created_at IS NULL AND (id) < (?))`, sql)
+		assert.Equal(t, []interface{}{"id"}, args)
+	})
+
+	t.Run("Return error when keyset does not match ordered columns", func(t *testing.T) {
+		// WHEN
+		_, _, err := ConvertKeysetToSQL([]interface{}{"id"}, []string{"name", "id"}, AscendingOrder)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cursor is not correct`)
+	})
+}
+
+func TestConvertOrderedColumnsLimitAndOffsetToSQL(t *testing.T) {
+	t.Run("Success converting ordered columns and limit to SQL", func(t *testing.T) {
+		// WHEN
+		sql, err := ConvertOrderedColumnsLimitAndOffsetToSQL([]string{"name", "id"}, DescendingOrder, 5, 0)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, `ORDER BY name DESC NULLS LAST, id DESC LIMIT 6`, sql)
+	})
+
+	t.Run("Success converting ordered columns, limit and offset to SQL", func(t *testing.T) {
+		// WHEN
+		sql, err := ConvertOrderedColumnsLimitAndOffsetToSQL([]string{"id"}, AscendingOrder, 5, 10)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, `ORDER BY id ASC LIMIT 6 OFFSET 10`, sql)
+	})
+
+	t.Run("Return error when there are no columns to order by", func(t *testing.T) {
+		// WHEN
+		_, err := ConvertOrderedColumnsLimitAndOffsetToSQL(nil, AscendingOrder, 5, 0)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `to use pagination you must provide column to order by`)
+	})
+
+	t.Run("Return error when sort order is not supported", func(t *testing.T) {
+		// WHEN
+		_, err := ConvertOrderedColumnsLimitAndOffsetToSQL([]string{"id"}, "RANDOM", 5, 0)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `sort order "RANDOM" is not supported`)
+	})
+
+	t.Run("Return error when page size is smaller than 1", func(t *testing.T) {
+		// WHEN
+		_, err := ConvertOrderedColumnsLimitAndOffsetToSQL([]string{"id"}, AscendingOrder, 0, 0)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `page size cannot be smaller than 1`)
+	})
+}
+
 func convertIntToBase64String(number int) string {
 	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(number)))
 }
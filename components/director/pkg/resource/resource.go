@@ -68,6 +68,8 @@ const (
 	Formations Type = "formations"
 	// FormationTemplate type represents formation template resource.
 	FormationTemplate Type = "formationTemplate"
+	// FormationAssignment type represents formation assignment resource.
+	FormationAssignment Type = "formationAssignment"
 	// Webhook type represents generic webhook resource. This resource does not assume the referenced resource type of the Webhook.
 	Webhook Type = "webhook"
 	// AppWebhook type represents application webhook resource.
@@ -80,6 +82,16 @@ const (
 	TenantAccess Type = "tenantAccess"
 	// Schema type represents schema resource.
 	Schema Type = "schemaMigration"
+	// ScheduledOperation type represents operation scheduled for processing in the database.
+	ScheduledOperation Type = "scheduledOperation"
+	// OperationHistory type represents an entry of the history of the asynchronous operations.
+	OperationHistory Type = "operationHistory"
+	// ORDAggregationSchedule type represents the ORD aggregation state of an application.
+	ORDAggregationSchedule Type = "ordAggregationSchedule"
+	// ORDAggregationReport type represents the report of the latest ORD aggregation of an application.
+	ORDAggregationReport Type = "ordAggregationReport"
+	// SystemFetcherWatermark type represents the state of the synchronization of the systems of a tenant.
+	SystemFetcherWatermark Type = "systemFetcherWatermark"
 )
 
 var tenantAccessTable = map[Type]string{
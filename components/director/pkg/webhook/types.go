@@ -46,6 +46,32 @@ type RequestObject struct {
 	Headers     map[string]string
 }
 
+// TemplateInput is implemented by the request objects which are used to render the request templates of a Webhook
+type TemplateInput interface {
+	ParseURLTemplate(tmpl *string) (*URL, error)
+	ParseInputTemplate(tmpl *string) ([]byte, error)
+	ParseHeadersTemplate(tmpl *string) (http.Header, error)
+}
+
+// FormationParticipant contains the details of a formation participant which might be needed in the templates of a formation assignment notification Webhook
+type FormationParticipant struct {
+	ID   string
+	Name string
+	Type string
+}
+
+// FormationAssignmentRequestObject struct contains parts of request that might be needed for later processing of a formation assignment notification Webhook request.
+// Source is the participant which was assigned to or unassigned from the formation and Target is the participant which owns the Webhook.
+type FormationAssignmentRequestObject struct {
+	Operation     string
+	FormationID   string
+	FormationName string
+	Source        FormationParticipant
+	Target        FormationParticipant
+	TenantID      string
+	Headers       map[string]string
+}
+
 // ResponseObject struct contains parts of response that might be needed for later processing of Webhook response
 type ResponseObject struct {
 	Body    map[string]string
@@ -162,6 +188,24 @@ func (rd *RequestObject) ParseHeadersTemplate(tmpl *string) (http.Header, error)
 	return headers, parseTemplate(tmpl, *rd, &headers)
 }
 
+// ParseURLTemplate renders the URL template of a formation assignment notification Webhook
+func (rd *FormationAssignmentRequestObject) ParseURLTemplate(tmpl *string) (*URL, error) {
+	var url URL
+	return &url, parseTemplate(tmpl, *rd, &url)
+}
+
+// ParseInputTemplate renders the input template of a formation assignment notification Webhook
+func (rd *FormationAssignmentRequestObject) ParseInputTemplate(tmpl *string) ([]byte, error) {
+	res := json.RawMessage{}
+	return res, parseTemplate(tmpl, *rd, &res)
+}
+
+// ParseHeadersTemplate renders the headers template of a formation assignment notification Webhook
+func (rd *FormationAssignmentRequestObject) ParseHeadersTemplate(tmpl *string) (http.Header, error) {
+	var headers http.Header
+	return headers, parseTemplate(tmpl, *rd, &headers)
+}
+
 // ParseOutputTemplate missing godoc
 func (rd *ResponseObject) ParseOutputTemplate(tmpl *string) (*Response, error) {
 	var resp Response
@@ -0,0 +1,232 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	// ClientCertificateLabel marks the secrets which are allowed to be referenced as client certificates by webhooks
+	ClientCertificateLabel = "compass.kyma-project.io/webhook-client-certificate"
+	// ClientCertificateOwnerAnnotation binds the client certificate of a secret to the application or runtime with the given ID.
+	// Only the webhooks of that owner are allowed to use the certificate.
+	ClientCertificateOwnerAnnotation = "compass.kyma-project.io/webhook-client-certificate-owner"
+)
+
+// CertificatesConfig configures the loading of the client certificates referenced by webhooks
+type CertificatesConfig struct {
+	Namespace         string        `envconfig:"optional,APP_WEBHOOK_CLIENT_CERTIFICATES_NAMESPACE"`
+	CertKey           string        `envconfig:"optional,default=tls.crt,APP_WEBHOOK_CLIENT_CERTIFICATES_CERT_KEY"`
+	KeyKey            string        `envconfig:"optional,default=tls.key,APP_WEBHOOK_CLIENT_CERTIFICATES_KEY_KEY"`
+	ReconnectInterval time.Duration `envconfig:"optional,default=5s,APP_WEBHOOK_CLIENT_CERTIFICATES_RECONNECT_INTERVAL"`
+}
+
+// SecretLister lists and watches the secrets of a single namespace
+//go:generate mockery --name=SecretLister --output=automock --outpkg=automock --case=underscore --disable-version-string
+type SecretLister interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*corev1.SecretList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+type certificateClient struct {
+	ownerID string
+	cert    *tls.Certificate
+	client  *http.Client
+}
+
+type clientProvider struct {
+	secrets   SecretLister
+	cfg       CertificatesConfig
+	transport *http.Transport
+	timeout   time.Duration
+
+	mutex   sync.RWMutex
+	clients map[string]*certificateClient
+}
+
+// NewClientProvider constructs a ClientProvider which keeps the client certificates of the labeled secrets parsed in memory.
+// The mTLS clients are based on the given transport and are reused until their secrets are deleted. The cache is filled by Run.
+func NewClientProvider(secrets SecretLister, cfg CertificatesConfig, transport *http.Transport, timeout time.Duration) *clientProvider {
+	return &clientProvider{
+		secrets:   secrets,
+		cfg:       cfg,
+		transport: transport,
+		timeout:   timeout,
+		clients:   make(map[string]*certificateClient),
+	}
+}
+
+// ClientFor returns the mTLS client for the given client certificate reference, if the secret is bound to the given webhook owner
+func (p *clientProvider) ClientFor(_ context.Context, clientCertificateRef, ownerID string) (*http.Client, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	cc, ok := p.clients[clientCertificateRef]
+	if !ok {
+		return nil, errors.Errorf("client certificate secret %q labeled with %s=true not found", clientCertificateRef, ClientCertificateLabel)
+	}
+
+	if ownerID == "" || cc.ownerID != ownerID {
+		return nil, NewFatalError(fmt.Sprintf("client certificate secret %q is not bound to the webhook owner %q with annotation %s", clientCertificateRef, ownerID, ClientCertificateOwnerAnnotation))
+	}
+
+	return cc.client, nil
+}
+
+// Run keeps the cached client certificates in sync with the labeled secrets until the context is cancelled.
+// The secrets are listed again whenever their watch ends, so that no deletion is missed.
+func (p *clientProvider) Run(ctx context.Context) {
+	for {
+		if err := p.sync(ctx); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to sync webhook client certificates. Will retry after %s: %v", p.cfg.ReconnectInterval, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping webhook client certificates watcher...")
+			return
+		case <-time.After(p.cfg.ReconnectInterval):
+		}
+	}
+}
+
+func (p *clientProvider) sync(ctx context.Context) error {
+	opts := metav1.ListOptions{LabelSelector: ClientCertificateLabel + "=true"}
+	secrets, err := p.secrets.List(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "while listing client certificate secrets")
+	}
+	p.replace(ctx, secrets.Items)
+
+	opts.ResourceVersion = secrets.ResourceVersion
+	opts.Watch = true
+	watcher, err := p.secrets.Watch(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "while watching client certificate secrets")
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+
+			if ev.Type == watch.Error {
+				return errors.New("error event received while watching client certificate secrets")
+			}
+
+			secret, ok := ev.Object.(*corev1.Secret)
+			if !ok {
+				log.C(ctx).Error("Unexpected error: object is not secret")
+				continue
+			}
+
+			switch ev.Type {
+			case watch.Added, watch.Modified:
+				p.store(ctx, secret)
+			case watch.Deleted:
+				p.remove(secret.Name)
+			}
+		}
+	}
+}
+
+func (p *clientProvider) replace(ctx context.Context, secrets []corev1.Secret) {
+	names := make(map[string]bool, len(secrets))
+	for i := range secrets {
+		names[secrets[i].Name] = true
+		p.store(ctx, &secrets[i])
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for name := range p.clients {
+		if !names[name] {
+			delete(p.clients, name)
+		}
+	}
+}
+
+func (p *clientProvider) store(ctx context.Context, secret *corev1.Secret) {
+	cert, err := tls.X509KeyPair(secret.Data[p.cfg.CertKey], secret.Data[p.cfg.KeyKey])
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to parse client certificate from secret %q: %v", secret.Name, err)
+		p.remove(secret.Name)
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	cc, ok := p.clients[secret.Name]
+	if !ok {
+		cc = &certificateClient{client: p.newClient(secret.Name)}
+		p.clients[secret.Name] = cc
+	}
+	cc.ownerID = secret.Annotations[ClientCertificateOwnerAnnotation]
+	cc.cert = &cert
+}
+
+func (p *clientProvider) remove(name string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.clients, name)
+}
+
+func (p *clientProvider) certificate(name string) (*tls.Certificate, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	cc, ok := p.clients[name]
+	if !ok {
+		return nil, errors.Errorf("client certificate secret %q was removed", name)
+	}
+	return cc.cert, nil
+}
+
+// newClient returns a client which always presents the current certificate of the given secret, so that it can be reused after certificate rotations
+func (p *clientProvider) newClient(name string) *http.Client {
+	transport := p.transport.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return p.certificate(name)
+	}
+
+	return &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(transport),
+		Timeout:   p.timeout,
+	}
+}
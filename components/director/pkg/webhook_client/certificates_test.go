@@ -0,0 +1,291 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	certificatesResourceVersion = "42"
+	otherOwnerID                = "otherOwnerID"
+)
+
+func TestClientProvider_ClientFor(t *testing.T) {
+	cfg := fixCertificatesConfig()
+	validSecret := fixClientCertificateSecret(t, cfg, applicationID)
+
+	invalidSecret := validSecret.DeepCopy()
+	invalidSecret.Data[cfg.KeyKey] = []byte("invalid")
+
+	testCases := []struct {
+		Name               string
+		Secrets            []corev1.Secret
+		OwnerID            string
+		ExpectedErrMessage string
+		ExpectedFatalError bool
+	}{
+		{
+			Name:    "Success for a secret bound to the webhook owner",
+			Secrets: []corev1.Secret{*validSecret},
+			OwnerID: applicationID,
+		},
+		{
+			Name:               "Fatal error when the secret is bound to another owner",
+			Secrets:            []corev1.Secret{*validSecret},
+			OwnerID:            otherOwnerID,
+			ExpectedErrMessage: "is not bound to the webhook owner",
+			ExpectedFatalError: true,
+		},
+		{
+			Name:               "Fatal error when the webhook owner is unknown",
+			Secrets:            []corev1.Secret{*validSecret},
+			ExpectedErrMessage: "is not bound to the webhook owner",
+			ExpectedFatalError: true,
+		},
+		{
+			Name:               "Error when the secret is not found",
+			OwnerID:            applicationID,
+			ExpectedErrMessage: "not found",
+		},
+		{
+			Name:               "Error when the secret does not contain a valid certificate",
+			Secrets:            []corev1.Secret{*invalidSecret},
+			OwnerID:            applicationID,
+			ExpectedErrMessage: "not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			watcher := watch.NewFake()
+			watchStarted := make(chan struct{})
+			secrets := &automock.SecretLister{}
+			secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(&corev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: certificatesResourceVersion}, Items: testCase.Secrets}, nil).Once()
+			secrets.On("Watch", mock.Anything, fixCertificatesWatchOptions()).Return(watcher, nil).Run(closeOnCall(watchStarted)).Once()
+			defer secrets.AssertExpectations(t)
+
+			provider := webhookclient.NewClientProvider(secrets, cfg, &http.Transport{}, time.Second)
+			go provider.Run(ctx)
+			<-watchStarted
+
+			// WHEN
+			client, err := provider.ClientFor(ctx, clientCertificateRef, testCase.OwnerID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Equal(t, testCase.ExpectedFatalError, webhookclient.IsFatalError(err))
+				require.Nil(t, client)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, client)
+
+				cachedClient, err := provider.ClientFor(ctx, clientCertificateRef, testCase.OwnerID)
+				require.NoError(t, err)
+				require.Same(t, client, cachedClient)
+			}
+		})
+	}
+}
+
+func TestClientProvider_Run(t *testing.T) {
+	cfg := fixCertificatesConfig()
+	validSecret := fixClientCertificateSecret(t, cfg, applicationID)
+
+	t.Run("Applies the changes of the watched secrets", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watcher := watch.NewFake()
+		secrets := &automock.SecretLister{}
+		secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(&corev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: certificatesResourceVersion}}, nil).Once()
+		secrets.On("Watch", mock.Anything, fixCertificatesWatchOptions()).Return(watcher, nil).Once()
+		defer secrets.AssertExpectations(t)
+
+		provider := webhookclient.NewClientProvider(secrets, cfg, &http.Transport{}, time.Second)
+
+		// WHEN
+		go provider.Run(ctx)
+
+		// THEN
+		watcher.Add(validSecret)
+		require.Eventually(t, func() bool {
+			_, err := provider.ClientFor(ctx, clientCertificateRef, applicationID)
+			return err == nil
+		}, time.Second, 10*time.Millisecond)
+		client, err := provider.ClientFor(ctx, clientCertificateRef, applicationID)
+		require.NoError(t, err)
+
+		rebound := fixClientCertificateSecret(t, cfg, otherOwnerID)
+		watcher.Modify(rebound)
+		require.Eventually(t, func() bool {
+			rotatedClient, err := provider.ClientFor(ctx, clientCertificateRef, otherOwnerID)
+			return err == nil && rotatedClient == client
+		}, time.Second, 10*time.Millisecond)
+
+		watcher.Delete(rebound)
+		require.Eventually(t, func() bool {
+			_, err := provider.ClientFor(ctx, clientCertificateRef, otherOwnerID)
+			return err != nil && !webhookclient.IsFatalError(err)
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("Lists the secrets again when the watch ends", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cfg := cfg
+		cfg.ReconnectInterval = 10 * time.Millisecond
+
+		firstWatcher, secondWatcher := watch.NewFake(), watch.NewFake()
+		watchStarted, rewatchStarted := make(chan struct{}), make(chan struct{})
+		secrets := &automock.SecretLister{}
+		secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(&corev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: certificatesResourceVersion}, Items: []corev1.Secret{*validSecret}}, nil).Once()
+		secrets.On("Watch", mock.Anything, fixCertificatesWatchOptions()).Return(firstWatcher, nil).Run(closeOnCall(watchStarted)).Once()
+		secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(&corev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: certificatesResourceVersion}}, nil).Once()
+		secrets.On("Watch", mock.Anything, fixCertificatesWatchOptions()).Return(secondWatcher, nil).Run(closeOnCall(rewatchStarted)).Once()
+		defer secrets.AssertExpectations(t)
+
+		provider := webhookclient.NewClientProvider(secrets, cfg, &http.Transport{}, time.Second)
+		go provider.Run(ctx)
+		<-watchStarted
+		_, err := provider.ClientFor(ctx, clientCertificateRef, applicationID)
+		require.NoError(t, err)
+
+		// WHEN
+		firstWatcher.Stop()
+
+		// THEN
+		<-rewatchStarted
+		_, err = provider.ClientFor(ctx, clientCertificateRef, applicationID)
+		require.Error(t, err)
+	})
+
+	t.Run("Retries when listing the secrets fails", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		cfg := cfg
+		cfg.ReconnectInterval = 10 * time.Millisecond
+
+		watcher := watch.NewFake()
+		watchStarted := make(chan struct{})
+		secrets := &automock.SecretLister{}
+		secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(nil, errors.New(mockedError)).Once()
+		secrets.On("List", mock.Anything, fixCertificatesListOptions()).Return(&corev1.SecretList{ListMeta: metav1.ListMeta{ResourceVersion: certificatesResourceVersion}, Items: []corev1.Secret{*validSecret}}, nil).Once()
+		secrets.On("Watch", mock.Anything, fixCertificatesWatchOptions()).Return(watcher, nil).Run(closeOnCall(watchStarted)).Once()
+		defer secrets.AssertExpectations(t)
+
+		provider := webhookclient.NewClientProvider(secrets, cfg, &http.Transport{}, time.Second)
+
+		// WHEN
+		go provider.Run(ctx)
+
+		// THEN
+		<-watchStarted
+		_, err := provider.ClientFor(ctx, clientCertificateRef, applicationID)
+		require.NoError(t, err)
+	})
+}
+
+// closeOnCall signals through the given channel that the provider has listed the secrets and started watching them
+func closeOnCall(ch chan struct{}) func(mock.Arguments) {
+	return func(mock.Arguments) {
+		close(ch)
+	}
+}
+
+func fixCertificatesConfig() webhookclient.CertificatesConfig {
+	return webhookclient.CertificatesConfig{
+		Namespace:         "compass-system",
+		CertKey:           "tls.crt",
+		KeyKey:            "tls.key",
+		ReconnectInterval: time.Hour,
+	}
+}
+
+func fixCertificatesListOptions() metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: webhookclient.ClientCertificateLabel + "=true"}
+}
+
+func fixCertificatesWatchOptions() metav1.ListOptions {
+	return metav1.ListOptions{LabelSelector: webhookclient.ClientCertificateLabel + "=true", ResourceVersion: certificatesResourceVersion, Watch: true}
+}
+
+func fixClientCertificateSecret(t *testing.T, cfg webhookclient.CertificatesConfig, ownerID string) *corev1.Secret {
+	certPEM, keyPEM := fixClientCertificate(t)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        clientCertificateRef,
+			Labels:      map[string]string{webhookclient.ClientCertificateLabel: "true"},
+			Annotations: map[string]string{webhookclient.ClientCertificateOwnerAnnotation: ownerID},
+		},
+		Data: map[string][]byte{
+			cfg.CertKey: certPEM,
+			cfg.KeyKey:  keyPEM,
+		},
+	}
+}
+
+func fixClientCertificate(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: clientCertificateRef},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
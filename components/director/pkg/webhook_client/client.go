@@ -0,0 +1,377 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/pkg/errors"
+)
+
+const emptyBody = `{}`
+
+// Request represents a webhook request to be executed
+type Request struct {
+	Webhook       graphql.Webhook
+	Object        webhookdir.TemplateInput
+	CorrelationID string
+}
+
+// PollRequest represents a webhook poll request to be executed
+type PollRequest struct {
+	*Request
+	PollURL string
+}
+
+// NewRequest constructs a webhook Request
+func NewRequest(webhook graphql.Webhook, requestObject webhookdir.TemplateInput, correlationID string) *Request {
+	return &Request{
+		Webhook:       webhook,
+		Object:        requestObject,
+		CorrelationID: correlationID,
+	}
+}
+
+// NewPollRequest constructs a webhook PollRequest
+func NewPollRequest(webhook graphql.Webhook, requestObject webhookdir.TemplateInput, correlationID string, pollURL string) *PollRequest {
+	return &PollRequest{
+		Request: NewRequest(webhook, requestObject, correlationID),
+		PollURL: pollURL,
+	}
+}
+
+// Client executes webhooks and polls the status of asynchronous ones
+//go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Client interface {
+	Do(ctx context.Context, request *Request) (*webhookdir.Response, error)
+	Poll(ctx context.Context, request *PollRequest) (*webhookdir.ResponseStatus, error)
+}
+
+// ClientProvider provides mTLS HTTP clients which authenticate with the client certificate referenced by a webhook.
+// The referenced certificate must be bound to the owner of the webhook, i.e. the application or runtime with the given ID.
+//go:generate mockery --name=ClientProvider --output=automock --outpkg=automock --case=underscore --disable-version-string
+type ClientProvider interface {
+	ClientFor(ctx context.Context, clientCertificateRef, ownerID string) (*http.Client, error)
+}
+
+type client struct {
+	httpClient        *http.Client
+	securedHTTPClient *http.Client
+	mtlsClient        *http.Client
+	certClients       ClientProvider
+}
+
+// NewClient creates a webhook Client. The HTTP client is used for webhooks without authentication and the secured HTTP client, which is expected
+// to authorize the requests with the credentials stored in their context, for webhooks with credentials. The mTLS client is used for webhooks
+// with the CMP mTLS access strategy, unless the webhook references its own client certificate, whose client is provided by certClients.
+// Per-webhook client certificates are not supported when certClients is nil.
+func NewClient(httpClient, securedHTTPClient, mtlsClient *http.Client, certClients ClientProvider) *client {
+	return &client{
+		httpClient:        httpClient,
+		securedHTTPClient: securedHTTPClient,
+		mtlsClient:        mtlsClient,
+		certClients:       certClients,
+	}
+}
+
+// Do executes the webhook and parses the response according to its output template
+func (c *client) Do(ctx context.Context, request *Request) (*webhookdir.Response, error) {
+	var err error
+	webhook := request.Webhook
+
+	if webhook.OutputTemplate == nil {
+		return nil, NewFatalError("missing output template")
+	}
+
+	var method string
+	url := webhook.URL
+	if webhook.URLTemplate != nil {
+		resultURL, err := request.Object.ParseURLTemplate(webhook.URLTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook URL"))
+		}
+		url = resultURL.Path
+		method = *resultURL.Method
+	}
+
+	if url == nil {
+		return nil, NewFatalError("missing webhook url")
+	}
+
+	body := []byte(emptyBody)
+	if webhook.InputTemplate != nil {
+		body, err = request.Object.ParseInputTemplate(webhook.InputTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook input body"))
+		}
+	}
+
+	headers := http.Header{}
+	if webhook.HeaderTemplate != nil {
+		headers, err = request.Object.ParseHeadersTemplate(webhook.HeaderTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook headers"))
+		}
+	}
+
+	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, webhook.CorrelationIDKey, &request.CorrelationID)
+
+	req, err := http.NewRequestWithContext(ctx, method, *url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(err)
+	}
+
+	req.Header = headers
+
+	if err := c.applySecurity(req, webhook, body); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.executeRequestWithCorrectClient(ctx, req, webhook)
+	if err != nil {
+		return nil, errors.Wrap(err, "while initially executing webhook")
+	}
+	defer closeResponseBody(ctx, resp)
+
+	responseObject, err := parseResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Debugf("Webhook response object: %v", *responseObject)
+
+	response, err := responseObject.ParseOutputTemplate(webhook.OutputTemplate)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse response into webhook output template"))
+	}
+
+	if err = checkForGoneStatus(resp, response.GoneStatusCode); err != nil {
+		return response, err
+	}
+
+	isLocationEmpty := response.Location != nil && *response.Location == ""
+	isAsyncWebhook := webhook.Mode != nil && *webhook.Mode == graphql.WebhookModeAsync
+
+	if isLocationEmpty && isAsyncWebhook {
+		return nil, errors.Errorf("missing location url after executing async webhook: HTTP response status %+v with body %s", resp.Status, responseObject.Body)
+	}
+
+	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+}
+
+// Poll requests the status of an asynchronous webhook and parses the response according to its status template
+func (c *client) Poll(ctx context.Context, request *PollRequest) (*webhookdir.ResponseStatus, error) {
+	var err error
+	webhook := request.Webhook
+
+	if webhook.StatusTemplate == nil {
+		return nil, NewFatalError("missing status template")
+	}
+
+	headers := http.Header{}
+	if webhook.HeaderTemplate != nil {
+		headers, err = request.Object.ParseHeadersTemplate(webhook.HeaderTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook headers"))
+		}
+	}
+
+	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, webhook.CorrelationIDKey, &request.CorrelationID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, request.PollURL, nil)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(err)
+	}
+
+	req.Header = headers
+
+	if err := c.applySecurity(req, webhook, nil); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.executeRequestWithCorrectClient(ctx, req, webhook)
+	if err != nil {
+		return nil, errors.Wrap(err, "while executing webhook for poll")
+	}
+	defer closeResponseBody(ctx, resp)
+
+	responseObject, err := parseResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	log.C(ctx).Debugf("Webhook response object: %v", *responseObject)
+
+	response, err := responseObject.ParseStatusTemplate(webhook.StatusTemplate)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse response status into status template"))
+	}
+
+	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+}
+
+// applySecurity signs the request body when the webhook defines a signature
+func (c *client) applySecurity(req *http.Request, webhook graphql.Webhook, body []byte) error {
+	if clientCertificateRef(webhook) != "" && c.certClients == nil {
+		return NewFatalError("webhook references a client certificate but per-webhook client certificates are not configured")
+	}
+
+	if webhook.Signature == nil {
+		return nil
+	}
+
+	return signRequest(req.Header, webhook.Signature, body, time.Now())
+}
+
+func (c *client) executeRequestWithCorrectClient(ctx context.Context, req *http.Request, webhook graphql.Webhook) (*http.Response, error) {
+	if ref := clientCertificateRef(webhook); ref != "" {
+		certClient, err := c.certClients.ClientFor(ctx, ref, ownerID(webhook))
+		if err != nil {
+			return nil, err
+		}
+		return certClient.Do(req)
+	}
+
+	if webhook.Auth == nil {
+		return c.httpClient.Do(req)
+	}
+
+	if str.PtrStrToStr(webhook.Auth.AccessStrategy) == string(accessstrategy.CMPmTLSAccessStrategy) {
+		return c.mtlsClient.Do(req)
+	}
+
+	if webhook.Auth.Credential != nil {
+		ctx = saveCredentialsToContext(ctx, webhook.Auth.Credential)
+		return c.securedHTTPClient.Do(req.WithContext(ctx))
+	}
+
+	return nil, errors.New("could not determine auth flow for webhook")
+}
+
+func clientCertificateRef(webhook graphql.Webhook) string {
+	if webhook.Auth == nil {
+		return ""
+	}
+	return str.PtrStrToStr(webhook.Auth.ClientCertificateRef)
+}
+
+// ownerID returns the ID of the application, runtime, application template or integration system which the webhook belongs to
+func ownerID(webhook graphql.Webhook) string {
+	for _, id := range []*string{webhook.ApplicationID, webhook.RuntimeID, webhook.ApplicationTemplateID, webhook.IntegrationSystemID} {
+		if id != nil && *id != "" {
+			return *id
+		}
+	}
+	return ""
+}
+
+func closeResponseBody(ctx context.Context, resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.C(ctx).WithError(err).Error("Failed to close HTTP response body")
+	}
+}
+
+func parseResponseObject(resp *http.Response) (*webhookdir.ResponseObject, error) {
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]string)
+	if len(respBody) > 0 {
+		tmpBody := make(map[string]interface{})
+		if err := json.Unmarshal(respBody, &tmpBody); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshall HTTP response with body %s", respBody)
+		}
+
+		for k, v := range tmpBody {
+			if v == nil {
+				continue
+			}
+			body[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	headers := make(map[string]string)
+	for key, value := range resp.Header {
+		headers[key] = value[0]
+	}
+
+	return &webhookdir.ResponseObject{
+		Headers: headers,
+		Body:    body,
+	}, nil
+}
+
+func checkForErr(resp *http.Response, successStatusCode *int, errorMsg *string) error {
+	var msg string
+	if *successStatusCode != resp.StatusCode {
+		msg += fmt.Sprintf("response success status code was not met - expected %d, got %d; ", *successStatusCode, resp.StatusCode)
+	}
+
+	if errorMsg != nil && *errorMsg != "" {
+		msg += fmt.Sprintf("received error while polling external system: %s", *errorMsg)
+	}
+
+	if msg != "" {
+		return errors.New(msg)
+	}
+
+	return nil
+}
+
+func checkForGoneStatus(resp *http.Response, goneStatusCode *int) error {
+	if goneStatusCode != nil && resp.StatusCode == *goneStatusCode {
+		return NewStatusGoneError(*goneStatusCode)
+	}
+	return nil
+}
+
+func saveCredentialsToContext(ctx context.Context, credentialData graphql.CredentialData) context.Context {
+	var credentials auth.Credentials
+
+	switch v := credentialData.(type) {
+	case *graphql.BasicCredentialData:
+		credentials = &auth.BasicCredentials{
+			Username: v.Username,
+			Password: v.Password,
+		}
+	case *graphql.OAuthCredentialData:
+		credentials = &auth.OAuthCredentials{
+			ClientID:     v.ClientID,
+			ClientSecret: v.ClientSecret,
+			TokenURL:     v.URL,
+		}
+	default:
+		return ctx
+	}
+
+	return auth.SaveToContext(ctx, credentials)
+}
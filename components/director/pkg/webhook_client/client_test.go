@@ -0,0 +1,628 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/auth"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	urlTemplate     = `{"method": "DELETE","path":"https://test-domain.com/api/v1/applications/{{.Application.ID}}"}`
+	inputTemplate   = `{"application_id": "{{.Application.ID}}","name": "{{.Application.Name}}"}`
+	headersTemplate = `{"user-identity":["{{.Headers.Client_user}}"]}`
+	outputTemplate  = `{"location":"{{.Headers.Location}}","success_status_code": 202,"gone_status_code": 404,"error": "{{.Body.error}}"}`
+	statusTemplate  = `{"status":"{{.Body.status}}","success_status_code": 200,"success_status_identifier":"SUCCEEDED","in_progress_status_identifier":"IN_PROGRESS","failed_status_identifier":"FAILED","error": "{{.Body.error}}"}`
+
+	mockedError       = "mocked error"
+	mockedLocationURL = "https://test-domain.com/operation"
+	correlationID     = "correlation-id"
+	correlationIDKey  = "X-Correlation-Id"
+
+	signatureSecret      = "a3f2b8c9d4e5f6a7b8c9d0e1f2a3b4c5"
+	clientCertificateRef = "webhook-client-cert"
+	applicationID        = "appID"
+)
+
+func TestClient_Do(t *testing.T) {
+	invalidTemplate := "invalidTemplate"
+	username, password := "user", "pass"
+	clientID, clientSecret, tokenURL := "client-id", "client-secret", "https://test-domain.com/oauth/token"
+	syncMode := graphql.WebhookModeSync
+
+	testCases := []struct {
+		Name               string
+		Webhook            graphql.Webhook
+		HTTPClient         *http.Client
+		SecuredHTTPClient  *http.Client
+		MTLSClient         *http.Client
+		CertClientsFn      func() *automock.ClientProvider
+		ExpectedLocation   string
+		ExpectedErrMessage string
+		ExpectedFatalError bool
+		ExpectedStatusGone bool
+	}{
+		{
+			Name:              "Success for async webhook without auth",
+			Webhook:           fixWebhook(nil),
+			HTTPClient:        fixHTTPClient(http.StatusAccepted, `{}`, nil),
+			SecuredHTTPClient: fixFailingHTTPClient(),
+			MTLSClient:        fixFailingHTTPClient(),
+			ExpectedLocation:  mockedLocationURL,
+		},
+		{
+			Name:              "Success for webhook with basic credentials",
+			Webhook:           fixWebhook(&graphql.Auth{Credential: &graphql.BasicCredentialData{Username: username, Password: password}}),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixHTTPClient(http.StatusAccepted, `{}`, &auth.BasicCredentials{Username: username, Password: password}),
+			MTLSClient:        fixFailingHTTPClient(),
+			ExpectedLocation:  mockedLocationURL,
+		},
+		{
+			Name:              "Success for webhook with OAuth credentials",
+			Webhook:           fixWebhook(&graphql.Auth{Credential: &graphql.OAuthCredentialData{ClientID: clientID, ClientSecret: clientSecret, URL: tokenURL}}),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixHTTPClient(http.StatusAccepted, `{}`, &auth.OAuthCredentials{ClientID: clientID, ClientSecret: clientSecret, TokenURL: tokenURL}),
+			MTLSClient:        fixFailingHTTPClient(),
+			ExpectedLocation:  mockedLocationURL,
+		},
+		{
+			Name: "Success for sync webhook without location",
+			Webhook: func() graphql.Webhook {
+				webhook := fixWebhook(nil)
+				webhook.Mode = &syncMode
+				return webhook
+			}(),
+			HTTPClient:       fixHTTPClientWithHeader(http.StatusAccepted, `{}`, http.Header{}),
+			ExpectedLocation: "",
+		},
+		{
+			Name:              "Success for webhook with mTLS access strategy",
+			Webhook:           fixWebhook(&graphql.Auth{AccessStrategy: str.Ptr(string(accessstrategy.CMPmTLSAccessStrategy))}),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixFailingHTTPClient(),
+			MTLSClient:        fixHTTPClient(http.StatusAccepted, `{}`, nil),
+			ExpectedLocation:  mockedLocationURL,
+		},
+		{
+			Name:              "Success for webhook with its own client certificate",
+			Webhook:           fixWebhook(&graphql.Auth{AccessStrategy: str.Ptr(string(accessstrategy.CMPmTLSAccessStrategy)), ClientCertificateRef: str.Ptr(clientCertificateRef)}),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixFailingHTTPClient(),
+			MTLSClient:        fixFailingHTTPClient(),
+			CertClientsFn: func() *automock.ClientProvider {
+				certClients := &automock.ClientProvider{}
+				certClients.On("ClientFor", mock.Anything, clientCertificateRef, applicationID).Return(fixHTTPClient(http.StatusAccepted, `{}`, nil), nil).Once()
+				return certClients
+			},
+			ExpectedLocation: mockedLocationURL,
+		},
+		{
+			Name:    "Error when client of client certificate cannot be provided",
+			Webhook: fixWebhook(&graphql.Auth{ClientCertificateRef: str.Ptr(clientCertificateRef)}),
+			CertClientsFn: func() *automock.ClientProvider {
+				certClients := &automock.ClientProvider{}
+				certClients.On("ClientFor", mock.Anything, clientCertificateRef, applicationID).Return(nil, errors.New(mockedError)).Once()
+				return certClients
+			},
+			ExpectedErrMessage: mockedError,
+		},
+		{
+			Name:               "Fatal error when client certificates are not configured",
+			Webhook:            fixWebhook(&graphql.Auth{ClientCertificateRef: str.Ptr(clientCertificateRef)}),
+			ExpectedErrMessage: "per-webhook client certificates are not configured",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when output template is missing",
+			Webhook: graphql.Webhook{
+				URLTemplate: str.Ptr(urlTemplate),
+			},
+			ExpectedErrMessage: "missing output template",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when URL template is invalid",
+			Webhook: graphql.Webhook{
+				URLTemplate:    &invalidTemplate,
+				OutputTemplate: str.Ptr(outputTemplate),
+			},
+			ExpectedErrMessage: "unable to parse webhook URL",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when input template is invalid",
+			Webhook: func() graphql.Webhook {
+				webhook := fixWebhook(nil)
+				webhook.InputTemplate = str.Ptr(`{"application_id": "{{.Application.ID}}","group": "{{.Application.Group}}"}`)
+				return webhook
+			}(),
+			ExpectedErrMessage: "unable to parse webhook input body",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when headers template is invalid",
+			Webhook: func() graphql.Webhook {
+				webhook := fixWebhook(nil)
+				webhook.HeaderTemplate = &invalidTemplate
+				return webhook
+			}(),
+			ExpectedErrMessage: "unable to parse webhook headers",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when URL is missing",
+			Webhook: graphql.Webhook{
+				OutputTemplate: str.Ptr(outputTemplate),
+			},
+			ExpectedErrMessage: "missing webhook url",
+			ExpectedFatalError: true,
+		},
+		{
+			Name:               "Error when auth flow cannot be determined",
+			Webhook:            fixWebhook(&graphql.Auth{}),
+			ExpectedErrMessage: "could not determine auth flow for webhook",
+		},
+		{
+			Name:               "Error when executing request fails",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixFailingHTTPClient(),
+			ExpectedErrMessage: mockedError,
+		},
+		{
+			Name:               "Error when async webhook response does not contain location",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixHTTPClientWithHeader(http.StatusAccepted, `{}`, http.Header{}),
+			ExpectedErrMessage: "missing location url after executing async webhook",
+		},
+		{
+			Name:               "Error when response body contains error",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixHTTPClient(http.StatusAccepted, fmt.Sprintf(`{"error": "%s"}`, mockedError), nil),
+			ExpectedErrMessage: "received error while polling external system: " + mockedError,
+		},
+		{
+			Name:               "Error when response body contains error with JSON objects",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixHTTPClient(http.StatusAccepted, `{"error": {"code":"401","message":"Unauthorized","correlationId":"12345678-e89b-12d3-a456-556642440000"}}`, nil),
+			ExpectedErrMessage: "received error while polling external system: map[code:401 correlationId:12345678-e89b-12d3-a456-556642440000 message:Unauthorized]",
+		},
+		{
+			Name:               "Error when response status code is not the success one",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixHTTPClient(http.StatusInternalServerError, `{}`, nil),
+			ExpectedErrMessage: "response success status code was not met",
+		},
+		{
+			Name:               "Status gone error when response status code is the gone one",
+			Webhook:            fixWebhook(nil),
+			HTTPClient:         fixHTTPClient(http.StatusNotFound, `{}`, nil),
+			ExpectedErrMessage: "gone response status 404",
+			ExpectedStatusGone: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "app"}
+			request := webhookclient.NewRequest(testCase.Webhook, &webhookdir.RequestObject{Application: app}, correlationID)
+			var certClients webhookclient.ClientProvider
+			if testCase.CertClientsFn != nil {
+				certClientsMock := testCase.CertClientsFn()
+				defer certClientsMock.AssertExpectations(t)
+				certClients = certClientsMock
+			}
+			client := webhookclient.NewClient(testCase.HTTPClient, testCase.SecuredHTTPClient, testCase.MTLSClient, certClients)
+
+			// WHEN
+			response, err := client.Do(context.TODO(), request)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Equal(t, testCase.ExpectedFatalError, webhookclient.IsFatalError(err))
+				require.Equal(t, testCase.ExpectedStatusGone, webhookclient.IsStatusGoneError(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.ExpectedLocation, *response.Location)
+		})
+	}
+}
+
+func TestClient_Do_WhenCreatingRequestFails_ShouldReturnFatalError(t *testing.T) {
+	// GIVEN
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "app"}
+	request := webhookclient.NewRequest(fixWebhook(nil), &webhookdir.RequestObject{Application: app}, correlationID)
+
+	// WHEN
+	_, err := webhookclient.NewClient(fixFailingHTTPClient(), nil, nil, nil).Do(nil, request) // nolint:staticcheck
+
+	// THEN
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil Context")
+	require.True(t, webhookclient.IsFatalError(err))
+}
+
+func TestClient_Do_AttachesCorrelationID(t *testing.T) {
+	// GIVEN
+	var actualHeaders map[string]string
+	httpClient := fixRecordingHTTPClient(http.StatusAccepted, func(r *http.Request) {
+		actualHeaders = correlation.HeadersFromContext(r.Context())
+	})
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "app"}
+	webhook := fixWebhook(nil)
+	webhook.CorrelationIDKey = str.Ptr(correlationIDKey)
+	request := webhookclient.NewRequest(webhook, &webhookdir.RequestObject{Application: app, Headers: map[string]string{}}, correlationID)
+
+	// WHEN
+	_, err := webhookclient.NewClient(httpClient, nil, nil, nil).Do(context.TODO(), request)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, correlationID, actualHeaders[correlationIDKey])
+}
+
+func TestClient_Poll(t *testing.T) {
+	invalidTemplate := "invalidTemplate"
+	username, password := "user", "pass"
+	clientID, clientSecret, tokenURL := "client-id", "client-secret", "https://test-domain.com/oauth/token"
+
+	testCases := []struct {
+		Name               string
+		Webhook            graphql.Webhook
+		HTTPClient         *http.Client
+		SecuredHTTPClient  *http.Client
+		MTLSClient         *http.Client
+		ExpectedStatus     string
+		ExpectedErrMessage string
+		ExpectedFatalError bool
+	}{
+		{
+			Name:           "Success",
+			Webhook:        fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:     fixHTTPClient(http.StatusOK, `{"status": "SUCCEEDED"}`, nil),
+			ExpectedStatus: "SUCCEEDED",
+		},
+		{
+			Name:           "Success when response contains null error",
+			Webhook:        fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:     fixHTTPClient(http.StatusOK, `{"status": "IN_PROGRESS", "error": null}`, nil),
+			ExpectedStatus: "IN_PROGRESS",
+		},
+		{
+			Name:           "Success when response contains empty error",
+			Webhook:        fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:     fixHTTPClient(http.StatusOK, `{"status": "FAILED", "error": ""}`, nil),
+			ExpectedStatus: "FAILED",
+		},
+		{
+			Name: "Success for webhook with basic credentials",
+			Webhook: func() graphql.Webhook {
+				webhook := fixPollWebhook(str.Ptr(statusTemplate))
+				webhook.Auth = &graphql.Auth{Credential: &graphql.BasicCredentialData{Username: username, Password: password}}
+				return webhook
+			}(),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixHTTPClient(http.StatusOK, `{"status": "SUCCEEDED"}`, &auth.BasicCredentials{Username: username, Password: password}),
+			MTLSClient:        fixFailingHTTPClient(),
+			ExpectedStatus:    "SUCCEEDED",
+		},
+		{
+			Name: "Success for webhook with OAuth credentials",
+			Webhook: func() graphql.Webhook {
+				webhook := fixPollWebhook(str.Ptr(statusTemplate))
+				webhook.Auth = &graphql.Auth{Credential: &graphql.OAuthCredentialData{ClientID: clientID, ClientSecret: clientSecret, URL: tokenURL}}
+				return webhook
+			}(),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixHTTPClient(http.StatusOK, `{"status": "SUCCEEDED"}`, &auth.OAuthCredentials{ClientID: clientID, ClientSecret: clientSecret, TokenURL: tokenURL}),
+			MTLSClient:        fixFailingHTTPClient(),
+			ExpectedStatus:    "SUCCEEDED",
+		},
+		{
+			Name: "Success for webhook with mTLS access strategy",
+			Webhook: func() graphql.Webhook {
+				webhook := fixPollWebhook(str.Ptr(statusTemplate))
+				webhook.Auth = &graphql.Auth{AccessStrategy: str.Ptr(string(accessstrategy.CMPmTLSAccessStrategy))}
+				return webhook
+			}(),
+			HTTPClient:        fixFailingHTTPClient(),
+			SecuredHTTPClient: fixFailingHTTPClient(),
+			MTLSClient:        fixHTTPClient(http.StatusOK, `{"status": "SUCCEEDED"}`, nil),
+			ExpectedStatus:    "SUCCEEDED",
+		},
+		{
+			Name:               "Fatal error when status template is missing",
+			Webhook:            fixPollWebhook(nil),
+			ExpectedErrMessage: "missing status template",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Fatal error when headers template is invalid",
+			Webhook: func() graphql.Webhook {
+				webhook := fixPollWebhook(str.Ptr(statusTemplate))
+				webhook.HeaderTemplate = &invalidTemplate
+				return webhook
+			}(),
+			ExpectedErrMessage: "unable to parse webhook headers",
+			ExpectedFatalError: true,
+		},
+		{
+			Name: "Error when auth flow cannot be determined",
+			Webhook: func() graphql.Webhook {
+				webhook := fixPollWebhook(str.Ptr(statusTemplate))
+				webhook.Auth = &graphql.Auth{AccessStrategy: str.Ptr("wrong")}
+				return webhook
+			}(),
+			ExpectedErrMessage: "could not determine auth flow for webhook",
+		},
+		{
+			Name:               "Fatal error when status template cannot be parsed",
+			Webhook:            fixPollWebhook(str.Ptr(`{"status":"{{.Body.status}}"}`)),
+			HTTPClient:         fixHTTPClient(http.StatusOK, `{}`, nil),
+			ExpectedErrMessage: "unable to parse response status into status template",
+			ExpectedFatalError: true,
+		},
+		{
+			Name:               "Error when executing request fails",
+			Webhook:            fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:         fixFailingHTTPClient(),
+			ExpectedErrMessage: mockedError,
+		},
+		{
+			Name:               "Error when response body contains error",
+			Webhook:            fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:         fixHTTPClient(http.StatusOK, fmt.Sprintf(`{"error": "%s"}`, mockedError), nil),
+			ExpectedErrMessage: "received error while polling external system: " + mockedError,
+		},
+		{
+			Name:               "Error when response status code is not the success one",
+			Webhook:            fixPollWebhook(str.Ptr(statusTemplate)),
+			HTTPClient:         fixHTTPClient(http.StatusInternalServerError, `{}`, nil),
+			ExpectedErrMessage: "response success status code was not met",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+			request := webhookclient.NewPollRequest(testCase.Webhook, &webhookdir.RequestObject{Application: app}, correlationID, mockedLocationURL)
+			client := webhookclient.NewClient(testCase.HTTPClient, testCase.SecuredHTTPClient, testCase.MTLSClient, nil)
+
+			// WHEN
+			response, err := client.Poll(context.TODO(), request)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				require.Equal(t, testCase.ExpectedFatalError, webhookclient.IsFatalError(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.ExpectedStatus, *response.Status)
+		})
+	}
+}
+
+func TestClient_Poll_WhenCreatingRequestFails_ShouldReturnFatalError(t *testing.T) {
+	// GIVEN
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	request := webhookclient.NewPollRequest(fixPollWebhook(str.Ptr(statusTemplate)), &webhookdir.RequestObject{Application: app}, correlationID, mockedLocationURL)
+
+	// WHEN
+	_, err := webhookclient.NewClient(fixFailingHTTPClient(), nil, nil, nil).Poll(nil, request) // nolint:staticcheck
+
+	// THEN
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "nil Context")
+	require.True(t, webhookclient.IsFatalError(err))
+}
+
+func TestClient_Poll_AttachesCorrelationID(t *testing.T) {
+	// GIVEN
+	var actualHeaders map[string]string
+	httpClient := fixRecordingHTTPClient(http.StatusOK, func(r *http.Request) {
+		actualHeaders = correlation.HeadersFromContext(r.Context())
+	})
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhook := fixPollWebhook(str.Ptr(statusTemplate))
+	webhook.CorrelationIDKey = str.Ptr(correlationIDKey)
+	request := webhookclient.NewPollRequest(webhook, &webhookdir.RequestObject{Application: app, Headers: map[string]string{}}, correlationID, mockedLocationURL)
+
+	// WHEN
+	_, err := webhookclient.NewClient(httpClient, nil, nil, nil).Poll(context.TODO(), request)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, correlationID, actualHeaders[correlationIDKey])
+}
+
+func TestClient_Do_SignsRequestBody(t *testing.T) {
+	signatureHeader := "X-Custom-Signature"
+
+	t.Run("Signs the request body when signature is configured", func(t *testing.T) {
+		// GIVEN
+		var actualHeaders http.Header
+		var actualBody []byte
+		httpClient := fixRecordingHTTPClient(http.StatusAccepted, func(r *http.Request) {
+			actualHeaders = r.Header
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			actualBody = body
+		})
+		app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "app"}
+		webhook := fixWebhook(nil)
+		webhook.Signature = &graphql.WebhookSignature{Secret: signatureSecret, SignatureHeader: &signatureHeader}
+		request := webhookclient.NewRequest(webhook, &webhookdir.RequestObject{Application: app}, correlationID)
+
+		// WHEN
+		_, err := webhookclient.NewClient(httpClient, nil, nil, nil).Do(context.TODO(), request)
+
+		// THEN
+		require.NoError(t, err)
+		timestamp := actualHeaders.Get(webhookclient.DefaultTimestampHeader)
+		nonce := actualHeaders.Get(webhookclient.DefaultNonceHeader)
+		require.NotEmpty(t, timestamp)
+		require.NotEmpty(t, nonce)
+		require.Empty(t, actualHeaders.Get(webhookclient.DefaultSignatureHeader))
+		require.Equal(t, `{"application_id": "appID","name": "app"}`, string(actualBody))
+		require.Equal(t, webhookclient.ComputeSignature(signatureSecret, timestamp, nonce, actualBody), actualHeaders.Get(signatureHeader))
+	})
+
+	t.Run("Does not sign the request when signature is not configured", func(t *testing.T) {
+		// GIVEN
+		var actualHeaders http.Header
+		httpClient := fixRecordingHTTPClient(http.StatusAccepted, func(r *http.Request) {
+			actualHeaders = r.Header
+		})
+		app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "app"}
+		request := webhookclient.NewRequest(fixWebhook(nil), &webhookdir.RequestObject{Application: app}, correlationID)
+
+		// WHEN
+		_, err := webhookclient.NewClient(httpClient, nil, nil, nil).Do(context.TODO(), request)
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, actualHeaders.Get(webhookclient.DefaultSignatureHeader))
+		require.Empty(t, actualHeaders.Get(webhookclient.DefaultTimestampHeader))
+		require.Empty(t, actualHeaders.Get(webhookclient.DefaultNonceHeader))
+	})
+}
+
+func TestClient_Poll_SignsEmptyBody(t *testing.T) {
+	// GIVEN
+	var actualHeaders http.Header
+	httpClient := fixRecordingHTTPClient(http.StatusOK, func(r *http.Request) {
+		actualHeaders = r.Header
+	})
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhook := fixPollWebhook(str.Ptr(statusTemplate))
+	webhook.Signature = &graphql.WebhookSignature{Secret: signatureSecret}
+	request := webhookclient.NewPollRequest(webhook, &webhookdir.RequestObject{Application: app}, correlationID, mockedLocationURL)
+
+	// WHEN
+	_, err := webhookclient.NewClient(httpClient, nil, nil, nil).Poll(context.TODO(), request)
+
+	// THEN
+	require.NoError(t, err)
+	timestamp := actualHeaders.Get(webhookclient.DefaultTimestampHeader)
+	nonce := actualHeaders.Get(webhookclient.DefaultNonceHeader)
+	require.Equal(t, webhookclient.ComputeSignature(signatureSecret, timestamp, nonce, nil), actualHeaders.Get(webhookclient.DefaultSignatureHeader))
+}
+
+func fixWebhook(webhookAuth *graphql.Auth) graphql.Webhook {
+	mode := graphql.WebhookModeAsync
+	return graphql.Webhook{
+		ApplicationID:  str.Ptr(applicationID),
+		URLTemplate:    str.Ptr(urlTemplate),
+		InputTemplate:  str.Ptr(inputTemplate),
+		HeaderTemplate: str.Ptr(headersTemplate),
+		OutputTemplate: str.Ptr(outputTemplate),
+		Mode:           &mode,
+		Auth:           webhookAuth,
+	}
+}
+
+func fixPollWebhook(statusTemplate *string) graphql.Webhook {
+	mode := graphql.WebhookModeAsync
+	return graphql.Webhook{
+		HeaderTemplate: str.Ptr(headersTemplate),
+		StatusTemplate: statusTemplate,
+		Mode:           &mode,
+	}
+}
+
+// fixHTTPClient returns a client responding with the given status and body, which expects the given credentials in the context of the requests
+func fixHTTPClient(statusCode int, body string, expectedCredentials auth.Credentials) *http.Client {
+	return fixHTTPClientWithCredentials(statusCode, body, http.Header{"Location": []string{mockedLocationURL}}, expectedCredentials)
+}
+
+// fixHTTPClientWithHeader returns a client responding with the given status, body and header to requests without credentials
+func fixHTTPClientWithHeader(statusCode int, body string, header http.Header) *http.Client {
+	return fixHTTPClientWithCredentials(statusCode, body, header, nil)
+}
+
+func fixHTTPClientWithCredentials(statusCode int, body string, header http.Header, expectedCredentials auth.Credentials) *http.Client {
+	return &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			credentials, err := auth.LoadFromContext(r.Context())
+			if expectedCredentials == nil && err == nil {
+				return nil, errors.New("unexpected credentials in request context")
+			}
+			if expectedCredentials != nil && (err != nil || fmt.Sprint(credentials) != fmt.Sprint(expectedCredentials)) {
+				return nil, errors.New("missing expected credentials in request context")
+			}
+
+			return &http.Response{
+				StatusCode: statusCode,
+				Header:     header,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+			}, nil
+		}),
+	}
+}
+
+// fixRecordingHTTPClient returns a client responding with the given status, which passes the requests to the given function
+func fixRecordingHTTPClient(statusCode int, record func(r *http.Request)) *http.Client {
+	return &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			record(r)
+			return &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"status": "SUCCEEDED"}`))),
+			}, nil
+		}),
+	}
+}
+
+func fixFailingHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, errors.New(mockedError)
+		}),
+	}
+}
+
+type roundTripperFunc func(r *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
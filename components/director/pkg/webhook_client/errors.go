@@ -0,0 +1,73 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrWebhookTimeoutReached is returned when a webhook has not completed within its timeout
+	ErrWebhookTimeoutReached = errors.New("webhook timeout reached")
+	// ErrWebhookPollTimeExpired is returned when an asynchronous webhook is still in progress after being polled
+	ErrWebhookPollTimeExpired = errors.New("polling time has expired")
+	// ErrFailedWebhookStatus is returned when an asynchronous webhook reports a failed status
+	ErrFailedWebhookStatus = errors.New("webhook operation has finished with failed status")
+)
+
+// FatalError denotes a webhook failure which cannot be recovered from by retrying the webhook
+type FatalError struct {
+	error
+}
+
+// NewFatalError constructs a new FatalError with the given error message
+func NewFatalError(message string) *FatalError {
+	return &FatalError{
+		error: errors.New(message),
+	}
+}
+
+// NewFatalErrorFromExisting constructs a new FatalError based on the provided error
+func NewFatalErrorFromExisting(err error) *FatalError {
+	return &FatalError{
+		error: err,
+	}
+}
+
+// IsFatalError checks whether an error is a FatalError
+func IsFatalError(err error) bool {
+	_, ok := err.(*FatalError)
+	return ok
+}
+
+// StatusGoneError is returned when a webhook responds with its gone status code
+type StatusGoneError struct {
+	error
+}
+
+// NewStatusGoneError constructs a new StatusGoneError for the given gone status code
+func NewStatusGoneError(goneStatusCode int) StatusGoneError {
+	return StatusGoneError{error: fmt.Errorf("gone response status %d was met while calling webhook", goneStatusCode)}
+}
+
+// IsStatusGoneError checks whether an error is a StatusGoneError
+func IsStatusGoneError(err error) bool {
+	_, ok := err.(StatusGoneError)
+	return ok
+}
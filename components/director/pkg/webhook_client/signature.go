@@ -0,0 +1,74 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhookclient
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultSignatureHeader is the header carrying the request signature when the webhook does not define one
+	DefaultSignatureHeader = "X-Compass-Signature"
+	// DefaultTimestampHeader is the header carrying the signing timestamp when the webhook does not define one
+	DefaultTimestampHeader = "X-Compass-Timestamp"
+	// DefaultNonceHeader is the header carrying the signing nonce when the webhook does not define one
+	DefaultNonceHeader = "X-Compass-Nonce"
+
+	signaturePrefix = "sha256="
+	nonceLength     = 16
+)
+
+// ComputeSignature returns the HMAC-SHA256 signature of the given body for the provided timestamp and nonce.
+// The signed payload is "<timestamp>.<nonce>.<body>" so that receivers can reject replayed or outdated requests.
+func ComputeSignature(secret, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + nonce + "."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func signRequest(headers http.Header, signature *graphql.WebhookSignature, body []byte, now time.Time) error {
+	nonceBytes := make([]byte, nonceLength)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return errors.Wrap(err, "while generating signature nonce")
+	}
+	nonce := hex.EncodeToString(nonceBytes)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	headers.Set(headerOrDefault(signature.TimestampHeader, DefaultTimestampHeader), timestamp)
+	headers.Set(headerOrDefault(signature.NonceHeader, DefaultNonceHeader), nonce)
+	headers.Set(headerOrDefault(signature.SignatureHeader, DefaultSignatureHeader), ComputeSignature(signature.Secret, timestamp, nonce, body))
+
+	return nil
+}
+
+func headerOrDefault(header *string, defaultHeader string) string {
+	if h := str.PtrStrToStr(header); h != "" {
+		return h
+	}
+	return defaultHeader
+}
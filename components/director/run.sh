@@ -17,6 +17,7 @@ REUSE_DB=false
 DUMP_DB=false
 AUTO_TERMINATE=false
 DISABLE_ASYNC_MODE=true
+OPERATIONS_SCHEDULER=kubernetes
 COMPONENT='director'
 TERMINAION_TIMEOUT_IN_SECONDS=300
 
@@ -52,6 +53,11 @@ do
           DISABLE_ASYNC_MODE=false
           shift
         ;;
+        --async-database-enabled)
+          DISABLE_ASYNC_MODE=false
+          OPERATIONS_SCHEDULER=database
+          shift
+        ;;
         --tenant-fetcher)
           COMPONENT='tenantfetcher-svc'
           shift
@@ -219,7 +225,9 @@ export APP_LOG_LEVEL=debug
 export APP_HTTP_RETRY_ATTEMPTS=3
 export APP_HTTP_RETRY_DELAY=100ms
 export APP_DISABLE_ASYNC_MODE=${DISABLE_ASYNC_MODE}
+export APP_OPERATIONS_SCHEDULER=${OPERATIONS_SCHEDULER}
 export APP_DISABLE_TENANT_ON_DEMAND_MODE=true
+export APP_SPEC_NORMALIZATION_ENABLED=false
 export APP_HEALTH_CONFIG_INDICATORS="{database,5s,1s,1s,3}"
 export APP_SUGGEST_TOKEN_HTTP_HEADER=suggest_token
 export APP_SCHEMA_MIGRATION_VERSION=$(ls -lr ${ROOT_PATH}/../schema-migrator/migrations/director | head -n 2 | tail -n 1 | tr -s ' ' | cut -d ' ' -f9 | cut -d '_' -f1)
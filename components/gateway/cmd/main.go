@@ -18,6 +18,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/spool"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
@@ -201,9 +203,76 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 		return nil, nil, errors.Wrap(err, "while loading auditlog cfg")
 	}
 
+	sinkTypes, err := sink.ParseTypes(cfg.Sinks)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while loading auditlog sinks")
+	}
+
 	uuidSvc := uuid.NewService()
 	timeSvc := &timeservices.TimeService{}
 
+	var msgFactory auditlog.AuditlogMessageFactory = auditlog.NewMessageFactory(cfg.MsgUser, cfg.MsgTenant, uuidSvc, timeSvc)
+	clients := make([]auditlog.NamedClient, 0, len(sinkTypes))
+	for _, sinkType := range sinkTypes {
+		var client auditlog.AuditlogClient
+		switch sinkType {
+		case sink.HTTP:
+			client, msgFactory, err = initHTTPAuditlogClient(cfg, collector, uuidSvc, timeSvc)
+		case sink.Syslog:
+			client, err = initSyslogAuditlogClient()
+		case sink.OTLP:
+			client, err = initOTLPAuditlogClient()
+		case sink.File:
+			client, err = initFileAuditlogClient()
+		}
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while initializing auditlog sink %s", sinkType)
+		}
+
+		clients = append(clients, auditlog.NamedClient{Name: string(sinkType), Client: client})
+	}
+
+	auditlogClient := auditlog.NewMultiClient(clients...)
+	go func() {
+		<-ctx.Done()
+		if err := auditlogClient.Close(); err != nil {
+			log.C(ctx).WithError(err).Error("Failed to close auditlog sinks")
+		}
+	}()
+
+	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
+	workers := make(chan bool, cfg.WriteWorkers)
+
+	var spoolCfg spool.Config
+	if err := envconfig.InitWithPrefix(&spoolCfg, "APP"); err != nil {
+		return nil, nil, errors.Wrap(err, "while loading auditlog spool configuration")
+	}
+
+	if spoolCfg.Enabled() {
+		auditlogSpool, err := spool.Open(ctx, spoolCfg, uuidSvc, collector)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while opening auditlog spool")
+		}
+		go auditlogSpool.Start(ctx)
+		initWorkers(ctx, workers, auditlogSvc, auditlogSpool)
+
+		log.C(ctx).Infof("Auditlog configured successfully with spool in %s, sinks: %v", spoolCfg.Dir, sinkTypes)
+		return auditlogSpool, auditlogSvc, nil
+	}
+
+	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
+	initWorkers(ctx, workers, auditlogSvc, auditlog.NewChannelQueue(msgChannel, collector))
+
+	log.C(ctx).Infof("Auditlog configured successfully, sinks: %v", sinkTypes)
+	return auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout, collector), auditlogSvc, nil
+}
+
+// initHTTPAuditlogClient creates the client for the audit log service together with the message factory for the configured auth mode
+func initHTTPAuditlogClient(cfg auditlog.Config, collector *metrics.AuditlogCollector, uuidSvc auditlog.UUIDService, timeSvc auditlog.TimeService) (auditlog.AuditlogClient, auditlog.AuditlogMessageFactory, error) {
+	if err := cfg.ValidateHTTP(); err != nil {
+		return nil, nil, err
+	}
+
 	var httpClient auditlog.HttpClient
 	var msgFactory auditlog.AuditlogMessageFactory
 
@@ -249,7 +318,7 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 	case auditlog.OAuthMtls:
 		{
 			var mtlsConfig auditlog.OAuthMtlsConfig
-			if err = envconfig.InitWithPrefix(&mtlsConfig, "APP"); err != nil {
+			if err := envconfig.InitWithPrefix(&mtlsConfig, "APP"); err != nil {
 				return nil, nil, errors.Wrap(err, "while loading auditlog oauth-mTLS configuration")
 			}
 
@@ -287,13 +356,39 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 		return nil, nil, errors.Wrap(err, "Error while creating auditlog client from cfg")
 	}
 
-	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
-	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
-	workers := make(chan bool, cfg.WriteWorkers)
-	initWorkers(ctx, workers, auditlogSvc, msgChannel, collector)
+	return auditlogClient, msgFactory, nil
+}
 
-	log.C(ctx).Infof("Auditlog configured successfully, auth mode: %s", cfg.AuthMode)
-	return auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout, collector), auditlogSvc, nil
+func initSyslogAuditlogClient() (auditlog.AuditlogClient, error) {
+	var syslogCfg sink.SyslogConfig
+	if err := envconfig.InitWithPrefix(&syslogCfg, "APP"); err != nil {
+		return nil, errors.Wrap(err, "while loading auditlog syslog configuration")
+	}
+
+	return sink.NewSyslogClient(syslogCfg)
+}
+
+func initOTLPAuditlogClient() (auditlog.AuditlogClient, error) {
+	var otlpCfg sink.OTLPConfig
+	if err := envconfig.InitWithPrefix(&otlpCfg, "APP"); err != nil {
+		return nil, errors.Wrap(err, "while loading auditlog OTLP configuration")
+	}
+
+	httpClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		Timeout:   otlpCfg.Timeout,
+	}
+
+	return sink.NewOTLPClient(otlpCfg, httpClient)
+}
+
+func initFileAuditlogClient() (auditlog.AuditlogClient, error) {
+	var fileCfg sink.FileConfig
+	if err := envconfig.InitWithPrefix(&fileCfg, "APP"); err != nil {
+		return nil, errors.Wrap(err, "while loading auditlog file configuration")
+	}
+
+	return sink.NewFileClient(fileCfg)
 }
 
 func fillJWTCredentials(cfg auditlog.OAuthConfig) clientcredentials.Config {
@@ -314,7 +409,7 @@ func fillOAuthMtlsCredentials(cfg auditlog.OAuthMtlsConfig) clientcredentials.Co
 	}
 }
 
-func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.AuditlogService, msgChannel chan proxy.AuditlogMessage, collector *metrics.AuditlogCollector) {
+func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.AuditlogService, queue auditlog.Queue) {
 	logger := log.C(ctx)
 
 	go func() {
@@ -325,7 +420,7 @@ func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.Audit
 				return
 			case workers <- true:
 			}
-			worker := auditlog.NewWorker(auditlogSvc, msgChannel, collector)
+			worker := auditlog.NewWorker(auditlogSvc, queue)
 			go func() {
 				logger.Infoln("Starting worker for auditlog message processing")
 				worker.Start(ctx)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/cert"
+	"github.com/pkg/errors"
 )
 
 type AuthMode string
@@ -16,16 +17,28 @@ const (
 )
 
 type Config struct {
-	URL               string        `envconfig:"APP_AUDITLOG_URL"`
-	ConfigPath        string        `envconfig:"APP_AUDITLOG_CONFIG_PATH"`
-	SecurityPath      string        `envconfig:"APP_AUDITLOG_SECURITY_PATH"`
-	AuthMode          AuthMode      `envconfig:"APP_AUDITLOG_AUTH_MODE"`
+	Sinks             []string      `envconfig:"APP_AUDITLOG_SINKS,default=http"`
+	URL               string        `envconfig:"optional,APP_AUDITLOG_URL"`
+	ConfigPath        string        `envconfig:"optional,APP_AUDITLOG_CONFIG_PATH"`
+	SecurityPath      string        `envconfig:"optional,APP_AUDITLOG_SECURITY_PATH"`
+	AuthMode          AuthMode      `envconfig:"optional,APP_AUDITLOG_AUTH_MODE"`
+	MsgUser           string        `envconfig:"APP_AUDITLOG_MESSAGE_USER,default=proxy"`
+	MsgTenant         string        `envconfig:"optional,APP_AUDITLOG_MESSAGE_TENANT"`
 	ClientTimeout     time.Duration `envconfig:"APP_AUDITLOG_CLIENT_TIMEOUT,default=30s"`
 	MsgChannelSize    int           `envconfig:"APP_AUDITLOG_CHANNEL_SIZE,default=100"`
 	MsgChannelTimeout time.Duration `envconfig:"APP_AUDITLOG_CHANNEL_TIMEOUT,default=5s"`
 	WriteWorkers      int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
 }
 
+// ValidateHTTP checks that the audit log service is configured. It is required only when the http sink is used.
+func (c Config) ValidateHTTP() error {
+	if c.URL == "" {
+		return errors.New("auditlog URL must not be empty")
+	}
+
+	return nil
+}
+
 type BasicAuthConfig struct {
 	User     string `envconfig:"APP_AUDITLOG_USER"`
 	Password string `envconfig:"APP_AUDITLOG_PASSWORD"`
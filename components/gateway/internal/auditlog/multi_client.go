@@ -0,0 +1,149 @@
+package auditlog
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+// NamedClient is an AuditlogClient identified by the name of the sink it writes to
+type NamedClient struct {
+	Name   string
+	Client AuditlogClient
+}
+
+// maxPartiallyDelivered is the maximum number of messages whose delivery to the sinks which accepted them is remembered
+const maxPartiallyDelivered = 10000
+
+// MultiClient writes every audit log message to all configured sinks. Each sink maps the message to its own format.
+// A message is reported as failed if any of the sinks fails. The sinks which accepted it are remembered by the message UUID,
+// so that a retried message is written again only to the sinks which failed.
+type MultiClient struct {
+	clients []NamedClient
+
+	mutex sync.Mutex
+	// accepted contains the indexes of the sinks which accepted each partially delivered message, by message UUID
+	accepted map[string]map[int]bool
+	// order contains the UUIDs of the partially delivered messages, from the oldest to the newest
+	order []string
+}
+
+func NewMultiClient(clients ...NamedClient) *MultiClient {
+	return &MultiClient{
+		clients:  clients,
+		accepted: make(map[string]map[int]bool),
+	}
+}
+
+func (c *MultiClient) LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error {
+	return c.forEach(ctx, change.UUID, func(client AuditlogClient) error {
+		return client.LogConfigurationChange(ctx, change)
+	})
+}
+
+func (c *MultiClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	return c.forEach(ctx, event.UUID, func(client AuditlogClient) error {
+		return client.LogSecurityEvent(ctx, event)
+	})
+}
+
+// Close closes all sinks which hold resources, such as open files or connections
+func (c *MultiClient) Close() error {
+	failed := make([]string, 0)
+	for _, client := range c.clients {
+		closer, ok := client.Client.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		if err := closer.Close(); err != nil {
+			failed = append(failed, client.Name+": "+err.Error())
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("while closing auditlog sinks: [%s]", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (c *MultiClient) forEach(ctx context.Context, msgUUID string, logFn func(client AuditlogClient) error) error {
+	accepted := c.acceptedSinks(msgUUID)
+	failed := make([]string, 0)
+	for i, client := range c.clients {
+		if accepted[i] {
+			log.C(ctx).Debugf("Skipping auditlog message with UUID %s for sink %s which has already accepted it", msgUUID, client.Name)
+			continue
+		}
+
+		if err := logFn(client.Client); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to write auditlog message to sink %s", client.Name)
+			failed = append(failed, client.Name+": "+err.Error())
+			continue
+		}
+		accepted[i] = true
+	}
+
+	if len(failed) > 0 {
+		c.rememberAccepted(msgUUID, accepted)
+		return errors.Errorf("while writing to auditlog sinks: [%s]", strings.Join(failed, "; "))
+	}
+
+	c.forgetAccepted(msgUUID)
+	return nil
+}
+
+// acceptedSinks returns a copy of the indexes of the sinks which have already accepted the message with the given UUID
+func (c *MultiClient) acceptedSinks(msgUUID string) map[int]bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	accepted := make(map[int]bool, len(c.clients))
+	for i := range c.accepted[msgUUID] {
+		accepted[i] = true
+	}
+	return accepted
+}
+
+// rememberAccepted stores the sinks which accepted the partially delivered message with the given UUID.
+// Once there are too many partially delivered messages, the oldest of them is forgotten and delivered again to all sinks if retried.
+func (c *MultiClient) rememberAccepted(msgUUID string, accepted map[int]bool) {
+	if msgUUID == "" || len(accepted) == 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.accepted[msgUUID]; !ok {
+		c.order = append(c.order, msgUUID)
+	}
+	c.accepted[msgUUID] = accepted
+
+	for len(c.order) > maxPartiallyDelivered {
+		delete(c.accepted, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+func (c *MultiClient) forgetAccepted(msgUUID string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.accepted[msgUUID]; !ok {
+		return
+	}
+	delete(c.accepted, msgUUID)
+
+	for i, id := range c.order {
+		if id == msgUUID {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package auditlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiClient_LogConfigurationChange(t *testing.T) {
+	msg := fixFilledConfigChangeMsg()
+
+	t.Run("Success", func(t *testing.T) {
+		//GIVEN
+		first := &automock.AuditlogClient{}
+		first.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+		second := &automock.AuditlogClient{}
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+
+		client := auditlog.NewMultiClient(
+			auditlog.NamedClient{Name: "http", Client: first},
+			auditlog.NamedClient{Name: "file", Client: second},
+		)
+
+		//WHEN
+		err := client.LogConfigurationChange(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+
+	t.Run("Writes to all sinks when one of them fails", func(t *testing.T) {
+		//GIVEN
+		first := &automock.AuditlogClient{}
+		first.On("LogConfigurationChange", context.TODO(), msg).Return(errors.New("test err")).Once()
+		second := &automock.AuditlogClient{}
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+
+		client := auditlog.NewMultiClient(
+			auditlog.NamedClient{Name: "http", Client: first},
+			auditlog.NamedClient{Name: "file", Client: second},
+		)
+
+		//WHEN
+		err := client.LogConfigurationChange(context.TODO(), msg)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "http: test err")
+		assert.NotContains(t, err.Error(), "file")
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+
+	t.Run("Writes the retried message only to the sinks which failed", func(t *testing.T) {
+		//GIVEN
+		first := &automock.AuditlogClient{}
+		first.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+		second := &automock.AuditlogClient{}
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(errors.New("test err")).Once()
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Once()
+
+		client := auditlog.NewMultiClient(
+			auditlog.NamedClient{Name: "http", Client: first},
+			auditlog.NamedClient{Name: "syslog", Client: second},
+		)
+
+		//WHEN
+		err := client.LogConfigurationChange(context.TODO(), msg)
+		require.Error(t, err)
+		err = client.LogConfigurationChange(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+
+	t.Run("Writes the message again to all sinks once it has been delivered", func(t *testing.T) {
+		//GIVEN
+		first := &automock.AuditlogClient{}
+		first.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Twice()
+		second := &automock.AuditlogClient{}
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(errors.New("test err")).Once()
+		second.On("LogConfigurationChange", context.TODO(), msg).Return(nil).Twice()
+
+		client := auditlog.NewMultiClient(
+			auditlog.NamedClient{Name: "http", Client: first},
+			auditlog.NamedClient{Name: "syslog", Client: second},
+		)
+
+		//WHEN
+		require.Error(t, client.LogConfigurationChange(context.TODO(), msg))
+		require.NoError(t, client.LogConfigurationChange(context.TODO(), msg))
+		err := client.LogConfigurationChange(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, first, second)
+	})
+}
+
+func TestMultiClient_LogSecurityEvent(t *testing.T) {
+	//GIVEN
+	msg := fixFilledSecurityEventMsg()
+
+	first := &automock.AuditlogClient{}
+	first.On("LogSecurityEvent", context.TODO(), msg).Return(nil).Once()
+	second := &automock.AuditlogClient{}
+	second.On("LogSecurityEvent", context.TODO(), msg).Return(errors.New("test err")).Once()
+
+	client := auditlog.NewMultiClient(
+		auditlog.NamedClient{Name: "http", Client: first},
+		auditlog.NamedClient{Name: "syslog", Client: second},
+	)
+
+	//WHEN
+	err := client.LogSecurityEvent(context.TODO(), msg)
+
+	//THEN
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "syslog: test err")
+	mock.AssertExpectationsForObjects(t, first, second)
+}
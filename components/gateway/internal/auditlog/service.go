@@ -84,7 +84,7 @@ func NewService(client AuditlogClient, msgFactory AuditlogMessageFactory) *Servi
 
 func (svc *Service) PreLog(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PreAuditlogOperation)
+	configChangeMsg := svc.createConfigChangeMsg("", msg.Claims, msg.Request, correlationID, PreAuditlogOperation)
 	err := svc.client.LogConfigurationChange(ctx, configChangeMsg)
 	return errors.Wrap(err, "while sending configuration pre-change")
 }
@@ -98,7 +98,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
 
 	if len(graphqlResponse.Errors) == 0 {
-		configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+		configChangeMsg := svc.createConfigChangeMsg(msg.ID, msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
 				Name: "response",
@@ -112,6 +112,9 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 
 	if svc.hasInsufficientScopeError(graphqlResponse.Errors) {
 		securityEventMsg := svc.msgFactory.CreateSecurityEvent()
+		if msg.ID != "" {
+			securityEventMsg.UUID = msg.ID
+		}
 		eventData := model.SecurityEventData{
 			ID:            fillID(msg.Claims, "Security Event"),
 			CorrelationID: correlationID,
@@ -132,7 +135,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 		return errors.Wrap(err, "while checking if error is read error")
 	}
 
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+	configChangeMsg := svc.createConfigChangeMsg(msg.ID, msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
 	if isReadErr {
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
@@ -164,8 +167,11 @@ func (svc *Service) parseResponse(response string) (model.GraphqlResponse, error
 	return graphqlResponse, nil
 }
 
-func (svc *Service) createConfigChangeMsg(claims proxy.Claims, request string, correlationID string, auditlogOperationType string) model.ConfigurationChange {
+func (svc *Service) createConfigChangeMsg(msgID string, claims proxy.Claims, request string, correlationID string, auditlogOperationType string) model.ConfigurationChange {
 	msg := svc.msgFactory.CreateConfigurationChange()
+	if msgID != "" {
+		msg.UUID = msgID
+	}
 	msg.Object = model.Object{ID: fillID(claims, "Config Change")}
 
 	msg.Attributes = []model.Attribute{
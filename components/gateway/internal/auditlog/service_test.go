@@ -47,6 +47,36 @@ func TestAuditlogService_Log(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, client, factory)
 	})
 
+	t.Run("Success mutation with message ID", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
+
+		request := fixRequest()
+		response := fixNoErrorResponse(t)
+		claims := fixClaims()
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log.UUID = "spooled-msg-id"
+
+		client := &automock.AuditlogClient{}
+		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		//WHEN
+		msg := proxy.AuditlogMessage{
+			ID:                   "spooled-msg-id",
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              request,
+			Response:             response,
+			Claims:               claims,
+		}
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
 	t.Run("Unsuccessful mutation", func(t *testing.T) {
 		//GIVEN
 		factory := &automock.AuditlogMessageFactory{}
@@ -0,0 +1,136 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+)
+
+const (
+	cefConfigChangeSignature  = "configuration-change"
+	cefSecurityEventSignature = "security-event"
+
+	cefSeverityInfo    = 3
+	cefSeverityFailure = 5
+	cefSeverityHigh    = 7
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+// cefFormatter maps audit log messages to ArcSight Common Event Format (CEF) records
+type cefFormatter struct {
+	vendor  string
+	product string
+	version string
+}
+
+type cefExtension struct {
+	key   string
+	value string
+}
+
+func (f cefFormatter) configurationChange(change model.ConfigurationChange) (string, error) {
+	attributes, err := json.Marshal(change.Attributes)
+	if err != nil {
+		return "", err
+	}
+	objectID := []byte{}
+	if len(change.Object.ID) > 0 {
+		if objectID, err = json.Marshal(change.Object.ID); err != nil {
+			return "", err
+		}
+	}
+
+	severity := cefSeverityInfo
+	outcome := ""
+	if change.Success != nil {
+		outcome = "success"
+		if !*change.Success {
+			outcome = "failure"
+			severity = cefSeverityFailure
+		}
+	}
+
+	extensions := f.metadata(change.Metadata, change.User)
+	extensions = append(extensions, cefExtension{key: "outcome", value: outcome})
+	extensions = append(extensions, customString(2, "objectType", change.Object.Type)...)
+	extensions = append(extensions, customString(3, "objectId", string(objectID))...)
+	extensions = append(extensions, customString(4, "attributes", string(attributes))...)
+
+	return f.format(cefConfigChangeSignature, "Configuration change", severity, extensions), nil
+}
+
+func (f cefFormatter) securityEvent(event model.SecurityEvent) string {
+	ip := ""
+	if event.IP != nil {
+		ip = event.IP.String()
+	}
+
+	extensions := f.metadata(event.Metadata, event.User)
+	extensions = append(extensions,
+		cefExtension{key: "src", value: ip},
+		cefExtension{key: "msg", value: event.Data},
+	)
+
+	return f.format(cefSecurityEventSignature, "Security event", cefSeverityHigh, extensions)
+}
+
+func (f cefFormatter) metadata(metadata model.Metadata, user string) []cefExtension {
+	extensions := make([]cefExtension, 0, 12)
+	if t, err := time.Parse(model.LogFormatDate, metadata.Time); err == nil {
+		extensions = append(extensions, cefExtension{key: "rt", value: strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)})
+	}
+
+	extensions = append(extensions,
+		cefExtension{key: "externalId", value: metadata.UUID},
+		cefExtension{key: "suser", value: user},
+	)
+	return append(extensions, customString(1, "tenant", metadata.Tenant)...)
+}
+
+// customString returns the csN extension together with its label, or nothing if the value is empty
+func customString(n int, label, value string) []cefExtension {
+	if value == "" {
+		return nil
+	}
+
+	key := fmt.Sprintf("cs%d", n)
+	return []cefExtension{
+		{key: key + "Label", value: label},
+		{key: key, value: value},
+	}
+}
+
+func (f cefFormatter) format(signature, name string, severity int, extensions []cefExtension) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeaderEscaper.Replace(f.vendor),
+		cefHeaderEscaper.Replace(f.product),
+		cefHeaderEscaper.Replace(f.version),
+		cefHeaderEscaper.Replace(signature),
+		cefHeaderEscaper.Replace(name),
+		severity)
+
+	first := true
+	for _, ext := range extensions {
+		if ext.value == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(ext.key)
+		b.WriteByte('=')
+		b.WriteString(cefExtensionEscaper.Replace(ext.value))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,121 @@
+package sink
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Type is the kind of destination to which audit log messages are written
+type Type string
+
+const (
+	// HTTP sends the messages to the audit log service
+	HTTP Type = "http"
+	// Syslog sends the messages in CEF format to a syslog server
+	Syslog Type = "syslog"
+	// OTLP exports the messages as OpenTelemetry log records
+	OTLP Type = "otlp"
+	// File writes the messages as JSON lines to rotating files
+	File Type = "file"
+)
+
+// ParseTypes validates the configured sink names and removes duplicates
+func ParseTypes(names []string) ([]Type, error) {
+	types := make([]Type, 0, len(names))
+	seen := make(map[Type]bool, len(names))
+	for _, name := range names {
+		t := Type(name)
+		switch t {
+		case HTTP, Syslog, OTLP, File:
+		default:
+			return nil, errors.Errorf("invalid auditlog sink: %q", name)
+		}
+
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		types = append(types, t)
+	}
+
+	if len(types) == 0 {
+		return nil, errors.New("at least one auditlog sink must be configured")
+	}
+
+	return types, nil
+}
+
+// SyslogConfig configures the syslog sink
+type SyslogConfig struct {
+	Network           string        `envconfig:"default=udp,APP_AUDITLOG_SYSLOG_NETWORK"`
+	Address           string        `envconfig:"APP_AUDITLOG_SYSLOG_ADDRESS"`
+	Facility          int           `envconfig:"default=13,APP_AUDITLOG_SYSLOG_FACILITY"`
+	AppName           string        `envconfig:"default=compass-gateway,APP_AUDITLOG_SYSLOG_APP_NAME"`
+	Timeout           time.Duration `envconfig:"default=5s,APP_AUDITLOG_SYSLOG_TIMEOUT"`
+	SkipSSLValidation bool          `envconfig:"default=false,APP_AUDITLOG_SYSLOG_SKIP_SSL_VALIDATION"`
+	CEFVendor         string        `envconfig:"default=Kyma,APP_AUDITLOG_CEF_VENDOR"`
+	CEFProduct        string        `envconfig:"default=Compass Gateway,APP_AUDITLOG_CEF_PRODUCT"`
+	CEFVersion        string        `envconfig:"default=1.0,APP_AUDITLOG_CEF_VERSION"`
+}
+
+// Validate checks that the syslog sink can be created from the configuration
+func (c SyslogConfig) Validate() error {
+	switch c.Network {
+	case networkUDP, networkTCP, networkTLS:
+	default:
+		return errors.Errorf("invalid syslog network %q, expected one of %s, %s, %s", c.Network, networkUDP, networkTCP, networkTLS)
+	}
+
+	if c.Address == "" {
+		return errors.New("syslog address must not be empty")
+	}
+
+	if c.Facility < 0 || c.Facility > 23 {
+		return errors.Errorf("invalid syslog facility %d", c.Facility)
+	}
+
+	return nil
+}
+
+// OTLPConfig configures the OpenTelemetry logs sink
+type OTLPConfig struct {
+	Endpoint    string        `envconfig:"APP_AUDITLOG_OTLP_ENDPOINT"`
+	Headers     string        `envconfig:"optional,APP_AUDITLOG_OTLP_HEADERS"`
+	ServiceName string        `envconfig:"default=compass-gateway,APP_AUDITLOG_OTLP_SERVICE_NAME"`
+	Timeout     time.Duration `envconfig:"default=10s,APP_AUDITLOG_OTLP_TIMEOUT"`
+}
+
+// Validate checks that the OpenTelemetry logs sink can be created from the configuration
+func (c OTLPConfig) Validate() error {
+	if c.Endpoint == "" {
+		return errors.New("OTLP endpoint must not be empty")
+	}
+
+	_, err := parseHeaders(c.Headers)
+	return err
+}
+
+// FileConfig configures the file sink
+type FileConfig struct {
+	Path       string `envconfig:"APP_AUDITLOG_FILE_PATH"`
+	MaxSize    int64  `envconfig:"default=104857600,APP_AUDITLOG_FILE_MAX_SIZE"`
+	MaxBackups int    `envconfig:"default=5,APP_AUDITLOG_FILE_MAX_BACKUPS"`
+}
+
+// Validate checks that the file sink can be created from the configuration
+func (c FileConfig) Validate() error {
+	if c.Path == "" {
+		return errors.New("file path must not be empty")
+	}
+
+	if c.MaxSize <= 0 {
+		return errors.New("max file size must be positive")
+	}
+
+	if c.MaxBackups < 0 {
+		return errors.New("max file backups must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package sink_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypes(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		types, err := sink.ParseTypes([]string{"http", "syslog", "http", "otlp", "file"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []sink.Type{sink.HTTP, sink.Syslog, sink.OTLP, sink.File}, types)
+	})
+
+	t.Run("Error for unknown sink", func(t *testing.T) {
+		_, err := sink.ParseTypes([]string{"http", "kafka"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid auditlog sink: "kafka"`)
+	})
+
+	t.Run("Error when no sink is configured", func(t *testing.T) {
+		_, err := sink.ParseTypes(nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one auditlog sink must be configured")
+	})
+}
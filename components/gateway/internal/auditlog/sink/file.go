@@ -0,0 +1,203 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	configChangeRecordType  = "configuration_change"
+	securityEventRecordType = "security_event"
+)
+
+// fileRecord is a single line written by FileClient
+type fileRecord struct {
+	Type   string      `json:"type"`
+	UUID   string      `json:"uuid"`
+	Time   string      `json:"time"`
+	Tenant string      `json:"tenant"`
+	User   string      `json:"user"`
+	Event  interface{} `json:"event"`
+}
+
+type fileConfigChange struct {
+	Object     model.Object      `json:"object"`
+	Attributes []model.Attribute `json:"attributes"`
+	Success    *bool             `json:"success,omitempty"`
+}
+
+type fileSecurityEvent struct {
+	IP   string          `json:"ip,omitempty"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// FileClient writes audit log messages as JSON lines. When the file would grow over the configured size it is rotated:
+// the current file is renamed to <path>.1, older files are shifted by one and the oldest file over the configured
+// number of backups is removed.
+type FileClient struct {
+	cfg FileConfig
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileClient creates a file sink and opens the file for appending
+func NewFileClient(cfg FileConfig) (*FileClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "while validating file sink configuration")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0700); err != nil {
+		return nil, errors.Wrapf(err, "while creating directory for audit log file %s", cfg.Path)
+	}
+
+	c := &FileClient{cfg: cfg}
+	if err := c.open(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// LogConfigurationChange writes the configuration change as a single JSON line
+func (c *FileClient) LogConfigurationChange(_ context.Context, change model.ConfigurationChange) error {
+	return c.write(fileRecord{
+		Type:   configChangeRecordType,
+		UUID:   change.UUID,
+		Time:   change.Time,
+		Tenant: change.Tenant,
+		User:   change.User,
+		Event: fileConfigChange{
+			Object:     change.Object,
+			Attributes: change.Attributes,
+			Success:    change.Success,
+		},
+	})
+}
+
+// LogSecurityEvent writes the security event as a single JSON line
+func (c *FileClient) LogSecurityEvent(_ context.Context, event model.SecurityEvent) error {
+	securityEvent := fileSecurityEvent{}
+	if event.IP != nil {
+		securityEvent.IP = event.IP.String()
+	}
+	if json.Valid([]byte(event.Data)) {
+		securityEvent.Data = json.RawMessage(event.Data)
+	} else if event.Data != "" {
+		data, err := json.Marshal(event.Data)
+		if err != nil {
+			return errors.Wrap(err, "while marshaling security event data")
+		}
+		securityEvent.Data = data
+	}
+
+	return c.write(fileRecord{
+		Type:   securityEventRecordType,
+		UUID:   event.UUID,
+		Time:   event.Time,
+		Tenant: event.Tenant,
+		User:   event.User,
+		Event:  securityEvent,
+	})
+}
+
+// Close closes the current file
+func (c *FileClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+func (c *FileClient) write(record fileRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "while marshaling audit log record")
+	}
+	line = append(line, '\n')
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return errors.New("audit log file is closed")
+	}
+
+	if c.size > 0 && c.size+int64(len(line)) > c.cfg.MaxSize {
+		if err := c.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := c.file.Write(line)
+	c.size += int64(n)
+	if err != nil {
+		return errors.Wrapf(err, "while writing to audit log file %s", c.cfg.Path)
+	}
+
+	return nil
+}
+
+func (c *FileClient) open() error {
+	file, err := os.OpenFile(c.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while opening audit log file %s", c.cfg.Path)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while reading audit log file %s", c.cfg.Path)
+	}
+
+	c.file = file
+	c.size = info.Size()
+	return nil
+}
+
+func (c *FileClient) rotate() error {
+	if err := c.file.Close(); err != nil {
+		return errors.Wrapf(err, "while closing audit log file %s", c.cfg.Path)
+	}
+	c.file = nil
+
+	if c.cfg.MaxBackups == 0 {
+		if err := os.Remove(c.cfg.Path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "while removing audit log file %s", c.cfg.Path)
+		}
+		return c.open()
+	}
+
+	if err := os.Remove(c.backupPath(c.cfg.MaxBackups)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "while removing oldest audit log file backup")
+	}
+
+	for i := c.cfg.MaxBackups - 1; i >= 0; i-- {
+		if err := os.Rename(c.backupPath(i), c.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "while rotating audit log file backup %d", i)
+		}
+	}
+
+	return c.open()
+}
+
+func (c *FileClient) backupPath(index int) string {
+	if index == 0 {
+		return c.cfg.Path
+	}
+	return fmt.Sprintf("%s.%d", c.cfg.Path, index)
+}
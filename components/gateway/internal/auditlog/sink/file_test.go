@@ -0,0 +1,110 @@
+package sink_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileClient_Log(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "audit", "auditlog.json")
+	client, err := sink.NewFileClient(sink.FileConfig{Path: path, MaxSize: 1 << 20, MaxBackups: 1})
+	require.NoError(t, err)
+
+	// WHEN
+	require.NoError(t, client.LogConfigurationChange(context.TODO(), fixConfigChange(true)))
+	require.NoError(t, client.LogSecurityEvent(context.TODO(), fixSecurityEvent()))
+	require.NoError(t, client.Close())
+
+	// THEN
+	lines := readLines(t, path)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "configuration_change", lines[0]["type"])
+	assert.Equal(t, testUUID, lines[0]["uuid"])
+	assert.Equal(t, testTime, lines[0]["time"])
+	assert.Equal(t, testTenant, lines[0]["tenant"])
+	assert.Equal(t, testUser, lines[0]["user"])
+	assert.Equal(t, map[string]interface{}{
+		"object": map[string]interface{}{
+			"id":   map[string]interface{}{"name": "Config Change", "consumerID": "consumer"},
+			"type": "graphql",
+		},
+		"attributes": []interface{}{map[string]interface{}{"name": "request", "old": "", "new": "mutation { a=b|c }"}},
+		"success":    true,
+	}, lines[0]["event"])
+
+	assert.Equal(t, "security_event", lines[1]["type"])
+	assert.Equal(t, map[string]interface{}{
+		"ip":   "10.0.0.1",
+		"data": map[string]interface{}{"reason": "insufficient scopes"},
+	}, lines[1]["event"])
+}
+
+func TestFileClient_Rotate(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "auditlog.json")
+	client, err := sink.NewFileClient(sink.FileConfig{Path: path, MaxSize: 100, MaxBackups: 2})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// WHEN
+	for i := 0; i < 4; i++ {
+		require.NoError(t, client.LogSecurityEvent(context.TODO(), fixSecurityEvent()))
+	}
+
+	// THEN
+	files, err := filepath.Glob(path + "*")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{path, path + ".1", path + ".2"}, files)
+	for _, f := range files {
+		assert.Len(t, readLines(t, f), 1)
+	}
+}
+
+func TestFileClient_AppendsToExistingFile(t *testing.T) {
+	// GIVEN
+	path := filepath.Join(t.TempDir(), "auditlog.json")
+	cfg := sink.FileConfig{Path: path, MaxSize: 1 << 20}
+	client, err := sink.NewFileClient(cfg)
+	require.NoError(t, err)
+	require.NoError(t, client.LogSecurityEvent(context.TODO(), fixSecurityEvent()))
+	require.NoError(t, client.Close())
+
+	// WHEN
+	client, err = sink.NewFileClient(cfg)
+	require.NoError(t, err)
+	require.NoError(t, client.LogSecurityEvent(context.TODO(), fixSecurityEvent()))
+	require.NoError(t, client.Close())
+
+	// THEN
+	assert.Len(t, readLines(t, path), 2)
+	err = client.LogSecurityEvent(context.TODO(), fixSecurityEvent())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "audit log file is closed")
+}
+
+func readLines(t *testing.T, path string) []map[string]interface{} {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	lines := make([]map[string]interface{}, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
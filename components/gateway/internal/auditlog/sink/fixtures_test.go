@@ -0,0 +1,45 @@
+package sink_test
+
+import (
+	"net"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+)
+
+const (
+	testUUID   = "1ee2b1b5-4b5e-4b55-9c6d-2a2f5bf4b5e1"
+	testTenant = "tenant"
+	testUser   = "proxy"
+	testTime   = "2022-02-01T10:11:12.123Z"
+)
+
+func fixConfigChange(success bool) model.ConfigurationChange {
+	return model.ConfigurationChange{
+		User: testUser,
+		Object: model.Object{
+			ID:   map[string]string{"name": "Config Change", "consumerID": "consumer"},
+			Type: "graphql",
+		},
+		Attributes: []model.Attribute{{Name: "request", New: "mutation { a=b|c }"}},
+		Success:    &success,
+		Metadata: model.Metadata{
+			Time:   testTime,
+			Tenant: testTenant,
+			UUID:   testUUID,
+		},
+	}
+}
+
+func fixSecurityEvent() model.SecurityEvent {
+	ip := net.ParseIP("10.0.0.1")
+	return model.SecurityEvent{
+		User: testUser,
+		IP:   &ip,
+		Data: `{"reason":"insufficient scopes"}`,
+		Metadata: model.Metadata{
+			Time:   testTime,
+			Tenant: testTenant,
+			UUID:   testUUID,
+		},
+	}
+}
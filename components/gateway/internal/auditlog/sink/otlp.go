@@ -0,0 +1,223 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/httpcommon"
+	"github.com/pkg/errors"
+)
+
+const (
+	otlpScopeName = "compass-gateway-auditlog"
+
+	otlpSeverityInfo = 9
+	otlpSeverityWarn = 13
+
+	configChangeEventName  = "compass.auditlog.configuration_change"
+	securityEventEventName = "compass.auditlog.security_event"
+)
+
+//go:generate mockery --name=HttpClient --output=automock --outpkg=automock --case=underscore --disable-version-string
+type HttpClient interface {
+	Do(request *http.Request) (*http.Response, error)
+}
+
+// OTLPClient exports audit log messages as OpenTelemetry log records using the OTLP/HTTP protocol with JSON encoding
+type OTLPClient struct {
+	httpClient  HttpClient
+	endpoint    string
+	headers     map[string]string
+	serviceName string
+}
+
+// NewOTLPClient creates an OpenTelemetry logs sink which sends the records to the configured endpoint, e.g. http://collector:4318/v1/logs
+func NewOTLPClient(cfg OTLPConfig, httpClient HttpClient) (*OTLPClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "while validating OTLP configuration")
+	}
+
+	headers, err := parseHeaders(cfg.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OTLPClient{
+		httpClient:  httpClient,
+		endpoint:    cfg.Endpoint,
+		headers:     headers,
+		serviceName: cfg.ServiceName,
+	}, nil
+}
+
+// LogConfigurationChange exports the configuration change as an INFO log record
+func (c *OTLPClient) LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error {
+	body, err := json.Marshal(change)
+	if err != nil {
+		return errors.Wrap(err, "while marshaling configuration change")
+	}
+
+	attributes := c.metadataAttributes(configChangeEventName, change.Metadata, change.User)
+	if change.Object.Type != "" {
+		attributes = append(attributes, stringAttribute("compass.auditlog.object.type", change.Object.Type))
+	}
+	if change.Success != nil {
+		attributes = append(attributes, otlpKeyValue{Key: "compass.auditlog.success", Value: otlpAnyValue{BoolValue: change.Success}})
+	}
+
+	return c.export(ctx, c.logRecord(change.Metadata, otlpSeverityInfo, "INFO", string(body), attributes))
+}
+
+// LogSecurityEvent exports the security event as a WARN log record
+func (c *OTLPClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	attributes := c.metadataAttributes(securityEventEventName, event.Metadata, event.User)
+	if event.IP != nil {
+		attributes = append(attributes, stringAttribute("client.address", event.IP.String()))
+	}
+
+	return c.export(ctx, c.logRecord(event.Metadata, otlpSeverityWarn, "WARN", event.Data, attributes))
+}
+
+func (c *OTLPClient) metadataAttributes(eventName string, metadata model.Metadata, user string) []otlpKeyValue {
+	attributes := []otlpKeyValue{stringAttribute("event.name", eventName)}
+	if metadata.UUID != "" {
+		attributes = append(attributes, stringAttribute("log.record.uid", metadata.UUID))
+	}
+	if user != "" {
+		attributes = append(attributes, stringAttribute("enduser.id", user))
+	}
+	if metadata.Tenant != "" {
+		attributes = append(attributes, stringAttribute("compass.tenant", metadata.Tenant))
+	}
+
+	return attributes
+}
+
+func (c *OTLPClient) logRecord(metadata model.Metadata, severity int, severityText, body string, attributes []otlpKeyValue) otlpLogRecord {
+	now := time.Now()
+	eventTime, err := time.Parse(model.LogFormatDate, metadata.Time)
+	if err != nil {
+		eventTime = now
+	}
+
+	return otlpLogRecord{
+		TimeUnixNano:         strconv.FormatInt(eventTime.UnixNano(), 10),
+		ObservedTimeUnixNano: strconv.FormatInt(now.UnixNano(), 10),
+		SeverityNumber:       severity,
+		SeverityText:         severityText,
+		Body:                 otlpAnyValue{StringValue: &body},
+		Attributes:           attributes,
+	}
+}
+
+func (c *OTLPClient) export(ctx context.Context, record otlpLogRecord) error {
+	payload, err := json.Marshal(otlpExportRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: otlpResource{Attributes: []otlpKeyValue{stringAttribute("service.name", c.serviceName)}},
+			ScopeLogs: []otlpScopeLogs{{
+				Scope:      otlpScope{Name: otlpScopeName},
+				LogRecords: []otlpLogRecord{record},
+			}},
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "while marshaling OTLP export request")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "while creating OTLP export request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range c.headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "while sending OTLP export request to %s", c.endpoint)
+	}
+	defer httpcommon.CloseBody(ctx, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return errors.Wrapf(err, "while reading OTLP export response with status code %d", response.StatusCode)
+		}
+		return errors.Errorf("OTLP export failed with status code %d: %s", response.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// parseHeaders parses headers in the format used by OTEL_EXPORTER_OTLP_HEADERS, e.g. "key1=value1,key2=value2"
+func parseHeaders(raw string) (map[string]string, error) {
+	headers := make(map[string]string)
+	if strings.TrimSpace(raw) == "" {
+		return headers, nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, errors.Errorf("invalid OTLP header %q, expected key=value", pair)
+		}
+		headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+
+	return headers, nil
+}
+
+func stringAttribute(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &value}}
+}
+
+// The types below mirror the JSON encoding of the OTLP logs ExportLogsServiceRequest message
+
+type otlpExportRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
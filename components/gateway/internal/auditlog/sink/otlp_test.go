@@ -0,0 +1,193 @@
+package sink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testOTLPEndpoint = "http://collector:4318/v1/logs"
+
+func TestOTLPClient_LogConfigurationChange(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		var request *http.Request
+		httpClient := &automock.HttpClient{}
+		httpClient.On("Do", mock.Anything).Run(func(args mock.Arguments) {
+			request = args.Get(0).(*http.Request)
+		}).Return(fixHTTPResponse(http.StatusOK, "{}"), nil).Once()
+
+		client, err := sink.NewOTLPClient(fixOTLPConfig("Authorization=Bearer token"), httpClient)
+		require.NoError(t, err)
+
+		// WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixConfigChange(true))
+
+		// THEN
+		require.NoError(t, err)
+		httpClient.AssertExpectations(t)
+
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, testOTLPEndpoint, request.URL.String())
+		assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+
+		record := decodeOTLPRecord(t, request)
+		assert.Equal(t, "1643710272123000000", record["timeUnixNano"])
+		assert.Equal(t, float64(9), record["severityNumber"])
+		assert.Equal(t, "INFO", record["severityText"])
+		assert.Contains(t, record["body"].(map[string]interface{})["stringValue"], `"uuid":"`+testUUID+`"`)
+		assert.Equal(t, map[string]interface{}{
+			"event.name":                   "compass.auditlog.configuration_change",
+			"log.record.uid":               testUUID,
+			"enduser.id":                   testUser,
+			"compass.tenant":               testTenant,
+			"compass.auditlog.object.type": "graphql",
+			"compass.auditlog.success":     true,
+		}, attributes(record))
+	})
+
+	t.Run("Error when collector rejects the request", func(t *testing.T) {
+		// GIVEN
+		httpClient := &automock.HttpClient{}
+		httpClient.On("Do", mock.Anything).Return(fixHTTPResponse(http.StatusBadRequest, "bad request"), nil).Once()
+
+		client, err := sink.NewOTLPClient(fixOTLPConfig(""), httpClient)
+		require.NoError(t, err)
+
+		// WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixConfigChange(true))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OTLP export failed with status code 400: bad request")
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("Error when request fails", func(t *testing.T) {
+		// GIVEN
+		httpClient := &automock.HttpClient{}
+		httpClient.On("Do", mock.Anything).Return(nil, errors.New("test err")).Once()
+
+		client, err := sink.NewOTLPClient(fixOTLPConfig(""), httpClient)
+		require.NoError(t, err)
+
+		// WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixConfigChange(true))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "test err")
+		httpClient.AssertExpectations(t)
+	})
+}
+
+func TestOTLPClient_LogSecurityEvent(t *testing.T) {
+	// GIVEN
+	var request *http.Request
+	httpClient := &automock.HttpClient{}
+	httpClient.On("Do", mock.Anything).Run(func(args mock.Arguments) {
+		request = args.Get(0).(*http.Request)
+	}).Return(fixHTTPResponse(http.StatusOK, "{}"), nil).Once()
+
+	client, err := sink.NewOTLPClient(fixOTLPConfig(""), httpClient)
+	require.NoError(t, err)
+
+	// WHEN
+	err = client.LogSecurityEvent(context.TODO(), fixSecurityEvent())
+
+	// THEN
+	require.NoError(t, err)
+	httpClient.AssertExpectations(t)
+
+	record := decodeOTLPRecord(t, request)
+	assert.Equal(t, float64(13), record["severityNumber"])
+	assert.Equal(t, "WARN", record["severityText"])
+	assert.Equal(t, `{"reason":"insufficient scopes"}`, record["body"].(map[string]interface{})["stringValue"])
+	assert.Equal(t, map[string]interface{}{
+		"event.name":     "compass.auditlog.security_event",
+		"log.record.uid": testUUID,
+		"enduser.id":     testUser,
+		"compass.tenant": testTenant,
+		"client.address": "10.0.0.1",
+	}, attributes(record))
+}
+
+func TestNewOTLPClient(t *testing.T) {
+	t.Run("Error for invalid headers", func(t *testing.T) {
+		_, err := sink.NewOTLPClient(fixOTLPConfig("Authorization"), &automock.HttpClient{})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid OTLP header "Authorization"`)
+	})
+
+	t.Run("Error for missing endpoint", func(t *testing.T) {
+		_, err := sink.NewOTLPClient(sink.OTLPConfig{}, &automock.HttpClient{})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OTLP endpoint must not be empty")
+	})
+}
+
+func fixOTLPConfig(headers string) sink.OTLPConfig {
+	return sink.OTLPConfig{
+		Endpoint:    testOTLPEndpoint,
+		Headers:     headers,
+		ServiceName: "compass-gateway",
+		Timeout:     time.Second,
+	}
+}
+
+func fixHTTPResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func decodeOTLPRecord(t *testing.T, request *http.Request) map[string]interface{} {
+	var payload struct {
+		ResourceLogs []struct {
+			Resource struct {
+				Attributes []map[string]interface{} `json:"attributes"`
+			} `json:"resource"`
+			ScopeLogs []struct {
+				Scope struct {
+					Name string `json:"name"`
+				} `json:"scope"`
+				LogRecords []map[string]interface{} `json:"logRecords"`
+			} `json:"scopeLogs"`
+		} `json:"resourceLogs"`
+	}
+	require.NoError(t, json.NewDecoder(request.Body).Decode(&payload))
+
+	require.Len(t, payload.ResourceLogs, 1)
+	assert.Equal(t, "service.name", payload.ResourceLogs[0].Resource.Attributes[0]["key"])
+	require.Len(t, payload.ResourceLogs[0].ScopeLogs, 1)
+	assert.Equal(t, "compass-gateway-auditlog", payload.ResourceLogs[0].ScopeLogs[0].Scope.Name)
+	require.Len(t, payload.ResourceLogs[0].ScopeLogs[0].LogRecords, 1)
+
+	return payload.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+}
+
+func attributes(record map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, attr := range record["attributes"].([]interface{}) {
+		kv := attr.(map[string]interface{})
+		for _, value := range kv["value"].(map[string]interface{}) {
+			result[kv["key"].(string)] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,177 @@
+package sink
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	networkUDP = "udp"
+	networkTCP = "tcp"
+	networkTLS = "tcp+tls"
+
+	syslogSeverityWarning = 4
+	syslogSeverityNotice  = 5
+
+	syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+	syslogNilValue   = "-"
+)
+
+// SyslogClient writes audit log messages in CEF format to a syslog server, framed as described in RFC 5424.
+// Messages sent over TCP use octet counting as described in RFC 6587.
+type SyslogClient struct {
+	cfg       SyslogConfig
+	formatter cefFormatter
+	hostname  string
+	procID    string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslogClient creates a syslog sink. The connection is established with the first message.
+func NewSyslogClient(cfg SyslogConfig) (*SyslogClient, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "while validating syslog configuration")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = syslogNilValue
+	}
+
+	return &SyslogClient{
+		cfg: cfg,
+		formatter: cefFormatter{
+			vendor:  cfg.CEFVendor,
+			product: cfg.CEFProduct,
+			version: cfg.CEFVersion,
+		},
+		hostname: hostname,
+		procID:   fmt.Sprintf("%d", os.Getpid()),
+	}, nil
+}
+
+// LogConfigurationChange writes the configuration change as a CEF record with notice severity
+func (c *SyslogClient) LogConfigurationChange(ctx context.Context, change model.ConfigurationChange) error {
+	msg, err := c.formatter.configurationChange(change)
+	if err != nil {
+		return errors.Wrap(err, "while formatting configuration change")
+	}
+
+	return c.write(ctx, syslogSeverityNotice, cefConfigChangeSignature, msg)
+}
+
+// LogSecurityEvent writes the security event as a CEF record with warning severity
+func (c *SyslogClient) LogSecurityEvent(ctx context.Context, event model.SecurityEvent) error {
+	return c.write(ctx, syslogSeverityWarning, cefSecurityEventSignature, c.formatter.securityEvent(event))
+}
+
+// Close closes the connection to the syslog server
+func (c *SyslogClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.closeConn()
+}
+
+func (c *SyslogClient) write(ctx context.Context, severity int, msgID, msg string) error {
+	line := fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		c.cfg.Facility*8+severity,
+		time.Now().UTC().Format(syslogTimeFormat),
+		c.hostname,
+		c.cfg.AppName,
+		c.procID,
+		msgID,
+		msg)
+	if c.cfg.Network != networkUDP {
+		line = fmt.Sprintf("%d %s", len(line), line)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// A stream connection may have been closed by the server since the last message, so the write is repeated once
+	// on a new connection before the error is reported.
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if err = c.connect(ctx); err != nil {
+			return errors.Wrapf(err, "while connecting to syslog server %s", c.cfg.Address)
+		}
+
+		if err = c.conn.SetWriteDeadline(time.Now().Add(c.cfg.Timeout)); err == nil {
+			if _, err = c.conn.Write([]byte(line)); err == nil {
+				return nil
+			}
+		}
+
+		_ = c.closeConn()
+	}
+
+	return errors.Wrapf(err, "while writing to syslog server %s", c.cfg.Address)
+}
+
+func (c *SyslogClient) connect(ctx context.Context) error {
+	if c.conn != nil {
+		if c.cfg.Network == networkUDP || c.isAlive() {
+			return nil
+		}
+		_ = c.closeConn()
+	}
+
+	dialer := &net.Dialer{Timeout: c.cfg.Timeout}
+
+	var conn net.Conn
+	var err error
+	switch c.cfg.Network {
+	case networkTLS:
+		tlsDialer := &tls.Dialer{
+			NetDialer: dialer,
+			Config: &tls.Config{
+				InsecureSkipVerify: c.cfg.SkipSSLValidation,
+			},
+		}
+		conn, err = tlsDialer.DialContext(ctx, networkTCP, c.cfg.Address)
+	default:
+		conn, err = dialer.DialContext(ctx, c.cfg.Network, c.cfg.Address)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.conn = conn
+	return nil
+}
+
+// isAlive checks whether the server closed the stream connection. Syslog servers do not send any data, so a read
+// ending with anything other than a timeout means that the connection can no longer be used. Without the check the
+// first write after the server closed the connection succeeds locally and the message is lost.
+func (c *SyslogClient) isAlive() bool {
+	if err := c.conn.SetReadDeadline(time.Now().Add(time.Millisecond)); err != nil {
+		return false
+	}
+
+	_, err := c.conn.Read(make([]byte, 1))
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return true
+	}
+	return err == nil
+}
+
+func (c *SyslogClient) closeConn() error {
+	if c.conn == nil {
+		return nil
+	}
+
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
@@ -0,0 +1,150 @@
+package sink_test
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/sink"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogClient_LogConfigurationChange(t *testing.T) {
+	t.Run("Writes CEF record over UDP", func(t *testing.T) {
+		// GIVEN
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+
+		client, err := sink.NewSyslogClient(fixSyslogConfig("udp", conn.LocalAddr().String()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		// WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixConfigChange(true))
+
+		// THEN
+		require.NoError(t, err)
+
+		buf := make([]byte, 4096)
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+
+		msg := string(buf[:n])
+		assert.True(t, strings.HasPrefix(msg, "<109>1 "), msg)
+		assert.Contains(t, msg, " compass-gateway ")
+		assert.Contains(t, msg, " configuration-change - CEF:0|Vendor|Product\\|Name|1.0|configuration-change|Configuration change|3|")
+		assert.Contains(t, msg, "rt=1643710272123 externalId="+testUUID+" suser=proxy cs1Label=tenant cs1=tenant outcome=success")
+		assert.Contains(t, msg, "cs2Label=objectType cs2=graphql")
+		assert.Contains(t, msg, `cs3={"consumerID":"consumer","name":"Config Change"}`)
+		assert.Contains(t, msg, `cs4=[{"name":"request","old":"","new":"mutation { a\=b|c }"}]`)
+	})
+
+	t.Run("Writes octet counted CEF record over TCP and reconnects", func(t *testing.T) {
+		// GIVEN
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		messages := make(chan string, 2)
+		go func() {
+			for i := 0; i < 2; i++ {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				msg := readOctetCountedFrame(t, conn)
+				_ = conn.Close()
+				messages <- msg
+			}
+		}()
+
+		client, err := sink.NewSyslogClient(fixSyslogConfig("tcp", listener.Addr().String()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		// WHEN
+		err = client.LogConfigurationChange(context.TODO(), fixConfigChange(false))
+		require.NoError(t, err)
+		first := receive(t, messages)
+
+		err = client.LogSecurityEvent(context.TODO(), fixSecurityEvent())
+		require.NoError(t, err)
+		second := receive(t, messages)
+
+		// THEN
+		assert.Contains(t, first, "|configuration-change|Configuration change|5|")
+		assert.Contains(t, first, "outcome=failure")
+		assert.True(t, strings.HasPrefix(second, "<108>1 "), second)
+		assert.Contains(t, second, "|security-event|Security event|7|")
+		assert.Contains(t, second, `src=10.0.0.1 msg={"reason":"insufficient scopes"}`)
+	})
+}
+
+func TestNewSyslogClient(t *testing.T) {
+	t.Run("Error for invalid network", func(t *testing.T) {
+		_, err := sink.NewSyslogClient(fixSyslogConfig("unix", "127.0.0.1:514"))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid syslog network "unix"`)
+	})
+
+	t.Run("Error for missing address", func(t *testing.T) {
+		_, err := sink.NewSyslogClient(fixSyslogConfig("udp", ""))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "syslog address must not be empty")
+	})
+}
+
+func fixSyslogConfig(network, address string) sink.SyslogConfig {
+	return sink.SyslogConfig{
+		Network:    network,
+		Address:    address,
+		Facility:   13,
+		AppName:    "compass-gateway",
+		Timeout:    5 * time.Second,
+		CEFVendor:  "Vendor",
+		CEFProduct: "Product|Name",
+		CEFVersion: "1.0",
+	}
+}
+
+func readOctetCountedFrame(t *testing.T, conn net.Conn) string {
+	reader := bufio.NewReader(conn)
+	length, err := reader.ReadString(' ')
+	if err != nil {
+		t.Errorf("while reading frame length: %v", err)
+		return ""
+	}
+
+	size, err := strconv.Atoi(strings.TrimSpace(length))
+	if err != nil {
+		t.Errorf("while parsing frame length: %v", err)
+		return ""
+	}
+
+	frame := make([]byte, size)
+	if _, err := io.ReadFull(reader, frame); err != nil {
+		t.Errorf("while reading frame: %v", err)
+		return ""
+	}
+	return string(frame)
+}
+
+func receive(t *testing.T, messages chan string) string {
+	select {
+	case msg := <-messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout while waiting for syslog message")
+		return ""
+	}
+}
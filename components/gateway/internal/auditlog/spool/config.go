@@ -0,0 +1,61 @@
+package spool
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SyncPolicy defines when the spool flushes written records to the disk
+type SyncPolicy string
+
+const (
+	// SyncAlways flushes every record before it is acknowledged to the caller
+	SyncAlways SyncPolicy = "always"
+	// SyncInterval flushes the written records periodically
+	SyncInterval SyncPolicy = "interval"
+	// SyncNone leaves flushing to the operating system
+	SyncNone SyncPolicy = "none"
+)
+
+// Config configures the persistent audit log spool. The spool is disabled if Dir is empty.
+type Config struct {
+	Dir           string        `envconfig:"optional,APP_AUDITLOG_SPOOL_DIR"`
+	SegmentSize   int64         `envconfig:"default=16777216,APP_AUDITLOG_SPOOL_SEGMENT_SIZE"`
+	MaxSize       int64         `envconfig:"default=1073741824,APP_AUDITLOG_SPOOL_MAX_SIZE"`
+	SyncPolicy    SyncPolicy    `envconfig:"default=always,APP_AUDITLOG_SPOOL_SYNC_POLICY"`
+	SyncInterval  time.Duration `envconfig:"default=1s,APP_AUDITLOG_SPOOL_SYNC_INTERVAL"`
+	RetryInterval time.Duration `envconfig:"default=10s,APP_AUDITLOG_SPOOL_RETRY_INTERVAL"`
+}
+
+// Enabled returns whether a spool directory is configured
+func (c Config) Enabled() bool {
+	return c.Dir != ""
+}
+
+// Validate checks that the configuration can be used to open a spool
+func (c Config) Validate() error {
+	switch c.SyncPolicy {
+	case SyncAlways, SyncNone:
+	case SyncInterval:
+		if c.SyncInterval <= 0 {
+			return errors.New("sync interval must be positive")
+		}
+	default:
+		return errors.Errorf("invalid sync policy %q", c.SyncPolicy)
+	}
+
+	if c.SegmentSize <= 0 {
+		return errors.New("segment size must be positive")
+	}
+
+	if c.MaxSize < c.SegmentSize {
+		return errors.New("max size must not be lower than the segment size")
+	}
+
+	if c.RetryInterval <= 0 {
+		return errors.New("retry interval must be positive")
+	}
+
+	return nil
+}
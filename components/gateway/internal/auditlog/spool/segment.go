@@ -0,0 +1,156 @@
+package spool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+const (
+	segmentFileExt   = ".wal"
+	recordHeaderSize = 8
+)
+
+type recordType string
+
+const (
+	messageRecord recordType = "message"
+	ackRecord     recordType = "ack"
+)
+
+// record is a single entry of the write-ahead log. Every message appended to the spool is stored as a message record
+// and every delivered message is marked by an ack record with the same ID.
+type record struct {
+	Type      recordType             `json:"type"`
+	ID        string                 `json:"id"`
+	Timestamp time.Time              `json:"timestamp,omitempty"`
+	Message   *proxy.AuditlogMessage `json:"message,omitempty"`
+}
+
+// segment is a single file of the write-ahead log
+type segment struct {
+	index   uint64
+	size    int64
+	ids     []string
+	pending int
+}
+
+func segmentFileName(index uint64) string {
+	return fmt.Sprintf("%020d%s", index, segmentFileExt)
+}
+
+// listSegments returns the indexes of the segment files in dir in ascending order
+func listSegments(dir string) ([]uint64, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading spool directory %s", dir)
+	}
+
+	indexes := make([]uint64, 0, len(files))
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), segmentFileExt) {
+			continue
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(f.Name(), segmentFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes, nil
+}
+
+// encodeRecord frames a record as a big-endian payload length, a CRC-32 checksum of the payload and the JSON payload itself
+func encodeRecord(rec record) ([]byte, error) {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling spool record")
+	}
+
+	buf := make([]byte, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	copy(buf[recordHeaderSize:], payload)
+
+	return buf, nil
+}
+
+// readSegment reads the records of a segment file. Reading stops at the first incomplete or corrupted record, which is
+// what a crash in the middle of a write leaves behind. The returned size is the length of the valid part of the file.
+func readSegment(path string, maxRecordSize int64) ([]record, int64, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, false, errors.Wrapf(err, "while opening spool segment %s", path)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	reader := bufio.NewReader(f)
+	header := make([]byte, recordHeaderSize)
+	records := make([]record, 0)
+	var size int64
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF {
+				return records, size, false, nil
+			}
+			if err == io.ErrUnexpectedEOF {
+				return records, size, true, nil
+			}
+			return nil, 0, false, errors.Wrapf(err, "while reading spool segment %s", path)
+		}
+
+		length := int64(binary.BigEndian.Uint32(header[0:4]))
+		if length > maxRecordSize {
+			return records, size, true, nil
+		}
+
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(reader, payload); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return records, size, true, nil
+			}
+			return nil, 0, false, errors.Wrapf(err, "while reading spool segment %s", path)
+		}
+
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+			return records, size, true, nil
+		}
+
+		var rec record
+		if err := json.Unmarshal(payload, &rec); err != nil {
+			return records, size, true, nil
+		}
+
+		records = append(records, rec)
+		size += recordHeaderSize + length
+	}
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(filepath.Clean(dir))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = d.Close()
+	}()
+
+	return d.Sync()
+}
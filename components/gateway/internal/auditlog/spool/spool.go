@@ -0,0 +1,494 @@
+package spool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+// ErrFull is returned when a message does not fit in the configured maximum size of the spool
+var ErrFull = errors.New("audit log spool is full")
+
+// ErrClosed is returned when the spool is used after it has been closed
+var ErrClosed = errors.New("audit log spool is closed")
+
+//go:generate mockery --name=UUIDService --output=automock --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=MetricCollector --output=automock --outpkg=automock --case=underscore --disable-version-string
+type MetricCollector interface {
+	SetSpoolBacklog(depth int, age time.Duration, sizeBytes int64)
+}
+
+type entry struct {
+	msg      proxy.AuditlogMessage
+	segment  uint64
+	enqueued time.Time
+	retryAt  time.Time
+	acked    bool
+}
+
+// Spool is a persistent, segment-based write-ahead log of audit log messages. Messages are written to the disk before
+// Log returns and are handed out to the workers until they are acknowledged, which gives at-least-once delivery across
+// gateway restarts. Messages are deduplicated by their ID.
+type Spool struct {
+	cfg       Config
+	uuidSvc   UUIDService
+	collector MetricCollector
+	now       func() time.Time
+
+	mu        sync.Mutex
+	notify    chan struct{}
+	segments  []*segment
+	active    *os.File
+	size      int64
+	dirty     bool
+	closed    bool
+	entries   map[string]*entry
+	delivered map[string]uint64
+	order     []*entry
+	ready     []*entry
+	retries   []*entry
+}
+
+// Open opens the spool in the configured directory and replays the messages which have not been acknowledged yet
+func Open(ctx context.Context, cfg Config, uuidSvc UUIDService, collector MetricCollector) (*Spool, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "while validating spool configuration")
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "while creating spool directory %s", cfg.Dir)
+	}
+
+	s := &Spool{
+		cfg:       cfg,
+		uuidSvc:   uuidSvc,
+		collector: collector,
+		now:       time.Now,
+		notify:    make(chan struct{}, 1),
+		entries:   make(map[string]*entry),
+		delivered: make(map[string]uint64),
+	}
+
+	if err := s.replay(ctx); err != nil {
+		return nil, err
+	}
+
+	var next uint64
+	if len(s.segments) > 0 {
+		next = s.segments[len(s.segments)-1].index + 1
+	}
+	if err := s.createSegment(next); err != nil {
+		return nil, err
+	}
+
+	s.removeDeliveredSegments(ctx)
+	s.updateMetrics()
+
+	log.C(ctx).Infof("Audit log spool opened in %s with %d pending messages", cfg.Dir, len(s.entries))
+	return s, nil
+}
+
+// Start flushes the spool periodically if the interval sync policy is used and keeps the backlog metrics up to date.
+// The spool is closed when the context is done.
+func (s *Spool) Start(ctx context.Context) {
+	interval := s.cfg.SyncInterval
+	if s.cfg.SyncPolicy != SyncInterval || interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.Close(); err != nil {
+				log.C(ctx).WithError(err).Error("An error has occurred while closing the audit log spool")
+			}
+			log.C(ctx).Infoln("Audit log spool has been closed")
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			if s.cfg.SyncPolicy == SyncInterval && s.dirty && !s.closed {
+				if err := s.active.Sync(); err != nil {
+					log.C(ctx).WithError(err).Error("An error has occurred while flushing the audit log spool")
+				} else {
+					s.dirty = false
+				}
+			}
+			s.updateMetrics()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// Log persists the message in the spool. A message whose ID is already pending or has been delivered is skipped.
+func (s *Spool) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
+	if msg.ID == "" {
+		msg.ID = s.uuidSvc.Generate()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	if _, exists := s.entries[msg.ID]; exists {
+		log.C(ctx).Debugf("Audit log message with ID %s is already in the spool", msg.ID)
+		return nil
+	}
+	if _, exists := s.delivered[msg.ID]; exists {
+		log.C(ctx).Debugf("Audit log message with ID %s has already been delivered", msg.ID)
+		return nil
+	}
+
+	now := s.now()
+	data, err := encodeRecord(record{Type: messageRecord, ID: msg.ID, Timestamp: now, Message: &msg})
+	if err != nil {
+		return err
+	}
+
+	if s.size+int64(len(data)) > s.cfg.MaxSize {
+		return ErrFull
+	}
+
+	if err := s.write(data); err != nil {
+		return errors.Wrapf(err, "while writing audit log message with ID %s to the spool", msg.ID)
+	}
+
+	active := s.segments[len(s.segments)-1]
+	active.ids = append(active.ids, msg.ID)
+	active.pending++
+
+	e := &entry{msg: msg, segment: active.index, enqueued: now}
+	s.entries[msg.ID] = e
+	s.order = append(s.order, e)
+	s.ready = append(s.ready, e)
+	s.signal()
+	s.updateMetrics()
+
+	log.C(ctx).Debugf("Successfully registered auditlog message with ID %s in the spool (pending=%d)", msg.ID, len(s.entries))
+	return nil
+}
+
+// Dequeue blocks until a message is ready to be delivered or the context is done. Messages which failed to be
+// delivered are handed out again once the retry interval passes.
+func (s *Spool) Dequeue(ctx context.Context) (proxy.AuditlogMessage, error) {
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return proxy.AuditlogMessage{}, ErrClosed
+		}
+
+		now := s.now()
+		var e *entry
+		var wait time.Duration
+		switch {
+		case len(s.retries) > 0 && !s.retries[0].retryAt.After(now):
+			e, s.retries = s.retries[0], s.retries[1:]
+		case len(s.ready) > 0:
+			e, s.ready = s.ready[0], s.ready[1:]
+		case len(s.retries) > 0:
+			wait = s.retries[0].retryAt.Sub(now)
+		}
+
+		if e != nil {
+			if len(s.ready) > 0 || len(s.retries) > 0 {
+				s.signal()
+			}
+			s.mu.Unlock()
+			return e.msg, nil
+		}
+		s.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			return proxy.AuditlogMessage{}, ctx.Err()
+		case <-s.notify:
+		case <-timeout:
+		}
+		stopTimer(timer)
+	}
+}
+
+// Ack marks the message as delivered. Segments whose messages have all been delivered are removed.
+func (s *Spool) Ack(ctx context.Context, msg proxy.AuditlogMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	e, exists := s.entries[msg.ID]
+	if !exists {
+		return nil
+	}
+
+	data, err := encodeRecord(record{Type: ackRecord, ID: msg.ID, Timestamp: s.now()})
+	if err != nil {
+		return err
+	}
+
+	if err := s.write(data); err != nil {
+		return errors.Wrapf(err, "while writing acknowledgement of audit log message with ID %s to the spool", msg.ID)
+	}
+
+	s.markDelivered(e)
+	s.removeDeliveredSegments(ctx)
+	s.updateMetrics()
+
+	return nil
+}
+
+// Nack returns the message to the spool to be delivered again after the retry interval
+func (s *Spool) Nack(_ context.Context, msg proxy.AuditlogMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	e, exists := s.entries[msg.ID]
+	if !exists {
+		return nil
+	}
+
+	e.retryAt = s.now().Add(s.cfg.RetryInterval)
+	s.retries = append(s.retries, e)
+	s.signal()
+
+	return nil
+}
+
+// Close flushes and closes the active segment
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+
+	if err := s.active.Sync(); err != nil {
+		_ = s.active.Close()
+		return errors.Wrap(err, "while flushing the audit log spool")
+	}
+
+	return s.active.Close()
+}
+
+func (s *Spool) replay(ctx context.Context) error {
+	indexes, err := listSegments(s.cfg.Dir)
+	if err != nil {
+		return err
+	}
+
+	for _, index := range indexes {
+		path := filepath.Join(s.cfg.Dir, segmentFileName(index))
+		records, size, corrupted, err := readSegment(path, s.cfg.MaxSize)
+		if err != nil {
+			return err
+		}
+
+		if corrupted {
+			log.C(ctx).Warnf("Audit log spool segment %s ends with an incomplete record, truncating it to %d bytes", path, size)
+			if err := os.Truncate(path, size); err != nil {
+				return errors.Wrapf(err, "while truncating spool segment %s", path)
+			}
+		}
+
+		seg := &segment{index: index, size: size}
+		s.segments = append(s.segments, seg)
+		s.size += size
+
+		for _, rec := range records {
+			switch rec.Type {
+			case messageRecord:
+				if rec.Message == nil {
+					continue
+				}
+				if _, exists := s.entries[rec.ID]; exists {
+					continue
+				}
+				if _, exists := s.delivered[rec.ID]; exists {
+					continue
+				}
+
+				e := &entry{msg: *rec.Message, segment: index, enqueued: rec.Timestamp}
+				e.msg.ID = rec.ID
+				seg.ids = append(seg.ids, rec.ID)
+				seg.pending++
+				s.entries[rec.ID] = e
+				s.order = append(s.order, e)
+			case ackRecord:
+				if e, exists := s.entries[rec.ID]; exists {
+					s.markDelivered(e)
+				}
+			}
+		}
+	}
+
+	for _, e := range s.order {
+		if !e.acked {
+			s.ready = append(s.ready, e)
+		}
+	}
+
+	return nil
+}
+
+func (s *Spool) markDelivered(e *entry) {
+	e.acked = true
+	delete(s.entries, e.msg.ID)
+	s.delivered[e.msg.ID] = e.segment
+
+	for _, seg := range s.segments {
+		if seg.index == e.segment {
+			seg.pending--
+			break
+		}
+	}
+}
+
+// removeDeliveredSegments deletes the oldest segments as long as all of their messages have been delivered. Segments
+// are only removed in order, so that an ack record is never removed before the message record it refers to.
+func (s *Spool) removeDeliveredSegments(ctx context.Context) {
+	removed := false
+	for len(s.segments) > 1 && s.segments[0].pending == 0 {
+		seg := s.segments[0]
+		path := filepath.Join(s.cfg.Dir, segmentFileName(seg.index))
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while removing spool segment %s", path)
+			return
+		}
+
+		for _, id := range seg.ids {
+			if s.delivered[id] == seg.index {
+				delete(s.delivered, id)
+			}
+		}
+
+		s.size -= seg.size
+		s.segments = s.segments[1:]
+		removed = true
+	}
+
+	if removed && s.cfg.SyncPolicy != SyncNone {
+		if err := syncDir(s.cfg.Dir); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while flushing spool directory %s", s.cfg.Dir)
+		}
+	}
+}
+
+func (s *Spool) write(data []byte) error {
+	active := s.segments[len(s.segments)-1]
+	if active.size > 0 && active.size+int64(len(data)) > s.cfg.SegmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+		active = s.segments[len(s.segments)-1]
+	}
+
+	if _, err := s.active.Write(data); err != nil {
+		return err
+	}
+
+	active.size += int64(len(data))
+	s.size += int64(len(data))
+
+	switch s.cfg.SyncPolicy {
+	case SyncAlways:
+		return s.active.Sync()
+	case SyncInterval:
+		s.dirty = true
+	}
+
+	return nil
+}
+
+func (s *Spool) rotate() error {
+	if err := s.active.Sync(); err != nil {
+		return errors.Wrap(err, "while flushing the active spool segment")
+	}
+
+	if err := s.active.Close(); err != nil {
+		return errors.Wrap(err, "while closing the active spool segment")
+	}
+	s.dirty = false
+
+	return s.createSegment(s.segments[len(s.segments)-1].index + 1)
+}
+
+func (s *Spool) createSegment(index uint64) error {
+	path := filepath.Join(s.cfg.Dir, segmentFileName(index))
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_WRONLY|os.O_APPEND|os.O_EXCL, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while creating spool segment %s", path)
+	}
+
+	if s.cfg.SyncPolicy != SyncNone {
+		if err := syncDir(s.cfg.Dir); err != nil {
+			_ = f.Close()
+			return errors.Wrapf(err, "while flushing spool directory %s", s.cfg.Dir)
+		}
+	}
+
+	s.active = f
+	s.segments = append(s.segments, &segment{index: index})
+	return nil
+}
+
+// signal wakes up one of the goroutines waiting in Dequeue
+func (s *Spool) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Spool) updateMetrics() {
+	for len(s.order) > 0 && s.order[0].acked {
+		s.order = s.order[1:]
+	}
+
+	if s.collector == nil {
+		return
+	}
+
+	var age time.Duration
+	if len(s.order) > 0 {
+		age = s.now().Sub(s.order[0].enqueued)
+	}
+
+	s.collector.SetSpoolBacklog(len(s.entries), age, s.size)
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
@@ -0,0 +1,350 @@
+package spool_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/spool"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/spool/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpool_DeliversMessagesOnce(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	s, err := spool.Open(ctx, cfg, fixUUIDService("id-1"), nil)
+	require.NoError(t, err)
+
+	// WHEN
+	require.NoError(t, s.Log(ctx, fixMessage("")))
+	msg, err := s.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Ack(ctx, msg))
+	require.NoError(t, s.Close())
+
+	// THEN
+	assert.Equal(t, "id-1", msg.ID)
+	assert.Equal(t, "request", msg.Request)
+
+	reopened, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, reopened)
+	assertEmpty(t, reopened)
+}
+
+func TestSpool_ReplaysPendingMessagesOnOpen(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Log(ctx, fixMessage("delivered")))
+	require.NoError(t, s.Log(ctx, fixMessage("pending")))
+	require.NoError(t, s.Log(ctx, fixMessage("in-flight")))
+
+	msg, err := s.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Ack(ctx, msg))
+	_, err = s.Dequeue(ctx)
+	require.NoError(t, err)
+	_, err = s.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	// WHEN
+	reopened, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, reopened)
+
+	// THEN
+	first, err := reopened.Dequeue(ctx)
+	require.NoError(t, err)
+	second, err := reopened.Dequeue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", first.ID)
+	assert.Equal(t, "in-flight", second.ID)
+	assertEmpty(t, reopened)
+}
+
+func TestSpool_DeduplicatesMessagesByID(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, s)
+
+	// WHEN
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	msg, err := s.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	require.NoError(t, s.Ack(ctx, msg))
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+
+	// THEN
+	assert.Equal(t, "foo", msg.ID)
+	assertEmpty(t, s)
+}
+
+func TestSpool_RedeliversRejectedMessages(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	cfg.RetryInterval = 50 * time.Millisecond
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, s)
+
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	msg, err := s.Dequeue(ctx)
+	require.NoError(t, err)
+
+	// WHEN
+	rejectedAt := time.Now()
+	require.NoError(t, s.Nack(ctx, msg))
+	redelivered, err := s.Dequeue(ctx)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, msg, redelivered)
+	assert.GreaterOrEqual(t, time.Since(rejectedAt), cfg.RetryInterval)
+}
+
+func TestSpool_TruncatesIncompleteRecordOnOpen(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	require.NoError(t, s.Close())
+
+	segments, err := filepath.Glob(filepath.Join(cfg.Dir, "*.wal"))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	valid, err := os.Stat(segments[0])
+	require.NoError(t, err)
+	f, err := os.OpenFile(segments[0], os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 1, 0, 1, 2, 3})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// WHEN
+	reopened, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, reopened)
+
+	// THEN
+	msg, err := reopened.Dequeue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", msg.ID)
+	assertEmpty(t, reopened)
+
+	info, err := os.Stat(segments[0])
+	require.NoError(t, err)
+	assert.Equal(t, valid.Size(), info.Size())
+}
+
+func TestSpool_RemovesDeliveredSegments(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	cfg.SegmentSize = 256
+	cfg.MaxSize = 1 << 20
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, s)
+
+	ids := []string{"a", "b", "c", "d", "e"}
+	for _, id := range ids {
+		require.NoError(t, s.Log(ctx, fixMessage(id)))
+	}
+	segments, err := filepath.Glob(filepath.Join(cfg.Dir, "*.wal"))
+	require.NoError(t, err)
+	require.Greater(t, len(segments), 2)
+
+	// WHEN
+	for range ids {
+		msg, err := s.Dequeue(ctx)
+		require.NoError(t, err)
+		require.NoError(t, s.Ack(ctx, msg))
+	}
+
+	// THEN
+	segments, err = filepath.Glob(filepath.Join(cfg.Dir, "*.wal"))
+	require.NoError(t, err)
+	assert.Len(t, segments, 1)
+}
+
+func TestSpool_RejectsMessagesWhenFull(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	cfg := fixConfig(t)
+	cfg.SegmentSize = 256
+	cfg.MaxSize = 512
+	s, err := spool.Open(ctx, cfg, nil, nil)
+	require.NoError(t, err)
+	defer closeSpool(t, s)
+
+	// WHEN
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	err = s.Log(ctx, fixMessage("bar"))
+
+	// THEN
+	assert.Equal(t, spool.ErrFull, err)
+}
+
+func TestSpool_ReportsBacklogMetrics(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	collector := &automock.MetricCollector{}
+	collector.On("SetSpoolBacklog", 0, time.Duration(0), int64(0)).Return().Once()
+	collector.On("SetSpoolBacklog", 1, mock.AnythingOfType("time.Duration"), mock.MatchedBy(func(size int64) bool { return size > 0 })).Return().Once()
+	collector.On("SetSpoolBacklog", 0, time.Duration(0), mock.MatchedBy(func(size int64) bool { return size > 0 })).Return().Once()
+
+	s, err := spool.Open(ctx, fixConfig(t), nil, collector)
+	require.NoError(t, err)
+	defer closeSpool(t, s)
+
+	// WHEN
+	require.NoError(t, s.Log(ctx, fixMessage("foo")))
+	msg, err := s.Dequeue(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.Ack(ctx, msg))
+
+	// THEN
+	collector.AssertExpectations(t)
+}
+
+func TestSpool_Dequeue(t *testing.T) {
+	t.Run("Returns error when context is done", func(t *testing.T) {
+		// GIVEN
+		s, err := spool.Open(context.TODO(), fixConfig(t), nil, nil)
+		require.NoError(t, err)
+		defer closeSpool(t, s)
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		// WHEN
+		_, err = s.Dequeue(ctx)
+
+		// THEN
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("Returns error when spool is closed", func(t *testing.T) {
+		// GIVEN
+		s, err := spool.Open(context.TODO(), fixConfig(t), nil, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.Close())
+
+		// WHEN
+		_, err = s.Dequeue(context.TODO())
+
+		// THEN
+		assert.Equal(t, spool.ErrClosed, err)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Modify      func(cfg *spool.Config)
+		ExpectedErr string
+	}{
+		{
+			Name:   "Valid",
+			Modify: func(cfg *spool.Config) {},
+		},
+		{
+			Name:        "Invalid sync policy",
+			Modify:      func(cfg *spool.Config) { cfg.SyncPolicy = "sometimes" },
+			ExpectedErr: "invalid sync policy",
+		},
+		{
+			Name: "Missing sync interval",
+			Modify: func(cfg *spool.Config) {
+				cfg.SyncPolicy = spool.SyncInterval
+				cfg.SyncInterval = 0
+			},
+			ExpectedErr: "sync interval must be positive",
+		},
+		{
+			Name:        "Max size lower than segment size",
+			Modify:      func(cfg *spool.Config) { cfg.MaxSize = cfg.SegmentSize - 1 },
+			ExpectedErr: "max size must not be lower than the segment size",
+		},
+		{
+			Name:        "Missing retry interval",
+			Modify:      func(cfg *spool.Config) { cfg.RetryInterval = 0 },
+			ExpectedErr: "retry interval must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			cfg := fixConfig(t)
+			testCase.Modify(&cfg)
+
+			err := cfg.Validate()
+
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			}
+		})
+	}
+}
+
+func fixConfig(t *testing.T) spool.Config {
+	return spool.Config{
+		Dir:           t.TempDir(),
+		SegmentSize:   1 << 20,
+		MaxSize:       1 << 24,
+		SyncPolicy:    spool.SyncAlways,
+		SyncInterval:  time.Second,
+		RetryInterval: time.Second,
+	}
+}
+
+func fixMessage(id string) proxy.AuditlogMessage {
+	return proxy.AuditlogMessage{
+		ID:                   id,
+		CorrelationIDHeaders: map[string]string{"x-request-id": "correlation-id"},
+		Request:              "request",
+		Response:             "response",
+		Claims:               proxy.Claims{Tenant: "tenant", ConsumerID: "consumer"},
+	}
+}
+
+func fixUUIDService(id string) *automock.UUIDService {
+	svc := &automock.UUIDService{}
+	svc.On("Generate").Return(id).Once()
+	return svc
+}
+
+func assertEmpty(t *testing.T, s *spool.Spool) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := s.Dequeue(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func closeSpool(t *testing.T, s *spool.Spool) {
+	require.NoError(t, s.Close())
+}
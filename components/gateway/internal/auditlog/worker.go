@@ -9,37 +9,81 @@ import (
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 )
 
+//go:generate mockery --name=Queue --output=automock --outpkg=automock --case=underscore --disable-version-string
+type Queue interface {
+	Dequeue(ctx context.Context) (proxy.AuditlogMessage, error)
+	Ack(ctx context.Context, msg proxy.AuditlogMessage) error
+	Nack(ctx context.Context, msg proxy.AuditlogMessage) error
+}
+
 type Worker struct {
-	svc             proxy.AuditlogService
-	client          Client
-	auditlogChannel chan proxy.AuditlogMessage
-	done            chan bool
-	collector       MetricCollector
+	svc   proxy.AuditlogService
+	queue Queue
 }
 
-func NewWorker(svc proxy.AuditlogService, auditlogChannel chan proxy.AuditlogMessage, collector MetricCollector) *Worker {
+func NewWorker(svc proxy.AuditlogService, queue Queue) *Worker {
 	return &Worker{
-		svc:             svc,
-		auditlogChannel: auditlogChannel,
-		collector:       collector,
+		svc:   svc,
+		queue: queue,
 	}
 }
 
 func (w *Worker) Start(ctx context.Context) {
 	logger := log.C(ctx)
 	for {
-		select {
-		case <-ctx.Done():
+		msg, err := w.queue.Dequeue(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.WithError(err).Errorf("while reading auditlog message: %v", err)
+			}
 			logger.Infoln("Worker for auditlog message processing has finished")
 			return
-		case msg := <-w.auditlogChannel:
-			logger.Debugf("Read from auditlog channel (size=%d, cap=%d)", len(w.auditlogChannel), cap(w.auditlogChannel))
-			w.collector.SetChannelSize(len(w.auditlogChannel))
-			ctx := context.WithValue(ctx, correlation.HeadersContextKey, msg.CorrelationIDHeaders)
-			err := w.svc.Log(ctx, msg)
-			if err != nil {
-				logger.WithError(err).Errorf("while saving auditlog message: %v", err)
+		}
+
+		msgCtx := context.WithValue(ctx, correlation.HeadersContextKey, msg.CorrelationIDHeaders)
+		if err := w.svc.Log(msgCtx, msg); err != nil {
+			logger.WithError(err).Errorf("while saving auditlog message: %v", err)
+			if err := w.queue.Nack(ctx, msg); err != nil {
+				logger.WithError(err).Errorf("while returning auditlog message to the queue: %v", err)
 			}
+			continue
+		}
+
+		if err := w.queue.Ack(ctx, msg); err != nil {
+			logger.WithError(err).Errorf("while acknowledging auditlog message: %v", err)
 		}
 	}
 }
+
+// ChannelQueue reads the audit log messages from the in-memory channel filled by the Sink. Messages which fail to be
+// delivered are dropped.
+type ChannelQueue struct {
+	auditlogChannel chan proxy.AuditlogMessage
+	collector       MetricCollector
+}
+
+func NewChannelQueue(auditlogChannel chan proxy.AuditlogMessage, collector MetricCollector) *ChannelQueue {
+	return &ChannelQueue{
+		auditlogChannel: auditlogChannel,
+		collector:       collector,
+	}
+}
+
+func (q *ChannelQueue) Dequeue(ctx context.Context) (proxy.AuditlogMessage, error) {
+	select {
+	case <-ctx.Done():
+		return proxy.AuditlogMessage{}, ctx.Err()
+	case msg := <-q.auditlogChannel:
+		log.C(ctx).Debugf("Read from auditlog channel (size=%d, cap=%d)", len(q.auditlogChannel), cap(q.auditlogChannel))
+		q.collector.SetChannelSize(len(q.auditlogChannel))
+		return msg, nil
+	}
+}
+
+func (q *ChannelQueue) Ack(context.Context, proxy.AuditlogMessage) error {
+	return nil
+}
+
+func (q *ChannelQueue) Nack(context.Context, proxy.AuditlogMessage) error {
+	return nil
+}
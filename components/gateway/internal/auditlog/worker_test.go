@@ -0,0 +1,100 @@
+package auditlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	proxyautomock "github.com/kyma-incubator/compass/components/gateway/pkg/proxy/automock"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWorker_Start(t *testing.T) {
+	msg := proxy.AuditlogMessage{
+		ID:                   "msg-id",
+		CorrelationIDHeaders: fixCorrelationID(),
+		Request:              fixRequest(),
+		Claims:               fixClaims(),
+	}
+
+	t.Run("Acknowledges delivered message", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		queue := &automock.Queue{}
+		queue.On("Dequeue", ctx).Return(msg, nil).Once()
+		queue.On("Ack", ctx, msg).Return(nil).Once()
+		queue.On("Dequeue", ctx).Run(func(mock.Arguments) { cancel() }).Return(proxy.AuditlogMessage{}, context.Canceled).Once()
+
+		svc := &proxyautomock.AuditlogService{}
+		svc.On("Log", mock.Anything, msg).Return(nil).Once()
+
+		//WHEN
+		auditlog.NewWorker(svc, queue).Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, queue, svc)
+	})
+
+	t.Run("Returns message to the queue when delivery fails", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		queue := &automock.Queue{}
+		queue.On("Dequeue", ctx).Return(msg, nil).Once()
+		queue.On("Nack", ctx, msg).Return(nil).Once()
+		queue.On("Dequeue", ctx).Run(func(mock.Arguments) { cancel() }).Return(proxy.AuditlogMessage{}, context.Canceled).Once()
+
+		svc := &proxyautomock.AuditlogService{}
+		svc.On("Log", mock.Anything, msg).Return(errors.New("test err")).Once()
+
+		//WHEN
+		auditlog.NewWorker(svc, queue).Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, queue, svc)
+		queue.AssertNotCalled(t, "Ack", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Stops when the queue cannot be read", func(t *testing.T) {
+		//GIVEN
+		queue := &automock.Queue{}
+		queue.On("Dequeue", context.TODO()).Return(proxy.AuditlogMessage{}, errors.New("test err")).Once()
+
+		svc := &proxyautomock.AuditlogService{}
+
+		//WHEN
+		auditlog.NewWorker(svc, queue).Start(context.TODO())
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, queue, svc)
+	})
+}
+
+func TestChannelQueue_Dequeue(t *testing.T) {
+	//GIVEN
+	msg := proxy.AuditlogMessage{Request: fixRequest()}
+	channel := make(chan proxy.AuditlogMessage, 1)
+	channel <- msg
+
+	collector := &automock.MetricCollector{}
+	collector.On("SetChannelSize", 0).Return().Once()
+	queue := auditlog.NewChannelQueue(channel, collector)
+
+	//WHEN
+	result, err := queue.Dequeue(context.TODO())
+
+	//THEN
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Request != msg.Request {
+		t.Fatalf("expected %v, got %v", msg, result)
+	}
+	mock.AssertExpectationsForObjects(t, collector)
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -9,6 +10,9 @@ import (
 
 type AuditlogCollector struct {
 	channelLength           prometheus.Gauge
+	spoolBacklogLength      prometheus.Gauge
+	spoolBacklogAge         prometheus.Gauge
+	spoolSize               prometheus.Gauge
 	auditlogRequestDuration *prometheus.HistogramVec
 }
 
@@ -20,6 +24,24 @@ func NewAuditlogMetricCollector() *AuditlogCollector {
 			Name:      "auditlog_channel_length",
 			Help:      "current audit log async channel size",
 		}),
+		spoolBacklogLength: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_spool_backlog_length",
+			Help:      "number of audit log messages in the spool which have not been delivered yet",
+		}),
+		spoolBacklogAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_spool_backlog_age_seconds",
+			Help:      "age of the oldest audit log message in the spool which has not been delivered yet",
+		}),
+		spoolSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_spool_size_bytes",
+			Help:      "current audit log spool size on disk",
+		}),
 		auditlogRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "compass",
 			Subsystem: "gateway",
@@ -31,11 +53,17 @@ func NewAuditlogMetricCollector() *AuditlogCollector {
 
 func (c *AuditlogCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.channelLength.Describe(ch)
+	c.spoolBacklogLength.Describe(ch)
+	c.spoolBacklogAge.Describe(ch)
+	c.spoolSize.Describe(ch)
 	c.auditlogRequestDuration.Describe(ch)
 }
 
 func (c *AuditlogCollector) Collect(ch chan<- prometheus.Metric) {
 	c.channelLength.Collect(ch)
+	c.spoolBacklogLength.Collect(ch)
+	c.spoolBacklogAge.Collect(ch)
+	c.spoolSize.Collect(ch)
 	c.auditlogRequestDuration.Collect(ch)
 }
 
@@ -43,6 +71,12 @@ func (c *AuditlogCollector) SetChannelSize(size int) {
 	c.channelLength.Set(float64(size))
 }
 
+func (c *AuditlogCollector) SetSpoolBacklog(depth int, age time.Duration, sizeBytes int64) {
+	c.spoolBacklogLength.Set(float64(depth))
+	c.spoolBacklogAge.Set(age.Seconds())
+	c.spoolSize.Set(float64(sizeBytes))
+}
+
 func (c *AuditlogCollector) InstrumentAuditlogHTTPClient(client *http.Client) {
 	client.Transport = promhttp.InstrumentRoundTripperDuration(c.auditlogRequestDuration, client.Transport)
 }
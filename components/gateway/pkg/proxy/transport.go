@@ -39,6 +39,8 @@ type PreAuditlogService interface {
 }
 
 type AuditlogMessage struct {
+	// ID identifies the message when it is delivered asynchronously, it is assigned by the sink if empty
+	ID                   string
 	CorrelationIDHeaders correlation.Headers
 	Request              string
 	Response             string
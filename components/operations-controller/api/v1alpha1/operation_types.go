@@ -155,29 +155,19 @@ func (in *Operation) PollURL() string {
 	return in.Status.Webhooks[0].WebhookPollURL
 }
 
-// NextPollTime calculates the remaining time until the Poll URL associated with
-// the current Operation can be requested/polled again.
-func (in *Operation) NextPollTime(retryInterval *int, timeLayout string) (time.Duration, error) {
-	if len(in.Status.Webhooks) == 0 || in.Status.Webhooks[0].LastPollTimestamp == "" || retryInterval == nil {
-		return 0, nil
+// LastPollTime returns the time when the Poll URL associated with the current Operation
+// was last requested/polled, or nil if it has not been polled yet.
+func (in *Operation) LastPollTime(timeLayout string) (*time.Time, error) {
+	if len(in.Status.Webhooks) == 0 || in.Status.Webhooks[0].LastPollTimestamp == "" {
+		return nil, nil
 	}
 
 	lastPollTimestamp, err := time.Parse(timeLayout, in.Status.Webhooks[0].LastPollTimestamp)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	nextPollTime := lastPollTimestamp.Add(time.Duration(*retryInterval) * time.Second)
-	return time.Until(nextPollTime), nil
-}
-
-// TimeoutReached returns whether the current operation has timed-out or not
-// based on the InitializedAt status timestamp of the Operation and the provided
-// timeout duration variable.
-func (in *Operation) TimeoutReached(timeout time.Duration) bool {
-	operationEndTime := in.Status.InitializedAt.Time.Add(timeout)
-
-	return time.Now().After(operationEndTime)
+	return &lastPollTimestamp, nil
 }
 
 // RequestObject parses and returns the request object associated with
@@ -28,6 +28,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/config"
@@ -35,11 +36,12 @@ import (
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/k8s"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/k8s/status"
 	collector "github.com/kyma-incubator/compass/components/operations-controller/internal/metrics"
-	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/env"
+	httputil "github.com/kyma-incubator/compass/components/system-broker/pkg/http"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -57,6 +59,11 @@ var (
 	setupLog         = ctrl.Log.WithName("setup")
 )
 
+const (
+	externalClientCertCertKey = "tls.crt"
+	externalClientCertKeyKey  = "tls.key"
+)
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
@@ -112,11 +119,24 @@ func main() {
 	httpClient, err := utils.PrepareHttpClient(cfg.HttpClient)
 	fatalOnError(err)
 
-	certCache, err := certloader.StartCertLoader(ctx, cfg.ExternalClientCertSecret)
+	certCache, err := certloader.StartCertLoader(ctx, certloader.Config{
+		ExternalClientCertSecret:  cfg.ExternalClientCertSecret,
+		ExternalClientCertCertKey: externalClientCertCertKey,
+		ExternalClientCertKeyKey:  externalClientCertKeyKey,
+	})
 	fatalOnError(errors.Wrapf(err, "Failed to initialize certificate loader"))
 
 	httpMTLSClient := utils.PrepareMTLSClient(cfg.HttpClient, certCache)
 
+	var certClients webhookclient.ClientProvider
+	if cfg.Webhook.ClientCertificatesNamespace != "" {
+		clientset, err := kubernetes.NewForConfig(ctrl.GetConfigOrDie())
+		fatalOnError(err)
+		certClientProvider := webhookclient.NewClientProvider(clientset.CoreV1().Secrets(cfg.Webhook.ClientCertificatesNamespace), cfg.Webhook.ClientCertificates(), httputil.NewHTTPTransport(cfg.HttpClient), cfg.HttpClient.Timeout)
+		go certClientProvider.Run(ctx)
+		certClients = certClientProvider
+	}
+
 	directorClient, err := director.NewClient(cfg.Director.OperationEndpoint, cfg.GraphQLClient, httpClient)
 	fatalOnError(err)
 
@@ -126,7 +146,7 @@ func main() {
 		status.NewManager(mgr.GetClient()),
 		k8s.NewClient(mgr.GetClient()),
 		directorClient,
-		webhook.NewClient(httpClient, httpMTLSClient),
+		webhookclient.NewClient(httpClient, httpClient, httpMTLSClient, certClients),
 		collector)
 
 	if err = controller.SetupWithManager(mgr); err != nil {
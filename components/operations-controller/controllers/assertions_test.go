@@ -24,9 +24,9 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers/controllersfakes"
-	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -142,6 +142,19 @@ func assertDirectorUpdateOperationWithErrorInvocation(t *testing.T, directorClie
 	require.Contains(t, actualRequest.Error, errMsg)
 }
 
+func assertDirectorReportOperationProgressCalled(t *testing.T, directorClient *controllersfakes.FakeDirectorClient, operation *v1alpha1.Operation) {
+	require.Equal(t, 1, directorClient.ReportOperationProgressCallCount())
+	_, actualRequest := directorClient.ReportOperationProgressArgsForCall(0)
+	require.Equal(t, resource.Type(operation.Spec.ResourceType), actualRequest.ResourceType)
+	require.Equal(t, operation.Spec.ResourceID, actualRequest.ResourceID)
+	require.Len(t, actualRequest.Webhooks, len(operation.Status.Webhooks))
+	for i, webhook := range operation.Status.Webhooks {
+		require.Equal(t, webhook.WebhookID, actualRequest.Webhooks[i].WebhookID)
+		require.Equal(t, webhook.WebhookPollURL, actualRequest.Webhooks[i].WebhookPollURL)
+		require.Equal(t, webhook.RetriesCount, actualRequest.Webhooks[i].RetriesCount)
+	}
+}
+
 func assertDirectorFetchApplicationCalled(t *testing.T, directorClient *controllersfakes.FakeDirectorClient, expectedResourceID, expectedTenantID string) {
 	require.Equal(t, 1, directorClient.FetchApplicationCallCount())
 	assertDirectorFetchApplicationInvocation(t, directorClient, expectedResourceID, expectedTenantID, 0)
@@ -162,7 +175,7 @@ func assertWebhookDoInvocation(t *testing.T, webhookClient *controllersfakes.Fak
 	_, actualRequest := webhookClient.DoArgsForCall(invocation)
 	expectedRequestObject, err := operation.RequestObject()
 	require.NoError(t, err)
-	expectedRequest := webhook.NewRequest(*webhookEntity, expectedRequestObject, operation.Spec.CorrelationID)
+	expectedRequest := webhookclient.NewRequest(*webhookEntity, &expectedRequestObject, operation.Spec.CorrelationID)
 	require.Equal(t, expectedRequest, actualRequest)
 }
 
@@ -175,7 +188,7 @@ func assertWebhookPollInvocation(t *testing.T, webhookClient *controllersfakes.F
 	_, actualRequest := webhookClient.PollArgsForCall(invocation)
 	expectedRequestObject, err := operation.RequestObject()
 	require.NoError(t, err)
-	expectedRequest := webhook.NewPollRequest(*webhookEntity, expectedRequestObject, operation.Spec.CorrelationID, mockedLocationURL)
+	expectedRequest := webhookclient.NewPollRequest(*webhookEntity, &expectedRequestObject, operation.Spec.CorrelationID, mockedLocationURL)
 	require.Equal(t, expectedRequest, actualRequest)
 }
 
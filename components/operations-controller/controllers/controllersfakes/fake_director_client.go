@@ -25,6 +25,18 @@ type FakeDirectorClient struct {
 		result1 *director.ApplicationOutput
 		result2 error
 	}
+	ReportOperationProgressStub        func(context.Context, *directora.Request) error
+	reportOperationProgressMutex       sync.RWMutex
+	reportOperationProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 *directora.Request
+	}
+	reportOperationProgressReturns struct {
+		result1 error
+	}
+	reportOperationProgressReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateOperationStub        func(context.Context, *directora.Request) error
 	updateOperationMutex       sync.RWMutex
 	updateOperationArgsForCall []struct {
@@ -106,6 +118,68 @@ func (fake *FakeDirectorClient) FetchApplicationReturnsOnCall(i int, result1 *di
 	}{result1, result2}
 }
 
+func (fake *FakeDirectorClient) ReportOperationProgress(arg1 context.Context, arg2 *directora.Request) error {
+	fake.reportOperationProgressMutex.Lock()
+	ret, specificReturn := fake.reportOperationProgressReturnsOnCall[len(fake.reportOperationProgressArgsForCall)]
+	fake.reportOperationProgressArgsForCall = append(fake.reportOperationProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 *directora.Request
+	}{arg1, arg2})
+	stub := fake.ReportOperationProgressStub
+	fakeReturns := fake.reportOperationProgressReturns
+	fake.recordInvocation("ReportOperationProgress", []interface{}{arg1, arg2})
+	fake.reportOperationProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDirectorClient) ReportOperationProgressCallCount() int {
+	fake.reportOperationProgressMutex.RLock()
+	defer fake.reportOperationProgressMutex.RUnlock()
+	return len(fake.reportOperationProgressArgsForCall)
+}
+
+func (fake *FakeDirectorClient) ReportOperationProgressCalls(stub func(context.Context, *directora.Request) error) {
+	fake.reportOperationProgressMutex.Lock()
+	defer fake.reportOperationProgressMutex.Unlock()
+	fake.ReportOperationProgressStub = stub
+}
+
+func (fake *FakeDirectorClient) ReportOperationProgressArgsForCall(i int) (context.Context, *directora.Request) {
+	fake.reportOperationProgressMutex.RLock()
+	defer fake.reportOperationProgressMutex.RUnlock()
+	argsForCall := fake.reportOperationProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDirectorClient) ReportOperationProgressReturns(result1 error) {
+	fake.reportOperationProgressMutex.Lock()
+	defer fake.reportOperationProgressMutex.Unlock()
+	fake.ReportOperationProgressStub = nil
+	fake.reportOperationProgressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDirectorClient) ReportOperationProgressReturnsOnCall(i int, result1 error) {
+	fake.reportOperationProgressMutex.Lock()
+	defer fake.reportOperationProgressMutex.Unlock()
+	fake.ReportOperationProgressStub = nil
+	if fake.reportOperationProgressReturnsOnCall == nil {
+		fake.reportOperationProgressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reportOperationProgressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDirectorClient) UpdateOperation(arg1 context.Context, arg2 *directora.Request) error {
 	fake.updateOperationMutex.Lock()
 	ret, specificReturn := fake.updateOperationReturnsOnCall[len(fake.updateOperationArgsForCall)]
@@ -173,6 +247,8 @@ func (fake *FakeDirectorClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.fetchApplicationMutex.RLock()
 	defer fake.fetchApplicationMutex.RUnlock()
+	fake.reportOperationProgressMutex.RLock()
+	defer fake.reportOperationProgressMutex.RUnlock()
 	fake.updateOperationMutex.RLock()
 	defer fake.updateOperationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -25,12 +25,13 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/metrics"
 
-	"github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 
+	directoroperation "github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/reconcile"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
-	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/director"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/log"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
@@ -47,6 +48,7 @@ import (
 // OperationReconciler reconciles an Operation object
 type OperationReconciler struct {
 	config           *webhook.Config
+	decider          *reconcile.Decider
 	statusManager    StatusManager
 	k8sClient        KubernetesClient
 	directorClient   DirectorClient
@@ -57,6 +59,7 @@ type OperationReconciler struct {
 func NewOperationReconciler(config *webhook.Config, statusManager StatusManager, k8sClient KubernetesClient, directorClient DirectorClient, webhookClient WebhookClient, collector *metrics.Collector) *OperationReconciler {
 	return &OperationReconciler{
 		config:           config,
+		decider:          reconcile.NewDecider(config.Reconcile()),
 		statusManager:    statusManager,
 		k8sClient:        k8sClient,
 		directorClient:   directorClient,
@@ -109,49 +112,50 @@ func (r *OperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return r.finalizeStatusWithError(ctx, operation, err, nil)
 	}
 
-	if operation.TimeoutReached(r.determineTimeout(webhookEntity)) {
+	if r.decider.TimeoutReached(operation.Status.InitializedAt.Time, webhookEntity, time.Now()) {
 		log.C(ctx).Info("Reconciliation timeout reached")
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrWebhookTimeoutReached, webhookEntity)
+		return r.finalizeStatusWithError(ctx, operation, webhookclient.ErrWebhookTimeoutReached, webhookEntity)
 	}
 
 	if !operation.HasPollURL() {
 		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
-		request := webhook.NewRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID)
+		request := webhookclient.NewRequest(*webhookEntity, &requestObject, operation.Spec.CorrelationID)
 
 		response, err := r.webhookClient.Do(ctx, request)
-		if errors.IsWebhookStatusGoneErr(err) && operation.Spec.OperationType == v1alpha1.OperationTypeDelete {
-			log.C(ctx).Info(fmt.Sprintf("%s webhook initial request returned gone status %d", *(webhookEntity.Mode), *response.GoneStatusCode))
-			return r.finalizeStatusSuccess(ctx, operation, webhookEntity)
-		}
-		if err != nil {
+		if webhookclient.IsStatusGoneError(err) {
+			log.C(ctx).Info(fmt.Sprintf("Webhook initial request returned gone status %d", *response.GoneStatusCode))
+		} else if err != nil {
 			log.C(ctx).Error(err, "Unable to execute Webhook request")
-			return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+		} else {
+			log.C(ctx).Info("Webhook initial request has been executed successfully")
 		}
 
-		return r.handleWebhookResponse(ctx, operation, webhookEntity.Mode, response)
+		decision := r.decider.WebhookExecuted(directorOperationType(operation), webhookEntity, response, err, operation.Status.InitializedAt.Time, time.Now())
+		return r.apply(ctx, operation, webhookEntity, decision)
 	}
 
 	log.C(ctx).Info("Webhook Poll URL is found. Will calculate next poll time")
-	requeueAfter, err := operation.NextPollTime(webhookEntity.RetryInterval, r.config.TimeLayout)
+	lastPollTime, err := operation.LastPollTime(r.config.TimeLayout)
 	if err != nil {
 		log.C(ctx).Error(err, "Unable to calculate next poll time")
 		return r.finalizeStatusWithError(ctx, operation, err, webhookEntity)
 	}
 
-	if requeueAfter > 0 {
-		log.C(ctx).Info(fmt.Sprintf("Poll interval has not passed. Will requeue after: %d seconds", requeueAfter*time.Second))
+	if requeueAfter := r.decider.NextPollAfter(lastPollTime, webhookEntity, time.Now()); requeueAfter > 0 {
+		log.C(ctx).Info(fmt.Sprintf("Poll interval has not passed. Will requeue after: %s", requeueAfter))
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
-	request := webhook.NewPollRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID, operation.PollURL())
+	request := webhookclient.NewPollRequest(*webhookEntity, &requestObject, operation.Spec.CorrelationID, operation.PollURL())
 	response, err := r.webhookClient.Poll(ctx, request)
 	if err != nil {
 		log.C(ctx).Error(err, "Unable to execute Webhook Poll request")
-		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+	} else {
+		log.C(ctx).Info(fmt.Sprintf("Asynchronous webhook polling request has been executed successfully with response status: %s", *response.Status))
 	}
 
-	return r.handleWebhookPollResponse(ctx, operation, webhookEntity, response)
-
+	decision := r.decider.WebhookPolled(webhookEntity, response, err, operation.Status.InitializedAt.Time, time.Now())
+	return r.apply(ctx, operation, webhookEntity, decision)
 }
 
 func (r *OperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -188,97 +192,73 @@ func (r *OperationReconciler) handleGetOperationError(ctx context.Context, req *
 
 func (r *OperationReconciler) handleFetchApplicationError(ctx context.Context, operation *v1alpha1.Operation, err error) (ctrl.Result, error) {
 	log.C(ctx).Error(err, fmt.Sprintf("Unable to fetch application with ID %s", operation.Spec.ResourceID))
-	if operation.TimeoutReached(time.Duration(r.config.TimeoutFactor) * r.config.WebhookTimeout) {
-		if err := r.k8sClient.Delete(ctx, operation); err != nil {
-			return ctrl.Result{}, err
-		}
-
-		log.C(ctx).Info("Successfully deleted operation")
-		return ctrl.Result{}, nil
-	}
-
-	if isNotFoundError(err) {
+	decision := r.decider.FetchResourceFailed(directorOperationType(operation), "Application", isNotFoundError(err), err, operation.Status.InitializedAt.Time, time.Now())
+	if decision.Outcome != reconcile.OutcomeDelete && isNotFoundError(err) {
 		if operation.Status.Phase == v1alpha1.StateSuccess || operation.Status.Phase == v1alpha1.StateFailed {
 			log.C(ctx).Info(fmt.Sprintf("Last state of operation for application with ID %s is %s, will not requeue", operation.Spec.ResourceID, operation.Status.Phase))
 			return ctrl.Result{}, nil
 		}
 
-		if operation.Spec.OperationType == v1alpha1.OperationTypeDelete && operation.Status.Phase == v1alpha1.StateInProgress {
-			return r.finalizeStatus(ctx, operation, nil, nil)
-		}
-		if operation.Spec.OperationType == v1alpha1.OperationTypeUpdate && operation.Status.Phase == v1alpha1.StateInProgress {
-			return r.finalizeStatus(ctx, operation, str.Ptr("Application not found in director"), nil)
+		if operation.Status.Phase != v1alpha1.StateInProgress {
+			return ctrl.Result{}, err
 		}
 	}
 
-	// requeue in case of async create (app is not created in director yet), or a glitch in controller<->director communication
-	return ctrl.Result{}, err
+	return r.apply(ctx, operation, nil, decision)
 }
 
-func (r *OperationReconciler) handleWebhookResponse(ctx context.Context, operation *v1alpha1.Operation, webhookMode *graphql.WebhookMode, response *webhookdir.Response) (ctrl.Result, error) {
-	mode := graphql.WebhookModeSync
-	if webhookMode != nil {
-		mode = *webhookMode
-	}
-
-	switch mode {
-	case graphql.WebhookModeAsync:
-		log.C(ctx).Info("Asynchronous webhook initial request has been executed successfully")
-		if err := r.statusManager.InProgressWithPollURL(ctx, operation, *response.Location); err != nil {
+// apply updates the status of the operation according to the decision
+func (r *OperationReconciler) apply(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, decision reconcile.Decision) (ctrl.Result, error) {
+	if decision.PollURL != "" {
+		if err := r.statusManager.InProgressWithPollURL(ctx, operation, decision.PollURL); err != nil {
 			return ctrl.Result{}, err
 		}
-		log.C(ctx).Info("Successfully updated operation status with poll URL: " + *response.Location)
-		return ctrl.Result{Requeue: true}, nil
-	case graphql.WebhookModeSync:
-		log.C(ctx).Info("Synchronous webhook has been executed successfully")
-		return r.finalizeStatusSuccess(ctx, operation, nil)
-	default:
-		log.C(ctx).Error(errors.ErrUnsupportedWebhookMode, "Unable to post-process Webhook response")
-		return ctrl.Result{}, nil
+		log.C(ctx).Info("Successfully updated operation status with poll URL: " + decision.PollURL)
+		r.reportProgress(ctx, operation)
 	}
-}
 
-func (r *OperationReconciler) handleWebhookPollResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.ResponseStatus) (ctrl.Result, error) {
-	log.C(ctx).Info(fmt.Sprintf("Asynchronous webhook polling request has been executed successfully with response status: %s", *response.Status))
-	switch *response.Status {
-	case *response.InProgressStatusIdentifier:
+	if decision.Polled {
 		lastPollTimestamp := time.Now().Format(r.config.TimeLayout)
 		retryCount := operation.Status.Webhooks[0].RetriesCount + 1
 		if err := r.statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, operation.PollURL(), lastPollTimestamp, retryCount); err != nil {
 			return ctrl.Result{}, err
 		}
 		log.C(ctx).Info(fmt.Sprintf("Successfully updated operation status last poll timestamp to %s", lastPollTimestamp), "status", operation.Status)
-		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, errors.ErrWebhookPollTimeExpired)
-	case *response.SuccessStatusIdentifier:
-		return r.finalizeStatusSuccess(ctx, operation, webhookEntity)
-	case *response.FailedStatusIdentifier:
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrFailedWebhookStatus, webhookEntity)
-	default:
-		log.C(ctx).Error(fmt.Errorf("unexpected poll status response: %s", *response.Status), "Polling will be stopped due to an unknown status code received")
-		return ctrl.Result{}, nil
+		r.reportProgress(ctx, operation)
 	}
-}
 
-func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, webhookErr error) (ctrl.Result, error) {
-	_, isFatalErr := webhookErr.(*errors.FatalReconcileErr)
-	if !operation.TimeoutReached(r.determineTimeout(webhookEntity)) && !isFatalErr {
-		requeueAfter := r.config.RequeueInterval
-		if webhookEntity.RetryInterval != nil {
-			requeueAfter = time.Duration(*webhookEntity.RetryInterval)
+	switch decision.Outcome {
+	case reconcile.OutcomeSuccess:
+		return r.finalizeStatusSuccess(ctx, operation, webhookEntity)
+	case reconcile.OutcomeFailure:
+		return r.finalizeStatusWithError(ctx, operation, decision.Err, webhookEntity)
+	case reconcile.OutcomeComplete:
+		var errorMsg *string
+		if decision.Err != nil {
+			errorMsg = str.Ptr(decision.Err.Error())
+		}
+		return r.finalizeStatus(ctx, operation, errorMsg, webhookEntity)
+	case reconcile.OutcomeDelete:
+		if err := r.k8sClient.Delete(ctx, operation); err != nil {
+			return ctrl.Result{}, err
 		}
 
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
-	}
-
-	if !isFatalErr {
-		webhookErr = fmt.Errorf("%s: %s", errors.ErrWebhookTimeoutReached, webhookErr)
+		log.C(ctx).Info("Successfully deleted operation")
+		return ctrl.Result{}, nil
+	default:
+		// the error is returned so that the operation is requeued with the backoff of the controller
+		if decision.Err != nil {
+			return ctrl.Result{}, decision.Err
+		}
+		if decision.RequeueAfter > 0 {
+			return ctrl.Result{RequeueAfter: decision.RequeueAfter}, nil
+		}
+		return ctrl.Result{Requeue: true}, nil
 	}
-
-	return r.finalizeStatusWithError(ctx, operation, webhookErr, webhookEntity)
 }
 
 func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg *string, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.decider.Timeout(webhook)) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -308,7 +288,7 @@ func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1a
 }
 
 func (r *OperationReconciler) finalizeStatusSuccess(ctx context.Context, operation *v1alpha1.Operation, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.decider.Timeout(webhook)) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -329,7 +309,7 @@ func (r *OperationReconciler) finalizeStatusSuccess(ctx context.Context, operati
 }
 
 func (r *OperationReconciler) finalizeStatusWithError(ctx context.Context, operation *v1alpha1.Operation, opErr error, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if operation != nil && isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+	if operation != nil && isCloseToTimeout(operation.Status.InitializedAt.Time, r.decider.Timeout(webhook)) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -352,16 +332,19 @@ func (r *OperationReconciler) finalizeStatusWithError(ctx context.Context, opera
 	return ctrl.Result{}, nil
 }
 
-func (r *OperationReconciler) determineTimeout(webhook *graphql.Webhook) time.Duration {
-	if webhook == nil {
-		return r.config.WebhookTimeout
-	}
-
-	if webhook.Timeout == nil {
-		return r.config.WebhookTimeout
+// reportProgress records the progress of the webhooks in the operations history of the Director.
+// Failures are only logged, as the progress is informational and the operation proceeds regardless.
+func (r *OperationReconciler) reportProgress(ctx context.Context, operation *v1alpha1.Operation) {
+	if err := r.directorClient.ReportOperationProgress(ctx, prepareDirectorRequest(operation)); err != nil {
+		log.C(ctx).Error(err, "Unable to report operation progress to director")
 	}
+}
 
-	return time.Duration(*webhook.Timeout) * time.Second
+// webhookStates maps the states of the webhooks in the Operation status to the ones of the operations history of the Director
+var webhookStates = map[v1alpha1.State]string{
+	v1alpha1.StateSuccess:    "SUCCEEDED",
+	v1alpha1.StateFailed:     "FAILED",
+	v1alpha1.StateInProgress: "IN_PROGRESS",
 }
 
 func prepareDirectorRequest(operation *v1alpha1.Operation) *director.Request {
@@ -380,6 +363,16 @@ func prepareDirectorRequestWithError(operation *v1alpha1.Operation, err error) *
 		request.Error = err.Error()
 	}
 
+	for _, webhook := range operation.Status.Webhooks {
+		request.Webhooks = append(request.Webhooks, director.WebhookStatus{
+			WebhookID:         webhook.WebhookID,
+			State:             webhookStates[webhook.State],
+			RetriesCount:      webhook.RetriesCount,
+			WebhookPollURL:    webhook.WebhookPollURL,
+			LastPollTimestamp: webhook.LastPollTimestamp,
+		})
+	}
+
 	return request
 }
 
@@ -393,6 +386,11 @@ func extractWebhook(appWebhooks []graphql.Webhook, operationWebhookID string) (*
 	return nil, fmt.Errorf("missing webhook with ID: %s", operationWebhookID)
 }
 
+// directorOperationType returns the type of the operation as defined by the director
+func directorOperationType(operation *v1alpha1.Operation) directoroperation.OperationType {
+	return directoroperation.OperationType(operation.Spec.OperationType)
+}
+
 func trimRequestObject(operation *v1alpha1.Operation) string {
 	index := strings.Index(operation.Spec.RequestObject, ",\"Headers\"")
 	if index != -1 {
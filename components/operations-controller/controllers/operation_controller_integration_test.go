@@ -28,11 +28,11 @@ import (
 
 	collector "github.com/kyma-incubator/compass/components/operations-controller/internal/metrics"
 
-	"github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers/controllersfakes"
@@ -182,7 +182,7 @@ func TestController_Scenarios(t *testing.T) {
 	t.Run("Successful Async Webhook flow due to gone status", func(t *testing.T) {
 		mode := graphql.WebhookModeAsync
 		goneStatusCode := 410
-		expectedErr := errors.NewWebhookStatusGoneErr(goneStatusCode)
+		expectedErr := webhookclient.NewStatusGoneError(goneStatusCode)
 		application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode})
 
 		directorClient.FetchApplicationReturns(application, nil)
@@ -368,7 +368,7 @@ func TestController_Scenarios(t *testing.T) {
 	t.Run("Successful Async Webhook execution after Operation CR has previously resulted in FAILED state", func(t *testing.T) {
 		updateInvocationVars(&fetchApplicationInvocations, &updateOperationInvocations, &doInvocations, &pollInvocations, directorClient, webhookClient)
 
-		expectedErr := errors.NewFatalReconcileError("unable to parse output template")
+		expectedErr := webhookclient.NewFatalError("unable to parse output template")
 		mode := graphql.WebhookModeAsync
 		application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
 			graphql.Webhook{ID: webhookGUID, Mode: &mode, Timeout: intToIntPtr(120)},
@@ -465,7 +465,7 @@ func TestController_Scenarios(t *testing.T) {
 			require.NoError(t, err)
 		}()
 
-		expectedStatus := expectedFailedStatus(webhookGUID, errors.ErrFailedWebhookStatus.Error())
+		expectedStatus := expectedFailedStatus(webhookGUID, webhookclient.ErrFailedWebhookStatus.Error())
 		expectedStatus.Webhooks[0].WebhookPollURL = mockedLocationURL
 		expectedStatus.Webhooks[0].RetriesCount = pollCallCount - 1
 
@@ -523,7 +523,7 @@ func TestController_Scenarios(t *testing.T) {
 			require.NoError(t, err)
 		}()
 
-		expectedStatus := expectedFailedStatus(webhookGUID, errors.ErrWebhookTimeoutReached.Error())
+		expectedStatus := expectedFailedStatus(webhookGUID, webhookclient.ErrWebhookTimeoutReached.Error())
 		expectedStatus.Webhooks[0].WebhookPollURL = mockedLocationURL
 		namespacedName := types.NamespacedName{Namespace: operation.ObjectMeta.Namespace, Name: operation.ObjectMeta.Name}
 
@@ -567,7 +567,7 @@ func TestController_Scenarios(t *testing.T) {
 			require.NoError(t, err)
 		}()
 
-		expectedStatus := expectedFailedStatus(webhookGUID, errors.ErrWebhookTimeoutReached.Error())
+		expectedStatus := expectedFailedStatus(webhookGUID, webhookclient.ErrWebhookTimeoutReached.Error())
 		expectedStatus.Webhooks[0].WebhookPollURL = mockedLocationURL
 		namespacedName := types.NamespacedName{Namespace: operation.ObjectMeta.Namespace, Name: operation.ObjectMeta.Name}
 
@@ -672,7 +672,7 @@ func TestController_Scenarios(t *testing.T) {
 	t.Run("Successful Sync Webhook flow due to gone status", func(t *testing.T) {
 		mode := graphql.WebhookModeSync
 		goneStatusCode := 410
-		expectedErr := errors.NewWebhookStatusGoneErr(goneStatusCode)
+		expectedErr := webhookclient.NewStatusGoneError(goneStatusCode)
 		application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode})
 
 		directorClient.FetchApplicationReturns(application, nil)
@@ -784,7 +784,7 @@ func TestController_Scenarios(t *testing.T) {
 	t.Run("Successful Sync Webhook execution after Operation CR has previously resulted in FAILED state", func(t *testing.T) {
 		updateInvocationVars(&fetchApplicationInvocations, &updateOperationInvocations, &doInvocations, &pollInvocations, directorClient, webhookClient)
 
-		expectedErr := errors.NewFatalReconcileError("unable to parse output template")
+		expectedErr := webhookclient.NewFatalError("unable to parse output template")
 		mode := graphql.WebhookModeSync
 		application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
 			graphql.Webhook{ID: webhookGUID, Mode: &mode, Timeout: intToIntPtr(120)},
@@ -868,7 +868,7 @@ func TestController_Scenarios(t *testing.T) {
 			require.NoError(t, err)
 		}()
 
-		expectedStatus := expectedFailedStatus(webhookGUID, errors.ErrWebhookTimeoutReached.Error())
+		expectedStatus := expectedFailedStatus(webhookGUID, webhookclient.ErrWebhookTimeoutReached.Error())
 		namespacedName := types.NamespacedName{Namespace: operation.ObjectMeta.Namespace, Name: operation.ObjectMeta.Name}
 
 		require.Eventually(t, func() bool {
@@ -28,10 +28,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
-	recerr "github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
-
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers/controllersfakes"
@@ -927,7 +926,7 @@ func TestReconcile_ReconciliationTimeoutReached_When_DirectorUpdateOperationFail
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount)
 }
@@ -962,9 +961,9 @@ func TestReconcile_ReconciliationTimeoutReached_When_StatusManagerFailedStatusFa
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, initializedMockedOperation, recerr.ErrWebhookTimeoutReached.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, initializedMockedOperation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount)
 }
@@ -998,13 +997,51 @@ func TestReconcile_ReconciliationTimeoutReached_And_DirectorAndStatusManagerUpda
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, initializedMockedOperation, recerr.ErrWebhookTimeoutReached.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, initializedMockedOperation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, initializedMockedOperation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount)
 }
 
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookHasSecuritySettings_ShouldPassThemToWebhookRequest(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(initializedMockedOperation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+
+	clientCertificateRef := "webhook-client-cert"
+	webhookEntity := graphql.Webhook{
+		ID:        webhookGUID,
+		Auth:      &graphql.Auth{ClientCertificateRef: &clientCertificateRef},
+		Signature: &graphql.WebhookSignature{Secret: "secret"},
+	}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, webhookEntity)
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	_, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	require.NoError(t, err)
+	require.Equal(t, 1, directorClient.FetchApplicationCallCount())
+	require.Equal(t, 1, webhookClient.DoCallCount())
+	_, actualRequest := webhookClient.DoArgsForCall(0)
+	require.Equal(t, webhookEntity.Auth, actualRequest.Webhook.Auth)
+	require.Equal(t, webhookEntity.Signature, actualRequest.Webhook.Signature)
+}
+
 func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_WebhookTimeoutNotReached_ShouldResultRequeueAfterNoError(t *testing.T) {
 	// GIVEN:
 	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
@@ -1049,7 +1086,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 
 func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_FatalErrorReturned_When_DirectorUpdateOperationFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
+	expectedErr := webhookclient.NewFatalError("unable to parse output template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1097,7 +1134,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 
 func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_FatalErrorReturned_When_StatusManagerFailedStatusFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
+	expectedErr := webhookclient.NewFatalError("unable to parse output template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1147,7 +1184,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 
 func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_FatalErrorReturned_And_DirectorAndStatusManagerUpdateSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
+	expectedErr := webhookclient.NewFatalError("unable to parse output template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1198,7 +1235,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 	// GIVEN:
 	goneStatusCode := 410
 	webhookMode := graphql.WebhookModeAsync
-	expectedErr := recerr.NewWebhookStatusGoneErr(goneStatusCode)
+	expectedErr := webhookclient.NewStatusGoneError(goneStatusCode)
 
 	stubLoggerAssertion(t, expectedErr.Error(), "gone response status")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1268,7 +1305,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 	directorClient.UpdateOperationReturns(mockedErr)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		DoStub: func(_ context.Context, _ *webhook.Request) (*web_hook.Response, error) {
+		DoStub: func(_ context.Context, _ *webhookclient.Request) (*web_hook.Response, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -1319,7 +1356,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		DoStub: func(_ context.Context, _ *webhook.Request) (*web_hook.Response, error) {
+		DoStub: func(_ context.Context, _ *webhookclient.Request) (*web_hook.Response, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -1371,7 +1408,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		DoStub: func(_ context.Context, _ *webhook.Request) (*web_hook.Response, error) {
+		DoStub: func(_ context.Context, _ *webhookclient.Request) (*web_hook.Response, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -1475,6 +1512,49 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_AsyncWebhookExecutionSucce
 	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, mockedLocationURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
 	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
+	assertDirectorReportOperationProgressCalled(t, directorClient, initializedMockedOperation)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+		webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_AsyncWebhookExecutionSucceeds_And_StatusManagerInProgressWithPollURLSucceeds_When_DirectorReportOperationProgressFails_ShouldResultRequeueNoError(t *testing.T) {
+	// GIVEN:
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(initializedMockedOperation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.InProgressWithPollURLReturns(nil)
+
+	mode := graphql.WebhookModeAsync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.ReportOperationProgressReturns(mockedErr)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{Location: &mockedLocationURL}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.True(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
+	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, mockedLocationURL)
+	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
+	assertDirectorReportOperationProgressCalled(t, directorClient, initializedMockedOperation)
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
 		statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
 		webhookClient.PollCallCount)
@@ -1842,7 +1922,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_Webhook
 
 func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_FatalErrorReturned_When_DirectorUpdateOperationFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse status template")
+	expectedErr := webhookclient.NewFatalError("unable to parse status template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook Poll request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1892,7 +1972,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_FatalEr
 
 func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_FatalErrorReturned_When_StatusManagerFailedStatusFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse status template")
+	expectedErr := webhookclient.NewFatalError("unable to parse status template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook Poll request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -1944,7 +2024,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_FatalEr
 
 func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_FatalErrorReturned_And_DirectorAndStatusManagerUpdateSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
 	// GIVEN:
-	expectedErr := recerr.NewFatalReconcileError("unable to parse status template")
+	expectedErr := webhookclient.NewFatalError("unable to parse status template")
 
 	stubLoggerAssertion(t, expectedErr.Error(), "Unable to execute Webhook Poll request")
 	defer func() { ctrl.Log = &originalLogger }()
@@ -2017,7 +2097,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_Webhook
 	directorClient.UpdateOperationReturns(mockedErr)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -2071,7 +2151,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_Webhook
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -2126,7 +2206,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_Webhook
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return nil, mockedErr
 		},
@@ -2265,7 +2345,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	directorClient.UpdateOperationReturns(mockedErr)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return prepareResponseStatus("IN_PROGRESS"), nil
 		},
@@ -2288,7 +2368,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
 	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
@@ -2317,7 +2397,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return prepareResponseStatus("IN_PROGRESS"), nil
 		},
@@ -2339,9 +2419,9 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
 	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.SuccessStatusCallCount, webhookClient.DoCallCount)
@@ -2369,7 +2449,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{
-		PollStub: func(_ context.Context, _ *webhook.PollRequest) (*web_hook.ResponseStatus, error) {
+		PollStub: func(_ context.Context, _ *webhookclient.PollRequest) (*web_hook.ResponseStatus, error) {
 			time.Sleep(time.Duration(webhookTimeout) * time.Second)
 			return prepareResponseStatus("IN_PROGRESS"), nil
 		},
@@ -2390,9 +2470,9 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
 	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
-	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, webhookclient.ErrWebhookTimeoutReached.Error())
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.SuccessStatusCallCount, webhookClient.DoCallCount)
@@ -2619,7 +2699,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrFailedWebhookStatus.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, webhookclient.ErrFailedWebhookStatus.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
@@ -2665,7 +2745,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrFailedWebhookStatus.Error())
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, webhookclient.ErrFailedWebhookStatus.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
@@ -2720,7 +2800,7 @@ func TestReconcile_OperationNoWebhookPollURL_And_PollIntervalHasNotPassed_And_Sh
 		webhookClient.DoCallCount, webhookClient.PollCallCount)
 }
 
-func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_StatusIsUnknown_And_ShouldResultNoRequeueNoError(t *testing.T) {
+func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_StatusIsUnknown_And_DirectorAndStatusManagerUpdateSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
 	// GIVEN:
 	unknownStatus := "UNKNOWN"
 	stubLoggerAssertion(t, fmt.Sprintf("unexpected poll status response: %s", unknownStatus), "unknown status code received")
@@ -2735,12 +2815,14 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 
 	statusMgrClient := &controllersfakes.FakeStatusManager{}
 	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
 
 	mode := graphql.WebhookModeAsync
 	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode, RetryInterval: intToIntPtr(30)})
 
 	directorClient := &controllersfakes.FakeDirectorClient{}
 	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
 
 	webhookClient := &controllersfakes.FakeWebhookClient{}
 	webhookClient.PollReturns(prepareResponseStatus(unknownStatus), nil)
@@ -2759,10 +2841,12 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, fmt.Sprintf("unexpected poll status response: %s", unknownStatus))
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
-	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
-		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
 		webhookClient.DoCallCount)
 }
 
@@ -22,9 +22,9 @@ import (
 	"errors"
 
 	webhookdir "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/director"
-	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
 	directorclient "github.com/kyma-incubator/compass/components/system-broker/pkg/director"
 	typesbroker "github.com/kyma-incubator/compass/components/system-broker/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,13 +53,14 @@ type KubernetesClient interface {
 type DirectorClient interface {
 	typesbroker.ApplicationLister
 	UpdateOperation(ctx context.Context, request *director.Request) error
+	ReportOperationProgress(ctx context.Context, request *director.Request) error
 }
 
 // WebhookClient defines a general purpose Webhook executor client
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . WebhookClient
 type WebhookClient interface {
-	Do(ctx context.Context, request *webhook.Request) (*webhookdir.Response, error)
-	Poll(ctx context.Context, request *webhook.PollRequest) (*webhookdir.ResponseStatus, error)
+	Do(ctx context.Context, request *webhookclient.Request) (*webhookdir.Response, error)
+	Poll(ctx context.Context, request *webhookclient.PollRequest) (*webhookdir.ResponseStatus, error)
 }
 
 func isNotFoundError(err error) bool {
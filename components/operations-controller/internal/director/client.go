@@ -31,6 +31,8 @@ import (
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/types"
 )
 
+const progressPath = "/progress"
+
 // client implements the DirectorClient interface
 type client struct {
 	types.ApplicationLister
@@ -45,6 +47,16 @@ type Request struct {
 	ResourceID        string                `json:"resource_id"`
 	OperationCategory string                `json:"operation_category"`
 	Error             string                `json:"error,omitempty"`
+	Webhooks          []WebhookStatus       `json:"webhooks,omitempty"`
+}
+
+// WebhookStatus describes the progress of the execution of a webhook of an operation, as recorded in the operations history of the Director
+type WebhookStatus struct {
+	WebhookID         string `json:"webhook_id"`
+	State             string `json:"state,omitempty"`
+	RetriesCount      int    `json:"retries_count,omitempty"`
+	WebhookPollURL    string `json:"webhook_poll_url,omitempty"`
+	LastPollTimestamp string `json:"last_poll_timestamp,omitempty"`
 }
 
 // NewClient constructs a default implementation of the Client interface
@@ -70,12 +82,21 @@ func NewClient(operationEndpoint string, cfg *graphqlbroker.Config, httpClient *
 
 // UpdateOperation makes an http request to the Director to notify about any operation state changes
 func (c *client) UpdateOperation(ctx context.Context, request *Request) error {
+	return c.putOperation(ctx, c.operationEndpoint, request)
+}
+
+// ReportOperationProgress makes an http request to the Director to record the progress of the webhooks of an operation which is not yet finished
+func (c *client) ReportOperationProgress(ctx context.Context, request *Request) error {
+	return c.putOperation(ctx, c.operationEndpoint+progressPath, request)
+}
+
+func (c *client) putOperation(ctx context.Context, endpoint string, request *Request) error {
 	body, err := json.Marshal(request)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.operationEndpoint, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewBuffer(body))
 	if err != nil {
 		return err
 	}
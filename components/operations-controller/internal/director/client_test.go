@@ -18,6 +18,7 @@ package director_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -91,6 +92,66 @@ func TestClient_UpdateStatus_WhenRequestIsSuccessful_ShouldNotReturnError(t *tes
 	require.NoError(t, err)
 }
 
+func TestClient_ReportOperationProgress_WhenRequestIsSuccessful_ShouldSendWebhooksToProgressEndpoint(t *testing.T) {
+	var actualRequest *http.Request
+	var actualBody director.Request
+	httpClient := http.Client{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			actualRequest = req
+			if err := json.NewDecoder(req.Body).Decode(&actualBody); err != nil {
+				return nil, err
+			}
+			return &http.Response{StatusCode: http.StatusOK}, nil
+		}),
+	}
+
+	client, err := director.NewClient("http://director/operations", &graphql.Config{}, &httpClient)
+	require.NoError(t, err)
+
+	ctx := tnt.SaveToContext(context.Background(), tenant)
+	request := &director.Request{
+		ResourceID: "resource-id",
+		Webhooks: []director.WebhookStatus{{
+			WebhookID:      "webhook-id",
+			State:          "IN_PROGRESS",
+			RetriesCount:   2,
+			WebhookPollURL: "http://poll",
+		}},
+	}
+
+	err = client.ReportOperationProgress(ctx, request)
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPut, actualRequest.Method)
+	require.Equal(t, "http://director/operations/progress", actualRequest.URL.String())
+	require.Equal(t, tenant, actualRequest.Header.Get("tenant"))
+	require.Equal(t, *request, actualBody)
+}
+
+func TestClient_ReportOperationProgress_WhenResponseStatusCodeIsNotOK_ShouldReturnError(t *testing.T) {
+	httpClient := http.Client{
+		Transport: mockedTransport{resp: &http.Response{
+			StatusCode: http.StatusInternalServerError,
+		}, err: nil},
+	}
+
+	client, err := director.NewClient("", &graphql.Config{}, &httpClient)
+	require.NoError(t, err)
+
+	ctx := tnt.SaveToContext(context.Background(), tenant)
+
+	err = client.ReportOperationProgress(ctx, &director.Request{})
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected status code")
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 type mockedTransport struct {
 	resp *http.Response
 	err  error
@@ -17,53 +17,9 @@
 package errors
 
 import (
-	"fmt"
-
 	"github.com/pkg/errors"
 )
 
 var (
 	ErrReconciliationTimeoutReached = errors.New("reconciliation timeout reached")
-	ErrWebhookTimeoutReached        = errors.New("webhook timeout reached")
-	ErrWebhookPollTimeExpired       = errors.New("polling time has expired")
-	ErrFailedWebhookStatus          = errors.New("webhook operation has finished with failed status")
-	ErrUnsupportedWebhookMode       = errors.New("unsupported webhook mode")
 )
-
-// FatalReconcileErr represents an error type which denotes a failure to proceed with the reconciliation of an Operation CR.
-// The error should result in ceasing with the requeue of the specific resource.
-type FatalReconcileErr struct {
-	error
-}
-
-// NewFatalReconcileError constructs a new FatalReconcileErr with the given error message
-func NewFatalReconcileError(message string) *FatalReconcileErr {
-	return &FatalReconcileErr{
-		error: errors.New(message),
-	}
-}
-
-// NewFatalReconcileError constructs a new FatalReconcileErr based on the provided error
-func NewFatalReconcileErrorFromExisting(err error) *FatalReconcileErr {
-	return &FatalReconcileErr{
-		error: err,
-	}
-}
-
-// WebhookStatusGoneErr represents an error type which represents a gone status code
-// returned in response to calling delete webhook.
-type WebhookStatusGoneErr struct {
-	error
-}
-
-// NewWebhookStatusGoneErr constructs a new WebhookStatusGoneErr with the given error message
-func NewWebhookStatusGoneErr(goneStatusCode int) WebhookStatusGoneErr {
-	return WebhookStatusGoneErr{error: fmt.Errorf("gone response status %d was met while calling webhook", goneStatusCode)}
-}
-
-// IsWebhookStatusGoneErr check whether an error is a WebhookStatusGoneErr
-// and returns true if so.
-func IsWebhookStatusGoneErr(err error) (ok bool) {
-	_, ok = err.(WebhookStatusGoneErr)
-	return
-}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/reconcile"
+	webhookclient "github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
 	"github.com/pkg/errors"
 )
 
@@ -29,6 +31,11 @@ type Config struct {
 	WebhookTimeout  time.Duration `mapstructure:"webhook_timeout" description:"defines the maximum time to process a webhook"`
 	RequeueInterval time.Duration `mapstructure:"requeue_interval" description:"defines the default requeue interval"`
 	TimeLayout      string        `mapstructure:"time_layout" description:"defines the default timestamp time layout"`
+
+	ClientCertificatesNamespace string        `mapstructure:"client_certificates_namespace" description:"namespace of the secrets holding the client certificates referenced by webhooks; empty disables per-webhook client certificates"`
+	ClientCertificateCertKey    string        `mapstructure:"client_certificate_cert_key" description:"key of the certificate chain in the client certificate secrets"`
+	ClientCertificateKeyKey     string        `mapstructure:"client_certificate_key_key" description:"key of the private key in the client certificate secrets"`
+	ClientCertificatesReconnect time.Duration `mapstructure:"client_certificates_reconnect" description:"defines the interval after which the watch of the client certificate secrets is restarted when it ends"`
 }
 
 // DefaultSettings returns the default values for configuring the System Broker
@@ -38,6 +45,10 @@ func DefaultConfig() *Config {
 		WebhookTimeout:  2 * time.Hour,
 		RequeueInterval: 2 * time.Minute,
 		TimeLayout:      time.RFC3339Nano,
+
+		ClientCertificateCertKey:    "tls.crt",
+		ClientCertificateKeyKey:     "tls.key",
+		ClientCertificatesReconnect: 5 * time.Second,
 	}
 }
 
@@ -54,5 +65,30 @@ func (s *Config) Validate() error {
 	if s.TimeLayout != time.RFC3339Nano {
 		return fmt.Errorf("validate webhook settings: time layout should be %s", time.RFC3339Nano)
 	}
+	if s.ClientCertificatesNamespace != "" && (s.ClientCertificateCertKey == "" || s.ClientCertificateKeyKey == "") {
+		return errors.New("validate webhook settings: client certificate secret keys should not be empty when client certificates namespace is set")
+	}
+	if s.ClientCertificatesNamespace != "" && s.ClientCertificatesReconnect <= 0 {
+		return errors.New("validate webhook settings: client certificates reconnect interval should be > 0 when client certificates namespace is set")
+	}
 	return nil
 }
+
+// ClientCertificates returns the configuration of the client certificates which webhooks can reference
+func (s *Config) ClientCertificates() webhookclient.CertificatesConfig {
+	return webhookclient.CertificatesConfig{
+		Namespace:         s.ClientCertificatesNamespace,
+		CertKey:           s.ClientCertificateCertKey,
+		KeyKey:            s.ClientCertificateKeyKey,
+		ReconnectInterval: s.ClientCertificatesReconnect,
+	}
+}
+
+// Reconcile returns the configuration of the decisions on how the processing of the operations proceeds
+func (s *Config) Reconcile() reconcile.Config {
+	return reconcile.Config{
+		WebhookTimeout:  s.WebhookTimeout,
+		TimeoutFactor:   s.TimeoutFactor,
+		RequeueInterval: s.RequeueInterval,
+	}
+}
@@ -78,6 +78,24 @@ func TestConfig_Validate(t *testing.T) {
 				return config
 			},
 		},
+		{
+			Msg: "Client certificates namespace with empty secret keys should be invalid",
+			ConfigProvider: func() *webhook.Config {
+				config := webhook.DefaultConfig()
+				config.ClientCertificatesNamespace = "compass-system"
+				config.ClientCertificateKeyKey = ""
+				return config
+			},
+		},
+		{
+			Msg: "Client certificates namespace with zero reconnect interval should be invalid",
+			ConfigProvider: func() *webhook.Config {
+				config := webhook.DefaultConfig()
+				config.ClientCertificatesNamespace = "compass-system"
+				config.ClientCertificatesReconnect = 0
+				return config
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,44 @@
+BEGIN;
+
+DROP TABLE formation_assignments;
+
+DROP TYPE formation_assignment_state;
+
+DELETE FROM webhooks WHERE type = 'FORMATION_ASSIGNMENT_NOTIFICATION';
+
+DROP VIEW IF EXISTS webhooks_tenants;
+DROP VIEW IF EXISTS application_webhooks_tenants;
+DROP VIEW IF EXISTS runtime_webhooks_tenants;
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE VARCHAR(255);
+
+DROP TYPE webhook_type;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED',
+    'REGISTER_APPLICATION',
+    'UNREGISTER_APPLICATION',
+    'OPEN_RESOURCE_DISCOVERY',
+    'UNPAIR_APPLICATION'
+    );
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING (type::webhook_type);
+
+CREATE OR REPLACE VIEW application_webhooks_tenants AS
+SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                            INNER JOIN tenant_applications ta ON w.app_id = ta.id;
+
+CREATE OR REPLACE VIEW runtime_webhooks_tenants AS
+SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                            INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id;
+
+CREATE OR REPLACE VIEW webhooks_tenants AS
+(SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                             INNER JOIN tenant_applications ta ON w.app_id = ta.id)
+UNION ALL
+(SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                             INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id);
+
+COMMIT;
@@ -0,0 +1,66 @@
+BEGIN;
+
+DROP VIEW IF EXISTS webhooks_tenants;
+DROP VIEW IF EXISTS application_webhooks_tenants;
+DROP VIEW IF EXISTS runtime_webhooks_tenants;
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE VARCHAR(255);
+
+DROP TYPE webhook_type;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED',
+    'REGISTER_APPLICATION',
+    'UNREGISTER_APPLICATION',
+    'OPEN_RESOURCE_DISCOVERY',
+    'UNPAIR_APPLICATION',
+    'FORMATION_ASSIGNMENT_NOTIFICATION'
+    );
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING (type::webhook_type);
+
+CREATE OR REPLACE VIEW application_webhooks_tenants AS
+SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                            INNER JOIN tenant_applications ta ON w.app_id = ta.id;
+
+CREATE OR REPLACE VIEW runtime_webhooks_tenants AS
+SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                            INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id;
+
+CREATE OR REPLACE VIEW webhooks_tenants AS
+(SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                             INNER JOIN tenant_applications ta ON w.app_id = ta.id)
+UNION ALL
+(SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                             INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id);
+
+CREATE TYPE formation_assignment_state AS ENUM (
+    'INITIAL',
+    'IN_PROGRESS',
+    'READY',
+    'FAILED'
+    );
+
+CREATE TABLE formation_assignments (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    formation_id UUID NOT NULL CHECK (formation_id <> '00000000-0000-0000-0000-000000000000'),
+    FOREIGN KEY (formation_id) REFERENCES formations(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL CHECK (tenant_id <> '00000000-0000-0000-0000-000000000000'),
+    FOREIGN KEY (tenant_id) REFERENCES business_tenant_mappings(id) ON DELETE CASCADE,
+    source UUID NOT NULL,
+    source_type VARCHAR(256) NOT NULL,
+    target UUID NOT NULL,
+    target_type VARCHAR(256) NOT NULL,
+    webhook_id UUID NOT NULL,
+    FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE,
+    operation VARCHAR(256) NOT NULL,
+    state formation_assignment_state NOT NULL DEFAULT 'INITIAL',
+    error JSONB,
+    CONSTRAINT formation_assignments_source_target_unique UNIQUE (formation_id, source, target)
+);
+
+CREATE INDEX formation_assignments_tenant_id_formation_id_idx ON formation_assignments (tenant_id, formation_id);
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+DROP INDEX labels_app_search_vector_idx;
+
+DROP TRIGGER set_search_vector_application ON applications;
+DROP TRIGGER set_search_vector_bundle ON bundles;
+DROP TRIGGER set_search_vector_api_def ON api_definitions;
+DROP TRIGGER set_search_vector_event_def ON event_api_definitions;
+DROP TRIGGER set_search_vector_document ON documents;
+
+DROP FUNCTION set_application_search_vector();
+DROP FUNCTION set_bundle_search_vector();
+DROP FUNCTION set_definition_search_vector();
+DROP FUNCTION set_document_search_vector();
+
+ALTER TABLE applications DROP COLUMN search_vector;
+ALTER TABLE bundles DROP COLUMN search_vector;
+ALTER TABLE api_definitions DROP COLUMN search_vector;
+ALTER TABLE event_api_definitions DROP COLUMN search_vector;
+ALTER TABLE documents DROP COLUMN search_vector;
+
+COMMIT;
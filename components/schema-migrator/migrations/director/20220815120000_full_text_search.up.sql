@@ -0,0 +1,111 @@
+BEGIN;
+
+-- The search vectors are maintained by triggers instead of generated columns, as generated columns are not available in PostgreSQL 11.
+-- The 'simple' configuration is used, so that names and ORD IDs are matched as they are, without stemming.
+
+ALTER TABLE applications ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE bundles ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE api_definitions ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE event_api_definitions ADD COLUMN search_vector TSVECTOR;
+ALTER TABLE documents ADD COLUMN search_vector TSVECTOR;
+
+CREATE OR REPLACE FUNCTION set_application_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := setweight(to_tsvector('simple', coalesce(NEW.name, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.labels::text, '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION set_bundle_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := setweight(to_tsvector('simple', coalesce(NEW.name, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.ord_id, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.short_description, '')), 'B') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.labels::text, '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+-- Used for both API and Event definitions, as they have the same searchable columns
+CREATE OR REPLACE FUNCTION set_definition_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := setweight(to_tsvector('simple', coalesce(NEW.name, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.ord_id, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.short_description, '')), 'B') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.labels::text, '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION set_document_search_vector()
+    RETURNS TRIGGER
+AS
+$$
+BEGIN
+    NEW.search_vector := setweight(to_tsvector('simple', coalesce(NEW.title, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.display_name, '')), 'A') ||
+                         setweight(to_tsvector('simple', coalesce(NEW.description, '')), 'B');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER set_search_vector_application
+    BEFORE INSERT OR UPDATE
+    ON applications
+    FOR EACH ROW
+EXECUTE PROCEDURE set_application_search_vector();
+
+CREATE TRIGGER set_search_vector_bundle
+    BEFORE INSERT OR UPDATE
+    ON bundles
+    FOR EACH ROW
+EXECUTE PROCEDURE set_bundle_search_vector();
+
+CREATE TRIGGER set_search_vector_api_def
+    BEFORE INSERT OR UPDATE
+    ON api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE set_definition_search_vector();
+
+CREATE TRIGGER set_search_vector_event_def
+    BEFORE INSERT OR UPDATE
+    ON event_api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE set_definition_search_vector();
+
+CREATE TRIGGER set_search_vector_document
+    BEFORE INSERT OR UPDATE
+    ON documents
+    FOR EACH ROW
+EXECUTE PROCEDURE set_document_search_vector();
+
+-- Populate the search vectors of the existing rows through the triggers
+UPDATE applications SET search_vector = NULL;
+UPDATE bundles SET search_vector = NULL;
+UPDATE api_definitions SET search_vector = NULL;
+UPDATE event_api_definitions SET search_vector = NULL;
+UPDATE documents SET search_vector = NULL;
+
+CREATE INDEX applications_search_vector_idx ON applications USING GIN (search_vector);
+CREATE INDEX bundles_search_vector_idx ON bundles USING GIN (search_vector);
+CREATE INDEX api_definitions_search_vector_idx ON api_definitions USING GIN (search_vector);
+CREATE INDEX event_api_definitions_search_vector_idx ON event_api_definitions USING GIN (search_vector);
+CREATE INDEX documents_search_vector_idx ON documents USING GIN (search_vector);
+
+-- Application labels are matched at query time, the expression must be the same as the one used by the search query
+CREATE INDEX labels_app_search_vector_idx ON labels USING GIN (to_tsvector('simple', key || ' ' || coalesce(value::text, ''))) WHERE app_id IS NOT NULL;
+
+COMMIT;
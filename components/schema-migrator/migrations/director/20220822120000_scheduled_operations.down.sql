@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE scheduled_operations;
+
+DROP TYPE scheduled_operation_phase;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TYPE scheduled_operation_phase AS ENUM (
+    'IN_PROGRESS',
+    'SUCCESS',
+    'FAILED'
+    );
+
+CREATE TABLE scheduled_operations (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    resource_type VARCHAR(256) NOT NULL,
+    resource_id UUID NOT NULL,
+    operation_type VARCHAR(256) NOT NULL,
+    operation_category VARCHAR(256),
+    correlation_id VARCHAR(256),
+    webhook_ids JSONB,
+    request_object TEXT,
+    phase scheduled_operation_phase NOT NULL DEFAULT 'IN_PROGRESS',
+    error TEXT,
+    webhook_poll_url TEXT,
+    last_poll_timestamp TIMESTAMP,
+    retries_count INTEGER NOT NULL DEFAULT 0,
+    initialized_at TIMESTAMP NOT NULL,
+    next_attempt_at TIMESTAMP NOT NULL,
+    CONSTRAINT scheduled_operations_resource_unique UNIQUE (resource_type, resource_id)
+);
+
+CREATE INDEX scheduled_operations_next_attempt_at_idx ON scheduled_operations (next_attempt_at) WHERE phase = 'IN_PROGRESS';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE operations_history;
+
+DROP TYPE operation_phase;
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TYPE operation_phase AS ENUM (
+    'IN_PROGRESS',
+    'SUCCEEDED',
+    'FAILED'
+    );
+
+CREATE TABLE operations_history (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    resource_type VARCHAR(256) NOT NULL,
+    resource_id UUID NOT NULL,
+    tenant_id UUID,
+    operation_type VARCHAR(256) NOT NULL,
+    operation_category VARCHAR(256),
+    correlation_id VARCHAR(256),
+    phase operation_phase NOT NULL DEFAULT 'IN_PROGRESS',
+    error TEXT,
+    webhooks JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP
+);
+
+CREATE INDEX operations_history_resource_idx ON operations_history (resource_type, resource_id, created_at DESC);
+
+-- at most one operation can be in progress for a resource, which is the one to which the reported progress and results are applied
+CREATE UNIQUE INDEX operations_history_in_progress_idx ON operations_history (resource_type, resource_id) WHERE phase = 'IN_PROGRESS';
+
+COMMIT;
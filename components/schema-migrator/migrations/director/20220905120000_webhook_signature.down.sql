@@ -0,0 +1,24 @@
+BEGIN;
+
+DROP VIEW IF EXISTS webhooks_tenants;
+DROP VIEW IF EXISTS application_webhooks_tenants;
+DROP VIEW IF EXISTS runtime_webhooks_tenants;
+
+ALTER TABLE webhooks DROP COLUMN signature;
+
+CREATE OR REPLACE VIEW application_webhooks_tenants AS
+SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                            INNER JOIN tenant_applications ta ON w.app_id = ta.id;
+
+CREATE OR REPLACE VIEW runtime_webhooks_tenants AS
+SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                            INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id;
+
+CREATE OR REPLACE VIEW webhooks_tenants AS
+(SELECT w.*, ta.tenant_id, ta.owner FROM webhooks AS w
+                                             INNER JOIN tenant_applications ta ON w.app_id = ta.id)
+UNION ALL
+(SELECT w.*, tr.tenant_id, tr.owner FROM webhooks AS w
+                                             INNER JOIN tenant_runtimes tr ON w.runtime_id = tr.id);
+
+COMMIT;
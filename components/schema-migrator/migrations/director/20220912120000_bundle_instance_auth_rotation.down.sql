@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP VIEW IF EXISTS bundle_instance_auths_tenants;
+
+DROP INDEX IF EXISTS bundle_instance_auths_expires_at;
+DROP INDEX IF EXISTS bundle_instance_auths_previous_auth_expires_at;
+
+ALTER TABLE bundle_instance_auths DROP COLUMN expires_at;
+ALTER TABLE bundle_instance_auths DROP COLUMN previous_auth_value;
+ALTER TABLE bundle_instance_auths DROP COLUMN previous_auth_expires_at;
+
+CREATE OR REPLACE VIEW bundle_instance_auths_tenants AS
+SELECT bia.*, ta.tenant_id, ta.owner  FROM bundle_instance_auths AS bia
+                                               INNER JOIN bundles b ON b.id = bia.bundle_id
+                                               INNER JOIN tenant_applications ta ON ta.id = b.app_id;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP VIEW IF EXISTS bundle_instance_auths_tenants;
+
+ALTER TABLE bundle_instance_auths ADD COLUMN expires_at TIMESTAMP;
+ALTER TABLE bundle_instance_auths ADD COLUMN previous_auth_value JSONB;
+ALTER TABLE bundle_instance_auths ADD COLUMN previous_auth_expires_at TIMESTAMP;
+
+CREATE INDEX bundle_instance_auths_expires_at ON bundle_instance_auths(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX bundle_instance_auths_previous_auth_expires_at ON bundle_instance_auths(previous_auth_expires_at) WHERE previous_auth_expires_at IS NOT NULL;
+
+CREATE OR REPLACE VIEW bundle_instance_auths_tenants AS
+SELECT bia.*, ta.tenant_id, ta.owner  FROM bundle_instance_auths AS bia
+                                               INNER JOIN bundles b ON b.id = bia.bundle_id
+                                               INNER JOIN tenant_applications ta ON ta.id = b.app_id;
+
+COMMIT;
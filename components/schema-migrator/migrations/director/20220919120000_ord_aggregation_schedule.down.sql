@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE ord_aggregation_schedule;
+
+COMMIT;
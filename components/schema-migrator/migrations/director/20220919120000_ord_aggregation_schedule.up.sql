@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE ord_aggregation_schedule (
+    app_id UUID PRIMARY KEY REFERENCES applications (id) ON DELETE CASCADE,
+    next_due_at TIMESTAMP NOT NULL,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_aggregated_at TIMESTAMP,
+    -- the cache validators and the well-known configuration returned by the provider on the last successful aggregation
+    fetch_cache JSONB
+);
+
+CREATE INDEX ord_aggregation_schedule_next_due_at_idx ON ord_aggregation_schedule (next_due_at);
+
+COMMIT;
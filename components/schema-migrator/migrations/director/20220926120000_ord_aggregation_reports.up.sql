@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE ord_aggregation_reports (
+    app_id UUID PRIMARY KEY REFERENCES applications (id) ON DELETE CASCADE,
+    status VARCHAR(32) NOT NULL CHECK (status IN ('SUCCEEDED', 'FAILED')),
+    error TEXT,
+    documents_fetched INTEGER NOT NULL DEFAULT 0,
+    -- the resources described in the documents which passed the validation, and the ones which failed it along with the failing rule and field path
+    accepted_resources JSONB NOT NULL DEFAULT '[]'::jsonb,
+    rejected_resources JSONB NOT NULL DEFAULT '[]'::jsonb,
+    -- the ORD IDs of the tombstones whose resources were deleted
+    applied_tombstones JSONB NOT NULL DEFAULT '[]'::jsonb,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP NOT NULL
+);
+
+COMMIT;
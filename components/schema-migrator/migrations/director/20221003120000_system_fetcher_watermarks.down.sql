@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE system_fetcher_watermarks;
+
+COMMIT;
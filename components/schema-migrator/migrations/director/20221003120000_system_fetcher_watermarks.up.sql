@@ -0,0 +1,10 @@
+BEGIN;
+
+CREATE TABLE system_fetcher_watermarks (
+    tenant_id UUID PRIMARY KEY REFERENCES business_tenant_mappings (id) ON DELETE CASCADE,
+    -- the systems of the tenant changed before this time are already synchronized
+    last_change_at TIMESTAMP NOT NULL,
+    last_full_resync_at TIMESTAMP NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE scheduled_operations DROP COLUMN locked_until;
+
+COMMIT;
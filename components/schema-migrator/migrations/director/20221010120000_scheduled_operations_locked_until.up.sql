@@ -0,0 +1,6 @@
+BEGIN;
+
+-- the operation is claimed by a worker until this time, so that its webhook can be executed outside of a database transaction
+ALTER TABLE scheduled_operations ADD COLUMN locked_until TIMESTAMP;
+
+COMMIT;
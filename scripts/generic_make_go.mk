@@ -25,6 +25,10 @@ IMG_GOPATH := /workspace/go
 IMG_GOCACHE := /root/.cache/go-build
 # VERIFY_IGNORE is a grep pattern to exclude files and directories from verification
 VERIFY_IGNORE := /vendor\|/automock
+# DOCKER_BUILD_CONTEXT is a path to the context of the component image build, relative to the component
+DOCKER_BUILD_CONTEXT ?= .
+# BUILDPACK_MOUNTS are additional volumes mounted to the buildpack, e.g. the local modules the component replaces in its go.mod
+BUILDPACK_MOUNTS ?=
 
 # Other variables
 # LOCAL_DIR in a local path to scripts folder
@@ -49,7 +53,7 @@ DEPLOYMENT_NAME="compass-"$(COMPONENT_NAME)
 NAMESPACE="compass-system"
 
 # Base docker configuration
-DOCKER_CREATE_OPTS := -v $(LOCAL_DIR):$(WORKSPACE_LOCAL_DIR):delegated --rm -w $(WORKSPACE_COMPONENT_DIR) $(BUILDPACK)
+DOCKER_CREATE_OPTS := -v $(LOCAL_DIR):$(WORKSPACE_LOCAL_DIR):delegated $(BUILDPACK_MOUNTS) --rm -w $(WORKSPACE_COMPONENT_DIR) $(BUILDPACK)
 
 # Check if go is available
 ifneq (,$(shell go version 2>/dev/null))
@@ -101,7 +105,7 @@ release: verify build-image
 build-image: pull-licenses
 	docker run --rm --privileged linuxkit/binfmt:v0.8 # https://stackoverflow.com/questions/70066249/docker-random-alpine-packages-fail-to-install
 	docker buildx create --name multi-arch-builder --use
-	( sleep 15m && docker buildx rm multi-arch-builder ) & docker buildx build --platform linux/amd64,linux/arm64 -t $(IMG_NAME):$(TAG) --push . 
+	( sleep 15m && docker buildx rm multi-arch-builder ) & docker buildx build --platform linux/amd64,linux/arm64 -t $(IMG_NAME):$(TAG) -f Dockerfile --push $(DOCKER_BUILD_CONTEXT) 
 docker-create-opts:
 	@echo $(DOCKER_CREATE_OPTS)
 
@@ -111,7 +115,7 @@ $(foreach t,$(MOUNT_TARGETS),$(eval $(call buildpack-mount,$(t))))
 
 # Builds new Docker image into k3d's Docker Registry
 build-for-k3d: pull-licenses-local
-	docker build -t k3d-kyma-registry:5001/$(IMG_NAME):$(TAG) .
+	docker build -t k3d-kyma-registry:5001/$(IMG_NAME):$(TAG) -f Dockerfile $(DOCKER_BUILD_CONTEXT)
 	docker push k3d-kyma-registry:5001/$(IMG_NAME):$(TAG)
 
 build-local: